@@ -106,9 +106,15 @@ var ErrValidationEmptyRootHash = errors.New("rootHash is empty")
 // ErrValidationEmptyAddress signals that an empty address was provided
 var ErrValidationEmptyAddress = errors.New("address is empty")
 
+// ErrValidationEmptyABI signals that an empty contract ABI was provided
+var ErrValidationEmptyABI = errors.New("abi is empty")
+
 // ErrValidationEmptyKey signals that an empty key was provided
 var ErrValidationEmptyKey = errors.New("key is empty")
 
+// ErrValidationEmptyBlsKey signals that an empty BLS key was provided
+var ErrValidationEmptyBlsKey = errors.New("BLS key is empty")
+
 // ErrGetProof signals an error happening when trying to compute a Merkle proof
 var ErrGetProof = errors.New("getting proof failed")
 
@@ -174,3 +180,87 @@ var ErrGetWaitingManagedKeys = errors.New("error getting the waiting managed key
 
 // ErrGetWaitingEpochsLeftForPublicKey signals that an error occurred while getting the waiting epochs left for public key
 var ErrGetWaitingEpochsLeftForPublicKey = errors.New("error getting the waiting epochs left for public key")
+
+// ErrUnauthorized signals that the request does not hold valid admin credentials
+var ErrUnauthorized = errors.New("unauthorized request")
+
+// ErrSetAntifloodQuota signals that an error occurred while changing an antiflood quota
+var ErrSetAntifloodQuota = errors.New("error setting the antiflood quota")
+
+// ErrSetAntifloodTopic signals that an error occurred while changing the antiflood limit of a topic
+var ErrSetAntifloodTopic = errors.New("error setting the antiflood topic limit")
+
+// ErrBlacklistPeer signals that an error occurred while blacklisting a peer
+var ErrBlacklistPeer = errors.New("error blacklisting the peer")
+
+// ErrRemoveBlacklistedPeer signals that an error occurred while removing a peer from the blacklist
+var ErrRemoveBlacklistedPeer = errors.New("error removing the peer from the blacklist")
+
+// ErrImportPeerReputation signals that an error occurred while importing the peer reputation
+var ErrImportPeerReputation = errors.New("error importing the peer reputation")
+
+// ErrAddManagedKey signals that an error occurred while adding a managed key
+var ErrAddManagedKey = errors.New("error adding the managed key")
+
+// ErrRemoveManagedKey signals that an error occurred while removing a managed key
+var ErrRemoveManagedKey = errors.New("error removing the managed key")
+
+// ErrExportSlashingProtection signals that an error occurred while exporting the slashing protection records
+var ErrExportSlashingProtection = errors.New("error exporting the slashing protection records")
+
+// ErrImportSlashingProtection signals that an error occurred while importing the slashing protection records
+var ErrImportSlashingProtection = errors.New("error importing the slashing protection records")
+
+// ErrStartOutportReplay signals that an error occurred while starting the outport replay
+var ErrStartOutportReplay = errors.New("error starting the outport replay")
+
+// ErrGetGovernanceConfig signals that an error occurred while getting the governance configuration
+var ErrGetGovernanceConfig = errors.New("error getting the governance configuration")
+
+// ErrGetGovernanceProposals signals that an error occurred while getting the governance proposals
+var ErrGetGovernanceProposals = errors.New("error getting the governance proposals")
+
+// ErrGetGovernanceProposal signals that an error occurred while getting a governance proposal
+var ErrGetGovernanceProposal = errors.New("error getting the governance proposal")
+
+// ErrGetGovernanceVotes signals that an error occurred while getting the governance votes of an address
+var ErrGetGovernanceVotes = errors.New("error getting the governance votes")
+
+// ErrGetGovernanceVotingPower signals that an error occurred while getting the governance voting power of an address
+var ErrGetGovernanceVotingPower = errors.New("error getting the governance voting power")
+
+// ErrGetGovernanceDelegatedVoteInfo signals that an error occurred while getting the delegated vote info of a contract
+var ErrGetGovernanceDelegatedVoteInfo = errors.New("error getting the governance delegated vote info")
+
+// ErrInvalidProposalNonce signals that an invalid proposal nonce was provided
+var ErrInvalidProposalNonce = errors.New("invalid proposal nonce")
+
+// ErrGetDelegationContractConfig signals that an error occurred while getting the configuration of a delegation contract
+var ErrGetDelegationContractConfig = errors.New("error getting the delegation contract configuration")
+
+// ErrGetDelegationContractNodeStates signals that an error occurred while getting the node states of a delegation contract
+var ErrGetDelegationContractNodeStates = errors.New("error getting the delegation contract node states")
+
+// ErrGetDelegationContractDelegator signals that an error occurred while getting a delegator of a delegation contract
+var ErrGetDelegationContractDelegator = errors.New("error getting the delegation contract delegator")
+
+// ErrGetDelegationContractDelegators signals that an error occurred while getting the delegators of a delegation contract
+var ErrGetDelegationContractDelegators = errors.New("error getting the delegation contract delegators")
+
+// ErrGetRewardsBreakdown signals that an error occurred while getting the rewards breakdown of an epoch
+var ErrGetRewardsBreakdown = errors.New("error getting the rewards breakdown")
+
+// ErrGetOwnerStakingInfo signals that an error occurred while getting the staking info of an owner
+var ErrGetOwnerStakingInfo = errors.New("error getting the owner staking info")
+
+// ErrDecodeWithABI signals that an error occurred while decoding data using the registered contract ABI
+var ErrDecodeWithABI = errors.New("error decoding with the contract abi")
+
+// ErrRegisterContractABI signals that an error occurred while registering a contract ABI
+var ErrRegisterContractABI = errors.New("error registering the contract abi")
+
+// ErrGetContractsWithABI signals that an error occurred while getting the contracts with a registered ABI
+var ErrGetContractsWithABI = errors.New("error getting the contracts with a registered abi")
+
+// ErrVerifyContract signals that an error occurred while verifying a contract code
+var ErrVerifyContract = errors.New("error verifying the contract code")
@@ -156,6 +156,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["address"] = addressGroup
 
+	antifloodGroup, err := groups.NewAntifloodGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["antiflood"] = antifloodGroup
+
 	blockGroup, err := groups.NewBlockGroup(ws.facade)
 	if err != nil {
 		return err
@@ -204,6 +210,36 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["validator"] = validatorGroup
 
+	governanceGroup, err := groups.NewGovernanceGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["governance"] = governanceGroup
+
+	delegationGroup, err := groups.NewDelegationGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["delegation"] = delegationGroup
+
+	rewardsGroup, err := groups.NewRewardsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["rewards"] = rewardsGroup
+
+	stakingGroup, err := groups.NewStakingGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["staking"] = stakingGroup
+
+	abiGroup, err := groups.NewABIGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["abi"] = abiGroup
+
 	vmValuesGroup, err := groups.NewVmValuesGroup(ws.facade)
 	if err != nil {
 		return err
@@ -0,0 +1,131 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const (
+	abiContractsPath = "/contracts"
+	abiContractPath  = "/contract/:address"
+)
+
+// abiFacadeHandler defines the methods to be implemented by a facade for contract ABI requests
+type abiFacadeHandler interface {
+	RegisterContractABI(address string, abiJSON []byte) error
+	GetContractsWithABI() ([]string, error)
+	IsAdminRequestAuthorized(username string, password string) bool
+	IsInterfaceNil() bool
+}
+
+type abiGroup struct {
+	*baseGroup
+	facade    abiFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewABIGroup returns a new instance of abiGroup
+func NewABIGroup(facade abiFacadeHandler) (*abiGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for abi group", errors.ErrNilFacadeHandler)
+	}
+
+	ag := &abiGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	adminMiddlewares := createAdminMiddlewares(func() adminRequestAuthorizer {
+		return ag.getFacade()
+	})
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    abiContractsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getContracts,
+		},
+		{
+			Path:                  abiContractPath,
+			Method:                http.MethodPost,
+			Handler:               ag.registerContractABI,
+			AdditionalMiddlewares: adminMiddlewares,
+		},
+	}
+	ag.endpoints = endpoints
+
+	return ag, nil
+}
+
+// getContracts returns the addresses of the contracts that have a registered ABI
+func (ag *abiGroup) getContracts(c *gin.Context) {
+	contracts, err := ag.getFacade().GetContractsWithABI()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetContractsWithABI, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"contracts": contracts})
+}
+
+// registerContractABI registers the ABI JSON provided in the request body for the contract address provided in the path
+func (ag *abiGroup) registerContractABI(c *gin.Context) {
+	address := c.Param("address")
+	if len(address) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrRegisterContractABI, errors.ErrValidationEmptyAddress)
+		return
+	}
+
+	abiJSON, err := c.GetRawData()
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrRegisterContractABI, err)
+		return
+	}
+	if len(abiJSON) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrRegisterContractABI, errors.ErrValidationEmptyABI)
+		return
+	}
+
+	err = ag.getFacade().RegisterContractABI(address, abiJSON)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrRegisterContractABI, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"status": "ok"})
+}
+
+func (ag *abiGroup) getFacade() abiFacadeHandler {
+	ag.mutFacade.RLock()
+	defer ag.mutFacade.RUnlock()
+
+	return ag.facade
+}
+
+// UpdateFacade will update the facade
+func (ag *abiGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(abiFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	ag.mutFacade.Lock()
+	ag.facade = castFacade
+	ag.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ag *abiGroup) IsInterfaceNil() bool {
+	return ag == nil
+}
@@ -0,0 +1,227 @@
+package groups_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+const testContractABI = `{"name":"Adder","endpoints":[{"name":"getSum","inputs":[],"outputs":[{"type":"BigUint"}]}]}`
+
+type contractsWithABIResponse struct {
+	Data struct {
+		Contracts []string `json:"contracts"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type registerContractABIResponse struct {
+	Data struct {
+		Status string `json:"status"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewABIGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		ag, err := groups.NewABIGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, ag)
+	})
+	t.Run("should work", func(t *testing.T) {
+		ag, err := groups.NewABIGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, ag)
+	})
+}
+
+func TestABIGroup_getContracts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetContractsWithABICalled: func() ([]string, error) {
+				return nil, expectedErr
+			},
+		}
+		ag, _ := groups.NewABIGroup(facade)
+		ws := startWebServer(ag, "abi", getABIRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/abi/contracts", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &contractsWithABIResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetContractsWithABI.Error())
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedContracts := []string{"erd1contract1", "erd1contract2"}
+		facade := &mock.FacadeStub{
+			GetContractsWithABICalled: func() ([]string, error) {
+				return providedContracts, nil
+			},
+		}
+		ag, _ := groups.NewABIGroup(facade)
+		ws := startWebServer(ag, "abi", getABIRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/abi/contracts", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &contractsWithABIResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, providedContracts, response.Data.Contracts)
+	})
+}
+
+func TestABIGroup_registerContractABI(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.RegisterContractABICalled = func(address string, abiJSON []byte) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		}
+		ag, _ := groups.NewABIGroup(facade)
+		ws := startWebServer(ag, "abi", getABIRoutesConfig())
+
+		resp := doAdminRequest(ws, "POST", "/abi/contract/erd1contract", json.RawMessage(testContractABI), false)
+		require.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("empty body should error", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := groups.NewABIGroup(createAdminFacadeStub())
+		ws := startWebServer(ag, "abi", getABIRoutesConfig())
+
+		resp := doAdminRequest(ws, "POST", "/abi/contract/erd1contract", nil, true)
+		response := &registerContractABIResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrValidationEmptyABI.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.RegisterContractABICalled = func(address string, abiJSON []byte) error {
+			return expectedErr
+		}
+		ag, _ := groups.NewABIGroup(facade)
+		ws := startWebServer(ag, "abi", getABIRoutesConfig())
+
+		resp := doAdminRequest(ws, "POST", "/abi/contract/erd1contract", json.RawMessage(testContractABI), true)
+		response := &registerContractABIResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrRegisterContractABI.Error())
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := createAdminFacadeStub()
+		facade.RegisterContractABICalled = func(address string, abiJSON []byte) error {
+			wasCalled = true
+			require.Equal(t, "erd1contract", address)
+			require.JSONEq(t, testContractABI, string(abiJSON))
+			return nil
+		}
+		ag, _ := groups.NewABIGroup(facade)
+		ws := startWebServer(ag, "abi", getABIRoutesConfig())
+
+		resp := doAdminRequest(ws, "POST", "/abi/contract/erd1contract", json.RawMessage(testContractABI), true)
+		response := &registerContractABIResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, "ok", response.Data.Status)
+		require.True(t, wasCalled)
+	})
+}
+
+func TestABIGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := groups.NewABIGroup(&mock.FacadeStub{})
+		err := ag.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := groups.NewABIGroup(&mock.FacadeStub{})
+		err := ag.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := groups.NewABIGroup(&mock.FacadeStub{})
+		err := ag.UpdateFacade(&mock.FacadeStub{
+			GetContractsWithABICalled: func() ([]string, error) {
+				return nil, expectedErr
+			},
+		})
+		require.NoError(t, err)
+
+		ws := startWebServer(ag, "abi", getABIRoutesConfig())
+		req, _ := http.NewRequest("GET", "/abi/contracts", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &contractsWithABIResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+}
+
+func TestABIGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	ag, _ := groups.NewABIGroup(nil)
+	require.True(t, ag.IsInterfaceNil())
+
+	ag, _ = groups.NewABIGroup(&mock.FacadeStub{})
+	require.False(t, ag.IsInterfaceNil())
+}
+
+func getABIRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"abi": {
+				Routes: []config.RouteConfig{
+					{Name: "/contracts", Open: true},
+					{Name: "/contract/:address", Open: true},
+				},
+			},
+		},
+	}
+}
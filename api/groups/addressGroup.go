@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 const (
@@ -32,6 +33,7 @@ const (
 	getRegisteredNFTsPath          = "/:address/registered-nfts"
 	getESDTNFTDataPath             = "/:address/nft/:tokenIdentifier/nonce/:nonce"
 	getGuardianData                = "/:address/guardian-data"
+	verifyCodePath                 = "/:address/verify-code"
 	urlParamOnFinalBlock           = "onFinalBlock"
 	urlParamOnStartOfEpoch         = "onStartOfEpoch"
 	urlParamBlockNonce             = "blockNonce"
@@ -39,6 +41,7 @@ const (
 	urlParamBlockRootHash          = "blockRootHash"
 	urlParamHintEpoch              = "hintEpoch"
 	urlParamWithKeys               = "withKeys"
+	urlParamWithVerification       = "withVerification"
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
@@ -57,6 +60,9 @@ type addressFacadeHandler interface {
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
+	VerifyContract(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error)
+	GetVerifiedContract(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error)
+	IsAdminRequestAuthorized(username string, password string) bool
 	IsInterfaceNil() bool
 }
 
@@ -96,6 +102,10 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 		baseGroup: &baseGroup{},
 	}
 
+	adminMiddlewares := createAdminMiddlewares(func() adminRequestAuthorizer {
+		return ag.getFacade()
+	})
+
 	endpoints := []*shared.EndpointHandlerData{
 		{
 			Path:    getAccountPath,
@@ -172,6 +182,12 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ag.isDataTrieMigrated,
 		},
+		{
+			Path:                  verifyCodePath,
+			Method:                http.MethodPost,
+			Handler:               ag.verifyCode,
+			AdditionalMiddlewares: adminMiddlewares,
+		},
 	}
 	ag.endpoints = endpoints
 
@@ -192,6 +208,12 @@ func (ag *addressGroup) getAccount(c *gin.Context) {
 		return
 	}
 
+	withVerification, err := parseBoolUrlParam(c, urlParamWithVerification)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrCouldNotGetAccount, err)
+		return
+	}
+
 	options.WithKeys = withKeys
 
 	accountResponse, blockInfo, err := ag.getFacade().GetAccount(addr, options)
@@ -201,7 +223,44 @@ func (ag *addressGroup) getAccount(c *gin.Context) {
 	}
 
 	accountResponse.Address = addr
-	shared.RespondWithSuccess(c, gin.H{"account": accountResponse, "blockInfo": blockInfo})
+	if !withVerification {
+		shared.RespondWithSuccess(c, gin.H{"account": accountResponse, "blockInfo": blockInfo})
+		return
+	}
+
+	// the verification is returned only if the verified code is the one the account had on the requested block
+	verifiedContract, err := ag.getFacade().GetVerifiedContract(addr, accountResponse.CodeHash)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrCouldNotGetAccount, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"account": accountResponse, "verification": verifiedContract, "blockInfo": blockInfo})
+}
+
+// verifyCode checks the reproducible build artifact provided in the request body against the code deployed at the
+// provided address and returns the stored verification record
+func (ag *addressGroup) verifyCode(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrVerifyContract, errors.ErrEmptyAddress)
+		return
+	}
+
+	artifact := &common.ContractVerificationArtifact{}
+	err := c.ShouldBindJSON(artifact)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrVerifyContract, err)
+		return
+	}
+
+	verifiedContract, err := ag.getFacade().VerifyContract(addr, artifact)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrVerifyContract, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"verification": verifiedContract})
 }
 
 // getAccounts returns the state of the provided addresses on the specified block
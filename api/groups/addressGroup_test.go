@@ -10,6 +10,7 @@ import (
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,6 +38,14 @@ type accountResponse struct {
 	} `json:"account"`
 }
 
+type verifiedAccountResponse struct {
+	Data struct {
+		Verification *common.VerifiedContractAPIResponse `json:"verification"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 type valueForKeyResponseData struct {
 	Value string `json:"value"`
 }
@@ -240,6 +250,138 @@ func TestAddressGroup_getAccount(t *testing.T) {
 		assert.Equal(t, "120", accResp.Account.DeveloperReward)
 		assert.Empty(t, response.Error)
 	})
+	t.Run("invalid with verification flag should error",
+		testErrorScenario("/address/addr?withVerification=not-bool", "GET", nil,
+			formatExpectedErr(apiErrors.ErrCouldNotGetAccount, &strconv.NumError{Func: "ParseBool", Num: "not-bool", Err: strconv.ErrSyntax})))
+	t.Run("verification error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				return api.AccountResponse{CodeHash: []byte("code hash")}, api.BlockInfo{}, nil
+			},
+			GetVerifiedContractCalled: func(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		testAddressGroup(
+			t,
+			facade,
+			"/address/addr?withVerification=true",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrCouldNotGetAccount, expectedErr),
+		)
+	})
+	t.Run("should work with verification", func(t *testing.T) {
+		t.Parallel()
+
+		providedVerification := &common.VerifiedContractAPIResponse{
+			Address:  "addr",
+			CodeHash: "636f64652068617368",
+			UnverifiedMetadata: &common.SubmittedContractMetadata{
+				CompilerVersion: "rustc 1.76.0",
+				SourceHash:      "abcdef",
+				ABI:             json.RawMessage(`{"name":"Adder"}`),
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				return api.AccountResponse{CodeHash: []byte("code hash")}, api.BlockInfo{}, nil
+			},
+			GetVerifiedContractCalled: func(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error) {
+				require.Equal(t, "addr", address)
+				require.Equal(t, []byte("code hash"), codeHash)
+				return providedVerification, nil
+			},
+		}
+
+		response := &verifiedAccountResponse{}
+		loadAddressGroupResponse(t, facade, "/address/addr?withVerification=true", "GET", nil, response)
+		assert.Equal(t, providedVerification, response.Data.Verification)
+		assert.Empty(t, response.Error)
+	})
+}
+
+func TestAddressGroup_verifyCode(t *testing.T) {
+	t.Parallel()
+
+	artifact := &common.ContractVerificationArtifact{
+		Code:            "0061736d",
+		CompilerVersion: "rustc 1.76.0",
+		SourceHash:      "abcdef",
+	}
+
+	t.Run("missing credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.VerifyContractCalled = func(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		}
+		addrGroup, _ := groups.NewAddressGroup(facade)
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		resp := doAdminRequest(ws, "POST", "/address/addr/verify-code", artifact, false)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		addrGroup, _ := groups.NewAddressGroup(createAdminFacadeStub())
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		resp := doAdminRequest(ws, "POST", "/address/addr/verify-code", "not an artifact", true)
+		response := &verifiedAccountResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrVerifyContract.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.VerifyContractCalled = func(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error) {
+			return nil, expectedErr
+		}
+		addrGroup, _ := groups.NewAddressGroup(facade)
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		resp := doAdminRequest(ws, "POST", "/address/addr/verify-code", artifact, true)
+		response := &verifiedAccountResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, formatExpectedErr(apiErrors.ErrVerifyContract, expectedErr), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedVerification := &common.VerifiedContractAPIResponse{
+			Address:  "addr",
+			CodeHash: "aabb",
+			UnverifiedMetadata: &common.SubmittedContractMetadata{
+				CompilerVersion: "rustc 1.76.0",
+				SourceHash:      "abcdef",
+			},
+		}
+		facade := createAdminFacadeStub()
+		facade.VerifyContractCalled = func(address string, providedArtifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error) {
+			require.Equal(t, "addr", address)
+			require.Equal(t, artifact, providedArtifact)
+			return providedVerification, nil
+		}
+		addrGroup, _ := groups.NewAddressGroup(facade)
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		resp := doAdminRequest(ws, "POST", "/address/addr/verify-code", artifact, true)
+		response := &verifiedAccountResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedVerification, response.Data.Verification)
+	})
 }
 
 func TestAddressGroup_getBalance(t *testing.T) {
@@ -1151,6 +1293,7 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
 					{Name: "/:address/is-data-trie-migrated", Open: true},
+					{Name: "/:address/verify-code", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,274 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	quotasPath       = "/quotas"
+	quotaPath        = "/quota"
+	topicsPath       = "/topics"
+	topicPath        = "/topic"
+	blacklistPath    = "/blacklist"
+	blacklistPidPath = "/blacklist/:pid"
+	reputationPath   = "/reputation"
+)
+
+// antifloodFacadeHandler defines the methods to be implemented by a facade for antiflood requests
+type antifloodFacadeHandler interface {
+	GetAntifloodQuotas() []*common.AntifloodQuotaStatus
+	GetAntifloodTopics() []*common.AntifloodTopicStatus
+	GetAntifloodBlacklistedPeers() []*common.BlacklistedPeer
+	SetAntifloodQuota(name string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetAntifloodTopicMaxMessages(topic string, maxMessagesPerPeer uint32) error
+	AddAntifloodBlacklistedPeer(pid string, duration time.Duration) error
+	RemoveAntifloodBlacklistedPeer(pid string) error
+	ExportPeerReputation() *common.PeerReputationSnapshot
+	ImportPeerReputation(snapshot *common.PeerReputationSnapshot) error
+	IsAdminRequestAuthorized(username string, password string) bool
+	IsInterfaceNil() bool
+}
+
+type antifloodGroup struct {
+	*baseGroup
+	facade    antifloodFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewAntifloodGroup returns a new instance of antifloodGroup
+func NewAntifloodGroup(facade antifloodFacadeHandler) (*antifloodGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for antiflood group", errors.ErrNilFacadeHandler)
+	}
+
+	ag := &antifloodGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	adminMiddlewares := createAdminMiddlewares(func() adminRequestAuthorizer {
+		return ag.getFacade()
+	})
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    quotasPath,
+			Method:  http.MethodGet,
+			Handler: ag.getQuotas,
+		},
+		{
+			Path:                  quotaPath,
+			Method:                http.MethodPost,
+			Handler:               ag.setQuota,
+			AdditionalMiddlewares: adminMiddlewares,
+		},
+		{
+			Path:    topicsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getTopics,
+		},
+		{
+			Path:                  topicPath,
+			Method:                http.MethodPost,
+			Handler:               ag.setTopic,
+			AdditionalMiddlewares: adminMiddlewares,
+		},
+		{
+			Path:    blacklistPath,
+			Method:  http.MethodGet,
+			Handler: ag.getBlacklist,
+		},
+		{
+			Path:                  blacklistPath,
+			Method:                http.MethodPost,
+			Handler:               ag.addToBlacklist,
+			AdditionalMiddlewares: adminMiddlewares,
+		},
+		{
+			Path:                  blacklistPidPath,
+			Method:                http.MethodDelete,
+			Handler:               ag.removeFromBlacklist,
+			AdditionalMiddlewares: adminMiddlewares,
+		},
+		{
+			Path:    reputationPath,
+			Method:  http.MethodGet,
+			Handler: ag.exportReputation,
+		},
+		{
+			Path:                  reputationPath,
+			Method:                http.MethodPost,
+			Handler:               ag.importReputation,
+			AdditionalMiddlewares: adminMiddlewares,
+		},
+	}
+	ag.endpoints = endpoints
+
+	return ag, nil
+}
+
+// AntifloodQuotaRequest represents the structure on which user input for changing an antiflood quota will validate against
+type AntifloodQuotaRequest struct {
+	Name                string `json:"name"`
+	MaxMessagesPerPeer  uint32 `json:"maxMessagesPerPeer"`
+	MaxTotalSizePerPeer uint64 `json:"maxTotalSizePerPeer"`
+}
+
+// AntifloodTopicRequest represents the structure on which user input for changing an antiflood topic limit will validate against
+type AntifloodTopicRequest struct {
+	Topic              string `json:"topic"`
+	MaxMessagesPerPeer uint32 `json:"maxMessagesPerPeer"`
+}
+
+// BlacklistPeerRequest represents the structure on which user input for blacklisting a peer will validate against
+type BlacklistPeerRequest struct {
+	Pid               string `json:"pid"`
+	DurationInSeconds uint32 `json:"durationInSeconds"`
+}
+
+// getQuotas returns the current status of the antiflood quotas
+func (ag *antifloodGroup) getQuotas(c *gin.Context) {
+	quotas := ag.getFacade().GetAntifloodQuotas()
+	shared.RespondWithSuccess(c, gin.H{"quotas": quotas})
+}
+
+// setQuota changes the limits of an antiflood quota
+func (ag *antifloodGroup) setQuota(c *gin.Context) {
+	request := AntifloodQuotaRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = ag.getFacade().SetAntifloodQuota(request.Name, request.MaxMessagesPerPeer, request.MaxTotalSizePerPeer)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrSetAntifloodQuota, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"status": "ok"})
+}
+
+// getTopics returns the current status of the antiflood topics
+func (ag *antifloodGroup) getTopics(c *gin.Context) {
+	topics := ag.getFacade().GetAntifloodTopics()
+	shared.RespondWithSuccess(c, gin.H{"topics": topics})
+}
+
+// setTopic changes the maximum number of messages per peer accepted on a topic
+func (ag *antifloodGroup) setTopic(c *gin.Context) {
+	request := AntifloodTopicRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = ag.getFacade().SetAntifloodTopicMaxMessages(request.Topic, request.MaxMessagesPerPeer)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrSetAntifloodTopic, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"status": "ok"})
+}
+
+// getBlacklist returns the peers currently blacklisted
+func (ag *antifloodGroup) getBlacklist(c *gin.Context) {
+	peers := ag.getFacade().GetAntifloodBlacklistedPeers()
+	shared.RespondWithSuccess(c, gin.H{"blacklist": peers})
+}
+
+// addToBlacklist manually blacklists a peer
+func (ag *antifloodGroup) addToBlacklist(c *gin.Context) {
+	request := BlacklistPeerRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	duration := time.Duration(request.DurationInSeconds) * time.Second
+	err = ag.getFacade().AddAntifloodBlacklistedPeer(request.Pid, duration)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBlacklistPeer, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"status": "ok"})
+}
+
+// removeFromBlacklist manually removes a peer from the blacklist
+func (ag *antifloodGroup) removeFromBlacklist(c *gin.Context) {
+	pid := c.Param("pid")
+	err := ag.getFacade().RemoveAntifloodBlacklistedPeer(pid)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrRemoveBlacklistedPeer, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"status": "ok"})
+}
+
+// exportReputation returns the blacklisted peers and the peers ratings, in the format accepted by importReputation
+func (ag *antifloodGroup) exportReputation(c *gin.Context) {
+	snapshot := ag.getFacade().ExportPeerReputation()
+	shared.RespondWithSuccess(c, gin.H{"reputation": snapshot})
+}
+
+// importReputation applies the provided blacklisted peers and peers ratings
+func (ag *antifloodGroup) importReputation(c *gin.Context) {
+	snapshot := &common.PeerReputationSnapshot{}
+	err := c.ShouldBindJSON(snapshot)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = ag.getFacade().ImportPeerReputation(snapshot)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrImportPeerReputation, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"status": "ok"})
+}
+
+func (ag *antifloodGroup) getFacade() antifloodFacadeHandler {
+	ag.mutFacade.RLock()
+	defer ag.mutFacade.RUnlock()
+
+	return ag.facade
+}
+
+// UpdateFacade will update the facade
+func (ag *antifloodGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(antifloodFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	ag.mutFacade.Lock()
+	ag.facade = castFacade
+	ag.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ag *antifloodGroup) IsInterfaceNil() bool {
+	return ag == nil
+}
@@ -0,0 +1,530 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	antifloodTestUsername = "admin"
+	antifloodTestPassword = "secret"
+)
+
+type antifloodQuotasResponse struct {
+	Data struct {
+		Quotas []*common.AntifloodQuotaStatus `json:"quotas"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type antifloodTopicsResponse struct {
+	Data struct {
+		Topics []*common.AntifloodTopicStatus `json:"topics"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type antifloodBlacklistResponse struct {
+	Data struct {
+		Blacklist []*common.BlacklistedPeer `json:"blacklist"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type antifloodReputationResponse struct {
+	Data struct {
+		Reputation *common.PeerReputationSnapshot `json:"reputation"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewAntifloodGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		ag, err := groups.NewAntifloodGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, ag)
+	})
+	t.Run("should work", func(t *testing.T) {
+		ag, err := groups.NewAntifloodGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, ag)
+	})
+}
+
+func TestAntifloodGroup_GetEndpoints(t *testing.T) {
+	t.Parallel()
+
+	quotas := []*common.AntifloodQuotaStatus{
+		{
+			Name:                          "fast_reacting",
+			BaseMaxNumMessagesPerPeer:     100,
+			ComputedMaxNumMessagesPerPeer: 120,
+			MaxTotalSizePerPeer:           1024,
+		},
+	}
+	topics := []*common.AntifloodTopicStatus{
+		{
+			Topic:              "heartbeat",
+			MaxMessagesPerPeer: 10,
+			Peers:              map[string]uint32{"pid": 2},
+		},
+	}
+	blacklist := []*common.BlacklistedPeer{
+		{
+			Pid:       "pid",
+			ExpiresAt: 1000,
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetAntifloodQuotasCalled: func() []*common.AntifloodQuotaStatus {
+			return quotas
+		},
+		GetAntifloodTopicsCalled: func() []*common.AntifloodTopicStatus {
+			return topics
+		},
+		GetAntifloodBlacklistedPeersCalled: func() []*common.BlacklistedPeer {
+			return blacklist
+		},
+	}
+	ag, err := groups.NewAntifloodGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+	t.Run("quotas", func(t *testing.T) {
+		resp := doAntifloodRequest(ws, http.MethodGet, "/antiflood/quotas", nil, false)
+		response := antifloodQuotasResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, quotas, response.Data.Quotas)
+	})
+	t.Run("topics", func(t *testing.T) {
+		resp := doAntifloodRequest(ws, http.MethodGet, "/antiflood/topics", nil, false)
+		response := antifloodTopicsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, topics, response.Data.Topics)
+	})
+	t.Run("blacklist", func(t *testing.T) {
+		resp := doAntifloodRequest(ws, http.MethodGet, "/antiflood/blacklist", nil, false)
+		response := antifloodBlacklistResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, blacklist, response.Data.Blacklist)
+	})
+}
+
+func TestAntifloodGroup_SetQuota(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unauthorized request should not call the facade", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAntifloodFacadeStub()
+		facade.SetAntifloodQuotaCalled = func(name string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		request := groups.AntifloodQuotaRequest{Name: "fast_reacting", MaxMessagesPerPeer: 10, MaxTotalSizePerPeer: 100}
+		resp := doAntifloodRequest(ws, http.MethodPost, "/antiflood/quota", request, false)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := groups.NewAntifloodGroup(createAntifloodFacadeStub())
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		resp := doAntifloodRequest(ws, http.MethodPost, "/antiflood/quota", "not a request", true)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := createAntifloodFacadeStub()
+		facade.SetAntifloodQuotaCalled = func(name string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+			return expectedErr
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		request := groups.AntifloodQuotaRequest{Name: "fast_reacting", MaxMessagesPerPeer: 10, MaxTotalSizePerPeer: 100}
+		resp := doAntifloodRequest(ws, http.MethodPost, "/antiflood/quota", request, true)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrSetAntifloodQuota.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := createAntifloodFacadeStub()
+		facade.SetAntifloodQuotaCalled = func(name string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+			wasCalled = true
+			assert.Equal(t, "fast_reacting", name)
+			assert.Equal(t, uint32(10), maxMessagesPerPeer)
+			assert.Equal(t, uint64(100), maxTotalSizePerPeer)
+			return nil
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		request := groups.AntifloodQuotaRequest{Name: "fast_reacting", MaxMessagesPerPeer: 10, MaxTotalSizePerPeer: 100}
+		resp := doAntifloodRequest(ws, http.MethodPost, "/antiflood/quota", request, true)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestAntifloodGroup_SetTopic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := createAntifloodFacadeStub()
+		facade.SetAntifloodTopicMaxMessagesCalled = func(topic string, maxMessagesPerPeer uint32) error {
+			return expectedErr
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		request := groups.AntifloodTopicRequest{Topic: "heartbeat", MaxMessagesPerPeer: 5}
+		resp := doAntifloodRequest(ws, http.MethodPost, "/antiflood/topic", request, true)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrSetAntifloodTopic.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := createAntifloodFacadeStub()
+		facade.SetAntifloodTopicMaxMessagesCalled = func(topic string, maxMessagesPerPeer uint32) error {
+			wasCalled = true
+			assert.Equal(t, "heartbeat", topic)
+			assert.Equal(t, uint32(5), maxMessagesPerPeer)
+			return nil
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		request := groups.AntifloodTopicRequest{Topic: "heartbeat", MaxMessagesPerPeer: 5}
+		resp := doAntifloodRequest(ws, http.MethodPost, "/antiflood/topic", request, true)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestAntifloodGroup_Blacklist(t *testing.T) {
+	t.Parallel()
+
+	t.Run("add facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := createAntifloodFacadeStub()
+		facade.AddAntifloodBlacklistedPeerCalled = func(pid string, duration time.Duration) error {
+			return expectedErr
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		request := groups.BlacklistPeerRequest{Pid: "pid", DurationInSeconds: 60}
+		resp := doAntifloodRequest(ws, http.MethodPost, "/antiflood/blacklist", request, true)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBlacklistPeer.Error()))
+	})
+	t.Run("add should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := createAntifloodFacadeStub()
+		facade.AddAntifloodBlacklistedPeerCalled = func(pid string, duration time.Duration) error {
+			wasCalled = true
+			assert.Equal(t, "pid", pid)
+			assert.Equal(t, time.Minute, duration)
+			return nil
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		request := groups.BlacklistPeerRequest{Pid: "pid", DurationInSeconds: 60}
+		resp := doAntifloodRequest(ws, http.MethodPost, "/antiflood/blacklist", request, true)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+	t.Run("remove unauthorized should not call the facade", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAntifloodFacadeStub()
+		facade.RemoveAntifloodBlacklistedPeerCalled = func(pid string) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		resp := doAntifloodRequest(ws, http.MethodDelete, "/antiflood/blacklist/pid", nil, false)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("remove facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := createAntifloodFacadeStub()
+		facade.RemoveAntifloodBlacklistedPeerCalled = func(pid string) error {
+			return expectedErr
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		resp := doAntifloodRequest(ws, http.MethodDelete, "/antiflood/blacklist/pid", nil, true)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrRemoveBlacklistedPeer.Error()))
+	})
+	t.Run("remove should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := createAntifloodFacadeStub()
+		facade.RemoveAntifloodBlacklistedPeerCalled = func(pid string) error {
+			wasCalled = true
+			assert.Equal(t, "pid", pid)
+			return nil
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		resp := doAntifloodRequest(ws, http.MethodDelete, "/antiflood/blacklist/pid", nil, true)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestAntifloodGroup_Reputation(t *testing.T) {
+	t.Parallel()
+
+	snapshot := &common.PeerReputationSnapshot{
+		BlacklistedPeers: []*common.BlacklistedPeer{{Pid: "pid1", ExpiresAt: 100}},
+		Ratings:          []*common.PeerRating{{Pid: "pid2", Rating: -20, ExpiresAt: 200}},
+	}
+
+	t.Run("export should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAntifloodFacadeStub()
+		facade.ExportPeerReputationCalled = func() *common.PeerReputationSnapshot {
+			return snapshot
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		resp := doAntifloodRequest(ws, http.MethodGet, "/antiflood/reputation", nil, false)
+		response := antifloodReputationResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, snapshot, response.Data.Reputation)
+	})
+	t.Run("import unauthorized should not call the facade", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAntifloodFacadeStub()
+		facade.ImportPeerReputationCalled = func(snapshot *common.PeerReputationSnapshot) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		resp := doAntifloodRequest(ws, http.MethodPost, "/antiflood/reputation", snapshot, false)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("import invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := groups.NewAntifloodGroup(createAntifloodFacadeStub())
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		resp := doAntifloodRequest(ws, http.MethodPost, "/antiflood/reputation", "invalid", true)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("import facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := createAntifloodFacadeStub()
+		facade.ImportPeerReputationCalled = func(snapshot *common.PeerReputationSnapshot) error {
+			return expectedErr
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		resp := doAntifloodRequest(ws, http.MethodPost, "/antiflood/reputation", snapshot, true)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrImportPeerReputation.Error()))
+	})
+	t.Run("import should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := createAntifloodFacadeStub()
+		facade.ImportPeerReputationCalled = func(providedSnapshot *common.PeerReputationSnapshot) error {
+			wasCalled = true
+			assert.Equal(t, snapshot, providedSnapshot)
+			return nil
+		}
+		ag, _ := groups.NewAntifloodGroup(facade)
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		resp := doAntifloodRequest(ws, http.MethodPost, "/antiflood/reputation", snapshot, true)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestAntifloodGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := groups.NewAntifloodGroup(&mock.FacadeStub{})
+
+		err := ag.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := groups.NewAntifloodGroup(&mock.FacadeStub{})
+
+		err := ag.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work and use the new facade for authorization", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := groups.NewAntifloodGroup(&mock.FacadeStub{})
+		ws := startWebServer(ag, "antiflood", getAntifloodRoutesConfig())
+
+		request := groups.AntifloodTopicRequest{Topic: "heartbeat", MaxMessagesPerPeer: 5}
+		resp := doAntifloodRequest(ws, http.MethodPost, "/antiflood/topic", request, true)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+		err := ag.UpdateFacade(createAntifloodFacadeStub())
+		require.NoError(t, err)
+
+		resp = doAntifloodRequest(ws, http.MethodPost, "/antiflood/topic", request, true)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+}
+
+func TestAntifloodGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	ag, _ := groups.NewAntifloodGroup(nil)
+	require.True(t, ag.IsInterfaceNil())
+
+	ag, _ = groups.NewAntifloodGroup(&mock.FacadeStub{})
+	require.False(t, ag.IsInterfaceNil())
+}
+
+func createAntifloodFacadeStub() *mock.FacadeStub {
+	return &mock.FacadeStub{
+		IsAdminRequestAuthorizedCalled: func(username string, password string) bool {
+			return username == antifloodTestUsername && password == antifloodTestPassword
+		},
+	}
+}
+
+func doAntifloodRequest(ws http.Handler, method string, path string, body interface{}, withCredentials bool) *httptest.ResponseRecorder {
+	var buff []byte
+	if body != nil {
+		buff, _ = json.Marshal(body)
+	}
+
+	req, _ := http.NewRequest(method, path, bytes.NewBuffer(buff))
+	if withCredentials {
+		req.SetBasicAuth(antifloodTestUsername, antifloodTestPassword)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func getAntifloodRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"antiflood": {
+				Routes: []config.RouteConfig{
+					{Name: "/quotas", Open: true},
+					{Name: "/quota", Open: true},
+					{Name: "/topics", Open: true},
+					{Name: "/topic", Open: true},
+					{Name: "/blacklist", Open: true},
+					{Name: "/blacklist/:pid", Open: true},
+					{Name: "/reputation", Open: true},
+				},
+			},
+		},
+	}
+}
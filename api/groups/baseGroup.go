@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -92,3 +93,26 @@ func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig config.Ap
 		isOpen: false,
 	}
 }
+
+type adminRequestAuthorizer interface {
+	IsAdminRequestAuthorized(username string, password string) bool
+}
+
+// currentFacadeAuthorizer checks the admin credentials against the facade used by a group at the time of the request
+type currentFacadeAuthorizer func() adminRequestAuthorizer
+
+// IsAdminRequestAuthorized checks the provided credentials against the current facade
+func (getFacade currentFacadeAuthorizer) IsAdminRequestAuthorized(username string, password string) bool {
+	return getFacade().IsAdminRequestAuthorized(username, password)
+}
+
+// createAdminMiddlewares returns the middlewares restricting an endpoint to the authorized admin requests. The facade
+// is fetched on each request, so the credentials are still checked after the group's facade was updated
+func createAdminMiddlewares(getFacade func() adminRequestAuthorizer) []shared.AdditionalMiddleware {
+	return []shared.AdditionalMiddleware{
+		{
+			Middleware: middleware.CreateAdminAuthorizerFromFacade(currentFacadeAuthorizer(getFacade)),
+			Position:   shared.Before,
+		},
+	}
+}
@@ -1,16 +1,25 @@
 package groups_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
 )
 
+const (
+	adminTestUsername = "admin"
+	adminTestPassword = "secret"
+)
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -34,3 +43,27 @@ func logError(err error) {
 		fmt.Println(err)
 	}
 }
+
+func createAdminFacadeStub() *mock.FacadeStub {
+	return &mock.FacadeStub{
+		IsAdminRequestAuthorizedCalled: func(username string, password string) bool {
+			return username == adminTestUsername && password == adminTestPassword
+		},
+	}
+}
+
+func doAdminRequest(ws http.Handler, method string, path string, body interface{}, withCredentials bool) *httptest.ResponseRecorder {
+	var buff []byte
+	if body != nil {
+		buff, _ = json.Marshal(body)
+	}
+
+	req, _ := http.NewRequest(method, path, bytes.NewBuffer(buff))
+	if withCredentials {
+		req.SetBasicAuth(adminTestUsername, adminTestPassword)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
@@ -0,0 +1,169 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	delegationConfigPath     = "/:contract/config"
+	delegationNodesPath      = "/:contract/nodes"
+	delegationDelegatorPath  = "/:contract/delegator/:address"
+	delegationDelegatorsPath = "/:contract/delegators"
+
+	urlParamPage                = "page"
+	urlParamPageSize            = "pageSize"
+	defaultDelegatorsPageSize   = uint32(100)
+	delegationContractUrlParam  = "contract"
+	delegationDelegatorUrlParam = "address"
+)
+
+// delegationFacadeHandler defines the methods to be implemented by a facade for delegation contract requests
+type delegationFacadeHandler interface {
+	GetDelegationContractConfig(contract string) (*common.DelegationContractConfigAPIResponse, error)
+	GetDelegationContractNodeStates(contract string) (*common.DelegationNodeStatesAPIResponse, error)
+	GetDelegationContractDelegator(contract string, delegator string) (*common.DelegatorAPIResponse, error)
+	GetDelegationContractDelegators(contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+type delegationGroup struct {
+	*baseGroup
+	facade    delegationFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewDelegationGroup returns a new instance of delegationGroup
+func NewDelegationGroup(facade delegationFacadeHandler) (*delegationGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for delegation group", errors.ErrNilFacadeHandler)
+	}
+
+	dg := &delegationGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    delegationConfigPath,
+			Method:  http.MethodGet,
+			Handler: dg.getConfig,
+		},
+		{
+			Path:    delegationNodesPath,
+			Method:  http.MethodGet,
+			Handler: dg.getNodeStates,
+		},
+		{
+			Path:    delegationDelegatorPath,
+			Method:  http.MethodGet,
+			Handler: dg.getDelegator,
+		},
+		{
+			Path:    delegationDelegatorsPath,
+			Method:  http.MethodGet,
+			Handler: dg.getDelegators,
+		},
+	}
+	dg.endpoints = endpoints
+
+	return dg, nil
+}
+
+// getConfig returns the configuration of the delegation contract
+func (dg *delegationGroup) getConfig(c *gin.Context) {
+	config, err := dg.getFacade().GetDelegationContractConfig(c.Param(delegationContractUrlParam))
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetDelegationContractConfig, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"config": config})
+}
+
+// getNodeStates returns the BLS keys of the delegation contract, grouped by their state
+func (dg *delegationGroup) getNodeStates(c *gin.Context) {
+	nodeStates, err := dg.getFacade().GetDelegationContractNodeStates(c.Param(delegationContractUrlParam))
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetDelegationContractNodeStates, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"nodes": nodeStates})
+}
+
+// getDelegator returns the active stake, the unbonding funds and the claimable rewards of a delegator
+func (dg *delegationGroup) getDelegator(c *gin.Context) {
+	contract := c.Param(delegationContractUrlParam)
+	delegator := c.Param(delegationDelegatorUrlParam)
+	delegatorInfo, err := dg.getFacade().GetDelegationContractDelegator(contract, delegator)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetDelegationContractDelegator, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"delegator": delegatorInfo})
+}
+
+// getDelegators returns a page of the delegators list of the delegation contract
+func (dg *delegationGroup) getDelegators(c *gin.Context) {
+	page, err := parseUint32UrlParam(c, urlParamPage)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, fmt.Errorf("%w: %s", errors.ErrBadUrlParams, err.Error()))
+		return
+	}
+	pageSize, err := parseUint32UrlParam(c, urlParamPageSize)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, fmt.Errorf("%w: %s", errors.ErrBadUrlParams, err.Error()))
+		return
+	}
+	if !pageSize.HasValue {
+		pageSize.Value = defaultDelegatorsPageSize
+	}
+
+	contract := c.Param(delegationContractUrlParam)
+	delegators, err := dg.getFacade().GetDelegationContractDelegators(contract, page.Value, pageSize.Value)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetDelegationContractDelegators, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"delegators": delegators})
+}
+
+func (dg *delegationGroup) getFacade() delegationFacadeHandler {
+	dg.mutFacade.RLock()
+	defer dg.mutFacade.RUnlock()
+
+	return dg.facade
+}
+
+// UpdateFacade will update the facade
+func (dg *delegationGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(delegationFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	dg.mutFacade.Lock()
+	dg.facade = castFacade
+	dg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dg *delegationGroup) IsInterfaceNil() bool {
+	return dg == nil
+}
@@ -0,0 +1,348 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+type delegationConfigResponse struct {
+	Data struct {
+		Config *common.DelegationContractConfigAPIResponse `json:"config"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type delegationNodesResponse struct {
+	Data struct {
+		Nodes *common.DelegationNodeStatesAPIResponse `json:"nodes"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type delegationDelegatorResponse struct {
+	Data struct {
+		Delegator *common.DelegatorAPIResponse `json:"delegator"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type delegationDelegatorsResponse struct {
+	Data struct {
+		Delegators *common.DelegatorsListAPIResponse `json:"delegators"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewDelegationGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		dg, err := groups.NewDelegationGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, dg)
+	})
+	t.Run("should work", func(t *testing.T) {
+		dg, err := groups.NewDelegationGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, dg)
+	})
+}
+
+func TestDelegationGroup_getConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetDelegationContractConfigCalled: func(contract string) (*common.DelegationContractConfigAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response := &delegationConfigResponse{}
+		resp := sendDelegationRequest(t, facade, "/delegation/erd1contract/config", response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetDelegationContractConfig.Error())
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedConfig := &common.DelegationContractConfigAPIResponse{
+			Owner:                "erd1owner",
+			ServiceFee:           1000,
+			MaxDelegationCap:     "0",
+			InitialOwnerFunds:    "1250",
+			AutomaticActivation:  true,
+			CreatedNonce:         37,
+			UnBondPeriodInEpochs: 10,
+			TotalActiveStake:     "4000",
+			NumDelegators:        3,
+		}
+		facade := &mock.FacadeStub{
+			GetDelegationContractConfigCalled: func(contract string) (*common.DelegationContractConfigAPIResponse, error) {
+				require.Equal(t, "erd1contract", contract)
+				return providedConfig, nil
+			},
+		}
+
+		response := &delegationConfigResponse{}
+		resp := sendDelegationRequest(t, facade, "/delegation/erd1contract/config", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, providedConfig, response.Data.Config)
+	})
+}
+
+func TestDelegationGroup_getNodeStates(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetDelegationContractNodeStatesCalled: func(contract string) (*common.DelegationNodeStatesAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response := &delegationNodesResponse{}
+		resp := sendDelegationRequest(t, facade, "/delegation/erd1contract/nodes", response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetDelegationContractNodeStates.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedNodeStates := &common.DelegationNodeStatesAPIResponse{
+			Staked:    []string{"bls1", "bls2"},
+			NotStaked: []string{"bls3"},
+			UnStaked:  []string{},
+		}
+		facade := &mock.FacadeStub{
+			GetDelegationContractNodeStatesCalled: func(contract string) (*common.DelegationNodeStatesAPIResponse, error) {
+				require.Equal(t, "erd1contract", contract)
+				return providedNodeStates, nil
+			},
+		}
+
+		response := &delegationNodesResponse{}
+		resp := sendDelegationRequest(t, facade, "/delegation/erd1contract/nodes", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, providedNodeStates, response.Data.Nodes)
+	})
+}
+
+func TestDelegationGroup_getDelegator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetDelegationContractDelegatorCalled: func(contract string, delegator string) (*common.DelegatorAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response := &delegationDelegatorResponse{}
+		resp := sendDelegationRequest(t, facade, "/delegation/erd1contract/delegator/erd1delegator", response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetDelegationContractDelegator.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedDelegator := &common.DelegatorAPIResponse{
+			Address:          "erd1delegator",
+			ActiveStake:      "100",
+			ClaimableRewards: "7",
+			UnStaked:         "30",
+			UnBondable:       "10",
+			UnDelegatedList: []*common.DelegationUnDelegatedFundAPIResponse{
+				{Value: "20", RemainingEpochs: 3},
+				{Value: "10", RemainingEpochs: 0},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetDelegationContractDelegatorCalled: func(contract string, delegator string) (*common.DelegatorAPIResponse, error) {
+				require.Equal(t, "erd1contract", contract)
+				require.Equal(t, "erd1delegator", delegator)
+				return providedDelegator, nil
+			},
+		}
+
+		response := &delegationDelegatorResponse{}
+		resp := sendDelegationRequest(t, facade, "/delegation/erd1contract/delegator/erd1delegator", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, providedDelegator, response.Data.Delegator)
+	})
+}
+
+func TestDelegationGroup_getDelegators(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid page should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &delegationDelegatorsResponse{}
+		resp := sendDelegationRequest(t, &mock.FacadeStub{}, "/delegation/erd1contract/delegators?page=invalid", response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+	t.Run("invalid page size should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &delegationDelegatorsResponse{}
+		resp := sendDelegationRequest(t, &mock.FacadeStub{}, "/delegation/erd1contract/delegators?pageSize=-1", response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetDelegationContractDelegatorsCalled: func(contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response := &delegationDelegatorsResponse{}
+		resp := sendDelegationRequest(t, facade, "/delegation/erd1contract/delegators", response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetDelegationContractDelegators.Error())
+	})
+	t.Run("should use the default page size", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetDelegationContractDelegatorsCalled: func(contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error) {
+				require.Equal(t, uint32(0), page)
+				require.Equal(t, uint32(100), pageSize)
+				return &common.DelegatorsListAPIResponse{}, nil
+			},
+		}
+
+		response := &delegationDelegatorsResponse{}
+		resp := sendDelegationRequest(t, facade, "/delegation/erd1contract/delegators", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedDelegators := &common.DelegatorsListAPIResponse{
+			Delegators: []*common.DelegatorStakeAPIResponse{
+				{Address: "erd1delegator1", ActiveStake: "10"},
+				{Address: "erd1delegator2", ActiveStake: "20"},
+			},
+			NumDelegators: 12,
+			Page:          2,
+			PageSize:      5,
+		}
+		facade := &mock.FacadeStub{
+			GetDelegationContractDelegatorsCalled: func(contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error) {
+				require.Equal(t, "erd1contract", contract)
+				require.Equal(t, uint32(2), page)
+				require.Equal(t, uint32(5), pageSize)
+				return providedDelegators, nil
+			},
+		}
+
+		response := &delegationDelegatorsResponse{}
+		resp := sendDelegationRequest(t, facade, "/delegation/erd1contract/delegators?page=2&pageSize=5", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, providedDelegators, response.Data.Delegators)
+	})
+}
+
+func TestDelegationGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		dg, _ := groups.NewDelegationGroup(&mock.FacadeStub{})
+		err := dg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		dg, _ := groups.NewDelegationGroup(&mock.FacadeStub{})
+		err := dg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dg, _ := groups.NewDelegationGroup(&mock.FacadeStub{})
+		err := dg.UpdateFacade(&mock.FacadeStub{
+			GetDelegationContractNodeStatesCalled: func(contract string) (*common.DelegationNodeStatesAPIResponse, error) {
+				return nil, expectedErr
+			},
+		})
+		require.NoError(t, err)
+
+		ws := startWebServer(dg, "delegation", getDelegationRoutesConfig())
+		req, _ := http.NewRequest("GET", "/delegation/erd1contract/nodes", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &delegationNodesResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+}
+
+func TestDelegationGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	dg, _ := groups.NewDelegationGroup(nil)
+	require.True(t, dg.IsInterfaceNil())
+
+	dg, _ = groups.NewDelegationGroup(&mock.FacadeStub{})
+	require.False(t, dg.IsInterfaceNil())
+}
+
+func sendDelegationRequest(t *testing.T, facade shared.FacadeHandler, path string, response interface{}) *httptest.ResponseRecorder {
+	dg, err := groups.NewDelegationGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(dg, "delegation", getDelegationRoutesConfig())
+	req, _ := http.NewRequest("GET", path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	loadResponse(resp.Body, response)
+
+	return resp
+}
+
+func getDelegationRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"delegation": {
+				Routes: []config.RouteConfig{
+					{Name: "/:contract/config", Open: true},
+					{Name: "/:contract/nodes", Open: true},
+					{Name: "/:contract/delegator/:address", Open: true},
+					{Name: "/:contract/delegators", Open: true},
+				},
+			},
+		},
+	}
+}
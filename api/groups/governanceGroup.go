@@ -0,0 +1,208 @@
+package groups
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	governanceConfigPath            = "/config"
+	governanceProposalsPath         = "/proposals"
+	governanceProposalPath          = "/proposal/:nonce"
+	governanceVotesPath             = "/votes/:address"
+	governanceVotingPowerPath       = "/voting-power/:address"
+	governanceDelegatedVoteInfoPath = "/delegated-vote-info/:contract/:nonce"
+)
+
+// governanceFacadeHandler defines the methods to be implemented by a facade for governance requests
+type governanceFacadeHandler interface {
+	GetGovernanceConfig() (*common.GovernanceConfigAPIResponse, error)
+	GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error)
+	GetGovernanceVotingPower(address string) (*big.Int, error)
+	GetGovernanceDelegatedVoteInfo(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+type governanceGroup struct {
+	*baseGroup
+	facade    governanceFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewGovernanceGroup returns a new instance of governanceGroup
+func NewGovernanceGroup(facade governanceFacadeHandler) (*governanceGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for governance group", errors.ErrNilFacadeHandler)
+	}
+
+	gg := &governanceGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    governanceConfigPath,
+			Method:  http.MethodGet,
+			Handler: gg.getConfig,
+		},
+		{
+			Path:    governanceProposalsPath,
+			Method:  http.MethodGet,
+			Handler: gg.getProposals,
+		},
+		{
+			Path:    governanceProposalPath,
+			Method:  http.MethodGet,
+			Handler: gg.getProposal,
+		},
+		{
+			Path:    governanceVotesPath,
+			Method:  http.MethodGet,
+			Handler: gg.getVotes,
+		},
+		{
+			Path:    governanceVotingPowerPath,
+			Method:  http.MethodGet,
+			Handler: gg.getVotingPower,
+		},
+		{
+			Path:    governanceDelegatedVoteInfoPath,
+			Method:  http.MethodGet,
+			Handler: gg.getDelegatedVoteInfo,
+		},
+	}
+	gg.endpoints = endpoints
+
+	return gg, nil
+}
+
+// getConfig returns the current configuration of the governance system smart contract
+func (gg *governanceGroup) getConfig(c *gin.Context) {
+	config, err := gg.getFacade().GetGovernanceConfig()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetGovernanceConfig, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"config": config})
+}
+
+// getProposals returns all the governance proposals, together with their status and tallies
+func (gg *governanceGroup) getProposals(c *gin.Context) {
+	proposals, err := gg.getFacade().GetGovernanceProposals()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetGovernanceProposals, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"proposals": proposals})
+}
+
+// getProposal returns the governance proposal with the provided nonce
+func (gg *governanceGroup) getProposal(c *gin.Context) {
+	nonce, err := getProposalNonceFromRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	proposal, err := gg.getFacade().GetGovernanceProposal(nonce)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetGovernanceProposal, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"proposal": proposal})
+}
+
+// getVotes returns the nonces of the governance proposals the provided address has voted for
+func (gg *governanceGroup) getVotes(c *gin.Context) {
+	address := c.Param("address")
+	votes, err := gg.getFacade().GetGovernanceVotes(address)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetGovernanceVotes, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"votes": votes})
+}
+
+// getVotingPower returns the governance voting power of the provided address
+func (gg *governanceGroup) getVotingPower(c *gin.Context) {
+	address := c.Param("address")
+	votingPower, err := gg.getFacade().GetGovernanceVotingPower(address)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetGovernanceVotingPower, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"votingPower": votingPower.String()})
+}
+
+// getDelegatedVoteInfo returns the voting stake and power used by the provided delegation contract on a proposal
+func (gg *governanceGroup) getDelegatedVoteInfo(c *gin.Context) {
+	nonce, err := getProposalNonceFromRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	contract := c.Param("contract")
+	delegatedVoteInfo, err := gg.getFacade().GetGovernanceDelegatedVoteInfo(contract, nonce)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetGovernanceDelegatedVoteInfo, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"delegatedVoteInfo": delegatedVoteInfo})
+}
+
+func getProposalNonceFromRequest(c *gin.Context) (uint64, error) {
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errors.ErrInvalidProposalNonce, err.Error())
+	}
+
+	return nonce, nil
+}
+
+func (gg *governanceGroup) getFacade() governanceFacadeHandler {
+	gg.mutFacade.RLock()
+	defer gg.mutFacade.RUnlock()
+
+	return gg.facade
+}
+
+// UpdateFacade will update the facade
+func (gg *governanceGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(governanceFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	gg.mutFacade.Lock()
+	gg.facade = castFacade
+	gg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gg *governanceGroup) IsInterfaceNil() bool {
+	return gg == nil
+}
@@ -0,0 +1,417 @@
+package groups_test
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+type governanceConfigResponse struct {
+	Data struct {
+		Config *common.GovernanceConfigAPIResponse `json:"config"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceProposalsResponse struct {
+	Data struct {
+		Proposals []*common.GovernanceProposalAPIResponse `json:"proposals"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceProposalResponse struct {
+	Data struct {
+		Proposal *common.GovernanceProposalAPIResponse `json:"proposal"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceVotesResponse struct {
+	Data struct {
+		Votes *common.GovernanceVotesAPIResponse `json:"votes"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceVotingPowerResponse struct {
+	Data struct {
+		VotingPower string `json:"votingPower"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceDelegatedVoteInfoResponse struct {
+	Data struct {
+		DelegatedVoteInfo *common.GovernanceDelegatedVoteInfoAPIResponse `json:"delegatedVoteInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewGovernanceGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		gg, err := groups.NewGovernanceGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, gg)
+	})
+	t.Run("should work", func(t *testing.T) {
+		gg, err := groups.NewGovernanceGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, gg)
+	})
+}
+
+func TestGovernanceGroup_getConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGovernanceConfigCalled: func() (*common.GovernanceConfigAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response := &governanceConfigResponse{}
+		resp := sendGovernanceRequest(t, facade, "/governance/config", response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetGovernanceConfig.Error())
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedConfig := &common.GovernanceConfigAPIResponse{
+			ProposalFee:       "1000",
+			MinQuorum:         "0.2",
+			MinPassThreshold:  "0.5",
+			MinVetoThreshold:  "0.33",
+			LastProposalNonce: 3,
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceConfigCalled: func() (*common.GovernanceConfigAPIResponse, error) {
+				return providedConfig, nil
+			},
+		}
+
+		response := &governanceConfigResponse{}
+		resp := sendGovernanceRequest(t, facade, "/governance/config", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, providedConfig, response.Data.Config)
+		require.Empty(t, response.Error)
+	})
+}
+
+func TestGovernanceGroup_getProposals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalsCalled: func() ([]*common.GovernanceProposalAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response := &governanceProposalsResponse{}
+		resp := sendGovernanceRequest(t, facade, "/governance/proposals", response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetGovernanceProposals.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedProposals := []*common.GovernanceProposalAPIResponse{
+			{Nonce: 1, Status: "passed", Yes: "100", Closed: true, Passed: true},
+			{Nonce: 2, Status: "active", Yes: "10"},
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalsCalled: func() ([]*common.GovernanceProposalAPIResponse, error) {
+				return providedProposals, nil
+			},
+		}
+
+		response := &governanceProposalsResponse{}
+		resp := sendGovernanceRequest(t, facade, "/governance/proposals", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, providedProposals, response.Data.Proposals)
+	})
+}
+
+func TestGovernanceGroup_getProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &governanceProposalResponse{}
+		resp := sendGovernanceRequest(t, &mock.FacadeStub{}, "/governance/proposal/invalid", response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+		require.Contains(t, response.Error, apiErrors.ErrInvalidProposalNonce.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalCalled: func(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response := &governanceProposalResponse{}
+		resp := sendGovernanceRequest(t, facade, "/governance/proposal/1", response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetGovernanceProposal.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedProposal := &common.GovernanceProposalAPIResponse{
+			Nonce:          7,
+			CommitHash:     "commit hash",
+			Issuer:         "issuer",
+			ProposalCost:   "1000",
+			StartVoteEpoch: 10,
+			EndVoteEpoch:   12,
+			Status:         "ended",
+			QuorumStake:    "500",
+			Yes:            "300",
+			No:             "100",
+			Veto:           "0",
+			Abstain:        "100",
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalCalled: func(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+				require.Equal(t, uint64(7), nonce)
+				return providedProposal, nil
+			},
+		}
+
+		response := &governanceProposalResponse{}
+		resp := sendGovernanceRequest(t, facade, "/governance/proposal/7", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, providedProposal, response.Data.Proposal)
+	})
+}
+
+func TestGovernanceGroup_getVotes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGovernanceVotesCalled: func(address string) (*common.GovernanceVotesAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response := &governanceVotesResponse{}
+		resp := sendGovernanceRequest(t, facade, "/governance/votes/erd1address", response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetGovernanceVotes.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedVotes := &common.GovernanceVotesAPIResponse{
+			Direct:    []uint64{1, 3},
+			Delegated: []uint64{2},
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceVotesCalled: func(address string) (*common.GovernanceVotesAPIResponse, error) {
+				require.Equal(t, "erd1address", address)
+				return providedVotes, nil
+			},
+		}
+
+		response := &governanceVotesResponse{}
+		resp := sendGovernanceRequest(t, facade, "/governance/votes/erd1address", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, providedVotes, response.Data.Votes)
+	})
+}
+
+func TestGovernanceGroup_getVotingPower(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGovernanceVotingPowerCalled: func(address string) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response := &governanceVotingPowerResponse{}
+		resp := sendGovernanceRequest(t, facade, "/governance/voting-power/erd1address", response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetGovernanceVotingPower.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGovernanceVotingPowerCalled: func(address string) (*big.Int, error) {
+				require.Equal(t, "erd1address", address)
+				return big.NewInt(123456), nil
+			},
+		}
+
+		response := &governanceVotingPowerResponse{}
+		resp := sendGovernanceRequest(t, facade, "/governance/voting-power/erd1address", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, "123456", response.Data.VotingPower)
+	})
+}
+
+func TestGovernanceGroup_getDelegatedVoteInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &governanceDelegatedVoteInfoResponse{}
+		resp := sendGovernanceRequest(t, &mock.FacadeStub{}, "/governance/delegated-vote-info/erd1contract/invalid", response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrInvalidProposalNonce.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGovernanceDelegatedVoteInfoCalled: func(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response := &governanceDelegatedVoteInfoResponse{}
+		resp := sendGovernanceRequest(t, facade, "/governance/delegated-vote-info/erd1contract/2", response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetGovernanceDelegatedVoteInfo.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedInfo := &common.GovernanceDelegatedVoteInfoAPIResponse{
+			UsedStake:  "10",
+			UsedPower:  "20",
+			TotalStake: "30",
+			TotalPower: "40",
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceDelegatedVoteInfoCalled: func(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error) {
+				require.Equal(t, "erd1contract", contract)
+				require.Equal(t, uint64(2), nonce)
+				return providedInfo, nil
+			},
+		}
+
+		response := &governanceDelegatedVoteInfoResponse{}
+		resp := sendGovernanceRequest(t, facade, "/governance/delegated-vote-info/erd1contract/2", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, providedInfo, response.Data.DelegatedVoteInfo)
+	})
+}
+
+func TestGovernanceGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGovernanceGroup(&mock.FacadeStub{})
+		err := gg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGovernanceGroup(&mock.FacadeStub{})
+		err := gg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGovernanceGroup(&mock.FacadeStub{})
+		err := gg.UpdateFacade(&mock.FacadeStub{
+			GetGovernanceVotingPowerCalled: func(address string) (*big.Int, error) {
+				return big.NewInt(5), nil
+			},
+		})
+		require.NoError(t, err)
+
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+		req, _ := http.NewRequest("GET", "/governance/voting-power/erd1address", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &governanceVotingPowerResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, "5", response.Data.VotingPower)
+	})
+}
+
+func TestGovernanceGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	gg, _ := groups.NewGovernanceGroup(nil)
+	require.True(t, gg.IsInterfaceNil())
+
+	gg, _ = groups.NewGovernanceGroup(&mock.FacadeStub{})
+	require.False(t, gg.IsInterfaceNil())
+}
+
+func sendGovernanceRequest(t *testing.T, facade shared.FacadeHandler, path string, response interface{}) *httptest.ResponseRecorder {
+	gg, err := groups.NewGovernanceGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+	req, _ := http.NewRequest("GET", path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	loadResponse(resp.Body, response)
+
+	return resp
+}
+
+func getGovernanceRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"governance": {
+				Routes: []config.RouteConfig{
+					{Name: "/config", Open: true},
+					{Name: "/proposals", Open: true},
+					{Name: "/proposal/:nonce", Open: true},
+					{Name: "/votes/:address", Open: true},
+					{Name: "/voting-power/:address", Open: true},
+					{Name: "/delegated-vote-info/:contract/:nonce", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -32,6 +32,9 @@ const (
 	managedKeysCount          = "/managed-keys/count"
 	eligibleManagedKeys       = "/managed-keys/eligible"
 	waitingManagedKeys        = "/managed-keys/waiting"
+	managedKeyPath            = "/managed-keys/:key"
+	slashingProtectionPath    = "/slashing-protection"
+	outportReplayPath         = "/outport/replay"
 	epochsLeftInWaiting       = "/waiting-epochs-left/:key"
 )
 
@@ -49,6 +52,13 @@ type nodeFacadeHandler interface {
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
+	AddManagedKey(privateKeyHex string) (string, error)
+	RemoveManagedKey(publicKey string) error
+	ExportSlashingProtection() (*common.SlashingProtectionSnapshot, error)
+	ImportSlashingProtection(snapshot *common.SlashingProtectionSnapshot) error
+	StartOutportReplay(startNonce uint64, endNonce uint64, target string) error
+	GetOutportReplayStatus() common.OutportReplayStatus
+	IsAdminRequestAuthorized(username string, password string) bool
 	IsInterfaceNil() bool
 }
 
@@ -58,6 +68,18 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// AddManagedKeyRequest represents the structure on which user input for adding a managed key will validate against
+type AddManagedKeyRequest struct {
+	PrivateKey string `json:"privateKey"`
+}
+
+// OutportReplayRequest represents the structure on which user input for replaying stored blocks to an outport target will validate against
+type OutportReplayRequest struct {
+	StartNonce uint64 `json:"startNonce"`
+	EndNonce   uint64 `json:"endNonce"`
+	Target     string `json:"target"`
+}
+
 type nodeGroup struct {
 	*baseGroup
 	facade    nodeFacadeHandler
@@ -75,6 +97,10 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 		baseGroup: &baseGroup{},
 	}
 
+	adminMiddlewares := createAdminMiddlewares(func() adminRequestAuthorizer {
+		return ng.getFacade()
+	})
+
 	endpoints := []*shared.EndpointHandlerData{
 		{
 			Path:    heartbeatStatusPath,
@@ -131,6 +157,42 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.managedKeys,
 		},
+		{
+			Path:                  managedKeys,
+			Method:                http.MethodPost,
+			Handler:               ng.addManagedKey,
+			AdditionalMiddlewares: adminMiddlewares,
+		},
+		{
+			Path:                  managedKeyPath,
+			Method:                http.MethodDelete,
+			Handler:               ng.removeManagedKey,
+			AdditionalMiddlewares: adminMiddlewares,
+		},
+		{
+			Path:                  slashingProtectionPath,
+			Method:                http.MethodGet,
+			Handler:               ng.exportSlashingProtection,
+			AdditionalMiddlewares: adminMiddlewares,
+		},
+		{
+			Path:                  slashingProtectionPath,
+			Method:                http.MethodPost,
+			Handler:               ng.importSlashingProtection,
+			AdditionalMiddlewares: adminMiddlewares,
+		},
+		{
+			Path:                  outportReplayPath,
+			Method:                http.MethodPost,
+			Handler:               ng.startOutportReplay,
+			AdditionalMiddlewares: adminMiddlewares,
+		},
+		{
+			Path:                  outportReplayPath,
+			Method:                http.MethodGet,
+			Handler:               ng.outportReplayStatus,
+			AdditionalMiddlewares: adminMiddlewares,
+		},
 		{
 			Path:    loadedKeys,
 			Method:  http.MethodGet,
@@ -418,6 +480,89 @@ func (ng *nodeGroup) managedKeys(c *gin.Context) {
 	)
 }
 
+// addManagedKey loads a new key to be managed by the current node, without a restart
+func (ng *nodeGroup) addManagedKey(c *gin.Context) {
+	request := AddManagedKeyRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	publicKey, err := ng.getFacade().AddManagedKey(request.PrivateKey)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrAddManagedKey, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"publicKey": publicKey})
+}
+
+// removeManagedKey releases a key managed by the current node, without a restart
+func (ng *nodeGroup) removeManagedKey(c *gin.Context) {
+	publicKey := c.Param("key")
+	err := ng.getFacade().RemoveManagedKey(publicKey)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrRemoveManagedKey, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"status": "ok"})
+}
+
+// exportSlashingProtection returns the last signed block of each validator key, in the format accepted by importSlashingProtection
+func (ng *nodeGroup) exportSlashingProtection(c *gin.Context) {
+	snapshot, err := ng.getFacade().ExportSlashingProtection()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrExportSlashingProtection, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"slashingProtection": snapshot})
+}
+
+// importSlashingProtection merges the provided signed block records, usually exported by another machine running the same keys
+func (ng *nodeGroup) importSlashingProtection(c *gin.Context) {
+	snapshot := &common.SlashingProtectionSnapshot{}
+	err := c.ShouldBindJSON(snapshot)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = ng.getFacade().ImportSlashingProtection(snapshot)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrImportSlashingProtection, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"status": "ok"})
+}
+
+// startOutportReplay starts pushing the stored blocks in the requested nonce range to the requested outport target
+func (ng *nodeGroup) startOutportReplay(c *gin.Context) {
+	request := OutportReplayRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = ng.getFacade().StartOutportReplay(request.StartNonce, request.EndNonce, request.Target)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrStartOutportReplay, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"status": "started"})
+}
+
+// outportReplayStatus returns the progress of the current (or last) outport replay
+func (ng *nodeGroup) outportReplayStatus(c *gin.Context) {
+	status := ng.getFacade().GetOutportReplayStatus()
+	shared.RespondWithSuccess(c, gin.H{"replay": status})
+}
+
 // loadedKeys returns all keys loaded by the current node
 func (ng *nodeGroup) loadedKeys(c *gin.Context) {
 	keys := ng.getFacade().GetLoadedKeys()
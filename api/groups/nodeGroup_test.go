@@ -109,6 +109,13 @@ type waitingEpochsLeftResponse struct {
 	generalResponse
 }
 
+type addManagedKeyResponse struct {
+	Data struct {
+		PublicKey string `json:"publicKey"`
+	} `json:"data"`
+	generalResponse
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -974,6 +981,381 @@ func TestNodeGroup_WaitingEpochsLeft(t *testing.T) {
 	})
 }
 
+func TestNodeGroup_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unauthorized should not call the facade", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.AddManagedKeyCalled = func(privateKeyHex string) (string, error) {
+			assert.Fail(t, "should have not been called")
+			return "", nil
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		request := groups.AddManagedKeyRequest{PrivateKey: "private key"}
+		resp := doAdminRequest(ws, http.MethodPost, "/node/managed-keys", request, false)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeGroup, _ := groups.NewNodeGroup(createAdminFacadeStub())
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodPost, "/node/managed-keys", "invalid", true)
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.AddManagedKeyCalled = func(privateKeyHex string) (string, error) {
+			return "", expectedErr
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		request := groups.AddManagedKeyRequest{PrivateKey: "private key"}
+		resp := doAdminRequest(ws, http.MethodPost, "/node/managed-keys", request, true)
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrAddManagedKey.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.AddManagedKeyCalled = func(privateKeyHex string) (string, error) {
+			assert.Equal(t, "private key", privateKeyHex)
+			return "public key", nil
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		request := groups.AddManagedKeyRequest{PrivateKey: "private key"}
+		resp := doAdminRequest(ws, http.MethodPost, "/node/managed-keys", request, true)
+		response := &addManagedKeyResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "public key", response.Data.PublicKey)
+	})
+}
+
+func TestNodeGroup_RemoveManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unauthorized should not call the facade", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.RemoveManagedKeyCalled = func(publicKey string) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodDelete, "/node/managed-keys/key", nil, false)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.RemoveManagedKeyCalled = func(publicKey string) error {
+			return expectedErr
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodDelete, "/node/managed-keys/key", nil, true)
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrRemoveManagedKey.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := createAdminFacadeStub()
+		facade.RemoveManagedKeyCalled = func(publicKey string) error {
+			wasCalled = true
+			assert.Equal(t, "key", publicKey)
+			return nil
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodDelete, "/node/managed-keys/key", nil, true)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestNodeGroup_ExportSlashingProtection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unauthorized should not call the facade", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.ExportSlashingProtectionCalled = func() (*common.SlashingProtectionSnapshot, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodGet, "/node/slashing-protection", nil, false)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.ExportSlashingProtectionCalled = func() (*common.SlashingProtectionSnapshot, error) {
+			return nil, expectedErr
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodGet, "/node/slashing-protection", nil, true)
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrExportSlashingProtection.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedSnapshot := &common.SlashingProtectionSnapshot{
+			Records: []*common.SignedBlockRecord{
+				{PublicKey: "aa", Epoch: 1, Round: 10, HeaderHash: "bb"},
+			},
+		}
+		facade := createAdminFacadeStub()
+		facade.ExportSlashingProtectionCalled = func() (*common.SlashingProtectionSnapshot, error) {
+			return providedSnapshot, nil
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodGet, "/node/slashing-protection", nil, true)
+		response := &struct {
+			Data struct {
+				SlashingProtection *common.SlashingProtectionSnapshot `json:"slashingProtection"`
+			} `json:"data"`
+		}{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedSnapshot, response.Data.SlashingProtection)
+	})
+}
+
+func TestNodeGroup_ImportSlashingProtection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unauthorized should not call the facade", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.ImportSlashingProtectionCalled = func(snapshot *common.SlashingProtectionSnapshot) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodPost, "/node/slashing-protection", &common.SlashingProtectionSnapshot{}, false)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeGroup, _ := groups.NewNodeGroup(createAdminFacadeStub())
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodPost, "/node/slashing-protection", "not a snapshot", true)
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.ImportSlashingProtectionCalled = func(snapshot *common.SlashingProtectionSnapshot) error {
+			return expectedErr
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodPost, "/node/slashing-protection", &common.SlashingProtectionSnapshot{}, true)
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrImportSlashingProtection.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedSnapshot := &common.SlashingProtectionSnapshot{
+			Records: []*common.SignedBlockRecord{
+				{PublicKey: "aa", Epoch: 1, Round: 10, HeaderHash: "bb"},
+			},
+		}
+		wasCalled := false
+		facade := createAdminFacadeStub()
+		facade.ImportSlashingProtectionCalled = func(snapshot *common.SlashingProtectionSnapshot) error {
+			wasCalled = true
+			assert.Equal(t, providedSnapshot, snapshot)
+			return nil
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodPost, "/node/slashing-protection", providedSnapshot, true)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestNodeGroup_StartOutportReplay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unauthorized should not call the facade", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.StartOutportReplayCalled = func(startNonce uint64, endNonce uint64, target string) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodPost, "/node/outport/replay", &groups.OutportReplayRequest{StartNonce: 1, EndNonce: 2}, false)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeGroup, _ := groups.NewNodeGroup(createAdminFacadeStub())
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodPost, "/node/outport/replay", "not a request", true)
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.StartOutportReplayCalled = func(startNonce uint64, endNonce uint64, target string) error {
+			return expectedErr
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodPost, "/node/outport/replay", &groups.OutportReplayRequest{StartNonce: 1, EndNonce: 2}, true)
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrStartOutportReplay.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := createAdminFacadeStub()
+		facade.StartOutportReplayCalled = func(startNonce uint64, endNonce uint64, target string) error {
+			wasCalled = true
+			assert.Equal(t, uint64(10), startNonce)
+			assert.Equal(t, uint64(20), endNonce)
+			assert.Equal(t, "eventNotifier", target)
+			return nil
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodPost, "/node/outport/replay", &groups.OutportReplayRequest{StartNonce: 10, EndNonce: 20, Target: "eventNotifier"}, true)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestNodeGroup_OutportReplayStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unauthorized should not call the facade", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createAdminFacadeStub()
+		facade.GetOutportReplayStatusCalled = func() common.OutportReplayStatus {
+			assert.Fail(t, "should have not been called")
+			return common.OutportReplayStatus{}
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodGet, "/node/outport/replay", nil, false)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedStatus := common.OutportReplayStatus{
+			InProgress:        true,
+			StartNonce:        10,
+			EndNonce:          20,
+			LastReplayedNonce: 15,
+			NumReplayedBlocks: 6,
+		}
+		facade := createAdminFacadeStub()
+		facade.GetOutportReplayStatusCalled = func() common.OutportReplayStatus {
+			return providedStatus
+		}
+		nodeGroup, _ := groups.NewNodeGroup(facade)
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := doAdminRequest(ws, http.MethodGet, "/node/outport/replay", nil, true)
+		response := &struct {
+			Data struct {
+				Replay common.OutportReplayStatus `json:"replay"`
+			} `json:"data"`
+		}{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedStatus, response.Data.Replay)
+	})
+}
+
 func TestNodeGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -1083,6 +1465,9 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/connected-peers-ratings", Open: true},
 					{Name: "/managed-keys/count", Open: true},
 					{Name: "/managed-keys", Open: true},
+					{Name: "/managed-keys/:key", Open: true},
+					{Name: "/slashing-protection", Open: true},
+					{Name: "/outport/replay", Open: true},
 					{Name: "/loaded-keys", Open: true},
 					{Name: "/managed-keys/eligible", Open: true},
 					{Name: "/managed-keys/waiting", Open: true},
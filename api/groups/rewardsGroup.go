@@ -0,0 +1,124 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	rewardsEpochPath      = "/epoch/:epoch"
+	rewardsEpochOwnerPath = "/epoch/:epoch/owner/:owner"
+
+	rewardsOwnerUrlParam = "owner"
+)
+
+// rewardsFacadeHandler defines the methods to be implemented by a facade for rewards breakdown requests
+type rewardsFacadeHandler interface {
+	GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetOwnerRewardsBreakdown(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+type rewardsGroup struct {
+	*baseGroup
+	facade    rewardsFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewRewardsGroup returns a new instance of rewardsGroup
+func NewRewardsGroup(facade rewardsFacadeHandler) (*rewardsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for rewards group", errors.ErrNilFacadeHandler)
+	}
+
+	rg := &rewardsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    rewardsEpochPath,
+			Method:  http.MethodGet,
+			Handler: rg.getRewardsBreakdown,
+		},
+		{
+			Path:    rewardsEpochOwnerPath,
+			Method:  http.MethodGet,
+			Handler: rg.getOwnerRewardsBreakdown,
+		},
+	}
+	rg.endpoints = endpoints
+
+	return rg, nil
+}
+
+// getRewardsBreakdown returns the economics data and the rewards of all the eligible nodes in an epoch
+func (rg *rewardsGroup) getRewardsBreakdown(c *gin.Context) {
+	epoch, err := getQueryParamEpoch(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, fmt.Errorf("%w: %s", errors.ErrInvalidEpoch, err.Error()))
+		return
+	}
+
+	rewardsBreakdown, err := rg.getFacade().GetRewardsBreakdown(epoch)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetRewardsBreakdown, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"rewards": rewardsBreakdown})
+}
+
+// getOwnerRewardsBreakdown returns the staking data and the rewards of the eligible nodes of an owner in an epoch
+func (rg *rewardsGroup) getOwnerRewardsBreakdown(c *gin.Context) {
+	epoch, err := getQueryParamEpoch(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, fmt.Errorf("%w: %s", errors.ErrInvalidEpoch, err.Error()))
+		return
+	}
+
+	ownerRewardsBreakdown, err := rg.getFacade().GetOwnerRewardsBreakdown(epoch, c.Param(rewardsOwnerUrlParam))
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetRewardsBreakdown, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"rewards": ownerRewardsBreakdown})
+}
+
+func (rg *rewardsGroup) getFacade() rewardsFacadeHandler {
+	rg.mutFacade.RLock()
+	defer rg.mutFacade.RUnlock()
+
+	return rg.facade
+}
+
+// UpdateFacade will update the facade
+func (rg *rewardsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(rewardsFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	rg.mutFacade.Lock()
+	rg.facade = castFacade
+	rg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rg *rewardsGroup) IsInterfaceNil() bool {
+	return rg == nil
+}
@@ -0,0 +1,237 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+type rewardsBreakdownResponse struct {
+	Data struct {
+		Rewards *common.RewardsBreakdownAPIResponse `json:"rewards"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type ownerRewardsBreakdownResponse struct {
+	Data struct {
+		Rewards *common.OwnerRewardsBreakdownAPIResponse `json:"rewards"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewRewardsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		rg, err := groups.NewRewardsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, rg)
+	})
+	t.Run("should work", func(t *testing.T) {
+		rg, err := groups.NewRewardsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, rg)
+	})
+}
+
+func TestRewardsGroup_getRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &rewardsBreakdownResponse{}
+		resp := sendRewardsRequest(t, &mock.FacadeStub{}, "/rewards/epoch/invalid", response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrInvalidEpoch.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetRewardsBreakdownCalled: func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response := &rewardsBreakdownResponse{}
+		resp := sendRewardsRequest(t, facade, "/rewards/epoch/4", response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetRewardsBreakdown.Error())
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedRewardsBreakdown := &common.RewardsBreakdownAPIResponse{
+			Epoch:        4,
+			TotalSupply:  "1000000",
+			BaseRewards:  "600",
+			TopUpRewards: "200",
+			Owners: []*common.OwnerRewardsBreakdownAPIResponse{
+				{
+					Owner:        "erd1owner",
+					TotalRewards: "800",
+					Nodes: []*common.NodeRewardsBreakdownAPIResponse{
+						{
+							BlsKey:      "bls1",
+							BaseReward:  "600",
+							TopUpReward: "200",
+							TotalReward: "800",
+						},
+					},
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetRewardsBreakdownCalled: func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+				require.Equal(t, uint32(4), epoch)
+				return providedRewardsBreakdown, nil
+			},
+		}
+
+		response := &rewardsBreakdownResponse{}
+		resp := sendRewardsRequest(t, facade, "/rewards/epoch/4", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, providedRewardsBreakdown, response.Data.Rewards)
+	})
+}
+
+func TestRewardsGroup_getOwnerRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &ownerRewardsBreakdownResponse{}
+		resp := sendRewardsRequest(t, &mock.FacadeStub{}, "/rewards/epoch/-1/owner/erd1owner", response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrInvalidEpoch.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetOwnerRewardsBreakdownCalled: func(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response := &ownerRewardsBreakdownResponse{}
+		resp := sendRewardsRequest(t, facade, "/rewards/epoch/4/owner/erd1owner", response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetRewardsBreakdown.Error())
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedOwnerRewardsBreakdown := &common.OwnerRewardsBreakdownAPIResponse{
+			Owner:          "erd1owner",
+			NumStakedNodes: 2,
+			TotalStaked:    "5000",
+			TotalTopUp:     "0",
+			TotalRewards:   "100",
+		}
+		facade := &mock.FacadeStub{
+			GetOwnerRewardsBreakdownCalled: func(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error) {
+				require.Equal(t, uint32(4), epoch)
+				require.Equal(t, "erd1owner", owner)
+				return providedOwnerRewardsBreakdown, nil
+			},
+		}
+
+		response := &ownerRewardsBreakdownResponse{}
+		resp := sendRewardsRequest(t, facade, "/rewards/epoch/4/owner/erd1owner", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, providedOwnerRewardsBreakdown, response.Data.Rewards)
+	})
+}
+
+func TestRewardsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		rg, _ := groups.NewRewardsGroup(&mock.FacadeStub{})
+		err := rg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		rg, _ := groups.NewRewardsGroup(&mock.FacadeStub{})
+		err := rg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rg, _ := groups.NewRewardsGroup(&mock.FacadeStub{})
+		err := rg.UpdateFacade(&mock.FacadeStub{
+			GetRewardsBreakdownCalled: func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+				return nil, expectedErr
+			},
+		})
+		require.NoError(t, err)
+
+		ws := startWebServer(rg, "rewards", getRewardsRoutesConfig())
+		req, _ := http.NewRequest("GET", "/rewards/epoch/4", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &rewardsBreakdownResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+}
+
+func TestRewardsGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	rg, _ := groups.NewRewardsGroup(nil)
+	require.True(t, rg.IsInterfaceNil())
+
+	rg, _ = groups.NewRewardsGroup(&mock.FacadeStub{})
+	require.False(t, rg.IsInterfaceNil())
+}
+
+func sendRewardsRequest(t *testing.T, facade shared.FacadeHandler, path string, response interface{}) *httptest.ResponseRecorder {
+	rg, err := groups.NewRewardsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(rg, "rewards", getRewardsRoutesConfig())
+	req, _ := http.NewRequest("GET", path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	loadResponse(resp.Body, response)
+
+	return resp
+}
+
+func getRewardsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"rewards": {
+				Routes: []config.RouteConfig{
+					{Name: "/epoch/:epoch", Open: true},
+					{Name: "/epoch/:epoch/owner/:owner", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,104 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	getOwnerStakingInfoEndpoint = "/staking/:owner"
+	stakingOwnerPath            = "/:owner"
+
+	stakingOwnerUrlParam = "owner"
+)
+
+// stakingFacadeHandler defines the methods to be implemented by a facade for staking info requests
+type stakingFacadeHandler interface {
+	GetOwnerStakingInfo(owner string) (*common.OwnerStakingInfoAPIResponse, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+type stakingGroup struct {
+	*baseGroup
+	facade    stakingFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewStakingGroup returns a new instance of stakingGroup
+func NewStakingGroup(facade stakingFacadeHandler) (*stakingGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for staking group", errors.ErrNilFacadeHandler)
+	}
+
+	sg := &stakingGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    stakingOwnerPath,
+			Method:  http.MethodGet,
+			Handler: sg.getOwnerStakingInfo,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getOwnerStakingInfoEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// getOwnerStakingInfo returns the staked and unstaked values, the nodes status and the delegation positions of an owner
+func (sg *stakingGroup) getOwnerStakingInfo(c *gin.Context) {
+	stakingInfo, err := sg.getFacade().GetOwnerStakingInfo(c.Param(stakingOwnerUrlParam))
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetOwnerStakingInfo, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"staking": stakingInfo})
+}
+
+func (sg *stakingGroup) getFacade() stakingFacadeHandler {
+	sg.mutFacade.RLock()
+	defer sg.mutFacade.RUnlock()
+
+	return sg.facade
+}
+
+// UpdateFacade will update the facade
+func (sg *stakingGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(stakingFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	sg.mutFacade.Lock()
+	sg.facade = castFacade
+	sg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *stakingGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
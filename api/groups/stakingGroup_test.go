@@ -0,0 +1,198 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+type ownerStakingInfoResponse struct {
+	Data struct {
+		Staking *common.OwnerStakingInfoAPIResponse `json:"staking"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewStakingGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		sg, err := groups.NewStakingGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, sg)
+	})
+	t.Run("should work", func(t *testing.T) {
+		sg, err := groups.NewStakingGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, sg)
+	})
+}
+
+func TestStakingGroup_getOwnerStakingInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetOwnerStakingInfoCalled: func(owner string) (*common.OwnerStakingInfoAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response := &ownerStakingInfoResponse{}
+		resp := sendStakingRequest(t, facade, "/staking/erd1owner", response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrGetOwnerStakingInfo.Error())
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("too many requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				require.Equal(t, "/staking/:owner", endpoint)
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool { return false },
+				}, true
+			},
+			GetOwnerStakingInfoCalled: func(owner string) (*common.OwnerStakingInfoAPIResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		response := &ownerStakingInfoResponse{}
+		resp := sendStakingRequest(t, facade, "/staking/erd1owner", response)
+		require.Equal(t, http.StatusTooManyRequests, resp.Code)
+		require.Contains(t, response.Error, apiErrors.ErrTooManyRequests.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedStakingInfo := &common.OwnerStakingInfoAPIResponse{
+			Owner:             "erd1owner",
+			CurrentEpoch:      10,
+			TotalStaked:       "5000",
+			TopUp:             "0",
+			NumActiveNodes:    2,
+			TotalUnStaked:     "2500",
+			TotalWithdrawable: "0",
+			Nodes: []*common.StakedNodeAPIResponse{
+				{BlsKey: "bls1", Status: string(common.EligibleList)},
+				{BlsKey: "bls2", Status: string(common.AuctionList)},
+			},
+			UnStakedList: []*common.UnBondingFundAPIResponse{
+				{Value: "2500", RemainingEpochs: 3, WithdrawEpoch: 13},
+			},
+			TotalDelegated: "100",
+			Delegations: []*common.DelegationPositionAPIResponse{
+				{
+					Contract:         "erd1contract",
+					ActiveStake:      "100",
+					ClaimableRewards: "1",
+					UnStaked:         "0",
+					UnBondable:       "0",
+					UnDelegatedList:  []*common.UnBondingFundAPIResponse{},
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetOwnerStakingInfoCalled: func(owner string) (*common.OwnerStakingInfoAPIResponse, error) {
+				require.Equal(t, "erd1owner", owner)
+				return providedStakingInfo, nil
+			},
+		}
+
+		response := &ownerStakingInfoResponse{}
+		resp := sendStakingRequest(t, facade, "/staking/erd1owner", response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, providedStakingInfo, response.Data.Staking)
+	})
+}
+
+func TestStakingGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewStakingGroup(&mock.FacadeStub{})
+		err := sg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewStakingGroup(&mock.FacadeStub{})
+		err := sg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewStakingGroup(&mock.FacadeStub{})
+		err := sg.UpdateFacade(&mock.FacadeStub{
+			GetOwnerStakingInfoCalled: func(owner string) (*common.OwnerStakingInfoAPIResponse, error) {
+				return nil, expectedErr
+			},
+		})
+		require.NoError(t, err)
+
+		ws := startWebServer(sg, "staking", getStakingRoutesConfig())
+		req, _ := http.NewRequest("GET", "/staking/erd1owner", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &ownerStakingInfoResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+}
+
+func TestStakingGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	sg, _ := groups.NewStakingGroup(nil)
+	require.True(t, sg.IsInterfaceNil())
+
+	sg, _ = groups.NewStakingGroup(&mock.FacadeStub{})
+	require.False(t, sg.IsInterfaceNil())
+}
+
+func sendStakingRequest(t *testing.T, facade shared.FacadeHandler, path string, response interface{}) *httptest.ResponseRecorder {
+	sg, err := groups.NewStakingGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(sg, "staking", getStakingRoutesConfig())
+	req, _ := http.NewRequest("GET", path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	loadResponse(resp.Body, response)
+
+	return resp
+}
+
+func getStakingRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"staking": {
+				Routes: []config.RouteConfig{
+					{Name: "/:owner", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -49,6 +49,7 @@ type transactionFacadeHandler interface {
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	DecodeTransaction(tx *transaction.ApiTransactionResult) (*common.ABIDecodedTransactionAPIResponse, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
@@ -458,6 +459,19 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
+	decode, err := parseBoolUrlParam(c, urlParamDecode)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	start := time.Now()
 	tx, err := tg.getFacade().GetTransaction(txhash, withResults)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransaction")
@@ -473,10 +487,35 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
+	if !decode {
+		c.JSON(
+			http.StatusOK,
+			shared.GenericAPIResponse{
+				Data:  gin.H{"transaction": tx},
+				Error: "",
+				Code:  shared.ReturnCodeSuccess,
+			},
+		)
+		return
+	}
+
+	decoded, err := tg.getFacade().DecodeTransaction(tx)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrDecodeWithABI.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"transaction": tx},
+			Data:  gin.H{"transaction": tx, "decoded": decoded},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
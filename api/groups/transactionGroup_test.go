@@ -52,6 +52,15 @@ type transactionResponse struct {
 	Code  string                  `json:"code"`
 }
 
+type decodedTransactionResponse struct {
+	Data struct {
+		TxResp  *groups.TxResponse                       `json:"transaction"`
+		Decoded *common.ABIDecodedTransactionAPIResponse `json:"decoded"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 type sendMultipleTxsResponseData struct {
 	TxsSent   int      `json:"txsSent"`
 	TxsHashes []string `json:"txsHashes"`
@@ -234,6 +243,75 @@ func TestTransactionsGroup_getTransaction(t *testing.T) {
 		assert.Equal(t, txData, txResp.Data)
 		assert.Equal(t, guardian, txResp.GuardianAddr)
 	})
+	t.Run("decode error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(hash string, withEvents bool) (*dataTx.ApiTransactionResult, error) {
+				return &dataTx.ApiTransactionResult{}, nil
+			},
+			DecodeTransactionCalled: func(tx *dataTx.ApiTransactionResult) (*common.ABIDecodedTransactionAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"?decode=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txResp := transactionResponse{}
+		loadResponse(resp.Body, &txResp)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, txResp.Error, apiErrors.ErrDecodeWithABI.Error())
+		assert.Empty(t, txResp.Data)
+	})
+	t.Run("should work with decode", func(t *testing.T) {
+		t.Parallel()
+
+		providedDecoded := &common.ABIDecodedTransactionAPIResponse{
+			Contract: receiver,
+			Function: "add",
+			Arguments: []*common.ABIDecodedValue{
+				{
+					Name:  "value",
+					Type:  "BigUint",
+					Value: "10",
+				},
+			},
+			Events: make([]*common.ABIDecodedEvent, 0),
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(hash string, withEvents bool) (*dataTx.ApiTransactionResult, error) {
+				return &dataTx.ApiTransactionResult{
+					Sender:   sender,
+					Receiver: receiver,
+				}, nil
+			},
+			DecodeTransactionCalled: func(tx *dataTx.ApiTransactionResult) (*common.ABIDecodedTransactionAPIResponse, error) {
+				require.Equal(t, receiver, tx.Receiver)
+				return providedDecoded, nil
+			},
+		}
+
+		response := &decodedTransactionResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/"+hash+"?decode=true",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, sender, response.Data.TxResp.Sender)
+		assert.Equal(t, providedDecoded.Function, response.Data.Decoded.Function)
+		assert.Equal(t, providedDecoded.Arguments, response.Data.Decoded.Arguments)
+	})
 }
 
 func TestTransactionGroup_sendTransaction(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-go/api/errors"
@@ -14,14 +15,20 @@ import (
 )
 
 const (
-	statisticsPath = "/statistics"
-	auctionPath    = "/auction"
+	statisticsPath        = "/statistics"
+	auctionPath           = "/auction"
+	auctionSimulationPath = "/auction/simulate"
+	historyPath           = "/:blskey/history"
+	urlParamFromEpoch     = "fromEpoch"
+	urlParamToEpoch       = "toEpoch"
 )
 
 // validatorFacadeHandler defines the methods to be implemented by a facade for validator requests
 type validatorFacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
+	AuctionSimulationApi(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error)
+	ValidatorHistoryApi(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -53,6 +60,16 @@ func NewValidatorGroup(facade validatorFacadeHandler) (*validatorGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.auction,
 		},
+		{
+			Path:    auctionSimulationPath,
+			Method:  http.MethodPost,
+			Handler: ng.auctionSimulation,
+		},
+		{
+			Path:    historyPath,
+			Method:  http.MethodGet,
+			Handler: ng.history,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -109,6 +126,110 @@ func (vg *validatorGroup) auction(c *gin.Context) {
 	)
 }
 
+// auctionSimulation will return the auction list resulted after applying the hypothetical changes from the request
+func (vg *validatorGroup) auctionSimulation(c *gin.Context) {
+	request := &common.AuctionSimulationAPIRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	simulation, err := vg.getFacade().AuctionSimulationApi(request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"simulation": simulation},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// history will return the statistics of a validator, as recorded at the end of each epoch from the requested range
+func (vg *validatorGroup) history(c *gin.Context) {
+	blsKey := c.Param("blskey")
+	if blsKey == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyBlsKey.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	fromEpoch, toEpoch, err := parseEpochsRangeUrlParams(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	history, err := vg.getFacade().ValidatorHistoryApi(blsKey, fromEpoch, toEpoch)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"history": history},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func parseEpochsRangeUrlParams(c *gin.Context) (core.OptionalUint32, core.OptionalUint32, error) {
+	fromEpoch, err := parseUint32UrlParam(c, urlParamFromEpoch)
+	if err != nil {
+		return core.OptionalUint32{}, core.OptionalUint32{}, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+
+	toEpoch, err := parseUint32UrlParam(c, urlParamToEpoch)
+	if err != nil {
+		return core.OptionalUint32{}, core.OptionalUint32{}, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+
+	return fromEpoch, toEpoch, nil
+}
+
 func (vg *validatorGroup) getFacade() validatorFacadeHandler {
 	vg.mutFacade.RLock()
 	defer vg.mutFacade.RUnlock()
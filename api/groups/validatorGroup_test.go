@@ -1,12 +1,14 @@
 package groups_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
@@ -47,6 +49,20 @@ type auctionListResponse struct {
 	Error string
 }
 
+type auctionSimulationResponse struct {
+	Data struct {
+		Result *common.AuctionSimulationAPIResponse `json:"simulation"`
+	} `json:"data"`
+	Error string
+}
+
+type validatorHistoryResponse struct {
+	Data struct {
+		Result []*common.ValidatorEpochStatisticsAPIResponse `json:"history"`
+	} `json:"data"`
+	Error string
+}
+
 func TestValidatorStatistics_ErrorWhenFacadeFails(t *testing.T) {
 	t.Parallel()
 
@@ -256,6 +272,219 @@ func TestAuctionList_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, response.Data.Result, auctionListToReturn)
 }
 
+func TestAuctionSimulation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			AuctionSimulationHandler: func(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		validatorGroup, err := groups.NewValidatorGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("POST", "/validator/auction/simulate", bytes.NewBuffer([]byte("invalid")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := auctionSimulationResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		errStr := "error in facade"
+		facade := mock.FacadeStub{
+			AuctionSimulationHandler: func(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error) {
+				return nil, errors.New(errStr)
+			},
+		}
+
+		validatorGroup, err := groups.NewValidatorGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("POST", "/validator/auction/simulate", bytes.NewBuffer([]byte("{}")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := auctionSimulationResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, errStr)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedRequest := &common.AuctionSimulationAPIRequest{
+			Changes: []*common.AuctionSimulationOwnerChange{
+				{
+					Owner:         "owner",
+					TopUpDelta:    "-1000",
+					UnStakedNodes: []string{"blsKey1"},
+					NumNewNodes:   2,
+				},
+			},
+		}
+		simulationToReturn := &common.AuctionSimulationAPIResponse{
+			CurrentThreshold:   "1000",
+			SimulatedThreshold: "900",
+			AuctionList: []*common.AuctionListValidatorAPIResponse{
+				{
+					Owner:          "owner",
+					NumStakedNodes: 3,
+					TotalTopUp:     "2700",
+					TopUpPerNode:   "900",
+					QualifiedTopUp: "900",
+					Nodes:          []*common.AuctionNode{{BlsKey: "blsKey2", Qualified: true}},
+				},
+			},
+			ChangedNodes: []*common.AuctionSimulationNodeChange{
+				{BlsKey: "blsKey1", Owner: "owner", Selected: false},
+			},
+		}
+		facade := mock.FacadeStub{
+			AuctionSimulationHandler: func(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error) {
+				require.Equal(t, providedRequest, request)
+				return simulationToReturn, nil
+			},
+		}
+
+		validatorGroup, err := groups.NewValidatorGroup(&facade)
+		require.NoError(t, err)
+
+		requestBytes, _ := json.Marshal(providedRequest)
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("POST", "/validator/auction/simulate", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := auctionSimulationResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, simulationToReturn, response.Data.Result)
+	})
+}
+
+func TestValidatorHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid fromEpoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			ValidatorHistoryHandler: func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		response, code := sendValidatorHistoryRequest(t, &facade, "/validator/blsKey/history?fromEpoch=invalid")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+		assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+	t.Run("invalid toEpoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, code := sendValidatorHistoryRequest(t, &mock.FacadeStub{}, "/validator/blsKey/history?toEpoch=-1")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		errStr := "error in facade"
+		facade := mock.FacadeStub{
+			ValidatorHistoryHandler: func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error) {
+				return nil, errors.New(errStr)
+			},
+		}
+
+		response, code := sendValidatorHistoryRequest(t, &facade, "/validator/blsKey/history")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, errStr)
+	})
+	t.Run("should work without epochs range", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			ValidatorHistoryHandler: func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error) {
+				require.Equal(t, "blsKey", blsKey)
+				require.False(t, fromEpoch.HasValue)
+				require.False(t, toEpoch.HasValue)
+				return make([]*common.ValidatorEpochStatisticsAPIResponse, 0), nil
+			},
+		}
+
+		response, code := sendValidatorHistoryRequest(t, &facade, "/validator/blsKey/history")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Empty(t, response.Data.Result)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		historyToReturn := []*common.ValidatorEpochStatisticsAPIResponse{
+			{
+				Epoch:               3,
+				ShardId:             1,
+				ValidatorStatus:     "eligible",
+				Rating:              51.5,
+				TempRating:          52,
+				NumLeaderSuccess:    10,
+				NumLeaderFailure:    1,
+				NumValidatorSuccess: 100,
+			},
+			{
+				Epoch:                         5,
+				ShardId:                       1,
+				ValidatorStatus:               "waiting",
+				Rating:                        50,
+				TempRating:                    50,
+				NumValidatorIgnoredSignatures: 2,
+			},
+		}
+		facade := mock.FacadeStub{
+			ValidatorHistoryHandler: func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error) {
+				require.Equal(t, "blsKey", blsKey)
+				require.Equal(t, core.OptionalUint32{Value: 3, HasValue: true}, fromEpoch)
+				require.Equal(t, core.OptionalUint32{Value: 6, HasValue: true}, toEpoch)
+				return historyToReturn, nil
+			},
+		}
+
+		response, code := sendValidatorHistoryRequest(t, &facade, "/validator/blsKey/history?fromEpoch=3&toEpoch=6")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, historyToReturn, response.Data.Result)
+	})
+}
+
+func sendValidatorHistoryRequest(t *testing.T, facade shared.FacadeHandler, path string) (*validatorHistoryResponse, int) {
+	validatorGroup, err := groups.NewValidatorGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+	req, _ := http.NewRequest("GET", path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &validatorHistoryResponse{}
+	loadResponse(resp.Body, response)
+
+	return response, resp.Code
+}
+
 func getValidatorRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -263,6 +492,8 @@ func getValidatorRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/statistics", Open: true},
 					{Name: "/auction", Open: true},
+					{Name: "/auction/simulate", Open: true},
+					{Name: "/:blskey/history", Open: true},
 				},
 			},
 		},
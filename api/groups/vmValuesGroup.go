@@ -13,22 +13,31 @@ import (
 	apiData "github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
 const (
-	hexPath    = "/hex"
-	stringPath = "/string"
-	intPath    = "/int"
-	queryPath  = "/query"
+	queryMultipleEndpoint = "/vm-values/query-multiple"
+	hexPath               = "/hex"
+	stringPath            = "/string"
+	intPath               = "/int"
+	queryPath             = "/query"
+	queryMultiplePath     = "/query-multiple"
+
+	urlParamDecode = "decode"
 )
 
 // vmValuesFacadeHandler defines the methods to be implemented by a facade for vm-values requests
 type vmValuesFacadeHandler interface {
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, apiData.BlockInfo, error)
+	ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultAPIResponse, apiData.BlockInfo, error)
+	DecodeSCQueryReturnData(scAddress string, funcName string, returnData [][]byte) ([]*common.ABIDecodedValue, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
 
@@ -70,6 +79,17 @@ func NewVmValuesGroup(facade vmValuesFacadeHandler) (*vmValuesGroup, error) {
 			Method:  http.MethodPost,
 			Handler: vvg.executeQuery,
 		},
+		{
+			Path:    queryMultiplePath,
+			Method:  http.MethodPost,
+			Handler: vvg.executeQueries,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(queryMultipleEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	vvg.endpoints = endpoints
 
@@ -118,15 +138,46 @@ func (vvg *vmValuesGroup) doGetVMValue(context *gin.Context, asType vm.ReturnDat
 	vvg.returnOkResponse(context, returnData, execErrMsg, blockInfo)
 }
 
-// executeQuery returns the data as string
+// executeQuery returns the data as string. If requested, the return data is also decoded using the ABI registered
+// for the queried contract
 func (vvg *vmValuesGroup) executeQuery(context *gin.Context) {
-	vmOutput, execErrMsg, blockInfo, err := vvg.doExecuteQuery(context)
+	decode, err := parseBoolUrlParam(context, urlParamDecode)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQuery", fmt.Errorf("%w for decode", err))
+		return
+	}
+
+	request := VMValueRequest{}
+	err = context.ShouldBindJSON(&request)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQuery", errors.ErrInvalidJSONRequest)
+		return
+	}
+
+	vmOutput, execErrMsg, blockInfo, err := vvg.executeQueryRequest(context, &request)
 	if err != nil {
 		vvg.returnBadRequest(context, "executeQuery", err)
 		return
 	}
+	if !decode || len(execErrMsg) > 0 {
+		vvg.returnOkResponse(context, vmOutput, execErrMsg, blockInfo)
+		return
+	}
 
-	vvg.returnOkResponse(context, vmOutput, execErrMsg, blockInfo)
+	decoded, err := vvg.getFacade().DecodeSCQueryReturnData(request.ScAddress, request.FuncName, vmOutput.ReturnData)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQuery", fmt.Errorf("%w: %s", errors.ErrDecodeWithABI, err.Error()))
+		return
+	}
+
+	context.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"data": vmOutput, "decoded": decoded, "blockInfo": blockInfo},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
 }
 
 func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, string, apiData.BlockInfo, error) {
@@ -136,7 +187,11 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 		return nil, "", apiData.BlockInfo{}, errors.ErrInvalidJSONRequest
 	}
 
-	command, err := vvg.createSCQuery(&request)
+	return vvg.executeQueryRequest(context, &request)
+}
+
+func (vvg *vmValuesGroup) executeQueryRequest(context *gin.Context, request *VMValueRequest) (*vm.VMOutputApi, string, apiData.BlockInfo, error) {
+	command, err := vvg.createSCQuery(request)
 	if err != nil {
 		return nil, "", apiData.BlockInfo{}, err
 	}
@@ -151,12 +206,77 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 		return nil, "", apiData.BlockInfo{}, err
 	}
 
-	vmExecErrMsg := ""
+	return vmOutputApi, getVMExecutionErrorMessage(vmOutputApi), blockInfo, nil
+}
+
+// executeQueries executes all the provided queries on the same block and returns the result of each of them
+func (vvg *vmValuesGroup) executeQueries(context *gin.Context) {
+	decode, err := parseBoolUrlParam(context, urlParamDecode)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQueries", fmt.Errorf("%w for decode", err))
+		return
+	}
+
+	var requests []VMValueRequest
+	err = context.ShouldBindJSON(&requests)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQueries", errors.ErrInvalidJSONRequest)
+		return
+	}
+
+	blockNonce, blockHash, err := extractBlockCoordinates(context)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQueries", err)
+		return
+	}
+
+	queries := make([]*process.SCQuery, 0, len(requests))
+	for idx := range requests {
+		query, errCreate := vvg.createSCQuery(&requests[idx])
+		if errCreate != nil {
+			vvg.returnBadRequest(context, "executeQueries", fmt.Errorf("query %d: %w", idx, errCreate))
+			return
+		}
+
+		query.BlockNonce = blockNonce
+		query.BlockHash = blockHash
+		queries = append(queries, query)
+	}
+
+	results, blockInfo, err := vvg.getFacade().ExecuteSCQueries(queries)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQueries", err)
+		return
+	}
+
+	for idx, result := range results {
+		if len(result.Error) == 0 && result.Data != nil {
+			result.Error = getVMExecutionErrorMessage(result.Data)
+		}
+		if decode && idx < len(requests) && len(result.Error) == 0 && result.Data != nil {
+			vvg.decodeQueryResult(&requests[idx], result)
+		}
+	}
+
+	vvg.returnOkResponse(context, results, "", blockInfo)
+}
+
+func (vvg *vmValuesGroup) decodeQueryResult(request *VMValueRequest, result *common.SCQueryResultAPIResponse) {
+	decoded, err := vvg.getFacade().DecodeSCQueryReturnData(request.ScAddress, request.FuncName, result.Data.ReturnData)
+	if err != nil {
+		result.Error = fmt.Sprintf("%s: %s", errors.ErrDecodeWithABI.Error(), err.Error())
+		return
+	}
+
+	result.Decoded = decoded
+}
+
+func getVMExecutionErrorMessage(vmOutputApi *vm.VMOutputApi) string {
 	if len(vmOutputApi.ReturnCode) > 0 && vmOutputApi.ReturnCode != vmcommon.Ok.String() {
-		vmExecErrMsg = vmOutputApi.ReturnCode + ":" + vmOutputApi.ReturnMessage
+		return vmOutputApi.ReturnCode + ":" + vmOutputApi.ReturnMessage
 	}
 
-	return vmOutputApi, vmExecErrMsg, blockInfo, nil
+	return ""
 }
 
 func extractBlockCoordinates(context *gin.Context) (core.OptionalUint64, []byte, error) {
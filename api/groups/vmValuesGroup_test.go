@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -52,6 +53,19 @@ type vmOutputResponse struct {
 	Error     string             `json:"error"`
 }
 
+type decodedVMOutputResponse struct {
+	Data      *vmcommon.VMOutput        `json:"data"`
+	Decoded   []*common.ABIDecodedValue `json:"decoded"`
+	BlockInfo api.BlockInfo             `json:"blockInfo"`
+	Error     string                    `json:"error"`
+}
+
+type queryResultsResponse struct {
+	Data      []*common.SCQueryResultAPIResponse `json:"data"`
+	BlockInfo api.BlockInfo                      `json:"blockInfo"`
+	Error     string                             `json:"error"`
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -211,6 +225,229 @@ func TestQuery(t *testing.T) {
 	})
 }
 
+func TestQueryMultiple(t *testing.T) {
+	t.Parallel()
+
+	requests := []groups.VMValueRequest{
+		{
+			ScAddress: dummyScAddress,
+			FuncName:  "function1",
+			Args:      []string{"01"},
+		},
+		{
+			ScAddress: dummyScAddress,
+			FuncName:  "function2",
+		},
+		{
+			ScAddress: dummyScAddress,
+			FuncName:  "function3",
+		},
+	}
+
+	t.Run("invalid json should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/query-multiple", []byte("dummy"), &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrInvalidJSONRequest.Error())
+	})
+	t.Run("invalid block nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/query-multiple?blockNonce=invalid_nonce", requests, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, "block nonce")
+	})
+	t.Run("invalid query should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidRequests := []groups.VMValueRequest{
+			requests[0],
+			{
+				ScAddress: dummyScAddress,
+				FuncName:  "function",
+				Args:      []string{"not a hex argument"},
+			},
+		}
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/query-multiple", invalidRequests, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, "query 1")
+		require.Contains(t, response.Error, "not a valid hex string")
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*common.SCQueryResultAPIResponse, api.BlockInfo, error) {
+				return nil, api.BlockInfo{}, expectedErr
+			},
+		}
+		response := simpleResponse{}
+		statusCode := doPost(t, facade, "/vm-values/query-multiple", requests, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("too many requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(_ string) (core.Throttler, bool) {
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool { return false },
+				}, true
+			},
+		}
+		response := simpleResponse{}
+		statusCode := doPost(t, facade, "/vm-values/query-multiple", requests, &response)
+		require.Equal(t, http.StatusTooManyRequests, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrTooManyRequests.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedBlockNonce := core.OptionalUint64{
+			Value:    123,
+			HasValue: true,
+		}
+		providedBlockInfo := api.BlockInfo{
+			Nonce:    123,
+			Hash:     "provided hash",
+			RootHash: "provided root hash",
+		}
+		facade := &mock.FacadeStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*common.SCQueryResultAPIResponse, api.BlockInfo, error) {
+				require.Equal(t, 3, len(queries))
+				for _, query := range queries {
+					require.Equal(t, providedBlockNonce, query.BlockNonce)
+				}
+				require.Equal(t, "function1", queries[0].FuncName)
+				require.Equal(t, [][]byte{{1}}, queries[0].Arguments)
+				require.Equal(t, "function3", queries[2].FuncName)
+
+				return []*common.SCQueryResultAPIResponse{
+					{
+						Data: &vm.VMOutputApi{
+							ReturnData: [][]byte{big.NewInt(42).Bytes()},
+							ReturnCode: vmcommon.Ok.String(),
+						},
+					},
+					{
+						Data: &vm.VMOutputApi{
+							ReturnCode:    vmcommon.UserError.String(),
+							ReturnMessage: "user error",
+						},
+					},
+					{
+						Error: expectedErr.Error(),
+					},
+				}, providedBlockInfo, nil
+			},
+		}
+
+		response := queryResultsResponse{}
+		url := fmt.Sprintf("/vm-values/query-multiple?blockNonce=%d", providedBlockNonce.Value)
+		statusCode := doPost(t, facade, url, requests, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "", response.Error)
+		require.Equal(t, providedBlockInfo, response.BlockInfo)
+		require.Equal(t, 3, len(response.Data))
+		require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data[0].Data.ReturnData[0]).Int64())
+		require.Empty(t, response.Data[0].Error)
+		require.Equal(t, vmcommon.UserError.String()+":user error", response.Data[1].Error)
+		require.Equal(t, expectedErr.Error(), response.Data[2].Error)
+	})
+}
+
+func TestQueryWithDecode(t *testing.T) {
+	t.Parallel()
+
+	request := groups.VMValueRequest{
+		ScAddress: dummyScAddress,
+		FuncName:  "getSum",
+	}
+	providedDecoded := []*common.ABIDecodedValue{
+		{
+			Type:  "BigUint",
+			Value: "42",
+		},
+	}
+	createFacade := func(decodeErr error) *mock.FacadeStub {
+		return &mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+				return &vm.VMOutputApi{
+					ReturnData: [][]byte{big.NewInt(42).Bytes()},
+				}, api.BlockInfo{}, nil
+			},
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*common.SCQueryResultAPIResponse, api.BlockInfo, error) {
+				return []*common.SCQueryResultAPIResponse{
+					{
+						Data: &vm.VMOutputApi{
+							ReturnData: [][]byte{big.NewInt(42).Bytes()},
+						},
+					},
+				}, api.BlockInfo{}, nil
+			},
+			DecodeSCQueryReturnDataCalled: func(scAddress string, funcName string, returnData [][]byte) ([]*common.ABIDecodedValue, error) {
+				require.Equal(t, dummyScAddress, scAddress)
+				require.Equal(t, "getSum", funcName)
+				require.Equal(t, [][]byte{big.NewInt(42).Bytes()}, returnData)
+				return providedDecoded, decodeErr
+			},
+		}
+	}
+
+	t.Run("invalid decode flag should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := simpleResponse{}
+		statusCode := doPost(t, createFacade(nil), "/vm-values/query?decode=not-a-bool", request, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, "decode")
+	})
+	t.Run("decode error should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := simpleResponse{}
+		statusCode := doPost(t, createFacade(expectedErr), "/vm-values/query?decode=true", request, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrDecodeWithABI.Error())
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		response := decodedVMOutputResponse{}
+		statusCode := doPost(t, createFacade(nil), "/vm-values/query?decode=true", request, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "", response.Error)
+		require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
+		require.Equal(t, providedDecoded, response.Decoded)
+	})
+	t.Run("multiple queries decode error should be set on the result", func(t *testing.T) {
+		t.Parallel()
+
+		response := queryResultsResponse{}
+		statusCode := doPost(t, createFacade(expectedErr), "/vm-values/query-multiple?decode=true", []groups.VMValueRequest{request}, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, 1, len(response.Data))
+		require.Contains(t, response.Data[0].Error, apiErrors.ErrDecodeWithABI.Error())
+		require.Nil(t, response.Data[0].Decoded)
+	})
+	t.Run("multiple queries should work", func(t *testing.T) {
+		t.Parallel()
+
+		response := queryResultsResponse{}
+		statusCode := doPost(t, createFacade(nil), "/vm-values/query-multiple?decode=true", []groups.VMValueRequest{request}, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, 1, len(response.Data))
+		require.Empty(t, response.Data[0].Error)
+		require.Equal(t, providedDecoded, response.Data[0].Decoded)
+	})
+}
+
 func testQueryShouldWork(t *testing.T, url string, facade shared.FacadeHandler) {
 	request := groups.VMValueRequest{
 		ScAddress: dummyScAddress,
@@ -581,6 +818,7 @@ func getVmValuesRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/string", Open: true},
 					{Name: "/int", Open: true},
 					{Name: "/query", Open: true},
+					{Name: "/query-multiple", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+type adminRequestAuthorizer interface {
+	IsAdminRequestAuthorized(username string, password string) bool
+}
+
+// CreateAdminAuthorizerFromFacade will create a middleware-type of handler to be used in conjunction with the
+// REST API end points that can alter the node's state. The request credentials are read from the basic auth header
+func CreateAdminAuthorizerFromFacade(facade interface{}) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authorizer, ok := facade.(adminRequestAuthorizer)
+		if !ok {
+			c.AbortWithStatusJSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: errors.ErrInvalidAppContext.Error(),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		username, password, ok := c.Request.BasicAuth()
+		if !ok || !authorizer.IsAdminRequestAuthorized(username, password) {
+			c.Header("WWW-Authenticate", `Basic realm="admin"`)
+			c.AbortWithStatusJSON(
+				http.StatusUnauthorized,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: errors.ErrUnauthorized.Error(),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		c.Next()
+	}
+}
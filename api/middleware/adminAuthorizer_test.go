@@ -0,0 +1,98 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func startNodeServerAdminAuthorizer(handler func(c *gin.Context), facade interface{}) *gin.Engine {
+	ws := gin.New()
+	ws.Use(middleware.CreateAdminAuthorizerFromFacade(facade))
+
+	ws.Handle(http.MethodPost, "/admin", handler)
+
+	return ws
+}
+
+func makeAdminRequest(ws *gin.Engine, withCredentials bool) int {
+	req, _ := http.NewRequest(http.MethodPost, "/admin", nil)
+	if withCredentials {
+		req.SetBasicAuth("user", "pass")
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestCreateAdminAuthorizerFromFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		handlerCalled := false
+		ws := startNodeServerAdminAuthorizer(func(c *gin.Context) {
+			handlerCalled = true
+		}, "not a facade")
+
+		assert.Equal(t, http.StatusInternalServerError, makeAdminRequest(ws, true))
+		assert.False(t, handlerCalled)
+	})
+	t.Run("missing credentials should not execute", func(t *testing.T) {
+		t.Parallel()
+
+		handlerCalled := false
+		facade := &mock.FacadeStub{
+			IsAdminRequestAuthorizedCalled: func(username string, password string) bool {
+				assert.Fail(t, "should have not been called")
+				return true
+			},
+		}
+		ws := startNodeServerAdminAuthorizer(func(c *gin.Context) {
+			handlerCalled = true
+		}, facade)
+
+		assert.Equal(t, http.StatusUnauthorized, makeAdminRequest(ws, false))
+		assert.False(t, handlerCalled)
+	})
+	t.Run("wrong credentials should not execute", func(t *testing.T) {
+		t.Parallel()
+
+		handlerCalled := false
+		facade := &mock.FacadeStub{
+			IsAdminRequestAuthorizedCalled: func(username string, password string) bool {
+				return false
+			},
+		}
+		ws := startNodeServerAdminAuthorizer(func(c *gin.Context) {
+			handlerCalled = true
+		}, facade)
+
+		assert.Equal(t, http.StatusUnauthorized, makeAdminRequest(ws, true))
+		assert.False(t, handlerCalled)
+	})
+	t.Run("valid credentials should execute", func(t *testing.T) {
+		t.Parallel()
+
+		handlerCalled := false
+		facade := &mock.FacadeStub{
+			IsAdminRequestAuthorizedCalled: func(username string, password string) bool {
+				return username == "user" && password == "pass"
+			},
+		}
+		ws := startNodeServerAdminAuthorizer(func(c *gin.Context) {
+			handlerCalled = true
+			c.JSON(http.StatusOK, "ok")
+		}, facade)
+
+		assert.Equal(t, http.StatusOK, makeAdminRequest(ws, true))
+		assert.True(t, handlerCalled)
+	})
+}
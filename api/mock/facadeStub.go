@@ -3,6 +3,7 @@ package mock
 import (
 	"encoding/hex"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
@@ -35,6 +36,7 @@ type FacadeStub struct {
 	ValidateTransactionForSimulationHandler     func(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactionsHandler                 func(txs []*transaction.Transaction) (uint64, error)
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	ExecuteSCQueriesHandler                     func(queries []*process.SCQuery) ([]*common.SCQueryResultAPIResponse, api.BlockInfo, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler                  func() (map[string]*validator.ValidatorStatistics, error)
 	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
@@ -44,6 +46,20 @@ type FacadeStub struct {
 	GetGuardianDataCalled                       func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	GetPeerInfoCalled                           func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetworkCalled func() (string, error)
+	GetAntifloodQuotasCalled                    func() []*common.AntifloodQuotaStatus
+	GetAntifloodTopicsCalled                    func() []*common.AntifloodTopicStatus
+	GetAntifloodBlacklistedPeersCalled          func() []*common.BlacklistedPeer
+	SetAntifloodQuotaCalled                     func(name string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetAntifloodTopicMaxMessagesCalled          func(topic string, maxMessagesPerPeer uint32) error
+	AddAntifloodBlacklistedPeerCalled           func(pid string, duration time.Duration) error
+	RemoveAntifloodBlacklistedPeerCalled        func(pid string) error
+	ExportPeerReputationCalled                  func() *common.PeerReputationSnapshot
+	ImportPeerReputationCalled                  func(snapshot *common.PeerReputationSnapshot) error
+	AddManagedKeyCalled                         func(privateKeyHex string) (string, error)
+	RemoveManagedKeyCalled                      func(publicKey string) error
+	ExportSlashingProtectionCalled              func() (*common.SlashingProtectionSnapshot, error)
+	ImportSlashingProtectionCalled              func(snapshot *common.SlashingProtectionSnapshot) error
+	IsAdminRequestAuthorizedCalled              func(username string, password string) bool
 	GetEpochStartDataAPICalled                  func(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetThrottlerForEndpointCalled               func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                           func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
@@ -95,8 +111,31 @@ type FacadeStub struct {
 	GetEligibleManagedKeysCalled                func() ([]string, error)
 	GetWaitingManagedKeysCalled                 func() ([]string, error)
 	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
+	StartOutportReplayCalled                    func(startNonce uint64, endNonce uint64, target string) error
+	GetOutportReplayStatusCalled                func() common.OutportReplayStatus
+	GetGovernanceConfigCalled                   func() (*common.GovernanceConfigAPIResponse, error)
+	GetGovernanceProposalsCalled                func() ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposalCalled                 func(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVotesCalled                    func(address string) (*common.GovernanceVotesAPIResponse, error)
+	GetGovernanceVotingPowerCalled              func(address string) (*big.Int, error)
+	GetGovernanceDelegatedVoteInfoCalled        func(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error)
+	GetDelegationContractConfigCalled           func(contract string) (*common.DelegationContractConfigAPIResponse, error)
+	GetDelegationContractNodeStatesCalled       func(contract string) (*common.DelegationNodeStatesAPIResponse, error)
+	GetDelegationContractDelegatorCalled        func(contract string, delegator string) (*common.DelegatorAPIResponse, error)
+	GetDelegationContractDelegatorsCalled       func(contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error)
+	GetRewardsBreakdownCalled                   func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetOwnerRewardsBreakdownCalled              func(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error)
+	GetOwnerStakingInfoCalled                   func(owner string) (*common.OwnerStakingInfoAPIResponse, error)
+	RegisterContractABICalled                   func(address string, abiJSON []byte) error
+	GetContractsWithABICalled                   func() ([]string, error)
+	DecodeSCQueryReturnDataCalled               func(scAddress string, funcName string, returnData [][]byte) ([]*common.ABIDecodedValue, error)
+	DecodeTransactionCalled                     func(tx *transaction.ApiTransactionResult) (*common.ABIDecodedTransactionAPIResponse, error)
+	VerifyContractCalled                        func(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error)
+	GetVerifiedContractCalled                   func(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error)
 	P2PPrometheusMetricsEnabledCalled           func() bool
 	AuctionListHandler                          func() ([]*common.AuctionListValidatorAPIResponse, error)
+	AuctionSimulationHandler                    func(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error)
+	ValidatorHistoryHandler                     func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error)
 }
 
 // GetTokenSupply -
@@ -383,6 +422,24 @@ func (f *FacadeStub) AuctionListApi() ([]*common.AuctionListValidatorAPIResponse
 	return nil, nil
 }
 
+// AuctionSimulationApi is the mock implementation of a handler's AuctionSimulationApi method
+func (f *FacadeStub) AuctionSimulationApi(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error) {
+	if f.AuctionSimulationHandler != nil {
+		return f.AuctionSimulationHandler(request)
+	}
+
+	return nil, nil
+}
+
+// ValidatorHistoryApi is the mock implementation of a handler's ValidatorHistoryApi method
+func (f *FacadeStub) ValidatorHistoryApi(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error) {
+	if f.ValidatorHistoryHandler != nil {
+		return f.ValidatorHistoryHandler(blsKey, fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
 // ExecuteSCQuery is a mock implementation.
 func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
 	if f.ExecuteSCQueryHandler != nil {
@@ -392,6 +449,15 @@ func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, ap
 	return nil, api.BlockInfo{}, nil
 }
 
+// ExecuteSCQueries is a mock implementation.
+func (f *FacadeStub) ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultAPIResponse, api.BlockInfo, error) {
+	if f.ExecuteSCQueriesHandler != nil {
+		return f.ExecuteSCQueriesHandler(queries)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
 // StatusMetrics is the mock implementation for the StatusMetrics
 func (f *FacadeStub) StatusMetrics() external.StatusMetricsHandler {
 	if f.StatusMetricsHandler != nil {
@@ -486,6 +552,132 @@ func (f *FacadeStub) GetConnectedPeersRatingsOnMainNetwork() (string, error) {
 	return "", nil
 }
 
+// GetAntifloodQuotas -
+func (f *FacadeStub) GetAntifloodQuotas() []*common.AntifloodQuotaStatus {
+	if f.GetAntifloodQuotasCalled != nil {
+		return f.GetAntifloodQuotasCalled()
+	}
+
+	return make([]*common.AntifloodQuotaStatus, 0)
+}
+
+// GetAntifloodTopics -
+func (f *FacadeStub) GetAntifloodTopics() []*common.AntifloodTopicStatus {
+	if f.GetAntifloodTopicsCalled != nil {
+		return f.GetAntifloodTopicsCalled()
+	}
+
+	return make([]*common.AntifloodTopicStatus, 0)
+}
+
+// GetAntifloodBlacklistedPeers -
+func (f *FacadeStub) GetAntifloodBlacklistedPeers() []*common.BlacklistedPeer {
+	if f.GetAntifloodBlacklistedPeersCalled != nil {
+		return f.GetAntifloodBlacklistedPeersCalled()
+	}
+
+	return make([]*common.BlacklistedPeer, 0)
+}
+
+// SetAntifloodQuota -
+func (f *FacadeStub) SetAntifloodQuota(name string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	if f.SetAntifloodQuotaCalled != nil {
+		return f.SetAntifloodQuotaCalled(name, maxMessagesPerPeer, maxTotalSizePerPeer)
+	}
+
+	return nil
+}
+
+// SetAntifloodTopicMaxMessages -
+func (f *FacadeStub) SetAntifloodTopicMaxMessages(topic string, maxMessagesPerPeer uint32) error {
+	if f.SetAntifloodTopicMaxMessagesCalled != nil {
+		return f.SetAntifloodTopicMaxMessagesCalled(topic, maxMessagesPerPeer)
+	}
+
+	return nil
+}
+
+// AddAntifloodBlacklistedPeer -
+func (f *FacadeStub) AddAntifloodBlacklistedPeer(pid string, duration time.Duration) error {
+	if f.AddAntifloodBlacklistedPeerCalled != nil {
+		return f.AddAntifloodBlacklistedPeerCalled(pid, duration)
+	}
+
+	return nil
+}
+
+// RemoveAntifloodBlacklistedPeer -
+func (f *FacadeStub) RemoveAntifloodBlacklistedPeer(pid string) error {
+	if f.RemoveAntifloodBlacklistedPeerCalled != nil {
+		return f.RemoveAntifloodBlacklistedPeerCalled(pid)
+	}
+
+	return nil
+}
+
+// ExportPeerReputation -
+func (f *FacadeStub) ExportPeerReputation() *common.PeerReputationSnapshot {
+	if f.ExportPeerReputationCalled != nil {
+		return f.ExportPeerReputationCalled()
+	}
+
+	return &common.PeerReputationSnapshot{}
+}
+
+// ImportPeerReputation -
+func (f *FacadeStub) ImportPeerReputation(snapshot *common.PeerReputationSnapshot) error {
+	if f.ImportPeerReputationCalled != nil {
+		return f.ImportPeerReputationCalled(snapshot)
+	}
+
+	return nil
+}
+
+// AddManagedKey -
+func (f *FacadeStub) AddManagedKey(privateKeyHex string) (string, error) {
+	if f.AddManagedKeyCalled != nil {
+		return f.AddManagedKeyCalled(privateKeyHex)
+	}
+
+	return "", nil
+}
+
+// RemoveManagedKey -
+func (f *FacadeStub) RemoveManagedKey(publicKey string) error {
+	if f.RemoveManagedKeyCalled != nil {
+		return f.RemoveManagedKeyCalled(publicKey)
+	}
+
+	return nil
+}
+
+// ExportSlashingProtection -
+func (f *FacadeStub) ExportSlashingProtection() (*common.SlashingProtectionSnapshot, error) {
+	if f.ExportSlashingProtectionCalled != nil {
+		return f.ExportSlashingProtectionCalled()
+	}
+
+	return &common.SlashingProtectionSnapshot{}, nil
+}
+
+// ImportSlashingProtection -
+func (f *FacadeStub) ImportSlashingProtection(snapshot *common.SlashingProtectionSnapshot) error {
+	if f.ImportSlashingProtectionCalled != nil {
+		return f.ImportSlashingProtectionCalled(snapshot)
+	}
+
+	return nil
+}
+
+// IsAdminRequestAuthorized -
+func (f *FacadeStub) IsAdminRequestAuthorized(username string, password string) bool {
+	if f.IsAdminRequestAuthorizedCalled != nil {
+		return f.IsAdminRequestAuthorizedCalled(username, password)
+	}
+
+	return false
+}
+
 // GetEpochStartDataAPI -
 func (f *FacadeStub) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	return f.GetEpochStartDataAPICalled(epoch)
@@ -727,6 +919,174 @@ func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32,
 	return 0, nil
 }
 
+// StartOutportReplay -
+func (f *FacadeStub) StartOutportReplay(startNonce uint64, endNonce uint64, target string) error {
+	if f.StartOutportReplayCalled != nil {
+		return f.StartOutportReplayCalled(startNonce, endNonce, target)
+	}
+	return nil
+}
+
+// GetOutportReplayStatus -
+func (f *FacadeStub) GetOutportReplayStatus() common.OutportReplayStatus {
+	if f.GetOutportReplayStatusCalled != nil {
+		return f.GetOutportReplayStatusCalled()
+	}
+	return common.OutportReplayStatus{}
+}
+
+// GetGovernanceConfig -
+func (f *FacadeStub) GetGovernanceConfig() (*common.GovernanceConfigAPIResponse, error) {
+	if f.GetGovernanceConfigCalled != nil {
+		return f.GetGovernanceConfigCalled()
+	}
+	return nil, nil
+}
+
+// GetGovernanceProposals -
+func (f *FacadeStub) GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error) {
+	if f.GetGovernanceProposalsCalled != nil {
+		return f.GetGovernanceProposalsCalled()
+	}
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (f *FacadeStub) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	if f.GetGovernanceProposalCalled != nil {
+		return f.GetGovernanceProposalCalled(nonce)
+	}
+	return nil, nil
+}
+
+// GetGovernanceVotes -
+func (f *FacadeStub) GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error) {
+	if f.GetGovernanceVotesCalled != nil {
+		return f.GetGovernanceVotesCalled(address)
+	}
+	return nil, nil
+}
+
+// GetGovernanceVotingPower -
+func (f *FacadeStub) GetGovernanceVotingPower(address string) (*big.Int, error) {
+	if f.GetGovernanceVotingPowerCalled != nil {
+		return f.GetGovernanceVotingPowerCalled(address)
+	}
+	return nil, nil
+}
+
+// GetGovernanceDelegatedVoteInfo -
+func (f *FacadeStub) GetGovernanceDelegatedVoteInfo(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error) {
+	if f.GetGovernanceDelegatedVoteInfoCalled != nil {
+		return f.GetGovernanceDelegatedVoteInfoCalled(contract, nonce)
+	}
+	return nil, nil
+}
+
+// GetDelegationContractConfig -
+func (f *FacadeStub) GetDelegationContractConfig(contract string) (*common.DelegationContractConfigAPIResponse, error) {
+	if f.GetDelegationContractConfigCalled != nil {
+		return f.GetDelegationContractConfigCalled(contract)
+	}
+	return nil, nil
+}
+
+// GetDelegationContractNodeStates -
+func (f *FacadeStub) GetDelegationContractNodeStates(contract string) (*common.DelegationNodeStatesAPIResponse, error) {
+	if f.GetDelegationContractNodeStatesCalled != nil {
+		return f.GetDelegationContractNodeStatesCalled(contract)
+	}
+	return nil, nil
+}
+
+// GetDelegationContractDelegator -
+func (f *FacadeStub) GetDelegationContractDelegator(contract string, delegator string) (*common.DelegatorAPIResponse, error) {
+	if f.GetDelegationContractDelegatorCalled != nil {
+		return f.GetDelegationContractDelegatorCalled(contract, delegator)
+	}
+	return nil, nil
+}
+
+// GetDelegationContractDelegators -
+func (f *FacadeStub) GetDelegationContractDelegators(contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error) {
+	if f.GetDelegationContractDelegatorsCalled != nil {
+		return f.GetDelegationContractDelegatorsCalled(contract, page, pageSize)
+	}
+	return nil, nil
+}
+
+// GetRewardsBreakdown -
+func (f *FacadeStub) GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	if f.GetRewardsBreakdownCalled != nil {
+		return f.GetRewardsBreakdownCalled(epoch)
+	}
+	return nil, nil
+}
+
+// GetOwnerRewardsBreakdown -
+func (f *FacadeStub) GetOwnerRewardsBreakdown(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error) {
+	if f.GetOwnerRewardsBreakdownCalled != nil {
+		return f.GetOwnerRewardsBreakdownCalled(epoch, owner)
+	}
+	return nil, nil
+}
+
+// GetOwnerStakingInfo -
+func (f *FacadeStub) GetOwnerStakingInfo(owner string) (*common.OwnerStakingInfoAPIResponse, error) {
+	if f.GetOwnerStakingInfoCalled != nil {
+		return f.GetOwnerStakingInfoCalled(owner)
+	}
+	return nil, nil
+}
+
+// RegisterContractABI -
+func (f *FacadeStub) RegisterContractABI(address string, abiJSON []byte) error {
+	if f.RegisterContractABICalled != nil {
+		return f.RegisterContractABICalled(address, abiJSON)
+	}
+	return nil
+}
+
+// GetContractsWithABI -
+func (f *FacadeStub) GetContractsWithABI() ([]string, error) {
+	if f.GetContractsWithABICalled != nil {
+		return f.GetContractsWithABICalled()
+	}
+	return nil, nil
+}
+
+// DecodeSCQueryReturnData -
+func (f *FacadeStub) DecodeSCQueryReturnData(scAddress string, funcName string, returnData [][]byte) ([]*common.ABIDecodedValue, error) {
+	if f.DecodeSCQueryReturnDataCalled != nil {
+		return f.DecodeSCQueryReturnDataCalled(scAddress, funcName, returnData)
+	}
+	return nil, nil
+}
+
+// DecodeTransaction -
+func (f *FacadeStub) DecodeTransaction(tx *transaction.ApiTransactionResult) (*common.ABIDecodedTransactionAPIResponse, error) {
+	if f.DecodeTransactionCalled != nil {
+		return f.DecodeTransactionCalled(tx)
+	}
+	return nil, nil
+}
+
+// VerifyContract -
+func (f *FacadeStub) VerifyContract(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error) {
+	if f.VerifyContractCalled != nil {
+		return f.VerifyContractCalled(address, artifact)
+	}
+	return nil, nil
+}
+
+// GetVerifiedContract -
+func (f *FacadeStub) GetVerifiedContract(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error) {
+	if f.GetVerifiedContractCalled != nil {
+		return f.GetVerifiedContractCalled(address, codeHash)
+	}
+	return nil, nil
+}
+
 // P2PPrometheusMetricsEnabled -
 func (f *FacadeStub) P2PPrometheusMetricsEnabled() bool {
 	if f.P2PPrometheusMetricsEnabledCalled != nil {
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -101,6 +102,20 @@ type FacadeHandler interface {
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetwork() (string, error)
+	GetAntifloodQuotas() []*common.AntifloodQuotaStatus
+	GetAntifloodTopics() []*common.AntifloodTopicStatus
+	GetAntifloodBlacklistedPeers() []*common.BlacklistedPeer
+	SetAntifloodQuota(name string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetAntifloodTopicMaxMessages(topic string, maxMessagesPerPeer uint32) error
+	AddAntifloodBlacklistedPeer(pid string, duration time.Duration) error
+	RemoveAntifloodBlacklistedPeer(pid string) error
+	ExportPeerReputation() *common.PeerReputationSnapshot
+	ImportPeerReputation(snapshot *common.PeerReputationSnapshot) error
+	AddManagedKey(privateKeyHex string) (string, error)
+	RemoveManagedKey(publicKey string) error
+	ExportSlashingProtection() (*common.SlashingProtectionSnapshot, error)
+	ImportSlashingProtection(snapshot *common.SlashingProtectionSnapshot) error
+	IsAdminRequestAuthorized(username string, password string) bool
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
@@ -116,7 +131,10 @@ type FacadeHandler interface {
 	EncodeAddressPubkey(pk []byte) (string, error)
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
+	AuctionSimulationApi(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error)
+	ValidatorHistoryApi(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error)
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultAPIResponse, api.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	RestApiInterface() string
 	RestAPIServerDebugMode() bool
@@ -135,6 +153,27 @@ type FacadeHandler interface {
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
+	StartOutportReplay(startNonce uint64, endNonce uint64, target string) error
+	GetOutportReplayStatus() common.OutportReplayStatus
+	GetGovernanceConfig() (*common.GovernanceConfigAPIResponse, error)
+	GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error)
+	GetGovernanceVotingPower(address string) (*big.Int, error)
+	GetGovernanceDelegatedVoteInfo(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error)
+	GetDelegationContractConfig(contract string) (*common.DelegationContractConfigAPIResponse, error)
+	GetDelegationContractNodeStates(contract string) (*common.DelegationNodeStatesAPIResponse, error)
+	GetDelegationContractDelegator(contract string, delegator string) (*common.DelegatorAPIResponse, error)
+	GetDelegationContractDelegators(contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error)
+	GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetOwnerRewardsBreakdown(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error)
+	GetOwnerStakingInfo(owner string) (*common.OwnerStakingInfoAPIResponse, error)
+	RegisterContractABI(address string, abiJSON []byte) error
+	GetContractsWithABI() ([]string, error)
+	DecodeSCQueryReturnData(scAddress string, funcName string, returnData [][]byte) ([]*common.ABIDecodedValue, error)
+	DecodeTransaction(tx *transaction.ApiTransactionResult) (*common.ABIDecodedTransactionAPIResponse, error)
+	VerifyContract(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error)
+	GetVerifiedContract(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error)
 	P2PPrometheusMetricsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -5,6 +5,8 @@ generate() {
     generateForKeyGenerator
     generateForLogViewer
     generateForNode
+    generateForOutportReplay
+    generateForRemoteSigner
     generateForSeedNode
     generateForTermUi
 }
@@ -65,6 +67,34 @@ $(code)
     echo "$HELP" > ./node/CLI.md
 }
 
+generateForOutportReplay() {
+    HELP="
+# MultiversX Outport Replay CLI
+
+The **MultiversX Outport Replay** tool exposes the following Command Line Interface:
+$(code)
+\$ outportreplay --help
+
+$(./outportreplay/outportreplay --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./outportreplay/CLI.md
+}
+
+generateForRemoteSigner() {
+    HELP="
+# MultiversX Remote Signer CLI
+
+The **MultiversX Remote Signer** exposes the following Command Line Interface:
+$(code)
+\$ remotesigner --help
+
+$(./remotesigner/remotesigner --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./remotesigner/CLI.md
+}
+
 generateForSeedNode() {
     HELP="
 # MultiversX SeedNode CLI
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-go/cmd/keygenerator/converter"
+	"github.com/urfave/cli"
+)
+
+var (
+	// inputFile defines a flag for the file holding an existing key
+	inputFile = cli.StringFlag{
+		Name:        "input",
+		Usage:       "The `file` holding the existing key",
+		Destination: &argsConfig.inputFile,
+	}
+	// inputFormat defines a flag for the format of the file holding an existing key
+	inputFormat = cli.StringFlag{
+		Name:        "input-format",
+		Usage:       fmt.Sprintf("The format of the input file. Available options: %s, %s, %s (only for wallet keys)", pemFormat, keyStoreFormat, mnemonicFormat),
+		Value:       pemFormat,
+		Destination: &argsConfig.inputFormat,
+	}
+	// outputFile defines a flag for the file the converted key is written to
+	outputFile = cli.StringFlag{
+		Name:        "output",
+		Usage:       "The `file` the converted key is written to. An existing file is kept as a timestamped backup",
+		Destination: &argsConfig.outputFile,
+	}
+	// existingKeyType defines a flag for the type of an existing key
+	existingKeyType = cli.StringFlag{
+		Name:        "key-type",
+		Usage:       fmt.Sprintf("The type of the existing key. Available options: %s, %s, %s", validatorType, walletType, p2pType),
+		Value:       validatorType,
+		Destination: &argsConfig.keyType,
+	}
+	// keyIndex defines a flag for the index of the key in a PEM file holding multiple keys
+	keyIndex = cli.IntFlag{
+		Name:        "index",
+		Usage:       "The index of the key in a PEM file holding multiple keys or the derivation index of the wallet key derived from a mnemonic",
+		Destination: &argsConfig.keyIndex,
+	}
+	// outputPasswordFile defines a flag for the file holding the password of the converted key store
+	outputPasswordFile = cli.StringFlag{
+		Name:        "output-password-file",
+		Usage:       "The `file` holding the password of the output key store. If not provided, the password is read from the standard input",
+		Destination: &argsConfig.outputPasswordFile,
+	}
+	// expectedPublicKey defines a flag for the public key a secret key is verified against
+	expectedPublicKey = cli.StringFlag{
+		Name:        "public-key",
+		Usage:       "The encoded public key to be verified. If not provided, the public key stored along the secret key is verified",
+		Destination: &argsConfig.publicKey,
+	}
+
+	convertCommand = cli.Command{
+		Name:  "convert",
+		Usage: "Converts an existing key between the PEM and the key store formats or exports a wallet key derived from a mnemonic",
+		Flags: []cli.Flag{inputFile, inputFormat, outputFile, outputFormat, existingKeyType, keyIndex, passwordFile, outputPasswordFile},
+		Action: func(_ *cli.Context) error {
+			return convertKey()
+		},
+	}
+	publicKeyCommand = cli.Command{
+		Name:  "public-key",
+		Usage: "Derives the public key of an existing secret key",
+		Flags: []cli.Flag{inputFile, inputFormat, existingKeyType, keyIndex, passwordFile},
+		Action: func(_ *cli.Context) error {
+			return derivePublicKey()
+		},
+	}
+	verifyCommand = cli.Command{
+		Name:  "verify",
+		Usage: "Verifies that a public key belongs to an existing secret key",
+		Flags: []cli.Flag{inputFile, inputFormat, existingKeyType, keyIndex, passwordFile, expectedPublicKey},
+		Action: func(_ *cli.Context) error {
+			return verifyKeyPair()
+		},
+	}
+)
+
+func convertKey() error {
+	if len(argsConfig.outputFile) == 0 {
+		return fmt.Errorf("no output file provided")
+	}
+
+	k, err := loadVerifiedKey()
+	if err != nil {
+		return err
+	}
+
+	err = saveKey(argsConfig.outputFile, argsConfig.outputFormat, k, argsConfig.keyType)
+	if err != nil {
+		return err
+	}
+
+	log.Info("key converted", "output", argsConfig.outputFile, "format", argsConfig.outputFormat)
+	return nil
+}
+
+func derivePublicKey() error {
+	k, err := loadVerifiedKey()
+	if err != nil {
+		return err
+	}
+
+	handlers, err := getKeyTypeHandlers(argsConfig.keyType)
+	if err != nil {
+		return err
+	}
+	pkString, err := handlers.pubKeyConverter.Encode(k.pkBytes)
+	if err != nil {
+		return err
+	}
+
+	log.Info("derived public key", "public key", pkString)
+	return nil
+}
+
+func verifyKeyPair() error {
+	k, err := loadVerifiedKey()
+	if err != nil {
+		return err
+	}
+	if len(argsConfig.publicKey) == 0 {
+		log.Info("the key pair is valid")
+		return nil
+	}
+
+	handlers, err := getKeyTypeHandlers(argsConfig.keyType)
+	if err != nil {
+		return err
+	}
+	pkString, err := handlers.pubKeyConverter.Encode(k.pkBytes)
+	if err != nil {
+		return err
+	}
+	if pkString != argsConfig.publicKey {
+		return fmt.Errorf("%w: provided %s, derived %s", converter.ErrPublicKeyMismatch, argsConfig.publicKey, pkString)
+	}
+
+	log.Info("the key pair is valid", "public key", pkString)
+	return nil
+}
+
+// loadVerifiedKey loads the key from the input file and replaces the stored public key with the one derived from
+// the secret key, after checking that they match
+func loadVerifiedKey() (key, error) {
+	if len(argsConfig.inputFile) == 0 {
+		return key{}, fmt.Errorf("no input file provided")
+	}
+
+	k, err := loadKey(argsConfig.inputFile, argsConfig.inputFormat, argsConfig.keyIndex, argsConfig.keyType)
+	if err != nil {
+		return key{}, err
+	}
+
+	handlers, err := getKeyTypeHandlers(argsConfig.keyType)
+	if err != nil {
+		return key{}, err
+	}
+	derivedPkBytes, err := converter.DerivePublicKey(handlers.keyGen, k.skBytes)
+	if err != nil {
+		return key{}, err
+	}
+	if len(k.pkBytes) > 0 {
+		err = converter.VerifyKeyPair(handlers.keyGen, handlers.signer, k.skBytes, k.pkBytes)
+		if err != nil {
+			return key{}, fmt.Errorf("%w for the public key stored in %s", err, argsConfig.inputFile)
+		}
+	}
+	k.pkBytes = derivedPkBytes
+
+	return k, nil
+}
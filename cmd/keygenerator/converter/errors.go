@@ -4,3 +4,45 @@ import "errors"
 
 // ErrNotImplemented is returned when a method is not implemented
 var errNotImplemented = errors.New("not implemented")
+
+// ErrEmptyPassword signals that an empty password was provided
+var ErrEmptyPassword = errors.New("empty password")
+
+// ErrEmptySecretKey signals that an empty secret key was provided
+var ErrEmptySecretKey = errors.New("empty secret key")
+
+// ErrNilKeyStore signals that a nil key store was provided
+var ErrNilKeyStore = errors.New("nil key store")
+
+// ErrUnsupportedKeyStoreVersion signals that the key store version is not supported
+var ErrUnsupportedKeyStoreVersion = errors.New("unsupported key store version")
+
+// ErrUnsupportedKeyStoreKind signals that the key store kind is not supported
+var ErrUnsupportedKeyStoreKind = errors.New("unsupported key store kind")
+
+// ErrUnsupportedCipher signals that the key store cipher is not supported
+var ErrUnsupportedCipher = errors.New("unsupported cipher")
+
+// ErrUnsupportedKDF signals that the key store key derivation function is not supported
+var ErrUnsupportedKDF = errors.New("unsupported key derivation function")
+
+// ErrWrongPassword signals that the key store could not be decrypted with the provided password
+var ErrWrongPassword = errors.New("wrong password or corrupted key store")
+
+// ErrNilKeyGenerator signals that a nil key generator was provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilSingleSigner signals that a nil single signer was provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrPublicKeyMismatch signals that the public key does not belong to the secret key
+var ErrPublicKeyMismatch = errors.New("the public key does not match the secret key")
+
+// ErrInvalidMnemonic signals that the mnemonic is not a valid BIP39 mnemonic
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+// ErrInvalidNumberOfWords signals that the number of words of a new mnemonic is not supported
+var ErrInvalidNumberOfWords = errors.New("invalid number of words, available options: 12, 15, 18, 21, 24")
+
+// ErrInvalidDerivationIndex signals that the derivation index exceeds the hardened indexes range
+var ErrInvalidDerivationIndex = errors.New("invalid derivation index")
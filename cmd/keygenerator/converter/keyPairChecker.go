@@ -0,0 +1,56 @@
+package converter
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
+
+var keyPairVerificationMessage = []byte("key pair verification")
+
+// DerivePublicKey returns the public key of the provided secret key
+func DerivePublicKey(keyGen crypto.KeyGenerator, skBytes []byte) ([]byte, error) {
+	if check.IfNil(keyGen) {
+		return nil, ErrNilKeyGenerator
+	}
+
+	sk, err := keyGen.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return sk.GeneratePublic().ToByteArray()
+}
+
+// VerifyKeyPair checks that the public key belongs to the secret key by comparing it with the derived public key
+// and by verifying a signature produced with the secret key
+func VerifyKeyPair(keyGen crypto.KeyGenerator, signer crypto.SingleSigner, skBytes []byte, pkBytes []byte) error {
+	if check.IfNil(signer) {
+		return ErrNilSingleSigner
+	}
+
+	derivedPkBytes, err := DerivePublicKey(keyGen, skBytes)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(derivedPkBytes, pkBytes) {
+		return ErrPublicKeyMismatch
+	}
+
+	sk, err := keyGen.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return err
+	}
+	pk, err := keyGen.PublicKeyFromByteArray(pkBytes)
+	if err != nil {
+		return err
+	}
+
+	signature, err := signer.Sign(sk, keyPairVerificationMessage)
+	if err != nil {
+		return err
+	}
+
+	return signer.Verify(pk, keyPairVerificationMessage, signature)
+}
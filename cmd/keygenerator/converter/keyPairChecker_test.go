@@ -0,0 +1,92 @@
+package converter
+
+import (
+	"encoding/hex"
+	"testing"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclSingleSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const alicePublicKeyHex = "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1"
+
+func TestDerivePublicKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		pkBytes, err := DerivePublicKey(nil, []byte("sk"))
+		assert.Nil(t, pkBytes)
+		assert.Equal(t, ErrNilKeyGenerator, err)
+	})
+	t.Run("invalid secret key should error", func(t *testing.T) {
+		t.Parallel()
+
+		pkBytes, err := DerivePublicKey(signing.NewKeyGenerator(ed25519.NewEd25519()), []byte("invalid"))
+		assert.Nil(t, pkBytes)
+		assert.NotNil(t, err)
+	})
+	t.Run("wallet key should work", func(t *testing.T) {
+		t.Parallel()
+
+		skBytes, _ := hex.DecodeString(aliceSecretKeyHex)
+		pkBytes, err := DerivePublicKey(signing.NewKeyGenerator(ed25519.NewEd25519()), skBytes)
+		require.Nil(t, err)
+		assert.Equal(t, alicePublicKeyHex, hex.EncodeToString(pkBytes))
+
+		pkBytes, err = DerivePublicKey(signing.NewKeyGenerator(ed25519.NewEd25519()), skBytes[:32])
+		require.Nil(t, err)
+		assert.Equal(t, alicePublicKeyHex, hex.EncodeToString(pkBytes))
+	})
+}
+
+func TestVerifyKeyPair(t *testing.T) {
+	t.Parallel()
+
+	blsKeyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	generatePair := func(keyGen crypto.KeyGenerator) ([]byte, []byte) {
+		sk, pk := keyGen.GeneratePair()
+		skBytes, _ := sk.ToByteArray()
+		pkBytes, _ := pk.ToByteArray()
+
+		return skBytes, pkBytes
+	}
+
+	t.Run("nil signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		skBytes, pkBytes := generatePair(blsKeyGen)
+		err := VerifyKeyPair(blsKeyGen, nil, skBytes, pkBytes)
+		assert.Equal(t, ErrNilSingleSigner, err)
+	})
+	t.Run("other public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		skBytes, _ := generatePair(blsKeyGen)
+		_, otherPkBytes := generatePair(blsKeyGen)
+		err := VerifyKeyPair(blsKeyGen, mclSingleSig.NewBlsSigner(), skBytes, otherPkBytes)
+		assert.Equal(t, ErrPublicKeyMismatch, err)
+	})
+	t.Run("validator key pair should work", func(t *testing.T) {
+		t.Parallel()
+
+		skBytes, pkBytes := generatePair(blsKeyGen)
+		err := VerifyKeyPair(blsKeyGen, mclSingleSig.NewBlsSigner(), skBytes, pkBytes)
+		assert.Nil(t, err)
+	})
+	t.Run("wallet key pair should work", func(t *testing.T) {
+		t.Parallel()
+
+		skBytes, _ := hex.DecodeString(aliceSecretKeyHex)
+		pkBytes, _ := hex.DecodeString(alicePublicKeyHex)
+		err := VerifyKeyPair(signing.NewKeyGenerator(ed25519.NewEd25519()), &singlesig.Ed25519Signer{}, skBytes, pkBytes)
+		assert.Nil(t, err)
+	})
+}
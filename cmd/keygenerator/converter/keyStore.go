@@ -0,0 +1,233 @@
+package converter
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	keyStoreVersion    = 4
+	keyStoreKindSecret = "secretKey"
+	keyStoreCipher     = "aes-128-ctr"
+	keyStoreKDF        = "scrypt"
+	scryptN            = 4096
+	scryptR            = 8
+	scryptP            = 1
+	scryptDKLen        = 32
+	saltLength         = 32
+	ivLength           = 16
+	idLength           = 16
+)
+
+// KeyStore is the password protected JSON representation of a secret key, in the format used by the wallets
+type KeyStore struct {
+	Version int            `json:"version"`
+	Kind    string         `json:"kind"`
+	ID      string         `json:"id"`
+	Address string         `json:"address"`
+	Bech32  string         `json:"bech32,omitempty"`
+	Crypto  KeyStoreCrypto `json:"crypto"`
+}
+
+// KeyStoreCrypto holds the encrypted secret key and the parameters needed for decrypting it
+type KeyStoreCrypto struct {
+	Ciphertext   string       `json:"ciphertext"`
+	CipherParams CipherParams `json:"cipherparams"`
+	Cipher       string       `json:"cipher"`
+	KDF          string       `json:"kdf"`
+	KDFParams    KDFParams    `json:"kdfparams"`
+	MAC          string       `json:"mac"`
+}
+
+// CipherParams holds the parameters of the cipher
+type CipherParams struct {
+	IV string `json:"iv"`
+}
+
+// KDFParams holds the parameters of the scrypt key derivation function
+type KDFParams struct {
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+}
+
+// ArgsEncryptKey holds the arguments needed for creating a key store
+type ArgsEncryptKey struct {
+	SecretKey     []byte
+	PublicKey     []byte
+	Bech32Address string
+	Password      string
+}
+
+// EncryptKey creates a new key store holding the secret key encrypted with the provided password. The key used by
+// the AES-128-CTR cipher is the first half of the scrypt derived key, while the second half authenticates the
+// ciphertext with HMAC-SHA256
+func EncryptKey(args ArgsEncryptKey) (*KeyStore, error) {
+	if len(args.SecretKey) == 0 {
+		return nil, ErrEmptySecretKey
+	}
+	if len(args.Password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+
+	salt, err := randomBytes(saltLength)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := randomBytes(ivLength)
+	if err != nil {
+		return nil, err
+	}
+	id, err := newKeyStoreID()
+	if err != nil {
+		return nil, err
+	}
+
+	kdfParams := KDFParams{
+		DKLen: scryptDKLen,
+		Salt:  hex.EncodeToString(salt),
+		N:     scryptN,
+		R:     scryptR,
+		P:     scryptP,
+	}
+	derivedKey, err := scrypt.Key([]byte(args.Password), salt, kdfParams.N, kdfParams.R, kdfParams.P, kdfParams.DKLen)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := applyCipher(derivedKey, iv, args.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyStore{
+		Version: keyStoreVersion,
+		Kind:    keyStoreKindSecret,
+		ID:      id,
+		Address: hex.EncodeToString(args.PublicKey),
+		Bech32:  args.Bech32Address,
+		Crypto: KeyStoreCrypto{
+			Ciphertext: hex.EncodeToString(ciphertext),
+			CipherParams: CipherParams{
+				IV: hex.EncodeToString(iv),
+			},
+			Cipher:    keyStoreCipher,
+			KDF:       keyStoreKDF,
+			KDFParams: kdfParams,
+			MAC:       hex.EncodeToString(computeMAC(derivedKey, ciphertext)),
+		},
+	}, nil
+}
+
+// DecryptKey returns the secret key held by the key store
+func DecryptKey(keyStore *KeyStore, password string) ([]byte, error) {
+	err := checkKeyStore(keyStore)
+	if err != nil {
+		return nil, err
+	}
+
+	salt, err := hex.DecodeString(keyStore.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the salt", err)
+	}
+	iv, err := hex.DecodeString(keyStore.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the iv", err)
+	}
+	ciphertext, err := hex.DecodeString(keyStore.Crypto.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the ciphertext", err)
+	}
+	mac, err := hex.DecodeString(keyStore.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the mac", err)
+	}
+
+	kdfParams := keyStore.Crypto.KDFParams
+	derivedKey, err := scrypt.Key([]byte(password), salt, kdfParams.N, kdfParams.R, kdfParams.P, kdfParams.DKLen)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(mac, computeMAC(derivedKey, ciphertext)) {
+		return nil, ErrWrongPassword
+	}
+
+	return applyCipher(derivedKey, iv, ciphertext)
+}
+
+func checkKeyStore(keyStore *KeyStore) error {
+	if keyStore == nil {
+		return ErrNilKeyStore
+	}
+	if keyStore.Version != keyStoreVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedKeyStoreVersion, keyStore.Version)
+	}
+	if keyStore.Kind != keyStoreKindSecret {
+		return fmt.Errorf("%w: %s", ErrUnsupportedKeyStoreKind, keyStore.Kind)
+	}
+	if keyStore.Crypto.Cipher != keyStoreCipher {
+		return fmt.Errorf("%w: %s", ErrUnsupportedCipher, keyStore.Crypto.Cipher)
+	}
+	if keyStore.Crypto.KDF != keyStoreKDF {
+		return fmt.Errorf("%w: %s", ErrUnsupportedKDF, keyStore.Crypto.KDF)
+	}
+	if keyStore.Crypto.KDFParams.DKLen != scryptDKLen {
+		return fmt.Errorf("%w: derived key length %d", ErrUnsupportedKDF, keyStore.Crypto.KDFParams.DKLen)
+	}
+
+	return nil
+}
+
+// applyCipher encrypts or decrypts the data, as AES-CTR is symmetric
+func applyCipher(derivedKey []byte, iv []byte, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(derivedKey[:scryptDKLen/2])
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != block.BlockSize() {
+		return nil, fmt.Errorf("%w: invalid iv length %d", ErrUnsupportedCipher, len(iv))
+	}
+
+	result := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(result, data)
+
+	return result, nil
+}
+
+func computeMAC(derivedKey []byte, ciphertext []byte) []byte {
+	hasher := hmac.New(sha256.New, derivedKey[scryptDKLen/2:])
+	_, _ = hasher.Write(ciphertext)
+
+	return hasher.Sum(nil)
+}
+
+func randomBytes(length int) ([]byte, error) {
+	buff := make([]byte, length)
+	_, err := rand.Read(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff, nil
+}
+
+// newKeyStoreID returns a random (version 4) UUID
+func newKeyStoreID() (string, error) {
+	id, err := randomBytes(idLength)
+	if err != nil {
+		return "", err
+	}
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]), nil
+}
@@ -0,0 +1,132 @@
+package converter
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const aliceKeyStore = `{"version":4,"kind":"secretKey","id":"0dc10c02-b59b-4bac-9710-6b2cfa4284ba","address":"0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1","bech32":"erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th","crypto":{"ciphertext":"4c41ef6fdfd52c39b1585a875eb3c86d30a315642d0e35bb8205b6372c1882f135441099b11ff76345a6f3a930b5665aaf9f7325a32c8ccd60081c797aa2d538","cipherparams":{"iv":"033182afaa1ebaafcde9ccc68a5eac31"},"cipher":"aes-128-ctr","kdf":"scrypt","kdfparams":{"dklen":32,"salt":"4903bd0e7880baa04fc4f886518ac5c672cdc745a6bd13dcec2b6c12e9bffe8d","n":4096,"r":8,"p":1},"mac":"5b4a6f14ab74ba7ca23db6847e28447f0e6a7724ba9664cf425df707a84f5a8b"}}`
+const aliceSecretKeyHex = "413f42575f7f26fad3317a778771212fdb80245850981e48b58a4f25e344e8f90139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1"
+const alicePassword = "password"
+
+func TestEncryptKey(t *testing.T) {
+	t.Parallel()
+
+	args := ArgsEncryptKey{
+		SecretKey:     []byte("secret key"),
+		PublicKey:     []byte("public key"),
+		Bech32Address: "erd1",
+		Password:      "pass",
+	}
+
+	t.Run("empty secret key should error", func(t *testing.T) {
+		t.Parallel()
+
+		argsCopy := args
+		argsCopy.SecretKey = nil
+		keyStore, err := EncryptKey(argsCopy)
+		assert.Nil(t, keyStore)
+		assert.Equal(t, ErrEmptySecretKey, err)
+	})
+	t.Run("empty password should error", func(t *testing.T) {
+		t.Parallel()
+
+		argsCopy := args
+		argsCopy.Password = ""
+		keyStore, err := EncryptKey(argsCopy)
+		assert.Nil(t, keyStore)
+		assert.Equal(t, ErrEmptyPassword, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		keyStore, err := EncryptKey(args)
+		require.Nil(t, err)
+		assert.Equal(t, keyStoreVersion, keyStore.Version)
+		assert.Equal(t, keyStoreKindSecret, keyStore.Kind)
+		assert.Equal(t, hex.EncodeToString(args.PublicKey), keyStore.Address)
+		assert.Equal(t, args.Bech32Address, keyStore.Bech32)
+		assert.Len(t, keyStore.ID, 36)
+		assert.Equal(t, byte('4'), keyStore.ID[14])
+
+		secretKey, err := DecryptKey(keyStore, args.Password)
+		require.Nil(t, err)
+		assert.Equal(t, args.SecretKey, secretKey)
+
+		otherKeyStore, err := EncryptKey(args)
+		require.Nil(t, err)
+		assert.NotEqual(t, keyStore.Crypto.KDFParams.Salt, otherKeyStore.Crypto.KDFParams.Salt)
+		assert.NotEqual(t, keyStore.Crypto.Ciphertext, otherKeyStore.Crypto.Ciphertext)
+	})
+}
+
+func TestDecryptKey(t *testing.T) {
+	t.Parallel()
+
+	loadAliceKeyStore := func() *KeyStore {
+		keyStore := &KeyStore{}
+		err := json.Unmarshal([]byte(aliceKeyStore), keyStore)
+		require.Nil(t, err)
+
+		return keyStore
+	}
+
+	t.Run("nil key store should error", func(t *testing.T) {
+		t.Parallel()
+
+		secretKey, err := DecryptKey(nil, alicePassword)
+		assert.Nil(t, secretKey)
+		assert.Equal(t, ErrNilKeyStore, err)
+	})
+	t.Run("unsupported parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		keyStore := loadAliceKeyStore()
+		keyStore.Version = 3
+		_, err := DecryptKey(keyStore, alicePassword)
+		assert.True(t, errors.Is(err, ErrUnsupportedKeyStoreVersion))
+
+		keyStore = loadAliceKeyStore()
+		keyStore.Kind = "mnemonic"
+		_, err = DecryptKey(keyStore, alicePassword)
+		assert.True(t, errors.Is(err, ErrUnsupportedKeyStoreKind))
+
+		keyStore = loadAliceKeyStore()
+		keyStore.Crypto.Cipher = "aes-128-cbc"
+		_, err = DecryptKey(keyStore, alicePassword)
+		assert.True(t, errors.Is(err, ErrUnsupportedCipher))
+
+		keyStore = loadAliceKeyStore()
+		keyStore.Crypto.KDF = "pbkdf2"
+		_, err = DecryptKey(keyStore, alicePassword)
+		assert.True(t, errors.Is(err, ErrUnsupportedKDF))
+	})
+	t.Run("wrong password should error", func(t *testing.T) {
+		t.Parallel()
+
+		secretKey, err := DecryptKey(loadAliceKeyStore(), "wrong password")
+		assert.Nil(t, secretKey)
+		assert.Equal(t, ErrWrongPassword, err)
+	})
+	t.Run("tampered ciphertext should error", func(t *testing.T) {
+		t.Parallel()
+
+		keyStore := loadAliceKeyStore()
+		keyStore.Crypto.Ciphertext = "00" + keyStore.Crypto.Ciphertext[2:]
+		secretKey, err := DecryptKey(keyStore, alicePassword)
+		assert.Nil(t, secretKey)
+		assert.Equal(t, ErrWrongPassword, err)
+	})
+	t.Run("wallet key store should work", func(t *testing.T) {
+		t.Parallel()
+
+		secretKey, err := DecryptKey(loadAliceKeyStore(), alicePassword)
+		require.Nil(t, err)
+		assert.Equal(t, aliceSecretKeyHex, hex.EncodeToString(secretKey))
+	})
+}
@@ -0,0 +1,95 @@
+package converter
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	minMnemonicWords       = 12
+	maxMnemonicWords       = 24
+	mnemonicWordsStep      = 3
+	entropyBitsPerWordStep = 32
+	ed25519Curve           = "ed25519 seed"
+	hardenedOffset         = uint32(0x80000000)
+	egldCoinType           = uint32(508)
+	bip44Purpose           = uint32(44)
+)
+
+// NewMnemonic generates a new random BIP39 mnemonic having the provided number of words
+func NewMnemonic(numWords int) (string, error) {
+	if numWords < minMnemonicWords || numWords > maxMnemonicWords || numWords%mnemonicWordsStep != 0 {
+		return "", ErrInvalidNumberOfWords
+	}
+
+	entropy, err := bip39.NewEntropy(numWords / mnemonicWordsStep * entropyBitsPerWordStep)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// NormalizeMnemonic removes the extra white spaces between the words of the mnemonic and checks its checksum
+func NormalizeMnemonic(mnemonic string) (string, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(mnemonic)), " ")
+	if !bip39.IsMnemonicValid(normalized) {
+		return "", ErrInvalidMnemonic
+	}
+	// the words validation above does not check the checksum
+	_, err := bip39.EntropyFromMnemonic(normalized)
+	if err != nil {
+		return "", ErrInvalidMnemonic
+	}
+
+	return normalized, nil
+}
+
+// DeriveWalletKey derives the wallet secret key found at the m/44'/508'/0'/0'/index' path of the BIP39 seed, as
+// defined by SLIP-0010 for the ed25519 curve. The returned key is the 32 bytes ed25519 seed
+func DeriveWalletKey(mnemonic string, index uint32) ([]byte, error) {
+	if index >= hardenedOffset {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidDerivationIndex, index)
+	}
+
+	normalized, err := NormalizeMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := bip39.NewSeed(normalized, "")
+	key, chainCode := slip10MasterKey(seed)
+	path := []uint32{bip44Purpose, egldCoinType, 0, 0, index}
+	for _, segment := range path {
+		key, chainCode = slip10HardenedChild(key, chainCode, segment)
+	}
+
+	return key, nil
+}
+
+func slip10MasterKey(seed []byte) ([]byte, []byte) {
+	return hmacSHA512([]byte(ed25519Curve), seed)
+}
+
+// slip10HardenedChild derives the hardened child key, the only kind of derivation the ed25519 curve supports
+func slip10HardenedChild(key []byte, chainCode []byte, index uint32) ([]byte, []byte) {
+	data := make([]byte, 0, 1+len(key)+4)
+	data = append(data, 0)
+	data = append(data, key...)
+	data = binary.BigEndian.AppendUint32(data, index+hardenedOffset)
+
+	return hmacSHA512(chainCode, data)
+}
+
+func hmacSHA512(key []byte, data []byte) ([]byte, []byte) {
+	hasher := hmac.New(sha512.New, key)
+	_, _ = hasher.Write(data)
+	sum := hasher.Sum(nil)
+
+	return sum[:32], sum[32:]
+}
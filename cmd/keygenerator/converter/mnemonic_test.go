@@ -0,0 +1,83 @@
+package converter
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "moral volcano peasant pass circle pen over picture flat shop clap goat never lyrics gather prepare woman film husband gravity behind test tiger improve"
+
+func TestNewMnemonic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of words should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, numWords := range []int{0, 11, 13, 27} {
+			mnemonic, err := NewMnemonic(numWords)
+			assert.Empty(t, mnemonic)
+			assert.Equal(t, ErrInvalidNumberOfWords, err)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		for _, numWords := range []int{12, 24} {
+			mnemonic, err := NewMnemonic(numWords)
+			require.Nil(t, err)
+			assert.Len(t, strings.Fields(mnemonic), numWords)
+
+			_, err = NormalizeMnemonic(mnemonic)
+			assert.Nil(t, err)
+		}
+	})
+}
+
+func TestNormalizeMnemonic(t *testing.T) {
+	t.Parallel()
+
+	normalized, err := NormalizeMnemonic("  " + strings.ToUpper(strings.ReplaceAll(testMnemonic, " ", " \n\t ")) + "\n")
+	assert.Nil(t, err)
+	assert.Equal(t, testMnemonic, normalized)
+
+	words := strings.Fields(testMnemonic)
+	words[0], words[1] = words[1], words[0]
+	normalized, err = NormalizeMnemonic(strings.Join(words, " "))
+	assert.Empty(t, normalized)
+	assert.Equal(t, ErrInvalidMnemonic, err)
+}
+
+func TestDeriveWalletKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid mnemonic should error", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := DeriveWalletKey("moral volcano", 0)
+		assert.Nil(t, key)
+		assert.Equal(t, ErrInvalidMnemonic, err)
+	})
+	t.Run("hardened index should error", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := DeriveWalletKey(testMnemonic, hardenedOffset)
+		assert.Nil(t, key)
+		assert.True(t, errors.Is(err, ErrInvalidDerivationIndex))
+	})
+	t.Run("should derive the same keys as the wallets", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := DeriveWalletKey(testMnemonic, 0)
+		require.Nil(t, err)
+		assert.Equal(t, aliceSecretKeyHex[:64], hex.EncodeToString(key))
+
+		otherKey, err := DeriveWalletKey(testMnemonic, 1)
+		require.Nil(t, err)
+		assert.NotEqual(t, key, otherKey)
+	})
+}
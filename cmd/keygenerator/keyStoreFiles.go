@@ -0,0 +1,279 @@
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/cmd/keygenerator/converter"
+)
+
+const pemFormat = "pem"
+const keyStoreFormat = "keystore"
+
+var (
+	walletKeyStoreFilenameTemplate    = "walletKey%s.json"
+	validatorKeyStoreFilenameTemplate = "validatorKey%s.json"
+	p2pKeyStoreFilenameTemplate       = "p2pKey%s.json"
+)
+
+func outputKeyStores(validatorKeys, walletKeys, p2pKeys []key, consoleOut bool) error {
+	password, err := readPassword(argsConfig.passwordFile, true)
+	if err != nil {
+		return err
+	}
+
+	keyGroups := []struct {
+		keys             []key
+		filenameTemplate string
+		typeKey          string
+	}{
+		{keys: validatorKeys, filenameTemplate: validatorKeyStoreFilenameTemplate, typeKey: validatorType},
+		{keys: walletKeys, filenameTemplate: walletKeyStoreFilenameTemplate, typeKey: walletType},
+		{keys: p2pKeys, filenameTemplate: p2pKeyStoreFilenameTemplate, typeKey: p2pType},
+	}
+	for _, group := range keyGroups {
+		for i, k := range group.keys {
+			keyStore, errEncrypt := encryptKey(k, group.typeKey, password)
+			if errEncrypt != nil {
+				return errEncrypt
+			}
+
+			if consoleOut {
+				errPrint := printKeyStore(keyStore)
+				if errPrint != nil {
+					return errPrint
+				}
+				continue
+			}
+
+			file, errCreate := generateFile(i, len(group.keys), false, group.filenameTemplate)
+			if errCreate != nil {
+				return errCreate
+			}
+			errWrite := writeKeyStoreToStream(file, keyStore)
+			errClose := file.Close()
+			if errWrite != nil {
+				return errWrite
+			}
+			if errClose != nil {
+				return errClose
+			}
+		}
+	}
+
+	return nil
+}
+
+func encryptKey(k key, typeKey string, password string) (*converter.KeyStore, error) {
+	handlers, err := getKeyTypeHandlers(typeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	bech32Address := ""
+	if handlers.hasBech32 {
+		bech32Address, err = handlers.pubKeyConverter.Encode(k.pkBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return converter.EncryptKey(converter.ArgsEncryptKey{
+		SecretKey:     k.skBytes,
+		PublicKey:     k.pkBytes,
+		Bech32Address: bech32Address,
+		Password:      password,
+	})
+}
+
+func printKeyStore(keyStore *converter.KeyStore) error {
+	buff, err := json.MarshalIndent(keyStore, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	log.Info("Key store:\n" + string(buff))
+	return nil
+}
+
+func writeKeyStoreToStream(writer io.Writer, keyStore *converter.KeyStore) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(keyStore)
+}
+
+func loadKeyStore(filename string) (*converter.KeyStore, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	keyStore := &converter.KeyStore{}
+	err = json.Unmarshal(buff, keyStore)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the key store %s", err, filename)
+	}
+
+	return keyStore, nil
+}
+
+// loadKey reads the secret key and the stored public key from a PEM file or from a key store
+func loadKey(filename string, format string, index int, typeKey string) (key, error) {
+	handlers, err := getKeyTypeHandlers(typeKey)
+	if err != nil {
+		return key{}, err
+	}
+
+	switch format {
+	case pemFormat:
+		skHex, pkString, errLoad := core.LoadSkPkFromPemFile(filename, index)
+		if errLoad != nil {
+			return key{}, errLoad
+		}
+		skBytes, errLoad := hex.DecodeString(string(skHex))
+		if errLoad != nil {
+			return key{}, fmt.Errorf("%w while decoding the secret key", errLoad)
+		}
+		// the peer IDs can not be decoded back to public keys so the p2p keys are verified only against the derived key
+		pkBytes, errLoad := handlers.pubKeyConverter.Decode(pkString)
+		if errLoad != nil {
+			pkBytes = nil
+		}
+
+		return key{skBytes: skBytes, pkBytes: pkBytes}, nil
+	case keyStoreFormat:
+		keyStore, errLoad := loadKeyStore(filename)
+		if errLoad != nil {
+			return key{}, errLoad
+		}
+		password, errLoad := readPassword(argsConfig.passwordFile, false)
+		if errLoad != nil {
+			return key{}, errLoad
+		}
+		skBytes, errLoad := converter.DecryptKey(keyStore, password)
+		if errLoad != nil {
+			return key{}, errLoad
+		}
+		pkBytes, errLoad := hex.DecodeString(keyStore.Address)
+		if errLoad != nil {
+			return key{}, fmt.Errorf("%w while decoding the key store address", errLoad)
+		}
+
+		return key{skBytes: skBytes, pkBytes: pkBytes}, nil
+	case mnemonicFormat:
+		if typeKey != walletType {
+			return key{}, fmt.Errorf("only the %s keys can be derived from a mnemonic", walletType)
+		}
+		mnemonic, errLoad := loadMnemonic(filename)
+		if errLoad != nil {
+			return key{}, errLoad
+		}
+		if index < 0 || index > math.MaxInt32 {
+			return key{}, fmt.Errorf("%w: %d", converter.ErrInvalidDerivationIndex, index)
+		}
+
+		return deriveWalletKey(handlers.keyGen, mnemonic, uint32(index))
+	default:
+		return key{}, fmt.Errorf("unknown key format %s, available options: %s, %s, %s", format, pemFormat, keyStoreFormat, mnemonicFormat)
+	}
+}
+
+// saveKey writes the key in the provided format. The file is overwritten after making a timestamped backup
+func saveKey(filename string, format string, k key, typeKey string) error {
+	handlers, err := getKeyTypeHandlers(typeKey)
+	if err != nil {
+		return err
+	}
+
+	var keyStore *converter.KeyStore
+	switch format {
+	case pemFormat:
+	case keyStoreFormat:
+		password, errPassword := readPassword(argsConfig.outputPasswordFile, true)
+		if errPassword != nil {
+			return errPassword
+		}
+		keyStore, err = encryptKey(k, typeKey, password)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown key format %s, available options: %s, %s", format, pemFormat, keyStoreFormat)
+	}
+
+	absPath, err := filepath.Abs(filename)
+	if err != nil {
+		return err
+	}
+	ext := filepath.Ext(absPath)
+	filenameTemplate := strings.TrimSuffix(absPath, ext) + "%s" + ext
+	backupFileIfExists(filenameTemplate)
+
+	file, err := os.OpenFile(absPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, core.FileModeReadWrite)
+	if err != nil {
+		return err
+	}
+
+	if keyStore != nil {
+		err = writeKeyStoreToStream(file, keyStore)
+	} else {
+		err = writeKeyToStream(file, k, handlers.pubKeyConverter)
+	}
+	errClose := file.Close()
+	if err != nil {
+		return err
+	}
+
+	return errClose
+}
+
+// readPassword reads the first line of the password file or, if no file was provided, asks for the password on the
+// standard input
+func readPassword(passwordFile string, confirm bool) (string, error) {
+	if len(passwordFile) > 0 {
+		buff, err := os.ReadFile(passwordFile)
+		if err != nil {
+			return "", err
+		}
+
+		return strings.TrimRight(strings.SplitN(string(buff), "\n", 2)[0], "\r"), nil
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	password, err := promptLine(reader, "Password: ")
+	if err != nil {
+		return "", err
+	}
+	if !confirm {
+		return password, nil
+	}
+
+	confirmation, err := promptLine(reader, "Repeat password: ")
+	if err != nil {
+		return "", err
+	}
+	if password != confirmation {
+		return "", fmt.Errorf("the passwords do not match")
+	}
+
+	return password, nil
+}
+
+func promptLine(reader *bufio.Reader, message string) (string, error) {
+	_, _ = fmt.Fprint(os.Stderr, message)
+	line, err := reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	ed25519SingleSig "github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclSingleSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/secp256k1"
+	secp256k1SingleSig "github.com/multiversx/mx-chain-crypto-go/signing/secp256k1/singlesig"
+)
+
+// keyTypeHandlers holds the components needed for handling an existing key of a certain type
+type keyTypeHandlers struct {
+	keyGen          crypto.KeyGenerator
+	signer          crypto.SingleSigner
+	pubKeyConverter pubKeyConverter
+	hasBech32       bool
+}
+
+func getKeyTypeHandlers(typeKey string) (*keyTypeHandlers, error) {
+	switch typeKey {
+	case validatorType:
+		return &keyTypeHandlers{
+			keyGen:          signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+			signer:          mclSingleSig.NewBlsSigner(),
+			pubKeyConverter: validatorPubKeyConverter,
+		}, nil
+	case walletType:
+		return &keyTypeHandlers{
+			keyGen:          signing.NewKeyGenerator(ed25519.NewEd25519()),
+			signer:          &ed25519SingleSig.Ed25519Signer{},
+			pubKeyConverter: walletPubKeyConverter,
+			hasBech32:       true,
+		}, nil
+	case p2pType:
+		return &keyTypeHandlers{
+			keyGen:          signing.NewKeyGenerator(secp256k1.NewSecp256k1()),
+			signer:          &secp256k1SingleSig.Secp256k1Signer{},
+			pubKeyConverter: pidPubKeyConverter,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown key type %s, available options: %s, %s, %s", typeKey, validatorType, walletType, p2pType)
+	}
+}
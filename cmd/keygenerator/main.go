@@ -16,7 +16,6 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
-	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-crypto-go/signing/secp256k1"
 	"github.com/multiversx/mx-chain-go/cmd/keygenerator/converter"
@@ -31,6 +30,18 @@ type cfg struct {
 	noSplit       bool
 	prefixPattern string
 	shardIDByte   int
+
+	outputFormat       string
+	passwordFile       string
+	outputPasswordFile string
+	inputFile          string
+	inputFormat        string
+	outputFile         string
+	keyIndex           int
+	publicKey          string
+	mnemonicFile       string
+	startIndex         uint
+	numWords           int
 }
 
 const validatorType = "validator"
@@ -44,8 +55,10 @@ const noshard = -1
 const pubkeyHrp = "erd"
 
 type key struct {
-	skBytes []byte
-	pkBytes []byte
+	skBytes         []byte
+	pkBytes         []byte
+	isDerived       bool
+	derivationIndex uint32
 }
 
 type pubKeyConverter interface {
@@ -62,11 +75,14 @@ var (
 	fileGenHelpTemplate = `NAME:
    {{.Name}} - {{.Usage}}
 USAGE:
-   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}{{if .Commands}} command [command options]{{end}}
    {{if len .Authors}}
 AUTHOR:
    {{range .Authors}}{{ . }}{{end}}
    {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
 GLOBAL OPTIONS:
    {{range .VisibleFlags}}{{.}}
    {{end}}
@@ -123,6 +139,33 @@ VERSION:
 		Value:       -1,
 		Destination: &argsConfig.shardIDByte,
 	}
+	// outputFormat defines a flag for the format of the generated or converted keys
+	outputFormat = cli.StringFlag{
+		Name: "output-format",
+		Usage: fmt.Sprintf("The format of the keys. Available options: %s, %s (password protected JSON, as used by the wallets)",
+			pemFormat, keyStoreFormat),
+		Value:       pemFormat,
+		Destination: &argsConfig.outputFormat,
+	}
+	// passwordFile defines a flag for the file holding the password of a key store
+	passwordFile = cli.StringFlag{
+		Name:        "password-file",
+		Usage:       "The `file` holding the password of the key store. If not provided, the password is read from the standard input",
+		Destination: &argsConfig.passwordFile,
+	}
+	// mnemonicFile defines a flag for the file holding the mnemonic the wallet keys are derived from
+	mnemonicFile = cli.StringFlag{
+		Name: "mnemonic-file",
+		Usage: "The `file` holding the BIP39 mnemonic the wallet keys are derived from, on the m/44'/508'/0'/0'/index' path." +
+			" If provided, the wallet keys are not randomly generated and the mined wallet keys are searched by derivation index",
+		Destination: &argsConfig.mnemonicFile,
+	}
+	// startIndex defines a flag for the first derivation index used with the mnemonic
+	startIndex = cli.UintFlag{
+		Name:        "start-index",
+		Usage:       "The first derivation `index` used for deriving the wallet keys from the mnemonic",
+		Destination: &argsConfig.startIndex,
+	}
 	argsConfig = &cfg{}
 
 	walletKeyFilenameTemplate    = "walletKey%s.pem"
@@ -155,6 +198,16 @@ func main() {
 		noSplit,
 		shardIDByte,
 		keyPrefix,
+		outputFormat,
+		passwordFile,
+		mnemonicFile,
+		startIndex,
+	}
+	app.Commands = []cli.Command{
+		mnemonicCommand,
+		convertCommand,
+		publicKeyCommand,
+		verifyCommand,
 	}
 
 	app.Action = func(_ *cli.Context) error {
@@ -170,15 +223,22 @@ func main() {
 }
 
 func process() error {
-	validatorKeys, walletKeys, p2pKeys, err := generateKeys(argsConfig.keyType, argsConfig.numKeys, argsConfig.prefixPattern, argsConfig.shardIDByte)
+	walletKeysGenerator, err := createWalletKeysGenerator(argsConfig.mnemonicFile, argsConfig.startIndex)
 	if err != nil {
 		return err
 	}
 
+	validatorKeys, walletKeys, p2pKeys, err := generateKeys(argsConfig.keyType, argsConfig.numKeys, argsConfig.prefixPattern, argsConfig.shardIDByte, walletKeysGenerator)
+	if err != nil {
+		return err
+	}
+
+	logDerivedKeys(walletKeys)
+
 	return outputKeys(validatorKeys, walletKeys, p2pKeys, argsConfig.consoleOut, argsConfig.noSplit)
 }
 
-func generateKeys(typeKey string, numKeys int, prefix string, shardID int) ([]key, []key, []key, error) {
+func generateKeys(typeKey string, numKeys int, prefix string, shardID int, walletKeysGenerator keysGenerator) ([]key, []key, []key, error) {
 	if numKeys < 1 {
 		return nil, nil, nil, fmt.Errorf("number of keys should be a number greater or equal to 1")
 	}
@@ -189,7 +249,6 @@ func generateKeys(typeKey string, numKeys int, prefix string, shardID int) ([]ke
 	var err error
 
 	blockSigningGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
-	txSigningGenerator := signing.NewKeyGenerator(ed25519.NewEd25519())
 	p2pKeyGenerator := signing.NewKeyGenerator(secp256k1.NewSecp256k1())
 
 	for i := 0; i < numKeys; i++ {
@@ -200,7 +259,7 @@ func generateKeys(typeKey string, numKeys int, prefix string, shardID int) ([]ke
 				return nil, nil, nil, err
 			}
 		case walletType:
-			walletKeys, err = generateKey(txSigningGenerator, walletKeys)
+			walletKeys, err = walletKeysGenerator(walletKeys)
 			if err != nil {
 				return nil, nil, nil, err
 			}
@@ -216,13 +275,13 @@ func generateKeys(typeKey string, numKeys int, prefix string, shardID int) ([]ke
 				return nil, nil, nil, err
 			}
 
-			walletKeys, err = generateKey(txSigningGenerator, walletKeys)
+			walletKeys, err = walletKeysGenerator(walletKeys)
 			if err != nil {
 				return nil, nil, nil, err
 			}
 
 		case minedWalletPrefixKeys:
-			walletKeys, err = generateMinedWalletKeys(txSigningGenerator, walletKeys, prefix, shardID)
+			walletKeys, err = generateMinedWalletKeys(walletKeysGenerator, walletKeys, prefix, shardID)
 			if err != nil {
 				return nil, nil, nil, err
 			}
@@ -257,7 +316,7 @@ func generateKey(keyGen crypto.KeyGenerator, list []key) ([]key, error) {
 	return list, nil
 }
 
-func generateMinedWalletKeys(keyGen crypto.KeyGenerator, list []key, startingHexPattern string, shardID int) ([]key, error) {
+func generateMinedWalletKeys(walletKeysGenerator keysGenerator, list []key, startingHexPattern string, shardID int) ([]key, error) {
 	isPatternProvided := nopattern != startingHexPattern
 	withPreferredShard := shardID != noshard && shardID >= 0 && shardID <= 255
 	var patternHexBytes []byte
@@ -275,7 +334,7 @@ func generateMinedWalletKeys(keyGen crypto.KeyGenerator, list []key, startingHex
 		if nbTrials%printDeltaTrials == 0 {
 			log.Info("mining address...", "trials", nbTrials)
 		}
-		keys, errKey := generateKey(keyGen, list)
+		keys, errKey := walletKeysGenerator(list)
 		if errKey != nil {
 			return nil, errKey
 		}
@@ -309,6 +368,18 @@ func outputKeys(
 	consoleOut bool,
 	noSplit bool,
 ) error {
+	switch argsConfig.outputFormat {
+	case pemFormat:
+	case keyStoreFormat:
+		if noSplit {
+			return fmt.Errorf("a key store holds only one key, the no-split flag can not be used with the %s output format", keyStoreFormat)
+		}
+
+		return outputKeyStores(validatorKeys, walletKeys, p2pKeys, consoleOut)
+	default:
+		return fmt.Errorf("unknown output format %s", argsConfig.outputFormat)
+	}
+
 	if consoleOut {
 		return printKeys(validatorKeys, walletKeys, p2pKeys)
 	}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-go/cmd/keygenerator/converter"
+	"github.com/urfave/cli"
+)
+
+const mnemonicFormat = "mnemonic"
+const defaultMnemonicWords = 24
+
+// keysGenerator appends a new key to the provided list
+type keysGenerator func(list []key) ([]key, error)
+
+var (
+	// numWords defines a flag for the number of words of a new mnemonic
+	numWords = cli.IntFlag{
+		Name:        "num-words",
+		Usage:       "The number of words of the new mnemonic. Available options: 12, 15, 18, 21, 24",
+		Value:       defaultMnemonicWords,
+		Destination: &argsConfig.numWords,
+	}
+	// mnemonicOutputFile defines a flag for the file the new mnemonic is written to
+	mnemonicOutputFile = cli.StringFlag{
+		Name:        "output",
+		Usage:       "The `file` the new mnemonic is written to. If not provided, the mnemonic is printed on the console",
+		Destination: &argsConfig.outputFile,
+	}
+
+	mnemonicCommand = cli.Command{
+		Name:  "mnemonic",
+		Usage: "Generates a new BIP39 mnemonic the wallet keys can be derived from",
+		Flags: []cli.Flag{numWords, mnemonicOutputFile},
+		Action: func(_ *cli.Context) error {
+			return generateMnemonic()
+		},
+	}
+)
+
+func generateMnemonic() error {
+	mnemonic, err := converter.NewMnemonic(argsConfig.numWords)
+	if err != nil {
+		return err
+	}
+
+	if len(argsConfig.outputFile) == 0 {
+		log.Info("Mnemonic:\n" + mnemonic)
+		return nil
+	}
+
+	_, err = os.Stat(argsConfig.outputFile)
+	if err == nil {
+		return fmt.Errorf("the file %s already exists, the mnemonic was not written", argsConfig.outputFile)
+	}
+
+	err = os.WriteFile(argsConfig.outputFile, []byte(mnemonic+"\n"), core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	log.Info("mnemonic written", "file", argsConfig.outputFile)
+	return nil
+}
+
+func loadMnemonic(filename string) (string, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+
+	return converter.NormalizeMnemonic(string(buff))
+}
+
+// createWalletKeysGenerator returns the generator of random wallet keys or, if a mnemonic file is provided, the
+// generator deriving the wallet keys at consecutive indexes, starting with the provided one
+func createWalletKeysGenerator(mnemonicFile string, startIndex uint) (keysGenerator, error) {
+	txSigningGenerator := signing.NewKeyGenerator(ed25519.NewEd25519())
+	if len(mnemonicFile) == 0 {
+		return func(list []key) ([]key, error) {
+			return generateKey(txSigningGenerator, list)
+		}, nil
+	}
+
+	mnemonic, err := loadMnemonic(mnemonicFile)
+	if err != nil {
+		return nil, err
+	}
+	if startIndex > math.MaxInt32 {
+		return nil, fmt.Errorf("%w: %d", converter.ErrInvalidDerivationIndex, startIndex)
+	}
+
+	nextIndex := uint32(startIndex)
+	return func(list []key) ([]key, error) {
+		k, errDerive := deriveWalletKey(txSigningGenerator, mnemonic, nextIndex)
+		if errDerive != nil {
+			return nil, errDerive
+		}
+		nextIndex++
+
+		return append(list, k), nil
+	}, nil
+}
+
+func deriveWalletKey(keyGen crypto.KeyGenerator, mnemonic string, index uint32) (key, error) {
+	seed, err := converter.DeriveWalletKey(mnemonic, index)
+	if err != nil {
+		return key{}, err
+	}
+
+	sk, err := keyGen.PrivateKeyFromByteArray(seed)
+	if err != nil {
+		return key{}, err
+	}
+	skBytes, err := sk.ToByteArray()
+	if err != nil {
+		return key{}, err
+	}
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return key{}, err
+	}
+
+	return key{
+		skBytes:         skBytes,
+		pkBytes:         pkBytes,
+		isDerived:       true,
+		derivationIndex: index,
+	}, nil
+}
+
+func logDerivedKeys(keys []key) {
+	for _, k := range keys {
+		if !k.isDerived {
+			continue
+		}
+
+		address, err := walletPubKeyConverter.Encode(k.pkBytes)
+		if err != nil {
+			log.Warn("cannot encode the derived wallet key", "index", k.derivationIndex, "error", err)
+			continue
+		}
+
+		log.Info("derived wallet key", "index", k.derivationIndex, "address", address)
+	}
+}
@@ -8,12 +8,14 @@ import (
 	"os/signal"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/cmd/logviewer/offline"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
@@ -34,6 +36,15 @@ type config struct {
 	useWss             bool
 	logWithCorrelation bool
 	logWithLoggerName  bool
+	inputFiles         string
+	filterLevel        string
+	filterLoggers      string
+	filterShard        string
+	filterEpochs       string
+	filterRounds       string
+	filterRegex        string
+	outputFormat       string
+	outputFile         string
 }
 
 var (
@@ -100,6 +111,65 @@ VERSION:
 		Value:       "",
 		Destination: &argsConfig.workingDir,
 	}
+	// inputFiles defines a flag for the saved log files to be opened in offline mode
+	inputFiles = cli.StringFlag{
+		Name: "input-files",
+		Usage: "Comma separated list of saved log `files` to be opened in offline mode, instead of connecting to the" +
+			" mx-chain-go node. Glob patterns are accepted. The entries of multiple files are merged by their timestamp.",
+		Destination: &argsConfig.inputFiles,
+	}
+	// filterLevel defines a flag for the minimum level of the log entries displayed in offline mode
+	filterLevel = cli.StringFlag{
+		Name:        "filter-level",
+		Usage:       "The minimum `level` of the log entries displayed in offline mode.",
+		Destination: &argsConfig.filterLevel,
+	}
+	// filterLoggers defines a flag for the logger names of the log entries displayed in offline mode
+	filterLoggers = cli.StringFlag{
+		Name: "filter-loggers",
+		Usage: "Comma separated list of logger `names` whose entries are displayed in offline mode. A logger matches" +
+			" if its name contains any of the provided names. Requires logs saved with logger names.",
+		Destination: &argsConfig.filterLoggers,
+	}
+	// filterShard defines a flag for the shard correlation element of the log entries displayed in offline mode
+	filterShard = cli.StringFlag{
+		Name:        "filter-shard",
+		Usage:       "The `shard` of the log entries displayed in offline mode. Requires logs saved with correlation elements.",
+		Destination: &argsConfig.filterShard,
+	}
+	// filterEpochs defines a flag for the epoch correlation element of the log entries displayed in offline mode
+	filterEpochs = cli.StringFlag{
+		Name: "filter-epochs",
+		Usage: "The `epoch` or the inclusive epochs range, as in 3-5, of the log entries displayed in offline mode." +
+			" Requires logs saved with correlation elements.",
+		Destination: &argsConfig.filterEpochs,
+	}
+	// filterRounds defines a flag for the round correlation element of the log entries displayed in offline mode
+	filterRounds = cli.StringFlag{
+		Name: "filter-rounds",
+		Usage: "The `round` or the inclusive rounds range, as in 1200-1210, of the log entries displayed in offline" +
+			" mode. Requires logs saved with correlation elements.",
+		Destination: &argsConfig.filterRounds,
+	}
+	// filterRegex defines a flag for the regular expression the log entries displayed in offline mode must match
+	filterRegex = cli.StringFlag{
+		Name:        "filter-regex",
+		Usage:       "The regular `expression` the message and arguments of the log entries displayed in offline mode must match.",
+		Destination: &argsConfig.filterRegex,
+	}
+	// outputFormat defines a flag for the format of the log entries displayed in offline mode
+	outputFormat = cli.StringFlag{
+		Name:        "output-format",
+		Usage:       "The `format` of the log entries displayed in offline mode. Can be plain, json or csv.",
+		Value:       offline.PlainFormat,
+		Destination: &argsConfig.outputFormat,
+	}
+	// outputFile defines a flag for the file the log entries are exported to in offline mode
+	outputFile = cli.StringFlag{
+		Name:        "output-file",
+		Usage:       "The `file` the log entries are exported to in offline mode. If not set, the entries are written to the standard output.",
+		Destination: &argsConfig.outputFile,
+	}
 
 	argsConfig = &config{}
 
@@ -116,6 +186,10 @@ func main() {
 	marshalizer = &marshal.GogoProtoMarshalizer{}
 
 	cliApp.Action = func(c *cli.Context) error {
+		if c.IsSet(inputFiles.Name) {
+			return startOfflineLogViewer()
+		}
+
 		return startLogViewer(c)
 	}
 
@@ -131,7 +205,8 @@ func initCliFlags() {
 	cli.AppHelpTemplate = nodeHelpTemplate
 	cliApp.Name = "MultiversX Logviewer App"
 	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
-	cliApp.Usage = "Logviewer application used to communicate with mx-chain-go node to log the message lines"
+	cliApp.Usage = "Logviewer application used to communicate with mx-chain-go node to log the message lines or to" +
+		" filter, search and export saved log files"
 	cliApp.Flags = []cli.Flag{
 		address,
 		logLevel,
@@ -140,6 +215,15 @@ func initCliFlags() {
 		useWss,
 		logWithCorrelation,
 		logWithLoggerName,
+		inputFiles,
+		filterLevel,
+		filterLoggers,
+		filterShard,
+		filterEpochs,
+		filterRounds,
+		filterRegex,
+		outputFormat,
+		outputFile,
 	}
 	cliApp.Authors = []cli.Author{
 		{
@@ -218,6 +302,79 @@ func startLogViewer(ctx *cli.Context) error {
 	return nil
 }
 
+func startOfflineLogViewer() error {
+	paths, err := expandInputFiles(argsConfig.inputFiles)
+	if err != nil {
+		return err
+	}
+
+	output := os.Stdout
+	if len(argsConfig.outputFile) > 0 {
+		output, err = os.Create(argsConfig.outputFile)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = output.Close()
+		}()
+	}
+
+	stats, err := offline.ProcessLogFiles(offline.ArgsOfflineViewer{
+		InputFiles: paths,
+		Filter: offline.ArgsLogEntryFilter{
+			MinLevel:    argsConfig.filterLevel,
+			LoggerNames: splitList(argsConfig.filterLoggers),
+			Shard:       argsConfig.filterShard,
+			Epochs:      argsConfig.filterEpochs,
+			Rounds:      argsConfig.filterRounds,
+			Regex:       argsConfig.filterRegex,
+		},
+		OutputFormat: argsConfig.outputFormat,
+		Output:       output,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(argsConfig.outputFile) > 0 {
+		log.Info("log entries exported", "file", argsConfig.outputFile, "format", argsConfig.outputFormat,
+			"num read entries", stats.NumReadEntries, "num exported entries", stats.NumMatchingEntries)
+	}
+
+	return nil
+}
+
+// expandInputFiles resolves the glob patterns of the provided files list. The files not matching any pattern
+// are kept as they are, so opening them will report the error
+func expandInputFiles(files string) ([]string, error) {
+	paths := make([]string, 0)
+	for _, pattern := range splitList(files) {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%w for input files pattern %s", err, pattern)
+		}
+		if len(matches) == 0 {
+			matches = []string{pattern}
+		}
+
+		paths = append(paths, matches...)
+	}
+
+	return paths, nil
+}
+
+func splitList(list string) []string {
+	values := make([]string, 0)
+	for _, value := range strings.Split(list, ",") {
+		value = strings.TrimSpace(value)
+		if len(value) > 0 {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
 func getLowestLogLevel(logLevels []logger.LogLevel) logger.LogLevel {
 	lowest := logLevels[0]
 	for i := 1; i < len(logLevels); i++ {
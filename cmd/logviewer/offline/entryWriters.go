@@ -0,0 +1,179 @@
+package offline
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// PlainFormat outputs the log entries as they were saved
+	PlainFormat = "plain"
+	// JSONFormat outputs the log entries as a JSON array
+	JSONFormat = "json"
+	// CSVFormat outputs the log entries as CSV records, preceded by a header record
+	CSVFormat = "csv"
+)
+
+var csvHeader = []string{"source", "timestamp", "level", "logger", "shard", "epoch", "round", "subRound", "message"}
+
+type entryWriter interface {
+	write(entry *LogEntry) error
+	close() error
+}
+
+func createEntryWriter(format string, output io.Writer, withSource bool) (entryWriter, error) {
+	switch format {
+	case PlainFormat, "":
+		return &plainWriter{output: output, withSource: withSource}, nil
+	case JSONFormat:
+		return &jsonWriter{output: output}, nil
+	case CSVFormat:
+		return &csvWriter{writer: csv.NewWriter(output)}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExportFormat, format)
+	}
+}
+
+// plainWriter outputs the log entries as they were saved, prefixed by their file name when merging multiple files
+type plainWriter struct {
+	output     io.Writer
+	withSource bool
+}
+
+func (writer *plainWriter) write(entry *LogEntry) error {
+	var err error
+	if writer.withSource {
+		_, err = fmt.Fprintf(writer.output, "[%s] %s\n", entry.Source, entry.Text)
+	} else {
+		_, err = fmt.Fprintln(writer.output, entry.Text)
+	}
+
+	return err
+}
+
+func (writer *plainWriter) close() error {
+	return nil
+}
+
+type exportedCorrelation struct {
+	Shard    string `json:"shard"`
+	Epoch    uint32 `json:"epoch"`
+	Round    int64  `json:"round"`
+	SubRound string `json:"subRound"`
+}
+
+type exportedEntry struct {
+	Source      string               `json:"source"`
+	Timestamp   string               `json:"timestamp"`
+	Level       string               `json:"level"`
+	Logger      string               `json:"logger,omitempty"`
+	Correlation *exportedCorrelation `json:"correlation,omitempty"`
+	Message     string               `json:"message"`
+}
+
+// jsonWriter outputs the log entries as a JSON array, one entry per line
+type jsonWriter struct {
+	output     io.Writer
+	numWritten int
+}
+
+func (writer *jsonWriter) write(entry *LogEntry) error {
+	exported := exportedEntry{
+		Source:    entry.Source,
+		Timestamp: entry.Timestamp.Format(time.RFC3339Nano),
+		Level:     levelToString(entry),
+		Logger:    entry.LoggerName,
+		Message:   entry.Message,
+	}
+	if entry.HasCorrelation {
+		exported.Correlation = &exportedCorrelation{
+			Shard:    entry.Shard,
+			Epoch:    entry.Epoch,
+			Round:    entry.Round,
+			SubRound: entry.SubRound,
+		}
+	}
+
+	buff, err := json.Marshal(exported)
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n"
+	if writer.numWritten == 0 {
+		separator = "[\n"
+	}
+	writer.numWritten++
+
+	_, err = fmt.Fprintf(writer.output, "%s%s", separator, buff)
+
+	return err
+}
+
+func (writer *jsonWriter) close() error {
+	if writer.numWritten == 0 {
+		_, err := fmt.Fprintln(writer.output, "[]")
+		return err
+	}
+
+	_, err := fmt.Fprintln(writer.output, "\n]")
+
+	return err
+}
+
+// csvWriter outputs the log entries as CSV records. The correlation columns are empty for the entries without them
+type csvWriter struct {
+	writer          *csv.Writer
+	isHeaderWritten bool
+}
+
+func (writer *csvWriter) write(entry *LogEntry) error {
+	if !writer.isHeaderWritten {
+		err := writer.writer.Write(csvHeader)
+		if err != nil {
+			return err
+		}
+		writer.isHeaderWritten = true
+	}
+
+	shard, epoch, round := "", "", ""
+	if entry.HasCorrelation {
+		shard = entry.Shard
+		epoch = strconv.FormatUint(uint64(entry.Epoch), 10)
+		round = strconv.FormatInt(entry.Round, 10)
+	}
+
+	return writer.writer.Write([]string{
+		entry.Source,
+		entry.Timestamp.Format(time.RFC3339Nano),
+		levelToString(entry),
+		entry.LoggerName,
+		shard,
+		epoch,
+		round,
+		entry.SubRound,
+		entry.Message,
+	})
+}
+
+func (writer *csvWriter) close() error {
+	if !writer.isHeaderWritten {
+		err := writer.writer.Write(csvHeader)
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.writer.Flush()
+
+	return writer.writer.Error()
+}
+
+func levelToString(entry *LogEntry) string {
+	return strings.TrimSpace(entry.Level.String())
+}
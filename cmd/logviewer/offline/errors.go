@@ -0,0 +1,15 @@
+package offline
+
+import "errors"
+
+// ErrNoInputFiles signals that no saved log file was provided
+var ErrNoInputFiles = errors.New("no input files")
+
+// ErrInvalidRange signals that an invalid range was provided
+var ErrInvalidRange = errors.New("invalid range")
+
+// ErrInvalidExportFormat signals that an invalid export format was provided
+var ErrInvalidExportFormat = errors.New("invalid export format")
+
+// ErrNilOutput signals that a nil output writer was provided
+var ErrNilOutput = errors.New("nil output")
@@ -0,0 +1,111 @@
+package offline
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	timestampLayout         = "2006-01-02 15:04:05.000"
+	loggerNameFieldLength   = 20
+	correlationFieldLength  = 14
+	fieldsSeparator         = " "
+	continuationLinesJoiner = "\n"
+)
+
+// headerLineRegex matches the first line of a log entry written by the logger's plain formatter:
+// LEVEL[timestamp] [logger name] [shard/epoch/round/subround] message args
+var headerLineRegex = regexp.MustCompile(`^(TRACE|DEBUG|INFO |WARN |ERROR|NONE )\[(\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}\.\d{3})\] (.*)$`)
+
+var correlationRegex = regexp.MustCompile(`^\[([^/\]]*)/(\d+)/(-?\d+)/([^\]]*)\]`)
+
+// LogEntry holds a log entry read from a saved log file
+type LogEntry struct {
+	Source         string
+	Level          logger.LogLevel
+	Timestamp      time.Time
+	LoggerName     string
+	HasCorrelation bool
+	Shard          string
+	Epoch          uint32
+	Round          int64
+	SubRound       string
+	Message        string
+	Text           string
+}
+
+// parseHeaderLine parses the first line of a log entry. It returns false if the line is not the start of
+// a log entry, as is the case of the continuation lines of multi-line messages
+func parseHeaderLine(line string, source string) (*LogEntry, bool) {
+	matches := headerLineRegex.FindStringSubmatch(line)
+	if matches == nil {
+		return nil, false
+	}
+
+	level, err := logger.GetLogLevel(matches[1])
+	if err != nil {
+		return nil, false
+	}
+	timestamp, err := time.ParseInLocation(timestampLayout, matches[2], time.Local)
+	if err != nil {
+		return nil, false
+	}
+
+	entry := &LogEntry{
+		Source:    source,
+		Level:     level,
+		Timestamp: timestamp,
+		Text:      line,
+	}
+
+	// the disabled logger name and correlation fields are written as empty strings, still followed by the separator
+	rest := matches[3]
+	if strings.HasPrefix(rest, "[") {
+		end := strings.Index(rest, "]")
+		if end > 0 {
+			entry.LoggerName = rest[1:end]
+			rest = skipField(rest, end+1, loggerNameFieldLength)
+		}
+	} else {
+		rest = strings.TrimPrefix(rest, fieldsSeparator)
+	}
+
+	correlation := correlationRegex.FindStringSubmatch(rest)
+	if correlation != nil {
+		entry.HasCorrelation = true
+		entry.Shard = correlation[1]
+		epoch, _ := strconv.ParseUint(correlation[2], 10, 32)
+		entry.Epoch = uint32(epoch)
+		entry.Round, _ = strconv.ParseInt(correlation[3], 10, 64)
+		entry.SubRound = correlation[4]
+		rest = skipField(rest, len(correlation[0]), correlationFieldLength)
+	} else {
+		rest = strings.TrimPrefix(rest, fieldsSeparator)
+	}
+
+	entry.Message = strings.TrimRight(rest, fieldsSeparator)
+
+	return entry, true
+}
+
+// skipField removes a right padded field and its separator from the beginning of the provided string
+func skipField(str string, fieldLength int, paddedLength int) string {
+	if fieldLength < paddedLength {
+		fieldLength = paddedLength
+	}
+	if fieldLength > len(str) {
+		return ""
+	}
+
+	return strings.TrimPrefix(str[fieldLength:], fieldsSeparator)
+}
+
+// appendContinuationLine adds a line of a multi-line message to the log entry
+func (entry *LogEntry) appendContinuationLine(line string) {
+	entry.Message += continuationLinesJoiner + line
+	entry.Text += continuationLinesJoiner + line
+}
@@ -0,0 +1,165 @@
+package offline
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const rangeSeparator = "-"
+
+// ArgsLogEntryFilter holds the criteria a log entry must match in order to be output. The empty criteria match
+// all the entries, while the correlation criteria match only the entries holding the correlation elements
+type ArgsLogEntryFilter struct {
+	MinLevel    string
+	LoggerNames []string
+	Shard       string
+	Epochs      string
+	Rounds      string
+	Regex       string
+}
+
+// valuesRange holds an inclusive range of values
+type valuesRange struct {
+	isSet bool
+	min   int64
+	max   int64
+}
+
+func (vr valuesRange) contains(value int64) bool {
+	return !vr.isSet || (value >= vr.min && value <= vr.max)
+}
+
+type logEntryFilter struct {
+	minLevel    logger.LogLevel
+	loggerNames []string
+	shard       string
+	epochs      valuesRange
+	rounds      valuesRange
+	regex       *regexp.Regexp
+}
+
+func newLogEntryFilter(args ArgsLogEntryFilter) (*logEntryFilter, error) {
+	filter := &logEntryFilter{
+		minLevel:    logger.LogTrace,
+		loggerNames: make([]string, 0, len(args.LoggerNames)),
+		shard:       args.Shard,
+	}
+
+	var err error
+	if len(args.MinLevel) > 0 {
+		filter.minLevel, err = logger.GetLogLevel(args.MinLevel)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range args.LoggerNames {
+		name = strings.TrimSpace(name)
+		if len(name) > 0 {
+			filter.loggerNames = append(filter.loggerNames, name)
+		}
+	}
+
+	filter.epochs, err = parseRange(args.Epochs)
+	if err != nil {
+		return nil, fmt.Errorf("%w for epochs", err)
+	}
+	filter.rounds, err = parseRange(args.Rounds)
+	if err != nil {
+		return nil, fmt.Errorf("%w for rounds", err)
+	}
+
+	if len(args.Regex) > 0 {
+		filter.regex, err = regexp.Compile(args.Regex)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return filter, nil
+}
+
+// parseRange parses a single value, as in 5, or an inclusive range of values, as in 5-10
+func parseRange(value string) (valuesRange, error) {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return valuesRange{}, nil
+	}
+
+	minValue, maxValue := value, value
+	separatorIndex := strings.Index(value, rangeSeparator)
+	if separatorIndex > 0 {
+		minValue, maxValue = value[:separatorIndex], value[separatorIndex+1:]
+	}
+
+	parsedMin, err := strconv.ParseInt(strings.TrimSpace(minValue), 10, 64)
+	if err != nil {
+		return valuesRange{}, fmt.Errorf("%w %s: %s", ErrInvalidRange, value, err.Error())
+	}
+	parsedMax, err := strconv.ParseInt(strings.TrimSpace(maxValue), 10, 64)
+	if err != nil {
+		return valuesRange{}, fmt.Errorf("%w %s: %s", ErrInvalidRange, value, err.Error())
+	}
+	if parsedMin > parsedMax {
+		return valuesRange{}, fmt.Errorf("%w %s: the start is greater than the end", ErrInvalidRange, value)
+	}
+
+	return valuesRange{
+		isSet: true,
+		min:   parsedMin,
+		max:   parsedMax,
+	}, nil
+}
+
+func (filter *logEntryFilter) hasCorrelationCriteria() bool {
+	return len(filter.shard) > 0 || filter.epochs.isSet || filter.rounds.isSet
+}
+
+// isMatch returns true if the log entry matches all the criteria
+func (filter *logEntryFilter) isMatch(entry *LogEntry) bool {
+	if entry.Level < filter.minLevel {
+		return false
+	}
+	if !filter.isLoggerNameMatch(entry.LoggerName) {
+		return false
+	}
+	if filter.hasCorrelationCriteria() && !filter.isCorrelationMatch(entry) {
+		return false
+	}
+	if filter.regex != nil && !filter.regex.MatchString(entry.Message) {
+		return false
+	}
+
+	return true
+}
+
+// isLoggerNameMatch checks the logger name against the configured names. The saved logs hold the long logger
+// names truncated, so a name matches if it contains any of the configured names
+func (filter *logEntryFilter) isLoggerNameMatch(loggerName string) bool {
+	if len(filter.loggerNames) == 0 {
+		return true
+	}
+
+	for _, name := range filter.loggerNames {
+		if strings.Contains(loggerName, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (filter *logEntryFilter) isCorrelationMatch(entry *LogEntry) bool {
+	if !entry.HasCorrelation {
+		return false
+	}
+	if len(filter.shard) > 0 && entry.Shard != filter.shard {
+		return false
+	}
+
+	return filter.epochs.contains(int64(entry.Epoch)) && filter.rounds.contains(entry.Round)
+}
@@ -0,0 +1,108 @@
+package offline
+
+import (
+	"errors"
+	"testing"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/require"
+)
+
+func createLogEntry() *LogEntry {
+	return &LogEntry{
+		Level:          logger.LogDebug,
+		LoggerName:     "..ensus/spos/bls",
+		HasCorrelation: true,
+		Shard:          "1",
+		Epoch:          4,
+		Round:          1205,
+		Message:        "received proposed block   hash = 0a0b",
+	}
+}
+
+func TestNewLogEntryFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid level should error", func(t *testing.T) {
+		t.Parallel()
+
+		filter, err := newLogEntryFilter(ArgsLogEntryFilter{MinLevel: "verbose"})
+		require.Nil(t, filter)
+		require.NotNil(t, err)
+	})
+	t.Run("invalid epochs should error", func(t *testing.T) {
+		t.Parallel()
+
+		filter, err := newLogEntryFilter(ArgsLogEntryFilter{Epochs: "a"})
+		require.Nil(t, filter)
+		require.True(t, errors.Is(err, ErrInvalidRange))
+	})
+	t.Run("invalid rounds should error", func(t *testing.T) {
+		t.Parallel()
+
+		filter, err := newLogEntryFilter(ArgsLogEntryFilter{Rounds: "10-5"})
+		require.Nil(t, filter)
+		require.True(t, errors.Is(err, ErrInvalidRange))
+	})
+	t.Run("invalid regex should error", func(t *testing.T) {
+		t.Parallel()
+
+		filter, err := newLogEntryFilter(ArgsLogEntryFilter{Regex: "(unclosed"})
+		require.Nil(t, filter)
+		require.NotNil(t, err)
+	})
+}
+
+func TestLogEntryFilter_IsMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		args    ArgsLogEntryFilter
+		isMatch bool
+	}{
+		{name: "no criteria", args: ArgsLogEntryFilter{}, isMatch: true},
+		{name: "lower level", args: ArgsLogEntryFilter{MinLevel: "trace"}, isMatch: true},
+		{name: "higher level", args: ArgsLogEntryFilter{MinLevel: "INFO"}, isMatch: false},
+		{name: "matching logger", args: ArgsLogEntryFilter{LoggerNames: []string{"process", "spos"}}, isMatch: true},
+		{name: "other logger", args: ArgsLogEntryFilter{LoggerNames: []string{"process"}}, isMatch: false},
+		{name: "matching shard", args: ArgsLogEntryFilter{Shard: "1"}, isMatch: true},
+		{name: "other shard", args: ArgsLogEntryFilter{Shard: "metachain"}, isMatch: false},
+		{name: "matching epoch", args: ArgsLogEntryFilter{Epochs: "4"}, isMatch: true},
+		{name: "other epochs", args: ArgsLogEntryFilter{Epochs: "5-6"}, isMatch: false},
+		{name: "matching rounds", args: ArgsLogEntryFilter{Rounds: "1200-1210"}, isMatch: true},
+		{name: "other round", args: ArgsLogEntryFilter{Rounds: "1206"}, isMatch: false},
+		{name: "matching regex", args: ArgsLogEntryFilter{Regex: "proposed.*hash = 0a"}, isMatch: true},
+		{name: "other regex", args: ArgsLogEntryFilter{Regex: "^hash"}, isMatch: false},
+		{
+			name: "all criteria matching",
+			args: ArgsLogEntryFilter{
+				MinLevel:    "debug",
+				LoggerNames: []string{"bls"},
+				Shard:       "1",
+				Epochs:      "3-4",
+				Rounds:      "1205",
+				Regex:       "block",
+			},
+			isMatch: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		filter, err := newLogEntryFilter(tc.args)
+		require.Nil(t, err, tc.name)
+		require.Equal(t, tc.isMatch, filter.isMatch(createLogEntry()), tc.name)
+	}
+
+	t.Run("correlation criteria should not match entries without correlation", func(t *testing.T) {
+		t.Parallel()
+
+		filter, err := newLogEntryFilter(ArgsLogEntryFilter{Epochs: "0"})
+		require.Nil(t, err)
+
+		entry := createLogEntry()
+		entry.HasCorrelation = false
+		entry.Epoch = 0
+		require.False(t, filter.isMatch(entry))
+	})
+}
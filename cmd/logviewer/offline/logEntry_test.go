@@ -0,0 +1,103 @@
+package offline
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/require"
+)
+
+// formatLine mimics the logger's plain formatter
+func formatLine(level string, timestamp string, loggerName string, correlation string, message string) string {
+	if len(loggerName) > 0 {
+		loggerName = padRight("["+loggerName+"]", loggerNameFieldLength)
+	}
+	if len(correlation) > 0 {
+		correlation = padRight(correlation, correlationFieldLength)
+	}
+
+	return fmt.Sprintf("%s[%s] %s %s %s %s", level, timestamp, loggerName, correlation, padRight(message, 40), "round = 120 ")
+}
+
+func padRight(str string, length int) string {
+	if len(str) >= length {
+		return str
+	}
+
+	return str + strings.Repeat(" ", length-len(str))
+}
+
+func TestParseHeaderLine(t *testing.T) {
+	t.Parallel()
+
+	expectedTimestamp, _ := time.ParseInLocation(timestampLayout, "2024-05-10 10:00:00.123", time.Local)
+
+	t.Run("not a header line should return false", func(t *testing.T) {
+		t.Parallel()
+
+		entry, isHeader := parseHeaderLine("goroutine 1 [running]:", "node.log")
+		require.Nil(t, entry)
+		require.False(t, isHeader)
+	})
+	t.Run("without logger name and correlation", func(t *testing.T) {
+		t.Parallel()
+
+		line := formatLine("INFO ", "2024-05-10 10:00:00.123", "", "", "[message in brackets]")
+		entry, isHeader := parseHeaderLine(line, "node.log")
+		require.True(t, isHeader)
+		require.Equal(t, &LogEntry{
+			Source:    "node.log",
+			Level:     logger.LogInfo,
+			Timestamp: expectedTimestamp,
+			Message:   padRight("[message in brackets]", 40) + " round = 120",
+			Text:      line,
+		}, entry)
+	})
+	t.Run("with logger name and correlation", func(t *testing.T) {
+		t.Parallel()
+
+		line := formatLine("DEBUG", "2024-05-10 10:00:00.123", "consensus/spos", "[0/3/120/(BLOCK)]", "proposed block")
+		entry, isHeader := parseHeaderLine(line, "node.log")
+		require.True(t, isHeader)
+		require.Equal(t, &LogEntry{
+			Source:         "node.log",
+			Level:          logger.LogDebug,
+			Timestamp:      expectedTimestamp,
+			LoggerName:     "consensus/spos",
+			HasCorrelation: true,
+			Shard:          "0",
+			Epoch:          3,
+			Round:          120,
+			SubRound:       "(BLOCK)",
+			Message:        padRight("proposed block", 40) + " round = 120",
+			Text:           line,
+		}, entry)
+	})
+	t.Run("with correlation only", func(t *testing.T) {
+		t.Parallel()
+
+		line := formatLine("WARN ", "2024-05-10 10:00:00.123", "", "[metachain/3/7/]", "[not the logger]")
+		entry, isHeader := parseHeaderLine(line, "node.log")
+		require.True(t, isHeader)
+		require.Empty(t, entry.LoggerName)
+		require.True(t, entry.HasCorrelation)
+		require.Equal(t, "metachain", entry.Shard)
+		require.Equal(t, int64(7), entry.Round)
+		require.Empty(t, entry.SubRound)
+		require.True(t, strings.HasPrefix(entry.Message, "[not the logger]"))
+	})
+	t.Run("with logger name only", func(t *testing.T) {
+		t.Parallel()
+
+		line := formatLine("ERROR", "2024-05-10 10:00:00.123", "..ss/block/preprocess", "", "[0/1/2/3] is the message")
+		entry, isHeader := parseHeaderLine(line, "node.log")
+		require.True(t, isHeader)
+		require.Equal(t, logger.LogError, entry.Level)
+		require.Equal(t, "..ss/block/preprocess", entry.LoggerName)
+		require.False(t, entry.HasCorrelation)
+		require.True(t, strings.HasPrefix(entry.Message, "[0/1/2/3] is the message"))
+	})
+}
@@ -0,0 +1,68 @@
+package offline
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+)
+
+const maxLineLength = 64 * 1024 * 1024
+
+// logFileReader reads the log entries of a saved log file, one at a time
+type logFileReader struct {
+	file    *os.File
+	scanner *bufio.Scanner
+	source  string
+	next    *LogEntry
+}
+
+func newLogFileReader(path string) (*logFileReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineLength)
+
+	return &logFileReader{
+		file:    file,
+		scanner: scanner,
+		source:  filepath.Base(path),
+	}, nil
+}
+
+// readEntry returns the next log entry or nil when the end of the file was reached. The lines preceding the
+// first log entry are ignored
+func (reader *logFileReader) readEntry() (*LogEntry, error) {
+	for reader.scanner.Scan() {
+		line := reader.scanner.Text()
+		entry, isHeader := parseHeaderLine(line, reader.source)
+		if !isHeader {
+			if reader.next != nil {
+				reader.next.appendContinuationLine(line)
+			}
+			continue
+		}
+
+		current := reader.next
+		reader.next = entry
+		if current != nil {
+			return current, nil
+		}
+	}
+
+	err := reader.scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	current := reader.next
+	reader.next = nil
+
+	return current, nil
+}
+
+func (reader *logFileReader) close() error {
+	return reader.file.Close()
+}
@@ -0,0 +1,126 @@
+package offline
+
+import (
+	"container/heap"
+)
+
+type readerHead struct {
+	reader *logFileReader
+	entry  *LogEntry
+	index  int
+}
+
+// readersHeap orders the readers by the timestamp of their current log entry. The readers of the files
+// provided first win the ties, so the entries with the same timestamp keep a stable order
+type readersHeap []*readerHead
+
+// Len returns the number of readers
+func (rh readersHeap) Len() int {
+	return len(rh)
+}
+
+// Less returns true if the current entry of the reader at index i should be output first
+func (rh readersHeap) Less(i, j int) bool {
+	if rh[i].entry.Timestamp.Equal(rh[j].entry.Timestamp) {
+		return rh[i].index < rh[j].index
+	}
+
+	return rh[i].entry.Timestamp.Before(rh[j].entry.Timestamp)
+}
+
+// Swap swaps the readers at the provided indexes
+func (rh readersHeap) Swap(i, j int) {
+	rh[i], rh[j] = rh[j], rh[i]
+}
+
+// Push adds a reader
+func (rh *readersHeap) Push(x interface{}) {
+	*rh = append(*rh, x.(*readerHead))
+}
+
+// Pop removes the last reader
+func (rh *readersHeap) Pop() interface{} {
+	old := *rh
+	n := len(old)
+	head := old[n-1]
+	*rh = old[:n-1]
+
+	return head
+}
+
+// mergedReader reads the log entries of multiple saved log files, merged by their timestamp
+type mergedReader struct {
+	readers []*logFileReader
+	heads   *readersHeap
+}
+
+func newMergedReader(paths []string) (*mergedReader, error) {
+	if len(paths) == 0 {
+		return nil, ErrNoInputFiles
+	}
+
+	mr := &mergedReader{
+		readers: make([]*logFileReader, 0, len(paths)),
+		heads:   &readersHeap{},
+	}
+	for index, path := range paths {
+		reader, err := newLogFileReader(path)
+		if err != nil {
+			_ = mr.close()
+			return nil, err
+		}
+		mr.readers = append(mr.readers, reader)
+
+		err = mr.pushNextEntry(&readerHead{reader: reader, index: index})
+		if err != nil {
+			_ = mr.close()
+			return nil, err
+		}
+	}
+
+	return mr, nil
+}
+
+func (mr *mergedReader) pushNextEntry(head *readerHead) error {
+	entry, err := head.reader.readEntry()
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return nil
+	}
+
+	head.entry = entry
+	heap.Push(mr.heads, head)
+
+	return nil
+}
+
+// readEntry returns the oldest log entry not yet read from all the files or nil when all the files were read
+func (mr *mergedReader) readEntry() (*LogEntry, error) {
+	if mr.heads.Len() == 0 {
+		return nil, nil
+	}
+
+	head := heap.Pop(mr.heads).(*readerHead)
+	entry := head.entry
+
+	err := mr.pushNextEntry(head)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (mr *mergedReader) close() error {
+	var lastErr error
+	for _, reader := range mr.readers {
+		err := reader.close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
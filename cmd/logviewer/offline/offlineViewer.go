@@ -0,0 +1,74 @@
+package offline
+
+import (
+	"io"
+)
+
+// ArgsOfflineViewer holds the arguments needed for processing saved log files
+type ArgsOfflineViewer struct {
+	InputFiles   []string
+	Filter       ArgsLogEntryFilter
+	OutputFormat string
+	Output       io.Writer
+}
+
+// Statistics holds the number of log entries read from the saved log files and how many of them were output
+type Statistics struct {
+	NumReadEntries     uint64
+	NumMatchingEntries uint64
+}
+
+// ProcessLogFiles reads the saved log files, merged by the entries' timestamp, and writes the entries matching
+// the filter to the output, in the requested format
+func ProcessLogFiles(args ArgsOfflineViewer) (*Statistics, error) {
+	if args.Output == nil {
+		return nil, ErrNilOutput
+	}
+
+	filter, err := newLogEntryFilter(args.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := createEntryWriter(args.OutputFormat, args.Output, len(args.InputFiles) > 1)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := newMergedReader(args.InputFiles)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = reader.close()
+	}()
+
+	stats := &Statistics{}
+	for {
+		entry, errRead := reader.readEntry()
+		if errRead != nil {
+			return nil, errRead
+		}
+		if entry == nil {
+			break
+		}
+
+		stats.NumReadEntries++
+		if !filter.isMatch(entry) {
+			continue
+		}
+
+		stats.NumMatchingEntries++
+		errWrite := writer.write(entry)
+		if errWrite != nil {
+			return nil, errWrite
+		}
+	}
+
+	err = writer.close()
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
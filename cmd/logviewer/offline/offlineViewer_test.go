@@ -0,0 +1,148 @@
+package offline
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeLogFile(t *testing.T, dir string, name string, lines ...string) string {
+	path := filepath.Join(dir, name)
+	err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644)
+	require.Nil(t, err)
+
+	return path
+}
+
+func createLogFiles(t *testing.T) []string {
+	dir := t.TempDir()
+	first := writeLogFile(t, dir, "node0.log",
+		"not a log line, ignored",
+		formatLine("INFO ", "2024-05-10 10:00:00.000", "main", "[0/3/100/]", "starting"),
+		formatLine("ERROR", "2024-05-10 10:00:02.000", "consensus/spos", "[0/3/102/(END_ROUND)]", "consensus failed"),
+		"goroutine 1 [running]:",
+		"main.main()",
+	)
+	second := writeLogFile(t, dir, "node1.log",
+		formatLine("DEBUG", "2024-05-10 10:00:01.000", "consensus/spos", "[1/3/101/(BLOCK)]", "proposed block"),
+		formatLine("INFO ", "2024-05-10 10:00:02.000", "main", "[1/3/102/]", "same timestamp"),
+	)
+
+	return []string{first, second}
+}
+
+func TestProcessLogFiles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil output should error", func(t *testing.T) {
+		t.Parallel()
+
+		stats, err := ProcessLogFiles(ArgsOfflineViewer{InputFiles: createLogFiles(t)})
+		require.Nil(t, stats)
+		require.Equal(t, ErrNilOutput, err)
+	})
+	t.Run("no input files should error", func(t *testing.T) {
+		t.Parallel()
+
+		stats, err := ProcessLogFiles(ArgsOfflineViewer{Output: &bytes.Buffer{}})
+		require.Nil(t, stats)
+		require.Equal(t, ErrNoInputFiles, err)
+	})
+	t.Run("missing input file should error", func(t *testing.T) {
+		t.Parallel()
+
+		files := append(createLogFiles(t), filepath.Join(t.TempDir(), "missing.log"))
+		stats, err := ProcessLogFiles(ArgsOfflineViewer{InputFiles: files, Output: &bytes.Buffer{}})
+		require.Nil(t, stats)
+		require.True(t, errors.Is(err, os.ErrNotExist))
+	})
+	t.Run("invalid format should error", func(t *testing.T) {
+		t.Parallel()
+
+		stats, err := ProcessLogFiles(ArgsOfflineViewer{InputFiles: createLogFiles(t), OutputFormat: "xml", Output: &bytes.Buffer{}})
+		require.Nil(t, stats)
+		require.True(t, errors.Is(err, ErrInvalidExportFormat))
+	})
+	t.Run("plain output should merge the files by timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		output := &bytes.Buffer{}
+		stats, err := ProcessLogFiles(ArgsOfflineViewer{InputFiles: createLogFiles(t), Output: output})
+		require.Nil(t, err)
+		require.Equal(t, &Statistics{NumReadEntries: 4, NumMatchingEntries: 4}, stats)
+
+		lines := strings.Split(strings.TrimSuffix(output.String(), "\n"), "\n")
+		require.Equal(t, 6, len(lines))
+		require.True(t, strings.HasPrefix(lines[0], "[node0.log] INFO [2024-05-10 10:00:00.000]"))
+		require.True(t, strings.HasPrefix(lines[1], "[node1.log] DEBUG[2024-05-10 10:00:01.000]"))
+		require.True(t, strings.HasPrefix(lines[2], "[node0.log] ERROR[2024-05-10 10:00:02.000]"))
+		require.Equal(t, "goroutine 1 [running]:", lines[3])
+		require.Equal(t, "main.main()", lines[4])
+		require.True(t, strings.HasPrefix(lines[5], "[node1.log] INFO [2024-05-10 10:00:02.000]"))
+	})
+	t.Run("json output", func(t *testing.T) {
+		t.Parallel()
+
+		output := &bytes.Buffer{}
+		stats, err := ProcessLogFiles(ArgsOfflineViewer{
+			InputFiles:   createLogFiles(t),
+			Filter:       ArgsLogEntryFilter{Rounds: "101-102", LoggerNames: []string{"spos"}},
+			OutputFormat: JSONFormat,
+			Output:       output,
+		})
+		require.Nil(t, err)
+		require.Equal(t, &Statistics{NumReadEntries: 4, NumMatchingEntries: 2}, stats)
+
+		entries := make([]exportedEntry, 0)
+		require.Nil(t, json.Unmarshal(output.Bytes(), &entries))
+		require.Equal(t, 2, len(entries))
+		require.Equal(t, "node1.log", entries[0].Source)
+		require.Equal(t, "DEBUG", entries[0].Level)
+		require.Equal(t, "consensus/spos", entries[0].Logger)
+		require.Equal(t, &exportedCorrelation{Shard: "1", Epoch: 3, Round: 101, SubRound: "(BLOCK)"}, entries[0].Correlation)
+		require.Equal(t, "ERROR", entries[1].Level)
+		require.True(t, strings.HasSuffix(entries[1].Message, "goroutine 1 [running]:\nmain.main()"))
+	})
+	t.Run("json output without matching entries", func(t *testing.T) {
+		t.Parallel()
+
+		output := &bytes.Buffer{}
+		_, err := ProcessLogFiles(ArgsOfflineViewer{
+			InputFiles:   createLogFiles(t),
+			Filter:       ArgsLogEntryFilter{Regex: "not found"},
+			OutputFormat: JSONFormat,
+			Output:       output,
+		})
+		require.Nil(t, err)
+		require.Equal(t, "[]\n", output.String())
+	})
+	t.Run("csv output", func(t *testing.T) {
+		t.Parallel()
+
+		output := &bytes.Buffer{}
+		stats, err := ProcessLogFiles(ArgsOfflineViewer{
+			InputFiles:   createLogFiles(t),
+			Filter:       ArgsLogEntryFilter{MinLevel: "INFO", Shard: "0"},
+			OutputFormat: CSVFormat,
+			Output:       output,
+		})
+		require.Nil(t, err)
+		require.Equal(t, &Statistics{NumReadEntries: 4, NumMatchingEntries: 2}, stats)
+
+		records, err := csv.NewReader(output).ReadAll()
+		require.Nil(t, err)
+		require.Equal(t, 3, len(records))
+		require.Equal(t, csvHeader, records[0])
+		require.Equal(t, []string{"node0.log", "INFO", "main", "0", "3", "100", ""}, []string{
+			records[1][0], records[1][2], records[1][3], records[1][4], records[1][5], records[1][6], records[1][7],
+		})
+		require.Equal(t, "ERROR", records[2][2])
+	})
+}
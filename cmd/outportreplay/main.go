@@ -0,0 +1,268 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/common"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	outportReplayPath = "/node/outport/replay"
+	requestTimeout    = 10 * time.Second
+)
+
+var (
+	outportReplayHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// address defines a flag for the REST API address of the node
+	address = cli.StringFlag{
+		Name:  "address",
+		Usage: "The `address` (protocol, interface and port) of the node's REST API.",
+		Value: "http://127.0.0.1:8080",
+	}
+	// startNonce defines a flag for the first block nonce to be replayed
+	startNonce = cli.Uint64Flag{
+		Name:  "start-nonce",
+		Usage: "The `nonce` of the first block to be pushed to the replay target.",
+	}
+	// endNonce defines a flag for the last block nonce to be replayed
+	endNonce = cli.Uint64Flag{
+		Name:  "end-nonce",
+		Usage: "The `nonce` of the last block to be pushed to the replay target.",
+	}
+	// target defines a flag for the outport driver the blocks are replayed to
+	target = cli.StringFlag{
+		Name: "target",
+		Usage: "The `target` the blocks are replayed to: elasticIndexer, eventNotifier or the URL of a host driver " +
+			"running in client mode. A dedicated driver is created for the target, the node's live drivers do not " +
+			"receive the replayed blocks.",
+	}
+	// username defines a flag for the admin API username
+	username = cli.StringFlag{
+		Name:  "username",
+		Usage: "The `username` of the node's admin API credentials.",
+	}
+	// password defines a flag for the admin API password
+	password = cli.StringFlag{
+		Name:   "password",
+		Usage:  "The `password` of the node's admin API credentials. It can also be provided through the environment.",
+		EnvVar: "MX_ADMIN_PASSWORD",
+	}
+	// pollInterval defines a flag for the interval between two replay status requests
+	pollInterval = cli.DurationFlag{
+		Name:  "poll-interval",
+		Usage: "The `duration` between two replay status requests.",
+		Value: 5 * time.Second,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+
+	log = logger.GetOrCreate("main")
+)
+
+type replayRequest struct {
+	StartNonce uint64 `json:"startNonce"`
+	EndNonce   uint64 `json:"endNonce"`
+	Target     string `json:"target"`
+}
+
+type apiResponse struct {
+	Data  json.RawMessage `json:"data"`
+	Error string          `json:"error"`
+	Code  string          `json:"code"`
+}
+
+type replayStatusResponse struct {
+	Replay common.OutportReplayStatus `json:"replay"`
+}
+
+type replayClient struct {
+	httpClient *http.Client
+	address    string
+	username   string
+	password   string
+}
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = outportReplayHelpTemplate
+	app.Name = "Outport replay CLI App"
+	app.Usage = "This tool asks a running node to push the stored blocks in a nonce range to one of its outport " +
+		"targets, so that target can be backfilled without a new sync"
+	app.Flags = []cli.Flag{
+		address,
+		startNonce,
+		endNonce,
+		target,
+		username,
+		password,
+		pollInterval,
+		logLevel,
+	}
+	app.Version = "v1.0.0"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return replay(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func replay(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	client := &replayClient{
+		httpClient: &http.Client{Timeout: requestTimeout},
+		address:    ctx.GlobalString(address.Name),
+		username:   ctx.GlobalString(username.Name),
+		password:   ctx.GlobalString(password.Name),
+	}
+
+	request := replayRequest{
+		StartNonce: ctx.GlobalUint64(startNonce.Name),
+		EndNonce:   ctx.GlobalUint64(endNonce.Name),
+		Target:     ctx.GlobalString(target.Name),
+	}
+	err = client.startReplay(request)
+	if err != nil {
+		return err
+	}
+
+	log.Info("outport replay started", "target", request.Target, "start nonce", request.StartNonce, "end nonce", request.EndNonce)
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		select {
+		case <-sigs:
+			log.Info("stopped following the replay at user's signal, the node will continue the replay")
+			return nil
+		case <-time.After(ctx.GlobalDuration(pollInterval.Name)):
+		}
+
+		status, errStatus := client.getStatus()
+		if errStatus != nil {
+			log.Warn("cannot fetch the replay status", "error", errStatus)
+			continue
+		}
+
+		log.Info("outport replay status",
+			"last replayed nonce", status.LastReplayedNonce,
+			"num replayed blocks", status.NumReplayedBlocks,
+			"num total blocks", status.EndNonce-status.StartNonce+1,
+		)
+		if status.InProgress {
+			continue
+		}
+		if len(status.Error) > 0 {
+			return errors.New(status.Error)
+		}
+
+		log.Info("outport replay finished", "num replayed blocks", status.NumReplayedBlocks)
+		return nil
+	}
+}
+
+func (rc *replayClient) startReplay(request replayRequest) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	_, err = rc.doRequest(http.MethodPost, body)
+
+	return err
+}
+
+func (rc *replayClient) getStatus() (*common.OutportReplayStatus, error) {
+	data, err := rc.doRequest(http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &replayStatusResponse{}
+	err = json.Unmarshal(data, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Replay, nil
+}
+
+func (rc *replayClient) doRequest(method string, body []byte) (json.RawMessage, error) {
+	request, err := http.NewRequest(method, rc.address+outportReplayPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.SetBasicAuth(rc.username, rc.password)
+
+	resp, err := rc.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	buff, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &apiResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, fmt.Errorf("%w, http status: %s", err, resp.Status)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("http status: %s, error: %s", resp.Status, response.Error)
+	}
+
+	return response.Data, nil
+}
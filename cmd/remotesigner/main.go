@@ -0,0 +1,279 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	unixSocketPrefix = "unix://"
+
+	// each signed round is written on the disk before the signature is returned
+	dbBatchDelaySeconds = 1
+	dbMaxBatchSize      = 1
+	dbMaxOpenFiles      = 10
+)
+
+var (
+	remoteSignerHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// keysFile defines a flag for the path to the PEM file holding the validator keys
+	keysFile = cli.StringFlag{
+		Name:  "keys-file",
+		Usage: "The `filepath` for the PEM file which contains the BLS secret keys, in the allValidatorsKeys.pem format.",
+		Value: "./allValidatorsKeys.pem",
+	}
+	// address defines a flag for the address on which the signer listens
+	address = cli.StringFlag{
+		Name: "address",
+		Usage: "The `address` of the Unix socket (unix:///path/to/signer.sock) on which the signer will listen. TCP is not " +
+			"supported as the signer does not authenticate its callers. It should match the RemoteSigner.Address node configuration.",
+		Value: "unix:///tmp/mx-remote-signer.sock",
+	}
+	// dbPath defines a flag for the path to the slashing protection database
+	dbPath = cli.StringFlag{
+		Name:  "db-path",
+		Usage: "The `directory` of the slashing protection database, recording the message signed by each key in each round.",
+		Value: "./slashingProtectionDB",
+	}
+	// nodesSetupFile defines a flag for the path to the nodes setup file, providing the genesis time and the round duration
+	nodesSetupFile = cli.StringFlag{
+		Name: "nodes-setup-file",
+		Usage: "The `filepath` for the nodesSetup.json file used by the nodes. Its startTime and roundDuration are used " +
+			"to compute the current round, so the signer does not trust the round sent by the nodes.",
+		Value: "./nodesSetup.json",
+	}
+	// genesisTime defines a flag for overriding the genesis time from the nodes setup file
+	genesisTime = cli.Int64Flag{
+		Name: "genesis-time",
+		Usage: "The genesis `unix timestamp` in seconds, overriding the startTime from the nodes setup file. It is " +
+			"required if the startTime is 0 and it should match Hardfork.GenesisTime after a hardfork.",
+	}
+	// startRound defines a flag for the round of the genesis time
+	startRound = cli.Int64Flag{
+		Name:  "start-round",
+		Usage: "The `round` at the genesis time. It should match Hardfork.StartRound after a hardfork.",
+	}
+	// maxRoundsDrift defines a flag for the accepted difference between the requested round and the signer round
+	maxRoundsDrift = cli.UintFlag{
+		Name:  "max-rounds-drift",
+		Usage: "The maximum `number` of rounds between the round of a signing request and the round computed by the signer.",
+		Value: 1,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+
+	log = logger.GetOrCreate("main")
+)
+
+// nodesSetup holds the fields of the nodes setup file used to compute the current round
+type nodesSetup struct {
+	StartTime     int64  `json:"startTime"`
+	RoundDuration uint64 `json:"roundDuration"`
+}
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = remoteSignerHelpTemplate
+	app.Name = "Remote signer CLI App"
+	app.Usage = "This is a reference remote signer holding the BLS validator keys and signing the requests sent by the nodes"
+	app.Flags = []cli.Flag{
+		keysFile,
+		address,
+		dbPath,
+		nodesSetupFile,
+		genesisTime,
+		startRound,
+		maxRoundsDrift,
+		logLevel,
+	}
+	app.Version = "v1.0.0"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return startSigner(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startSigner(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	roundHandler, err := createRoundHandler(ctx)
+	if err != nil {
+		return err
+	}
+
+	privateKeys, err := loadPrivateKeys(ctx.GlobalString(keysFile.Name))
+	if err != nil {
+		return err
+	}
+
+	persister, err := database.NewLevelDB(ctx.GlobalString(dbPath.Name), dbBatchDelaySeconds, dbMaxBatchSize, dbMaxOpenFiles)
+	if err != nil {
+		return fmt.Errorf("%w while opening the slashing protection database", err)
+	}
+
+	slashingProtector, err := remoteSigner.NewSlashingProtector(persister)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(slashingProtector.Close())
+	}()
+
+	listener, err := createListener(ctx.GlobalString(address.Name))
+	if err != nil {
+		return err
+	}
+
+	argsSignerServer := remoteSigner.ArgsSignerServer{
+		KeyGenerator:      signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		SingleSigner:      &mclSig.BlsSingleSigner{},
+		SlashingProtector: slashingProtector,
+		RoundHandler:      roundHandler,
+		MaxRoundsDrift:    uint32(ctx.GlobalUint(maxRoundsDrift.Name)),
+		PrivateKeys:       privateKeys,
+		Listener:          listener,
+	}
+	server, err := remoteSigner.NewSignerServer(argsSignerServer)
+	if err != nil {
+		_ = listener.Close()
+		return err
+	}
+
+	log.Info("remote signer is now running",
+		"address", ctx.GlobalString(address.Name),
+		"num keys", len(privateKeys),
+		"current round", roundHandler.Index())
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+
+	log.Info("terminating at user's signal...")
+
+	return server.Close()
+}
+
+func createRoundHandler(ctx *cli.Context) (remoteSigner.RoundHandler, error) {
+	setup := &nodesSetup{}
+	err := core.LoadJsonFile(setup, ctx.GlobalString(nodesSetupFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := setup.StartTime
+	if ctx.GlobalIsSet(genesisTime.Name) {
+		startTime = ctx.GlobalInt64(genesisTime.Name)
+	}
+	// the nodes compute a genesis time when the startTime is 0, which can not be known by the signer
+	if startTime == 0 {
+		return nil, fmt.Errorf("the genesis time is not set, provide it with the --%s flag", genesisTime.Name)
+	}
+
+	return remoteSigner.NewClockRoundHandler(remoteSigner.ArgsClockRoundHandler{
+		GenesisTime:   time.Unix(startTime, 0),
+		RoundDuration: time.Duration(setup.RoundDuration) * time.Millisecond,
+		StartRound:    ctx.GlobalInt64(startRound.Name),
+	})
+}
+
+func loadPrivateKeys(filePath string) ([][]byte, error) {
+	encodedPrivateKeys, publicKeys, err := core.NewKeyLoader().LoadAllKeys(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeys := make([][]byte, 0, len(encodedPrivateKeys))
+	for i, encodedPrivateKey := range encodedPrivateKeys {
+		privateKey, errDecode := hex.DecodeString(string(encodedPrivateKey))
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for encoded secret key, key index %d", errDecode, i)
+		}
+
+		log.Debug("loaded key", "public key", publicKeys[i])
+		privateKeys = append(privateKeys, privateKey)
+	}
+
+	return privateKeys, nil
+}
+
+func createListener(listenAddress string) (net.Listener, error) {
+	// the signer does not authenticate its callers so it only listens on a Unix socket, protected by the file permissions
+	if !strings.HasPrefix(listenAddress, unixSocketPrefix) {
+		return nil, fmt.Errorf("unsupported address %s, only Unix sockets (%s) are supported", listenAddress, unixSocketPrefix)
+	}
+
+	socketPath := strings.TrimPrefix(listenAddress, unixSocketPrefix)
+	// remove the socket file left behind by a previous run
+	err := os.Remove(socketPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	// only the user running the signer, and the node if it is run by the same user, can connect to the socket. The
+	// socket is created under a restrictive umask, so no other user can connect before its permissions are set
+	oldMask := syscall.Umask(0177)
+	listener, err := net.Listen("unix", socketPath)
+	syscall.Umask(oldMask)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Chmod(socketPath, 0600)
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
@@ -0,0 +1,18 @@
+package fleet
+
+import "errors"
+
+// ErrNoNodes signals that no node was provided
+var ErrNoNodes = errors.New("no nodes provided")
+
+// ErrDuplicatedAddress signals that the same node address was provided twice
+var ErrDuplicatedAddress = errors.New("duplicated node address")
+
+// ErrNilPresenter signals that a nil presenter was provided
+var ErrNilPresenter = errors.New("nil presenter")
+
+// ErrNilUpdateTimeProvider signals that a nil update time provider was provided
+var ErrNilUpdateTimeProvider = errors.New("nil update time provider")
+
+// ErrInvalidOfflineTimeout signals that an invalid offline timeout was provided
+var ErrInvalidOfflineTimeout = errors.New("invalid offline timeout")
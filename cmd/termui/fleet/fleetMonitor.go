@@ -0,0 +1,245 @@
+package fleet
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+)
+
+type sortColumn struct {
+	name string
+	less func(first, second *view.NodeStatus) bool
+}
+
+// sortColumns holds the columns the fleet can be sorted by. The nodes are sorted by address in the order they were
+// provided, while the ties of the other columns keep the same order
+var sortColumns = []sortColumn{
+	{name: "address", less: nil},
+	{name: "shard", less: func(first, second *view.NodeStatus) bool { return first.ShardID < second.ShardID }},
+	{name: "nonce", less: func(first, second *view.NodeStatus) bool { return first.Nonce < second.Nonce }},
+	{name: "lag", less: func(first, second *view.NodeStatus) bool { return first.Lag < second.Lag }},
+	{name: "consensus", less: func(first, second *view.NodeStatus) bool {
+		return first.CountConsensusAcceptedBlocks < second.CountConsensusAcceptedBlocks
+	}},
+	{name: "peers", less: func(first, second *view.NodeStatus) bool { return first.NumConnectedPeers < second.NumConnectedPeers }},
+	{name: "cpu", less: func(first, second *view.NodeStatus) bool { return first.CpuLoadPercent < second.CpuLoadPercent }},
+	{name: "mem", less: func(first, second *view.NodeStatus) bool { return first.MemLoadPercent < second.MemLoadPercent }},
+	{name: "state", less: func(first, second *view.NodeStatus) bool {
+		return stateSeverity[first.State] < stateSeverity[second.State]
+	}},
+}
+
+var stateSeverity = map[view.NodeState]int{
+	view.NodeStateSynchronized: 0,
+	view.NodeStateLagging:      1,
+	view.NodeStateSyncing:      2,
+	view.NodeStateOffline:      3,
+}
+
+// NodeArgs holds the components of a monitored node
+type NodeArgs struct {
+	Address            string
+	Presenter          view.Presenter
+	UpdateTimeProvider UpdateTimeProvider
+}
+
+// ArgsFleetMonitor holds the arguments needed for creating a new fleetMonitor
+type ArgsFleetMonitor struct {
+	Nodes          []NodeArgs
+	LagThreshold   uint64
+	OfflineTimeout time.Duration
+}
+
+// fleetMonitor summarizes the metrics of multiple nodes. A node is lagging if its nonce is behind the highest
+// nonce known for its shard, either reported by the node itself or by another node of the fleet, by more than
+// the lag threshold
+type fleetMonitor struct {
+	nodes          []NodeArgs
+	lagThreshold   uint64
+	offlineTimeout time.Duration
+	getTimeHandler func() time.Time
+
+	mutSort         sync.RWMutex
+	sortColumnIndex int
+	sortDescending  bool
+}
+
+// NewFleetMonitor will create a new instance of fleetMonitor
+func NewFleetMonitor(args ArgsFleetMonitor) (*fleetMonitor, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fleetMonitor{
+		nodes:          args.Nodes,
+		lagThreshold:   args.LagThreshold,
+		offlineTimeout: args.OfflineTimeout,
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+func checkArgs(args ArgsFleetMonitor) error {
+	if len(args.Nodes) == 0 {
+		return ErrNoNodes
+	}
+	if args.OfflineTimeout <= 0 {
+		return ErrInvalidOfflineTimeout
+	}
+
+	addresses := make(map[string]struct{}, len(args.Nodes))
+	for _, node := range args.Nodes {
+		_, found := addresses[node.Address]
+		if found {
+			return fmt.Errorf("%w: %s", ErrDuplicatedAddress, node.Address)
+		}
+		addresses[node.Address] = struct{}{}
+
+		if node.Presenter == nil || node.Presenter.IsInterfaceNil() {
+			return fmt.Errorf("%w for node %s", ErrNilPresenter, node.Address)
+		}
+		if node.UpdateTimeProvider == nil {
+			return fmt.Errorf("%w for node %s", ErrNilUpdateTimeProvider, node.Address)
+		}
+	}
+
+	return nil
+}
+
+// GetNodesStatus returns the summary of all the nodes, sorted by the selected column
+func (fm *fleetMonitor) GetNodesStatus() []view.NodeStatus {
+	statuses := make([]view.NodeStatus, 0, len(fm.nodes))
+	highestNonces := make(map[uint64]uint64)
+	now := fm.getTimeHandler()
+	for _, node := range fm.nodes {
+		status := fm.getNodeStatus(node, now)
+		statuses = append(statuses, status)
+
+		if status.State != view.NodeStateOffline && status.Nonce > highestNonces[status.ShardID] {
+			highestNonces[status.ShardID] = status.Nonce
+		}
+	}
+
+	for i := range statuses {
+		fm.setLagAndState(&statuses[i], highestNonces[statuses[i].ShardID])
+	}
+
+	fm.sortStatuses(statuses)
+
+	return statuses
+}
+
+func (fm *fleetMonitor) getNodeStatus(node NodeArgs, now time.Time) view.NodeStatus {
+	presenter := node.Presenter
+	status := view.NodeStatus{
+		Address:                      node.Address,
+		NodeName:                     presenter.GetNodeName(),
+		PeerType:                     presenter.GetPeerType(),
+		ShardID:                      presenter.GetShardId(),
+		Epoch:                        presenter.GetEpochNumber(),
+		Nonce:                        presenter.GetNonce(),
+		HighestNonce:                 presenter.GetProbableHighestNonce(),
+		CountConsensus:               presenter.GetCountConsensus(),
+		CountConsensusAcceptedBlocks: presenter.GetCountConsensusAcceptedBlocks(),
+		CountLeader:                  presenter.GetCountLeader(),
+		NumConnectedPeers:            presenter.GetNumConnectedPeers(),
+		CpuLoadPercent:               presenter.GetCpuLoadPercent(),
+		MemLoadPercent:               presenter.GetMemLoadPercent(),
+		State:                        view.NodeStateSynchronized,
+	}
+
+	lastUpdateTime := node.UpdateTimeProvider.GetLastUpdateTime()
+	if lastUpdateTime.IsZero() || now.Sub(lastUpdateTime) > fm.offlineTimeout {
+		status.State = view.NodeStateOffline
+		return status
+	}
+	if presenter.GetIsSyncing() != 0 {
+		status.State = view.NodeStateSyncing
+	}
+
+	return status
+}
+
+func (fm *fleetMonitor) setLagAndState(status *view.NodeStatus, shardHighestNonce uint64) {
+	if shardHighestNonce > status.HighestNonce {
+		status.HighestNonce = shardHighestNonce
+	}
+	if status.HighestNonce > status.Nonce {
+		status.Lag = status.HighestNonce - status.Nonce
+	}
+
+	if status.State == view.NodeStateSynchronized && status.Lag > fm.lagThreshold {
+		status.State = view.NodeStateLagging
+	}
+}
+
+func (fm *fleetMonitor) sortStatuses(statuses []view.NodeStatus) {
+	fm.mutSort.RLock()
+	column := sortColumns[fm.sortColumnIndex]
+	descending := fm.sortDescending
+	fm.mutSort.RUnlock()
+
+	if column.less == nil {
+		if descending {
+			for i, j := 0, len(statuses)-1; i < j; i, j = i+1, j-1 {
+				statuses[i], statuses[j] = statuses[j], statuses[i]
+			}
+		}
+		return
+	}
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		if descending {
+			return column.less(&statuses[j], &statuses[i])
+		}
+
+		return column.less(&statuses[i], &statuses[j])
+	})
+}
+
+// GetNodePresenter returns the presenter of the node with the provided address or nil if the node is not monitored
+func (fm *fleetMonitor) GetNodePresenter(address string) view.Presenter {
+	for _, node := range fm.nodes {
+		if node.Address == address {
+			return node.Presenter
+		}
+	}
+
+	return nil
+}
+
+// ChangeSortColumn will sort the nodes by the next column, in ascending order
+func (fm *fleetMonitor) ChangeSortColumn() {
+	fm.mutSort.Lock()
+	fm.sortColumnIndex = (fm.sortColumnIndex + 1) % len(sortColumns)
+	fm.sortDescending = false
+	fm.mutSort.Unlock()
+}
+
+// ToggleSortOrder will switch between the ascending and the descending order
+func (fm *fleetMonitor) ToggleSortOrder() {
+	fm.mutSort.Lock()
+	fm.sortDescending = !fm.sortDescending
+	fm.mutSort.Unlock()
+}
+
+// GetSortDescription returns the column and the order the nodes are sorted by
+func (fm *fleetMonitor) GetSortDescription() string {
+	fm.mutSort.RLock()
+	defer fm.mutSort.RUnlock()
+
+	order := "ascending"
+	if fm.sortDescending {
+		order = "descending"
+	}
+
+	return fmt.Sprintf("%s, %s", sortColumns[fm.sortColumnIndex].name, order)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fm *fleetMonitor) IsInterfaceNil() bool {
+	return fm == nil
+}
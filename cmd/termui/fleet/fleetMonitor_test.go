@@ -0,0 +1,228 @@
+package fleet
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/cmd/termui/presenter"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Unix(1700000000, 0)
+
+type updateTimeProviderStub struct {
+	lastUpdateTime time.Time
+}
+
+// GetLastUpdateTime -
+func (stub *updateTimeProviderStub) GetLastUpdateTime() time.Time {
+	return stub.lastUpdateTime
+}
+
+func createNode(address string, shardID uint64, nonce uint64, lastUpdateTime time.Time) NodeArgs {
+	psh := presenter.NewPresenterStatusHandler()
+	psh.SetUInt64Value(common.MetricShardId, shardID)
+	psh.SetUInt64Value(common.MetricNonce, nonce)
+	psh.SetUInt64Value(common.MetricProbableHighestNonce, nonce)
+	psh.SetUInt64Value(common.MetricNumConnectedPeers, nonce%7)
+
+	return NodeArgs{
+		Address:            address,
+		Presenter:          psh,
+		UpdateTimeProvider: &updateTimeProviderStub{lastUpdateTime: lastUpdateTime},
+	}
+}
+
+func createMockArgs() ArgsFleetMonitor {
+	syncingNode := createNode("node-3", 1, 95, now)
+	syncingNode.Presenter.(*presenter.PresenterStatusHandler).SetUInt64Value(common.MetricIsSyncing, 1)
+
+	return ArgsFleetMonitor{
+		Nodes: []NodeArgs{
+			createNode("node-0", 0, 100, now),
+			createNode("node-1", 0, 98, now.Add(-time.Second)),
+			createNode("node-2", 1, 80, now),
+			syncingNode,
+			createNode("node-4", uint64(core.MetachainShardId), 200, now.Add(-time.Minute)),
+		},
+		LagThreshold:   5,
+		OfflineTimeout: 3 * time.Second,
+	}
+}
+
+func createFleetMonitor(t *testing.T) *fleetMonitor {
+	fm, err := NewFleetMonitor(createMockArgs())
+	require.Nil(t, err)
+	fm.getTimeHandler = func() time.Time {
+		return now
+	}
+
+	return fm
+}
+
+func getAddresses(statuses []view.NodeStatus) []string {
+	addresses := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		addresses = append(addresses, status.Address)
+	}
+
+	return addresses
+}
+
+func TestNewFleetMonitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Nodes = nil
+
+		fm, err := NewFleetMonitor(args)
+		require.Nil(t, fm)
+		require.Equal(t, ErrNoNodes, err)
+	})
+	t.Run("invalid offline timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.OfflineTimeout = 0
+
+		fm, err := NewFleetMonitor(args)
+		require.Nil(t, fm)
+		require.Equal(t, ErrInvalidOfflineTimeout, err)
+	})
+	t.Run("duplicated address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Nodes[1].Address = args.Nodes[0].Address
+
+		fm, err := NewFleetMonitor(args)
+		require.Nil(t, fm)
+		require.True(t, errors.Is(err, ErrDuplicatedAddress))
+	})
+	t.Run("nil presenter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Nodes[2].Presenter = nil
+
+		fm, err := NewFleetMonitor(args)
+		require.Nil(t, fm)
+		require.True(t, errors.Is(err, ErrNilPresenter))
+	})
+	t.Run("nil update time provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Nodes[2].UpdateTimeProvider = nil
+
+		fm, err := NewFleetMonitor(args)
+		require.Nil(t, fm)
+		require.True(t, errors.Is(err, ErrNilUpdateTimeProvider))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fm, err := NewFleetMonitor(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, fm.IsInterfaceNil())
+		require.Equal(t, "address, ascending", fm.GetSortDescription())
+	})
+}
+
+func TestFleetMonitor_GetNodesStatus(t *testing.T) {
+	t.Parallel()
+
+	fm := createFleetMonitor(t)
+	statuses := fm.GetNodesStatus()
+	require.Equal(t, []string{"node-0", "node-1", "node-2", "node-3", "node-4"}, getAddresses(statuses))
+
+	require.Equal(t, view.NodeStateSynchronized, statuses[0].State)
+	require.Equal(t, uint64(0), statuses[0].Lag)
+
+	// within the lag threshold
+	require.Equal(t, view.NodeStateSynchronized, statuses[1].State)
+	require.Equal(t, uint64(100), statuses[1].HighestNonce)
+	require.Equal(t, uint64(2), statuses[1].Lag)
+
+	// behind another node of the same shard
+	require.Equal(t, view.NodeStateLagging, statuses[2].State)
+	require.Equal(t, uint64(95), statuses[2].HighestNonce)
+	require.Equal(t, uint64(15), statuses[2].Lag)
+
+	require.Equal(t, view.NodeStateSyncing, statuses[3].State)
+	require.Equal(t, view.NodeStateOffline, statuses[4].State)
+	require.Equal(t, uint64(core.MetachainShardId), statuses[4].ShardID)
+}
+
+func TestFleetMonitor_OfflineNodesShouldNotSetTheShardHighestNonce(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Nodes = []NodeArgs{
+		createNode("online", 0, 100, now),
+		createNode("stale", 0, 150, now.Add(-time.Minute)),
+		createNode("never updated", 0, 0, time.Time{}),
+	}
+	fm, err := NewFleetMonitor(args)
+	require.Nil(t, err)
+	fm.getTimeHandler = func() time.Time {
+		return now
+	}
+
+	statuses := fm.GetNodesStatus()
+	require.Equal(t, view.NodeStateSynchronized, statuses[0].State)
+	require.Equal(t, uint64(0), statuses[0].Lag)
+	require.Equal(t, view.NodeStateOffline, statuses[1].State)
+	require.Equal(t, view.NodeStateOffline, statuses[2].State)
+}
+
+func TestFleetMonitor_Sort(t *testing.T) {
+	t.Parallel()
+
+	fm := createFleetMonitor(t)
+
+	fm.ToggleSortOrder()
+	require.Equal(t, "address, descending", fm.GetSortDescription())
+	require.Equal(t, []string{"node-4", "node-3", "node-2", "node-1", "node-0"}, getAddresses(fm.GetNodesStatus()))
+
+	fm.ChangeSortColumn()
+	require.Equal(t, "shard, ascending", fm.GetSortDescription())
+	require.Equal(t, []string{"node-0", "node-1", "node-2", "node-3", "node-4"}, getAddresses(fm.GetNodesStatus()))
+
+	fm.ChangeSortColumn()
+	fm.ToggleSortOrder()
+	require.Equal(t, "nonce, descending", fm.GetSortDescription())
+	require.Equal(t, []string{"node-4", "node-0", "node-1", "node-3", "node-2"}, getAddresses(fm.GetNodesStatus()))
+
+	fm.ChangeSortColumn()
+	fm.ToggleSortOrder()
+	require.Equal(t, "lag, descending", fm.GetSortDescription())
+	require.Equal(t, []string{"node-2", "node-1", "node-0", "node-3", "node-4"}, getAddresses(fm.GetNodesStatus()))
+
+	for i := 0; i < 5; i++ {
+		fm.ChangeSortColumn()
+	}
+	fm.ToggleSortOrder()
+	require.Equal(t, "state, descending", fm.GetSortDescription())
+	require.Equal(t, []string{"node-4", "node-3", "node-2", "node-0", "node-1"}, getAddresses(fm.GetNodesStatus()))
+
+	fm.ChangeSortColumn()
+	require.Equal(t, "address, ascending", fm.GetSortDescription())
+}
+
+func TestFleetMonitor_GetNodePresenter(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	fm, _ := NewFleetMonitor(args)
+
+	require.True(t, fm.GetNodePresenter("node-2") == args.Nodes[2].Presenter)
+	require.Nil(t, fm.GetNodePresenter("unknown"))
+}
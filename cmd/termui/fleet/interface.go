@@ -0,0 +1,8 @@
+package fleet
+
+import "time"
+
+// UpdateTimeProvider defines a component able to tell when the metrics of a node were last fetched
+type UpdateTimeProvider interface {
+	GetLastUpdateTime() time.Time
+}
@@ -5,8 +5,11 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"strings"
 	"syscall"
+	"time"
 
+	"github.com/multiversx/mx-chain-go/cmd/termui/fleet"
 	"github.com/multiversx/mx-chain-go/cmd/termui/presenter"
 	"github.com/multiversx/mx-chain-go/cmd/termui/provider"
 	"github.com/multiversx/mx-chain-go/cmd/termui/view/termuic"
@@ -21,8 +24,17 @@ type config struct {
 	interval           int
 	address            string
 	logLevel           string
+	fleetAddresses     string
+	fleetLagThreshold  uint64
+	historyWindow      int
 }
 
+// numMissedFetchesUntilOffline is the number of fetch intervals without metrics after which a node is displayed as
+// offline in the fleet view
+const numMissedFetchesUntilOffline = 3
+
+const fleetLogMessage = "the logs are not streamed in the fleet view, press Esc to return to the fleet"
+
 var (
 	nodeHelpTemplate = `NAME:
    {{.Name}} - {{.Usage}}
@@ -83,6 +95,28 @@ VERSION:
 		Usage:       "Will use wss instead of ws when creating the web socket",
 		Destination: &argsConfig.useWss,
 	}
+
+	// fleetAddresses defines a flag for the addresses of the nodes displayed in the fleet view
+	fleetAddresses = cli.StringFlag{
+		Name: "fleet-addresses",
+		Usage: "Comma separated list of node `addresses` to be monitored in the fleet view. If set, the application" +
+			" displays the summary of all the nodes instead of connecting to the node at the provided address.",
+		Destination: &argsConfig.fleetAddresses,
+	}
+	// fleetLagThreshold defines a flag for the number of blocks a node can be behind its shard until displayed as lagging
+	fleetLagThreshold = cli.Uint64Flag{
+		Name:        "fleet-lag-threshold",
+		Usage:       "The number of `blocks` a node can be behind the highest nonce known for its shard until it is displayed as lagging in the fleet view.",
+		Value:       5,
+		Destination: &argsConfig.fleetLagThreshold,
+	}
+	// historyWindow defines a flag for the number of samples displayed in the history charts
+	historyWindow = cli.IntFlag{
+		Name:        "history-window",
+		Usage:       "The number of `samples` kept for the history charts, one sample being taken at each fetch interval.",
+		Value:       300,
+		Destination: &argsConfig.historyWindow,
+	}
 	argsConfig = &config{}
 
 	log    = logger.GetOrCreate("termui")
@@ -93,6 +127,10 @@ func main() {
 	initCliFlags()
 
 	cliApp.Action = func(c *cli.Context) error {
+		if c.IsSet(fleetAddresses.Name) {
+			return startTermuiFleetViewer()
+		}
+
 		return startTermuiViewer(c)
 	}
 
@@ -109,7 +147,11 @@ func startTermuiViewer(ctx *cli.Context) error {
 
 	chanNodeIsStarting := make(chan struct{})
 
-	presenterStatusHandler := presenter.NewPresenterStatusHandler()
+	presenterStatusHandler, err := createPresenter()
+	if err != nil {
+		return err
+	}
+
 	statusMetricsProvider, err := provider.NewStatusMetricsProvider(presenterStatusHandler, nodeAddress, fetchIntervalFlagValue)
 	if err != nil {
 		return err
@@ -155,6 +197,68 @@ func startTermuiViewer(ctx *cli.Context) error {
 	return nil
 }
 
+func startTermuiFleetViewer() error {
+	fetchInterval := argsConfig.interval
+	nodes := make([]fleet.NodeArgs, 0)
+	for _, nodeAddress := range strings.Split(argsConfig.fleetAddresses, ",") {
+		nodeAddress = strings.TrimSpace(nodeAddress)
+		if len(nodeAddress) == 0 {
+			continue
+		}
+
+		presenterStatusHandler, err := createPresenter()
+		if err != nil {
+			return err
+		}
+
+		statusMetricsProvider, err := provider.NewStatusMetricsProvider(presenterStatusHandler, nodeAddress, fetchInterval)
+		if err != nil {
+			return err
+		}
+
+		_, _ = presenterStatusHandler.Write([]byte(fleetLogMessage))
+		nodes = append(nodes, fleet.NodeArgs{
+			Address:            nodeAddress,
+			Presenter:          presenterStatusHandler,
+			UpdateTimeProvider: statusMetricsProvider,
+		})
+		statusMetricsProvider.StartUpdatingData()
+	}
+
+	fleetMonitor, err := fleet.NewFleetMonitor(fleet.ArgsFleetMonitor{
+		Nodes:          nodes,
+		LagThreshold:   argsConfig.fleetLagThreshold,
+		OfflineTimeout: numMissedFetchesUntilOffline * time.Duration(fetchInterval) * time.Millisecond,
+	})
+	if err != nil {
+		return err
+	}
+
+	termuiFleetConsole, err := termuic.NewTermuiFleetConsole(fleetMonitor, fetchInterval)
+	if err != nil {
+		return err
+	}
+
+	err = termuiFleetConsole.Start()
+	if err != nil {
+		return err
+	}
+
+	waitForUserToTerminateApp()
+
+	return nil
+}
+
+func createPresenter() (*presenter.PresenterStatusHandler, error) {
+	presenterStatusHandler := presenter.NewPresenterStatusHandler()
+	err := presenterStatusHandler.SetHistoryWindow(argsConfig.historyWindow)
+	if err != nil {
+		return nil, fmt.Errorf("%w for flag %s", err, historyWindow.Name)
+	}
+
+	return presenterStatusHandler, nil
+}
+
 func initCliFlags() {
 	cliApp = cli.NewApp()
 	cli.AppHelpTemplate = nodeHelpTemplate
@@ -168,6 +272,9 @@ func initCliFlags() {
 		logWithLoggerName,
 		fetchIntervalInMilliseconds,
 		useWss,
+		fleetAddresses,
+		fleetLagThreshold,
+		historyWindow,
 	}
 	cliApp.Authors = []cli.Author{
 		{
@@ -0,0 +1,6 @@
+package presenter
+
+import "errors"
+
+// ErrInvalidHistoryWindow signals that an invalid number of history samples was provided
+var ErrInvalidHistoryWindow = errors.New("invalid history window")
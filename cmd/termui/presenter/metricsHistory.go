@@ -0,0 +1,175 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+)
+
+// defaultHistoryWindow is the number of samples kept for each metric with history
+const defaultHistoryWindow = 300
+
+const maxPercentage = 100
+
+// ringBuffer keeps the most recent values, overwriting the oldest one when full
+type ringBuffer struct {
+	values []float64
+	start  int
+	size   int
+}
+
+func newRingBuffer(capacity int) *ringBuffer {
+	return &ringBuffer{
+		values: make([]float64, capacity),
+	}
+}
+
+func (rb *ringBuffer) add(value float64) {
+	capacity := len(rb.values)
+	if rb.size < capacity {
+		rb.values[(rb.start+rb.size)%capacity] = value
+		rb.size++
+		return
+	}
+
+	rb.values[rb.start] = value
+	rb.start = (rb.start + 1) % capacity
+}
+
+// getValues returns a copy of the values, the oldest one first
+func (rb *ringBuffer) getValues() []float64 {
+	values := make([]float64, 0, rb.size)
+	for i := 0; i < rb.size; i++ {
+		values = append(values, rb.values[(rb.start+i)%len(rb.values)])
+	}
+
+	return values
+}
+
+// historySample holds the counters needed for computing the rates between two samples
+type historySample struct {
+	timestamp      time.Time
+	numTxProcessed uint64
+}
+
+// blockReference holds the last block or round from which the block time or the consensus success is computed
+type blockReference struct {
+	nonce          uint64
+	round          uint64
+	roundTimestamp uint64
+}
+
+func createHistories(window int) map[view.HistoryMetric]*ringBuffer {
+	histories := make(map[view.HistoryMetric]*ringBuffer, len(view.HistoryMetrics))
+	for _, metric := range view.HistoryMetrics {
+		histories[metric] = newRingBuffer(window)
+	}
+
+	return histories
+}
+
+// SetHistoryWindow sets the number of samples kept for each metric with history. The samples already kept are dropped
+func (psh *PresenterStatusHandler) SetHistoryWindow(numSamples int) error {
+	if numSamples < 1 {
+		return ErrInvalidHistoryWindow
+	}
+
+	psh.mutHistory.Lock()
+	psh.histories = createHistories(numSamples)
+	psh.mutHistory.Unlock()
+
+	return nil
+}
+
+// SampleHistory adds the current values of the metrics with history. Should be called after each metrics update
+func (psh *PresenterStatusHandler) SampleHistory() {
+	current := historySample{
+		timestamp:      psh.getTimeHandler(),
+		numTxProcessed: psh.GetNumTxProcessed(),
+	}
+	nonce := psh.GetNonce()
+	round := psh.GetCurrentRound()
+	roundTimestamp := psh.GetCurrentRoundTimestamp()
+
+	psh.mutHistory.Lock()
+	defer psh.mutHistory.Unlock()
+
+	psh.histories[view.HistoryNetworkRecv].add(float64(psh.GetNetworkRecvBps()))
+	psh.histories[view.HistoryNetworkSent].add(float64(psh.GetNetworkSentBps()))
+	psh.histories[view.HistoryMemory].add(float64(psh.GetMemUsedByNode()))
+
+	psh.sampleTPS(current)
+	psh.sampleBlockTime(nonce, roundTimestamp)
+	psh.sampleConsensusSuccess(nonce, round)
+}
+
+func (psh *PresenterStatusHandler) sampleTPS(current historySample) {
+	last := psh.lastHistorySample
+	psh.lastHistorySample = &current
+	if last == nil {
+		return
+	}
+
+	elapsedSeconds := current.timestamp.Sub(last.timestamp).Seconds()
+	if elapsedSeconds <= 0 || current.numTxProcessed < last.numTxProcessed {
+		return
+	}
+
+	psh.histories[view.HistoryTPS].add(float64(current.numTxProcessed-last.numTxProcessed) / elapsedSeconds)
+}
+
+// sampleBlockTime adds the average time between the blocks committed since the last new block, based on the
+// rounds' timestamps
+func (psh *PresenterStatusHandler) sampleBlockTime(nonce uint64, roundTimestamp uint64) {
+	last := psh.lastBlock
+	if nonce == 0 || roundTimestamp == 0 {
+		return
+	}
+	if last == nil || nonce < last.nonce || roundTimestamp < last.roundTimestamp {
+		psh.lastBlock = &blockReference{nonce: nonce, roundTimestamp: roundTimestamp}
+		return
+	}
+	if nonce == last.nonce {
+		return
+	}
+
+	blockTime := float64(roundTimestamp-last.roundTimestamp) / float64(nonce-last.nonce)
+	psh.histories[view.HistoryBlockTime].add(blockTime)
+	psh.lastBlock = &blockReference{nonce: nonce, roundTimestamp: roundTimestamp}
+}
+
+// sampleConsensusSuccess adds, on each new round, the percentage of the rounds passed since the last sample
+// that produced a block
+func (psh *PresenterStatusHandler) sampleConsensusSuccess(nonce uint64, round uint64) {
+	last := psh.lastRound
+	if round == 0 {
+		return
+	}
+	if last == nil || round < last.round || nonce < last.nonce {
+		psh.lastRound = &blockReference{nonce: nonce, round: round}
+		return
+	}
+	if round == last.round {
+		return
+	}
+
+	successPercentage := float64(nonce-last.nonce) * maxPercentage / float64(round-last.round)
+	if successPercentage > maxPercentage {
+		successPercentage = maxPercentage
+	}
+	psh.histories[view.HistoryConsensusSuccess].add(successPercentage)
+	psh.lastRound = &blockReference{nonce: nonce, round: round}
+}
+
+// GetHistory returns the kept values of the provided metric, the oldest one first
+func (psh *PresenterStatusHandler) GetHistory(metric view.HistoryMetric) []float64 {
+	psh.mutHistory.RLock()
+	defer psh.mutHistory.RUnlock()
+
+	history, found := psh.histories[metric]
+	if !found {
+		return make([]float64, 0)
+	}
+
+	return history.getValues()
+}
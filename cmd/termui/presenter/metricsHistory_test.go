@@ -0,0 +1,149 @@
+package presenter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRingBuffer_AddShouldOverwriteTheOldestValues(t *testing.T) {
+	t.Parallel()
+
+	rb := newRingBuffer(3)
+	assert.Equal(t, []float64{}, rb.getValues())
+
+	rb.add(1)
+	rb.add(2)
+	assert.Equal(t, []float64{1, 2}, rb.getValues())
+
+	rb.add(3)
+	rb.add(4)
+	rb.add(5)
+	assert.Equal(t, []float64{3, 4, 5}, rb.getValues())
+}
+
+func TestRingBuffer_GetValuesShouldReturnACopy(t *testing.T) {
+	t.Parallel()
+
+	rb := newRingBuffer(2)
+	rb.add(1)
+
+	values := rb.getValues()
+	values[0] = 100
+
+	assert.Equal(t, []float64{1}, rb.getValues())
+}
+
+func TestPresenterStatusHandler_SetHistoryWindow(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid window should error", func(t *testing.T) {
+		t.Parallel()
+
+		presenterStatusHandler := NewPresenterStatusHandler()
+		err := presenterStatusHandler.SetHistoryWindow(0)
+		assert.Equal(t, ErrInvalidHistoryWindow, err)
+	})
+	t.Run("should keep only the provided number of samples", func(t *testing.T) {
+		t.Parallel()
+
+		presenterStatusHandler := NewPresenterStatusHandler()
+		err := presenterStatusHandler.SetHistoryWindow(2)
+		require.Nil(t, err)
+
+		for i := uint64(1); i <= 4; i++ {
+			presenterStatusHandler.SetUInt64Value(common.MetricMemUsedGolang, i*100)
+			presenterStatusHandler.SampleHistory()
+		}
+
+		assert.Equal(t, []float64{300, 400}, presenterStatusHandler.GetHistory(view.HistoryMemory))
+	})
+}
+
+func TestPresenterStatusHandler_SampleHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("network and memory should be sampled every time", func(t *testing.T) {
+		t.Parallel()
+
+		presenterStatusHandler := NewPresenterStatusHandler()
+		presenterStatusHandler.SetUInt64Value(common.MetricNetworkRecvBps, 10)
+		presenterStatusHandler.SetUInt64Value(common.MetricNetworkSentBps, 20)
+		presenterStatusHandler.SetUInt64Value(common.MetricMemUsedGolang, 30)
+		presenterStatusHandler.SampleHistory()
+		presenterStatusHandler.SampleHistory()
+
+		assert.Equal(t, []float64{10, 10}, presenterStatusHandler.GetHistory(view.HistoryNetworkRecv))
+		assert.Equal(t, []float64{20, 20}, presenterStatusHandler.GetHistory(view.HistoryNetworkSent))
+		assert.Equal(t, []float64{30, 30}, presenterStatusHandler.GetHistory(view.HistoryMemory))
+	})
+	t.Run("tps should be computed from the processed transactions between samples", func(t *testing.T) {
+		t.Parallel()
+
+		presenterStatusHandler := NewPresenterStatusHandler()
+		now := time.Unix(1000, 0)
+		presenterStatusHandler.getTimeHandler = func() time.Time {
+			return now
+		}
+
+		presenterStatusHandler.SetUInt64Value(common.MetricNumProcessedTxs, 100)
+		presenterStatusHandler.SampleHistory()
+		assert.Empty(t, presenterStatusHandler.GetHistory(view.HistoryTPS))
+
+		now = now.Add(2 * time.Second)
+		presenterStatusHandler.SetUInt64Value(common.MetricNumProcessedTxs, 300)
+		presenterStatusHandler.SampleHistory()
+
+		now = now.Add(4 * time.Second)
+		presenterStatusHandler.SetUInt64Value(common.MetricNumProcessedTxs, 340)
+		presenterStatusHandler.SampleHistory()
+
+		assert.Equal(t, []float64{100, 10}, presenterStatusHandler.GetHistory(view.HistoryTPS))
+	})
+	t.Run("block time should be sampled only on new blocks", func(t *testing.T) {
+		t.Parallel()
+
+		presenterStatusHandler := NewPresenterStatusHandler()
+		setBlock := func(nonce uint64, roundTimestamp uint64) {
+			presenterStatusHandler.SetUInt64Value(common.MetricNonce, nonce)
+			presenterStatusHandler.SetUInt64Value(common.MetricCurrentRoundTimestamp, roundTimestamp)
+			presenterStatusHandler.SampleHistory()
+		}
+
+		setBlock(10, 600)
+		setBlock(10, 606)
+		setBlock(11, 612)
+		setBlock(13, 624)
+
+		assert.Equal(t, []float64{12, 6}, presenterStatusHandler.GetHistory(view.HistoryBlockTime))
+	})
+	t.Run("consensus success should be sampled only on new rounds", func(t *testing.T) {
+		t.Parallel()
+
+		presenterStatusHandler := NewPresenterStatusHandler()
+		setRound := func(nonce uint64, round uint64) {
+			presenterStatusHandler.SetUInt64Value(common.MetricNonce, nonce)
+			presenterStatusHandler.SetUInt64Value(common.MetricCurrentRound, round)
+			presenterStatusHandler.SampleHistory()
+		}
+
+		setRound(10, 20)
+		setRound(11, 21)
+		setRound(11, 21)
+		setRound(12, 23)
+		setRound(12, 24)
+
+		assert.Equal(t, []float64{100, 50, 0}, presenterStatusHandler.GetHistory(view.HistoryConsensusSuccess))
+	})
+}
+
+func TestPresenterStatusHandler_GetHistoryUnknownMetric(t *testing.T) {
+	t.Parallel()
+
+	presenterStatusHandler := NewPresenterStatusHandler()
+	assert.Empty(t, presenterStatusHandler.GetHistory("unknown"))
+}
@@ -4,6 +4,9 @@ import (
 	"math/big"
 	"strings"
 	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
 )
 
 // maxLogLines is used to specify how many lines of logs need to store in slice
@@ -18,6 +21,12 @@ type PresenterStatusHandler struct {
 	oldRound                    uint64
 	synchronizationSpeedHistory []uint64
 	totalRewardsOld             *big.Float
+	mutHistory                  sync.RWMutex
+	histories                   map[view.HistoryMetric]*ringBuffer
+	lastHistorySample           *historySample
+	lastBlock                   *blockReference
+	lastRound                   *blockReference
+	getTimeHandler              func() time.Time
 }
 
 // NewPresenterStatusHandler will return an instance of the struct
@@ -26,6 +35,8 @@ func NewPresenterStatusHandler() *PresenterStatusHandler {
 		presenterMetrics:            make(map[string]interface{}),
 		synchronizationSpeedHistory: make([]uint64, 0),
 		totalRewardsOld:             big.NewFloat(0),
+		histories:                   createHistories(defaultHistoryWindow),
+		getTimeHandler:              time.Now,
 	}
 	return psh
 }
@@ -12,6 +12,7 @@ type PresenterHandler interface {
 	SetInt64Value(key string, value int64)
 	SetUInt64Value(key string, value uint64)
 	SetStringValue(key string, value string)
+	SampleHistory()
 	Close()
 	Write(p []byte) (n int, err error)
 	view.Presenter
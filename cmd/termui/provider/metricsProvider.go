@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/multiversx/mx-chain-go/common"
@@ -51,6 +52,7 @@ type StatusMetricsProvider struct {
 	fetchInterval   int
 	shardID         string
 	numTrieNodesSet bool
+	lastUpdateTime  int64
 }
 
 // NewStatusMetricsProvider will return a new instance of a StatusMetricsProvider
@@ -187,6 +189,18 @@ func (smp *StatusMetricsProvider) fetchAndApplyMetrics(metricsPath string) {
 	}
 
 	smp.applyMetricsToPresenter(metricsMap)
+	smp.presenter.SampleHistory()
+	atomic.StoreInt64(&smp.lastUpdateTime, time.Now().UnixNano())
+}
+
+// GetLastUpdateTime returns the time of the last successful status metrics fetch or the zero time if none succeeded
+func (smp *StatusMetricsProvider) GetLastUpdateTime() time.Time {
+	lastUpdateTime := atomic.LoadInt64(&smp.lastUpdateTime)
+	if lastUpdateTime == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, lastUpdateTime)
 }
 
 func (smp *StatusMetricsProvider) loadMetricsFromApi(metricsPath string) (map[string]interface{}, error) {
@@ -13,3 +13,6 @@ var ErrNilGrid = errors.New("nil grid")
 
 // ErrInvalidRefreshTimeInMilliseconds signals that an invalid time in milliseconds was provided
 var ErrInvalidRefreshTimeInMilliseconds = errors.New("invalid refresh time in milliseconds")
+
+// ErrNilFleetPresenter will be returned when a nil FleetPresenter is passed as parameter
+var ErrNilFleetPresenter = errors.New("nil fleet presenter")
@@ -0,0 +1,29 @@
+package view
+
+// HistoryMetric defines a metric whose recent values are kept for the history charts
+type HistoryMetric string
+
+const (
+	// HistoryTPS holds the number of transactions processed per second
+	HistoryTPS HistoryMetric = "tps"
+	// HistoryBlockTime holds the number of seconds between consecutive blocks
+	HistoryBlockTime HistoryMetric = "block time"
+	// HistoryConsensusSuccess holds the percentage of the rounds that produced a block
+	HistoryConsensusSuccess HistoryMetric = "consensus success"
+	// HistoryNetworkRecv holds the number of bytes received per second
+	HistoryNetworkRecv HistoryMetric = "network received"
+	// HistoryNetworkSent holds the number of bytes sent per second
+	HistoryNetworkSent HistoryMetric = "network sent"
+	// HistoryMemory holds the memory used by the node
+	HistoryMemory HistoryMetric = "memory"
+)
+
+// HistoryMetrics contains all the metrics with history
+var HistoryMetrics = []HistoryMetric{
+	HistoryTPS,
+	HistoryBlockTime,
+	HistoryConsensusSuccess,
+	HistoryNetworkRecv,
+	HistoryNetworkSent,
+	HistoryMemory,
+}
@@ -65,6 +65,18 @@ type Presenter interface {
 	GetTrieSyncNumBytesReceived() uint64
 	GetTrieSyncProcessedPercentage() core.OptionalUint64
 
+	GetHistory(metric HistoryMetric) []float64
+
 	InvalidateCache()
 	IsInterfaceNil() bool
 }
+
+// FleetPresenter defines the methods that return information about a fleet of nodes
+type FleetPresenter interface {
+	GetNodesStatus() []NodeStatus
+	GetNodePresenter(address string) Presenter
+	ChangeSortColumn()
+	ToggleSortOrder()
+	GetSortDescription() string
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,34 @@
+package view
+
+// NodeState defines the health of a node, as displayed in the fleet view
+type NodeState string
+
+const (
+	// NodeStateSynchronized defines a node in sync with the rest of its shard
+	NodeStateSynchronized NodeState = "synchronized"
+	// NodeStateLagging defines a node whose nonce is behind the highest nonce known for its shard
+	NodeStateLagging NodeState = "lagging"
+	// NodeStateSyncing defines a node reporting that it is currently syncing
+	NodeStateSyncing NodeState = "syncing"
+	// NodeStateOffline defines a node whose metrics could not be fetched lately
+	NodeStateOffline NodeState = "offline"
+)
+
+// NodeStatus holds the summary of a node, as displayed in the fleet view
+type NodeStatus struct {
+	Address                      string
+	NodeName                     string
+	PeerType                     string
+	ShardID                      uint64
+	Epoch                        uint64
+	Nonce                        uint64
+	HighestNonce                 uint64
+	Lag                          uint64
+	CountConsensus               uint64
+	CountConsensusAcceptedBlocks uint64
+	CountLeader                  uint64
+	NumConnectedPeers            uint64
+	CpuLoadPercent               uint64
+	MemLoadPercent               uint64
+	State                        NodeState
+}
@@ -4,6 +4,8 @@ package termuic
 type TermuiRender interface {
 	// RefreshData method is used to refresh data that are displayed on a grid
 	RefreshData(numMillisecondsRefreshTime int)
+	// ToggleHistoryCharts will switch between displaying the log lines and the history charts
+	ToggleHistoryCharts()
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -114,9 +114,19 @@ func (tc *TermuiConsole) processUiEvents(e ui.Event, numMillisecondsRefreshTime
 		ui.Close()
 		stopApplication()
 		return
+	case "h":
+		tc.toggleHistoryCharts(numMillisecondsRefreshTime)
 	}
 }
 
+func (tc *TermuiConsole) toggleHistoryCharts(numMillisecondsRefreshTime int) {
+	tc.mutRefresh.Lock()
+	tc.consoleRender.ToggleHistoryCharts()
+	tc.mutRefresh.Unlock()
+
+	tc.refreshWindow(numMillisecondsRefreshTime)
+}
+
 func (tc *TermuiConsole) doChanges(counter *uint32, numMillisecondsRefreshTime int) {
 	atomic.AddUint32(counter, 1)
 	if atomic.LoadUint32(counter) > numOfTicksBeforeRedrawing {
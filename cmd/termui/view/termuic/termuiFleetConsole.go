@@ -0,0 +1,156 @@
+package termuic
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view/termuic/termuiRenders"
+)
+
+// TermuiFleetConsole displays the summary of multiple nodes and, on request, the details of one of them
+type TermuiFleetConsole struct {
+	fleetPresenter            view.FleetPresenter
+	fleetRender               *termuiRenders.FleetRender
+	nodeRender                TermuiRender
+	nodeGrid                  *termuiRenders.DrawableContainer
+	mutRefresh                sync.Mutex
+	refreshTimeInMilliseconds int
+}
+
+// NewTermuiFleetConsole method is used to return a new TermuiFleetConsole structure
+func NewTermuiFleetConsole(fleetPresenter view.FleetPresenter, refreshTimeInMilliseconds int) (*TermuiFleetConsole, error) {
+	if fleetPresenter == nil || fleetPresenter.IsInterfaceNil() {
+		return nil, view.ErrNilFleetPresenter
+	}
+	if refreshTimeInMilliseconds < 1 {
+		return nil, view.ErrInvalidRefreshTimeInMilliseconds
+	}
+
+	fleetRender, err := termuiRenders.NewFleetRender(fleetPresenter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TermuiFleetConsole{
+		fleetPresenter:            fleetPresenter,
+		fleetRender:               fleetRender,
+		refreshTimeInMilliseconds: refreshTimeInMilliseconds,
+	}, nil
+}
+
+// Start method - will start termui fleet console
+func (tfc *TermuiFleetConsole) Start() error {
+	go func() {
+		defer func() {
+			log.Debug("closing termui ui")
+			ui.Close()
+		}()
+		_ = ui.Init()
+		tfc.eventLoop()
+	}()
+
+	return nil
+}
+
+func (tfc *TermuiFleetConsole) eventLoop() {
+	uiEvents := ui.PollEvents()
+	sigTerm := make(chan os.Signal, 2)
+	signal.Notify(sigTerm, os.Interrupt, syscall.SIGTERM)
+
+	tfc.refreshWindow()
+	for {
+		select {
+		case <-time.After(time.Millisecond * time.Duration(tfc.refreshTimeInMilliseconds)):
+			tfc.refreshWindow()
+		case <-sigTerm:
+			ui.Clear()
+			return
+		case e := <-uiEvents:
+			tfc.processUiEvents(e)
+		}
+	}
+}
+
+func (tfc *TermuiFleetConsole) processUiEvents(e ui.Event) {
+	switch e.ID {
+	case "<C-c>":
+		ui.Close()
+		stopApplication()
+		return
+	case "<Down>", "j":
+		tfc.fleetRender.SelectNext()
+	case "<Up>", "k":
+		tfc.fleetRender.SelectPrevious()
+	case "s":
+		tfc.fleetPresenter.ChangeSortColumn()
+	case "r":
+		tfc.fleetPresenter.ToggleSortOrder()
+	case "<Enter>":
+		tfc.showNodeDetails(tfc.fleetRender.GetSelectedAddress())
+	case "<Escape>", "<Backspace>":
+		tfc.showFleet()
+	case "h":
+		tfc.toggleHistoryCharts()
+	}
+
+	tfc.refreshWindow()
+}
+
+func (tfc *TermuiFleetConsole) showNodeDetails(address string) {
+	presenter := tfc.fleetPresenter.GetNodePresenter(address)
+	if presenter == nil || presenter.IsInterfaceNil() {
+		return
+	}
+
+	grid := termuiRenders.NewDrawableContainer()
+	nodeRender, err := termuiRenders.NewWidgetsRender(presenter, grid)
+	if err != nil {
+		log.Debug("cannot render the node details", "address", address, "error", err.Error())
+		return
+	}
+
+	tfc.mutRefresh.Lock()
+	tfc.nodeGrid = grid
+	tfc.nodeRender = nodeRender
+	tfc.mutRefresh.Unlock()
+}
+
+func (tfc *TermuiFleetConsole) showFleet() {
+	tfc.mutRefresh.Lock()
+	tfc.nodeGrid = nil
+	tfc.nodeRender = nil
+	tfc.mutRefresh.Unlock()
+}
+
+func (tfc *TermuiFleetConsole) toggleHistoryCharts() {
+	tfc.mutRefresh.Lock()
+	defer tfc.mutRefresh.Unlock()
+
+	if tfc.nodeRender != nil {
+		tfc.nodeRender.ToggleHistoryCharts()
+	}
+}
+
+func (tfc *TermuiFleetConsole) refreshWindow() {
+	tfc.mutRefresh.Lock()
+	defer tfc.mutRefresh.Unlock()
+
+	width, height := ui.TerminalDimensions()
+	if tfc.nodeRender != nil {
+		tfc.nodeGrid.SetRectangle(0, 0, width, height)
+		tfc.nodeRender.RefreshData(tfc.refreshTimeInMilliseconds)
+		ui.Clear()
+		ui.Render(tfc.nodeGrid.TopLeft(), tfc.nodeGrid.TopRight(), tfc.nodeGrid.Bottom())
+		return
+	}
+
+	tfc.fleetRender.SetRectangle(width, height)
+	tfc.fleetRender.RefreshData(tfc.refreshTimeInMilliseconds)
+	ui.Clear()
+	ui.Render(tfc.fleetRender.Drawables()...)
+}
@@ -0,0 +1,148 @@
+package termuiRenders
+
+import (
+	"fmt"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+)
+
+const fleetHeaderHeight = 4
+
+var fleetTableHeader = []string{"Address", "Name", "Shard", "Epoch", "Nonce", "Lag", "Consensus", "Peers", "CPU", "Mem", "State"}
+
+var nodeStateStyles = map[view.NodeState]ui.Style{
+	view.NodeStateSynchronized: ui.NewStyle(ui.ColorGreen),
+	view.NodeStateLagging:      ui.NewStyle(ui.ColorYellow),
+	view.NodeStateSyncing:      ui.NewStyle(ui.ColorRed),
+	view.NodeStateOffline:      ui.NewStyle(ui.ColorRed, ui.ColorClear, ui.ModifierBold),
+}
+
+// FleetRender will define the termui widgets displaying the summary of multiple nodes
+type FleetRender struct {
+	header      *widgets.Paragraph
+	nodes       *widgets.Table
+	presenter   view.FleetPresenter
+	addresses   []string
+	selectedRow int
+}
+
+// NewFleetRender method will create a new FleetRender displaying the nodes provided by the fleet presenter
+func NewFleetRender(presenter view.FleetPresenter) (*FleetRender, error) {
+	if presenter == nil || presenter.IsInterfaceNil() {
+		return nil, view.ErrNilFleetPresenter
+	}
+
+	self := &FleetRender{
+		header:    widgets.NewParagraph(),
+		nodes:     widgets.NewTable(),
+		presenter: presenter,
+		addresses: make([]string, 0),
+	}
+	self.header.Title = "MultiversX fleet view"
+	self.nodes.RowSeparator = false
+	self.nodes.FillRow = true
+	self.nodes.Rows = [][]string{fleetTableHeader}
+
+	return self, nil
+}
+
+// SetRectangle sets the area the fleet view is drawn in
+func (fr *FleetRender) SetRectangle(width int, height int) {
+	fr.header.SetRect(0, 0, width, fleetHeaderHeight)
+	fr.nodes.SetRect(0, fleetHeaderHeight, width, height)
+}
+
+// Drawables returns the widgets to be rendered
+func (fr *FleetRender) Drawables() []ui.Drawable {
+	return []ui.Drawable{fr.header, fr.nodes}
+}
+
+// RefreshData method is used to prepare the data displayed in the fleet view
+func (fr *FleetRender) RefreshData(_ int) {
+	statuses := fr.presenter.GetNodesStatus()
+
+	rows := make([][]string, 0, len(statuses)+1)
+	rows = append(rows, fleetTableHeader)
+	fr.addresses = make([]string, 0, len(statuses))
+	fr.nodes.RowStyles = make(map[int]ui.Style)
+	fr.nodes.RowStyles[0] = ui.NewStyle(ui.ColorWhite, ui.ColorClear, ui.ModifierBold)
+	numNodesInState := make(map[view.NodeState]int)
+	for i, status := range statuses {
+		rows = append(rows, prepareNodeRow(status))
+		fr.addresses = append(fr.addresses, status.Address)
+		numNodesInState[status.State]++
+
+		style := nodeStateStyles[status.State]
+		if i == fr.selectedRow {
+			style.Modifier |= ui.ModifierReverse
+		}
+		fr.nodes.RowStyles[i+1] = style
+	}
+	fr.nodes.Rows = rows
+	fr.nodes.Title = fmt.Sprintf("Nodes: %d | synchronized: %d | lagging: %d | syncing: %d | offline: %d",
+		len(statuses),
+		numNodesInState[view.NodeStateSynchronized],
+		numNodesInState[view.NodeStateLagging],
+		numNodesInState[view.NodeStateSyncing],
+		numNodesInState[view.NodeStateOffline],
+	)
+
+	fr.header.Text = fmt.Sprintf("Sorted by: %s\n"+
+		"Up/Down: select node | Enter: node details | Esc: back to fleet | s: change sort column | r: reverse order",
+		fr.presenter.GetSortDescription())
+}
+
+func prepareNodeRow(status view.NodeStatus) []string {
+	return []string{
+		status.Address,
+		status.NodeName,
+		shardIDToString(status.ShardID),
+		fmt.Sprintf("%d", status.Epoch),
+		fmt.Sprintf("%d / %d", status.Nonce, status.HighestNonce),
+		fmt.Sprintf("%d", status.Lag),
+		fmt.Sprintf("%d / %d (proposed %d)", status.CountConsensusAcceptedBlocks, status.CountConsensus, status.CountLeader),
+		fmt.Sprintf("%d", status.NumConnectedPeers),
+		fmt.Sprintf("%d%%", status.CpuLoadPercent),
+		fmt.Sprintf("%d%%", status.MemLoadPercent),
+		string(status.State),
+	}
+}
+
+func shardIDToString(shardID uint64) string {
+	if shardID == uint64(core.MetachainShardId) {
+		return "meta"
+	}
+
+	return fmt.Sprintf("%d", shardID)
+}
+
+// SelectNext will select the next node, if any
+func (fr *FleetRender) SelectNext() {
+	if fr.selectedRow < len(fr.addresses)-1 {
+		fr.selectedRow++
+	}
+}
+
+// SelectPrevious will select the previous node, if any
+func (fr *FleetRender) SelectPrevious() {
+	if fr.selectedRow > 0 {
+		fr.selectedRow--
+	}
+}
+
+// GetSelectedAddress returns the address of the selected node, as displayed at the last refresh
+func (fr *FleetRender) GetSelectedAddress() string {
+	if fr.selectedRow >= len(fr.addresses) {
+		return ""
+	}
+
+	return fr.addresses[fr.selectedRow]
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fr *FleetRender) IsInterfaceNil() bool {
+	return fr == nil
+}
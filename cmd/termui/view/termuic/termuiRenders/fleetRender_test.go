@@ -0,0 +1,92 @@
+package termuiRenders
+
+import (
+	"testing"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fleetPresenterStub struct {
+	statuses []view.NodeStatus
+}
+
+// GetNodesStatus -
+func (stub *fleetPresenterStub) GetNodesStatus() []view.NodeStatus {
+	return stub.statuses
+}
+
+// GetNodePresenter -
+func (stub *fleetPresenterStub) GetNodePresenter(_ string) view.Presenter {
+	return nil
+}
+
+// ChangeSortColumn -
+func (stub *fleetPresenterStub) ChangeSortColumn() {
+}
+
+// ToggleSortOrder -
+func (stub *fleetPresenterStub) ToggleSortOrder() {
+}
+
+// GetSortDescription -
+func (stub *fleetPresenterStub) GetSortDescription() string {
+	return "address, ascending"
+}
+
+// IsInterfaceNil -
+func (stub *fleetPresenterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestNewFleetRender(t *testing.T) {
+	t.Parallel()
+
+	fr, err := NewFleetRender(nil)
+	assert.Nil(t, fr)
+	assert.Equal(t, view.ErrNilFleetPresenter, err)
+
+	fr, err = NewFleetRender(&fleetPresenterStub{})
+	assert.Nil(t, err)
+	assert.False(t, fr.IsInterfaceNil())
+	assert.Equal(t, "", fr.GetSelectedAddress())
+}
+
+func TestFleetRender_RefreshDataAndSelection(t *testing.T) {
+	t.Parallel()
+
+	presenter := &fleetPresenterStub{
+		statuses: []view.NodeStatus{
+			{Address: "node-0", NodeName: "alpha", Nonce: 100, HighestNonce: 100, State: view.NodeStateSynchronized},
+			{Address: "node-1", ShardID: uint64(core.MetachainShardId), Nonce: 80, HighestNonce: 100, Lag: 20, State: view.NodeStateLagging},
+			{Address: "node-2", State: view.NodeStateOffline},
+		},
+	}
+	fr, err := NewFleetRender(presenter)
+	require.Nil(t, err)
+
+	fr.RefreshData(1000)
+	require.Equal(t, 4, len(fr.nodes.Rows))
+	assert.Equal(t, fleetTableHeader, fr.nodes.Rows[0])
+	assert.Equal(t, []string{"node-1", "", "meta", "0", "80 / 100", "20", "0 / 0 (proposed 0)", "0", "0%", "0%", "lagging"}, fr.nodes.Rows[2])
+	assert.Equal(t, "Nodes: 3 | synchronized: 1 | lagging: 1 | syncing: 0 | offline: 1", fr.nodes.Title)
+	assert.Equal(t, ui.ColorGreen, fr.nodes.RowStyles[1].Fg)
+	assert.NotZero(t, fr.nodes.RowStyles[1].Modifier&ui.ModifierReverse)
+	assert.Equal(t, ui.ColorYellow, fr.nodes.RowStyles[2].Fg)
+	assert.Equal(t, "node-0", fr.GetSelectedAddress())
+
+	fr.SelectPrevious()
+	assert.Equal(t, "node-0", fr.GetSelectedAddress())
+	for i := 0; i < 5; i++ {
+		fr.SelectNext()
+	}
+	assert.Equal(t, "node-2", fr.GetSelectedAddress())
+
+	fr.RefreshData(1000)
+	assert.Zero(t, fr.nodes.RowStyles[1].Modifier&ui.ModifierReverse)
+	assert.Equal(t, ui.ColorRed, fr.nodes.RowStyles[3].Fg)
+	assert.NotZero(t, fr.nodes.RowStyles[3].Modifier&ui.ModifierReverse)
+}
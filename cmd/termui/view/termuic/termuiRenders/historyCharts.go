@@ -0,0 +1,81 @@
+package termuiRenders
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+)
+
+type historyChartInfo struct {
+	title       string
+	valueFormat func(value float64) string
+}
+
+var historyChartsInfo = map[view.HistoryMetric]historyChartInfo{
+	view.HistoryTPS:              {title: "TPS", valueFormat: formatFloat},
+	view.HistoryBlockTime:        {title: "Block time (s)", valueFormat: formatFloat},
+	view.HistoryConsensusSuccess: {title: "Consensus success (%)", valueFormat: formatFloat},
+	view.HistoryNetworkRecv:      {title: "Network received", valueFormat: formatBytesPerSecond},
+	view.HistoryNetworkSent:      {title: "Network sent", valueFormat: formatBytesPerSecond},
+	view.HistoryMemory:           {title: "Memory used", valueFormat: formatBytes},
+}
+
+func (wr *WidgetsRender) prepareHistoryCharts() {
+	for metric, chart := range wr.historyCharts {
+		info := historyChartsInfo[metric]
+		values := getLastValues(wr.presenter.GetHistory(metric), chart.Inner.Dx())
+		current, minValue, maxValue := getCurrentMinMax(values)
+
+		chart.Title = fmt.Sprintf("%s: %s (min: %s / max: %s)", info.title,
+			info.valueFormat(current), info.valueFormat(minValue), info.valueFormat(maxValue))
+		chart.Sparklines[0].Data = values
+		// the sparkline scales its bars to the max value, so an all-zero history needs a non-zero scale
+		chart.Sparklines[0].MaxVal = maxValue
+		if maxValue <= 0 {
+			chart.Sparklines[0].MaxVal = 1
+		}
+	}
+}
+
+// getLastValues returns the most recent values which fit in the provided width
+func getLastValues(values []float64, width int) []float64 {
+	if width <= 0 {
+		return make([]float64, 0)
+	}
+	if len(values) > width {
+		return values[len(values)-width:]
+	}
+
+	return values
+}
+
+func getCurrentMinMax(values []float64) (float64, float64, float64) {
+	if len(values) == 0 {
+		return 0, 0, 0
+	}
+
+	minValue, maxValue := values[0], values[0]
+	for _, value := range values {
+		if value < minValue {
+			minValue = value
+		}
+		if value > maxValue {
+			maxValue = value
+		}
+	}
+
+	return values[len(values)-1], minValue, maxValue
+}
+
+func formatFloat(value float64) string {
+	return fmt.Sprintf("%.2f", value)
+}
+
+func formatBytes(value float64) string {
+	return core.ConvertBytes(uint64(value))
+}
+
+func formatBytesPerSecond(value float64) string {
+	return formatBytes(value) + "/s"
+}
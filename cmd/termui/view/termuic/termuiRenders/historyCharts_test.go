@@ -0,0 +1,32 @@
+package termuiRenders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLastValues(t *testing.T) {
+	t.Parallel()
+
+	values := []float64{1, 2, 3, 4}
+
+	assert.Empty(t, getLastValues(values, 0))
+	assert.Equal(t, []float64{3, 4}, getLastValues(values, 2))
+	assert.Equal(t, values, getLastValues(values, 4))
+	assert.Equal(t, values, getLastValues(values, 10))
+}
+
+func TestGetCurrentMinMax(t *testing.T) {
+	t.Parallel()
+
+	current, minValue, maxValue := getCurrentMinMax(nil)
+	assert.Zero(t, current)
+	assert.Zero(t, minValue)
+	assert.Zero(t, maxValue)
+
+	current, minValue, maxValue = getCurrentMinMax([]float64{5, 2, 9, 4})
+	assert.Equal(t, float64(4), current)
+	assert.Equal(t, float64(2), minValue)
+	assert.Equal(t, float64(9), maxValue)
+}
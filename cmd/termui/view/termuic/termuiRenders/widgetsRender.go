@@ -34,6 +34,10 @@ type WidgetsRender struct {
 
 	networkBytesInEpoch *widgets.Gauge
 
+	gridLogs      *ui.Grid
+	gridCharts    *ui.Grid
+	historyCharts map[view.HistoryMetric]*widgets.SparklineGroup
+
 	presenter view.Presenter
 }
 
@@ -74,6 +78,13 @@ func (wr *WidgetsRender) initWidgets() {
 	wr.networkBytesInEpoch = widgets.NewGauge()
 
 	wr.lLog = widgets.NewList()
+
+	wr.historyCharts = make(map[view.HistoryMetric]*widgets.SparklineGroup, len(view.HistoryMetrics))
+	for _, metric := range view.HistoryMetrics {
+		sparkline := widgets.NewSparkline()
+		sparkline.LineColor = ui.ColorGreen
+		wr.historyCharts[metric] = widgets.NewSparklineGroup(sparkline)
+	}
 }
 
 func (wr *WidgetsRender) setGrid() {
@@ -98,12 +109,38 @@ func (wr *WidgetsRender) setGrid() {
 		ui.NewRow(3.0/22, colNetworkSent, colNetworkRecv),
 	)
 
-	gridBottom := ui.NewGrid()
-	gridBottom.Set(ui.NewRow(1.0, wr.lLog))
+	wr.gridLogs = ui.NewGrid()
+	wr.gridLogs.Set(ui.NewRow(1.0, wr.lLog))
+
+	wr.gridCharts = ui.NewGrid()
+	wr.gridCharts.Set(
+		ui.NewRow(1.0/2,
+			ui.NewCol(1.0/3, wr.historyCharts[view.HistoryTPS]),
+			ui.NewCol(1.0/3, wr.historyCharts[view.HistoryBlockTime]),
+			ui.NewCol(1.0/3, wr.historyCharts[view.HistoryConsensusSuccess]),
+		),
+		ui.NewRow(1.0/2,
+			ui.NewCol(1.0/3, wr.historyCharts[view.HistoryNetworkRecv]),
+			ui.NewCol(1.0/3, wr.historyCharts[view.HistoryNetworkSent]),
+			ui.NewCol(1.0/3, wr.historyCharts[view.HistoryMemory]),
+		),
+	)
 
 	wr.container.SetTopLeft(gridLeft)
 	wr.container.SetTopRight(gridRight)
-	wr.container.SetBottom(gridBottom)
+	wr.container.SetBottom(wr.gridLogs)
+}
+
+// ToggleHistoryCharts will switch the bottom area between the log lines and the history charts
+func (wr *WidgetsRender) ToggleHistoryCharts() {
+	next := wr.gridCharts
+	if wr.container.Bottom() == wr.gridCharts {
+		next = wr.gridLogs
+	}
+
+	rect := wr.container.Bottom().GetRect()
+	next.SetRect(rect.Min.X, rect.Min.Y, rect.Max.X, rect.Max.Y)
+	wr.container.SetBottom(next)
 }
 
 // RefreshData method is used to prepare data that are displayed on container
@@ -113,6 +150,7 @@ func (wr *WidgetsRender) RefreshData(numMillisecondsRefreshTime int) {
 	wr.prepareBlockInfo()
 	wr.prepareListWithLogsForDisplay()
 	wr.prepareLoads()
+	wr.prepareHistoryCharts()
 }
 
 func (wr *WidgetsRender) prepareInstanceInfo() {
@@ -346,7 +384,7 @@ func (wr *WidgetsRender) prepareBlockInfo() {
 }
 
 func (wr *WidgetsRender) prepareListWithLogsForDisplay() {
-	wr.lLog.Title = "Log info:"
+	wr.lLog.Title = "Log info (h: history charts):"
 	wr.lLog.TextStyle = ui.NewStyle(ui.ColorWhite)
 
 	logData := wr.presenter.GetLogLines()
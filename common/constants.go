@@ -895,6 +895,24 @@ const MetricTrieSyncNumReceivedBytes = "erd_trie_sync_num_bytes_received"
 // MetricTrieSyncNumProcessedNodes is the metric that outputs the number of trie nodes processed for accounts during trie sync
 const MetricTrieSyncNumProcessedNodes = "erd_trie_sync_num_nodes_processed"
 
+// MetricSCQueryHistoricalRootsCacheHits is the metric that outputs the number of historical roots served from the SC query cache
+const MetricSCQueryHistoricalRootsCacheHits = "erd_sc_query_historical_roots_cache_hits"
+
+// MetricSCQueryHistoricalRootsCacheMisses is the metric that outputs the number of historical roots fetched from storage by the SC query services
+const MetricSCQueryHistoricalRootsCacheMisses = "erd_sc_query_historical_roots_cache_misses"
+
+// MetricSCQueryHistoricalTriesCacheHits is the metric that outputs the number of historical state tries reused from the SC query cache
+const MetricSCQueryHistoricalTriesCacheHits = "erd_sc_query_historical_tries_cache_hits"
+
+// MetricSCQueryHistoricalTriesCacheMisses is the metric that outputs the number of historical state tries recreated by the SC query services
+const MetricSCQueryHistoricalTriesCacheMisses = "erd_sc_query_historical_tries_cache_misses"
+
+// MetricSCQueryResultsCacheHits is the metric that outputs the number of historical SC query results served from cache
+const MetricSCQueryResultsCacheHits = "erd_sc_query_results_cache_hits"
+
+// MetricSCQueryResultsCacheMisses is the metric that outputs the number of historical SC queries that had to be executed
+const MetricSCQueryResultsCacheMisses = "erd_sc_query_results_cache_misses"
+
 // FullArchiveMetricSuffix is the suffix added to metrics specific for full archive network
 const FullArchiveMetricSuffix = "_full_archive"
 
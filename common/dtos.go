@@ -1,7 +1,11 @@
 package common
 
 import (
+	"encoding/json"
+
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
 )
 
 // GetProofResponse is a struct that stores the response of a GetProof API request
@@ -91,3 +95,371 @@ type AuctionListValidatorAPIResponse struct {
 	QualifiedTopUp string         `json:"qualifiedTopUp"`
 	Nodes          []*AuctionNode `json:"nodes"`
 }
+
+// AuctionSimulationOwnerChange holds the hypothetical changes of an owner to be applied when simulating the auction
+type AuctionSimulationOwnerChange struct {
+	Owner         string   `json:"owner"`
+	TopUpDelta    string   `json:"topUpDelta"`
+	UnStakedNodes []string `json:"unStakedNodes"`
+	NumNewNodes   uint32   `json:"numNewNodes"`
+}
+
+// AuctionSimulationAPIRequest holds the changes to be applied when simulating the auction
+type AuctionSimulationAPIRequest struct {
+	Changes []*AuctionSimulationOwnerChange `json:"changes"`
+}
+
+// AuctionSimulationNodeChange holds a node whose auction selection status differs in the simulated auction
+type AuctionSimulationNodeChange struct {
+	BlsKey   string `json:"blsKey"`
+	Owner    string `json:"owner"`
+	Selected bool   `json:"selected"`
+}
+
+// AuctionSimulationAPIResponse holds the result of an auction simulation for responding to API calls
+type AuctionSimulationAPIResponse struct {
+	CurrentThreshold   string                             `json:"currentThreshold"`
+	SimulatedThreshold string                             `json:"simulatedThreshold"`
+	AuctionList        []*AuctionListValidatorAPIResponse `json:"auctionList"`
+	ChangedNodes       []*AuctionSimulationNodeChange     `json:"changedNodes"`
+}
+
+// ValidatorEpochStatisticsAPIResponse holds the statistics of a validator as recorded at the end of an epoch
+type ValidatorEpochStatisticsAPIResponse struct {
+	Epoch                         uint32  `json:"epoch"`
+	ShardId                       uint32  `json:"shardId"`
+	ValidatorStatus               string  `json:"validatorStatus"`
+	Rating                        float32 `json:"rating"`
+	TempRating                    float32 `json:"tempRating"`
+	NumLeaderSuccess              uint32  `json:"numLeaderSuccess"`
+	NumLeaderFailure              uint32  `json:"numLeaderFailure"`
+	NumValidatorSuccess           uint32  `json:"numValidatorSuccess"`
+	NumValidatorFailure           uint32  `json:"numValidatorFailure"`
+	NumValidatorIgnoredSignatures uint32  `json:"numValidatorIgnoredSignatures"`
+}
+
+// RewardsBreakdownAPIResponse holds the economics data used when computing the rewards of an epoch, along with the
+// rewards of the eligible nodes grouped by owners, for responding to API calls
+type RewardsBreakdownAPIResponse struct {
+	Epoch                            uint32                              `json:"epoch"`
+	TotalSupply                      string                              `json:"totalSupply"`
+	TotalToDistribute                string                              `json:"totalToDistribute"`
+	TotalNewlyMinted                 string                              `json:"totalNewlyMinted"`
+	RewardsPerBlock                  string                              `json:"rewardsPerBlock"`
+	RewardsForProtocolSustainability string                              `json:"rewardsForProtocolSustainability"`
+	NodePrice                        string                              `json:"nodePrice"`
+	DeveloperFees                    string                              `json:"developerFees"`
+	LeaderFees                       string                              `json:"leaderFees"`
+	RewardsForBlocks                 string                              `json:"rewardsForBlocks"`
+	BaseRewards                      string                              `json:"baseRewards"`
+	TopUpRewards                     string                              `json:"topUpRewards"`
+	TotalStakeEligible               string                              `json:"totalStakeEligible"`
+	TotalTopUpEligible               string                              `json:"totalTopUpEligible"`
+	NumberOfBlocks                   uint64                              `json:"numberOfBlocks"`
+	Owners                           []*OwnerRewardsBreakdownAPIResponse `json:"owners"`
+}
+
+// OwnerRewardsBreakdownAPIResponse holds the staking data of an owner along with the rewards of its eligible nodes in
+// an epoch, for responding to API calls
+type OwnerRewardsBreakdownAPIResponse struct {
+	Owner          string                             `json:"owner"`
+	NumStakedNodes int64                              `json:"numStakedNodes"`
+	TotalStaked    string                             `json:"totalStaked"`
+	TotalTopUp     string                             `json:"totalTopUp"`
+	BaseRewards    string                             `json:"baseRewards"`
+	TopUpRewards   string                             `json:"topUpRewards"`
+	LeaderFees     string                             `json:"leaderFees"`
+	TotalRewards   string                             `json:"totalRewards"`
+	Nodes          []*NodeRewardsBreakdownAPIResponse `json:"nodes"`
+}
+
+// NodeRewardsBreakdownAPIResponse holds the rewards of an eligible node in an epoch along with the data used when
+// computing them, for responding to API calls
+type NodeRewardsBreakdownAPIResponse struct {
+	BlsKey                     string `json:"blsKey"`
+	RewardAddress              string `json:"rewardAddress"`
+	ShardId                    uint32 `json:"shardId"`
+	Rating                     uint32 `json:"rating"`
+	NumSelectedInSuccessBlocks uint32 `json:"numSelectedInSuccessBlocks"`
+	Offline                    bool   `json:"offline"`
+	TopUpStake                 string `json:"topUpStake"`
+	BaseReward                 string `json:"baseReward"`
+	TopUpReward                string `json:"topUpReward"`
+	LeaderFees                 string `json:"leaderFees"`
+	TotalReward                string `json:"totalReward"`
+}
+
+// AntifloodPeerQuota holds the quota counters of a peer, as measured by a flood preventer in the current interval
+type AntifloodPeerQuota struct {
+	Pid                   string `json:"pid"`
+	NumReceivedMessages   uint32 `json:"numReceivedMessages"`
+	SizeReceivedMessages  uint64 `json:"sizeReceivedMessages"`
+	NumProcessedMessages  uint32 `json:"numProcessedMessages"`
+	SizeProcessedMessages uint64 `json:"sizeProcessedMessages"`
+}
+
+// AntifloodQuotaStatus holds the limits and the per-peer usage of a quota flood preventer
+type AntifloodQuotaStatus struct {
+	Name                          string                `json:"name"`
+	BaseMaxNumMessagesPerPeer     uint32                `json:"baseMaxNumMessagesPerPeer"`
+	ComputedMaxNumMessagesPerPeer uint32                `json:"computedMaxNumMessagesPerPeer"`
+	MaxTotalSizePerPeer           uint64                `json:"maxTotalSizePerPeer"`
+	PercentReserved               float32               `json:"percentReserved"`
+	Peers                         []*AntifloodPeerQuota `json:"peers"`
+}
+
+// AntifloodTopicStatus holds the limit and the per-peer number of messages received on a topic
+type AntifloodTopicStatus struct {
+	Topic              string            `json:"topic"`
+	MaxMessagesPerPeer uint32            `json:"maxMessagesPerPeer"`
+	Peers              map[string]uint32 `json:"peers"`
+}
+
+// BlacklistedPeer holds a blacklisted peer and the unix timestamp (in seconds) when its ban expires
+type BlacklistedPeer struct {
+	Pid       string `json:"pid"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+// PeerRating holds the persisted rating of a peer
+type PeerRating struct {
+	Pid       string `json:"pid"`
+	Rating    int32  `json:"rating"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+// PeerRatingInfo holds the current rating of a peer and the unix timestamp of its last update
+type PeerRatingInfo struct {
+	Pid        core.PeerID
+	Rating     int32
+	LastUpdate int64
+}
+
+// PeerReputationSnapshot holds the blacklisted peers and the peers ratings at a given moment
+type PeerReputationSnapshot struct {
+	BlacklistedPeers []*BlacklistedPeer `json:"blacklistedPeers"`
+	Ratings          []*PeerRating      `json:"ratings"`
+}
+
+// SignedBlockRecord holds the last block signed by a validator key. The public key and the header hash are hex encoded
+type SignedBlockRecord struct {
+	PublicKey  string `json:"publicKey"`
+	Epoch      uint32 `json:"epoch"`
+	Round      int64  `json:"round"`
+	HeaderHash string `json:"headerHash"`
+}
+
+// SlashingProtectionSnapshot holds the signed block records of all the validator keys seen by a node
+type SlashingProtectionSnapshot struct {
+	Records []*SignedBlockRecord `json:"records"`
+}
+
+// OutportReplayStatus holds the progress of an outport blocks replay
+type OutportReplayStatus struct {
+	InProgress        bool   `json:"inProgress"`
+	Target            string `json:"target"`
+	StartNonce        uint64 `json:"startNonce"`
+	EndNonce          uint64 `json:"endNonce"`
+	LastReplayedNonce uint64 `json:"lastReplayedNonce"`
+	NumReplayedBlocks uint64 `json:"numReplayedBlocks"`
+	Error             string `json:"error"`
+}
+
+// GovernanceConfigAPIResponse holds the governance configuration for responding to API calls
+type GovernanceConfigAPIResponse struct {
+	ProposalFee       string `json:"proposalFee"`
+	MinQuorum         string `json:"minQuorum"`
+	MinPassThreshold  string `json:"minPassThreshold"`
+	MinVetoThreshold  string `json:"minVetoThreshold"`
+	LastProposalNonce uint64 `json:"lastProposalNonce"`
+}
+
+// GovernanceProposalAPIResponse holds a governance proposal, along with its status and votes tallies, for responding to API calls
+type GovernanceProposalAPIResponse struct {
+	Nonce          uint64 `json:"nonce"`
+	CommitHash     string `json:"commitHash"`
+	Issuer         string `json:"issuer"`
+	ProposalCost   string `json:"proposalCost"`
+	StartVoteEpoch uint64 `json:"startVoteEpoch"`
+	EndVoteEpoch   uint64 `json:"endVoteEpoch"`
+	Status         string `json:"status"`
+	QuorumStake    string `json:"quorumStake"`
+	Yes            string `json:"yes"`
+	No             string `json:"no"`
+	Veto           string `json:"veto"`
+	Abstain        string `json:"abstain"`
+	Closed         bool   `json:"closed"`
+	Passed         bool   `json:"passed"`
+}
+
+// GovernanceVotesAPIResponse holds the nonces of the proposals an address has voted for, directly or through delegation
+type GovernanceVotesAPIResponse struct {
+	Direct    []uint64 `json:"direct"`
+	Delegated []uint64 `json:"delegated"`
+}
+
+// GovernanceDelegatedVoteInfoAPIResponse holds the voting stake and power used by a delegation contract on a proposal
+type GovernanceDelegatedVoteInfoAPIResponse struct {
+	UsedStake  string `json:"usedStake"`
+	UsedPower  string `json:"usedPower"`
+	TotalStake string `json:"totalStake"`
+	TotalPower string `json:"totalPower"`
+}
+
+// DelegationContractConfigAPIResponse holds the configuration of a delegation contract for responding to API calls
+type DelegationContractConfigAPIResponse struct {
+	Owner                       string `json:"owner"`
+	ServiceFee                  uint64 `json:"serviceFee"`
+	MaxDelegationCap            string `json:"maxDelegationCap"`
+	InitialOwnerFunds           string `json:"initialOwnerFunds"`
+	AutomaticActivation         bool   `json:"automaticActivation"`
+	WithDelegationCap           bool   `json:"withDelegationCap"`
+	ChangeableServiceFee        bool   `json:"changeableServiceFee"`
+	CheckCapOnReDelegateRewards bool   `json:"checkCapOnReDelegateRewards"`
+	CreatedNonce                uint64 `json:"createdNonce"`
+	UnBondPeriodInEpochs        uint64 `json:"unBondPeriodInEpochs"`
+	TotalActiveStake            string `json:"totalActiveStake"`
+	NumDelegators               uint64 `json:"numDelegators"`
+}
+
+// DelegationNodeStatesAPIResponse holds the BLS keys of a delegation contract, grouped by their state
+type DelegationNodeStatesAPIResponse struct {
+	Staked    []string `json:"staked"`
+	NotStaked []string `json:"notStaked"`
+	UnStaked  []string `json:"unStaked"`
+}
+
+// DelegationUnDelegatedFundAPIResponse holds an undelegated fund and the number of epochs left until it can be withdrawn
+type DelegationUnDelegatedFundAPIResponse struct {
+	Value           string `json:"value"`
+	RemainingEpochs uint64 `json:"remainingEpochs"`
+}
+
+// DelegatorAPIResponse holds the funds of a delegator in a delegation contract for responding to API calls
+type DelegatorAPIResponse struct {
+	Address          string                                  `json:"address"`
+	ActiveStake      string                                  `json:"activeStake"`
+	ClaimableRewards string                                  `json:"claimableRewards"`
+	UnStaked         string                                  `json:"unStaked"`
+	UnBondable       string                                  `json:"unBondable"`
+	UnDelegatedList  []*DelegationUnDelegatedFundAPIResponse `json:"unDelegatedList"`
+}
+
+// DelegatorStakeAPIResponse holds the active stake of a delegator
+type DelegatorStakeAPIResponse struct {
+	Address     string `json:"address"`
+	ActiveStake string `json:"activeStake"`
+}
+
+// DelegatorsListAPIResponse holds a page of the delegators list of a delegation contract
+type DelegatorsListAPIResponse struct {
+	Delegators    []*DelegatorStakeAPIResponse `json:"delegators"`
+	NumDelegators uint64                       `json:"numDelegators"`
+	Page          uint32                       `json:"page"`
+	PageSize      uint32                       `json:"pageSize"`
+}
+
+// UnBondingFundAPIResponse holds an unstaked amount, the number of epochs left until it can be withdrawn and the
+// epoch starting with which it can be withdrawn
+type UnBondingFundAPIResponse struct {
+	Value           string `json:"value"`
+	RemainingEpochs uint64 `json:"remainingEpochs"`
+	WithdrawEpoch   uint32 `json:"withdrawEpoch"`
+	Withdrawable    bool   `json:"withdrawable"`
+}
+
+// StakedNodeAPIResponse holds the status of a BLS key registered by an owner in the staking system smart contracts
+type StakedNodeAPIResponse struct {
+	BlsKey                string `json:"blsKey"`
+	Status                string `json:"status"`
+	RemainingUnBondRounds uint64 `json:"remainingUnBondRounds,omitempty"`
+}
+
+// DelegationPositionAPIResponse holds the funds of an address in one delegation contract
+type DelegationPositionAPIResponse struct {
+	Contract         string                      `json:"contract"`
+	ActiveStake      string                      `json:"activeStake"`
+	ClaimableRewards string                      `json:"claimableRewards"`
+	UnStaked         string                      `json:"unStaked"`
+	UnBondable       string                      `json:"unBondable"`
+	UnDelegatedList  []*UnBondingFundAPIResponse `json:"unDelegatedList"`
+}
+
+// OwnerStakingInfoAPIResponse aggregates the direct staking data, the nodes status and the delegation positions of
+// an owner, along with the epochs at which the unstaked amounts can be withdrawn
+type OwnerStakingInfoAPIResponse struct {
+	Owner                string                           `json:"owner"`
+	CurrentEpoch         uint32                           `json:"currentEpoch"`
+	UnBondPeriodInEpochs uint32                           `json:"unBondPeriodInEpochs"`
+	UnBondPeriodInRounds uint64                           `json:"unBondPeriodInRounds"`
+	TotalStaked          string                           `json:"totalStaked"`
+	TopUp                string                           `json:"topUp"`
+	NumActiveNodes       uint64                           `json:"numActiveNodes"`
+	TotalUnStaked        string                           `json:"totalUnStaked"`
+	TotalWithdrawable    string                           `json:"totalWithdrawable"`
+	Nodes                []*StakedNodeAPIResponse         `json:"nodes"`
+	UnStakedList         []*UnBondingFundAPIResponse      `json:"unStakedList"`
+	TotalDelegated       string                           `json:"totalDelegated"`
+	Delegations          []*DelegationPositionAPIResponse `json:"delegations"`
+}
+
+// SCQueryResultAPIResponse is a struct that holds the result of one SC query from a batch of SC queries
+type SCQueryResultAPIResponse struct {
+	Data    *vm.VMOutputApi    `json:"data"`
+	Decoded []*ABIDecodedValue `json:"decoded,omitempty"`
+	Error   string             `json:"error"`
+}
+
+// ABIDecodedValue is a struct that holds a value decoded using the ABI registered for a contract
+type ABIDecodedValue struct {
+	Name  string      `json:"name,omitempty"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// ABIDecodedEvent is a struct that holds an event decoded using the ABI registered for the contract that emitted it
+type ABIDecodedEvent struct {
+	Address    string             `json:"address"`
+	Identifier string             `json:"identifier"`
+	Arguments  []*ABIDecodedValue `json:"arguments"`
+}
+
+// ABIDecodedTransactionAPIResponse is a struct that holds the function call, the return data and the events of a
+// transaction, decoded using the ABIs registered for the involved contracts
+type ABIDecodedTransactionAPIResponse struct {
+	Contract   string             `json:"contract,omitempty"`
+	Function   string             `json:"function,omitempty"`
+	Arguments  []*ABIDecodedValue `json:"arguments,omitempty"`
+	ReturnData []*ABIDecodedValue `json:"returnData,omitempty"`
+	Events     []*ABIDecodedEvent `json:"events,omitempty"`
+}
+
+// ContractVerificationArtifact is a struct that holds the reproducible build artifact of a smart contract, submitted
+// for verification: the hex encoded wasm code along with the source metadata
+type ContractVerificationArtifact struct {
+	Code            string          `json:"code"`
+	CompilerVersion string          `json:"compilerVersion"`
+	SourceHash      string          `json:"sourceHash"`
+	SourceURL       string          `json:"sourceUrl,omitempty"`
+	BuildImage      string          `json:"buildImage,omitempty"`
+	ABI             json.RawMessage `json:"abi,omitempty"`
+}
+
+// VerifiedContractAPIResponse is a struct that holds the verification record of a smart contract. Only the code hash
+// is verified by the node, against the deployed code, the rest of the metadata being returned as it was submitted
+type VerifiedContractAPIResponse struct {
+	Address            string                     `json:"address"`
+	CodeHash           string                     `json:"codeHash"`
+	UnverifiedMetadata *SubmittedContractMetadata `json:"unverifiedMetadata"`
+	Timestamp          int64                      `json:"timestamp"`
+}
+
+// SubmittedContractMetadata is a struct that holds the source metadata supplied by the submitter of a contract
+// verification. It is not checked by the node against the verified code
+type SubmittedContractMetadata struct {
+	CompilerVersion string          `json:"compilerVersion"`
+	SourceHash      string          `json:"sourceHash"`
+	SourceURL       string          `json:"sourceUrl,omitempty"`
+	BuildImage      string          `json:"buildImage,omitempty"`
+	ABI             json.RawMessage `json:"abi,omitempty"`
+}
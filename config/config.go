@@ -157,6 +157,9 @@ type Config struct {
 	SmartContractsStorageForSCQuery StorageConfig
 	TrieEpochRootHashStorage        StorageConfig
 	SmartContractsStorageSimulate   StorageConfig
+	ValidatorsHistoryStorage        StorageConfig
+	RewardsBreakdownStorage         StorageConfig
+	ContractsVerificationStorage    StorageConfig
 
 	BootstrapStorage StorageConfig
 	MetaBlockStorage StorageConfig
@@ -219,6 +222,7 @@ type Config struct {
 	SoftwareVersionConfig SoftwareVersionConfig
 	GatewayMetricsConfig  GatewayMetricsConfig
 	DbLookupExtensions    DbLookupExtensionsConfig
+	ABIRegistry           ABIRegistryConfig
 	Versions              VersionsConfig
 	Logs                  LogsConfig
 	TrieSync              TrieSyncConfig
@@ -226,8 +230,13 @@ type Config struct {
 	VMOutputCacher        CacheConfig
 
 	PeersRatingConfig   PeersRatingConfig
+	PeerReputation      PeerReputationConfig
 	PoolsCleanersConfig PoolsCleanersConfig
 	Redundancy          RedundancyConfig
+	ManagedKeys         ManagedKeysConfig
+	RemoteSigner        RemoteSignerConfig
+	SlashingProtection  SlashingProtectionConfig
+	OutportQueue        OutportQueueConfig
 }
 
 // PeersRatingConfig will hold settings related to peers rating
@@ -236,6 +245,14 @@ type PeersRatingConfig struct {
 	BadRatedCacheCapacity int
 }
 
+// PeerReputationConfig will hold settings related to the persistence of the peers blacklist and ratings
+type PeerReputationConfig struct {
+	Enabled            bool
+	SaveIntervalInSec  uint32
+	RatingsExpiryInSec uint32
+	Storage            StorageConfig
+}
+
 // LogsConfig will hold settings related to the logging sub-system
 type LogsConfig struct {
 	LogFileLifeSpanInSec int
@@ -335,6 +352,7 @@ type WebServerAntifloodConfig struct {
 	SameSourceResetIntervalInSec       uint32
 	TrieOperationsDeadlineMilliseconds uint32
 	GetAddressesBulkMaxSize            uint32
+	VmQueryMultipleMaxSize             uint32
 	VmQueryDelayAfterStartInSec        uint32
 	EndpointsThrottlers                []EndpointsThrottlersConfig
 }
@@ -424,7 +442,10 @@ type WasmVMVersionByEpoch struct {
 // QueryVirtualMachineConfig holds the configuration for the virtual machine(s) used in query process
 type QueryVirtualMachineConfig struct {
 	VirtualMachineConfig
-	NumConcurrentVMs int
+	NumConcurrentVMs             int
+	HistoricalRootsCacheCapacity int
+	HistoricalTriesCacheCapacity int
+	QueryResultsCacheCapacity    int
 }
 
 // VirtualMachineGasConfig holds the configuration for the virtual machine(s) gas operations
@@ -467,6 +488,12 @@ type LogsAndEventsConfig struct {
 	TxLogsStorage        StorageConfig
 }
 
+// ABIRegistryConfig holds the configuration for the contracts ABI registry used by the API
+type ABIRegistryConfig struct {
+	Enabled   bool
+	Directory string
+}
+
 // DbLookupExtensionsConfig holds the configuration for the db lookup extensions
 type DbLookupExtensionsConfig struct {
 	Enabled                            bool
@@ -541,9 +568,17 @@ type ProcessDebugConfig struct {
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	Logging     ApiLoggingConfig
+	Admin       ApiAdminConfig
 	APIPackages map[string]APIPackageConfig
 }
 
+// ApiAdminConfig holds the credentials required by the Rest API routes that can alter the node's state
+type ApiAdminConfig struct {
+	Enabled  bool
+	Username string
+	Password string
+}
+
 // ApiLoggingConfig holds the configuration related to API requests logging
 type ApiLoggingConfig struct {
 	LoggingEnabled          bool
@@ -639,3 +674,29 @@ type PoolsCleanersConfig struct {
 type RedundancyConfig struct {
 	MaxRoundsOfInactivityAccepted int
 }
+
+// ManagedKeysConfig represents the config options to be used when changing the managed keys of a multikey node at runtime
+type ManagedKeysConfig struct {
+	FileWatcherEnabled              bool
+	FileWatcherPollingIntervalInSec uint32
+}
+
+// RemoteSignerConfig represents the config options to be used when the validator keys are held by a remote signer
+type RemoteSignerConfig struct {
+	Enabled             bool
+	Address             string
+	RequestTimeoutInSec uint32
+}
+
+// OutportQueueConfig represents the config options for the persistent queues placed in front of the outport drivers
+type OutportQueueConfig struct {
+	Enabled    bool
+	MaxBacklog uint64
+	Storage    StorageConfig
+}
+
+// SlashingProtectionConfig represents the config options for the local record of the blocks signed by the validator keys
+type SlashingProtectionConfig struct {
+	Enabled bool
+	Storage StorageConfig
+}
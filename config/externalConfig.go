@@ -5,6 +5,8 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	HostDriversConfig      []HostDriversConfig
+	FileDriversConfig      []FileDriversConfig
+	GRPCDriversConfig      []GRPCDriversConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -28,6 +30,7 @@ type EventNotifierConfig struct {
 	Password          string
 	RequestTimeoutSec int
 	MarshallerType    string
+	Filter            OutportFilterConfig
 }
 
 // CovalentConfig will hold the configurations for covalent indexer
@@ -50,4 +53,36 @@ type HostDriversConfig struct {
 	RetryDurationInSec         int
 	AcknowledgeTimeoutInSec    int
 	Version                    uint32
+	Filter                     OutportFilterConfig
+}
+
+// OutportFilterConfig will hold the configuration for reducing the blocks pushed to a driver to the data its
+// consumer is interested in
+type OutportFilterConfig struct {
+	Enabled          bool
+	Addresses        []string
+	Tokens           []string
+	EventIdentifiers []string
+	TransactionTypes []string
+}
+
+// FileDriversConfig will hold the configuration for the driver writing the outport data in rolling segment files
+type FileDriversConfig struct {
+	Enabled             bool
+	Path                string
+	Format              string
+	MaxSegmentSizeInMB  uint64
+	RotateOnEpochChange bool
+}
+
+// GRPCDriversConfig will hold the configuration for the driver streaming the outport data over gRPC
+type GRPCDriversConfig struct {
+	Enabled                  bool
+	URL                      string
+	MarshallerType           string
+	BufferSize               uint32
+	MaxInFlight              uint32
+	MaxConsumers             uint32
+	DropMessagesIfNoConsumer bool
+	Filter                   OutportFilterConfig
 }
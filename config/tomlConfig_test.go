@@ -105,8 +105,10 @@ func TestTomlParser(t *testing.T) {
 				WasmerSIGSEGVPassthrough:            true,
 			},
 			Querying: QueryVirtualMachineConfig{
-				NumConcurrentVMs:     16,
-				VirtualMachineConfig: VirtualMachineConfig{WasmVMVersions: wasmVMVersions},
+				NumConcurrentVMs:             16,
+				HistoricalRootsCacheCapacity: 1000,
+				QueryResultsCacheCapacity:    5000,
+				VirtualMachineConfig:         VirtualMachineConfig{WasmVMVersions: wasmVMVersions},
 			},
 			GasConfig: VirtualMachineGasConfig{
 				ShardMaxGasPerVmQuery: 1_500_000_000,
@@ -203,6 +205,8 @@ func TestTomlParser(t *testing.T) {
 
     [VirtualMachine.Querying]
         NumConcurrentVMs = 16
+        HistoricalRootsCacheCapacity = 1000
+        QueryResultsCacheCapacity = 5000
         WasmVMVersions = [
             { StartEpoch = 12, Version = "v0.3" },
             { StartEpoch = 88, Version = "v1.2" },
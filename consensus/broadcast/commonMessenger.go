@@ -1,6 +1,8 @@
 package broadcast
 
 import (
+	"encoding/hex"
+	"fmt"
 	"strings"
 	"time"
 
@@ -99,6 +101,13 @@ func checkCommonMessengerNilParameters(
 
 // BroadcastConsensusMessage will send on consensus topic the consensus message
 func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message) error {
+	// a managed key can be removed at runtime, the message should not be signed with the node's original key instead
+	isHandled := cm.keysHandler.IsOriginalPublicKeyOfTheNode(message.PubKey) ||
+		cm.keysHandler.IsKeyManagedByCurrentNode(message.PubKey)
+	if !isHandled {
+		return fmt.Errorf("%w, public key %s", ErrKeyNotHandledByCurrentNode, hex.EncodeToString(message.PubKey))
+	}
+
 	privateKey := cm.keysHandler.GetHandledPrivateKey(message.PubKey)
 	signature, err := cm.peerSignatureHandler.GetPeerSignature(privateKey, message.OriginatorPid)
 	if err != nil {
@@ -62,6 +62,39 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldErrWhenSignMessageFail(t
 	assert.Equal(t, err, err2)
 }
 
+func TestCommonMessenger_BroadcastConsensusMessageShouldErrWhenKeyIsNotHandled(t *testing.T) {
+	messengerMock := &p2pmocks.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			assert.Fail(t, "should have not been called")
+		},
+	}
+	peerSigHandler := &mock.PeerSignatureHandler{Signer: &mock.SingleSignerMock{}}
+
+	cm, _ := broadcast.NewCommonMessenger(
+		&mock.MarshalizerMock{},
+		messengerMock,
+		&mock.ShardCoordinatorMock{},
+		peerSigHandler,
+		&testscommon.KeysHandlerStub{
+			IsOriginalPublicKeyOfTheNodeCalled: func(pkBytes []byte) bool {
+				return false
+			},
+			IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+				// the managed key was removed
+				return false
+			},
+			GetHandledPrivateKeyCalled: func(pkBytes []byte) crypto.PrivateKey {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		},
+	)
+
+	msg := &consensus.Message{PubKey: []byte("removed managed key")}
+	err := cm.BroadcastConsensusMessage(msg)
+	assert.True(t, errors.Is(err, broadcast.ErrKeyNotHandledByCurrentNode))
+}
+
 func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 	marshalizerMock := &mock.MarshalizerMock{}
 	messengerMock := &p2pmocks.MessengerStub{
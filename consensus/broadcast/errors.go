@@ -4,3 +4,6 @@ import "errors"
 
 // ErrNilKeysHandler signals that a nil keys handler was provided
 var ErrNilKeysHandler = errors.New("nil keys handler")
+
+// ErrKeyNotHandledByCurrentNode signals that the provided public key is not handled by the current node
+var ErrKeyNotHandledByCurrentNode = errors.New("key is not handled by the current node")
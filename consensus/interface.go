@@ -7,6 +7,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/p2p"
 )
 
@@ -165,11 +166,22 @@ type PeerBlacklistHandler interface {
 	IsInterfaceNil() bool
 }
 
+// SlashingProtectionHandler defines the behaviour of a component able to prevent a validator key from signing
+// two different blocks in the same round
+type SlashingProtectionHandler interface {
+	CheckAndRecord(publicKey []byte, epoch uint32, round int64, headerHash []byte) error
+	Export() *common.SlashingProtectionSnapshot
+	Import(snapshot *common.SlashingProtectionSnapshot) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // SigningHandler defines the behaviour of a component that handles multi and single signatures used in consensus operations
 type SigningHandler interface {
 	Reset(pubKeys []string) error
 	CreateSignatureShareForPublicKey(message []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error)
 	CreateSignatureForPublicKey(message []byte, publicKeyBytes []byte) ([]byte, error)
+	CreateRandomSeedSignatureForPublicKey(prevRandSeed []byte, publicKeyBytes []byte) ([]byte, error)
 	VerifySingleSignature(publicKeyBytes []byte, message []byte, signature []byte) error
 	StoreSignatureShare(index uint16, sig []byte) error
 	SignatureShare(index uint16) ([]byte, error)
@@ -38,6 +38,7 @@ type ConsensusCoreMock struct {
 	messageSigningHandler   consensus.P2PSigningHandler
 	peerBlacklistHandler    consensus.PeerBlacklistHandler
 	signingHandler          consensus.SigningHandler
+	slashingProtection      consensus.SlashingProtectionHandler
 }
 
 // GetAntiFloodHandler -
@@ -240,6 +241,16 @@ func (ccm *ConsensusCoreMock) SetSigningHandler(signingHandler consensus.Signing
 	ccm.signingHandler = signingHandler
 }
 
+// SlashingProtectionHandler -
+func (ccm *ConsensusCoreMock) SlashingProtectionHandler() consensus.SlashingProtectionHandler {
+	return ccm.slashingProtection
+}
+
+// SetSlashingProtectionHandler -
+func (ccm *ConsensusCoreMock) SetSlashingProtectionHandler(slashingProtection consensus.SlashingProtectionHandler) {
+	ccm.slashingProtection = slashingProtection
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
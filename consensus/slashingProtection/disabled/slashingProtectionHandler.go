@@ -0,0 +1,40 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/consensus/spos"
+)
+
+var _ consensus.SlashingProtectionHandler = (*SlashingProtectionHandler)(nil)
+
+// SlashingProtectionHandler is a disabled instance of the slashing protection handler, used when the protection is not enabled
+type SlashingProtectionHandler struct {
+}
+
+// CheckAndRecord does nothing and returns nil
+func (sph *SlashingProtectionHandler) CheckAndRecord(_ []byte, _ uint32, _ int64, _ []byte) error {
+	return nil
+}
+
+// Export returns an empty snapshot
+func (sph *SlashingProtectionHandler) Export() *common.SlashingProtectionSnapshot {
+	return &common.SlashingProtectionSnapshot{
+		Records: make([]*common.SignedBlockRecord, 0),
+	}
+}
+
+// Import returns ErrSlashingProtectionDisabled
+func (sph *SlashingProtectionHandler) Import(_ *common.SlashingProtectionSnapshot) error {
+	return spos.ErrSlashingProtectionDisabled
+}
+
+// Close does nothing and returns nil
+func (sph *SlashingProtectionHandler) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sph *SlashingProtectionHandler) IsInterfaceNil() bool {
+	return sph == nil
+}
@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus/spos"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlashingProtectionHandler_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r, "this shouldn't panic")
+	}()
+
+	sph := &SlashingProtectionHandler{}
+	assert.False(t, check.IfNil(sph))
+
+	assert.Nil(t, sph.CheckAndRecord([]byte("pk"), 1, 2, []byte("hash")))
+	assert.Empty(t, sph.Export().Records)
+	assert.Equal(t, spos.ErrSlashingProtectionDisabled, sph.Import(&common.SlashingProtectionSnapshot{}))
+	assert.Nil(t, sph.Close())
+}
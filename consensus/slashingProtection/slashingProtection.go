@@ -0,0 +1,246 @@
+package slashingProtection
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus/spos"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("consensus/slashingProtection")
+
+// ArgsSlashingProtection defines the arguments needed to create a new slashing protection component
+type ArgsSlashingProtection struct {
+	Storer     storage.Storer
+	Marshaller marshal.Marshalizer
+}
+
+// slashingProtection keeps, for each validator key, the last signed block. As the rounds are strictly increasing,
+// a key is allowed to sign only for a newer round or for the same block in the last signed round
+type slashingProtection struct {
+	mutRecords sync.Mutex
+	storer     storage.Storer
+	marshaller marshal.Marshalizer
+	records    map[string]*common.SignedBlockRecord
+}
+
+// NewSlashingProtection creates a new slashing protection component and loads the persisted records
+func NewSlashingProtection(args ArgsSlashingProtection) (*slashingProtection, error) {
+	if check.IfNil(args.Storer) {
+		return nil, spos.ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, spos.ErrNilMarshalizer
+	}
+
+	sp := &slashingProtection{
+		storer:     args.Storer,
+		marshaller: args.Marshaller,
+		records:    make(map[string]*common.SignedBlockRecord),
+	}
+
+	err := sp.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return sp, nil
+}
+
+func (sp *slashingProtection) load() error {
+	var errLoad error
+	sp.storer.RangeKeys(func(key []byte, val []byte) bool {
+		record := &common.SignedBlockRecord{}
+		errLoad = sp.marshaller.Unmarshal(record, val)
+		if errLoad != nil {
+			errLoad = fmt.Errorf("%w for key %s", errLoad, hex.EncodeToString(key))
+			return false
+		}
+
+		sp.records[string(key)] = record
+		return true
+	})
+	if errLoad != nil {
+		return errLoad
+	}
+
+	log.Debug("slashingProtection: loaded the signed block records", "num records", len(sp.records))
+
+	return nil
+}
+
+// CheckAndRecord returns an error if the provided key should not sign the provided block, otherwise it persists the
+// signed block record. It should be called before each signature created for a block
+func (sp *slashingProtection) CheckAndRecord(publicKey []byte, epoch uint32, round int64, headerHash []byte) error {
+	sp.mutRecords.Lock()
+	defer sp.mutRecords.Unlock()
+
+	record, found := sp.records[string(publicKey)]
+	if found {
+		isSameRound, err := checkRecord(record, round, headerHash)
+		if err != nil {
+			return err
+		}
+		if isSameRound {
+			return nil
+		}
+	}
+
+	newRecord := &common.SignedBlockRecord{
+		PublicKey:  hex.EncodeToString(publicKey),
+		Epoch:      epoch,
+		Round:      round,
+		HeaderHash: hex.EncodeToString(headerHash),
+	}
+
+	return sp.saveRecord(publicKey, newRecord)
+}
+
+func checkRecord(record *common.SignedBlockRecord, round int64, headerHash []byte) (bool, error) {
+	if round < record.Round {
+		return false, fmt.Errorf("%w for public key %s, last signed round %d, requested round %d",
+			spos.ErrRoundAlreadySigned, record.PublicKey, record.Round, round)
+	}
+	if round > record.Round {
+		return false, nil
+	}
+
+	recordedHash, err := hex.DecodeString(record.HeaderHash)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(recordedHash, headerHash) {
+		return false, fmt.Errorf("%w for public key %s in round %d, signed header hash %s, requested header hash %s",
+			spos.ErrDoubleSigning, record.PublicKey, round, record.HeaderHash, hex.EncodeToString(headerHash))
+	}
+
+	return true, nil
+}
+
+// saveRecord persists the record before keeping it in memory, so a signature is never created without being recorded
+func (sp *slashingProtection) saveRecord(publicKey []byte, record *common.SignedBlockRecord) error {
+	buff, err := sp.marshaller.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	err = sp.storer.Put(publicKey, buff)
+	if err != nil {
+		return err
+	}
+
+	sp.records[string(publicKey)] = record
+
+	return nil
+}
+
+// Export returns the signed block records of all known keys, sorted by public key
+func (sp *slashingProtection) Export() *common.SlashingProtectionSnapshot {
+	sp.mutRecords.Lock()
+	defer sp.mutRecords.Unlock()
+
+	records := make([]*common.SignedBlockRecord, 0, len(sp.records))
+	for _, record := range sp.records {
+		recordCopy := *record
+		records = append(records, &recordCopy)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].PublicKey < records[j].PublicKey
+	})
+
+	return &common.SlashingProtectionSnapshot{
+		Records: records,
+	}
+}
+
+// Import merges the provided records, usually exported by another machine running the same keys. For each key, the
+// record with the highest round is kept. If one record is invalid, none of the records will be imported
+func (sp *slashingProtection) Import(snapshot *common.SlashingProtectionSnapshot) error {
+	if snapshot == nil {
+		return spos.ErrNilSlashingProtectionSnapshot
+	}
+
+	publicKeys := make([][]byte, 0, len(snapshot.Records))
+	records := make([]*common.SignedBlockRecord, 0, len(snapshot.Records))
+	for i, record := range snapshot.Records {
+		publicKey, normalizedRecord, err := normalizeImportedRecord(record)
+		if err != nil {
+			return fmt.Errorf("%w, record index %d", err, i)
+		}
+
+		publicKeys = append(publicKeys, publicKey)
+		records = append(records, normalizedRecord)
+	}
+
+	sp.mutRecords.Lock()
+	defer sp.mutRecords.Unlock()
+
+	numImported := 0
+	for i, record := range records {
+		existingRecord, found := sp.records[string(publicKeys[i])]
+		if found && existingRecord.Round >= record.Round {
+			if existingRecord.Round == record.Round && existingRecord.HeaderHash != record.HeaderHash {
+				log.Warn("slashingProtection.Import: conflicting records found, keeping the local one",
+					"public key", record.PublicKey, "round", record.Round,
+					"local header hash", existingRecord.HeaderHash, "imported header hash", record.HeaderHash)
+			}
+			continue
+		}
+
+		err := sp.saveRecord(publicKeys[i], record)
+		if err != nil {
+			return err
+		}
+		numImported++
+	}
+
+	log.Debug("slashingProtection.Import", "num records", len(snapshot.Records), "num imported", numImported)
+
+	return nil
+}
+
+// normalizeImportedRecord checks the provided record and returns a copy with lowercase hex encoded fields
+func normalizeImportedRecord(record *common.SignedBlockRecord) ([]byte, *common.SignedBlockRecord, error) {
+	if record == nil {
+		return nil, nil, fmt.Errorf("%w, nil record", spos.ErrInvalidSignedBlockRecord)
+	}
+
+	publicKey, err := hex.DecodeString(record.PublicKey)
+	if err != nil || len(publicKey) == 0 {
+		return nil, nil, fmt.Errorf("%w, invalid public key %s", spos.ErrInvalidSignedBlockRecord, record.PublicKey)
+	}
+
+	headerHash, err := hex.DecodeString(record.HeaderHash)
+	if err != nil || len(headerHash) == 0 {
+		return nil, nil, fmt.Errorf("%w, invalid header hash %s", spos.ErrInvalidSignedBlockRecord, record.HeaderHash)
+	}
+
+	if record.Round < 0 {
+		return nil, nil, fmt.Errorf("%w, invalid round %d", spos.ErrInvalidSignedBlockRecord, record.Round)
+	}
+
+	return publicKey, &common.SignedBlockRecord{
+		PublicKey:  hex.EncodeToString(publicKey),
+		Epoch:      record.Epoch,
+		Round:      record.Round,
+		HeaderHash: hex.EncodeToString(headerHash),
+	}, nil
+}
+
+// Close closes the underlying storer
+func (sp *slashingProtection) Close() error {
+	return sp.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *slashingProtection) IsInterfaceNil() bool {
+	return sp == nil
+}
@@ -0,0 +1,229 @@
+package slashingProtection_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus/slashingProtection"
+	"github.com/multiversx/mx-chain-go/consensus/spos"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	pk1 = []byte("pk1")
+	pk2 = []byte("pk2")
+)
+
+func createMockArgsSlashingProtection() slashingProtection.ArgsSlashingProtection {
+	return slashingProtection.ArgsSlashingProtection{
+		Storer:     genericMocks.NewStorerMock(),
+		Marshaller: &marshal.JsonMarshalizer{},
+	}
+}
+
+func TestNewSlashingProtection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSlashingProtection()
+		args.Storer = nil
+		sp, err := slashingProtection.NewSlashingProtection(args)
+		assert.Equal(t, spos.ErrNilStorer, err)
+		assert.True(t, check.IfNil(sp))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSlashingProtection()
+		args.Marshaller = nil
+		sp, err := slashingProtection.NewSlashingProtection(args)
+		assert.Equal(t, spos.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(sp))
+	})
+	t.Run("corrupted records should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSlashingProtection()
+		_ = args.Storer.Put(pk1, []byte("not a json"))
+		sp, err := slashingProtection.NewSlashingProtection(args)
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(sp))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := slashingProtection.NewSlashingProtection(createMockArgsSlashingProtection())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(sp))
+	})
+}
+
+func TestSlashingProtection_CheckAndRecord(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same block in the same round should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := slashingProtection.NewSlashingProtection(createMockArgsSlashingProtection())
+		assert.Nil(t, sp.CheckAndRecord(pk1, 1, 10, []byte("hash")))
+		assert.Nil(t, sp.CheckAndRecord(pk1, 1, 10, []byte("hash")))
+	})
+	t.Run("different block in the same round should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := slashingProtection.NewSlashingProtection(createMockArgsSlashingProtection())
+		assert.Nil(t, sp.CheckAndRecord(pk1, 1, 10, []byte("hash")))
+		err := sp.CheckAndRecord(pk1, 1, 10, []byte("another hash"))
+		assert.True(t, errors.Is(err, spos.ErrDoubleSigning))
+
+		// other keys are not affected
+		assert.Nil(t, sp.CheckAndRecord(pk2, 1, 10, []byte("another hash")))
+	})
+	t.Run("older round should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := slashingProtection.NewSlashingProtection(createMockArgsSlashingProtection())
+		assert.Nil(t, sp.CheckAndRecord(pk1, 1, 10, []byte("hash")))
+		err := sp.CheckAndRecord(pk1, 1, 9, []byte("hash"))
+		assert.True(t, errors.Is(err, spos.ErrRoundAlreadySigned))
+	})
+	t.Run("newer round should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := slashingProtection.NewSlashingProtection(createMockArgsSlashingProtection())
+		assert.Nil(t, sp.CheckAndRecord(pk1, 1, 10, []byte("hash")))
+		assert.Nil(t, sp.CheckAndRecord(pk1, 2, 11, []byte("another hash")))
+	})
+	t.Run("storer error should not record", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsSlashingProtection()
+		args.Storer = &storage.StorerStub{
+			PutCalled: func(key, data []byte) error {
+				return expectedErr
+			},
+		}
+		sp, _ := slashingProtection.NewSlashingProtection(args)
+		assert.Equal(t, expectedErr, sp.CheckAndRecord(pk1, 1, 10, []byte("hash")))
+		assert.Empty(t, sp.Export().Records)
+	})
+	t.Run("records should survive a restart", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSlashingProtection()
+		sp, _ := slashingProtection.NewSlashingProtection(args)
+		assert.Nil(t, sp.CheckAndRecord(pk1, 1, 10, []byte("hash")))
+
+		// simulate a restart by reusing only the storer
+		newSp, err := slashingProtection.NewSlashingProtection(args)
+		require.Nil(t, err)
+		err = newSp.CheckAndRecord(pk1, 1, 10, []byte("another hash"))
+		assert.True(t, errors.Is(err, spos.ErrDoubleSigning))
+	})
+}
+
+func TestSlashingProtection_Export(t *testing.T) {
+	t.Parallel()
+
+	sp, _ := slashingProtection.NewSlashingProtection(createMockArgsSlashingProtection())
+	_ = sp.CheckAndRecord(pk2, 2, 20, []byte("hash2"))
+	_ = sp.CheckAndRecord(pk1, 1, 10, []byte("hash1"))
+
+	expectedSnapshot := &common.SlashingProtectionSnapshot{
+		Records: []*common.SignedBlockRecord{
+			{PublicKey: "706b31", Epoch: 1, Round: 10, HeaderHash: "6861736831"},
+			{PublicKey: "706b32", Epoch: 2, Round: 20, HeaderHash: "6861736832"},
+		},
+	}
+	assert.Equal(t, expectedSnapshot, sp.Export())
+}
+
+func TestSlashingProtection_Import(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil snapshot should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := slashingProtection.NewSlashingProtection(createMockArgsSlashingProtection())
+		assert.Equal(t, spos.ErrNilSlashingProtectionSnapshot, sp.Import(nil))
+	})
+	t.Run("invalid records should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidRecords := []*common.SignedBlockRecord{
+			nil,
+			{PublicKey: "not hex", Round: 1, HeaderHash: "aa"},
+			{PublicKey: "", Round: 1, HeaderHash: "aa"},
+			{PublicKey: "aa", Round: 1, HeaderHash: "not hex"},
+			{PublicKey: "aa", Round: -1, HeaderHash: "aa"},
+		}
+
+		sp, _ := slashingProtection.NewSlashingProtection(createMockArgsSlashingProtection())
+		for _, record := range invalidRecords {
+			err := sp.Import(&common.SlashingProtectionSnapshot{
+				Records: []*common.SignedBlockRecord{
+					{PublicKey: "bb", Round: 1, HeaderHash: "bb"},
+					record,
+				},
+			})
+			assert.True(t, errors.Is(err, spos.ErrInvalidSignedBlockRecord))
+		}
+
+		// no record should have been imported
+		assert.Empty(t, sp.Export().Records)
+	})
+	t.Run("should keep the newest records", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := slashingProtection.NewSlashingProtection(createMockArgsSlashingProtection())
+		_ = sp.CheckAndRecord(pk1, 1, 10, []byte("hash1"))
+		_ = sp.CheckAndRecord(pk2, 1, 10, []byte("hash2"))
+
+		err := sp.Import(&common.SlashingProtectionSnapshot{
+			Records: []*common.SignedBlockRecord{
+				{PublicKey: "706B31", Epoch: 1, Round: 9, HeaderHash: "AA"},
+				{PublicKey: "706B32", Epoch: 2, Round: 11, HeaderHash: "BB"},
+				{PublicKey: "706B33", Epoch: 2, Round: 12, HeaderHash: "CC"},
+			},
+		})
+		require.Nil(t, err)
+
+		expectedRecords := []*common.SignedBlockRecord{
+			{PublicKey: "706b31", Epoch: 1, Round: 10, HeaderHash: "6861736831"},
+			{PublicKey: "706b32", Epoch: 2, Round: 11, HeaderHash: "bb"},
+			{PublicKey: "706b33", Epoch: 2, Round: 12, HeaderHash: "cc"},
+		}
+		assert.Equal(t, expectedRecords, sp.Export().Records)
+
+		err = sp.CheckAndRecord(pk2, 2, 11, []byte("hash2"))
+		assert.True(t, errors.Is(err, spos.ErrDoubleSigning))
+		err = sp.CheckAndRecord([]byte("pk3"), 2, 11, []byte("hash3"))
+		assert.True(t, errors.Is(err, spos.ErrRoundAlreadySigned))
+	})
+}
+
+func TestSlashingProtection_Close(t *testing.T) {
+	t.Parallel()
+
+	closeCalled := false
+	args := createMockArgsSlashingProtection()
+	args.Storer = &storage.StorerStub{
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+	sp, _ := slashingProtection.NewSlashingProtection(args)
+
+	assert.Nil(t, sp.Close())
+	assert.True(t, closeCalled)
+}
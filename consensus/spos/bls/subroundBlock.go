@@ -342,7 +342,7 @@ func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
 		return nil, errGetLeader
 	}
 
-	randSeed, err := sr.SigningHandler().CreateSignatureForPublicKey(prevRandSeed, []byte(leader))
+	randSeed, err := sr.SigningHandler().CreateRandomSeedSignatureForPublicKey(prevRandSeed, []byte(leader))
 	if err != nil {
 		return nil, err
 	}
@@ -803,6 +803,11 @@ func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
 		return nil, errGetLeader
 	}
 
+	err = sr.SlashingProtectionHandler().CheckAndRecord([]byte(leader), sr.Header.GetEpoch(), sr.RoundHandler().Index(), sr.GetData())
+	if err != nil {
+		return nil, err
+	}
+
 	return sr.SigningHandler().CreateSignatureForPublicKey(marshalizedHdr, []byte(leader))
 }
 
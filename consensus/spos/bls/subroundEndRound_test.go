@@ -617,6 +617,34 @@ func TestSubroundEndRound_DoEndRoundJobAllOK(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundEndRound_DoEndRoundJobSlashingProtectionShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	checkAndRecordCalled := false
+	container.SetSlashingProtectionHandler(&consensusMocks.SlashingProtectionHandlerStub{
+		CheckAndRecordCalled: func(publicKey []byte, epoch uint32, round int64, headerHash []byte) error {
+			checkAndRecordCalled = true
+			assert.Equal(t, []byte("A"), publicKey)
+			return spos.ErrDoubleSigning
+		},
+	})
+	container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+		CreateSignatureForPublicKeyCalled: func(publicKeyBytes []byte, msg []byte) ([]byte, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	})
+	sr := *initSubroundEndRoundWithContainer(container, &statusHandler.AppStatusHandlerStub{})
+	sr.SetSelfPubKey("A")
+
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.False(t, r)
+	assert.True(t, checkAndRecordCalled)
+}
+
 func TestSubroundEndRound_CheckIfSignatureIsFilled(t *testing.T) {
 	t.Parallel()
 
@@ -89,6 +89,17 @@ func (sr *subroundSignature) doSignatureJob(_ context.Context) bool {
 			return false
 		}
 
+		err = sr.SlashingProtectionHandler().CheckAndRecord(
+			[]byte(sr.SelfPubKey()),
+			sr.Header.GetEpoch(),
+			sr.RoundHandler().Index(),
+			sr.GetData(),
+		)
+		if err != nil {
+			log.Error("doSignatureJob.CheckAndRecord", "error", err.Error())
+			return false
+		}
+
 		signatureShare, err := sr.SigningHandler().CreateSignatureShareForPublicKey(
 			sr.GetData(),
 			uint16(selfIndex),
@@ -367,6 +378,17 @@ func (sr *subroundSignature) doSignatureJobForManagedKeys() bool {
 			continue
 		}
 
+		err = sr.SlashingProtectionHandler().CheckAndRecord(
+			pkBytes,
+			sr.Header.GetEpoch(),
+			sr.RoundHandler().Index(),
+			sr.GetData(),
+		)
+		if err != nil {
+			log.Error("doSignatureJobForManagedKeys.CheckAndRecord", "error", err.Error())
+			continue
+		}
+
 		signatureShare, err := sr.SigningHandler().CreateSignatureShareForPublicKey(
 			sr.GetData(),
 			uint16(selfIndex),
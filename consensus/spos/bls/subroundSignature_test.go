@@ -466,6 +466,109 @@ func TestSubroundSignature_DoSignatureJobWithMultikey(t *testing.T) {
 	assert.Equal(t, expectedMap, signatureSentForPks)
 }
 
+func TestSubroundSignature_DoSignatureJobSlashingProtection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("self key refused by the slashing protection should not sign", func(t *testing.T) {
+		t.Parallel()
+
+		container := mock.InitConsensusCore()
+		sr := *initSubroundSignatureWithContainer(container)
+		sr.Header = &block.Header{Epoch: 2}
+		sr.Data = []byte("X")
+
+		container.SetSlashingProtectionHandler(&consensusMocks.SlashingProtectionHandlerStub{
+			CheckAndRecordCalled: func(publicKey []byte, epoch uint32, round int64, headerHash []byte) error {
+				assert.Equal(t, []byte(sr.SelfPubKey()), publicKey)
+				assert.Equal(t, uint32(2), epoch)
+				assert.Equal(t, container.RoundHandler().Index(), round)
+				assert.Equal(t, []byte("X"), headerHash)
+				return spos.ErrDoubleSigning
+			},
+		})
+		container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+			CreateSignatureShareForPublicKeyCalled: func(msg []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		})
+
+		r := sr.DoSignatureJob()
+		assert.False(t, r)
+	})
+	t.Run("managed key refused by the slashing protection should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		container := mock.InitConsensusCore()
+		consensusState := initConsensusStateWithKeysHandler(
+			&testscommon.KeysHandlerStub{
+				IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+					return true
+				},
+			},
+		)
+		ch := make(chan bool, 1)
+		sr, _ := spos.NewSubround(
+			bls.SrBlock,
+			bls.SrSignature,
+			bls.SrEndRound,
+			int64(70*roundTimeDuration/100),
+			int64(85*roundTimeDuration/100),
+			"(SIGNATURE)",
+			consensusState,
+			ch,
+			executeStoredMessages,
+			container,
+			chainID,
+			currentPid,
+			&statusHandler.AppStatusHandlerStub{},
+		)
+
+		signatureSentForPks := make(map[string]struct{})
+		srSignature, _ := bls.NewSubroundSignature(
+			sr,
+			extend,
+			&statusHandler.AppStatusHandlerStub{},
+			&testscommon.SentSignatureTrackerStub{
+				SignatureSentCalled: func(pkBytes []byte) {
+					signatureSentForPks[string(pkBytes)] = struct{}{}
+				},
+			},
+		)
+		srSignature.Header = &block.Header{}
+		sr.Data = []byte("X")
+
+		container.SetSlashingProtectionHandler(&consensusMocks.SlashingProtectionHandlerStub{
+			CheckAndRecordCalled: func(publicKey []byte, epoch uint32, round int64, headerHash []byte) error {
+				if string(publicKey) == "C" {
+					return spos.ErrDoubleSigning
+				}
+				return nil
+			},
+		})
+		container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+			CreateSignatureShareForPublicKeyCalled: func(msg []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
+				assert.NotEqual(t, []byte("C"), publicKeyBytes)
+				return []byte("SIG"), nil
+			},
+		})
+
+		r := srSignature.DoSignatureJob()
+		assert.True(t, r)
+		// the self key B is signed on the single key flow, C is skipped
+		expectedMap := map[string]struct{}{
+			"A": {},
+			"D": {},
+			"E": {},
+			"F": {},
+			"G": {},
+			"H": {},
+			"I": {},
+		}
+		assert.Equal(t, expectedMap, signatureSentForPks)
+	})
+}
+
 func TestSubroundSignature_ReceivedSignature(t *testing.T) {
 	t.Parallel()
 
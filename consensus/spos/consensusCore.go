@@ -38,6 +38,7 @@ type ConsensusCore struct {
 	messageSigningHandler         consensus.P2PSigningHandler
 	peerBlacklistHandler          consensus.PeerBlacklistHandler
 	signingHandler                consensus.SigningHandler
+	slashingProtectionHandler     consensus.SlashingProtectionHandler
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -64,6 +65,7 @@ type ConsensusCoreArgs struct {
 	MessageSigningHandler         consensus.P2PSigningHandler
 	PeerBlacklistHandler          consensus.PeerBlacklistHandler
 	SigningHandler                consensus.SigningHandler
+	SlashingProtectionHandler     consensus.SlashingProtectionHandler
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -93,6 +95,7 @@ func NewConsensusCore(
 		messageSigningHandler:         args.MessageSigningHandler,
 		peerBlacklistHandler:          args.PeerBlacklistHandler,
 		signingHandler:                args.SigningHandler,
+		slashingProtectionHandler:     args.SlashingProtectionHandler,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -213,6 +216,11 @@ func (cc *ConsensusCore) SigningHandler() consensus.SigningHandler {
 	return cc.signingHandler
 }
 
+// SlashingProtectionHandler will return the slashing protection handler component
+func (cc *ConsensusCore) SlashingProtectionHandler() consensus.SlashingProtectionHandler {
+	return cc.slashingProtectionHandler
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
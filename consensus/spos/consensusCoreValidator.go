@@ -74,6 +74,9 @@ func ValidateConsensusCore(container ConsensusCoreHandler) error {
 	if check.IfNil(container.SigningHandler()) {
 		return ErrNilSigningHandler
 	}
+	if check.IfNil(container.SlashingProtectionHandler()) {
+		return ErrNilSlashingProtectionHandler
+	}
 
 	return nil
 }
@@ -35,29 +35,31 @@ func initConsensusDataContainer() *ConsensusCore {
 	peerBlacklistHandler := &mock.PeerBlacklistHandlerStub{}
 	multiSignerContainer := cryptoMocks.NewMultiSignerContainerMock(multiSignerMock)
 	signingHandler := &consensusMocks.SigningHandlerStub{}
+	slashingProtectionHandler := &consensusMocks.SlashingProtectionHandlerStub{}
 
 	return &ConsensusCore{
-		blockChain:              blockChain,
-		blockProcessor:          blockProcessorMock,
-		bootstrapper:            bootstrapperMock,
-		broadcastMessenger:      broadcastMessengerMock,
-		chronologyHandler:       chronologyHandlerMock,
-		hasher:                  hasherMock,
-		marshalizer:             marshalizerMock,
-		multiSignerContainer:    multiSignerContainer,
-		roundHandler:            roundHandlerMock,
-		shardCoordinator:        shardCoordinatorMock,
-		syncTimer:               syncTimerMock,
-		nodesCoordinator:        validatorGroupSelector,
-		antifloodHandler:        antifloodHandler,
-		peerHonestyHandler:      peerHonestyHandler,
-		headerSigVerifier:       headerSigVerifier,
-		fallbackHeaderValidator: fallbackHeaderValidator,
-		nodeRedundancyHandler:   nodeRedundancyHandler,
-		scheduledProcessor:      scheduledProcessor,
-		messageSigningHandler:   messageSigningHandler,
-		peerBlacklistHandler:    peerBlacklistHandler,
-		signingHandler:          signingHandler,
+		blockChain:                blockChain,
+		blockProcessor:            blockProcessorMock,
+		bootstrapper:              bootstrapperMock,
+		broadcastMessenger:        broadcastMessengerMock,
+		chronologyHandler:         chronologyHandlerMock,
+		hasher:                    hasherMock,
+		marshalizer:               marshalizerMock,
+		multiSignerContainer:      multiSignerContainer,
+		roundHandler:              roundHandlerMock,
+		shardCoordinator:          shardCoordinatorMock,
+		syncTimer:                 syncTimerMock,
+		nodesCoordinator:          validatorGroupSelector,
+		antifloodHandler:          antifloodHandler,
+		peerHonestyHandler:        peerHonestyHandler,
+		headerSigVerifier:         headerSigVerifier,
+		fallbackHeaderValidator:   fallbackHeaderValidator,
+		nodeRedundancyHandler:     nodeRedundancyHandler,
+		scheduledProcessor:        scheduledProcessor,
+		messageSigningHandler:     messageSigningHandler,
+		peerBlacklistHandler:      peerBlacklistHandler,
+		signingHandler:            signingHandler,
+		slashingProtectionHandler: slashingProtectionHandler,
 	}
 }
 
@@ -259,6 +261,17 @@ func TestConsensusContainerValidator_ValidateNilSignatureHandlerShouldFail(t *te
 	assert.Equal(t, ErrNilSigningHandler, err)
 }
 
+func TestConsensusContainerValidator_ValidateNilSlashingProtectionHandlerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.slashingProtectionHandler = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilSlashingProtectionHandler, err)
+}
+
 func TestConsensusContainerValidator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
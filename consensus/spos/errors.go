@@ -235,6 +235,9 @@ var ErrNilSignatureOnP2PMessage = errors.New("nil signature on the p2p message")
 // ErrNilSigningHandler signals that provided signing handler is nil
 var ErrNilSigningHandler = errors.New("nil signing handler")
 
+// ErrNilSlashingProtectionHandler signals that a nil slashing protection handler has been provided
+var ErrNilSlashingProtectionHandler = errors.New("nil slashing protection handler")
+
 // ErrNilKeysHandler signals that a nil keys handler was provided
 var ErrNilKeysHandler = errors.New("nil keys handler")
 
@@ -243,3 +246,21 @@ var ErrNilFunctionHandler = errors.New("nil function handler")
 
 // ErrWrongHashForHeader signals that the hash of the header is not the expected one
 var ErrWrongHashForHeader = errors.New("wrong hash for header")
+
+// ErrDoubleSigning signals that a validator key was asked to sign a different block for an already signed round
+var ErrDoubleSigning = errors.New("double signing attempt")
+
+// ErrRoundAlreadySigned signals that a validator key was asked to sign a block for a round older than its last signed round
+var ErrRoundAlreadySigned = errors.New("a newer round was already signed")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilSlashingProtectionSnapshot signals that a nil slashing protection snapshot has been provided
+var ErrNilSlashingProtectionSnapshot = errors.New("nil slashing protection snapshot")
+
+// ErrInvalidSignedBlockRecord signals that an invalid signed block record has been provided
+var ErrInvalidSignedBlockRecord = errors.New("invalid signed block record")
+
+// ErrSlashingProtectionDisabled signals that the slashing protection is disabled
+var ErrSlashingProtectionDisabled = errors.New("slashing protection is disabled")
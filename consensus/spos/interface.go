@@ -65,6 +65,8 @@ type ConsensusCoreHandler interface {
 	PeerBlacklistHandler() consensus.PeerBlacklistHandler
 	// SigningHandler returns the signing handler component
 	SigningHandler() consensus.SigningHandler
+	// SlashingProtectionHandler returns the component that prevents the managed keys from signing different blocks in the same round
+	SlashingProtectionHandler() consensus.SlashingProtectionHandler
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -49,6 +49,12 @@ const (
 	PeerAccountsUnit UnitType = 21
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 22
+	// ValidatorsHistoryUnit is the per epoch validators statistics storage unit identifier
+	ValidatorsHistoryUnit UnitType = 23
+	// RewardsBreakdownUnit is the per epoch rewards breakdown storage unit identifier
+	RewardsBreakdownUnit UnitType = 24
+	// ContractsVerificationUnit is the verified smart contracts storage unit identifier
+	ContractsVerificationUnit UnitType = 25
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -110,6 +116,12 @@ func (ut UnitType) String() string {
 		return "PeerAccountsUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case ValidatorsHistoryUnit:
+		return "ValidatorsHistoryUnit"
+	case RewardsBreakdownUnit:
+		return "RewardsBreakdownUnit"
+	case ContractsVerificationUnit:
+		return "ContractsVerificationUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -55,6 +55,12 @@ func TestUnitType_String(t *testing.T) {
 	require.Equal(t, "PeerAccountsUnit", ut.String())
 	ut = ScheduledSCRsUnit
 	require.Equal(t, "ScheduledSCRsUnit", ut.String())
+	ut = ValidatorsHistoryUnit
+	require.Equal(t, "ValidatorsHistoryUnit", ut.String())
+	ut = RewardsBreakdownUnit
+	require.Equal(t, "RewardsBreakdownUnit", ut.String())
+	ut = ContractsVerificationUnit
+	require.Equal(t, "ContractsVerificationUnit", ut.String())
 
 	ut = 200
 	require.Equal(t, "ShardHdrNonceHashDataUnit100", ut.String())
@@ -136,6 +136,9 @@ func createMockEpochStartBootstrapArgs(
 			SmartContractsStorage:           generalCfg.SmartContractsStorage,
 			SmartContractsStorageForSCQuery: generalCfg.SmartContractsStorageForSCQuery,
 			TrieEpochRootHashStorage:        generalCfg.TrieEpochRootHashStorage,
+			ValidatorsHistoryStorage:        generalCfg.ValidatorsHistoryStorage,
+			RewardsBreakdownStorage:         generalCfg.RewardsBreakdownStorage,
+			ContractsVerificationStorage:    generalCfg.ContractsVerificationStorage,
 			BootstrapStorage:                generalCfg.BootstrapStorage,
 			MetaBlockStorage:                generalCfg.MetaBlockStorage,
 			AccountsTrieStorage:             generalCfg.AccountsTrieStorage,
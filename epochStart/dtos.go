@@ -1,3 +1,4 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. rewardsBreakdown.proto
 package epochStart
 
 import (
@@ -12,10 +13,35 @@ type OwnerData struct {
 	NumActiveNodes int64
 	TotalTopUp     *big.Int
 	TopUpPerNode   *big.Int
+	TotalStaked    *big.Int
+	BlsKeys        [][]byte
 	AuctionList    []state.ValidatorInfoHandler
 	Qualified      bool
 }
 
+// CopyOwnerData returns a copy of the provided owner data which can be altered without affecting the original one.
+// The validators in the auction list are shared between the copies
+func CopyOwnerData(ownerData *OwnerData) *OwnerData {
+	ownerDataCopy := &OwnerData{
+		NumStakedNodes: ownerData.NumStakedNodes,
+		NumActiveNodes: ownerData.NumActiveNodes,
+		TotalTopUp:     big.NewInt(0).Set(ownerData.TotalTopUp),
+		TopUpPerNode:   big.NewInt(0).Set(ownerData.TopUpPerNode),
+		AuctionList:    make([]state.ValidatorInfoHandler, len(ownerData.AuctionList)),
+		Qualified:      ownerData.Qualified,
+	}
+	if ownerData.TotalStaked != nil {
+		ownerDataCopy.TotalStaked = big.NewInt(0).Set(ownerData.TotalStaked)
+	}
+	if ownerData.BlsKeys != nil {
+		ownerDataCopy.BlsKeys = make([][]byte, len(ownerData.BlsKeys))
+		copy(ownerDataCopy.BlsKeys, ownerData.BlsKeys)
+	}
+	copy(ownerDataCopy.AuctionList, ownerData.AuctionList)
+
+	return ownerDataCopy
+}
+
 // ValidatorStatsInEpoch holds validator stats in an epoch
 type ValidatorStatsInEpoch struct {
 	Eligible map[uint32]int
@@ -6,10 +6,12 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
 )
 
 type configuredRewardsCreator string
@@ -24,9 +26,11 @@ const (
 // RewardsCreatorProxyArgs holds the proxy arguments
 type RewardsCreatorProxyArgs struct {
 	BaseRewardsCreatorArgs
-	StakingDataProvider   epochStart.StakingDataProvider
-	EconomicsDataProvider epochStart.EpochEconomicsDataProvider
-	RewardsHandler        process.RewardsHandler
+	StakingDataProvider        epochStart.StakingDataProvider
+	EconomicsDataProvider      epochStart.EpochEconomicsDataProvider
+	RewardsHandler             process.RewardsHandler
+	RewardsBreakdownStorer     storage.Storer
+	RewardsBreakdownMarshaller marshal.Marshalizer
 }
 
 type rewardsCreatorProxy struct {
@@ -178,10 +182,12 @@ func (rcp *rewardsCreatorProxy) switchToRewardsCreatorV2() error {
 
 func (rcp *rewardsCreatorProxy) createRewardsCreatorV2() (*rewardsCreatorV2, error) {
 	argsV2 := RewardsCreatorArgsV2{
-		BaseRewardsCreatorArgs: rcp.args.BaseRewardsCreatorArgs,
-		StakingDataProvider:    rcp.args.StakingDataProvider,
-		EconomicsDataProvider:  rcp.args.EconomicsDataProvider,
-		RewardsHandler:         rcp.args.RewardsHandler,
+		BaseRewardsCreatorArgs:     rcp.args.BaseRewardsCreatorArgs,
+		StakingDataProvider:        rcp.args.StakingDataProvider,
+		EconomicsDataProvider:      rcp.args.EconomicsDataProvider,
+		RewardsHandler:             rcp.args.RewardsHandler,
+		RewardsBreakdownStorer:     rcp.args.RewardsBreakdownStorer,
+		RewardsBreakdownMarshaller: rcp.args.RewardsBreakdownMarshaller,
 	}
 
 	return NewRewardsCreatorV2(argsV2)
@@ -381,10 +381,12 @@ func createDefaultRewardsCreatorProxyArgs() RewardsCreatorProxyArgs {
 	}
 
 	return RewardsCreatorProxyArgs{
-		BaseRewardsCreatorArgs: getBaseRewardsArguments(),
-		StakingDataProvider:    &stakingcommon.StakingDataProviderStub{},
-		EconomicsDataProvider:  NewEpochEconomicsStatistics(),
-		RewardsHandler:         rewardsHandler,
+		BaseRewardsCreatorArgs:     getBaseRewardsArguments(),
+		StakingDataProvider:        &stakingcommon.StakingDataProviderStub{},
+		EconomicsDataProvider:      NewEpochEconomicsStatistics(),
+		RewardsHandler:             rewardsHandler,
+		RewardsBreakdownStorer:     mock.NewStorerMock(),
+		RewardsBreakdownMarshaller: &marshal.JsonMarshalizer{},
 	}
 }
 
@@ -1,6 +1,7 @@
 package metachain
 
 import (
+	"fmt"
 	"math"
 	"math/big"
 
@@ -9,12 +10,14 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/validatorInfo"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
 )
 
 var _ process.RewardsCreator = (*rewardsCreatorV2)(nil)
@@ -31,16 +34,21 @@ type nodeRewardsData struct {
 // RewardsCreatorArgsV2 holds the data required to create end of epoch rewards
 type RewardsCreatorArgsV2 struct {
 	BaseRewardsCreatorArgs
-	StakingDataProvider   epochStart.StakingDataProvider
-	EconomicsDataProvider epochStart.EpochEconomicsDataProvider
-	RewardsHandler        process.RewardsHandler
+	StakingDataProvider        epochStart.StakingDataProvider
+	EconomicsDataProvider      epochStart.EpochEconomicsDataProvider
+	RewardsHandler             process.RewardsHandler
+	RewardsBreakdownStorer     storage.Storer
+	RewardsBreakdownMarshaller marshal.Marshalizer
 }
 
 type rewardsCreatorV2 struct {
 	*baseRewardsCreator
-	stakingDataProvider   epochStart.StakingDataProvider
-	economicsDataProvider epochStart.EpochEconomicsDataProvider
-	rewardsHandler        process.RewardsHandler
+	stakingDataProvider        epochStart.StakingDataProvider
+	economicsDataProvider      epochStart.EpochEconomicsDataProvider
+	rewardsHandler             process.RewardsHandler
+	rewardsBreakdownStorer     storage.Storer
+	rewardsBreakdownMarshaller marshal.Marshalizer
+	rewardsBreakdown           *epochStart.RewardsBreakdown
 }
 
 // NewRewardsCreatorV2 creates a new rewards creator object
@@ -59,12 +67,20 @@ func NewRewardsCreatorV2(args RewardsCreatorArgsV2) (*rewardsCreatorV2, error) {
 	if check.IfNil(args.RewardsHandler) {
 		return nil, epochStart.ErrNilRewardsHandler
 	}
+	if check.IfNil(args.RewardsBreakdownStorer) {
+		return nil, fmt.Errorf("%w for rewards breakdown", epochStart.ErrNilStorage)
+	}
+	if check.IfNil(args.RewardsBreakdownMarshaller) {
+		return nil, fmt.Errorf("%w for rewards breakdown", epochStart.ErrNilMarshalizer)
+	}
 
 	rc := &rewardsCreatorV2{
-		baseRewardsCreator:    brc,
-		economicsDataProvider: args.EconomicsDataProvider,
-		stakingDataProvider:   args.StakingDataProvider,
-		rewardsHandler:        args.RewardsHandler,
+		baseRewardsCreator:         brc,
+		economicsDataProvider:      args.EconomicsDataProvider,
+		stakingDataProvider:        args.StakingDataProvider,
+		rewardsHandler:             args.RewardsHandler,
+		rewardsBreakdownStorer:     args.RewardsBreakdownStorer,
+		rewardsBreakdownMarshaller: args.RewardsBreakdownMarshaller,
 	}
 
 	return rc, nil
@@ -100,6 +116,7 @@ func (rc *rewardsCreatorV2) CreateRewardsMiniBlocks(
 
 	miniBlocks := rc.initializeRewardsMiniBlocks()
 	rc.clean()
+	rc.rewardsBreakdown = nil
 	rc.flagDelegationSystemSCEnabled.SetValue(metaBlock.GetEpoch() >= rc.enableEpochsHandler.GetActivationEpoch(common.StakingV2Flag))
 
 	protRwdTx, protRwdShardId, err := rc.createProtocolSustainabilityRewardTransaction(metaBlock, computedEconomics)
@@ -124,6 +141,8 @@ func (rc *rewardsCreatorV2) CreateRewardsMiniBlocks(
 		return nil, err
 	}
 
+	rc.rewardsBreakdown = rc.createRewardsBreakdown(metaBlock, computedEconomics, nodesRewardInfo)
+
 	return rc.finalizeMiniBlocks(miniBlocks), nil
 }
 
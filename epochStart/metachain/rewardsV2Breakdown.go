@@ -0,0 +1,183 @@
+package metachain
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/epochStart"
+)
+
+// SaveBlockDataToStorage saves block data to storage, along with the rewards breakdown of the ended epoch
+func (rc *rewardsCreatorV2) SaveBlockDataToStorage(metaBlock data.MetaHeaderHandler, body *block.Body) {
+	rc.baseRewardsCreator.SaveBlockDataToStorage(metaBlock, body)
+	rc.saveRewardsBreakdown(metaBlock)
+}
+
+// DeleteBlockDataFromStorage deletes block data from storage, along with the rewards breakdown of the ended epoch
+func (rc *rewardsCreatorV2) DeleteBlockDataFromStorage(metaBlock data.MetaHeaderHandler, body *block.Body) {
+	rc.baseRewardsCreator.DeleteBlockDataFromStorage(metaBlock, body)
+	if check.IfNil(metaBlock) || metaBlock.GetEpoch() == 0 {
+		return
+	}
+
+	_ = rc.rewardsBreakdownStorer.Remove([]byte(core.EpochStartIdentifier(metaBlock.GetEpoch() - 1)))
+}
+
+func (rc *rewardsCreatorV2) saveRewardsBreakdown(metaBlock data.MetaHeaderHandler) {
+	if check.IfNil(metaBlock) {
+		return
+	}
+
+	rc.mutRewardsData.RLock()
+	rewardsBreakdown := rc.rewardsBreakdown
+	rc.mutRewardsData.RUnlock()
+
+	// the breakdown is saved only if it was computed for the committed epoch start block
+	if rewardsBreakdown == nil || rewardsBreakdown.Epoch+1 != metaBlock.GetEpoch() {
+		return
+	}
+
+	buff, err := rc.rewardsBreakdownMarshaller.Marshal(rewardsBreakdown)
+	if err != nil {
+		log.Warn("rewardsCreatorV2.saveRewardsBreakdown - marshal", "epoch", rewardsBreakdown.Epoch, "error", err)
+		return
+	}
+
+	err = rc.rewardsBreakdownStorer.Put([]byte(core.EpochStartIdentifier(rewardsBreakdown.Epoch)), buff)
+	if err != nil {
+		log.Warn("rewardsCreatorV2.saveRewardsBreakdown - put", "epoch", rewardsBreakdown.Epoch, "error", err)
+	}
+}
+
+// createRewardsBreakdown gathers the economics data of the ended epoch and the rewards computed for each eligible
+// node. Should be called under the rewards data mutex, after the protocol sustainability rewards have been adjusted
+func (rc *rewardsCreatorV2) createRewardsBreakdown(
+	metaBlock data.MetaHeaderHandler,
+	computedEconomics *block.Economics,
+	nodesRewardInfo map[uint32][]*nodeRewardsData,
+) *epochStart.RewardsBreakdown {
+	rewardsForBlocks := rc.economicsDataProvider.RewardsToBeDistributedForBlocks()
+	totalTopUpEligible := rc.stakingDataProvider.GetTotalTopUpStakeEligibleNodes()
+	topUpRewards := rc.computeTopUpRewards(rewardsForBlocks, totalTopUpEligible)
+
+	epoch := metaBlock.GetEpoch()
+	if epoch > 0 {
+		epoch--
+	}
+
+	rewardsBreakdown := &epochStart.RewardsBreakdown{
+		Epoch:                            epoch,
+		TotalSupply:                      copyBigInt(computedEconomics.TotalSupply),
+		TotalToDistribute:                copyBigInt(computedEconomics.TotalToDistribute),
+		TotalNewlyMinted:                 copyBigInt(computedEconomics.TotalNewlyMinted),
+		RewardsPerBlock:                  copyBigInt(computedEconomics.RewardsPerBlock),
+		RewardsForProtocolSustainability: copyBigInt(rc.protocolSustainabilityValue),
+		NodePrice:                        copyBigInt(computedEconomics.NodePrice),
+		DeveloperFees:                    copyBigInt(metaBlock.GetDevFeesInEpoch()),
+		LeaderFees:                       copyBigInt(rc.economicsDataProvider.LeaderFees()),
+		RewardsForBlocks:                 copyBigInt(rewardsForBlocks),
+		BaseRewards:                      big.NewInt(0).Sub(rewardsForBlocks, topUpRewards),
+		TopUpRewards:                     topUpRewards,
+		TotalStakeEligible:               copyBigInt(rc.stakingDataProvider.GetTotalStakeEligibleNodes()),
+		TotalTopUpEligible:               copyBigInt(totalTopUpEligible),
+		NumberOfBlocks:                   rc.economicsDataProvider.NumberOfBlocks(),
+	}
+	rewardsBreakdown.Owners = rc.createOwnersRewardsBreakdown(nodesRewardInfo)
+
+	return rewardsBreakdown
+}
+
+func (rc *rewardsCreatorV2) createOwnersRewardsBreakdown(nodesRewardInfo map[uint32][]*nodeRewardsData) []*epochStart.OwnerRewardsBreakdown {
+	ownersData := rc.stakingDataProvider.GetOwnersData()
+	blsKeyOwners := make(map[string]string)
+	for owner, ownerData := range ownersData {
+		for _, blsKey := range ownerData.BlsKeys {
+			blsKeyOwners[string(blsKey)] = owner
+		}
+	}
+
+	ownersBreakdown := make(map[string]*epochStart.OwnerRewardsBreakdown)
+	for shardID, nodeInfoList := range nodesRewardInfo {
+		for _, nodeInfo := range nodeInfoList {
+			// the nodes with an unknown owner are grouped under an empty owner
+			owner := blsKeyOwners[string(nodeInfo.valInfo.GetPublicKey())]
+			ownerBreakdown, ok := ownersBreakdown[owner]
+			if !ok {
+				ownerBreakdown = createOwnerRewardsBreakdown(owner, ownersData[owner])
+				ownersBreakdown[owner] = ownerBreakdown
+			}
+
+			ownerBreakdown.Nodes = append(ownerBreakdown.Nodes, createNodeRewardsBreakdown(shardID, nodeInfo))
+		}
+	}
+
+	owners := make([]*epochStart.OwnerRewardsBreakdown, 0, len(ownersBreakdown))
+	for _, ownerBreakdown := range ownersBreakdown {
+		sort.Slice(ownerBreakdown.Nodes, func(i, j int) bool {
+			return bytes.Compare(ownerBreakdown.Nodes[i].BlsKey, ownerBreakdown.Nodes[j].BlsKey) < 0
+		})
+		owners = append(owners, ownerBreakdown)
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		return bytes.Compare(owners[i].Owner, owners[j].Owner) < 0
+	})
+
+	return owners
+}
+
+func createOwnerRewardsBreakdown(owner string, ownerData *epochStart.OwnerData) *epochStart.OwnerRewardsBreakdown {
+	ownerBreakdown := &epochStart.OwnerRewardsBreakdown{
+		Owner:       []byte(owner),
+		TotalStaked: big.NewInt(0),
+		TotalTopUp:  big.NewInt(0),
+		Nodes:       make([]*epochStart.NodeRewardsBreakdown, 0),
+	}
+	if ownerData == nil {
+		return ownerBreakdown
+	}
+
+	ownerBreakdown.NumStakedNodes = ownerData.NumStakedNodes
+	ownerBreakdown.TotalStaked = copyBigInt(ownerData.TotalStaked)
+	ownerBreakdown.TotalTopUp = copyBigInt(ownerData.TotalTopUp)
+
+	return ownerBreakdown
+}
+
+func createNodeRewardsBreakdown(shardID uint32, nodeInfo *nodeRewardsData) *epochStart.NodeRewardsBreakdown {
+	nodeBreakdown := &epochStart.NodeRewardsBreakdown{
+		BlsKey:                     nodeInfo.valInfo.GetPublicKey(),
+		RewardAddress:              nodeInfo.valInfo.GetRewardAddress(),
+		ShardId:                    shardID,
+		Rating:                     nodeInfo.valInfo.GetRating(),
+		NumSelectedInSuccessBlocks: nodeInfo.valInfo.GetNumSelectedInSuccessBlocks(),
+		TopUpStake:                 copyBigInt(nodeInfo.topUpStake),
+		BaseReward:                 big.NewInt(0),
+		TopUpReward:                big.NewInt(0),
+		LeaderFees:                 big.NewInt(0),
+	}
+
+	// same condition as the one used when distributing the rewards per reward address
+	if nodeInfo.valInfo.GetLeaderSuccess() == 0 && nodeInfo.valInfo.GetValidatorSuccess() == 0 {
+		nodeBreakdown.Offline = true
+		return nodeBreakdown
+	}
+
+	nodeBreakdown.BaseReward = copyBigInt(nodeInfo.baseReward)
+	nodeBreakdown.TopUpReward = copyBigInt(nodeInfo.topUpReward)
+	nodeBreakdown.LeaderFees = copyBigInt(nodeInfo.valInfo.GetAccumulatedFees())
+
+	return nodeBreakdown
+}
+
+func copyBigInt(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(value)
+}
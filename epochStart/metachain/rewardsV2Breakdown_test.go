@@ -0,0 +1,162 @@
+package metachain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon/stakingcommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createRewardsCreatorV2ForBreakdown(t *testing.T) (*rewardsCreatorV2, state.ShardValidatorsInfoMapHandler, RewardsCreatorArgsV2) {
+	args := getRewardsCreatorV2Arguments()
+	nbEligiblePerShard := uint32(4)
+	vInfo := createDefaultValidatorInfo(nbEligiblePerShard, args.ShardCoordinator, args.NodesConfigProvider, 100, defaultBlocksPerShard)
+
+	// the first node of the metachain is offline
+	offlineNode := vInfo.GetShardValidatorsInfoMap()[core.MetachainShardId][0]
+	offlineNode.SetLeaderSuccess(0)
+	offlineNode.SetValidatorSuccess(0)
+
+	ownersData := map[string]*epochStart.OwnerData{
+		"owner1": {
+			NumStakedNodes: 2,
+			TotalStaked:    big.NewInt(5000),
+			TotalTopUp:     big.NewInt(1000),
+			BlsKeys:        [][]byte{[]byte("pubKeyBLS00"), []byte("pubKeyBLS01")},
+		},
+		"owner2": {
+			NumStakedNodes: 1,
+			TotalStaked:    big.NewInt(2500),
+			TotalTopUp:     big.NewInt(0),
+			BlsKeys:        [][]byte{offlineNode.GetPublicKey()},
+		},
+	}
+	args.StakingDataProvider = &stakingcommon.StakingDataProviderStub{
+		GetTotalTopUpStakeEligibleNodesCalled: func() *big.Int {
+			totalTopUpStake, _ := big.NewInt(0).SetString("3000000000000000000000000", 10)
+			return totalTopUpStake
+		},
+		GetNodeStakedTopUpCalled: func(blsKey []byte) (*big.Int, error) {
+			return big.NewInt(500), nil
+		},
+		GetOwnersDataCalled: func() map[string]*epochStart.OwnerData {
+			return ownersData
+		},
+	}
+
+	blocksPerShard := make(map[uint32]uint64)
+	for shardID := range createShardsMap(args.ShardCoordinator) {
+		blocksPerShard[shardID] = uint64(defaultBlocksPerShard)
+	}
+	args.EconomicsDataProvider.SetNumberOfBlocksPerShard(blocksPerShard)
+	rewardsForBlocks, _ := big.NewInt(0).SetString("5000000000000000000000", 10)
+	args.EconomicsDataProvider.SetRewardsToBeDistributedForBlocks(rewardsForBlocks)
+
+	rwd, err := NewRewardsCreatorV2(args)
+	require.Nil(t, err)
+
+	return rwd, vInfo, args
+}
+
+func createEpochStartMetaBlockForBreakdown(epoch uint32) *block.MetaBlock {
+	return &block.MetaBlock{
+		Epoch:          epoch,
+		EpochStart:     getDefaultEpochStart(),
+		DevFeesInEpoch: big.NewInt(37),
+	}
+}
+
+func TestRewardsCreatorV2_SaveBlockDataToStorage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should save the rewards breakdown of the ended epoch", func(t *testing.T) {
+		t.Parallel()
+
+		rwd, vInfo, args := createRewardsCreatorV2ForBreakdown(t)
+		metaBlock := createEpochStartMetaBlockForBreakdown(5)
+		_, err := rwd.CreateRewardsMiniBlocks(metaBlock, vInfo, &metaBlock.EpochStart.Economics)
+		require.Nil(t, err)
+
+		rwd.SaveBlockDataToStorage(metaBlock, &block.Body{})
+
+		buff, err := args.RewardsBreakdownStorer.Get([]byte(core.EpochStartIdentifier(4)))
+		require.Nil(t, err)
+
+		rewardsBreakdown := &epochStart.RewardsBreakdown{}
+		err = args.RewardsBreakdownMarshaller.Unmarshal(rewardsBreakdown, buff)
+		require.Nil(t, err)
+
+		require.Equal(t, uint32(4), rewardsBreakdown.Epoch)
+		require.Equal(t, big.NewInt(37), rewardsBreakdown.DeveloperFees)
+		require.Equal(t, rwd.GetProtocolSustainabilityRewards(), rewardsBreakdown.RewardsForProtocolSustainability)
+		require.Equal(t, args.EconomicsDataProvider.RewardsToBeDistributedForBlocks(), rewardsBreakdown.RewardsForBlocks)
+		require.Equal(t, rewardsBreakdown.RewardsForBlocks, big.NewInt(0).Add(rewardsBreakdown.BaseRewards, rewardsBreakdown.TopUpRewards))
+
+		// nodes without a known owner, owner1 and owner2, sorted by owner
+		require.Equal(t, 3, len(rewardsBreakdown.Owners))
+		require.Empty(t, rewardsBreakdown.Owners[0].Owner)
+		require.Equal(t, len(vInfo.GetAllValidatorsInfo())-3, len(rewardsBreakdown.Owners[0].Nodes))
+
+		owner1 := rewardsBreakdown.Owners[1]
+		require.Equal(t, []byte("owner1"), owner1.Owner)
+		require.Equal(t, int64(2), owner1.NumStakedNodes)
+		require.Equal(t, big.NewInt(5000), owner1.TotalStaked)
+		require.Equal(t, big.NewInt(1000), owner1.TotalTopUp)
+		require.Equal(t, 2, len(owner1.Nodes))
+		require.Equal(t, []byte("pubKeyBLS00"), owner1.Nodes[0].BlsKey)
+		require.Equal(t, []byte("pubKeyBLS01"), owner1.Nodes[1].BlsKey)
+		for _, node := range owner1.Nodes {
+			require.False(t, node.Offline)
+			require.Equal(t, uint32(0), node.ShardId)
+			require.Equal(t, big.NewInt(500), node.TopUpStake)
+			require.Equal(t, big.NewInt(100), node.LeaderFees)
+			require.True(t, node.BaseReward.Cmp(zero) > 0)
+			require.True(t, node.TopUpReward.Cmp(zero) > 0)
+		}
+
+		owner2 := rewardsBreakdown.Owners[2]
+		require.Equal(t, []byte("owner2"), owner2.Owner)
+		require.Equal(t, 1, len(owner2.Nodes))
+		require.True(t, owner2.Nodes[0].Offline)
+		require.Equal(t, core.MetachainShardId, owner2.Nodes[0].ShardId)
+		require.Equal(t, big.NewInt(0), owner2.Nodes[0].BaseReward)
+		require.Equal(t, big.NewInt(0), owner2.Nodes[0].TopUpReward)
+	})
+	t.Run("breakdown computed for another epoch should not save", func(t *testing.T) {
+		t.Parallel()
+
+		rwd, vInfo, args := createRewardsCreatorV2ForBreakdown(t)
+		metaBlock := createEpochStartMetaBlockForBreakdown(5)
+		_, err := rwd.CreateRewardsMiniBlocks(metaBlock, vInfo, &metaBlock.EpochStart.Economics)
+		require.Nil(t, err)
+
+		rwd.SaveBlockDataToStorage(createEpochStartMetaBlockForBreakdown(6), &block.Body{})
+
+		_, err = args.RewardsBreakdownStorer.Get([]byte(core.EpochStartIdentifier(4)))
+		require.NotNil(t, err)
+		_, err = args.RewardsBreakdownStorer.Get([]byte(core.EpochStartIdentifier(5)))
+		require.NotNil(t, err)
+	})
+}
+
+func TestRewardsCreatorV2_DeleteBlockDataFromStorageShouldRemoveRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	rwd, vInfo, args := createRewardsCreatorV2ForBreakdown(t)
+	metaBlock := createEpochStartMetaBlockForBreakdown(5)
+	_, err := rwd.CreateRewardsMiniBlocks(metaBlock, vInfo, &metaBlock.EpochStart.Economics)
+	require.Nil(t, err)
+
+	rwd.SaveBlockDataToStorage(metaBlock, &block.Body{})
+	_, err = args.RewardsBreakdownStorer.Get([]byte(core.EpochStartIdentifier(4)))
+	require.Nil(t, err)
+
+	rwd.DeleteBlockDataFromStorage(metaBlock, &block.Body{})
+	_, err = args.RewardsBreakdownStorer.Get([]byte(core.EpochStartIdentifier(4)))
+	require.NotNil(t, err)
+}
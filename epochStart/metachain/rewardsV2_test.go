@@ -3,6 +3,7 @@ package metachain
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -13,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/epochStart/mock"
@@ -86,6 +88,28 @@ func TestNewRewardsCreator_NilRewardsHandlerShouldErr(t *testing.T) {
 	require.Equal(t, epochStart.ErrNilRewardsHandler, err)
 }
 
+func TestNewRewardsCreator_NilRewardsBreakdownStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getRewardsCreatorV2Arguments()
+	args.RewardsBreakdownStorer = nil
+
+	rwd, err := NewRewardsCreatorV2(args)
+	require.True(t, check.IfNil(rwd))
+	require.True(t, errors.Is(err, epochStart.ErrNilStorage))
+}
+
+func TestNewRewardsCreator_NilRewardsBreakdownMarshallerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getRewardsCreatorV2Arguments()
+	args.RewardsBreakdownMarshaller = nil
+
+	rwd, err := NewRewardsCreatorV2(args)
+	require.True(t, check.IfNil(rwd))
+	require.True(t, errors.Is(err, epochStart.ErrNilMarshalizer))
+}
+
 func TestNewRewardsCreatorOK(t *testing.T) {
 	t.Parallel()
 
@@ -1775,10 +1799,12 @@ func getRewardsCreatorV2Arguments() RewardsCreatorArgsV2 {
 		},
 	}
 	return RewardsCreatorArgsV2{
-		BaseRewardsCreatorArgs: getBaseRewardsArguments(),
-		StakingDataProvider:    &stakingcommon.StakingDataProviderStub{},
-		EconomicsDataProvider:  NewEpochEconomicsStatistics(),
-		RewardsHandler:         rewardsHandler,
+		BaseRewardsCreatorArgs:     getBaseRewardsArguments(),
+		StakingDataProvider:        &stakingcommon.StakingDataProviderStub{},
+		EconomicsDataProvider:      NewEpochEconomicsStatistics(),
+		RewardsHandler:             rewardsHandler,
+		RewardsBreakdownStorer:     mock.NewStorerMock(),
+		RewardsBreakdownMarshaller: &marshal.GogoProtoMarshalizer{},
 	}
 }
 
@@ -1795,10 +1821,12 @@ func getRewardsCreatorV35Arguments() RewardsCreatorArgsV2 {
 		},
 	}
 	return RewardsCreatorArgsV2{
-		BaseRewardsCreatorArgs: getBaseRewardsArguments(),
-		StakingDataProvider:    &stakingcommon.StakingDataProviderStub{},
-		EconomicsDataProvider:  NewEpochEconomicsStatistics(),
-		RewardsHandler:         rewardsHandler,
+		BaseRewardsCreatorArgs:     getBaseRewardsArguments(),
+		StakingDataProvider:        &stakingcommon.StakingDataProviderStub{},
+		EconomicsDataProvider:      NewEpochEconomicsStatistics(),
+		RewardsHandler:             rewardsHandler,
+		RewardsBreakdownStorer:     mock.NewStorerMock(),
+		RewardsBreakdownMarshaller: &marshal.GogoProtoMarshalizer{},
 	}
 }
 
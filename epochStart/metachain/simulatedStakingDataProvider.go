@@ -0,0 +1,64 @@
+package metachain
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/epochStart"
+)
+
+// simulatedStakingDataProvider wraps a staking data provider, allowing the owners' data to be temporarily replaced
+// with hypothetical values. It is used by the API components in order to run the auction selection on what-if scenarios
+type simulatedStakingDataProvider struct {
+	epochStart.StakingDataProvider
+	mutOverride        sync.RWMutex
+	ownersDataOverride map[string]*epochStart.OwnerData
+}
+
+// NewSimulatedStakingDataProvider creates a staking data provider able to provide overridden owners' data
+func NewSimulatedStakingDataProvider(provider epochStart.StakingDataProvider) (*simulatedStakingDataProvider, error) {
+	if check.IfNil(provider) {
+		return nil, epochStart.ErrNilStakingDataProvider
+	}
+
+	return &simulatedStakingDataProvider{
+		StakingDataProvider: provider,
+	}, nil
+}
+
+// SetOwnersDataOverride sets the owners' data to be returned instead of the wrapped provider's owners' data
+func (sdp *simulatedStakingDataProvider) SetOwnersDataOverride(ownersData map[string]*epochStart.OwnerData) {
+	sdp.mutOverride.Lock()
+	sdp.ownersDataOverride = ownersData
+	sdp.mutOverride.Unlock()
+}
+
+// ResetOwnersDataOverride removes the overridden owners' data
+func (sdp *simulatedStakingDataProvider) ResetOwnersDataOverride() {
+	sdp.mutOverride.Lock()
+	sdp.ownersDataOverride = nil
+	sdp.mutOverride.Unlock()
+}
+
+// GetOwnersData returns the overridden owners' data, if set, otherwise the wrapped provider's owners' data
+func (sdp *simulatedStakingDataProvider) GetOwnersData() map[string]*epochStart.OwnerData {
+	sdp.mutOverride.RLock()
+	ownersDataOverride := sdp.ownersDataOverride
+	sdp.mutOverride.RUnlock()
+
+	if ownersDataOverride == nil {
+		return sdp.StakingDataProvider.GetOwnersData()
+	}
+
+	ret := make(map[string]*epochStart.OwnerData, len(ownersDataOverride))
+	for owner, ownerData := range ownersDataOverride {
+		ret[owner] = epochStart.CopyOwnerData(ownerData)
+	}
+
+	return ret
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sdp *simulatedStakingDataProvider) IsInterfaceNil() bool {
+	return sdp == nil
+}
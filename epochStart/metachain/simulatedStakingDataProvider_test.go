@@ -0,0 +1,69 @@
+package metachain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon/stakingcommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSimulatedStakingDataProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil staking data provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		sdp, err := NewSimulatedStakingDataProvider(nil)
+		require.Nil(t, sdp)
+		require.Equal(t, epochStart.ErrNilStakingDataProvider, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sdp, err := NewSimulatedStakingDataProvider(&stakingcommon.StakingDataProviderStub{})
+		require.Nil(t, err)
+		require.False(t, check.IfNil(sdp))
+	})
+}
+
+func TestSimulatedStakingDataProvider_GetOwnersData(t *testing.T) {
+	t.Parallel()
+
+	providedOwnersData := map[string]*epochStart.OwnerData{
+		"owner1": {
+			NumStakedNodes: 2,
+			TotalTopUp:     big.NewInt(100),
+			TopUpPerNode:   big.NewInt(50),
+			AuctionList:    []state.ValidatorInfoHandler{&state.ValidatorInfo{PublicKey: []byte("pk1")}},
+			Qualified:      true,
+		},
+	}
+	sdp, _ := NewSimulatedStakingDataProvider(&stakingcommon.StakingDataProviderStub{
+		GetOwnersDataCalled: func() map[string]*epochStart.OwnerData {
+			return providedOwnersData
+		},
+	})
+	require.Equal(t, providedOwnersData, sdp.GetOwnersData())
+
+	overriddenOwnersData := map[string]*epochStart.OwnerData{
+		"owner2": {
+			NumStakedNodes: 1,
+			TotalTopUp:     big.NewInt(10),
+			TopUpPerNode:   big.NewInt(10),
+			AuctionList:    []state.ValidatorInfoHandler{&state.ValidatorInfo{PublicKey: []byte("pk2")}},
+		},
+	}
+	sdp.SetOwnersDataOverride(overriddenOwnersData)
+	ownersData := sdp.GetOwnersData()
+	require.Equal(t, overriddenOwnersData, ownersData)
+
+	ownersData["owner2"].TotalTopUp.SetInt64(0)
+	require.Equal(t, big.NewInt(10), overriddenOwnersData["owner2"].TotalTopUp)
+
+	sdp.ResetOwnersDataOverride()
+	require.Equal(t, providedOwnersData, sdp.GetOwnersData())
+}
@@ -247,9 +247,12 @@ func (sdp *stakingDataProvider) GetOwnersData() map[string]*epochStart.OwnerData
 			NumStakedNodes: ownerData.numStakedNodes,
 			TotalTopUp:     big.NewInt(0).SetBytes(ownerData.totalTopUp.Bytes()),
 			TopUpPerNode:   big.NewInt(0).SetBytes(ownerData.topUpPerNode.Bytes()),
+			TotalStaked:    big.NewInt(0).SetBytes(ownerData.totalStaked.Bytes()),
+			BlsKeys:        make([][]byte, len(ownerData.blsKeys)),
 			AuctionList:    make([]state.ValidatorInfoHandler, len(ownerData.auctionList)),
 			Qualified:      ownerData.qualified,
 		}
+		copy(ret[owner].BlsKeys, ownerData.blsKeys)
 		copy(ret[owner].AuctionList, ownerData.auctionList)
 	}
 
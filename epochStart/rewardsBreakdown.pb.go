@@ -0,0 +1,2626 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rewardsBreakdown.proto
+
+package epochStart
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_multiversx_mx_chain_core_go_data "github.com/multiversx/mx-chain-core-go/data"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RewardsBreakdown holds the economics data used when computing the rewards of an epoch, along with the staking data
+// and the rewards of each eligible node, grouped by owners
+type RewardsBreakdown struct {
+	Epoch                            uint32                   `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	TotalSupply                      *math_big.Int            `protobuf:"bytes,2,opt,name=TotalSupply,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"TotalSupply,omitempty"`
+	TotalToDistribute                *math_big.Int            `protobuf:"bytes,3,opt,name=TotalToDistribute,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"TotalToDistribute,omitempty"`
+	TotalNewlyMinted                 *math_big.Int            `protobuf:"bytes,4,opt,name=TotalNewlyMinted,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"TotalNewlyMinted,omitempty"`
+	RewardsPerBlock                  *math_big.Int            `protobuf:"bytes,5,opt,name=RewardsPerBlock,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"RewardsPerBlock,omitempty"`
+	RewardsForProtocolSustainability *math_big.Int            `protobuf:"bytes,6,opt,name=RewardsForProtocolSustainability,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"RewardsForProtocolSustainability,omitempty"`
+	NodePrice                        *math_big.Int            `protobuf:"bytes,7,opt,name=NodePrice,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"NodePrice,omitempty"`
+	DeveloperFees                    *math_big.Int            `protobuf:"bytes,8,opt,name=DeveloperFees,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"DeveloperFees,omitempty"`
+	LeaderFees                       *math_big.Int            `protobuf:"bytes,9,opt,name=LeaderFees,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"LeaderFees,omitempty"`
+	RewardsForBlocks                 *math_big.Int            `protobuf:"bytes,10,opt,name=RewardsForBlocks,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"RewardsForBlocks,omitempty"`
+	BaseRewards                      *math_big.Int            `protobuf:"bytes,11,opt,name=BaseRewards,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"BaseRewards,omitempty"`
+	TopUpRewards                     *math_big.Int            `protobuf:"bytes,12,opt,name=TopUpRewards,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"TopUpRewards,omitempty"`
+	TotalStakeEligible               *math_big.Int            `protobuf:"bytes,13,opt,name=TotalStakeEligible,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"TotalStakeEligible,omitempty"`
+	TotalTopUpEligible               *math_big.Int            `protobuf:"bytes,14,opt,name=TotalTopUpEligible,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"TotalTopUpEligible,omitempty"`
+	NumberOfBlocks                   uint64                   `protobuf:"varint,15,opt,name=NumberOfBlocks,proto3" json:"NumberOfBlocks,omitempty"`
+	Owners                           []*OwnerRewardsBreakdown `protobuf:"bytes,16,rep,name=Owners,proto3" json:"Owners,omitempty"`
+}
+
+func (m *RewardsBreakdown) Reset()      { *m = RewardsBreakdown{} }
+func (*RewardsBreakdown) ProtoMessage() {}
+func (*RewardsBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17855dbf0640421b, []int{0}
+}
+func (m *RewardsBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RewardsBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsBreakdown.Merge(m, src)
+}
+func (m *RewardsBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsBreakdown proto.InternalMessageInfo
+
+func (m *RewardsBreakdown) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *RewardsBreakdown) GetTotalSupply() *math_big.Int {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return nil
+}
+
+func (m *RewardsBreakdown) GetTotalToDistribute() *math_big.Int {
+	if m != nil {
+		return m.TotalToDistribute
+	}
+	return nil
+}
+
+func (m *RewardsBreakdown) GetTotalNewlyMinted() *math_big.Int {
+	if m != nil {
+		return m.TotalNewlyMinted
+	}
+	return nil
+}
+
+func (m *RewardsBreakdown) GetRewardsPerBlock() *math_big.Int {
+	if m != nil {
+		return m.RewardsPerBlock
+	}
+	return nil
+}
+
+func (m *RewardsBreakdown) GetRewardsForProtocolSustainability() *math_big.Int {
+	if m != nil {
+		return m.RewardsForProtocolSustainability
+	}
+	return nil
+}
+
+func (m *RewardsBreakdown) GetNodePrice() *math_big.Int {
+	if m != nil {
+		return m.NodePrice
+	}
+	return nil
+}
+
+func (m *RewardsBreakdown) GetDeveloperFees() *math_big.Int {
+	if m != nil {
+		return m.DeveloperFees
+	}
+	return nil
+}
+
+func (m *RewardsBreakdown) GetLeaderFees() *math_big.Int {
+	if m != nil {
+		return m.LeaderFees
+	}
+	return nil
+}
+
+func (m *RewardsBreakdown) GetRewardsForBlocks() *math_big.Int {
+	if m != nil {
+		return m.RewardsForBlocks
+	}
+	return nil
+}
+
+func (m *RewardsBreakdown) GetBaseRewards() *math_big.Int {
+	if m != nil {
+		return m.BaseRewards
+	}
+	return nil
+}
+
+func (m *RewardsBreakdown) GetTopUpRewards() *math_big.Int {
+	if m != nil {
+		return m.TopUpRewards
+	}
+	return nil
+}
+
+func (m *RewardsBreakdown) GetTotalStakeEligible() *math_big.Int {
+	if m != nil {
+		return m.TotalStakeEligible
+	}
+	return nil
+}
+
+func (m *RewardsBreakdown) GetTotalTopUpEligible() *math_big.Int {
+	if m != nil {
+		return m.TotalTopUpEligible
+	}
+	return nil
+}
+
+func (m *RewardsBreakdown) GetNumberOfBlocks() uint64 {
+	if m != nil {
+		return m.NumberOfBlocks
+	}
+	return 0
+}
+
+func (m *RewardsBreakdown) GetOwners() []*OwnerRewardsBreakdown {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+// OwnerRewardsBreakdown holds the staking data of an owner along with the rewards of its eligible nodes
+type OwnerRewardsBreakdown struct {
+	Owner          []byte                  `protobuf:"bytes,1,opt,name=Owner,proto3" json:"Owner,omitempty"`
+	NumStakedNodes int64                   `protobuf:"varint,2,opt,name=NumStakedNodes,proto3" json:"NumStakedNodes,omitempty"`
+	TotalStaked    *math_big.Int           `protobuf:"bytes,3,opt,name=TotalStaked,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"TotalStaked,omitempty"`
+	TotalTopUp     *math_big.Int           `protobuf:"bytes,4,opt,name=TotalTopUp,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"TotalTopUp,omitempty"`
+	Nodes          []*NodeRewardsBreakdown `protobuf:"bytes,5,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+}
+
+func (m *OwnerRewardsBreakdown) Reset()      { *m = OwnerRewardsBreakdown{} }
+func (*OwnerRewardsBreakdown) ProtoMessage() {}
+func (*OwnerRewardsBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17855dbf0640421b, []int{1}
+}
+func (m *OwnerRewardsBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnerRewardsBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OwnerRewardsBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerRewardsBreakdown.Merge(m, src)
+}
+func (m *OwnerRewardsBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnerRewardsBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerRewardsBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnerRewardsBreakdown proto.InternalMessageInfo
+
+func (m *OwnerRewardsBreakdown) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *OwnerRewardsBreakdown) GetNumStakedNodes() int64 {
+	if m != nil {
+		return m.NumStakedNodes
+	}
+	return 0
+}
+
+func (m *OwnerRewardsBreakdown) GetTotalStaked() *math_big.Int {
+	if m != nil {
+		return m.TotalStaked
+	}
+	return nil
+}
+
+func (m *OwnerRewardsBreakdown) GetTotalTopUp() *math_big.Int {
+	if m != nil {
+		return m.TotalTopUp
+	}
+	return nil
+}
+
+func (m *OwnerRewardsBreakdown) GetNodes() []*NodeRewardsBreakdown {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// NodeRewardsBreakdown holds the rewards of an eligible node along with the data used when computing them. The offline
+// nodes do not receive rewards, their share being moved to the protocol sustainability address
+type NodeRewardsBreakdown struct {
+	BlsKey                     []byte        `protobuf:"bytes,1,opt,name=BlsKey,proto3" json:"BlsKey,omitempty"`
+	RewardAddress              []byte        `protobuf:"bytes,2,opt,name=RewardAddress,proto3" json:"RewardAddress,omitempty"`
+	ShardId                    uint32        `protobuf:"varint,3,opt,name=ShardId,proto3" json:"ShardId,omitempty"`
+	Rating                     uint32        `protobuf:"varint,4,opt,name=Rating,proto3" json:"Rating,omitempty"`
+	NumSelectedInSuccessBlocks uint32        `protobuf:"varint,5,opt,name=NumSelectedInSuccessBlocks,proto3" json:"NumSelectedInSuccessBlocks,omitempty"`
+	Offline                    bool          `protobuf:"varint,6,opt,name=Offline,proto3" json:"Offline,omitempty"`
+	TopUpStake                 *math_big.Int `protobuf:"bytes,7,opt,name=TopUpStake,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"TopUpStake,omitempty"`
+	BaseReward                 *math_big.Int `protobuf:"bytes,8,opt,name=BaseReward,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"BaseReward,omitempty"`
+	TopUpReward                *math_big.Int `protobuf:"bytes,9,opt,name=TopUpReward,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"TopUpReward,omitempty"`
+	LeaderFees                 *math_big.Int `protobuf:"bytes,10,opt,name=LeaderFees,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"LeaderFees,omitempty"`
+}
+
+func (m *NodeRewardsBreakdown) Reset()      { *m = NodeRewardsBreakdown{} }
+func (*NodeRewardsBreakdown) ProtoMessage() {}
+func (*NodeRewardsBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17855dbf0640421b, []int{2}
+}
+func (m *NodeRewardsBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeRewardsBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeRewardsBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeRewardsBreakdown.Merge(m, src)
+}
+func (m *NodeRewardsBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeRewardsBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeRewardsBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeRewardsBreakdown proto.InternalMessageInfo
+
+func (m *NodeRewardsBreakdown) GetBlsKey() []byte {
+	if m != nil {
+		return m.BlsKey
+	}
+	return nil
+}
+
+func (m *NodeRewardsBreakdown) GetRewardAddress() []byte {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return nil
+}
+
+func (m *NodeRewardsBreakdown) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *NodeRewardsBreakdown) GetRating() uint32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *NodeRewardsBreakdown) GetNumSelectedInSuccessBlocks() uint32 {
+	if m != nil {
+		return m.NumSelectedInSuccessBlocks
+	}
+	return 0
+}
+
+func (m *NodeRewardsBreakdown) GetOffline() bool {
+	if m != nil {
+		return m.Offline
+	}
+	return false
+}
+
+func (m *NodeRewardsBreakdown) GetTopUpStake() *math_big.Int {
+	if m != nil {
+		return m.TopUpStake
+	}
+	return nil
+}
+
+func (m *NodeRewardsBreakdown) GetBaseReward() *math_big.Int {
+	if m != nil {
+		return m.BaseReward
+	}
+	return nil
+}
+
+func (m *NodeRewardsBreakdown) GetTopUpReward() *math_big.Int {
+	if m != nil {
+		return m.TopUpReward
+	}
+	return nil
+}
+
+func (m *NodeRewardsBreakdown) GetLeaderFees() *math_big.Int {
+	if m != nil {
+		return m.LeaderFees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RewardsBreakdown)(nil), "proto.RewardsBreakdown")
+	proto.RegisterType((*OwnerRewardsBreakdown)(nil), "proto.OwnerRewardsBreakdown")
+	proto.RegisterType((*NodeRewardsBreakdown)(nil), "proto.NodeRewardsBreakdown")
+}
+
+func init() { proto.RegisterFile("rewardsBreakdown.proto", fileDescriptor_17855dbf0640421b) }
+
+var fileDescriptor_17855dbf0640421b = []byte{
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0x63, 0x20, 0x01, 0x86, 0x04, 0xb8, 0x23, 0x2e, 0xb2, 0xb8, 0x57, 0xbe, 0x11, 0xba,
+	0xba, 0xca, 0x26, 0x89, 0xee, 0x9f, 0xdd, 0x95, 0x2a, 0x35, 0x05, 0xa4, 0xa8, 0x2d, 0x20, 0x07,
+	0x36, 0xdd, 0x8d, 0xed, 0x13, 0x67, 0x14, 0xc7, 0x63, 0xcd, 0x8c, 0x09, 0xd9, 0xf5, 0x09, 0xaa,
+	0x6e, 0xfa, 0x0e, 0x55, 0x9f, 0xa4, 0x4b, 0x96, 0xec, 0x0a, 0x66, 0xd3, 0x25, 0x4f, 0x50, 0x55,
+	0x1e, 0x1b, 0xe2, 0x04, 0xd4, 0x6e, 0x86, 0x15, 0x9c, 0xe3, 0x93, 0xef, 0x37, 0x39, 0x73, 0x7c,
+	0xbe, 0xa0, 0x6d, 0x0e, 0x63, 0xc2, 0x3d, 0xd1, 0xe1, 0x40, 0x86, 0x1e, 0x1b, 0x87, 0xad, 0x88,
+	0x33, 0xc9, 0x70, 0x59, 0xfd, 0xd9, 0x69, 0xfa, 0x54, 0x0e, 0x62, 0xa7, 0xe5, 0xb2, 0x51, 0xdb,
+	0x67, 0x3e, 0x6b, 0xab, 0xb4, 0x13, 0xf7, 0x55, 0xa4, 0x02, 0xf5, 0x5f, 0xf6, 0xa9, 0xdd, 0x6f,
+	0x55, 0xb4, 0x69, 0xcf, 0x09, 0xe2, 0x2d, 0x54, 0xde, 0x8f, 0x98, 0x3b, 0x30, 0x8d, 0xba, 0xd1,
+	0xa8, 0xd9, 0x59, 0x80, 0x87, 0x68, 0xed, 0x84, 0x49, 0x12, 0xf4, 0xe2, 0x28, 0x0a, 0x26, 0xe6,
+	0x42, 0xdd, 0x68, 0x54, 0x3b, 0xdd, 0x4f, 0x5f, 0xfe, 0xd8, 0x1f, 0x11, 0x39, 0x68, 0x3b, 0xd4,
+	0x6f, 0x75, 0x43, 0xf9, 0x7f, 0x81, 0x3f, 0x8a, 0x03, 0x49, 0xcf, 0x80, 0x8b, 0xf3, 0xf6, 0xe8,
+	0xbc, 0xe9, 0x0e, 0x08, 0x0d, 0x9b, 0x2e, 0xe3, 0xd0, 0xf4, 0x59, 0xdb, 0x23, 0x92, 0xb4, 0x3a,
+	0xd4, 0xef, 0x86, 0xf2, 0x05, 0x11, 0x12, 0xb8, 0x5d, 0x54, 0xc7, 0x63, 0xf4, 0x8b, 0x0a, 0x4f,
+	0xd8, 0x1e, 0x15, 0x92, 0x53, 0x27, 0x96, 0x60, 0x2e, 0xea, 0x46, 0x3e, 0x64, 0xe0, 0x18, 0x6d,
+	0xaa, 0xe4, 0x21, 0x8c, 0x83, 0xc9, 0x6b, 0x1a, 0x4a, 0xf0, 0xcc, 0x25, 0xdd, 0xdc, 0x07, 0x08,
+	0x2c, 0xd0, 0x46, 0x7e, 0x0d, 0xc7, 0xc0, 0x3b, 0x01, 0x73, 0x87, 0x66, 0x59, 0x37, 0x75, 0x9e,
+	0x80, 0x3f, 0x18, 0xa8, 0x9e, 0xe7, 0x0e, 0x18, 0x3f, 0x4e, 0x07, 0xc2, 0x65, 0x41, 0x2f, 0x16,
+	0x92, 0xd0, 0x90, 0x38, 0x34, 0xa0, 0x72, 0x62, 0x56, 0x74, 0x1f, 0xe3, 0xa7, 0x48, 0xec, 0xa3,
+	0xd5, 0x43, 0xe6, 0xc1, 0x31, 0xa7, 0x2e, 0x98, 0xcb, 0xba, 0xf9, 0x53, 0x6d, 0xcc, 0x50, 0x6d,
+	0x0f, 0xce, 0x20, 0x60, 0x11, 0xf0, 0x03, 0x00, 0x61, 0xae, 0xe8, 0x86, 0xcd, 0xea, 0x63, 0x8a,
+	0xd0, 0x2b, 0x20, 0x5e, 0x4e, 0x5b, 0xd5, 0x4d, 0x2b, 0x88, 0xa7, 0x83, 0x3c, 0x6d, 0xb4, 0xba,
+	0x6f, 0x61, 0x22, 0xed, 0x83, 0x3c, 0x8f, 0x48, 0xb7, 0x44, 0x87, 0x08, 0xc8, 0xf3, 0xe6, 0x9a,
+	0xf6, 0x2d, 0x51, 0x50, 0xc7, 0x23, 0x54, 0x3d, 0x61, 0xd1, 0x69, 0x74, 0x47, 0xab, 0xea, 0xa6,
+	0xcd, 0xc8, 0xe3, 0x09, 0xc2, 0xd9, 0x8e, 0x92, 0x64, 0x08, 0xfb, 0x01, 0xf5, 0xa9, 0x13, 0x80,
+	0x59, 0xd3, 0x0d, 0x7d, 0x04, 0x72, 0x8f, 0x56, 0xe7, 0xb9, 0x47, 0xaf, 0x3f, 0x0d, 0x7a, 0x06,
+	0x82, 0xff, 0x42, 0xeb, 0x87, 0xf1, 0xc8, 0x01, 0x7e, 0xd4, 0xcf, 0xc7, 0x68, 0xa3, 0x6e, 0x34,
+	0x96, 0xec, 0xb9, 0x2c, 0xfe, 0x0f, 0x55, 0x8e, 0xc6, 0x21, 0x70, 0x61, 0x6e, 0xd6, 0x17, 0x1b,
+	0x6b, 0xff, 0xfc, 0x9e, 0x59, 0x4c, 0x4b, 0x25, 0xe7, 0x3d, 0xc6, 0xce, 0x6b, 0x77, 0xaf, 0x16,
+	0xd0, 0xaf, 0x8f, 0x56, 0xa4, 0x2e, 0xa4, 0x1e, 0x28, 0x17, 0xaa, 0xda, 0x59, 0x90, 0x9f, 0x46,
+	0x35, 0xc7, 0x4b, 0x5f, 0x64, 0xa1, 0x8c, 0x68, 0xd1, 0x9e, 0xcb, 0x4e, 0xdd, 0x4a, 0xe5, 0xf4,
+	0x5b, 0x47, 0x51, 0x3d, 0x7d, 0xad, 0xa7, 0x8d, 0xd3, 0x6f, 0x17, 0x05, 0x71, 0xfc, 0x37, 0x2a,
+	0x67, 0x5f, 0xbb, 0xac, 0x9a, 0xfc, 0x5b, 0xde, 0xe4, 0x34, 0xf7, 0xa0, 0xc7, 0x59, 0xe5, 0xee,
+	0xbb, 0x32, 0xda, 0x7a, 0xec, 0x39, 0xde, 0x46, 0x95, 0x4e, 0x20, 0x5e, 0xc2, 0x24, 0x6f, 0x71,
+	0x1e, 0xe1, 0x3f, 0x51, 0x2d, 0xab, 0x7d, 0xee, 0x79, 0x1c, 0x44, 0xd6, 0xe2, 0xaa, 0x3d, 0x9b,
+	0xc4, 0x26, 0x5a, 0xee, 0x0d, 0x08, 0xf7, 0xba, 0x59, 0x77, 0x6b, 0xf6, 0x5d, 0x98, 0xea, 0xda,
+	0x44, 0xd2, 0xd0, 0x57, 0xad, 0xa8, 0xd9, 0x79, 0x84, 0x9f, 0xa1, 0x9d, 0xf4, 0x96, 0x20, 0x00,
+	0x57, 0x82, 0xd7, 0x0d, 0x7b, 0xb1, 0xeb, 0x82, 0x10, 0xf9, 0x54, 0x95, 0x55, 0xed, 0x0f, 0x2a,
+	0x52, 0xe2, 0x51, 0xbf, 0x1f, 0xd0, 0x10, 0x94, 0x2b, 0xad, 0xd8, 0x77, 0x61, 0x76, 0x01, 0xd1,
+	0x69, 0xa4, 0xee, 0x43, 0xbf, 0x65, 0x14, 0xc4, 0x53, 0xd4, 0x74, 0x05, 0xe9, 0x37, 0x8c, 0x82,
+	0x78, 0x36, 0xc3, 0xf7, 0xfb, 0x47, 0xbf, 0x5d, 0x14, 0xd5, 0xe7, 0xac, 0x09, 0x3d, 0xa1, 0x35,
+	0x75, 0xf6, 0x2e, 0xae, 0xad, 0xd2, 0xe5, 0xb5, 0x55, 0xba, 0xbd, 0xb6, 0x8c, 0xb7, 0x89, 0x65,
+	0x7c, 0x4c, 0x2c, 0xe3, 0x73, 0x62, 0x19, 0x17, 0x89, 0x65, 0x5c, 0x26, 0x96, 0x71, 0x95, 0x58,
+	0xc6, 0xd7, 0xc4, 0x2a, 0xdd, 0x26, 0x96, 0xf1, 0xfe, 0xc6, 0x2a, 0x5d, 0xdc, 0x58, 0xa5, 0xcb,
+	0x1b, 0xab, 0xf4, 0x06, 0x41, 0xfa, 0x53, 0xb4, 0x27, 0x09, 0x97, 0x4e, 0x45, 0x4d, 0xfe, 0xbf,
+	0xdf, 0x07, 0x00, 0xb5, 0xf1, 0x48, 0x14, 0x11, 0x0b, 0x00, 0x00,
+}
+
+func (this *RewardsBreakdown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardsBreakdown)
+	if !ok {
+		that2, ok := that.(RewardsBreakdown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalSupply, that1.TotalSupply) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalToDistribute, that1.TotalToDistribute) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalNewlyMinted, that1.TotalNewlyMinted) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.RewardsPerBlock, that1.RewardsPerBlock) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.RewardsForProtocolSustainability, that1.RewardsForProtocolSustainability) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.NodePrice, that1.NodePrice) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.DeveloperFees, that1.DeveloperFees) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.LeaderFees, that1.LeaderFees) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.RewardsForBlocks, that1.RewardsForBlocks) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.BaseRewards, that1.BaseRewards) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TopUpRewards, that1.TopUpRewards) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalStakeEligible, that1.TotalStakeEligible) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalTopUpEligible, that1.TotalTopUpEligible) {
+			return false
+		}
+	}
+	if this.NumberOfBlocks != that1.NumberOfBlocks {
+		return false
+	}
+	if len(this.Owners) != len(that1.Owners) {
+		return false
+	}
+	for i := range this.Owners {
+		if !this.Owners[i].Equal(that1.Owners[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *OwnerRewardsBreakdown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OwnerRewardsBreakdown)
+	if !ok {
+		that2, ok := that.(OwnerRewardsBreakdown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Owner, that1.Owner) {
+		return false
+	}
+	if this.NumStakedNodes != that1.NumStakedNodes {
+		return false
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalStaked, that1.TotalStaked) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalTopUp, that1.TotalTopUp) {
+			return false
+		}
+	}
+	if len(this.Nodes) != len(that1.Nodes) {
+		return false
+	}
+	for i := range this.Nodes {
+		if !this.Nodes[i].Equal(that1.Nodes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *NodeRewardsBreakdown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NodeRewardsBreakdown)
+	if !ok {
+		that2, ok := that.(NodeRewardsBreakdown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.BlsKey, that1.BlsKey) {
+		return false
+	}
+	if !bytes.Equal(this.RewardAddress, that1.RewardAddress) {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.Rating != that1.Rating {
+		return false
+	}
+	if this.NumSelectedInSuccessBlocks != that1.NumSelectedInSuccessBlocks {
+		return false
+	}
+	if this.Offline != that1.Offline {
+		return false
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TopUpStake, that1.TopUpStake) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.BaseReward, that1.BaseReward) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TopUpReward, that1.TopUpReward) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.LeaderFees, that1.LeaderFees) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RewardsBreakdown) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 20)
+	s = append(s, "&epochStart.RewardsBreakdown{")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "TotalSupply: "+fmt.Sprintf("%#v", this.TotalSupply)+",\n")
+	s = append(s, "TotalToDistribute: "+fmt.Sprintf("%#v", this.TotalToDistribute)+",\n")
+	s = append(s, "TotalNewlyMinted: "+fmt.Sprintf("%#v", this.TotalNewlyMinted)+",\n")
+	s = append(s, "RewardsPerBlock: "+fmt.Sprintf("%#v", this.RewardsPerBlock)+",\n")
+	s = append(s, "RewardsForProtocolSustainability: "+fmt.Sprintf("%#v", this.RewardsForProtocolSustainability)+",\n")
+	s = append(s, "NodePrice: "+fmt.Sprintf("%#v", this.NodePrice)+",\n")
+	s = append(s, "DeveloperFees: "+fmt.Sprintf("%#v", this.DeveloperFees)+",\n")
+	s = append(s, "LeaderFees: "+fmt.Sprintf("%#v", this.LeaderFees)+",\n")
+	s = append(s, "RewardsForBlocks: "+fmt.Sprintf("%#v", this.RewardsForBlocks)+",\n")
+	s = append(s, "BaseRewards: "+fmt.Sprintf("%#v", this.BaseRewards)+",\n")
+	s = append(s, "TopUpRewards: "+fmt.Sprintf("%#v", this.TopUpRewards)+",\n")
+	s = append(s, "TotalStakeEligible: "+fmt.Sprintf("%#v", this.TotalStakeEligible)+",\n")
+	s = append(s, "TotalTopUpEligible: "+fmt.Sprintf("%#v", this.TotalTopUpEligible)+",\n")
+	s = append(s, "NumberOfBlocks: "+fmt.Sprintf("%#v", this.NumberOfBlocks)+",\n")
+	if this.Owners != nil {
+		s = append(s, "Owners: "+fmt.Sprintf("%#v", this.Owners)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OwnerRewardsBreakdown) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&epochStart.OwnerRewardsBreakdown{")
+	s = append(s, "Owner: "+fmt.Sprintf("%#v", this.Owner)+",\n")
+	s = append(s, "NumStakedNodes: "+fmt.Sprintf("%#v", this.NumStakedNodes)+",\n")
+	s = append(s, "TotalStaked: "+fmt.Sprintf("%#v", this.TotalStaked)+",\n")
+	s = append(s, "TotalTopUp: "+fmt.Sprintf("%#v", this.TotalTopUp)+",\n")
+	if this.Nodes != nil {
+		s = append(s, "Nodes: "+fmt.Sprintf("%#v", this.Nodes)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NodeRewardsBreakdown) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&epochStart.NodeRewardsBreakdown{")
+	s = append(s, "BlsKey: "+fmt.Sprintf("%#v", this.BlsKey)+",\n")
+	s = append(s, "RewardAddress: "+fmt.Sprintf("%#v", this.RewardAddress)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Rating: "+fmt.Sprintf("%#v", this.Rating)+",\n")
+	s = append(s, "NumSelectedInSuccessBlocks: "+fmt.Sprintf("%#v", this.NumSelectedInSuccessBlocks)+",\n")
+	s = append(s, "Offline: "+fmt.Sprintf("%#v", this.Offline)+",\n")
+	s = append(s, "TopUpStake: "+fmt.Sprintf("%#v", this.TopUpStake)+",\n")
+	s = append(s, "BaseReward: "+fmt.Sprintf("%#v", this.BaseReward)+",\n")
+	s = append(s, "TopUpReward: "+fmt.Sprintf("%#v", this.TopUpReward)+",\n")
+	s = append(s, "LeaderFees: "+fmt.Sprintf("%#v", this.LeaderFees)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRewardsBreakdown(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *RewardsBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.NumberOfBlocks != 0 {
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(m.NumberOfBlocks))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalTopUpEligible)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalTopUpEligible, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalStakeEligible)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalStakeEligible, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.TopUpRewards)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TopUpRewards, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.BaseRewards)
+		i -= size
+		if _, err := __caster.MarshalTo(m.BaseRewards, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.RewardsForBlocks)
+		i -= size
+		if _, err := __caster.MarshalTo(m.RewardsForBlocks, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.LeaderFees)
+		i -= size
+		if _, err := __caster.MarshalTo(m.LeaderFees, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.DeveloperFees)
+		i -= size
+		if _, err := __caster.MarshalTo(m.DeveloperFees, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.NodePrice)
+		i -= size
+		if _, err := __caster.MarshalTo(m.NodePrice, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.RewardsForProtocolSustainability)
+		i -= size
+		if _, err := __caster.MarshalTo(m.RewardsForProtocolSustainability, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.RewardsPerBlock)
+		i -= size
+		if _, err := __caster.MarshalTo(m.RewardsPerBlock, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalNewlyMinted)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalNewlyMinted, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalToDistribute)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalToDistribute, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalSupply)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalSupply, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerRewardsBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerRewardsBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerRewardsBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalTopUp)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalTopUp, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalStaked)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalStaked, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.NumStakedNodes != 0 {
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(m.NumStakedNodes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeRewardsBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeRewardsBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeRewardsBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.LeaderFees)
+		i -= size
+		if _, err := __caster.MarshalTo(m.LeaderFees, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.TopUpReward)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TopUpReward, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.BaseReward)
+		i -= size
+		if _, err := __caster.MarshalTo(m.BaseReward, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.TopUpStake)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TopUpStake, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Offline {
+		i--
+		if m.Offline {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumSelectedInSuccessBlocks != 0 {
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(m.NumSelectedInSuccessBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Rating != 0 {
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(m.Rating))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlsKey) > 0 {
+		i -= len(m.BlsKey)
+		copy(dAtA[i:], m.BlsKey)
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(len(m.BlsKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewardsBreakdown(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewardsBreakdown(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RewardsBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovRewardsBreakdown(uint64(m.Epoch))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalSupply)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalToDistribute)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalNewlyMinted)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.RewardsPerBlock)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.RewardsForProtocolSustainability)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.NodePrice)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.DeveloperFees)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.LeaderFees)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.RewardsForBlocks)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.BaseRewards)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.TopUpRewards)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalStakeEligible)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalTopUpEligible)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	if m.NumberOfBlocks != 0 {
+		n += 1 + sovRewardsBreakdown(uint64(m.NumberOfBlocks))
+	}
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 2 + l + sovRewardsBreakdown(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OwnerRewardsBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	if m.NumStakedNodes != 0 {
+		n += 1 + sovRewardsBreakdown(uint64(m.NumStakedNodes))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalStaked)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalTopUp)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovRewardsBreakdown(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeRewardsBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRewardsBreakdown(uint64(m.ShardId))
+	}
+	if m.Rating != 0 {
+		n += 1 + sovRewardsBreakdown(uint64(m.Rating))
+	}
+	if m.NumSelectedInSuccessBlocks != 0 {
+		n += 1 + sovRewardsBreakdown(uint64(m.NumSelectedInSuccessBlocks))
+	}
+	if m.Offline {
+		n += 2
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.TopUpStake)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.BaseReward)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.TopUpReward)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.LeaderFees)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	return n
+}
+
+func sovRewardsBreakdown(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewardsBreakdown(x uint64) (n int) {
+	return sovRewardsBreakdown(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *RewardsBreakdown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOwners := "[]*OwnerRewardsBreakdown{"
+	for _, f := range this.Owners {
+		repeatedStringForOwners += strings.Replace(f.String(), "OwnerRewardsBreakdown", "OwnerRewardsBreakdown", 1) + ","
+	}
+	repeatedStringForOwners += "}"
+	s := strings.Join([]string{`&RewardsBreakdown{`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`TotalSupply:` + fmt.Sprintf("%v", this.TotalSupply) + `,`,
+		`TotalToDistribute:` + fmt.Sprintf("%v", this.TotalToDistribute) + `,`,
+		`TotalNewlyMinted:` + fmt.Sprintf("%v", this.TotalNewlyMinted) + `,`,
+		`RewardsPerBlock:` + fmt.Sprintf("%v", this.RewardsPerBlock) + `,`,
+		`RewardsForProtocolSustainability:` + fmt.Sprintf("%v", this.RewardsForProtocolSustainability) + `,`,
+		`NodePrice:` + fmt.Sprintf("%v", this.NodePrice) + `,`,
+		`DeveloperFees:` + fmt.Sprintf("%v", this.DeveloperFees) + `,`,
+		`LeaderFees:` + fmt.Sprintf("%v", this.LeaderFees) + `,`,
+		`RewardsForBlocks:` + fmt.Sprintf("%v", this.RewardsForBlocks) + `,`,
+		`BaseRewards:` + fmt.Sprintf("%v", this.BaseRewards) + `,`,
+		`TopUpRewards:` + fmt.Sprintf("%v", this.TopUpRewards) + `,`,
+		`TotalStakeEligible:` + fmt.Sprintf("%v", this.TotalStakeEligible) + `,`,
+		`TotalTopUpEligible:` + fmt.Sprintf("%v", this.TotalTopUpEligible) + `,`,
+		`NumberOfBlocks:` + fmt.Sprintf("%v", this.NumberOfBlocks) + `,`,
+		`Owners:` + repeatedStringForOwners + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OwnerRewardsBreakdown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNodes := "[]*NodeRewardsBreakdown{"
+	for _, f := range this.Nodes {
+		repeatedStringForNodes += strings.Replace(f.String(), "NodeRewardsBreakdown", "NodeRewardsBreakdown", 1) + ","
+	}
+	repeatedStringForNodes += "}"
+	s := strings.Join([]string{`&OwnerRewardsBreakdown{`,
+		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
+		`NumStakedNodes:` + fmt.Sprintf("%v", this.NumStakedNodes) + `,`,
+		`TotalStaked:` + fmt.Sprintf("%v", this.TotalStaked) + `,`,
+		`TotalTopUp:` + fmt.Sprintf("%v", this.TotalTopUp) + `,`,
+		`Nodes:` + repeatedStringForNodes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeRewardsBreakdown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodeRewardsBreakdown{`,
+		`BlsKey:` + fmt.Sprintf("%v", this.BlsKey) + `,`,
+		`RewardAddress:` + fmt.Sprintf("%v", this.RewardAddress) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Rating:` + fmt.Sprintf("%v", this.Rating) + `,`,
+		`NumSelectedInSuccessBlocks:` + fmt.Sprintf("%v", this.NumSelectedInSuccessBlocks) + `,`,
+		`Offline:` + fmt.Sprintf("%v", this.Offline) + `,`,
+		`TopUpStake:` + fmt.Sprintf("%v", this.TopUpStake) + `,`,
+		`BaseReward:` + fmt.Sprintf("%v", this.BaseReward) + `,`,
+		`TopUpReward:` + fmt.Sprintf("%v", this.TopUpReward) + `,`,
+		`LeaderFees:` + fmt.Sprintf("%v", this.LeaderFees) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRewardsBreakdown(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *RewardsBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardsBreakdown
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalSupply = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalToDistribute", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalToDistribute = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalNewlyMinted", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalNewlyMinted = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerBlock", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.RewardsPerBlock = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsForProtocolSustainability", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.RewardsForProtocolSustainability = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodePrice", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.NodePrice = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFees", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.DeveloperFees = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderFees", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.LeaderFees = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsForBlocks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.RewardsForBlocks = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRewards", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.BaseRewards = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpRewards", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TopUpRewards = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStakeEligible", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalStakeEligible = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTopUpEligible", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalTopUpEligible = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfBlocks", wireType)
+			}
+			m.NumberOfBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, &OwnerRewardsBreakdown{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardsBreakdown(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnerRewardsBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardsBreakdown
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnerRewardsBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnerRewardsBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumStakedNodes", wireType)
+			}
+			m.NumStakedNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumStakedNodes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalStaked = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTopUp", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalTopUp = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &NodeRewardsBreakdown{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardsBreakdown(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeRewardsBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardsBreakdown
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeRewardsBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeRewardsBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsKey = append(m.BlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsKey == nil {
+				m.BlsKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = append(m.RewardAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.RewardAddress == nil {
+				m.RewardAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			m.Rating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rating |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSelectedInSuccessBlocks", wireType)
+			}
+			m.NumSelectedInSuccessBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSelectedInSuccessBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offline", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Offline = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpStake", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TopUpStake = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseReward", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.BaseReward = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpReward", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TopUpReward = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderFees", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.LeaderFees = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardsBreakdown(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewardsBreakdown(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewardsBreakdown
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewardsBreakdown
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewardsBreakdown
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewardsBreakdown
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewardsBreakdown        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewardsBreakdown          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewardsBreakdown = fmt.Errorf("proto: unexpected end of group")
+)
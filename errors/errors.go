@@ -127,6 +127,9 @@ var ErrNilBootstrapParamsHandler = errors.New("nil bootstrap parameters handler"
 // ErrNilBroadcastMessenger is raised when a valid broadcast messenger is expected but nil used
 var ErrNilBroadcastMessenger = errors.New("broadcast messenger is nil")
 
+// ErrNilSlashingProtectionHandler signals that a nil slashing protection handler has been provided
+var ErrNilSlashingProtectionHandler = errors.New("nil slashing protection handler")
+
 // ErrNilChronologyHandler is raised when a valid chronology handler is expected but nil used
 var ErrNilChronologyHandler = errors.New("chronology handler is nil")
 
@@ -265,6 +268,9 @@ var ErrNilPeerBlackListHandler = errors.New("nil peer black list handler")
 // ErrNilPeerHonestyHandler signals that a nil peer honesty handler was provided
 var ErrNilPeerHonestyHandler = errors.New("nil peer honesty handler")
 
+// ErrNilAntifloodDashboard signals that a nil antiflood dashboard was provided
+var ErrNilAntifloodDashboard = errors.New("nil antiflood dashboard")
+
 // ErrNilPeerShardMapper signals that a nil peer shard mapper was provided
 var ErrNilPeerShardMapper = errors.New("nil peer shard mapper")
 
@@ -598,3 +604,12 @@ var ErrNilSentSignatureTracker = errors.New("nil sent signature tracker")
 
 // ErrNilEpochSystemSCProcessor defines the error for setting a nil EpochSystemSCProcessor
 var ErrNilEpochSystemSCProcessor = errors.New("nil epoch system SC processor")
+
+// ErrInvalidPeerReputationConfig signals that an invalid peer reputation configuration has been provided
+var ErrInvalidPeerReputationConfig = errors.New("invalid peer reputation configuration")
+
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler has been provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
+// ErrNoRemoteSignerKeys signals that the remote signer does not hold any key
+var ErrNoRemoteSignerKeys = errors.New("the remote signer does not hold any key")
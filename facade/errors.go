@@ -44,5 +44,11 @@ var ErrEmptyGasConfigs = errors.New("empty gas configs")
 // ErrTooManyAddressesInBulk signals that there are too many addresses present in a bulk request
 var ErrTooManyAddressesInBulk = errors.New("too many addresses in the bulk request")
 
+// ErrTooManySCQueries signals that there are too many SC queries present in a batch request
+var ErrTooManySCQueries = errors.New("too many SC queries in the batch request")
+
+// ErrStateChangedWhileExecutingSCQueries signals that the state changed while executing a batch of SC queries
+var ErrStateChangedWhileExecutingSCQueries = errors.New("state changed while executing the batch of SC queries")
+
 // ErrNilStatusMetrics signals that a nil status metrics was provided
 var ErrNilStatusMetrics = errors.New("nil status metrics handler")
@@ -3,6 +3,7 @@ package initial
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -161,6 +162,16 @@ func (inf *initialNodeFacade) AuctionListApi() ([]*common.AuctionListValidatorAP
 	return nil, errNodeStarting
 }
 
+// AuctionSimulationApi returns nil and error
+func (inf *initialNodeFacade) AuctionSimulationApi(_ *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// ValidatorHistoryApi returns nil and error
+func (inf *initialNodeFacade) ValidatorHistoryApi(_ string, _ core.OptionalUint32, _ core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // SendBulkTransactions returns 0 and error
 func (inf *initialNodeFacade) SendBulkTransactions(_ []*transaction.Transaction) (uint64, error) {
 	return uint64(0), errNodeStarting
@@ -221,6 +232,11 @@ func (inf *initialNodeFacade) ExecuteSCQuery(_ *process.SCQuery) (*vm.VMOutputAp
 	return nil, api.BlockInfo{}, errNodeStarting
 }
 
+// ExecuteSCQueries returns nil and error
+func (inf *initialNodeFacade) ExecuteSCQueries(_ []*process.SCQuery) ([]*common.SCQueryResultAPIResponse, api.BlockInfo, error) {
+	return nil, api.BlockInfo{}, errNodeStarting
+}
+
 // PprofEnabled returns false
 func (inf *initialNodeFacade) PprofEnabled() bool {
 	return inf.pprofEnabled
@@ -261,6 +277,76 @@ func (inf *initialNodeFacade) GetConnectedPeersRatingsOnMainNetwork() (string, e
 	return "", errNodeStarting
 }
 
+// GetAntifloodQuotas returns nil
+func (inf *initialNodeFacade) GetAntifloodQuotas() []*common.AntifloodQuotaStatus {
+	return nil
+}
+
+// GetAntifloodTopics returns nil
+func (inf *initialNodeFacade) GetAntifloodTopics() []*common.AntifloodTopicStatus {
+	return nil
+}
+
+// GetAntifloodBlacklistedPeers returns nil
+func (inf *initialNodeFacade) GetAntifloodBlacklistedPeers() []*common.BlacklistedPeer {
+	return nil
+}
+
+// SetAntifloodQuota returns error
+func (inf *initialNodeFacade) SetAntifloodQuota(_ string, _ uint32, _ uint64) error {
+	return errNodeStarting
+}
+
+// SetAntifloodTopicMaxMessages returns error
+func (inf *initialNodeFacade) SetAntifloodTopicMaxMessages(_ string, _ uint32) error {
+	return errNodeStarting
+}
+
+// AddAntifloodBlacklistedPeer returns error
+func (inf *initialNodeFacade) AddAntifloodBlacklistedPeer(_ string, _ time.Duration) error {
+	return errNodeStarting
+}
+
+// RemoveAntifloodBlacklistedPeer returns error
+func (inf *initialNodeFacade) RemoveAntifloodBlacklistedPeer(_ string) error {
+	return errNodeStarting
+}
+
+// ExportPeerReputation returns nil
+func (inf *initialNodeFacade) ExportPeerReputation() *common.PeerReputationSnapshot {
+	return nil
+}
+
+// ImportPeerReputation returns error
+func (inf *initialNodeFacade) ImportPeerReputation(_ *common.PeerReputationSnapshot) error {
+	return errNodeStarting
+}
+
+// AddManagedKey returns empty string and error
+func (inf *initialNodeFacade) AddManagedKey(_ string) (string, error) {
+	return "", errNodeStarting
+}
+
+// RemoveManagedKey returns error
+func (inf *initialNodeFacade) RemoveManagedKey(_ string) error {
+	return errNodeStarting
+}
+
+// ExportSlashingProtection returns nil and error
+func (inf *initialNodeFacade) ExportSlashingProtection() (*common.SlashingProtectionSnapshot, error) {
+	return nil, errNodeStarting
+}
+
+// ImportSlashingProtection returns error
+func (inf *initialNodeFacade) ImportSlashingProtection(_ *common.SlashingProtectionSnapshot) error {
+	return errNodeStarting
+}
+
+// IsAdminRequestAuthorized returns false
+func (inf *initialNodeFacade) IsAdminRequestAuthorized(_ string, _ string) bool {
+	return false
+}
+
 // GetEpochStartDataAPI returns nil and error
 func (inf *initialNodeFacade) GetEpochStartDataAPI(_ uint32) (*common.EpochStartDataAPI, error) {
 	return nil, errNodeStarting
@@ -451,6 +537,111 @@ func (inf *initialNodeFacade) GetWaitingEpochsLeftForPublicKey(_ string) (uint32
 	return 0, errNodeStarting
 }
 
+// StartOutportReplay returns error
+func (inf *initialNodeFacade) StartOutportReplay(_ uint64, _ uint64, _ string) error {
+	return errNodeStarting
+}
+
+// GetOutportReplayStatus returns an empty status
+func (inf *initialNodeFacade) GetOutportReplayStatus() common.OutportReplayStatus {
+	return common.OutportReplayStatus{}
+}
+
+// GetGovernanceConfig returns nil and error
+func (inf *initialNodeFacade) GetGovernanceConfig() (*common.GovernanceConfigAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceProposals returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceProposal returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposal(_ uint64) (*common.GovernanceProposalAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceVotes returns nil and error
+func (inf *initialNodeFacade) GetGovernanceVotes(_ string) (*common.GovernanceVotesAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceVotingPower returns nil and error
+func (inf *initialNodeFacade) GetGovernanceVotingPower(_ string) (*big.Int, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceDelegatedVoteInfo returns nil and error
+func (inf *initialNodeFacade) GetGovernanceDelegatedVoteInfo(_ string, _ uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetDelegationContractConfig returns nil and error
+func (inf *initialNodeFacade) GetDelegationContractConfig(_ string) (*common.DelegationContractConfigAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetDelegationContractNodeStates returns nil and error
+func (inf *initialNodeFacade) GetDelegationContractNodeStates(_ string) (*common.DelegationNodeStatesAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetDelegationContractDelegator returns nil and error
+func (inf *initialNodeFacade) GetDelegationContractDelegator(_ string, _ string) (*common.DelegatorAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetDelegationContractDelegators returns nil and error
+func (inf *initialNodeFacade) GetDelegationContractDelegators(_ string, _ uint32, _ uint32) (*common.DelegatorsListAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetRewardsBreakdown returns nil and error
+func (inf *initialNodeFacade) GetRewardsBreakdown(_ uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetOwnerRewardsBreakdown returns nil and error
+func (inf *initialNodeFacade) GetOwnerRewardsBreakdown(_ uint32, _ string) (*common.OwnerRewardsBreakdownAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetOwnerStakingInfo returns nil and error
+func (inf *initialNodeFacade) GetOwnerStakingInfo(_ string) (*common.OwnerStakingInfoAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// RegisterContractABI returns error
+func (inf *initialNodeFacade) RegisterContractABI(_ string, _ []byte) error {
+	return errNodeStarting
+}
+
+// GetContractsWithABI returns nil and error
+func (inf *initialNodeFacade) GetContractsWithABI() ([]string, error) {
+	return nil, errNodeStarting
+}
+
+// DecodeSCQueryReturnData returns nil and error
+func (inf *initialNodeFacade) DecodeSCQueryReturnData(_ string, _ string, _ [][]byte) ([]*common.ABIDecodedValue, error) {
+	return nil, errNodeStarting
+}
+
+// DecodeTransaction returns nil and error
+func (inf *initialNodeFacade) DecodeTransaction(_ *transaction.ApiTransactionResult) (*common.ABIDecodedTransactionAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// VerifyContract returns nil and error
+func (inf *initialNodeFacade) VerifyContract(_ string, _ *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetVerifiedContract returns nil and error
+func (inf *initialNodeFacade) GetVerifiedContract(_ string, _ []byte) (*common.VerifiedContractAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // P2PPrometheusMetricsEnabled returns either the p2p prometheus metrics are enabled or not
 func (inf *initialNodeFacade) P2PPrometheusMetricsEnabled() bool {
 	return inf.p2pPrometheusMetricsEnabled
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -99,6 +101,10 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, v2)
 	assert.Equal(t, errNodeStarting, err)
 
+	v3, err := inf.ValidatorHistoryApi("", core.OptionalUint32{}, core.OptionalUint32{})
+	assert.Nil(t, v3)
+	assert.Equal(t, errNodeStarting, err)
+
 	u1, err := inf.SendBulkTransactions(nil)
 	assert.Equal(t, uint64(0), u1)
 	assert.Equal(t, errNodeStarting, err)
@@ -130,6 +136,10 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, vo)
 	assert.Equal(t, errNodeStarting, err)
 
+	queriesResults, _, err := inf.ExecuteSCQueries(nil)
+	assert.Nil(t, queriesResults)
+	assert.Equal(t, errNodeStarting, err)
+
 	b = inf.PprofEnabled()
 	assert.True(t, b)
 
@@ -276,6 +286,103 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Equal(t, "", ratings)
 	assert.Equal(t, errNodeStarting, err)
 
+	assert.Nil(t, inf.GetAntifloodQuotas())
+	assert.Nil(t, inf.GetAntifloodTopics())
+	assert.Nil(t, inf.GetAntifloodBlacklistedPeers())
+	assert.Equal(t, errNodeStarting, inf.SetAntifloodQuota("", 0, 0))
+	assert.Equal(t, errNodeStarting, inf.SetAntifloodTopicMaxMessages("", 0))
+	assert.Equal(t, errNodeStarting, inf.AddAntifloodBlacklistedPeer("", 0))
+	assert.Equal(t, errNodeStarting, inf.RemoveAntifloodBlacklistedPeer(""))
+	assert.Nil(t, inf.ExportPeerReputation())
+	assert.Equal(t, errNodeStarting, inf.ImportPeerReputation(nil))
+
+	managedPublicKey, err := inf.AddManagedKey("")
+	assert.Empty(t, managedPublicKey)
+	assert.Equal(t, errNodeStarting, err)
+	assert.Equal(t, errNodeStarting, inf.RemoveManagedKey(""))
+
+	slashingProtectionSnapshot, err := inf.ExportSlashingProtection()
+	assert.Nil(t, slashingProtectionSnapshot)
+	assert.Equal(t, errNodeStarting, err)
+	assert.Equal(t, errNodeStarting, inf.ImportSlashingProtection(nil))
+	assert.Equal(t, errNodeStarting, inf.StartOutportReplay(0, 0, ""))
+	assert.Equal(t, common.OutportReplayStatus{}, inf.GetOutportReplayStatus())
+
+	governanceConfig, err := inf.GetGovernanceConfig()
+	assert.Nil(t, governanceConfig)
+	assert.Equal(t, errNodeStarting, err)
+
+	governanceProposals, err := inf.GetGovernanceProposals()
+	assert.Nil(t, governanceProposals)
+	assert.Equal(t, errNodeStarting, err)
+
+	governanceProposal, err := inf.GetGovernanceProposal(0)
+	assert.Nil(t, governanceProposal)
+	assert.Equal(t, errNodeStarting, err)
+
+	governanceVotes, err := inf.GetGovernanceVotes("")
+	assert.Nil(t, governanceVotes)
+	assert.Equal(t, errNodeStarting, err)
+
+	governanceVotingPower, err := inf.GetGovernanceVotingPower("")
+	assert.Nil(t, governanceVotingPower)
+	assert.Equal(t, errNodeStarting, err)
+
+	governanceDelegatedVoteInfo, err := inf.GetGovernanceDelegatedVoteInfo("", 0)
+	assert.Nil(t, governanceDelegatedVoteInfo)
+	assert.Equal(t, errNodeStarting, err)
+
+	delegationContractConfig, err := inf.GetDelegationContractConfig("")
+	assert.Nil(t, delegationContractConfig)
+	assert.Equal(t, errNodeStarting, err)
+
+	delegationNodeStates, err := inf.GetDelegationContractNodeStates("")
+	assert.Nil(t, delegationNodeStates)
+	assert.Equal(t, errNodeStarting, err)
+
+	delegator, err := inf.GetDelegationContractDelegator("", "")
+	assert.Nil(t, delegator)
+	assert.Equal(t, errNodeStarting, err)
+
+	delegators, err := inf.GetDelegationContractDelegators("", 0, 0)
+	assert.Nil(t, delegators)
+	assert.Equal(t, errNodeStarting, err)
+
+	rewardsBreakdown, err := inf.GetRewardsBreakdown(0)
+	assert.Nil(t, rewardsBreakdown)
+	assert.Equal(t, errNodeStarting, err)
+
+	ownerRewardsBreakdown, err := inf.GetOwnerRewardsBreakdown(0, "")
+	assert.Nil(t, ownerRewardsBreakdown)
+	assert.Equal(t, errNodeStarting, err)
+
+	ownerStakingInfo, err := inf.GetOwnerStakingInfo("")
+	assert.Nil(t, ownerStakingInfo)
+	assert.Equal(t, errNodeStarting, err)
+
+	assert.Equal(t, errNodeStarting, inf.RegisterContractABI("", nil))
+
+	contractsWithABI, err := inf.GetContractsWithABI()
+	assert.Nil(t, contractsWithABI)
+	assert.Equal(t, errNodeStarting, err)
+
+	decodedValues, err := inf.DecodeSCQueryReturnData("", "", nil)
+	assert.Nil(t, decodedValues)
+	assert.Equal(t, errNodeStarting, err)
+
+	decodedTx, err := inf.DecodeTransaction(nil)
+	assert.Nil(t, decodedTx)
+	assert.Equal(t, errNodeStarting, err)
+
+	verifiedContract, err := inf.VerifyContract("", nil)
+	assert.Nil(t, verifiedContract)
+	assert.Equal(t, errNodeStarting, err)
+
+	verifiedContract, err = inf.GetVerifiedContract("", nil)
+	assert.Nil(t, verifiedContract)
+	assert.Equal(t, errNodeStarting, err)
+	assert.False(t, inf.IsAdminRequestAuthorized("", ""))
+
 	epochStartData, err := inf.GetEpochStartDataAPI(0)
 	assert.Nil(t, epochStartData)
 	assert.Equal(t, errNodeStarting, err)
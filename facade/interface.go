@@ -3,6 +3,7 @@ package facade
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	coreData "github.com/multiversx/mx-chain-core-go/data"
@@ -93,6 +94,8 @@ type NodeHandler interface {
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
+	AuctionSimulationApi(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error)
+	ValidatorHistoryApi(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error)
 	DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTrigger() bool
 
@@ -102,6 +105,19 @@ type NodeHandler interface {
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetwork() (string, error)
+	GetAntifloodQuotas() []*common.AntifloodQuotaStatus
+	GetAntifloodTopics() []*common.AntifloodTopicStatus
+	GetAntifloodBlacklistedPeers() []*common.BlacklistedPeer
+	SetAntifloodQuota(name string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetAntifloodTopicMaxMessages(topic string, maxMessagesPerPeer uint32) error
+	AddAntifloodBlacklistedPeer(pid string, duration time.Duration) error
+	RemoveAntifloodBlacklistedPeer(pid string) error
+	ExportPeerReputation() *common.PeerReputationSnapshot
+	ImportPeerReputation(snapshot *common.PeerReputationSnapshot) error
+	AddManagedKey(privateKeyHex string) (string, error)
+	RemoveManagedKey(publicKey string) error
+	ExportSlashingProtection() (*common.SlashingProtectionSnapshot, error)
+	ImportSlashingProtection(snapshot *common.SlashingProtectionSnapshot) error
 
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
 
@@ -153,6 +169,27 @@ type ApiResolver interface {
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
+	StartOutportReplay(startNonce uint64, endNonce uint64, target string) error
+	GetOutportReplayStatus() common.OutportReplayStatus
+	GetGovernanceConfig() (*common.GovernanceConfigAPIResponse, error)
+	GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error)
+	GetGovernanceVotingPower(address string) (*big.Int, error)
+	GetGovernanceDelegatedVoteInfo(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error)
+	GetDelegationContractConfig(contract string) (*common.DelegationContractConfigAPIResponse, error)
+	GetDelegationContractNodeStates(contract string) (*common.DelegationNodeStatesAPIResponse, error)
+	GetDelegationContractDelegator(contract string, delegator string) (*common.DelegatorAPIResponse, error)
+	GetDelegationContractDelegators(ctx context.Context, contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error)
+	GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetOwnerRewardsBreakdown(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error)
+	GetOwnerStakingInfo(ctx context.Context, owner string) (*common.OwnerStakingInfoAPIResponse, error)
+	RegisterContractABI(address string, abiJSON []byte) error
+	GetContractsWithABI() []string
+	DecodeSCQueryReturnData(scAddress string, funcName string, returnData [][]byte) ([]*common.ABIDecodedValue, error)
+	DecodeTransaction(tx *transaction.ApiTransactionResult) *common.ABIDecodedTransactionAPIResponse
+	VerifyContract(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error)
+	GetVerifiedContract(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error)
 	Close() error
 	IsInterfaceNil() bool
 }
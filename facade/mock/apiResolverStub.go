@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/api"
@@ -50,6 +51,27 @@ type ApiResolverStub struct {
 	GetEligibleManagedKeysCalled                func() ([]string, error)
 	GetWaitingManagedKeysCalled                 func() ([]string, error)
 	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
+	StartOutportReplayCalled                    func(startNonce uint64, endNonce uint64, target string) error
+	GetOutportReplayStatusCalled                func() common.OutportReplayStatus
+	GetGovernanceConfigCalled                   func() (*common.GovernanceConfigAPIResponse, error)
+	GetGovernanceProposalsCalled                func() ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposalCalled                 func(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVotesCalled                    func(address string) (*common.GovernanceVotesAPIResponse, error)
+	GetGovernanceVotingPowerCalled              func(address string) (*big.Int, error)
+	GetGovernanceDelegatedVoteInfoCalled        func(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error)
+	GetDelegationContractConfigCalled           func(contract string) (*common.DelegationContractConfigAPIResponse, error)
+	GetDelegationContractNodeStatesCalled       func(contract string) (*common.DelegationNodeStatesAPIResponse, error)
+	GetDelegationContractDelegatorCalled        func(contract string, delegator string) (*common.DelegatorAPIResponse, error)
+	GetDelegationContractDelegatorsCalled       func(ctx context.Context, contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error)
+	GetRewardsBreakdownCalled                   func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetOwnerRewardsBreakdownCalled              func(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error)
+	GetOwnerStakingInfoCalled                   func(ctx context.Context, owner string) (*common.OwnerStakingInfoAPIResponse, error)
+	RegisterContractABICalled                   func(address string, abiJSON []byte) error
+	GetContractsWithABICalled                   func() []string
+	DecodeSCQueryReturnDataCalled               func(scAddress string, funcName string, returnData [][]byte) ([]*common.ABIDecodedValue, error)
+	DecodeTransactionCalled                     func(tx *transaction.ApiTransactionResult) *common.ABIDecodedTransactionAPIResponse
+	VerifyContractCalled                        func(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error)
+	GetVerifiedContractCalled                   func(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error)
 }
 
 // GetTransaction -
@@ -342,6 +364,174 @@ func (ars *ApiResolverStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (
 	return 0, nil
 }
 
+// StartOutportReplay -
+func (ars *ApiResolverStub) StartOutportReplay(startNonce uint64, endNonce uint64, target string) error {
+	if ars.StartOutportReplayCalled != nil {
+		return ars.StartOutportReplayCalled(startNonce, endNonce, target)
+	}
+	return nil
+}
+
+// GetOutportReplayStatus -
+func (ars *ApiResolverStub) GetOutportReplayStatus() common.OutportReplayStatus {
+	if ars.GetOutportReplayStatusCalled != nil {
+		return ars.GetOutportReplayStatusCalled()
+	}
+	return common.OutportReplayStatus{}
+}
+
+// GetGovernanceConfig -
+func (ars *ApiResolverStub) GetGovernanceConfig() (*common.GovernanceConfigAPIResponse, error) {
+	if ars.GetGovernanceConfigCalled != nil {
+		return ars.GetGovernanceConfigCalled()
+	}
+	return nil, nil
+}
+
+// GetGovernanceProposals -
+func (ars *ApiResolverStub) GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error) {
+	if ars.GetGovernanceProposalsCalled != nil {
+		return ars.GetGovernanceProposalsCalled()
+	}
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (ars *ApiResolverStub) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	if ars.GetGovernanceProposalCalled != nil {
+		return ars.GetGovernanceProposalCalled(nonce)
+	}
+	return nil, nil
+}
+
+// GetGovernanceVotes -
+func (ars *ApiResolverStub) GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error) {
+	if ars.GetGovernanceVotesCalled != nil {
+		return ars.GetGovernanceVotesCalled(address)
+	}
+	return nil, nil
+}
+
+// GetGovernanceVotingPower -
+func (ars *ApiResolverStub) GetGovernanceVotingPower(address string) (*big.Int, error) {
+	if ars.GetGovernanceVotingPowerCalled != nil {
+		return ars.GetGovernanceVotingPowerCalled(address)
+	}
+	return nil, nil
+}
+
+// GetGovernanceDelegatedVoteInfo -
+func (ars *ApiResolverStub) GetGovernanceDelegatedVoteInfo(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error) {
+	if ars.GetGovernanceDelegatedVoteInfoCalled != nil {
+		return ars.GetGovernanceDelegatedVoteInfoCalled(contract, nonce)
+	}
+	return nil, nil
+}
+
+// GetDelegationContractConfig -
+func (ars *ApiResolverStub) GetDelegationContractConfig(contract string) (*common.DelegationContractConfigAPIResponse, error) {
+	if ars.GetDelegationContractConfigCalled != nil {
+		return ars.GetDelegationContractConfigCalled(contract)
+	}
+	return nil, nil
+}
+
+// GetDelegationContractNodeStates -
+func (ars *ApiResolverStub) GetDelegationContractNodeStates(contract string) (*common.DelegationNodeStatesAPIResponse, error) {
+	if ars.GetDelegationContractNodeStatesCalled != nil {
+		return ars.GetDelegationContractNodeStatesCalled(contract)
+	}
+	return nil, nil
+}
+
+// GetDelegationContractDelegator -
+func (ars *ApiResolverStub) GetDelegationContractDelegator(contract string, delegator string) (*common.DelegatorAPIResponse, error) {
+	if ars.GetDelegationContractDelegatorCalled != nil {
+		return ars.GetDelegationContractDelegatorCalled(contract, delegator)
+	}
+	return nil, nil
+}
+
+// GetDelegationContractDelegators -
+func (ars *ApiResolverStub) GetDelegationContractDelegators(ctx context.Context, contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error) {
+	if ars.GetDelegationContractDelegatorsCalled != nil {
+		return ars.GetDelegationContractDelegatorsCalled(ctx, contract, page, pageSize)
+	}
+	return nil, nil
+}
+
+// GetRewardsBreakdown -
+func (ars *ApiResolverStub) GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	if ars.GetRewardsBreakdownCalled != nil {
+		return ars.GetRewardsBreakdownCalled(epoch)
+	}
+	return nil, nil
+}
+
+// GetOwnerRewardsBreakdown -
+func (ars *ApiResolverStub) GetOwnerRewardsBreakdown(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error) {
+	if ars.GetOwnerRewardsBreakdownCalled != nil {
+		return ars.GetOwnerRewardsBreakdownCalled(epoch, owner)
+	}
+	return nil, nil
+}
+
+// GetOwnerStakingInfo -
+func (ars *ApiResolverStub) GetOwnerStakingInfo(ctx context.Context, owner string) (*common.OwnerStakingInfoAPIResponse, error) {
+	if ars.GetOwnerStakingInfoCalled != nil {
+		return ars.GetOwnerStakingInfoCalled(ctx, owner)
+	}
+	return nil, nil
+}
+
+// RegisterContractABI -
+func (ars *ApiResolverStub) RegisterContractABI(address string, abiJSON []byte) error {
+	if ars.RegisterContractABICalled != nil {
+		return ars.RegisterContractABICalled(address, abiJSON)
+	}
+	return nil
+}
+
+// GetContractsWithABI -
+func (ars *ApiResolverStub) GetContractsWithABI() []string {
+	if ars.GetContractsWithABICalled != nil {
+		return ars.GetContractsWithABICalled()
+	}
+	return nil
+}
+
+// DecodeSCQueryReturnData -
+func (ars *ApiResolverStub) DecodeSCQueryReturnData(scAddress string, funcName string, returnData [][]byte) ([]*common.ABIDecodedValue, error) {
+	if ars.DecodeSCQueryReturnDataCalled != nil {
+		return ars.DecodeSCQueryReturnDataCalled(scAddress, funcName, returnData)
+	}
+	return nil, nil
+}
+
+// DecodeTransaction -
+func (ars *ApiResolverStub) DecodeTransaction(tx *transaction.ApiTransactionResult) *common.ABIDecodedTransactionAPIResponse {
+	if ars.DecodeTransactionCalled != nil {
+		return ars.DecodeTransactionCalled(tx)
+	}
+	return nil
+}
+
+// VerifyContract -
+func (ars *ApiResolverStub) VerifyContract(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error) {
+	if ars.VerifyContractCalled != nil {
+		return ars.VerifyContractCalled(address, artifact)
+	}
+	return nil, nil
+}
+
+// GetVerifiedContract -
+func (ars *ApiResolverStub) GetVerifiedContract(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error) {
+	if ars.GetVerifiedContractCalled != nil {
+		return ars.GetVerifiedContractCalled(address, codeHash)
+	}
+	return nil, nil
+}
+
 // Close -
 func (ars *ApiResolverStub) Close() error {
 	return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
@@ -40,6 +41,19 @@ type NodeStub struct {
 	GetGuardianDataCalled                          func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetworkCalled    func() (string, error)
+	GetAntifloodQuotasCalled                       func() []*common.AntifloodQuotaStatus
+	GetAntifloodTopicsCalled                       func() []*common.AntifloodTopicStatus
+	GetAntifloodBlacklistedPeersCalled             func() []*common.BlacklistedPeer
+	SetAntifloodQuotaCalled                        func(name string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetAntifloodTopicMaxMessagesCalled             func(topic string, maxMessagesPerPeer uint32) error
+	AddAntifloodBlacklistedPeerCalled              func(pid string, duration time.Duration) error
+	RemoveAntifloodBlacklistedPeerCalled           func(pid string) error
+	ExportPeerReputationCalled                     func() *common.PeerReputationSnapshot
+	ImportPeerReputationCalled                     func(snapshot *common.PeerReputationSnapshot) error
+	AddManagedKeyCalled                            func(privateKeyHex string) (string, error)
+	RemoveManagedKeyCalled                         func(publicKey string) error
+	ExportSlashingProtectionCalled                 func() (*common.SlashingProtectionSnapshot, error)
+	ImportSlashingProtectionCalled                 func(snapshot *common.SlashingProtectionSnapshot) error
 	GetEpochStartDataAPICalled                     func(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetUsernameCalled                              func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetCodeHashCalled                              func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
@@ -56,6 +70,8 @@ type NodeStub struct {
 	GetTokenSupplyCalled                           func(token string) (*api.ESDTSupply, error)
 	IsDataTrieMigratedCalled                       func(address string, options api.AccountQueryOptions) (bool, error)
 	AuctionListApiCalled                           func() ([]*common.AuctionListValidatorAPIResponse, error)
+	AuctionSimulationApiCalled                     func(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error)
+	ValidatorHistoryApiCalled                      func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error)
 }
 
 // GetProof -
@@ -235,6 +251,24 @@ func (ns *NodeStub) AuctionListApi() ([]*common.AuctionListValidatorAPIResponse,
 	return nil, nil
 }
 
+// AuctionSimulationApi -
+func (ns *NodeStub) AuctionSimulationApi(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error) {
+	if ns.AuctionSimulationApiCalled != nil {
+		return ns.AuctionSimulationApiCalled(request)
+	}
+
+	return nil, nil
+}
+
+// ValidatorHistoryApi -
+func (ns *NodeStub) ValidatorHistoryApi(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error) {
+	if ns.ValidatorHistoryApiCalled != nil {
+		return ns.ValidatorHistoryApiCalled(blsKey, fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
 // DirectTrigger -
 func (ns *NodeStub) DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error {
 	if ns.DirectTriggerCalled != nil {
@@ -280,6 +314,123 @@ func (ns *NodeStub) GetConnectedPeersRatingsOnMainNetwork() (string, error) {
 	return "", nil
 }
 
+// GetAntifloodQuotas -
+func (ns *NodeStub) GetAntifloodQuotas() []*common.AntifloodQuotaStatus {
+	if ns.GetAntifloodQuotasCalled != nil {
+		return ns.GetAntifloodQuotasCalled()
+	}
+
+	return make([]*common.AntifloodQuotaStatus, 0)
+}
+
+// GetAntifloodTopics -
+func (ns *NodeStub) GetAntifloodTopics() []*common.AntifloodTopicStatus {
+	if ns.GetAntifloodTopicsCalled != nil {
+		return ns.GetAntifloodTopicsCalled()
+	}
+
+	return make([]*common.AntifloodTopicStatus, 0)
+}
+
+// GetAntifloodBlacklistedPeers -
+func (ns *NodeStub) GetAntifloodBlacklistedPeers() []*common.BlacklistedPeer {
+	if ns.GetAntifloodBlacklistedPeersCalled != nil {
+		return ns.GetAntifloodBlacklistedPeersCalled()
+	}
+
+	return make([]*common.BlacklistedPeer, 0)
+}
+
+// SetAntifloodQuota -
+func (ns *NodeStub) SetAntifloodQuota(name string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	if ns.SetAntifloodQuotaCalled != nil {
+		return ns.SetAntifloodQuotaCalled(name, maxMessagesPerPeer, maxTotalSizePerPeer)
+	}
+
+	return nil
+}
+
+// SetAntifloodTopicMaxMessages -
+func (ns *NodeStub) SetAntifloodTopicMaxMessages(topic string, maxMessagesPerPeer uint32) error {
+	if ns.SetAntifloodTopicMaxMessagesCalled != nil {
+		return ns.SetAntifloodTopicMaxMessagesCalled(topic, maxMessagesPerPeer)
+	}
+
+	return nil
+}
+
+// AddAntifloodBlacklistedPeer -
+func (ns *NodeStub) AddAntifloodBlacklistedPeer(pid string, duration time.Duration) error {
+	if ns.AddAntifloodBlacklistedPeerCalled != nil {
+		return ns.AddAntifloodBlacklistedPeerCalled(pid, duration)
+	}
+
+	return nil
+}
+
+// RemoveAntifloodBlacklistedPeer -
+func (ns *NodeStub) RemoveAntifloodBlacklistedPeer(pid string) error {
+	if ns.RemoveAntifloodBlacklistedPeerCalled != nil {
+		return ns.RemoveAntifloodBlacklistedPeerCalled(pid)
+	}
+
+	return nil
+}
+
+// ExportPeerReputation -
+func (ns *NodeStub) ExportPeerReputation() *common.PeerReputationSnapshot {
+	if ns.ExportPeerReputationCalled != nil {
+		return ns.ExportPeerReputationCalled()
+	}
+
+	return &common.PeerReputationSnapshot{}
+}
+
+// ImportPeerReputation -
+func (ns *NodeStub) ImportPeerReputation(snapshot *common.PeerReputationSnapshot) error {
+	if ns.ImportPeerReputationCalled != nil {
+		return ns.ImportPeerReputationCalled(snapshot)
+	}
+
+	return nil
+}
+
+// AddManagedKey -
+func (ns *NodeStub) AddManagedKey(privateKeyHex string) (string, error) {
+	if ns.AddManagedKeyCalled != nil {
+		return ns.AddManagedKeyCalled(privateKeyHex)
+	}
+
+	return "", nil
+}
+
+// RemoveManagedKey -
+func (ns *NodeStub) RemoveManagedKey(publicKey string) error {
+	if ns.RemoveManagedKeyCalled != nil {
+		return ns.RemoveManagedKeyCalled(publicKey)
+	}
+
+	return nil
+}
+
+// ExportSlashingProtection -
+func (ns *NodeStub) ExportSlashingProtection() (*common.SlashingProtectionSnapshot, error) {
+	if ns.ExportSlashingProtectionCalled != nil {
+		return ns.ExportSlashingProtectionCalled()
+	}
+
+	return &common.SlashingProtectionSnapshot{}, nil
+}
+
+// ImportSlashingProtection -
+func (ns *NodeStub) ImportSlashingProtection(snapshot *common.SlashingProtectionSnapshot) error {
+	if ns.ImportSlashingProtectionCalled != nil {
+		return ns.ImportSlashingProtectionCalled(snapshot)
+	}
+
+	return nil
+}
+
 // GetEpochStartDataAPI -
 func (ns *NodeStub) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	if ns.GetEpochStartDataAPICalled != nil {
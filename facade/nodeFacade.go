@@ -3,6 +3,7 @@ package facade
 import (
 	"bytes"
 	"context"
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -39,6 +40,10 @@ const DefaultRestInterface = "localhost:8080"
 // to start the node without a REST endpoint available
 const DefaultRestPortOff = "off"
 
+// maxNumSCQueriesBatchAttempts is the number of times a batch of SC queries on the current state is executed before
+// giving up, in case new blocks get committed while the batch is executed
+const maxNumSCQueriesBatchAttempts = 3
+
 var log = logger.GetOrCreate("facade")
 
 // ArgNodeFacade represents the argument for the nodeFacade
@@ -289,6 +294,18 @@ func (nf *nodeFacade) AuctionListApi() ([]*common.AuctionListValidatorAPIRespons
 	return nf.node.AuctionListApi()
 }
 
+// AuctionSimulationApi will return the data about the validators in the auction list, after applying the provided
+// hypothetical changes
+func (nf *nodeFacade) AuctionSimulationApi(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error) {
+	return nf.node.AuctionSimulationApi(request)
+}
+
+// ValidatorHistoryApi will return the statistics of the provided validator, as recorded at the end of each epoch from the
+// provided range
+func (nf *nodeFacade) ValidatorHistoryApi(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error) {
+	return nf.node.ValidatorHistoryApi(blsKey, fromEpoch, toEpoch)
+}
+
 // SendBulkTransactions will send a bulk of transactions on the topic channel
 func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return nf.node.SendBulkTransactions(txs)
@@ -444,6 +461,59 @@ func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, a
 	return nf.convertVmOutputToApiResponse(vmOutput), queryBlockInfoToApiResource(blockInfo), nil
 }
 
+// ExecuteSCQueries executes the provided SC queries on the same block and returns the result of each of them
+func (nf *nodeFacade) ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultAPIResponse, apiData.BlockInfo, error) {
+	numQueries := uint32(len(queries))
+	maxNumQueries := nf.wsAntifloodConfig.VmQueryMultipleMaxSize
+	isLimited := maxNumQueries > 0
+	if isLimited && numQueries > maxNumQueries {
+		return nil, apiData.BlockInfo{}, fmt.Errorf("%w (provided: %d, maximum: %d)", ErrTooManySCQueries, numQueries, maxNumQueries)
+	}
+
+	for i := 0; i < maxNumSCQueriesBatchAttempts; i++ {
+		results, blockInfo, isSameState := nf.executeSCQueriesBatch(queries)
+		if isSameState {
+			return results, blockInfo, nil
+		}
+
+		log.Debug("state changed while executing the batch of SC queries, retrying", "attempt", i+1)
+	}
+
+	return nil, apiData.BlockInfo{}, ErrStateChangedWhileExecutingSCQueries
+}
+
+// executeSCQueriesBatch executes all the queries and returns false if they were not all executed on the same root hash.
+// Errors on individual queries are returned in the corresponding results
+func (nf *nodeFacade) executeSCQueriesBatch(queries []*process.SCQuery) ([]*common.SCQueryResultAPIResponse, apiData.BlockInfo, bool) {
+	results := make([]*common.SCQueryResultAPIResponse, 0, len(queries))
+	var blockInfo apiData.BlockInfo
+	hasBlockInfo := false
+	for _, query := range queries {
+		vmOutput, queryBlockInfo, err := nf.apiResolver.ExecuteSCQuery(query)
+		if err != nil {
+			results = append(results, &common.SCQueryResultAPIResponse{
+				Error: err.Error(),
+			})
+			continue
+		}
+
+		apiBlockInfo := queryBlockInfoToApiResource(queryBlockInfo)
+		if !hasBlockInfo {
+			blockInfo = apiBlockInfo
+			hasBlockInfo = true
+		}
+		if apiBlockInfo.RootHash != blockInfo.RootHash {
+			return nil, apiData.BlockInfo{}, false
+		}
+
+		results = append(results, &common.SCQueryResultAPIResponse{
+			Data: nf.convertVmOutputToApiResponse(vmOutput),
+		})
+	}
+
+	return results, blockInfo, true
+}
+
 // PprofEnabled returns if profiling mode should be active or not on the application
 func (nf *nodeFacade) PprofEnabled() bool {
 	return nf.config.PprofEnabled
@@ -489,6 +559,84 @@ func (nf *nodeFacade) GetConnectedPeersRatingsOnMainNetwork() (string, error) {
 	return nf.node.GetConnectedPeersRatingsOnMainNetwork()
 }
 
+// GetAntifloodQuotas returns the current status of the input antiflood quotas
+func (nf *nodeFacade) GetAntifloodQuotas() []*common.AntifloodQuotaStatus {
+	return nf.node.GetAntifloodQuotas()
+}
+
+// GetAntifloodTopics returns the current status of the input antiflood topics
+func (nf *nodeFacade) GetAntifloodTopics() []*common.AntifloodTopicStatus {
+	return nf.node.GetAntifloodTopics()
+}
+
+// GetAntifloodBlacklistedPeers returns the peers currently blacklisted by the antiflood components
+func (nf *nodeFacade) GetAntifloodBlacklistedPeers() []*common.BlacklistedPeer {
+	return nf.node.GetAntifloodBlacklistedPeers()
+}
+
+// SetAntifloodQuota changes the limits of the antiflood quota with the provided name
+func (nf *nodeFacade) SetAntifloodQuota(name string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	return nf.node.SetAntifloodQuota(name, maxMessagesPerPeer, maxTotalSizePerPeer)
+}
+
+// SetAntifloodTopicMaxMessages changes the maximum number of messages per peer accepted on the provided topic
+func (nf *nodeFacade) SetAntifloodTopicMaxMessages(topic string, maxMessagesPerPeer uint32) error {
+	return nf.node.SetAntifloodTopicMaxMessages(topic, maxMessagesPerPeer)
+}
+
+// AddAntifloodBlacklistedPeer manually blacklists the provided peer for the provided duration
+func (nf *nodeFacade) AddAntifloodBlacklistedPeer(pid string, duration time.Duration) error {
+	return nf.node.AddAntifloodBlacklistedPeer(pid, duration)
+}
+
+// RemoveAntifloodBlacklistedPeer manually removes the provided peer from the blacklist
+func (nf *nodeFacade) RemoveAntifloodBlacklistedPeer(pid string) error {
+	return nf.node.RemoveAntifloodBlacklistedPeer(pid)
+}
+
+// ExportPeerReputation returns the blacklisted peers and the peers ratings, as they would be persisted
+func (nf *nodeFacade) ExportPeerReputation() *common.PeerReputationSnapshot {
+	return nf.node.ExportPeerReputation()
+}
+
+// ImportPeerReputation applies the provided blacklisted peers and peers ratings
+func (nf *nodeFacade) ImportPeerReputation(snapshot *common.PeerReputationSnapshot) error {
+	return nf.node.ImportPeerReputation(snapshot)
+}
+
+// AddManagedKey loads the provided hex encoded BLS private key as a key managed by the current node
+func (nf *nodeFacade) AddManagedKey(privateKeyHex string) (string, error) {
+	return nf.node.AddManagedKey(privateKeyHex)
+}
+
+// RemoveManagedKey releases the provided BLS public key from the keys managed by the current node
+func (nf *nodeFacade) RemoveManagedKey(publicKey string) error {
+	return nf.node.RemoveManagedKey(publicKey)
+}
+
+// ExportSlashingProtection returns the last signed block of each validator key, as persisted by the slashing protection
+func (nf *nodeFacade) ExportSlashingProtection() (*common.SlashingProtectionSnapshot, error) {
+	return nf.node.ExportSlashingProtection()
+}
+
+// ImportSlashingProtection merges the provided signed block records into the slashing protection
+func (nf *nodeFacade) ImportSlashingProtection(snapshot *common.SlashingProtectionSnapshot) error {
+	return nf.node.ImportSlashingProtection(snapshot)
+}
+
+// IsAdminRequestAuthorized returns true if the admin routes are enabled and the provided credentials match the configured ones
+func (nf *nodeFacade) IsAdminRequestAuthorized(username string, password string) bool {
+	adminConfig := nf.apiRoutesConfig.Admin
+	if !adminConfig.Enabled || len(adminConfig.Username) == 0 || len(adminConfig.Password) == 0 {
+		return false
+	}
+
+	isUsernameValid := subtle.ConstantTimeCompare([]byte(username), []byte(adminConfig.Username)) == 1
+	isPasswordValid := subtle.ConstantTimeCompare([]byte(password), []byte(adminConfig.Password)) == 1
+
+	return isUsernameValid && isPasswordValid
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	if !nf.wsAntifloodConfig.WebServerAntifloodEnabled {
@@ -638,6 +786,122 @@ func (nf *nodeFacade) GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32
 	return nf.apiResolver.GetWaitingEpochsLeftForPublicKey(publicKey)
 }
 
+// StartOutportReplay starts pushing the stored blocks in the provided nonce range to the provided outport target
+func (nf *nodeFacade) StartOutportReplay(startNonce uint64, endNonce uint64, target string) error {
+	return nf.apiResolver.StartOutportReplay(startNonce, endNonce, target)
+}
+
+// GetOutportReplayStatus returns the progress of the current (or last) outport replay
+func (nf *nodeFacade) GetOutportReplayStatus() common.OutportReplayStatus {
+	return nf.apiResolver.GetOutportReplayStatus()
+}
+
+// GetGovernanceConfig returns the current configuration of the governance system smart contract
+func (nf *nodeFacade) GetGovernanceConfig() (*common.GovernanceConfigAPIResponse, error) {
+	return nf.apiResolver.GetGovernanceConfig()
+}
+
+// GetGovernanceProposals returns all the governance proposals, decoded
+func (nf *nodeFacade) GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error) {
+	return nf.apiResolver.GetGovernanceProposals()
+}
+
+// GetGovernanceProposal returns the decoded governance proposal with the provided nonce
+func (nf *nodeFacade) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	return nf.apiResolver.GetGovernanceProposal(nonce)
+}
+
+// GetGovernanceVotes returns the nonces of the governance proposals the provided address has voted for
+func (nf *nodeFacade) GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error) {
+	return nf.apiResolver.GetGovernanceVotes(address)
+}
+
+// GetGovernanceVotingPower returns the governance voting power of the provided address
+func (nf *nodeFacade) GetGovernanceVotingPower(address string) (*big.Int, error) {
+	return nf.apiResolver.GetGovernanceVotingPower(address)
+}
+
+// GetGovernanceDelegatedVoteInfo returns the voting stake and power used by the provided delegation contract on a proposal
+func (nf *nodeFacade) GetGovernanceDelegatedVoteInfo(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error) {
+	return nf.apiResolver.GetGovernanceDelegatedVoteInfo(contract, nonce)
+}
+
+// GetDelegationContractConfig returns the decoded configuration of the provided delegation contract
+func (nf *nodeFacade) GetDelegationContractConfig(contract string) (*common.DelegationContractConfigAPIResponse, error) {
+	return nf.apiResolver.GetDelegationContractConfig(contract)
+}
+
+// GetDelegationContractNodeStates returns the BLS keys of the provided delegation contract, grouped by their state
+func (nf *nodeFacade) GetDelegationContractNodeStates(contract string) (*common.DelegationNodeStatesAPIResponse, error) {
+	return nf.apiResolver.GetDelegationContractNodeStates(contract)
+}
+
+// GetDelegationContractDelegator returns the decoded funds of the provided delegator in the provided delegation contract
+func (nf *nodeFacade) GetDelegationContractDelegator(contract string, delegator string) (*common.DelegatorAPIResponse, error) {
+	return nf.apiResolver.GetDelegationContractDelegator(contract, delegator)
+}
+
+// GetDelegationContractDelegators returns a page of the delegators list of the provided delegation contract
+func (nf *nodeFacade) GetDelegationContractDelegators(contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetDelegationContractDelegators(ctx, contract, page, pageSize)
+}
+
+// GetRewardsBreakdown returns the economics data and the rewards of all the eligible nodes in the provided epoch
+func (nf *nodeFacade) GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	return nf.apiResolver.GetRewardsBreakdown(epoch)
+}
+
+// GetOwnerRewardsBreakdown returns the staking data and the rewards of the eligible nodes of the provided owner in the provided epoch
+func (nf *nodeFacade) GetOwnerRewardsBreakdown(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error) {
+	return nf.apiResolver.GetOwnerRewardsBreakdown(epoch, owner)
+}
+
+// GetOwnerStakingInfo returns the staked and unstaked values, the nodes status and the delegation positions of the
+// provided owner, along with the epoch starting with which each unstaked amount can be withdrawn
+func (nf *nodeFacade) GetOwnerStakingInfo(owner string) (*common.OwnerStakingInfoAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetOwnerStakingInfo(ctx, owner)
+}
+
+// RegisterContractABI registers the provided ABI for the provided contract address, to be used when decoding the
+// contract's queries, transactions and events
+func (nf *nodeFacade) RegisterContractABI(address string, abiJSON []byte) error {
+	return nf.apiResolver.RegisterContractABI(address, abiJSON)
+}
+
+// GetContractsWithABI returns the addresses of the contracts that have a registered ABI
+func (nf *nodeFacade) GetContractsWithABI() ([]string, error) {
+	return nf.apiResolver.GetContractsWithABI(), nil
+}
+
+// DecodeSCQueryReturnData decodes the data returned by a SC query using the ABI registered for the queried contract
+func (nf *nodeFacade) DecodeSCQueryReturnData(scAddress string, funcName string, returnData [][]byte) ([]*common.ABIDecodedValue, error) {
+	return nf.apiResolver.DecodeSCQueryReturnData(scAddress, funcName, returnData)
+}
+
+// DecodeTransaction decodes the function call, the returned data and the events of the provided transaction using
+// the ABIs registered for the involved contracts
+func (nf *nodeFacade) DecodeTransaction(tx *transaction.ApiTransactionResult) (*common.ABIDecodedTransactionAPIResponse, error) {
+	return nf.apiResolver.DecodeTransaction(tx), nil
+}
+
+// VerifyContract checks the provided reproducible build artifact against the code of the contract deployed at the
+// provided address and stores the verification record
+func (nf *nodeFacade) VerifyContract(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error) {
+	return nf.apiResolver.VerifyContract(address, artifact)
+}
+
+// GetVerifiedContract returns the verification record of the contract deployed at the provided address, if the
+// verified code has the provided code hash
+func (nf *nodeFacade) GetVerifiedContract(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error) {
+	return nf.apiResolver.GetVerifiedContract(address, codeHash)
+}
+
 func (nf *nodeFacade) convertVmOutputToApiResponse(input *vmcommon.VMOutput) *vm.VMOutputApi {
 	outputAccounts := make(map[string]*vm.OutputAccountApi)
 	for key, acc := range input.OutputAccounts {
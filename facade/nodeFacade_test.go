@@ -21,6 +21,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/facade/mock"
@@ -1412,6 +1413,100 @@ func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 	})
 }
 
+func TestNodeFacade_ExecuteSCQueries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("too many queries should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.VmQueryMultipleMaxSize = 1
+		nf, _ := NewNodeFacade(arg)
+
+		results, blockInfo, err := nf.ExecuteSCQueries([]*process.SCQuery{{}, {}})
+		require.Nil(t, results)
+		require.Empty(t, blockInfo)
+		require.True(t, errors.Is(err, ErrTooManySCQueries))
+	})
+	t.Run("zero max size should not limit the queries", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.VmQueryMultipleMaxSize = 0
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+				return &vmcommon.VMOutput{}, holders.NewBlockInfo(nil, 1, []byte("root hash")), nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		results, _, err := nf.ExecuteSCQueries([]*process.SCQuery{{}, {}, {}})
+		require.Nil(t, err)
+		require.Len(t, results, 3)
+	})
+	t.Run("state always changing should error", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.VmQueryMultipleMaxSize = 2
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+				numCalls++
+				return &vmcommon.VMOutput{}, holders.NewBlockInfo(nil, uint64(numCalls), []byte{byte(numCalls)}), nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		results, blockInfo, err := nf.ExecuteSCQueries([]*process.SCQuery{{}, {}})
+		require.Nil(t, results)
+		require.Empty(t, blockInfo)
+		require.Equal(t, ErrStateChangedWhileExecutingSCQueries, err)
+		require.Equal(t, 2*maxNumSCQueriesBatchAttempts, numCalls)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		providedRootHash := []byte("root hash")
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.VmQueryMultipleMaxSize = 3
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+				numCalls++
+				if numCalls == 2 {
+					// a new block was committed during the first attempt
+					return &vmcommon.VMOutput{}, holders.NewBlockInfo(nil, 11, []byte("new root hash")), nil
+				}
+				if query.FuncName == "failing" {
+					return nil, nil, expectedErr
+				}
+
+				return &vmcommon.VMOutput{
+					ReturnData: [][]byte{[]byte(query.FuncName)},
+				}, holders.NewBlockInfo([]byte("hash"), 10, providedRootHash), nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		queries := []*process.SCQuery{
+			{FuncName: "first"},
+			{FuncName: "failing"},
+			{FuncName: "third"},
+		}
+		results, blockInfo, err := nf.ExecuteSCQueries(queries)
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(providedRootHash), blockInfo.RootHash)
+		require.Equal(t, uint64(10), blockInfo.Nonce)
+		require.Equal(t, 3, len(results))
+		require.Equal(t, [][]byte{[]byte("first")}, results[0].Data.ReturnData)
+		require.Nil(t, results[1].Data)
+		require.Equal(t, expectedErr.Error(), results[1].Error)
+		require.Equal(t, [][]byte{[]byte("third")}, results[2].Data.ReturnData)
+		require.Equal(t, 5, numCalls)
+	})
+}
+
 func TestNodeFacade_GetBlockByRoundShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -2268,6 +2363,428 @@ func TestNodeFacade_GetConnectedPeersRatingsOnMainNetwork(t *testing.T) {
 	require.Equal(t, providedResponse, response)
 }
 
+func TestNodeFacade_AntifloodMethods(t *testing.T) {
+	t.Parallel()
+
+	providedQuotas := []*common.AntifloodQuotaStatus{{Name: "quota"}}
+	providedTopics := []*common.AntifloodTopicStatus{{Topic: "topic"}}
+	providedBlacklist := []*common.BlacklistedPeer{{Pid: "pid"}}
+	providedSnapshot := &common.PeerReputationSnapshot{BlacklistedPeers: providedBlacklist}
+	expectedErr := errors.New("expected error")
+	numSetterCalls := 0
+	args := createMockArguments()
+	args.Node = &mock.NodeStub{
+		GetAntifloodQuotasCalled: func() []*common.AntifloodQuotaStatus {
+			return providedQuotas
+		},
+		GetAntifloodTopicsCalled: func() []*common.AntifloodTopicStatus {
+			return providedTopics
+		},
+		GetAntifloodBlacklistedPeersCalled: func() []*common.BlacklistedPeer {
+			return providedBlacklist
+		},
+		SetAntifloodQuotaCalled: func(name string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+			numSetterCalls++
+			return expectedErr
+		},
+		SetAntifloodTopicMaxMessagesCalled: func(topic string, maxMessagesPerPeer uint32) error {
+			numSetterCalls++
+			return expectedErr
+		},
+		AddAntifloodBlacklistedPeerCalled: func(pid string, duration time.Duration) error {
+			numSetterCalls++
+			return expectedErr
+		},
+		RemoveAntifloodBlacklistedPeerCalled: func(pid string) error {
+			numSetterCalls++
+			return expectedErr
+		},
+		ExportPeerReputationCalled: func() *common.PeerReputationSnapshot {
+			return providedSnapshot
+		},
+		ImportPeerReputationCalled: func(snapshot *common.PeerReputationSnapshot) error {
+			numSetterCalls++
+			return expectedErr
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	require.Equal(t, providedQuotas, nf.GetAntifloodQuotas())
+	require.Equal(t, providedTopics, nf.GetAntifloodTopics())
+	require.Equal(t, providedBlacklist, nf.GetAntifloodBlacklistedPeers())
+	require.Equal(t, expectedErr, nf.SetAntifloodQuota("quota", 1, 1))
+	require.Equal(t, expectedErr, nf.SetAntifloodTopicMaxMessages("topic", 1))
+	require.Equal(t, expectedErr, nf.AddAntifloodBlacklistedPeer("pid", time.Second))
+	require.Equal(t, expectedErr, nf.RemoveAntifloodBlacklistedPeer("pid"))
+	require.Equal(t, providedSnapshot, nf.ExportPeerReputation())
+	require.Equal(t, expectedErr, nf.ImportPeerReputation(providedSnapshot))
+	require.Equal(t, 5, numSetterCalls)
+}
+
+func TestNodeFacade_ManagedKeysMethods(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArguments()
+	args.Node = &mock.NodeStub{
+		AddManagedKeyCalled: func(privateKeyHex string) (string, error) {
+			require.Equal(t, "private key", privateKeyHex)
+			return "public key", nil
+		},
+		RemoveManagedKeyCalled: func(publicKey string) error {
+			require.Equal(t, "public key", publicKey)
+			return expectedErr
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	publicKey, err := nf.AddManagedKey("private key")
+	require.Nil(t, err)
+	require.Equal(t, "public key", publicKey)
+	require.Equal(t, expectedErr, nf.RemoveManagedKey("public key"))
+}
+
+func TestNodeFacade_SlashingProtectionMethods(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	providedSnapshot := &common.SlashingProtectionSnapshot{
+		Records: []*common.SignedBlockRecord{
+			{PublicKey: "aa", Epoch: 1, Round: 10, HeaderHash: "bb"},
+		},
+	}
+	args := createMockArguments()
+	args.Node = &mock.NodeStub{
+		ExportSlashingProtectionCalled: func() (*common.SlashingProtectionSnapshot, error) {
+			return providedSnapshot, nil
+		},
+		ImportSlashingProtectionCalled: func(snapshot *common.SlashingProtectionSnapshot) error {
+			require.Equal(t, providedSnapshot, snapshot)
+			return expectedErr
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	snapshot, err := nf.ExportSlashingProtection()
+	require.Nil(t, err)
+	require.Equal(t, providedSnapshot, snapshot)
+	require.Equal(t, expectedErr, nf.ImportSlashingProtection(providedSnapshot))
+}
+
+func TestNodeFacade_OutportReplayMethods(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	providedStatus := common.OutportReplayStatus{
+		InProgress:        true,
+		StartNonce:        10,
+		EndNonce:          20,
+		LastReplayedNonce: 12,
+		NumReplayedBlocks: 3,
+	}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		StartOutportReplayCalled: func(startNonce uint64, endNonce uint64, target string) error {
+			require.Equal(t, uint64(10), startNonce)
+			require.Equal(t, uint64(20), endNonce)
+			require.Equal(t, "eventNotifier", target)
+			return expectedErr
+		},
+		GetOutportReplayStatusCalled: func() common.OutportReplayStatus {
+			return providedStatus
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	require.Equal(t, expectedErr, nf.StartOutportReplay(10, 20, "eventNotifier"))
+	require.Equal(t, providedStatus, nf.GetOutportReplayStatus())
+}
+
+func TestNodeFacade_GovernanceMethods(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	providedConfig := &common.GovernanceConfigAPIResponse{ProposalFee: "1000", LastProposalNonce: 1}
+	providedProposal := &common.GovernanceProposalAPIResponse{Nonce: 1, Status: "active"}
+	providedVotes := &common.GovernanceVotesAPIResponse{Direct: []uint64{1}, Delegated: []uint64{}}
+	providedDelegatedVoteInfo := &common.GovernanceDelegatedVoteInfoAPIResponse{UsedStake: "10", TotalStake: "100"}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceConfigCalled: func() (*common.GovernanceConfigAPIResponse, error) {
+			return providedConfig, nil
+		},
+		GetGovernanceProposalsCalled: func() ([]*common.GovernanceProposalAPIResponse, error) {
+			return nil, expectedErr
+		},
+		GetGovernanceProposalCalled: func(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+			require.Equal(t, uint64(1), nonce)
+			return providedProposal, nil
+		},
+		GetGovernanceVotesCalled: func(address string) (*common.GovernanceVotesAPIResponse, error) {
+			require.Equal(t, "address", address)
+			return providedVotes, nil
+		},
+		GetGovernanceVotingPowerCalled: func(address string) (*big.Int, error) {
+			require.Equal(t, "address", address)
+			return big.NewInt(37), nil
+		},
+		GetGovernanceDelegatedVoteInfoCalled: func(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error) {
+			require.Equal(t, "contract", contract)
+			require.Equal(t, uint64(1), nonce)
+			return providedDelegatedVoteInfo, nil
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	config, err := nf.GetGovernanceConfig()
+	require.Nil(t, err)
+	require.Equal(t, providedConfig, config)
+
+	proposals, err := nf.GetGovernanceProposals()
+	require.Nil(t, proposals)
+	require.Equal(t, expectedErr, err)
+
+	proposal, err := nf.GetGovernanceProposal(1)
+	require.Nil(t, err)
+	require.Equal(t, providedProposal, proposal)
+
+	votes, err := nf.GetGovernanceVotes("address")
+	require.Nil(t, err)
+	require.Equal(t, providedVotes, votes)
+
+	votingPower, err := nf.GetGovernanceVotingPower("address")
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(37), votingPower)
+
+	delegatedVoteInfo, err := nf.GetGovernanceDelegatedVoteInfo("contract", 1)
+	require.Nil(t, err)
+	require.Equal(t, providedDelegatedVoteInfo, delegatedVoteInfo)
+}
+
+func TestNodeFacade_DelegationContractMethods(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	providedNodeStates := &common.DelegationNodeStatesAPIResponse{Staked: []string{"bls1"}}
+	providedDelegator := &common.DelegatorAPIResponse{Address: "delegator", ActiveStake: "100"}
+	providedDelegators := &common.DelegatorsListAPIResponse{NumDelegators: 1, Page: 2, PageSize: 10}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		GetDelegationContractConfigCalled: func(contract string) (*common.DelegationContractConfigAPIResponse, error) {
+			return nil, expectedErr
+		},
+		GetDelegationContractNodeStatesCalled: func(contract string) (*common.DelegationNodeStatesAPIResponse, error) {
+			require.Equal(t, "contract", contract)
+			return providedNodeStates, nil
+		},
+		GetDelegationContractDelegatorCalled: func(contract string, delegator string) (*common.DelegatorAPIResponse, error) {
+			require.Equal(t, "contract", contract)
+			require.Equal(t, "delegator", delegator)
+			return providedDelegator, nil
+		},
+		GetDelegationContractDelegatorsCalled: func(ctx context.Context, contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error) {
+			require.NotNil(t, ctx)
+			require.Equal(t, "contract", contract)
+			require.Equal(t, uint32(2), page)
+			require.Equal(t, uint32(10), pageSize)
+			return providedDelegators, nil
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	config, err := nf.GetDelegationContractConfig("contract")
+	require.Nil(t, config)
+	require.Equal(t, expectedErr, err)
+
+	nodeStates, err := nf.GetDelegationContractNodeStates("contract")
+	require.Nil(t, err)
+	require.Equal(t, providedNodeStates, nodeStates)
+
+	delegator, err := nf.GetDelegationContractDelegator("contract", "delegator")
+	require.Nil(t, err)
+	require.Equal(t, providedDelegator, delegator)
+
+	delegators, err := nf.GetDelegationContractDelegators("contract", 2, 10)
+	require.Nil(t, err)
+	require.Equal(t, providedDelegators, delegators)
+}
+
+func TestNodeFacade_RewardsBreakdownMethods(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	providedOwnerRewardsBreakdown := &common.OwnerRewardsBreakdownAPIResponse{Owner: "owner", TotalRewards: "100"}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		GetRewardsBreakdownCalled: func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+			return nil, expectedErr
+		},
+		GetOwnerRewardsBreakdownCalled: func(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error) {
+			require.Equal(t, uint32(4), epoch)
+			require.Equal(t, "owner", owner)
+			return providedOwnerRewardsBreakdown, nil
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	rewardsBreakdown, err := nf.GetRewardsBreakdown(4)
+	require.Nil(t, rewardsBreakdown)
+	require.Equal(t, expectedErr, err)
+
+	ownerRewardsBreakdown, err := nf.GetOwnerRewardsBreakdown(4, "owner")
+	require.Nil(t, err)
+	require.Equal(t, providedOwnerRewardsBreakdown, ownerRewardsBreakdown)
+}
+
+func TestNodeFacade_ContractABIs(t *testing.T) {
+	t.Parallel()
+
+	providedDecodedValues := []*common.ABIDecodedValue{{Type: "BigUint", Value: "42"}}
+	providedDecodedTx := &common.ABIDecodedTransactionAPIResponse{Function: "add"}
+	registerCalled := false
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		RegisterContractABICalled: func(address string, abiJSON []byte) error {
+			require.Equal(t, "contract", address)
+			require.Equal(t, []byte("abi"), abiJSON)
+			registerCalled = true
+			return nil
+		},
+		GetContractsWithABICalled: func() []string {
+			return []string{"contract"}
+		},
+		DecodeSCQueryReturnDataCalled: func(scAddress string, funcName string, returnData [][]byte) ([]*common.ABIDecodedValue, error) {
+			require.Equal(t, "contract", scAddress)
+			require.Equal(t, "getSum", funcName)
+			return providedDecodedValues, nil
+		},
+		DecodeTransactionCalled: func(tx *transaction.ApiTransactionResult) *common.ABIDecodedTransactionAPIResponse {
+			return providedDecodedTx
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	err := nf.RegisterContractABI("contract", []byte("abi"))
+	require.Nil(t, err)
+	require.True(t, registerCalled)
+
+	contracts, err := nf.GetContractsWithABI()
+	require.Nil(t, err)
+	require.Equal(t, []string{"contract"}, contracts)
+
+	decodedValues, err := nf.DecodeSCQueryReturnData("contract", "getSum", [][]byte{{42}})
+	require.Nil(t, err)
+	require.Equal(t, providedDecodedValues, decodedValues)
+
+	decodedTx, err := nf.DecodeTransaction(&transaction.ApiTransactionResult{})
+	require.Nil(t, err)
+	require.Equal(t, providedDecodedTx, decodedTx)
+}
+
+func TestNodeFacade_ContractsVerification(t *testing.T) {
+	t.Parallel()
+
+	providedArtifact := &common.ContractVerificationArtifact{Code: "0061736d"}
+	providedRecord := &common.VerifiedContractAPIResponse{Address: "contract", CodeHash: "aabb"}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		VerifyContractCalled: func(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error) {
+			require.Equal(t, "contract", address)
+			require.Equal(t, providedArtifact, artifact)
+			return providedRecord, nil
+		},
+		GetVerifiedContractCalled: func(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error) {
+			require.Equal(t, "contract", address)
+			require.Equal(t, []byte{0xaa, 0xbb}, codeHash)
+			return nil, expectedErr
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	record, err := nf.VerifyContract("contract", providedArtifact)
+	require.Nil(t, err)
+	require.Equal(t, providedRecord, record)
+
+	record, err = nf.GetVerifiedContract("contract", []byte{0xaa, 0xbb})
+	require.Equal(t, expectedErr, err)
+	require.Nil(t, record)
+}
+
+func TestNodeFacade_GetOwnerStakingInfo(t *testing.T) {
+	t.Parallel()
+
+	providedStakingInfo := &common.OwnerStakingInfoAPIResponse{Owner: "owner", TotalStaked: "2500"}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		GetOwnerStakingInfoCalled: func(ctx context.Context, owner string) (*common.OwnerStakingInfoAPIResponse, error) {
+			require.NotNil(t, ctx)
+			require.Equal(t, "owner", owner)
+			return providedStakingInfo, nil
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	stakingInfo, err := nf.GetOwnerStakingInfo("owner")
+	require.Nil(t, err)
+	require.Equal(t, providedStakingInfo, stakingInfo)
+}
+
+func TestNodeFacade_IsAdminRequestAuthorized(t *testing.T) {
+	t.Parallel()
+
+	t.Run("admin disabled should return false", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArguments()
+		args.ApiRoutesConfig.Admin = config.ApiAdminConfig{
+			Enabled:  false,
+			Username: "user",
+			Password: "pass",
+		}
+		nf, _ := NewNodeFacade(args)
+
+		require.False(t, nf.IsAdminRequestAuthorized("user", "pass"))
+	})
+	t.Run("empty configured credentials should return false", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArguments()
+		args.ApiRoutesConfig.Admin = config.ApiAdminConfig{
+			Enabled: true,
+		}
+		nf, _ := NewNodeFacade(args)
+
+		require.False(t, nf.IsAdminRequestAuthorized("", ""))
+	})
+	t.Run("wrong credentials should return false", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArguments()
+		args.ApiRoutesConfig.Admin = config.ApiAdminConfig{
+			Enabled:  true,
+			Username: "user",
+			Password: "pass",
+		}
+		nf, _ := NewNodeFacade(args)
+
+		require.False(t, nf.IsAdminRequestAuthorized("user", "wrong"))
+		require.False(t, nf.IsAdminRequestAuthorized("wrong", "pass"))
+	})
+	t.Run("valid credentials should return true", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArguments()
+		args.ApiRoutesConfig.Admin = config.ApiAdminConfig{
+			Enabled:  true,
+			Username: "user",
+			Password: "pass",
+		}
+		nf, _ := NewNodeFacade(args)
+
+		require.True(t, nf.IsAdminRequestAuthorized("user", "pass"))
+	})
+}
+
 func TestNodeFacade_GetBlockByHash(t *testing.T) {
 	t.Parallel()
 
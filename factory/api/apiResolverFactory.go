@@ -18,14 +18,21 @@ import (
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/node/external/abiAPI"
 	"github.com/multiversx/mx-chain-go/node/external/blockAPI"
+	"github.com/multiversx/mx-chain-go/node/external/governanceAPI"
 	"github.com/multiversx/mx-chain-go/node/external/logs"
+	"github.com/multiversx/mx-chain-go/node/external/rewardsAPI"
 	"github.com/multiversx/mx-chain-go/node/external/timemachine/fee"
 	"github.com/multiversx/mx-chain-go/node/external/transactionAPI"
+	"github.com/multiversx/mx-chain-go/node/external/verificationAPI"
 	"github.com/multiversx/mx-chain-go/node/trieIterators"
 	trieIteratorsFactory "github.com/multiversx/mx-chain-go/node/trieIterators/factory"
 	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts"
+	"github.com/multiversx/mx-chain-go/outport/process/transactionsfee"
+	"github.com/multiversx/mx-chain-go/outport/replay"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/preprocess"
 	"github.com/multiversx/mx-chain-go/process/coordinator"
 	"github.com/multiversx/mx-chain-go/process/factory/metachain"
 	"github.com/multiversx/mx-chain-go/process/factory/shard"
@@ -106,6 +113,7 @@ type scQueryElementArgs struct {
 	allowVMQueriesChan         chan struct{}
 	workingDir                 string
 	index                      int
+	queryCache                 process.SCQueryCacheHandler
 	processingMode             common.NodeProcessingMode
 	isInHistoricalBalancesMode bool
 }
@@ -211,12 +219,26 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	argsDelegationContractProcessor := trieIterators.ArgDelegationContractProcessor{
+		ArgTrieIteratorProcessor: argsProcessors,
+		ValidatorPubKeyConverter: args.CoreComponents.ValidatorPubKeyConverter(),
+	}
+	delegationContractHandler, err := trieIteratorsFactory.CreateDelegationContractHandler(argsDelegationContractProcessor)
+	if err != nil {
+		return nil, err
+	}
+
 	feeComputer, err := fee.NewFeeComputer(args.CoreComponents.EconomicsData())
 	if err != nil {
 		return nil, err
 	}
 
-	logsFacade, err := createLogsFacade(args)
+	abiRegistry, err := createABIRegistry(args)
+	if err != nil {
+		return nil, err
+	}
+
+	logsFacade, err := createLogsFacade(args, abiRegistry)
 	if err != nil {
 		return nil, err
 	}
@@ -244,6 +266,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		TxTypeHandler:            txTypeHandler,
 		LogsFacade:               logsFacade,
 		DataFieldParser:          dataFieldParser,
+		ABIDecoder:               abiRegistry,
 	}
 	apiTransactionProcessor, err := transactionAPI.NewAPITransactionProcessor(argsAPITransactionProc)
 	if err != nil {
@@ -260,24 +283,56 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	outportReplayer, err := createOutportReplayer(args, txTypeHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	governanceHandler, err := createGovernanceHandler(args, scQueryService)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardsBreakdownHandler, err := createRewardsBreakdownHandler(args)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingInfoHandler, err := createStakingInfoHandler(args, argsProcessors)
+	if err != nil {
+		return nil, err
+	}
+
+	contractsVerifier, err := createContractsVerifier(args)
+	if err != nil {
+		return nil, err
+	}
+
 	argsApiResolver := external.ArgNodeApiResolver{
-		SCQueryService:           scQueryService,
-		StatusMetricsHandler:     args.StatusCoreComponents.StatusMetrics(),
-		APITransactionEvaluator:  args.ProcessComponents.APITransactionEvaluator(),
-		TotalStakedValueHandler:  totalStakedValueHandler,
-		DirectStakedListHandler:  directStakedListHandler,
-		DelegatedListHandler:     delegatedListHandler,
-		APITransactionHandler:    apiTransactionProcessor,
-		APIBlockHandler:          apiBlockProcessor,
-		APIInternalBlockHandler:  apiInternalBlockProcessor,
-		GenesisNodesSetupHandler: args.CoreComponents.GenesisNodesSetup(),
-		ValidatorPubKeyConverter: args.CoreComponents.ValidatorPubKeyConverter(),
-		AccountsParser:           args.ProcessComponents.AccountsParser(),
-		GasScheduleNotifier:      args.GasScheduleNotifier,
-		ManagedPeersMonitor:      args.StatusComponents.ManagedPeersMonitor(),
-		PublicKey:                args.CryptoComponents.PublicKeyString(),
-		NodesCoordinator:         args.ProcessComponents.NodesCoordinator(),
-		StorageManagers:          storageManagers,
+		SCQueryService:            scQueryService,
+		StatusMetricsHandler:      args.StatusCoreComponents.StatusMetrics(),
+		APITransactionEvaluator:   args.ProcessComponents.APITransactionEvaluator(),
+		TotalStakedValueHandler:   totalStakedValueHandler,
+		DirectStakedListHandler:   directStakedListHandler,
+		DelegatedListHandler:      delegatedListHandler,
+		APITransactionHandler:     apiTransactionProcessor,
+		APIBlockHandler:           apiBlockProcessor,
+		APIInternalBlockHandler:   apiInternalBlockProcessor,
+		GenesisNodesSetupHandler:  args.CoreComponents.GenesisNodesSetup(),
+		ValidatorPubKeyConverter:  args.CoreComponents.ValidatorPubKeyConverter(),
+		AccountsParser:            args.ProcessComponents.AccountsParser(),
+		GasScheduleNotifier:       args.GasScheduleNotifier,
+		ManagedPeersMonitor:       args.StatusComponents.ManagedPeersMonitor(),
+		PublicKey:                 args.CryptoComponents.PublicKeyString(),
+		NodesCoordinator:          args.ProcessComponents.NodesCoordinator(),
+		StorageManagers:           storageManagers,
+		OutportReplayer:           outportReplayer,
+		GovernanceHandler:         governanceHandler,
+		DelegationContractHandler: delegationContractHandler,
+		RewardsBreakdownHandler:   rewardsBreakdownHandler,
+		StakingInfoHandler:        stakingInfoHandler,
+		ABIRegistry:               abiRegistry,
+		ContractsVerifier:         contractsVerifier,
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
@@ -291,6 +346,16 @@ func createScQueryService(
 		return nil, nil, fmt.Errorf("VirtualMachine.Querying.NumConcurrentVms should be a positive number more than 1")
 	}
 
+	queryCache, err := smartContract.NewSCQueryCache(smartContract.ArgsSCQueryCache{
+		HistoricalRootsCacheCapacity: args.generalConfig.VirtualMachine.Querying.HistoricalRootsCacheCapacity,
+		HistoricalTriesCacheCapacity: args.generalConfig.VirtualMachine.Querying.HistoricalTriesCacheCapacity,
+		QueryResultsCacheCapacity:    args.generalConfig.VirtualMachine.Querying.QueryResultsCacheCapacity,
+		AppStatusHandler:             args.statusCoreComponents.AppStatusHandler(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
 	argsQueryElem := &scQueryElementArgs{
 		generalConfig:              args.generalConfig,
 		epochConfig:                args.epochConfig,
@@ -307,11 +372,11 @@ func createScQueryService(
 		allowVMQueriesChan:         args.allowVMQueriesChan,
 		workingDir:                 args.workingDir,
 		index:                      0,
+		queryCache:                 queryCache,
 		processingMode:             args.processingMode,
 		isInHistoricalBalancesMode: args.isInHistoricalBalancesMode,
 	}
 
-	var err error
 	var scQueryService process.SCQueryService
 	var storageManager common.StorageManager
 	storageManagers := make([]common.StorageManager, 0, numConcurrentVms)
@@ -458,6 +523,7 @@ func createScQueryElement(
 		Marshaller:                 args.coreComponents.InternalMarshalizer(),
 		Hasher:                     args.coreComponents.Hasher(),
 		Uint64ByteSliceConverter:   args.coreComponents.Uint64ByteSliceConverter(),
+		QueryCache:                 args.queryCache,
 		IsInHistoricalBalancesMode: args.isInHistoricalBalancesMode,
 	}
 
@@ -697,7 +763,7 @@ func createAPIBlockProcessorArgs(args *ApiResolverArgs, apiTransactionHandler ex
 		return nil, errors.New("error creating transaction status computer " + err.Error())
 	}
 
-	logsFacade, err := createLogsFacade(args)
+	logsFacade, err := createLogsFacade(args, abiAPI.NewDisabledABIRegistry())
 	if err != nil {
 		return nil, err
 	}
@@ -733,10 +799,159 @@ func createAPIBlockProcessorArgs(args *ApiResolverArgs, apiTransactionHandler ex
 	return blockApiArgs, nil
 }
 
-func createLogsFacade(args *ApiResolverArgs) (factory.LogsFacade, error) {
+func createGovernanceHandler(args *ApiResolverArgs, scQueryService process.SCQueryService) (external.GovernanceHandler, error) {
+	if args.BootstrapComponents.ShardCoordinator().SelfId() != core.MetachainShardId {
+		return governanceAPI.NewDisabledGovernanceProcessor(), nil
+	}
+
+	return governanceAPI.NewAPIGovernanceProcessor(&governanceAPI.ArgAPIGovernanceProcessor{
+		QueryService:           scQueryService,
+		AddressPubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+		EpochNotifier:          args.CoreComponents.EpochNotifier(),
+	})
+}
+
+func createRewardsBreakdownHandler(args *ApiResolverArgs) (external.RewardsBreakdownHandler, error) {
+	if args.BootstrapComponents.ShardCoordinator().SelfId() != core.MetachainShardId {
+		return rewardsAPI.NewDisabledRewardsProcessor(), nil
+	}
+
+	rewardsBreakdownStorer, err := args.DataComponents.StorageService().GetStorer(dataRetriever.RewardsBreakdownUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	return rewardsAPI.NewAPIRewardsProcessor(&rewardsAPI.ArgAPIRewardsProcessor{
+		RewardsBreakdownStorer:   rewardsBreakdownStorer,
+		Marshaller:               args.CoreComponents.InternalMarshalizer(),
+		AddressPubKeyConverter:   args.CoreComponents.AddressPubKeyConverter(),
+		ValidatorPubKeyConverter: args.CoreComponents.ValidatorPubKeyConverter(),
+	})
+}
+
+func createContractsVerifier(args *ApiResolverArgs) (external.ContractsVerifier, error) {
+	if args.BootstrapComponents.ShardCoordinator().SelfId() == core.MetachainShardId {
+		return verificationAPI.NewDisabledContractsVerifier(), nil
+	}
+
+	contractsVerificationStorer, err := args.DataComponents.StorageService().GetStorer(dataRetriever.ContractsVerificationUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	return verificationAPI.NewAPIContractsVerifier(&verificationAPI.ArgAPIContractsVerifier{
+		ContractsVerificationStorer: contractsVerificationStorer,
+		AccountsRepository:          args.StateComponents.AccountsRepository(),
+		Marshaller:                  args.CoreComponents.InternalMarshalizer(),
+		Hasher:                      args.CoreComponents.Hasher(),
+		AddressPubKeyConverter:      args.CoreComponents.AddressPubKeyConverter(),
+	})
+}
+
+func createStakingInfoHandler(args *ApiResolverArgs, argsProcessors trieIterators.ArgTrieIteratorProcessor) (external.StakingInfoHandler, error) {
+	argsStakingInfoProcessor := trieIterators.ArgStakingInfoProcessor{
+		ArgTrieIteratorProcessor: argsProcessors,
+		ValidatorPubKeyConverter: args.CoreComponents.ValidatorPubKeyConverter(),
+		NodesCoordinator:         args.ProcessComponents.NodesCoordinator(),
+		EpochNotifier:            args.CoreComponents.EpochNotifier(),
+		EnableEpochsHandler:      args.CoreComponents.EnableEpochsHandler(),
+	}
+	// the system smart contracts config is used only by the metachain nodes
+	if args.Configs.SystemSCConfig != nil {
+		argsStakingInfoProcessor.UnBondPeriodInEpochs = args.Configs.SystemSCConfig.StakingSystemSCConfig.UnBondPeriodInEpochs
+		argsStakingInfoProcessor.UnBondPeriodInRounds = args.Configs.SystemSCConfig.StakingSystemSCConfig.UnBondPeriod
+	}
+
+	return trieIteratorsFactory.CreateStakingInfoHandler(argsStakingInfoProcessor)
+}
+
+func createOutportReplayer(args *ApiResolverArgs, txTypeHandler process.TxTypeHandler) (external.OutportReplayer, error) {
+	logsFacade, err := logs.NewLogsFacade(logs.ArgsNewLogsFacade{
+		StorageService:  args.DataComponents.StorageService(),
+		Marshaller:      args.CoreComponents.InternalMarshalizer(),
+		PubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+		ABIDecoder:      abiAPI.NewDisabledABIRegistry(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txsStorer, err := args.DataComponents.StorageService().GetStorer(dataRetriever.TransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionsFeeProcessor, err := transactionsfee.NewTransactionsFeeProcessor(transactionsfee.ArgTransactionsFeeProcessor{
+		Marshaller:         args.CoreComponents.InternalMarshalizer(),
+		TransactionsStorer: txsStorer,
+		ShardCoordinator:   args.ProcessComponents.ShardCoordinator(),
+		TxFeeCalculator:    args.CoreComponents.EconomicsData(),
+		PubKeyConverter:    args.CoreComponents.AddressPubKeyConverter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	alteredAccountsProvider, err := alteredaccounts.NewAlteredAccountsProvider(alteredaccounts.ArgsAlteredAccountsProvider{
+		ShardCoordinator:       args.ProcessComponents.ShardCoordinator(),
+		AddressConverter:       args.CoreComponents.AddressPubKeyConverter(),
+		AccountsDB:             args.StateComponents.AccountsAdapterAPI(),
+		EsdtDataStorageHandler: args.ProcessComponents.ESDTDataStorageHandlerForAPI(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gasComputer, err := preprocess.NewGasComputation(
+		args.CoreComponents.EconomicsData(),
+		txTypeHandler,
+		args.CoreComponents.EnableEpochsHandler(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return replay.NewBlockReplayer(replay.ArgsBlockReplayer{
+		ShardCoordinator:             args.ProcessComponents.ShardCoordinator(),
+		Store:                        args.DataComponents.StorageService(),
+		Marshaller:                   args.CoreComponents.InternalMarshalizer(),
+		Uint64ByteSliceConverter:     args.CoreComponents.Uint64ByteSliceConverter(),
+		ReceiptsRepository:           args.ProcessComponents.ReceiptsRepository(),
+		LogsFacade:                   logsFacade,
+		TransactionsFeeHandler:       transactionsFeeProcessor,
+		AlteredAccountsProvider:      alteredAccountsProvider,
+		AccountsRepository:           args.StateComponents.AccountsRepository(),
+		ScheduledTxsExecutionHandler: args.ProcessComponents.ScheduledTxsExecutionHandler(),
+		NodesCoordinator:             args.ProcessComponents.NodesCoordinator(),
+		EconomicsData:                args.CoreComponents.EconomicsData(),
+		GasComputer:                  gasComputer,
+		BlockChain:                   args.DataComponents.Blockchain(),
+		DriverFactory:                args.StatusComponents.OutportReplayDriverFactory(),
+	})
+}
+
+func createLogsFacade(args *ApiResolverArgs, abiDecoder logs.ABIDecoder) (factory.LogsFacade, error) {
 	return logs.NewLogsFacade(logs.ArgsNewLogsFacade{
 		StorageService:  args.DataComponents.StorageService(),
 		Marshaller:      args.CoreComponents.InternalMarshalizer(),
 		PubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+		ABIDecoder:      abiDecoder,
+	})
+}
+
+func createABIRegistry(args *ApiResolverArgs) (factory.ABIRegistry, error) {
+	abiRegistryConfig := args.Configs.GeneralConfig.ABIRegistry
+	if !abiRegistryConfig.Enabled {
+		return abiAPI.NewDisabledABIRegistry(), nil
+	}
+
+	directory := abiRegistryConfig.Directory
+	if !filepath.IsAbs(directory) {
+		directory = filepath.Join(args.Configs.FlagsConfig.WorkingDir, directory)
+	}
+
+	return abiAPI.NewABIRegistry(abiAPI.ArgsABIRegistry{
+		Directory:       directory,
+		PubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
 	})
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/guardianMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/mainFactoryMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	outportMocks "github.com/multiversx/mx-chain-go/testscommon/outport"
 	stateMocks "github.com/multiversx/mx-chain-go/testscommon/state"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/require"
@@ -109,6 +110,8 @@ func createMockArgs(t *testing.T) *api.ApiResolverArgs {
 		AllowVMQueriesChan: common.GetClosedUnbufferedChannel(),
 		StatusComponents: &mainFactoryMocks.StatusComponentsStub{
 			ManagedPeersMonitorField: &testscommon.ManagedPeersMonitorStub{},
+			Outport:                  &outportMocks.OutportStub{},
+			ReplayDriverFactory:      &outportMocks.ReplayDriverFactoryStub{},
 		},
 	}
 }
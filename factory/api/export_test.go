@@ -7,6 +7,7 @@ import (
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/smartContract"
 	"github.com/multiversx/mx-chain-go/vm"
 )
 
@@ -46,6 +47,7 @@ func CreateScQueryElement(args SCQueryElementArgs) (process.SCQueryService, comm
 		allowVMQueriesChan:    args.AllowVMQueriesChan,
 		workingDir:            args.WorkingDir,
 		index:                 args.Index,
+		queryCache:            smartContract.NewDisabledSCQueryCache(),
 		guardedAccountHandler: args.GuardedAccountHandler,
 	})
 }
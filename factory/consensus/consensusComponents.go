@@ -2,6 +2,7 @@ package consensus
 
 import (
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -15,6 +16,8 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/blacklist"
 	"github.com/multiversx/mx-chain-go/consensus/chronology"
+	"github.com/multiversx/mx-chain-go/consensus/slashingProtection"
+	slashingProtectionDisabled "github.com/multiversx/mx-chain-go/consensus/slashingProtection/disabled"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/consensus/spos/sposFactory"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
@@ -26,6 +29,9 @@ import (
 	"github.com/multiversx/mx-chain-go/process/sync/storageBootstrap"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state/syncer"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/trie/statistics"
 	"github.com/multiversx/mx-chain-go/update"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -77,6 +83,7 @@ type consensusComponents struct {
 	broadcastMessenger   consensus.BroadcastMessenger
 	worker               factory.ConsensusWorker
 	peerBlacklistHandler consensus.PeerBlacklistHandler
+	slashingProtection   consensus.SlashingProtectionHandler
 	consensusTopic       string
 	consensusGroupSize   int
 }
@@ -229,6 +236,11 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
+	cc.slashingProtection, err = ccf.createSlashingProtectionHandler()
+	if err != nil {
+		return nil, err
+	}
+
 	consensusArgs := &spos.ConsensusCoreArgs{
 		BlockChain:                    ccf.dataComponents.Blockchain(),
 		BlockProcessor:                ccf.processComponents.BlockProcessor(),
@@ -252,6 +264,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		MessageSigningHandler:         p2pSigningHandler,
 		PeerBlacklistHandler:          cc.peerBlacklistHandler,
 		SigningHandler:                ccf.cryptoComponents.ConsensusSigningHandler(),
+		SlashingProtectionHandler:     cc.slashingProtection,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -310,6 +323,12 @@ func (cc *consensusComponents) Close() error {
 	if err != nil {
 		return err
 	}
+	if !check.IfNil(cc.slashingProtection) {
+		err = cc.slashingProtection.Close()
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -665,6 +684,49 @@ func (ccf *consensusComponentsFactory) createPeerBlacklistHandler() (consensus.P
 	return blacklist.NewPeerBlacklist(blacklistArgs)
 }
 
+func (ccf *consensusComponentsFactory) createSlashingProtectionHandler() (consensus.SlashingProtectionHandler, error) {
+	if !ccf.config.SlashingProtection.Enabled || ccf.isInImportMode {
+		return &slashingProtectionDisabled.SlashingProtectionHandler{}, nil
+	}
+	if check.IfNil(ccf.coreComponents.PathHandler()) {
+		return nil, errors.ErrNilPathHandler
+	}
+
+	storer, err := ccf.createSlashingProtectionStorer(ccf.config.SlashingProtection.Storage)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the slashing protection storer", err)
+	}
+
+	argsSlashingProtection := slashingProtection.ArgsSlashingProtection{
+		Storer:     storer,
+		Marshaller: &marshal.JsonMarshalizer{},
+	}
+	handler, err := slashingProtection.NewSlashingProtection(argsSlashingProtection)
+	if err != nil {
+		log.LogIfError(storer.Close())
+		return nil, err
+	}
+
+	return handler, nil
+}
+
+func (ccf *consensusComponentsFactory) createSlashingProtectionStorer(storageConfig config.StorageConfig) (storage.Storer, error) {
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = filepath.Join(ccf.coreComponents.PathHandler().DatabasePath(), storageConfig.DB.FilePath)
+
+	dbConfigHandler := storageFactory.NewDBConfigHandler(storageConfig.DB)
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+}
+
 func (ccf *consensusComponentsFactory) createP2pSigningHandler() (consensus.P2PSigningHandler, error) {
 	p2pSignerArgs := p2pFactory.ArgsMessageVerifier{
 		Marshaller: ccf.coreComponents.InternalMarshalizer(),
@@ -130,6 +130,9 @@ func (mcc *managedConsensusComponents) CheckSubcomponents() error {
 	if check.IfNil(mcc.broadcastMessenger) {
 		return errors.ErrNilBroadcastMessenger
 	}
+	if check.IfNil(mcc.slashingProtection) {
+		return errors.ErrNilSlashingProtectionHandler
+	}
 
 	return nil
 }
@@ -146,6 +149,18 @@ func (mcc *managedConsensusComponents) Bootstrapper() process.Bootstrapper {
 	return mcc.consensusComponents.bootstrapper
 }
 
+// SlashingProtectionHandler returns the slashing protection handler
+func (mcc *managedConsensusComponents) SlashingProtectionHandler() consensus.SlashingProtectionHandler {
+	mcc.mutConsensusComponents.RLock()
+	defer mcc.mutConsensusComponents.RUnlock()
+
+	if mcc.consensusComponents == nil {
+		return nil
+	}
+
+	return mcc.consensusComponents.slashingProtection
+}
+
 // IsInterfaceNil returns true if the underlying object is nil
 func (mcc *managedConsensusComponents) IsInterfaceNil() bool {
 	return mcc == nil
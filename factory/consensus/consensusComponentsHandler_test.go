@@ -62,6 +62,7 @@ func TestManagedConsensusComponents_Create(t *testing.T) {
 		require.Nil(t, managedConsensusComponents.Chronology())
 		require.Nil(t, managedConsensusComponents.ConsensusWorker())
 		require.Nil(t, managedConsensusComponents.Bootstrapper())
+		require.Nil(t, managedConsensusComponents.SlashingProtectionHandler())
 
 		err := managedConsensusComponents.Create()
 		require.NoError(t, err)
@@ -69,6 +70,7 @@ func TestManagedConsensusComponents_Create(t *testing.T) {
 		require.NotNil(t, managedConsensusComponents.Chronology())
 		require.NotNil(t, managedConsensusComponents.ConsensusWorker())
 		require.NotNil(t, managedConsensusComponents.Bootstrapper())
+		require.NotNil(t, managedConsensusComponents.SlashingProtectionHandler())
 
 		require.Equal(t, factory.ConsensusComponentsName, managedConsensusComponents.String())
 	})
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
 	retriever "github.com/multiversx/mx-chain-go/dataRetriever"
 	errorsMx "github.com/multiversx/mx-chain-go/errors"
@@ -941,6 +942,51 @@ func TestConsensusComponentsFactory_Create(t *testing.T) {
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, cc)
 	})
+	t.Run("slashing protection enabled without path handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConsensusComponentsFactoryArgs()
+		args.Config.SlashingProtection = getSlashingProtectionConfig()
+		ccf, _ := consensusComp.NewConsensusComponentsFactory(args)
+		require.NotNil(t, ccf)
+
+		cc, err := ccf.Create()
+		require.Equal(t, errorsMx.ErrNilPathHandler, err)
+		require.Nil(t, cc)
+	})
+	t.Run("invalid slashing protection storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConsensusComponentsFactoryArgs()
+		args.Config.SlashingProtection = getSlashingProtectionConfig()
+		args.Config.SlashingProtection.Storage.DB.Type = "invalid"
+		coreCompStub, ok := args.CoreComponents.(*mock.CoreComponentsMock)
+		require.True(t, ok)
+		coreCompStub.PathHdl = &testscommon.PathManagerStub{}
+		ccf, _ := consensusComp.NewConsensusComponentsFactory(args)
+		require.NotNil(t, ccf)
+
+		cc, err := ccf.Create()
+		require.Error(t, err)
+		require.Nil(t, cc)
+	})
+	t.Run("should work with slashing protection enabled", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConsensusComponentsFactoryArgs()
+		args.Config.SlashingProtection = getSlashingProtectionConfig()
+		coreCompStub, ok := args.CoreComponents.(*mock.CoreComponentsMock)
+		require.True(t, ok)
+		coreCompStub.PathHdl = &testscommon.PathManagerStub{}
+		ccf, _ := consensusComp.NewConsensusComponentsFactory(args)
+		require.NotNil(t, ccf)
+
+		cc, err := ccf.Create()
+		require.NoError(t, err)
+		require.NotNil(t, cc)
+
+		require.Nil(t, cc.Close())
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -954,3 +1000,22 @@ func TestConsensusComponentsFactory_Create(t *testing.T) {
 		require.Nil(t, cc.Close())
 	})
 }
+
+func getSlashingProtectionConfig() config.SlashingProtectionConfig {
+	return config.SlashingProtectionConfig{
+		Enabled: true,
+		Storage: config.StorageConfig{
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 10,
+			},
+			DB: config.DBConfig{
+				FilePath:          "SlashingProtection",
+				Type:              "MemoryDB",
+				BatchDelaySeconds: 1,
+				MaxBatchSize:      1,
+				MaxOpenFiles:      10,
+			},
+		},
+	}
+}
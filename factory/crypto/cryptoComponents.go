@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -26,6 +27,7 @@ import (
 	"github.com/multiversx/mx-chain-go/factory/peerSignatureHandler"
 	"github.com/multiversx/mx-chain-go/genesis/process/disabled"
 	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
 	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
@@ -63,6 +65,7 @@ type cryptoComponentsFactory struct {
 	enableEpochs                         config.EnableEpochs
 	prefsConfig                          config.Preferences
 	validatorPubKeyConverter             core.PubkeyConverter
+	roundHandler                         consensus.RoundHandler
 	activateBLSPubKeyMessageVerification bool
 	keyLoader                            factory.KeyLoaderHandler
 	isInImportMode                       bool
@@ -99,6 +102,8 @@ type cryptoComponents struct {
 	consensusSigningHandler consensus.SigningHandler
 	managedPeersHolder      common.ManagedPeersHolder
 	keysHandler             consensus.KeysHandler
+	managedKeysFileWatcher  factory.Closer
+	remoteSignerClient      remoteSigner.SignerClient
 	cryptoParams
 	p2pCryptoParams
 }
@@ -127,6 +132,7 @@ func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs) (*cryptoCompon
 		config:                               args.Config,
 		prefsConfig:                          args.PrefsConfig,
 		validatorPubKeyConverter:             args.CoreComponentsHolder.ValidatorPubKeyConverter(),
+		roundHandler:                         args.CoreComponentsHolder.RoundHandler(),
 		activateBLSPubKeyMessageVerification: args.ActivateBLSPubKeyMessageVerification,
 		keyLoader:                            args.KeyLoader,
 		isInImportMode:                       args.IsInImportMode,
@@ -146,8 +152,23 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
-	blockSignKeyGen := signing.NewKeyGenerator(suite)
-	cp, err := ccf.createCryptoParams(blockSignKeyGen)
+	remoteSignerClient, err := ccf.createRemoteSignerClient()
+	if err != nil {
+		return nil, err
+	}
+
+	var blockSignKeyGen crypto.KeyGenerator = signing.NewKeyGenerator(suite)
+	var cp *cryptoParams
+	if check.IfNil(remoteSignerClient) {
+		cp, err = ccf.createCryptoParams(blockSignKeyGen)
+	} else {
+		blockSignKeyGen, err = remoteSigner.NewKeyGenerator(blockSignKeyGen)
+		if err != nil {
+			return nil, err
+		}
+
+		cp, err = ccf.createRemoteCryptoParams(blockSignKeyGen, remoteSignerClient)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -171,6 +192,20 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
+	randomSeedSingleSigner := interceptSingleSigner
+	peerSignatureSingleSigner := interceptSingleSigner
+	if !check.IfNil(remoteSignerClient) {
+		signers, errWrap := ccf.wrapSignersWithRemoteSigner(remoteSignerClient, interceptSingleSigner, multiSigner)
+		if errWrap != nil {
+			return nil, errWrap
+		}
+
+		interceptSingleSigner = signers.blockSingleSigner
+		randomSeedSingleSigner = signers.randomSeedSingleSigner
+		peerSignatureSingleSigner = signers.peerSignatureSingleSigner
+		multiSigner = signers.multiSignerContainer
+	}
+
 	var messageSignVerifier vm.MessageSignVerifier
 	if ccf.activateBLSPubKeyMessageVerification {
 		messageSignVerifier, err = systemVM.NewMessageSigVerifier(blockSignKeyGen, processingSingleSigner)
@@ -190,7 +225,7 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
-	peerSigHandler, err := peerSignatureHandler.NewPeerSignatureHandler(cachePkPIDSignature, interceptSingleSigner, blockSignKeyGen)
+	peerSigHandler, err := peerSignatureHandler.NewPeerSignatureHandler(cachePkPIDSignature, peerSignatureSingleSigner, blockSignKeyGen)
 	if err != nil {
 		return nil, err
 	}
@@ -244,6 +279,7 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		MultiSignerContainer: multiSigner,
 		KeyGenerator:         blockSignKeyGen,
 		SingleSigner:         interceptSingleSigner,
+		RandomSeedSigner:     randomSeedSingleSigner,
 		KeysHandler:          keysHandler,
 	}
 	consensusSigningHandler, err := NewSigningHandler(signingHandlerArgs)
@@ -251,6 +287,11 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
+	managedKeysFileWatcher, err := ccf.createManagedKeysFileWatcher(blockSignKeyGen, managedPeersHolder, remoteSignerClient)
+	if err != nil {
+		return nil, err
+	}
+
 	return &cryptoComponents{
 		txSingleSigner:          txSingleSigner,
 		blockSingleSigner:       interceptSingleSigner,
@@ -263,12 +304,94 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		consensusSigningHandler: consensusSigningHandler,
 		managedPeersHolder:      managedPeersHolder,
 		keysHandler:             keysHandler,
+		managedKeysFileWatcher:  managedKeysFileWatcher,
+		remoteSignerClient:      remoteSignerClient,
 		cryptoParams:            *cp,
 		p2pCryptoParams:         *p2pCryptoParamsInstance,
 		p2pSingleSigner:         p2pSingleSigner,
 	}, nil
 }
 
+func (ccf *cryptoComponentsFactory) createRemoteSignerClient() (remoteSigner.SignerClient, error) {
+	if !ccf.config.RemoteSigner.Enabled {
+		return nil, nil
+	}
+	if ccf.isInImportMode {
+		log.Warn("the remote signer is enabled but the node is running in import-db mode, it will not be used")
+		return nil, nil
+	}
+
+	argsClient := remoteSigner.ArgsClient{
+		Address:        ccf.config.RemoteSigner.Address,
+		RequestTimeout: time.Duration(ccf.config.RemoteSigner.RequestTimeoutInSec) * time.Second,
+	}
+
+	return remoteSigner.NewClient(argsClient)
+}
+
+type remoteSigners struct {
+	blockSingleSigner         crypto.SingleSigner
+	randomSeedSingleSigner    crypto.SingleSigner
+	peerSignatureSingleSigner crypto.SingleSigner
+	multiSignerContainer      cryptoCommon.MultiSignerContainer
+}
+
+// wrapSignersWithRemoteSigner wraps the provided signers so the signatures created with the keys held by the
+// remote signer are requested from it. Each type of single signature uses its own signer so the remote signer
+// can apply the slashing protection per signature type
+func (ccf *cryptoComponentsFactory) wrapSignersWithRemoteSigner(
+	signerClient remoteSigner.SignerClient,
+	singleSigner crypto.SingleSigner,
+	multiSignerContainer cryptoCommon.MultiSignerContainer,
+) (*remoteSigners, error) {
+	blockSingleSigner, err := ccf.createRemoteSingleSigner(signerClient, singleSigner, remoteSigner.SignatureType)
+	if err != nil {
+		return nil, err
+	}
+
+	randomSeedSingleSigner, err := ccf.createRemoteSingleSigner(signerClient, singleSigner, remoteSigner.RandomSeedType)
+	if err != nil {
+		return nil, err
+	}
+
+	peerSignatureSingleSigner, err := ccf.createRemoteSingleSigner(signerClient, singleSigner, remoteSigner.PeerSignatureType)
+	if err != nil {
+		return nil, err
+	}
+
+	argsMultiSignerContainer := remoteSigner.ArgsMultiSignerContainer{
+		MultiSignerContainer: multiSignerContainer,
+		SignerClient:         signerClient,
+		RoundHandler:         ccf.roundHandler,
+	}
+	remoteMultiSignerContainer, err := remoteSigner.NewMultiSignerContainer(argsMultiSignerContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &remoteSigners{
+		blockSingleSigner:         blockSingleSigner,
+		randomSeedSingleSigner:    randomSeedSingleSigner,
+		peerSignatureSingleSigner: peerSignatureSingleSigner,
+		multiSignerContainer:      remoteMultiSignerContainer,
+	}, nil
+}
+
+func (ccf *cryptoComponentsFactory) createRemoteSingleSigner(
+	signerClient remoteSigner.SignerClient,
+	singleSigner crypto.SingleSigner,
+	signatureType string,
+) (crypto.SingleSigner, error) {
+	argsSingleSigner := remoteSigner.ArgsSingleSigner{
+		SingleSigner:  singleSigner,
+		SignerClient:  signerClient,
+		RoundHandler:  ccf.roundHandler,
+		SignatureType: signatureType,
+	}
+
+	return remoteSigner.NewSingleSigner(argsSingleSigner)
+}
+
 func (ccf *cryptoComponentsFactory) createSingleSigner(importModeNoSigCheck bool) (crypto.SingleSigner, error) {
 	if importModeNoSigCheck {
 		log.Warn("using disabled single signer because the node is running in import-db 'turbo mode'")
@@ -347,6 +470,51 @@ func (ccf *cryptoComponentsFactory) createCryptoParams(
 	return ccf.generateCryptoParams(keygen, handledKeysInfo, handledPrivateKeys)
 }
 
+// createRemoteCryptoParams uses the public keys held by the remote signer: a single key becomes the node's key while
+// more keys are handled in multi-key mode
+func (ccf *cryptoComponentsFactory) createRemoteCryptoParams(
+	keygen crypto.KeyGenerator,
+	signerClient remoteSigner.SignerClient,
+) (*cryptoParams, error) {
+	publicKeys, err := signerClient.PublicKeys()
+	if err != nil {
+		return nil, err
+	}
+	if len(publicKeys) == 0 {
+		return nil, errors.ErrNoRemoteSignerKeys
+	}
+
+	remoteKeys := make([][]byte, 0, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		log.Debug("loaded remote signer key", "public key", ccf.validatorPubKeyConverter.SilentEncode(publicKey, log))
+		remoteKeys = append(remoteKeys, remoteSigner.NewRemotePrivateKeyBytes(publicKey))
+	}
+
+	if len(remoteKeys) > 1 {
+		reason := fmt.Sprintf("using the remote signer and is running in multi-key mode, managing %d keys", len(remoteKeys))
+		return ccf.generateCryptoParams(keygen, reason, remoteKeys)
+	}
+
+	cp := &cryptoParams{
+		handledPrivateKeys: make([][]byte, 0),
+		publicKeyBytes:     publicKeys[0],
+	}
+	cp.privateKey, err = keygen.PrivateKeyFromByteArray(remoteKeys[0])
+	if err != nil {
+		return nil, err
+	}
+
+	cp.publicKey = cp.privateKey.GeneratePublic()
+	cp.publicKeyString, err = ccf.validatorPubKeyConverter.Encode(cp.publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("the node is using the remote signer and is running in single-key mode")
+
+	return cp, nil
+}
+
 func (ccf *cryptoComponentsFactory) readCryptoParams(keygen crypto.KeyGenerator) (*cryptoParams, error) {
 	cp := &cryptoParams{}
 	sk, readPk, err := ccf.getSkPk()
@@ -525,7 +693,48 @@ func (ccf *cryptoComponentsFactory) processPrivatePublicKey(keygen crypto.KeyGen
 	return skBytes, nil
 }
 
+func (ccf *cryptoComponentsFactory) createManagedKeysFileWatcher(
+	keygen crypto.KeyGenerator,
+	managedPeersHolder common.ManagedPeersHolder,
+	remoteSignerClient remoteSigner.SignerClient,
+) (factory.Closer, error) {
+	if !ccf.config.ManagedKeys.FileWatcherEnabled {
+		return nil, nil
+	}
+	// the keys are held by the remote signer, the local PEM file must not be read
+	if !check.IfNil(remoteSignerClient) {
+		log.Warn("the managed keys file watcher is enabled but the keys are held by the remote signer, it will not be started")
+		return nil, nil
+	}
+	if !managedPeersHolder.IsMultiKeyMode() {
+		log.Warn("the managed keys file watcher is enabled but the node is not running in multi-key mode, it will not be started")
+		return nil, nil
+	}
+
+	argsWatcher := keysManagement.ArgsManagedKeysFileWatcher{
+		FilePath:           ccf.allValidatorKeysPemFileName,
+		KeysLoader:         ccf.keyLoader,
+		KeyGenerator:       keygen,
+		ManagedPeersHolder: managedPeersHolder,
+		PubKeyConverter:    ccf.validatorPubKeyConverter,
+		PollingInterval:    time.Duration(ccf.config.ManagedKeys.FileWatcherPollingIntervalInSec) * time.Second,
+	}
+
+	return keysManagement.NewManagedKeysFileWatcher(argsWatcher)
+}
+
 // Close closes all underlying components that need closing
 func (cc *cryptoComponents) Close() error {
-	return nil
+	var lastError error
+	if cc.managedKeysFileWatcher != nil {
+		lastError = cc.managedKeysFileWatcher.Close()
+	}
+	if !check.IfNil(cc.remoteSignerClient) {
+		err := cc.remoteSignerClient.Close()
+		if err != nil {
+			lastError = err
+		}
+	}
+
+	return lastError
 }
@@ -1,19 +1,28 @@
 package crypto_test
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
+	"net"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
 	"github.com/multiversx/mx-chain-go/config"
 	errErd "github.com/multiversx/mx-chain-go/errors"
 	cryptoComp "github.com/multiversx/mx-chain-go/factory/crypto"
 	"github.com/multiversx/mx-chain-go/factory/mock"
 	integrationTestsMock "github.com/multiversx/mx-chain-go/integrationTests/mock"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/storage/database"
 	componentsMock "github.com/multiversx/mx-chain-go/testscommon/components"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 )
 
 func TestNewCryptoComponentsFactory_NilCoreComponentsHandlerShouldErr(t *testing.T) {
@@ -515,6 +524,34 @@ func TestCryptoComponentsFactory_MultiKey(t *testing.T) {
 		skBytes, _, _ := ccf.GetSkPk()
 		assert.NotEqual(t, skBytes, privateKeys[0]) // should generate another private key and not use the one loaded with LoadKey call
 	})
+	t.Run("should work with the managed keys file watcher enabled", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.Config.ManagedKeys = config.ManagedKeysConfig{
+			FileWatcherEnabled:              true,
+			FileWatcherPollingIntervalInSec: 1,
+		}
+		args.AllValidatorKeysPemFileName = "allValidatorsKeys.pem"
+
+		privateKeys, publicKeys := createBLSPrivatePublicKeys()
+
+		args.KeyLoader = &mock.KeyLoaderStub{
+			LoadKeyCalled: func(relativePath string, skIndex int) ([]byte, string, error) {
+				return privateKeys[0], publicKeys[0], nil
+			},
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				return privateKeys[1:], publicKeys[1:], nil
+			},
+		}
+
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+		cc, err := ccf.Create()
+		require.Nil(t, err)
+		assert.NotNil(t, cc.GetManagedKeysFileWatcher())
+		assert.Nil(t, cc.Close())
+	})
 }
 
 func createBLSPrivatePublicKeys() ([][]byte, []string) {
@@ -536,3 +573,164 @@ func createBLSPrivatePublicKeys() ([][]byte, []string) {
 
 	return privateKeys, publicKeys
 }
+
+type remoteSignerWithoutKeys struct {
+	remoteSigner.UnimplementedRemoteSignerServer
+}
+
+// PublicKeys -
+func (signer *remoteSignerWithoutKeys) PublicKeys(_ context.Context, _ *remoteSigner.PublicKeysRequest) (*remoteSigner.PublicKeysResponse, error) {
+	return &remoteSigner.PublicKeysResponse{}, nil
+}
+
+func TestCryptoComponentsFactory_RemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	createArgs := func(address string) cryptoComp.CryptoComponentsFactoryArgs {
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.Config.RemoteSigner = config.RemoteSignerConfig{
+			Enabled:             true,
+			Address:             address,
+			RequestTimeoutInSec: 10,
+		}
+		args.KeyLoader = &mock.KeyLoaderStub{
+			LoadKeyCalled: func(relativePath string, skIndex int) ([]byte, string, error) {
+				assert.Fail(t, "should have not loaded the validator key")
+				return nil, "", nil
+			},
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				assert.Fail(t, "should have not loaded the validator keys")
+				return nil, nil, nil
+			},
+		}
+
+		return args
+	}
+	// the Unix socket path length is limited, t.TempDir might be too long
+	createListener := func() (net.Listener, string) {
+		dir, err := os.MkdirTemp("", "signer")
+		require.Nil(t, err)
+		t.Cleanup(func() {
+			_ = os.RemoveAll(dir)
+		})
+
+		socketPath := filepath.Join(dir, "signer.sock")
+		listener, err := net.Listen("unix", socketPath)
+		require.Nil(t, err)
+
+		return listener, "unix://" + socketPath
+	}
+	createSigner := func(numKeys int, roundHandler remoteSigner.RoundHandler) (string, []string) {
+		privateKeys, publicKeys := createBLSPrivatePublicKeys()
+		skBytes := make([][]byte, 0, numKeys)
+		for i := 0; i < numKeys; i++ {
+			sk, _ := hex.DecodeString(string(privateKeys[i]))
+			skBytes = append(skBytes, sk)
+		}
+
+		listener, address := createListener()
+		slashingProtector, _ := remoteSigner.NewSlashingProtector(database.NewMemDB())
+		server, err := remoteSigner.NewSignerServer(remoteSigner.ArgsSignerServer{
+			KeyGenerator:      signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+			SingleSigner:      &mclSig.BlsSingleSigner{},
+			SlashingProtector: slashingProtector,
+			RoundHandler:      roundHandler,
+			MaxRoundsDrift:    1,
+			PrivateKeys:       skBytes,
+			Listener:          listener,
+		})
+		require.Nil(t, err)
+		t.Cleanup(func() {
+			_ = server.Close()
+		})
+
+		return address, publicKeys[:numKeys]
+	}
+
+	t.Run("unreachable remote signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(createArgs("unix:///missing/signer.sock"))
+		cc, err := ccf.Create()
+		assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigner))
+		assert.Nil(t, cc)
+	})
+	t.Run("http remote signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(createArgs("http://127.0.0.1:8080"))
+		cc, err := ccf.Create()
+		assert.True(t, errors.Is(err, remoteSigner.ErrUnsupportedAddress))
+		assert.Nil(t, cc)
+	})
+	t.Run("remote signer without keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		listener, address := createListener()
+		server := grpc.NewServer(grpc.ForceServerCodec(remoteSigner.NewCodec()))
+		remoteSigner.RegisterRemoteSignerServer(server, &remoteSignerWithoutKeys{})
+		go func() {
+			_ = server.Serve(listener)
+		}()
+		defer server.Stop()
+
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(createArgs(address))
+		cc, err := ccf.Create()
+		assert.Equal(t, errErd.ErrNoRemoteSignerKeys, err)
+		assert.Nil(t, cc)
+	})
+	t.Run("single remote key should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs("")
+		address, publicKeys := createSigner(1, args.CoreComponentsHolder.RoundHandler())
+		args.Config.RemoteSigner.Address = address
+
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+		cc, err := ccf.Create()
+		require.Nil(t, err)
+		defer func() {
+			_ = cc.Close()
+		}()
+		assert.Equal(t, publicKeys[0], cc.GetPublicKeyString())
+		assert.False(t, cc.GetManagedPeersHolder().IsMultiKeyMode())
+
+		pkBytes, _ := hex.DecodeString(publicKeys[0])
+		signingHandler := cc.GetConsensusSigningHandler()
+		signature, err := signingHandler.CreateSignatureForPublicKey([]byte("message"), pkBytes)
+		require.Nil(t, err)
+		assert.Nil(t, signingHandler.VerifySingleSignature(pkBytes, []byte("message"), signature))
+
+		signatureShare, err := signingHandler.CreateSignatureShareForPublicKey([]byte("header hash"), 0, 0, pkBytes)
+		require.Nil(t, err)
+		// the BLS signature shares are plain BLS signatures
+		assert.Nil(t, signingHandler.VerifySingleSignature(pkBytes, []byte("header hash"), signatureShare))
+
+		// a different header in the same round is refused by the remote signer
+		_, err = signingHandler.CreateSignatureShareForPublicKey([]byte("other header hash"), 0, 0, pkBytes)
+		assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigner))
+	})
+	t.Run("multiple remote keys should work without the managed keys file watcher", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs("")
+		args.Config.ManagedKeys = config.ManagedKeysConfig{
+			FileWatcherEnabled:              true,
+			FileWatcherPollingIntervalInSec: 1,
+		}
+		args.AllValidatorKeysPemFileName = "allValidatorsKeys.pem"
+		address, publicKeys := createSigner(3, args.CoreComponentsHolder.RoundHandler())
+		args.Config.RemoteSigner.Address = address
+
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+		cc, err := ccf.Create()
+		require.Nil(t, err)
+		assert.True(t, cc.GetManagedPeersHolder().IsMultiKeyMode())
+		assert.Equal(t, len(publicKeys), len(cc.GetManagedPeersHolder().GetManagedKeysByCurrentNode()))
+		assert.NotContains(t, publicKeys, cc.GetPublicKeyString())
+		// the keys are held by the remote signer, the local PEM file is not watched
+		assert.Nil(t, cc.GetManagedKeysFileWatcher())
+		assert.Nil(t, cc.Close())
+	})
+}
@@ -40,3 +40,6 @@ var ErrNilMessage = errors.New("message to be signed or to be verified is nil")
 
 // ErrBitmapMismatch is raised when an invalid bitmap is passed to the multisigner
 var ErrBitmapMismatch = errors.New("multi signer reported a mismatch in used bitmap")
+
+// ErrKeyNotHandledByCurrentNode signals that the provided public key is not handled by the current node
+var ErrKeyNotHandledByCurrentNode = errors.New("key is not handled by the current node")
@@ -4,6 +4,8 @@ import (
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
 	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/factory"
 )
 
 // GetSkPk -
@@ -43,3 +45,18 @@ func (ccf *cryptoComponentsFactory) GetSuite() (crypto.Suite, error) {
 func (cc *cryptoComponents) GetManagedPeersHolder() common.ManagedPeersHolder {
 	return cc.managedPeersHolder
 }
+
+// GetManagedKeysFileWatcher -
+func (cc *cryptoComponents) GetManagedKeysFileWatcher() factory.Closer {
+	return cc.managedKeysFileWatcher
+}
+
+// GetPublicKeyString -
+func (cc *cryptoComponents) GetPublicKeyString() string {
+	return cc.publicKeyString
+}
+
+// GetConsensusSigningHandler -
+func (cc *cryptoComponents) GetConsensusSigningHandler() consensus.SigningHandler {
+	return cc.consensusSigningHandler
+}
@@ -1,6 +1,8 @@
 package crypto
 
 import (
+	"encoding/hex"
+	"fmt"
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -14,6 +16,7 @@ type ArgsSigningHandler struct {
 	PubKeys              []string
 	MultiSignerContainer cryptoCommon.MultiSignerContainer
 	SingleSigner         crypto.SingleSigner
+	RandomSeedSigner     crypto.SingleSigner
 	KeyGenerator         crypto.KeyGenerator
 	KeysHandler          consensus.KeysHandler
 }
@@ -29,6 +32,7 @@ type signingHandler struct {
 	mutSigningData       sync.RWMutex
 	multiSignerContainer cryptoCommon.MultiSignerContainer
 	singleSigner         crypto.SingleSigner
+	randomSeedSigner     crypto.SingleSigner
 	keyGen               crypto.KeyGenerator
 	keysHandler          consensus.KeysHandler
 }
@@ -58,6 +62,7 @@ func NewSigningHandler(args ArgsSigningHandler) (*signingHandler, error) {
 		mutSigningData:       sync.RWMutex{},
 		multiSignerContainer: args.MultiSignerContainer,
 		singleSigner:         args.SingleSigner,
+		randomSeedSigner:     args.RandomSeedSigner,
 		keyGen:               args.KeyGenerator,
 		keysHandler:          args.KeysHandler,
 	}, nil
@@ -70,6 +75,9 @@ func checkArgs(args ArgsSigningHandler) error {
 	if check.IfNil(args.SingleSigner) {
 		return ErrNilSingleSigner
 	}
+	if check.IfNil(args.RandomSeedSigner) {
+		return fmt.Errorf("%w for RandomSeedSigner", ErrNilSingleSigner)
+	}
 	if check.IfNil(args.KeysHandler) {
 		return ErrNilKeysHandler
 	}
@@ -90,6 +98,7 @@ func (sh *signingHandler) Create(pubKeys []string) (*signingHandler, error) {
 		KeysHandler:          sh.keysHandler,
 		MultiSignerContainer: sh.multiSignerContainer,
 		SingleSigner:         sh.singleSigner,
+		RandomSeedSigner:     sh.randomSeedSigner,
 		KeyGenerator:         sh.keyGen,
 	}
 	return NewSigningHandler(args)
@@ -128,7 +137,10 @@ func (sh *signingHandler) CreateSignatureShareForPublicKey(message []byte, index
 		return nil, ErrNilMessage
 	}
 
-	privateKey := sh.keysHandler.GetHandledPrivateKey(publicKeyBytes)
+	privateKey, err := sh.getHandledPrivateKey(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
 	privateKeyBytes, err := privateKey.ToByteArray()
 	if err != nil {
 		return nil, err
@@ -155,11 +167,37 @@ func (sh *signingHandler) CreateSignatureShareForPublicKey(message []byte, index
 // CreateSignatureForPublicKey returns a signature over a message using the managed private key that was selected based on the provided
 // publicKeyBytes argument
 func (sh *signingHandler) CreateSignatureForPublicKey(message []byte, publicKeyBytes []byte) ([]byte, error) {
-	privateKey := sh.keysHandler.GetHandledPrivateKey(publicKeyBytes)
+	privateKey, err := sh.getHandledPrivateKey(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
 
 	return sh.singleSigner.Sign(privateKey, message)
 }
 
+// CreateRandomSeedSignatureForPublicKey returns the signature over the previous randomness seed, using the managed
+// private key that was selected based on the provided publicKeyBytes argument
+func (sh *signingHandler) CreateRandomSeedSignatureForPublicKey(prevRandSeed []byte, publicKeyBytes []byte) ([]byte, error) {
+	privateKey, err := sh.getHandledPrivateKey(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return sh.randomSeedSigner.Sign(privateKey, prevRandSeed)
+}
+
+// getHandledPrivateKey returns the private key of the provided public key, if the key is still handled by the current
+// node. A managed key can be removed at runtime, in which case the node's original key must not be used instead
+func (sh *signingHandler) getHandledPrivateKey(publicKeyBytes []byte) (crypto.PrivateKey, error) {
+	isHandled := sh.keysHandler.IsOriginalPublicKeyOfTheNode(publicKeyBytes) ||
+		sh.keysHandler.IsKeyManagedByCurrentNode(publicKeyBytes)
+	if !isHandled {
+		return nil, fmt.Errorf("%w, public key %s", ErrKeyNotHandledByCurrentNode, hex.EncodeToString(publicKeyBytes))
+	}
+
+	return sh.keysHandler.GetHandledPrivateKey(publicKeyBytes), nil
+}
+
 // VerifySingleSignature returns an error if the public key bytes & message provided doesn't match with the signature
 func (sh *signingHandler) VerifySingleSignature(publicKeyBytes []byte, message []byte, signature []byte) error {
 	pk, err := sh.keyGen.PublicKeyFromByteArray(publicKeyBytes)
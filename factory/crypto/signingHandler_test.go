@@ -20,6 +20,7 @@ func createMockArgsSigningHandler() cryptoFactory.ArgsSigningHandler {
 		MultiSignerContainer: &cryptoMocks.MultiSignerContainerMock{},
 		KeyGenerator:         &cryptoMocks.KeyGenStub{},
 		SingleSigner:         &cryptoMocks.SingleSignerStub{},
+		RandomSeedSigner:     &cryptoMocks.SingleSignerStub{},
 	}
 }
 
@@ -46,6 +47,16 @@ func TestNewSigningHandler(t *testing.T) {
 		require.Nil(t, signer)
 		require.Equal(t, cryptoFactory.ErrNilSingleSigner, err)
 	})
+	t.Run("nil random seed signer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSigningHandler()
+		args.RandomSeedSigner = nil
+
+		signer, err := cryptoFactory.NewSigningHandler(args)
+		require.Nil(t, signer)
+		require.True(t, errors.Is(err, cryptoFactory.ErrNilSingleSigner))
+	})
 	t.Run("nil key generator", func(t *testing.T) {
 		t.Parallel()
 
@@ -166,6 +177,28 @@ func TestSigningHandler_CreateSignatureShareForPublicKey(t *testing.T) {
 		require.Nil(t, sigShare)
 		require.Equal(t, cryptoFactory.ErrNilMessage, err)
 	})
+	t.Run("key not handled by the current node should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSigningHandler()
+		args.KeysHandler = &testscommon.KeysHandlerStub{
+			IsOriginalPublicKeyOfTheNodeCalled: func(pkBytes []byte) bool {
+				return false
+			},
+			IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+				return false
+			},
+			GetHandledPrivateKeyCalled: func(pkBytes []byte) crypto.PrivateKey {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+
+		signer, _ := cryptoFactory.NewSigningHandler(args)
+		sigShare, err := signer.CreateSignatureShareForPublicKey([]byte("message"), selfIndex, epoch, pkBytes)
+		require.Nil(t, sigShare)
+		require.True(t, errors.Is(err, cryptoFactory.ErrKeyNotHandledByCurrentNode))
+	})
 	t.Run("create sig share failed", func(t *testing.T) {
 		t.Parallel()
 
@@ -645,9 +678,65 @@ func TestSigningHandler_CreateSignatureForPublicKey(t *testing.T) {
 	sigShare, err := signer.CreateSignatureForPublicKey([]byte("msg1"), pkBytes)
 	require.Nil(t, err)
 	require.Equal(t, expectedSigShare, sigShare)
+
+	args.KeysHandler = &testscommon.KeysHandlerStub{
+		IsOriginalPublicKeyOfTheNodeCalled: func(pkBytes []byte) bool {
+			return false
+		},
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			return false
+		},
+	}
+	signer, _ = cryptoFactory.NewSigningHandler(args)
+	sigShare, err = signer.CreateSignatureForPublicKey([]byte("msg1"), pkBytes)
+	require.Nil(t, sigShare)
+	require.True(t, errors.Is(err, cryptoFactory.ErrKeyNotHandledByCurrentNode))
 	assert.True(t, getHandledPrivateKeyCalled)
 }
 
+func TestSigningHandler_CreateRandomSeedSignatureForPublicKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSigningHandler()
+	pkBytes := []byte("public key bytes")
+	prevRandSeed := []byte("prev rand seed")
+	expectedSig := []byte("random seed signature")
+	args.KeysHandler = &testscommon.KeysHandlerStub{
+		GetHandledPrivateKeyCalled: func(providedPkBytes []byte) crypto.PrivateKey {
+			assert.Equal(t, pkBytes, providedPkBytes)
+
+			return &cryptoMocks.PrivateKeyStub{}
+		},
+	}
+	args.SingleSigner = &cryptoMocks.SingleSignerStub{
+		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			assert.Fail(t, "should have used the random seed signer")
+			return nil, nil
+		},
+	}
+	args.RandomSeedSigner = &cryptoMocks.SingleSignerStub{
+		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			assert.Equal(t, prevRandSeed, msg)
+			return expectedSig, nil
+		},
+	}
+
+	signer, _ := cryptoFactory.NewSigningHandler(args)
+	sig, err := signer.CreateRandomSeedSignatureForPublicKey(prevRandSeed, pkBytes)
+	require.Nil(t, err)
+	require.Equal(t, expectedSig, sig)
+
+	args.KeysHandler = &testscommon.KeysHandlerStub{
+		IsOriginalPublicKeyOfTheNodeCalled: func(pkBytes []byte) bool {
+			return false
+		},
+	}
+	signer, _ = cryptoFactory.NewSigningHandler(args)
+	sig, err = signer.CreateRandomSeedSignatureForPublicKey(prevRandSeed, pkBytes)
+	require.Nil(t, sig)
+	require.True(t, errors.Is(err, cryptoFactory.ErrKeyNotHandledByCurrentNode))
+}
+
 func TestSigningHandler_VerifySingleSignature(t *testing.T) {
 	t.Parallel()
 
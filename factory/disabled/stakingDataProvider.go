@@ -28,6 +28,19 @@ func (s *stakingDataProvider) GetOwnersData() map[string]*epochStart.OwnerData {
 	return nil
 }
 
+// GetBlsKeyOwner returns an empty string
+func (s *stakingDataProvider) GetBlsKeyOwner(_ []byte) (string, error) {
+	return "", nil
+}
+
+// SetOwnersDataOverride does nothing
+func (s *stakingDataProvider) SetOwnersDataOverride(_ map[string]*epochStart.OwnerData) {
+}
+
+// ResetOwnersDataOverride does nothing
+func (s *stakingDataProvider) ResetOwnersDataOverride() {
+}
+
 // Clean does nothing
 func (s *stakingDataProvider) Clean() {
 }
@@ -245,6 +245,8 @@ type NetworkComponentsHolder interface {
 	OutputAntiFloodHandler() P2PAntifloodHandler
 	PubKeyCacher() process.TimeCacher
 	PeerBlackListHandler() process.PeerBlackListCacher
+	AntifloodDashboard() process.AntifloodDashboardHandler
+	PeerReputationHandler() process.PeerReputationHandler
 	PeerHonestyHandler() PeerHonestyHandler
 	PreferredPeersHolderHandler() PreferredPeersHolderHandler
 	PeersRatingHandler() p2p.PeersRatingHandler
@@ -342,6 +344,7 @@ type StateComponentsHolder interface {
 // StatusComponentsHolder holds the status components
 type StatusComponentsHolder interface {
 	OutportHandler() outport.OutportHandler
+	OutportReplayDriverFactory() outport.ReplayDriverFactory
 	SoftwareVersionChecker() statistics.SoftwareVersionChecker
 	ManagedPeersMonitor() common.ManagedPeersMonitor
 	IsInterfaceNil() bool
@@ -423,6 +426,7 @@ type ConsensusComponentsHolder interface {
 	BroadcastMessenger() consensus.BroadcastMessenger
 	ConsensusGroupSize() (int, error)
 	Bootstrapper() process.Bootstrapper
+	SlashingProtectionHandler() consensus.SlashingProtectionHandler
 	IsInterfaceNil() bool
 }
 
@@ -500,6 +504,17 @@ type EconomicsHandler interface {
 type LogsFacade interface {
 	GetLog(logKey []byte, epoch uint32) (*transaction.ApiLogs, error)
 	IncludeLogsInTransactions(txs []*transaction.ApiTransactionResult, logsKeys [][]byte, epoch uint32) error
+	DecodeLogEvents(logs *transaction.ApiLogs) []*common.ABIDecodedEvent
+	IsInterfaceNil() bool
+}
+
+// ABIRegistry defines the interface of a component holding the contracts ABIs, used to decode the contracts data
+type ABIRegistry interface {
+	RegisterABI(address string, abiJSON []byte) error
+	GetRegisteredAddresses() []string
+	DecodeArguments(address string, function string, args [][]byte) ([]*common.ABIDecodedValue, error)
+	DecodeReturnData(address string, function string, returnData [][]byte) ([]*common.ABIDecodedValue, error)
+	DecodeEvent(event *transaction.Events) (*common.ABIDecodedEvent, error)
 	IsInterfaceNil() bool
 }
 
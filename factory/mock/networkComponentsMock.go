@@ -12,6 +12,8 @@ type NetworkComponentsMock struct {
 	InputAntiFlood                   factory.P2PAntifloodHandler
 	OutputAntiFlood                  factory.P2PAntifloodHandler
 	PeerBlackList                    process.PeerBlackListCacher
+	AntifloodDashboardField          process.AntifloodDashboardHandler
+	PeerReputationHandlerField       process.PeerReputationHandler
 	PreferredPeersHolder             factory.PreferredPeersHolderHandler
 	PeersRatingHandlerField          p2p.PeersRatingHandler
 	PeersRatingMonitorField          p2p.PeersRatingMonitor
@@ -64,6 +66,16 @@ func (ncm *NetworkComponentsMock) PeerBlackListHandler() process.PeerBlackListCa
 	return ncm.PeerBlackList
 }
 
+// AntifloodDashboard -
+func (ncm *NetworkComponentsMock) AntifloodDashboard() process.AntifloodDashboardHandler {
+	return ncm.AntifloodDashboardField
+}
+
+// PeerReputationHandler -
+func (ncm *NetworkComponentsMock) PeerReputationHandler() process.PeerReputationHandler {
+	return ncm.PeerReputationHandlerField
+}
+
 // PreferredPeersHolderHandler -
 func (ncm *NetworkComponentsMock) PreferredPeersHolderHandler() factory.PreferredPeersHolderHandler {
 	return ncm.PreferredPeersHolder
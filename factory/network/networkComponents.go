@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -21,7 +22,10 @@ import (
 	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/rating/peerHonesty"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/blackList"
+	antifloodDisabled "github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
 	antifloodFactory "github.com/multiversx/mx-chain-go/process/throttle/antiflood/factory"
+	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
@@ -42,6 +46,7 @@ type NetworkComponentsFactoryArgs struct {
 	NodeOperationMode     common.NodeOperation
 	ConnectionWatcherType string
 	CryptoComponents      factory.CryptoComponentsHolder
+	PathManager           storage.PathManagerHandler
 }
 
 type networkComponentsFactory struct {
@@ -57,6 +62,7 @@ type networkComponentsFactory struct {
 	nodeOperationMode     common.NodeOperation
 	connectionWatcherType string
 	cryptoComponents      factory.CryptoComponentsHolder
+	pathManager           storage.PathManagerHandler
 }
 
 type networkComponentsHolder struct {
@@ -64,6 +70,11 @@ type networkComponentsHolder struct {
 	preferredPeersHolder p2p.PreferredPeersHolderHandler
 }
 
+type peersRatingComponents struct {
+	peersRatingHandler process.PeersRatingTracker
+	peersRatingMonitor p2p.PeersRatingMonitor
+}
+
 // networkComponents struct holds the network components
 type networkComponents struct {
 	mainNetworkHolder        networkComponentsHolder
@@ -76,6 +87,8 @@ type networkComponents struct {
 	topicFloodPreventer      process.TopicFloodPreventer
 	floodPreventers          []process.FloodPreventer
 	peerBlackListHandler     process.PeerBlackListCacher
+	antifloodDashboard       process.AntifloodDashboardHandler
+	peerReputationHandler    process.PeerReputationHandler
 	antifloodConfig          config.AntifloodConfig
 	peerHonestyHandler       consensus.PeerHonestyHandler
 	closeFunc                context.CancelFunc
@@ -102,6 +115,9 @@ func NewNetworkComponentsFactory(
 	if args.NodeOperationMode != common.NormalOperation && args.NodeOperationMode != common.FullArchiveMode {
 		return nil, errors.ErrInvalidNodeOperationMode
 	}
+	if check.IfNil(args.PathManager) {
+		return nil, errors.ErrNilPathHandler
+	}
 
 	return &networkComponentsFactory{
 		mainP2PConfig:         args.MainP2pConfig,
@@ -116,22 +132,23 @@ func NewNetworkComponentsFactory(
 		nodeOperationMode:     args.NodeOperationMode,
 		connectionWatcherType: args.ConnectionWatcherType,
 		cryptoComponents:      args.CryptoComponents,
+		pathManager:           args.PathManager,
 	}, nil
 }
 
 // Create creates and returns the network components
 func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
-	peersRatingHandler, peersRatingMonitor, err := ncf.createPeersRatingComponents()
+	ratingComponents, err := ncf.createPeersRatingComponents()
 	if err != nil {
 		return nil, err
 	}
 
-	mainNetworkComp, err := ncf.createMainNetworkHolder(peersRatingHandler)
+	mainNetworkComp, err := ncf.createMainNetworkHolder(ratingComponents.peersRatingHandler)
 	if err != nil {
 		return nil, fmt.Errorf("%w for the main network holder", err)
 	}
 
-	fullArchiveNetworkComp, err := ncf.createFullArchiveNetworkHolder(peersRatingHandler)
+	fullArchiveNetworkComp, err := ncf.createFullArchiveNetworkHolder(ratingComponents.peersRatingHandler)
 	if err != nil {
 		return nil, fmt.Errorf("%w for the full archive network holder", err)
 	}
@@ -148,6 +165,16 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		return nil, err
 	}
 
+	peerReputationHandler, err := ncf.createPeerReputationHandler(ctx, antiFloodComponents.BlacklistHandler, ratingComponents)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			log.LogIfError(peerReputationHandler.Close())
+		}
+	}()
+
 	err = mainNetworkComp.netMessenger.Bootstrap()
 	if err != nil {
 		return nil, err
@@ -163,14 +190,16 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 	return &networkComponents{
 		mainNetworkHolder:        mainNetworkComp,
 		fullArchiveNetworkHolder: fullArchiveNetworkComp,
-		peersRatingHandler:       peersRatingHandler,
-		peersRatingMonitor:       peersRatingMonitor,
+		peersRatingHandler:       ratingComponents.peersRatingHandler,
+		peersRatingMonitor:       ratingComponents.peersRatingMonitor,
 		inputAntifloodHandler:    inputAntifloodHandler,
 		outputAntifloodHandler:   outputAntifloodHandler,
 		pubKeyTimeCacher:         antiFloodComponents.PubKeysCacher,
 		topicFloodPreventer:      antiFloodComponents.TopicPreventer,
 		floodPreventers:          antiFloodComponents.FloodPreventers,
 		peerBlackListHandler:     antiFloodComponents.BlacklistHandler,
+		antifloodDashboard:       antiFloodComponents.Dashboard,
+		peerReputationHandler:    peerReputationHandler,
 		antifloodConfig:          ncf.mainConfig.Antiflood,
 		peerHonestyHandler:       peerHonestyHandler,
 		closeFunc:                cancelFunc,
@@ -291,15 +320,15 @@ func (ncf *networkComponentsFactory) createFullArchiveNetworkHolder(peersRatingH
 	return ncf.createNetworkHolder(ncf.fullArchiveP2PConfig, loggerInstance, peersRatingHandler, p2p.FullArchiveNetwork)
 }
 
-func (ncf *networkComponentsFactory) createPeersRatingComponents() (p2p.PeersRatingHandler, p2p.PeersRatingMonitor, error) {
+func (ncf *networkComponentsFactory) createPeersRatingComponents() (*peersRatingComponents, error) {
 	peersRatingCfg := ncf.mainConfig.PeersRatingConfig
 	topRatedCache, err := cache.NewLRUCache(peersRatingCfg.TopRatedCacheCapacity)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	badRatedCache, err := cache.NewLRUCache(peersRatingCfg.BadRatedCacheCapacity)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	peersRatingLogger := logger.GetOrCreate("peersRating")
@@ -310,7 +339,18 @@ func (ncf *networkComponentsFactory) createPeersRatingComponents() (p2p.PeersRat
 	}
 	peersRatingHandler, err := p2pFactory.NewPeersRatingHandler(argsPeersRatingHandler)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	// all the ratings changes go through the tracker, so the ratings can be persisted and imported safely
+	argsPeersRatingTracker := blackList.ArgsPeersRatingTracker{
+		PeersRatingHandler: peersRatingHandler,
+		TopRatedCache:      topRatedCache,
+		BadRatedCache:      badRatedCache,
+	}
+	peersRatingTracker, err := blackList.NewPeersRatingTracker(argsPeersRatingTracker)
+	if err != nil {
+		return nil, err
 	}
 
 	argsPeersRatingMonitor := p2pFactory.ArgPeersRatingMonitor{
@@ -319,10 +359,91 @@ func (ncf *networkComponentsFactory) createPeersRatingComponents() (p2p.PeersRat
 	}
 	peersRatingMonitor, err := p2pFactory.NewPeersRatingMonitor(argsPeersRatingMonitor)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	return &peersRatingComponents{
+		peersRatingHandler: peersRatingTracker,
+		peersRatingMonitor: peersRatingMonitor,
+	}, nil
+}
+
+func (ncf *networkComponentsFactory) createPeerReputationHandler(
+	ctx context.Context,
+	blackListHandler process.PeerBlackListManager,
+	ratingComponents *peersRatingComponents,
+) (process.PeerReputationHandler, error) {
+	peerReputationConfig := ncf.mainConfig.PeerReputation
+	if !peerReputationConfig.Enabled {
+		return &antifloodDisabled.PeerReputationHandler{}, nil
+	}
+	if peerReputationConfig.SaveIntervalInSec == 0 {
+		return nil, fmt.Errorf("%w, SaveIntervalInSec should not be 0", errors.ErrInvalidPeerReputationConfig)
+	}
+
+	storer, err := ncf.createPeerReputationStorer(peerReputationConfig.Storage)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the peer reputation storer", err)
+	}
+
+	argsPeerReputationStorer := blackList.ArgsPeerReputationStorer{
+		Storer:             storer,
+		Marshaller:         &marshal.JsonMarshalizer{},
+		BlackListHandler:   blackListHandler,
+		PeersRatingTracker: ratingComponents.peersRatingHandler,
+		RatingsExpiry:      time.Duration(peerReputationConfig.RatingsExpiryInSec) * time.Second,
+	}
+	peerReputationStorer, err := blackList.NewPeerReputationStorer(argsPeerReputationStorer)
+	if err != nil {
+		log.LogIfError(storer.Close())
+		return nil, err
+	}
+
+	// a corrupted persisted state should not prevent the node from starting
+	err = peerReputationStorer.Load()
+	if err != nil {
+		log.Warn("could not load the persisted peer reputation", "error", err)
+	}
+
+	saveInterval := time.Duration(peerReputationConfig.SaveIntervalInSec) * time.Second
+	startSavingPeerReputation(ctx, peerReputationStorer, saveInterval)
+
+	return peerReputationStorer, nil
+}
+
+func (ncf *networkComponentsFactory) createPeerReputationStorer(storageConfig config.StorageConfig) (storage.Storer, error) {
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = filepath.Join(ncf.pathManager.DatabasePath(), storageConfig.DB.FilePath)
+
+	dbConfigHandler := storageFactory.NewDBConfigHandler(storageConfig.DB)
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, err
 	}
 
-	return peersRatingHandler, peersRatingMonitor, nil
+	return storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+}
+
+func startSavingPeerReputation(ctx context.Context, peerReputationHandler process.PeerReputationHandler, saveInterval time.Duration) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug("startSavingPeerReputation's go routine is stopping...")
+				return
+			case <-time.After(saveInterval):
+			}
+
+			err := peerReputationHandler.Save()
+			if err != nil {
+				log.Debug("could not save the peer reputation", "error", err)
+			}
+		}
+	}()
 }
 
 // Close closes all underlying components that need closing
@@ -338,6 +459,9 @@ func (nc *networkComponents) Close() error {
 	if !check.IfNil(nc.peerHonestyHandler) {
 		log.LogIfError(nc.peerHonestyHandler.Close())
 	}
+	if !check.IfNil(nc.peerReputationHandler) {
+		log.LogIfError(nc.peerReputationHandler.Close())
+	}
 
 	mainNetMessenger := nc.mainNetworkHolder.netMessenger
 	if !check.IfNil(mainNetMessenger) {
@@ -105,6 +105,12 @@ func (mnc *managedNetworkComponents) CheckSubcomponents() error {
 	if check.IfNil(mnc.peerHonestyHandler) {
 		return errors.ErrNilPeerHonestyHandler
 	}
+	if check.IfNil(mnc.antifloodDashboard) {
+		return errors.ErrNilAntifloodDashboard
+	}
+	if check.IfNil(mnc.peerReputationHandler) {
+		return errors.ErrNilPeerReputationHandler
+	}
 
 	return nil
 }
@@ -169,6 +175,30 @@ func (mnc *managedNetworkComponents) PeerBlackListHandler() process.PeerBlackLis
 	return mnc.networkComponents.peerBlackListHandler
 }
 
+// AntifloodDashboard returns the component able to report and tune the input antiflood mechanism
+func (mnc *managedNetworkComponents) AntifloodDashboard() process.AntifloodDashboardHandler {
+	mnc.mutNetworkComponents.RLock()
+	defer mnc.mutNetworkComponents.RUnlock()
+
+	if mnc.networkComponents == nil {
+		return nil
+	}
+
+	return mnc.networkComponents.antifloodDashboard
+}
+
+// PeerReputationHandler returns the component able to persist, export and import the peers blacklist and ratings
+func (mnc *managedNetworkComponents) PeerReputationHandler() process.PeerReputationHandler {
+	mnc.mutNetworkComponents.RLock()
+	defer mnc.mutNetworkComponents.RUnlock()
+
+	if mnc.networkComponents == nil {
+		return nil
+	}
+
+	return mnc.networkComponents.peerReputationHandler
+}
+
 // PeerHonestyHandler returns the blacklist handler
 func (mnc *managedNetworkComponents) PeerHonestyHandler() factory.PeerHonestyHandler {
 	mnc.mutNetworkComponents.RLock()
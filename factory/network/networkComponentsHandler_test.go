@@ -57,6 +57,8 @@ func TestManagedNetworkComponents_Create(t *testing.T) {
 		require.Nil(t, managedNetworkComponents.InputAntiFloodHandler())
 		require.Nil(t, managedNetworkComponents.OutputAntiFloodHandler())
 		require.Nil(t, managedNetworkComponents.PeerBlackListHandler())
+		require.Nil(t, managedNetworkComponents.AntifloodDashboard())
+		require.Nil(t, managedNetworkComponents.PeerReputationHandler())
 		require.Nil(t, managedNetworkComponents.PubKeyCacher())
 		require.Nil(t, managedNetworkComponents.PreferredPeersHolderHandler())
 		require.Nil(t, managedNetworkComponents.PeerHonestyHandler())
@@ -70,6 +72,8 @@ func TestManagedNetworkComponents_Create(t *testing.T) {
 		require.NotNil(t, managedNetworkComponents.InputAntiFloodHandler())
 		require.NotNil(t, managedNetworkComponents.OutputAntiFloodHandler())
 		require.NotNil(t, managedNetworkComponents.PeerBlackListHandler())
+		require.NotNil(t, managedNetworkComponents.AntifloodDashboard())
+		require.NotNil(t, managedNetworkComponents.PeerReputationHandler())
 		require.NotNil(t, managedNetworkComponents.PubKeyCacher())
 		require.NotNil(t, managedNetworkComponents.PreferredPeersHolderHandler())
 		require.NotNil(t, managedNetworkComponents.PeerHonestyHandler())
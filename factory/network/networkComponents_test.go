@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/multiversx/mx-chain-go/config"
 	errorsMx "github.com/multiversx/mx-chain-go/errors"
 	networkComp "github.com/multiversx/mx-chain-go/factory/network"
 	componentsMock "github.com/multiversx/mx-chain-go/testscommon/components"
@@ -49,6 +50,15 @@ func TestNewNetworkComponentsFactory(t *testing.T) {
 		require.Nil(t, ncf)
 		require.Equal(t, errorsMx.ErrNilCryptoComponentsHolder, err)
 	})
+	t.Run("nil PathManager should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := componentsMock.GetNetworkFactoryArgs()
+		args.PathManager = nil
+		ncf, err := networkComp.NewNetworkComponentsFactory(args)
+		require.Nil(t, ncf)
+		require.Equal(t, errorsMx.ErrNilPathHandler, err)
+	})
 	t.Run("invalid node operation mode should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -146,6 +156,44 @@ func TestNetworkComponentsFactory_Create(t *testing.T) {
 		require.Error(t, err)
 		require.Nil(t, nc)
 	})
+	t.Run("invalid peer reputation save interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := componentsMock.GetNetworkFactoryArgs()
+		args.MainConfig.PeerReputation = getPeerReputationConfig()
+		args.MainConfig.PeerReputation.SaveIntervalInSec = 0
+
+		ncf, _ := networkComp.NewNetworkComponentsFactory(args)
+
+		nc, err := ncf.Create()
+		require.True(t, errors.Is(err, errorsMx.ErrInvalidPeerReputationConfig))
+		require.Nil(t, nc)
+	})
+	t.Run("invalid peer reputation storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := componentsMock.GetNetworkFactoryArgs()
+		args.MainConfig.PeerReputation = getPeerReputationConfig()
+		args.MainConfig.PeerReputation.Storage.DB.Type = "invalid"
+
+		ncf, _ := networkComp.NewNetworkComponentsFactory(args)
+
+		nc, err := ncf.Create()
+		require.Error(t, err)
+		require.Nil(t, nc)
+	})
+	t.Run("should work with peer reputation enabled", func(t *testing.T) {
+		t.Parallel()
+
+		args := componentsMock.GetNetworkFactoryArgs()
+		args.MainConfig.PeerReputation = getPeerReputationConfig()
+		ncf, _ := networkComp.NewNetworkComponentsFactory(args)
+
+		nc, err := ncf.Create()
+		require.NoError(t, err)
+		require.NotNil(t, nc)
+		require.NoError(t, nc.Close())
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -171,3 +219,24 @@ func TestNetworkComponents_Close(t *testing.T) {
 	err = nc.Close()
 	require.NoError(t, err)
 }
+
+func getPeerReputationConfig() config.PeerReputationConfig {
+	return config.PeerReputationConfig{
+		Enabled:            true,
+		SaveIntervalInSec:  1,
+		RatingsExpiryInSec: 3600,
+		Storage: config.StorageConfig{
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 10,
+			},
+			DB: config.DBConfig{
+				FilePath:          "PeerReputation",
+				Type:              "MemoryDB",
+				BatchDelaySeconds: 1,
+				MaxBatchSize:      1,
+				MaxOpenFiles:      10,
+			},
+		},
+	}
+}
@@ -778,12 +778,22 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		return nil, err
 	}
 
+	rewardsBreakdownStorage, err := pcf.data.StorageService().GetStorer(dataRetriever.RewardsBreakdownUnit)
+	if err != nil {
+		return nil, err
+	}
+
 	miniBlockStorage, err := pcf.data.StorageService().GetStorer(dataRetriever.MiniBlockUnit)
 	if err != nil {
 		return nil, err
 	}
 
-	stakingDataProviderAPI, err := metachainEpochStart.NewStakingDataProvider(argsStakingDataProvider)
+	stakingDataProviderForAPI, err := metachainEpochStart.NewStakingDataProvider(argsStakingDataProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingDataProviderAPI, err := metachainEpochStart.NewSimulatedStakingDataProvider(stakingDataProviderForAPI)
 	if err != nil {
 		return nil, err
 	}
@@ -805,9 +815,11 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 			EnableEpochsHandler:           pcf.coreData.EnableEpochsHandler(),
 			ExecutionOrderHandler:         pcf.txExecutionOrderHandler,
 		},
-		StakingDataProvider:   stakingDataProvider,
-		RewardsHandler:        pcf.coreData.EconomicsData(),
-		EconomicsDataProvider: economicsDataProvider,
+		StakingDataProvider:        stakingDataProvider,
+		RewardsHandler:             pcf.coreData.EconomicsData(),
+		EconomicsDataProvider:      economicsDataProvider,
+		RewardsBreakdownStorer:     rewardsBreakdownStorage,
+		RewardsBreakdownMarshaller: pcf.coreData.InternalMarshalizer(),
 	}
 	epochRewards, err := metachainEpochStart.NewRewardsCreatorProxy(argsEpochRewards)
 	if err != nil {
@@ -632,6 +632,11 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		}
 	}
 
+	validatorsHistoryStorer, err := pcf.data.StorageService().GetStorer(dataRetriever.ValidatorsHistoryUnit)
+	if err != nil {
+		return nil, err
+	}
+
 	cacheRefreshDuration := time.Duration(pcf.config.ValidatorStatistics.CacheRefreshIntervalInSec) * time.Second
 	argVSP := peer.ArgValidatorsProvider{
 		NodesCoordinator:                  pcf.nodesCoordinator,
@@ -644,6 +649,8 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		AddressPubKeyConverter:            pcf.coreData.AddressPubKeyConverter(),
 		AuctionListSelector:               pcf.auctionListSelectorAPI,
 		StakingDataProvider:               pcf.stakingDataProviderAPI,
+		ValidatorsHistoryStorer:           validatorsHistoryStorer,
+		Marshaller:                        pcf.coreData.InternalMarshalizer(),
 	}
 
 	validatorsProvider, err := peer.NewValidatorsProvider(argVSP)
@@ -2,6 +2,8 @@ package status
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -23,6 +25,9 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -30,6 +35,7 @@ type statusComponents struct {
 	nodesCoordinator    nodesCoordinator.NodesCoordinator
 	statusHandler       core.AppStatusHandler
 	outportHandler      outport.OutportHandler
+	replayDriverFactory outport.ReplayDriverFactory
 	softwareVersion     statistics.SoftwareVersionChecker
 	managedPeersMonitor common.ManagedPeersMonitor
 	cancelFunc          func()
@@ -137,7 +143,17 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 		return nil, errors.ErrInvalidRoundDuration
 	}
 
-	outportHandler, err := scf.createOutportDriver()
+	outportFactoryArgs, err := scf.makeOutportFactoryArgs()
+	if err != nil {
+		return nil, err
+	}
+
+	outportHandler, err := outportDriverFactory.CreateOutport(outportFactoryArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	replayDriverFactory, err := outportDriverFactory.NewReplayDriverFactory(outportFactoryArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -159,6 +175,7 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 		nodesCoordinator:    scf.nodesCoordinator,
 		softwareVersion:     softwareVersionChecker,
 		outportHandler:      outportHandler,
+		replayDriverFactory: replayDriverFactory,
 		statusHandler:       scf.statusCoreComponents.AppStatusHandler(),
 		managedPeersMonitor: managedPeersMonitor,
 		cancelFunc:          cancelFunc,
@@ -204,13 +221,15 @@ func (pc *statusComponents) Close() error {
 	if !check.IfNil(pc.softwareVersion) {
 		log.LogIfError(pc.softwareVersion.Close())
 	}
+	if !check.IfNil(pc.outportHandler) {
+		log.LogIfError(pc.outportHandler.Close())
+	}
 
 	return nil
 }
 
-// createOutportDriver creates a new outport.OutportHandler which is used to register outport drivers
-// once a driver is subscribed it will receive data through the implemented outport.Driver methods
-func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandler, error) {
+// makeOutportFactoryArgs creates the arguments used to create the outport handler, with its drivers, and the replay drivers
+func (scf *statusComponentsFactory) makeOutportFactoryArgs() (*outportDriverFactory.OutportFactoryArgs, error) {
 	hostDriversArgs, err := scf.makeHostDriversArgs()
 	if err != nil {
 		return nil, err
@@ -221,16 +240,61 @@ func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandle
 		return nil, err
 	}
 
+	grpcDriversArgs, err := scf.makeGRPCDriversArgs()
+	if err != nil {
+		return nil, err
+	}
+
 	outportFactoryArgs := &outportDriverFactory.OutportFactoryArgs{
 		ShardID:                   scf.shardCoordinator.SelfId(),
 		RetrialInterval:           common.RetrialIntervalForOutportDriver,
 		ElasticIndexerFactoryArgs: scf.makeElasticIndexerArgs(),
 		EventNotifierFactoryArgs:  eventNotifierArgs,
 		HostDriversArgs:           hostDriversArgs,
+		FileDriversArgs:           scf.makeFileDriversArgs(),
+		GRPCDriversArgs:           grpcDriversArgs,
 		IsImportDB:                scf.isInImportMode,
+		QueueArgs:                 scf.makeOutportQueueArgs(),
+	}
+
+	return outportFactoryArgs, nil
+}
+
+func (scf *statusComponentsFactory) makeOutportQueueArgs() outportDriverFactory.ArgsOutportQueueFactory {
+	queueConfig := scf.config.OutportQueue
+	return outportDriverFactory.ArgsOutportQueueFactory{
+		Enabled:      queueConfig.Enabled,
+		MaxBacklog:   queueConfig.MaxBacklog,
+		Marshaller:   scf.coreComponents.InternalMarshalizer(),
+		CreateStorer: scf.createOutportQueueStorer,
+	}
+}
+
+func (scf *statusComponentsFactory) createOutportQueueStorer(driverIdentifier string) (storage.Storer, error) {
+	if check.IfNil(scf.coreComponents.PathHandler()) {
+		return nil, errors.ErrNilPathHandler
+	}
+
+	storageConfig := scf.config.OutportQueue.Storage
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = filepath.Join(scf.coreComponents.PathHandler().DatabasePath(), storageConfig.DB.FilePath, driverIdentifier)
+
+	dbConfigHandler := storageFactory.NewDBConfigHandler(storageConfig.DB)
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	storer, err := storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w for outport driver %s", err, driverIdentifier)
 	}
 
-	return outportDriverFactory.CreateOutport(outportFactoryArgs)
+	return storer, nil
 }
 
 func (scf *statusComponentsFactory) makeElasticIndexerArgs() indexerFactory.ArgsIndexerFactory {
@@ -269,6 +333,8 @@ func (scf *statusComponentsFactory) makeEventNotifierArgs() (*outportDriverFacto
 		Password:          eventNotifierConfig.Password,
 		RequestTimeoutSec: eventNotifierConfig.RequestTimeoutSec,
 		Marshaller:        marshaller,
+		Filter:            eventNotifierConfig.Filter,
+		AddressConverter:  scf.coreComponents.AddressPubKeyConverter(),
 	}, nil
 }
 
@@ -286,10 +352,48 @@ func (scf *statusComponentsFactory) makeHostDriversArgs() ([]outportDriverFactor
 		}
 
 		argsHostDriverFactorySlice = append(argsHostDriverFactorySlice, outportDriverFactory.ArgsHostDriverFactory{
-			Marshaller: marshaller,
-			HostConfig: hostConfig,
+			Marshaller:       marshaller,
+			HostConfig:       hostConfig,
+			AddressConverter: scf.coreComponents.AddressPubKeyConverter(),
 		})
 	}
 
 	return argsHostDriverFactorySlice, nil
 }
+
+func (scf *statusComponentsFactory) makeFileDriversArgs() []outportDriverFactory.ArgsFileDriverFactory {
+	argsFileDriverFactorySlice := make([]outportDriverFactory.ArgsFileDriverFactory, 0, len(scf.externalConfig.FileDriversConfig))
+	for _, fileConfig := range scf.externalConfig.FileDriversConfig {
+		if !fileConfig.Enabled {
+			continue
+		}
+
+		argsFileDriverFactorySlice = append(argsFileDriverFactorySlice, outportDriverFactory.ArgsFileDriverFactory{
+			FileConfig: fileConfig,
+		})
+	}
+
+	return argsFileDriverFactorySlice
+}
+
+func (scf *statusComponentsFactory) makeGRPCDriversArgs() ([]outportDriverFactory.ArgsGRPCDriverFactory, error) {
+	argsGRPCDriverFactorySlice := make([]outportDriverFactory.ArgsGRPCDriverFactory, 0, len(scf.externalConfig.GRPCDriversConfig))
+	for _, grpcConfig := range scf.externalConfig.GRPCDriversConfig {
+		if !grpcConfig.Enabled {
+			continue
+		}
+
+		marshaller, err := factoryMarshalizer.NewMarshalizer(grpcConfig.MarshallerType)
+		if err != nil {
+			return argsGRPCDriverFactorySlice, err
+		}
+
+		argsGRPCDriverFactorySlice = append(argsGRPCDriverFactorySlice, outportDriverFactory.ArgsGRPCDriverFactory{
+			GRPCConfig:       grpcConfig,
+			Marshaller:       marshaller,
+			AddressConverter: scf.coreComponents.AddressPubKeyConverter(),
+		})
+	}
+
+	return argsGRPCDriverFactorySlice, nil
+}
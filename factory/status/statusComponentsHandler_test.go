@@ -56,12 +56,14 @@ func TestManagedStatusComponents_Create(t *testing.T) {
 		require.Nil(t, err)
 		require.NotNil(t, managedStatusComponents)
 		require.Nil(t, managedStatusComponents.OutportHandler())
+		require.Nil(t, managedStatusComponents.OutportReplayDriverFactory())
 		require.Nil(t, managedStatusComponents.SoftwareVersionChecker())
 		require.Nil(t, managedStatusComponents.ManagedPeersMonitor())
 
 		err = managedStatusComponents.Create()
 		require.NoError(t, err)
 		require.NotNil(t, managedStatusComponents.OutportHandler())
+		require.NotNil(t, managedStatusComponents.OutportReplayDriverFactory())
 		require.NotNil(t, managedStatusComponents.SoftwareVersionChecker())
 		require.NotNil(t, managedStatusComponents.ManagedPeersMonitor())
 
@@ -66,6 +66,23 @@ func createMockStatusComponentsFactoryArgs() statusComp.StatusComponentsFactoryA
 	}
 }
 
+func getOutportQueueConfig() config.OutportQueueConfig {
+	return config.OutportQueueConfig{
+		Enabled:    true,
+		MaxBacklog: 10,
+		Storage: config.StorageConfig{
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 10,
+			},
+			DB: config.DBConfig{
+				FilePath: "OutportQueue",
+				Type:     "MemoryDB",
+			},
+		},
+	}
+}
+
 func TestNewStatusComponentsFactory(t *testing.T) {
 	// no t.Parallel for these tests as they create real components
 
@@ -188,6 +205,41 @@ func TestStatusComponentsFactory_Create(t *testing.T) {
 		require.Error(t, err)
 		require.Nil(t, sc)
 	})
+	t.Run("outport queue without path handler should error", func(t *testing.T) {
+		args := createMockStatusComponentsFactoryArgs()
+		args.Config.OutportQueue = getOutportQueueConfig()
+		args.ExternalConfig.HostDriversConfig[0].Enabled = true
+		args.ExternalConfig.HostDriversConfig[0].URL = "localhost"
+		args.ExternalConfig.HostDriversConfig[0].Mode = "client"
+		args.ExternalConfig.HostDriversConfig[0].RetryDurationInSec = 1
+		coreComponents := args.CoreComponents.(*mock.CoreComponentsMock)
+		coreComponents.IntMarsh = &testscommon.ProtoMarshalizerMock{}
+		scf, _ := statusComp.NewStatusComponentsFactory(args)
+		require.NotNil(t, scf)
+
+		sc, err := scf.Create()
+		require.ErrorIs(t, err, errorsMx.ErrNilPathHandler)
+		require.Nil(t, sc)
+	})
+	t.Run("outport queue enabled should work", func(t *testing.T) {
+		args := createMockStatusComponentsFactoryArgs()
+		args.Config.OutportQueue = getOutportQueueConfig()
+		args.ExternalConfig.HostDriversConfig[0].Enabled = true
+		args.ExternalConfig.HostDriversConfig[0].URL = "localhost"
+		args.ExternalConfig.HostDriversConfig[0].Mode = "client"
+		args.ExternalConfig.HostDriversConfig[0].RetryDurationInSec = 1
+		coreComponents := args.CoreComponents.(*mock.CoreComponentsMock)
+		coreComponents.PathHdl = &testscommon.PathManagerStub{}
+		coreComponents.IntMarsh = &testscommon.ProtoMarshalizerMock{}
+		scf, _ := statusComp.NewStatusComponentsFactory(args)
+		require.NotNil(t, scf)
+
+		sc, err := scf.Create()
+		require.NoError(t, err)
+		require.NotNil(t, sc)
+
+		require.NoError(t, sc.Close())
+	})
 	t.Run("should work", func(t *testing.T) {
 		shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
 		shardCoordinator.SelfIDCalled = func() uint32 {
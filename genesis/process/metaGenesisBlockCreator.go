@@ -577,6 +577,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, enableEpoc
 		Marshaller:               arg.Core.InternalMarshalizer(),
 		Hasher:                   arg.Core.Hasher(),
 		Uint64ByteSliceConverter: arg.Core.Uint64ByteSliceConverter(),
+		QueryCache:               smartContract.NewDisabledSCQueryCache(),
 	}
 	queryService, err := smartContract.NewSCQueryService(argsNewSCQueryService)
 	if err != nil {
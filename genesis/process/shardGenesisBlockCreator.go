@@ -664,6 +664,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		Marshaller:               arg.Core.InternalMarshalizer(),
 		Hasher:                   arg.Core.Hasher(),
 		Uint64ByteSliceConverter: arg.Core.Uint64ByteSliceConverter(),
+		QueryCache:               smartContract.NewDisabledSCQueryCache(),
 	}
 	queryService, err := smartContract.NewSCQueryService(argsNewSCQueryService)
 	if err != nil {
@@ -31,8 +31,10 @@ require (
 	github.com/prometheus/client_golang v1.14.0
 	github.com/shirou/gopsutil v3.21.11+incompatible
 	github.com/stretchr/testify v1.8.4
+	github.com/tyler-smith/go-bip39 v1.0.2
 	github.com/urfave/cli v1.22.10
 	golang.org/x/crypto v0.21.0
+	google.golang.org/grpc v1.56.3
 	gopkg.in/go-playground/validator.v8 v8.18.2
 )
 
@@ -181,6 +183,7 @@ require (
 	golang.org/x/text v0.14.0 // indirect
 	golang.org/x/tools v0.9.1 // indirect
 	gonum.org/v1/gonum v0.11.0 // indirect
+	google.golang.org/genproto v0.0.0-20230410155749-daa745c078e1 // indirect
 	google.golang.org/protobuf v1.30.0 // indirect
 	gopkg.in/go-playground/assert.v1 v1.2.1 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
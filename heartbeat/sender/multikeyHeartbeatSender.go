@@ -159,6 +159,12 @@ func (sender *multikeyHeartbeatSender) sendMultiKeysInfo() error {
 func (sender *multikeyHeartbeatSender) sendMessageForKey(pkBytes []byte) error {
 	time.Sleep(delayedBroadcast)
 
+	// the key might have been removed while waiting
+	if !sender.managedPeersHolder.IsKeyManagedByCurrentNode(pkBytes) {
+		log.Debug("managed key was removed, heartbeat message not sent", "managed key", pkBytes)
+		return nil
+	}
+
 	name, identity, err := sender.managedPeersHolder.GetNameAndIdentity(pkBytes)
 	if err != nil {
 		return err
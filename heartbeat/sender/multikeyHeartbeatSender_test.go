@@ -415,6 +415,51 @@ func TestMultikeyHeartbeatSender_execute(t *testing.T) {
 
 		assert.Equal(t, uint64(1), args.currentBlockProvider.GetCurrentBlockHeader().GetNonce())
 	})
+	t.Run("should not send the heartbeat of a key removed in the meantime", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockMultikeyHeartbeatSenderArgs(createMockBaseArgs())
+		recordedMainMessages := make(map[core.PeerID][][]byte)
+		args.mainMessenger = &p2pmocks.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				recordedMainMessages[args.mainMessenger.ID()] = append(recordedMainMessages[args.mainMessenger.ID()], buff)
+			},
+			BroadcastUsingPrivateKeyCalled: func(topic string, buff []byte, pid core.PeerID, skBytes []byte) {
+				recordedMainMessages[pid] = append(recordedMainMessages[pid], buff)
+			},
+		}
+		numChecks := make(map[string]int)
+		args.managedPeersHolder = &testscommon.ManagedPeersHolderStub{
+			IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+				numChecks[string(pkBytes)]++
+				// key bb is removed after it was selected for sending
+				return string(pkBytes) != "bb" || numChecks[string(pkBytes)] == 1
+			},
+			GetManagedKeysByCurrentNodeCalled: func() map[string]crypto.PrivateKey {
+				return map[string]crypto.PrivateKey{
+					"aa": &mock.PrivateKeyStub{},
+					"bb": &mock.PrivateKeyStub{},
+				}
+			},
+			GetP2PIdentityCalled: func(pkBytes []byte) ([]byte, core.PeerID, error) {
+				return []byte(string(pkBytes) + "_p2p"), core.PeerID(string(pkBytes) + "_pid"), nil
+			},
+			GetNameAndIdentityCalled: func(pkBytes []byte) (string, string, error) {
+				if string(pkBytes) == "bb" {
+					assert.Fail(t, "should have not been called for a removed key")
+				}
+				return string(pkBytes) + "_name", string(pkBytes) + "_identity", nil
+			},
+		}
+
+		senderInstance, _ := newMultikeyHeartbeatSender(args)
+
+		err := senderInstance.execute()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(recordedMainMessages)) // current pid, aa
+		assert.Equal(t, 1, len(recordedMainMessages["aa_pid"]))
+		assert.Equal(t, 0, len(recordedMainMessages["bb_pid"]))
+	})
 }
 
 func TestMultikeyHeartbeatSender_generateMessageBytes(t *testing.T) {
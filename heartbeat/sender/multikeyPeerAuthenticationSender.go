@@ -105,8 +105,9 @@ func (sender *multikeyPeerAuthenticationSender) Execute() {
 		if err != nil {
 			nextTimeToCheck, errNextPeerAuth := sender.managedPeersHolder.GetNextPeerAuthenticationTime([]byte(pk))
 			if errNextPeerAuth != nil {
+				// the key might have been removed in the meantime, the other keys should still be processed
 				log.Error("could not get next peer authentication time for pk", "pk", pk, "process error", err, "GetNextPeerAuthenticationTime error", errNextPeerAuth)
-				return
+				continue
 			}
 
 			log.Error("error sending peer authentication message", "bls pk", pk,
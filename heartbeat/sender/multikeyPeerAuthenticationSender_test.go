@@ -591,6 +591,50 @@ func TestNewMultikeyPeerAuthenticationSender_Execute(t *testing.T) {
 		testRecoveredMessages(t, args, buffResulted, pids, skBytesBroadcast, numKeys-1, "")
 		mutData.Unlock()
 	})
+	t.Run("keys removed during the execution should not stop the sender", func(t *testing.T) {
+		t.Parallel()
+
+		numKeys := 3
+		args, _ := createMockMultikeyPeerAuthenticationSenderArgsSemiIntegrationTests(numKeys)
+		args.peerSignatureHandler = &mock.PeerSignatureHandlerStub{
+			GetPeerSignatureCalled: func(privateKey crypto.PrivateKey, pid []byte) ([]byte, error) {
+				return nil, errors.New("expected error")
+			},
+		}
+		managedPeersHolder := args.managedPeersHolder.(*testscommon.ManagedPeersHolderStub)
+		mutNumCalls := sync.Mutex{}
+		numCallsPerKey := make(map[string]int)
+		managedPeersHolder.GetNextPeerAuthenticationTimeCalled = func(pkBytes []byte) (time.Time, error) {
+			mutNumCalls.Lock()
+			defer mutNumCalls.Unlock()
+
+			numCallsPerKey[string(pkBytes)]++
+			if numCallsPerKey[string(pkBytes)] > 1 {
+				// the key was removed after the send check
+				return time.Time{}, errors.New("missing public key definition")
+			}
+
+			return time.Time{}, nil
+		}
+
+		senderInstance, _ := newMultikeyPeerAuthenticationSender(args)
+		wasCalled := false
+		senderInstance.timerHandler = &mock.TimerHandlerStub{
+			CreateNewTimerCalled: func(duration time.Duration) {
+				assert.Equal(t, args.timeBetweenChecks, duration)
+				wasCalled = true
+			},
+		}
+		senderInstance.Execute()
+
+		assert.True(t, wasCalled)
+		mutNumCalls.Lock()
+		assert.Equal(t, numKeys, len(numCallsPerKey))
+		for _, numCalls := range numCallsPerKey {
+			assert.Equal(t, 2, numCalls)
+		}
+		mutNumCalls.Unlock()
+	})
 	t.Run("should work with some real components and hardfork trigger", func(t *testing.T) {
 		t.Parallel()
 
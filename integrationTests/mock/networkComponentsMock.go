@@ -13,6 +13,8 @@ type NetworkComponentsStub struct {
 	InputAntiFlood                   factory.P2PAntifloodHandler
 	OutputAntiFlood                  factory.P2PAntifloodHandler
 	PeerBlackList                    process.PeerBlackListCacher
+	AntifloodDashboardField          process.AntifloodDashboardHandler
+	PeerReputationHandlerField       process.PeerReputationHandler
 	PeerHonesty                      factory.PeerHonestyHandler
 	PreferredPeersHolder             factory.PreferredPeersHolderHandler
 	PeersRatingHandlerField          p2p.PeersRatingHandler
@@ -69,6 +71,16 @@ func (ncs *NetworkComponentsStub) PeerBlackListHandler() process.PeerBlackListCa
 	return ncs.PeerBlackList
 }
 
+// AntifloodDashboard -
+func (ncs *NetworkComponentsStub) AntifloodDashboard() process.AntifloodDashboardHandler {
+	return ncs.AntifloodDashboardField
+}
+
+// PeerReputationHandler -
+func (ncs *NetworkComponentsStub) PeerReputationHandler() process.PeerReputationHandler {
+	return ncs.PeerReputationHandlerField
+}
+
 // PreferredPeersHolderHandler -
 func (ncs *NetworkComponentsStub) PreferredPeersHolderHandler() factory.PreferredPeersHolderHandler {
 	return ncs.PreferredPeersHolder
@@ -9,6 +9,7 @@ import (
 // StatusComponentsStub -
 type StatusComponentsStub struct {
 	Outport                  outport.OutportHandler
+	ReplayDriverFactory      outport.ReplayDriverFactory
 	SoftwareVersionCheck     statistics.SoftwareVersionChecker
 	ManagedPeersMonitorField common.ManagedPeersMonitor
 }
@@ -33,6 +34,11 @@ func (scs *StatusComponentsStub) OutportHandler() outport.OutportHandler {
 	return scs.Outport
 }
 
+// OutportReplayDriverFactory -
+func (scs *StatusComponentsStub) OutportReplayDriverFactory() outport.ReplayDriverFactory {
+	return scs.ReplayDriverFactory
+}
+
 // SoftwareVersionChecker -
 func (scs *StatusComponentsStub) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	return scs.SoftwareVersionCheck
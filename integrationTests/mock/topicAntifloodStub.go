@@ -1,6 +1,9 @@
 package mock
 
-import "github.com/multiversx/mx-chain-core-go/core"
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+)
 
 // TopicAntiFloodStub -
 type TopicAntiFloodStub struct {
@@ -20,6 +23,10 @@ func (t *TopicAntiFloodStub) IncreaseLoad(pid core.PeerID, topic string, numMess
 func (t *TopicAntiFloodStub) ResetForTopic(_ string) {
 }
 
+// ResetForRegisteredTopics -
+func (t *TopicAntiFloodStub) ResetForRegisteredTopics() {
+}
+
 // ResetForNotRegisteredTopics -
 func (t *TopicAntiFloodStub) ResetForNotRegisteredTopics() {
 }
@@ -28,6 +35,11 @@ func (t *TopicAntiFloodStub) ResetForNotRegisteredTopics() {
 func (t *TopicAntiFloodStub) SetMaxMessagesForTopic(_ string, _ uint32) {
 }
 
+// GetTopicsStatus -
+func (t *TopicAntiFloodStub) GetTopicsStatus() []*common.AntifloodTopicStatus {
+	return nil
+}
+
 // IsInterfaceNil -
 func (t *TopicAntiFloodStub) IsInterfaceNil() bool {
 	return t == nil
@@ -284,6 +284,7 @@ func (tcn *TestConsensusNode) initNode(args ArgsTestConsensusNode) {
 		KeyGenerator:         args.KeyGen,
 		KeysHandler:          keysHandler,
 		SingleSigner:         TestSingleBlsSigner,
+		RandomSeedSigner:     TestSingleBlsSigner,
 	}
 	sigHandler, _ := cryptoFactory.NewSigningHandler(signingHandlerArgs)
 
@@ -821,6 +821,7 @@ func (tpn *TestProcessorNode) initTestNodeWithArgs(args ArgTestProcessorNode) {
 			Marshaller:               TestMarshaller,
 			Hasher:                   TestHasher,
 			Uint64ByteSliceConverter: TestUint64Converter,
+			QueryCache:               smartContract.NewDisabledSCQueryCache(),
 		}
 		tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	} else {
@@ -1024,6 +1025,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService(gasMap map[string]map[str
 		Marshaller:               TestMarshaller,
 		Hasher:                   TestHasher,
 		Uint64ByteSliceConverter: TestUint64Converter,
+		QueryCache:               smartContract.NewDisabledSCQueryCache(),
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 }
@@ -1054,6 +1056,7 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 		Marshaller:               TestMarshaller,
 		Hasher:                   TestHasher,
 		Uint64ByteSliceConverter: TestUint64Converter,
+		QueryCache:               smartContract.NewDisabledSCQueryCache(),
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor()
@@ -2313,9 +2316,11 @@ func (tpn *TestProcessorNode) initBlockProcessor() {
 				EnableEpochsHandler:           tpn.EnableEpochsHandler,
 				ExecutionOrderHandler:         tpn.TxExecutionOrderHandler,
 			},
-			StakingDataProvider:   stakingDataProvider,
-			RewardsHandler:        tpn.EconomicsData,
-			EconomicsDataProvider: economicsDataProvider,
+			StakingDataProvider:        stakingDataProvider,
+			RewardsHandler:             tpn.EconomicsData,
+			EconomicsDataProvider:      economicsDataProvider,
+			RewardsBreakdownStorer:     CreateMemUnit(),
+			RewardsBreakdownMarshaller: TestMarshalizer,
 		}
 		epochStartRewards, _ := metachain.NewRewardsCreatorProxy(argsEpochRewards)
 
@@ -13,8 +13,12 @@ import (
 	nodeFacade "github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/integrationTests/mock"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/node/external/abiAPI"
 	"github.com/multiversx/mx-chain-go/node/external/blockAPI"
+	"github.com/multiversx/mx-chain-go/node/external/governanceAPI"
+	"github.com/multiversx/mx-chain-go/node/external/rewardsAPI"
 	"github.com/multiversx/mx-chain-go/node/external/transactionAPI"
+	"github.com/multiversx/mx-chain-go/node/external/verificationAPI"
 	"github.com/multiversx/mx-chain-go/node/trieIterators"
 	"github.com/multiversx/mx-chain-go/node/trieIterators/factory"
 	"github.com/multiversx/mx-chain-go/process/coordinator"
@@ -24,6 +28,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/outport"
 	"github.com/multiversx/mx-chain-go/testscommon/state"
 	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts/defaults"
 	"github.com/multiversx/mx-chain-vm-common-go/parsers"
@@ -220,6 +225,23 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	delegatedListHandler, err := factory.CreateDelegatedListHandler(args)
 	log.LogIfError(err)
 
+	argsDelegationContractProcessor := trieIterators.ArgDelegationContractProcessor{
+		ArgTrieIteratorProcessor: args,
+		ValidatorPubKeyConverter: TestValidatorPubkeyConverter,
+	}
+	delegationContractHandler, err := factory.CreateDelegationContractHandler(argsDelegationContractProcessor)
+	log.LogIfError(err)
+
+	argsStakingInfoProcessor := trieIterators.ArgStakingInfoProcessor{
+		ArgTrieIteratorProcessor: args,
+		ValidatorPubKeyConverter: TestValidatorPubkeyConverter,
+		NodesCoordinator:         tpn.NodesCoordinator,
+		EpochNotifier:            tpn.EpochNotifier,
+		EnableEpochsHandler:      tpn.EnableEpochsHandler,
+	}
+	stakingInfoHandler, err := factory.CreateStakingInfoHandler(argsStakingInfoProcessor)
+	log.LogIfError(err)
+
 	logsFacade := &testscommon.LogsFacadeStub{}
 	receiptsRepository := &testscommon.ReceiptsRepositoryStub{}
 
@@ -235,6 +257,7 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		TxTypeHandler:            txTypeHandler,
 		LogsFacade:               logsFacade,
 		DataFieldParser:          dataFieldParser,
+		ABIDecoder:               abiAPI.NewDisabledABIRegistry(),
 	}
 	apiTransactionHandler, err := transactionAPI.NewAPITransactionProcessor(argsApiTransactionProc)
 	log.LogIfError(err)
@@ -266,21 +289,28 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	log.LogIfError(err)
 
 	argsApiResolver := external.ArgNodeApiResolver{
-		SCQueryService:           tpn.SCQueryService,
-		StatusMetricsHandler:     &testscommon.StatusMetricsStub{},
-		APITransactionEvaluator:  apiTransactionEvaluator,
-		TotalStakedValueHandler:  totalStakedValueHandler,
-		DirectStakedListHandler:  directStakedListHandler,
-		DelegatedListHandler:     delegatedListHandler,
-		APITransactionHandler:    apiTransactionHandler,
-		APIBlockHandler:          blockAPIHandler,
-		APIInternalBlockHandler:  apiInternalBlockProcessor,
-		GenesisNodesSetupHandler: &genesisMocks.NodesSetupStub{},
-		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
-		AccountsParser:           &genesisMocks.AccountsParserStub{},
-		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
-		ManagedPeersMonitor:      &testscommon.ManagedPeersMonitorStub{},
-		NodesCoordinator:         tpn.NodesCoordinator,
+		SCQueryService:            tpn.SCQueryService,
+		StatusMetricsHandler:      &testscommon.StatusMetricsStub{},
+		APITransactionEvaluator:   apiTransactionEvaluator,
+		TotalStakedValueHandler:   totalStakedValueHandler,
+		DirectStakedListHandler:   directStakedListHandler,
+		DelegatedListHandler:      delegatedListHandler,
+		APITransactionHandler:     apiTransactionHandler,
+		APIBlockHandler:           blockAPIHandler,
+		APIInternalBlockHandler:   apiInternalBlockProcessor,
+		GenesisNodesSetupHandler:  &genesisMocks.NodesSetupStub{},
+		ValidatorPubKeyConverter:  &testscommon.PubkeyConverterMock{},
+		AccountsParser:            &genesisMocks.AccountsParserStub{},
+		GasScheduleNotifier:       &testscommon.GasScheduleNotifierMock{},
+		ManagedPeersMonitor:       &testscommon.ManagedPeersMonitorStub{},
+		NodesCoordinator:          tpn.NodesCoordinator,
+		OutportReplayer:           &outport.OutportReplayerStub{},
+		GovernanceHandler:         governanceAPI.NewDisabledGovernanceProcessor(),
+		DelegationContractHandler: delegationContractHandler,
+		RewardsBreakdownHandler:   rewardsAPI.NewDisabledRewardsProcessor(),
+		StakingInfoHandler:        stakingInfoHandler,
+		ABIRegistry:               abiAPI.NewDisabledABIRegistry(),
+		ContractsVerifier:         verificationAPI.NewDisabledContractsVerifier(),
 	}
 
 	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
@@ -344,6 +374,31 @@ func createGroups(facade Facade) map[string]shared.GroupHandler {
 		groupsMap["validator"] = validatorGroup
 	}
 
+	governanceGroup, err := groups.NewGovernanceGroup(facade)
+	if err == nil {
+		groupsMap["governance"] = governanceGroup
+	}
+
+	delegationGroup, err := groups.NewDelegationGroup(facade)
+	if err == nil {
+		groupsMap["delegation"] = delegationGroup
+	}
+
+	rewardsGroup, err := groups.NewRewardsGroup(facade)
+	if err == nil {
+		groupsMap["rewards"] = rewardsGroup
+	}
+
+	stakingGroup, err := groups.NewStakingGroup(facade)
+	if err == nil {
+		groupsMap["staking"] = stakingGroup
+	}
+
+	abiGroup, err := groups.NewABIGroup(facade)
+	if err == nil {
+		groupsMap["abi"] = abiGroup
+	}
+
 	vmValuesGroup, err := groups.NewVmValuesGroup(facade)
 	if err == nil {
 		groupsMap["vm-values"] = vmValuesGroup
@@ -59,6 +59,7 @@ func TestVmGetShouldReturnValue(t *testing.T) {
 		Marshaller:               &marshallerMock.MarshalizerStub{},
 		Hasher:                   &testscommon.HasherStub{},
 		Uint64ByteSliceConverter: &mock.Uint64ByteSliceConverterMock{},
+		QueryCache:               smartContract.NewDisabledSCQueryCache(),
 	}
 	service, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -269,6 +269,7 @@ func (vmTestContext *VMTestContext) GetVMOutputWithTransientVM(funcName string,
 		StorageService:           &storageStubs.ChainStorerStub{},
 		Marshaller:               integrationTests.TestMarshalizer,
 		Uint64ByteSliceConverter: integrationTests.TestUint64Converter,
+		QueryCache:               smartContract.NewDisabledSCQueryCache(),
 		Hasher:                   integrationtests.TestHasher,
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
@@ -1707,6 +1708,7 @@ func GetVmOutput(
 		Marshaller:               integrationTests.TestMarshalizer,
 		Hasher:                   integrationtests.TestHasher,
 		Uint64ByteSliceConverter: integrationTests.TestUint64Converter,
+		QueryCache:               smartContract.NewDisabledSCQueryCache(),
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -1768,6 +1770,7 @@ func ComputeGasLimit(gasSchedule map[string]map[string]uint64, testContext *VMTe
 		Marshaller:               integrationTests.TestMarshalizer,
 		Hasher:                   integrationtests.TestHasher,
 		Uint64ByteSliceConverter: integrationTests.TestUint64Converter,
+		QueryCache:               smartContract.NewDisabledSCQueryCache(),
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
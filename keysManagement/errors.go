@@ -37,3 +37,15 @@ var ErrNilShardProvider = errors.New("nil shard provider")
 
 // ErrNilEpochProvider signals that a nil epoch provider has been provided
 var ErrNilEpochProvider = errors.New("nil epoch provider")
+
+// ErrCanNotRemoveLastManagedKey signals that the last managed key can not be removed
+var ErrCanNotRemoveLastManagedKey = errors.New("can not remove the last managed key")
+
+// ErrEmptyFilePath signals that an empty file path was provided
+var ErrEmptyFilePath = errors.New("empty file path")
+
+// ErrNilKeysLoader signals that a nil keys loader was provided
+var ErrNilKeysLoader = errors.New("nil keys loader")
+
+// ErrNilPubKeyConverter signals that a nil public key converter was provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
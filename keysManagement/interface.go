@@ -18,3 +18,9 @@ type CurrentEpochProvider interface {
 	CurrentEpoch() uint32
 	IsInterfaceNil() bool
 }
+
+// KeysLoader defines a component able to load all the keys from a pem file
+type KeysLoader interface {
+	LoadAllKeys(path string) ([][]byte, []string, error)
+	IsInterfaceNil() bool
+}
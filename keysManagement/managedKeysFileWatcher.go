@@ -0,0 +1,248 @@
+package keysManagement
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const minPollingInterval = time.Second
+
+// ArgsManagedKeysFileWatcher is the DTO used to create a new instance of managedKeysFileWatcher
+type ArgsManagedKeysFileWatcher struct {
+	FilePath           string
+	KeysLoader         KeysLoader
+	KeyGenerator       crypto.KeyGenerator
+	ManagedPeersHolder common.ManagedPeersHolder
+	PubKeyConverter    core.PubkeyConverter
+	PollingInterval    time.Duration
+}
+
+// managedKeysFileWatcher polls the allValidatorsKeys file and applies its changes on the managed peers holder:
+// the keys added in the file will be managed by the node while the keys removed from the file will be released
+type managedKeysFileWatcher struct {
+	filePath           string
+	keysLoader         KeysLoader
+	keyGenerator       crypto.KeyGenerator
+	managedPeersHolder common.ManagedPeersHolder
+	pubKeyConverter    core.PubkeyConverter
+	pollingInterval    time.Duration
+	lastModTime        time.Time
+	fileKeys           map[string]struct{}
+	cancelFunc         func()
+}
+
+// NewManagedKeysFileWatcher creates a new instance of managedKeysFileWatcher and starts polling the file
+func NewManagedKeysFileWatcher(args ArgsManagedKeysFileWatcher) (*managedKeysFileWatcher, error) {
+	err := checkArgsManagedKeysFileWatcher(args)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher := &managedKeysFileWatcher{
+		filePath:           args.FilePath,
+		keysLoader:         args.KeysLoader,
+		keyGenerator:       args.KeyGenerator,
+		managedPeersHolder: args.ManagedPeersHolder,
+		pubKeyConverter:    args.PubKeyConverter,
+		pollingInterval:    args.PollingInterval,
+		fileKeys:           make(map[string]struct{}),
+	}
+
+	// the keys already present in the file were loaded on the node start
+	watcher.lastModTime, _ = watcher.getModTime()
+	fileKeys, _, err := watcher.loadFileKeys()
+	if err != nil {
+		log.Debug("managedKeysFileWatcher: could not load the initial keys", "file", args.FilePath, "error", err)
+	} else {
+		watcher.fileKeys = fileKeys
+	}
+
+	var ctx context.Context
+	ctx, watcher.cancelFunc = context.WithCancel(context.Background())
+	go watcher.processLoop(ctx)
+
+	return watcher, nil
+}
+
+func checkArgsManagedKeysFileWatcher(args ArgsManagedKeysFileWatcher) error {
+	if len(args.FilePath) == 0 {
+		return ErrEmptyFilePath
+	}
+	if check.IfNil(args.KeysLoader) {
+		return ErrNilKeysLoader
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return fmt.Errorf("%w for args.KeyGenerator", ErrNilKeyGenerator)
+	}
+	if check.IfNil(args.ManagedPeersHolder) {
+		return ErrNilManagedPeersHolder
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if args.PollingInterval < minPollingInterval {
+		return fmt.Errorf("%w for PollingInterval, minimum %v, provided %v",
+			ErrInvalidValue, minPollingInterval, args.PollingInterval)
+	}
+
+	return nil
+}
+
+func (watcher *managedKeysFileWatcher) processLoop(ctx context.Context) {
+	timer := time.NewTimer(watcher.pollingInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("managedKeysFileWatcher's go routine is stopping...")
+			return
+		case <-timer.C:
+		}
+
+		watcher.checkFile()
+		timer.Reset(watcher.pollingInterval)
+	}
+}
+
+func (watcher *managedKeysFileWatcher) checkFile() {
+	modTime, err := watcher.getModTime()
+	if err != nil {
+		log.Trace("managedKeysFileWatcher: could not stat the file", "file", watcher.filePath, "error", err)
+		return
+	}
+	if modTime.Equal(watcher.lastModTime) {
+		return
+	}
+
+	fileKeys, privateKeys, err := watcher.loadFileKeys()
+	if err != nil {
+		// the file might be partially written, it will be retried on the next check
+		log.Warn("managedKeysFileWatcher: could not load the keys", "file", watcher.filePath, "error", err)
+		return
+	}
+	watcher.lastModTime = modTime
+
+	// only the keys newly added in the file are added, so the keys removed through the API are not re-added
+	numAdded := 0
+	for pk, skBytes := range privateKeys {
+		_, wasInFile := watcher.fileKeys[pk]
+		if wasInFile || watcher.managedPeersHolder.IsKeyRegistered([]byte(pk)) {
+			continue
+		}
+
+		err = watcher.managedPeersHolder.AddManagedPeer(skBytes)
+		if err != nil {
+			log.Warn("managedKeysFileWatcher: could not add the managed key",
+				"public key", watcher.pubKeyConverter.SilentEncode([]byte(pk), log), "error", err)
+			// stop tracking the key so the addition is retried on the next file change
+			delete(fileKeys, pk)
+			continue
+		}
+		numAdded++
+	}
+
+	numRemoved := 0
+	for pk := range watcher.fileKeys {
+		_, stillInFile := fileKeys[pk]
+		if stillInFile || !watcher.managedPeersHolder.IsKeyRegistered([]byte(pk)) {
+			continue
+		}
+
+		err = watcher.managedPeersHolder.RemoveManagedPeer([]byte(pk))
+		if err != nil {
+			log.Warn("managedKeysFileWatcher: could not remove the managed key",
+				"public key", watcher.pubKeyConverter.SilentEncode([]byte(pk), log), "error", err)
+			// keep tracking the key so the removal is retried on the next file change
+			fileKeys[pk] = struct{}{}
+			continue
+		}
+		numRemoved++
+	}
+	watcher.fileKeys = fileKeys
+
+	log.Info("managed keys file reloaded", "file", watcher.filePath,
+		"num keys added", numAdded, "num keys removed", numRemoved)
+}
+
+func (watcher *managedKeysFileWatcher) getModTime() (time.Time, error) {
+	info, err := os.Stat(watcher.filePath)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return info.ModTime(), nil
+}
+
+// loadFileKeys returns the set of public keys found in the file, along with the private key bytes for each public key
+func (watcher *managedKeysFileWatcher) loadFileKeys() (map[string]struct{}, map[string][]byte, error) {
+	encodedPrivateKeys, publicKeys, err := watcher.keysLoader.LoadAllKeys(watcher.filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(encodedPrivateKeys) != len(publicKeys) {
+		return nil, nil, fmt.Errorf("%w, mismatch number of private and public keys", ErrInvalidKey)
+	}
+
+	fileKeys := make(map[string]struct{}, len(publicKeys))
+	privateKeys := make(map[string][]byte, len(publicKeys))
+	for i, pkString := range publicKeys {
+		pkBytes, skBytes, errCheck := watcher.processKeyPair(encodedPrivateKeys[i], pkString)
+		if errCheck != nil {
+			return nil, nil, fmt.Errorf("%w, key index %d", errCheck, i)
+		}
+
+		fileKeys[string(pkBytes)] = struct{}{}
+		privateKeys[string(pkBytes)] = skBytes
+	}
+
+	return fileKeys, privateKeys, nil
+}
+
+func (watcher *managedKeysFileWatcher) processKeyPair(encodedSk []byte, pkString string) ([]byte, []byte, error) {
+	skBytes, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for encoded secret key", err)
+	}
+
+	pkBytes, err := watcher.pubKeyConverter.Decode(pkString)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for encoded public key %s", err, pkString)
+	}
+
+	sk, err := watcher.keyGenerator.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for secret key of public key %s", err, pkString)
+	}
+
+	pkGeneratedBytes, err := sk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while generating public key bytes for %s", err, pkString)
+	}
+	if !bytes.Equal(pkGeneratedBytes, pkBytes) {
+		return nil, nil, fmt.Errorf("%w, public keys mismatch for %s", ErrInvalidKey, pkString)
+	}
+
+	return pkBytes, skBytes, nil
+}
+
+// Close stops polling the file
+func (watcher *managedKeysFileWatcher) Close() error {
+	watcher.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (watcher *managedKeysFileWatcher) IsInterfaceNil() bool {
+	return watcher == nil
+}
@@ -0,0 +1,293 @@
+package keysManagement_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/factory/mock"
+	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type keysFileContent struct {
+	mut         sync.RWMutex
+	privateKeys [][]byte
+	publicKeys  []string
+	err         error
+	numLoads    int
+}
+
+func (content *keysFileContent) set(err error, secretKeys ...[]byte) {
+	content.mut.Lock()
+	defer content.mut.Unlock()
+
+	content.err = err
+	content.privateKeys = make([][]byte, 0, len(secretKeys))
+	content.publicKeys = make([]string, 0, len(secretKeys))
+	for _, sk := range secretKeys {
+		content.privateKeys = append(content.privateKeys, []byte(hex.EncodeToString(sk)))
+		pk := bytes.Replace(sk, []byte("private"), []byte("public"), -1)
+		content.publicKeys = append(content.publicKeys, hex.EncodeToString(pk))
+	}
+}
+
+func (content *keysFileContent) loader() *mock.KeyLoaderStub {
+	return &mock.KeyLoaderStub{
+		LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+			content.mut.Lock()
+			defer content.mut.Unlock()
+
+			content.numLoads++
+			return content.privateKeys, content.publicKeys, content.err
+		},
+	}
+}
+
+func (content *keysFileContent) getNumLoads() int {
+	content.mut.RLock()
+	defer content.mut.RUnlock()
+
+	return content.numLoads
+}
+
+func createMockArgsManagedKeysFileWatcher(t *testing.T, content *keysFileContent) keysManagement.ArgsManagedKeysFileWatcher {
+	filePath := filepath.Join(t.TempDir(), "allValidatorsKeys.pem")
+	require.Nil(t, os.WriteFile(filePath, []byte("keys"), 0644))
+
+	holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+
+	return keysManagement.ArgsManagedKeysFileWatcher{
+		FilePath:           filePath,
+		KeysLoader:         content.loader(),
+		KeyGenerator:       createMockKeyGenerator(),
+		ManagedPeersHolder: holder,
+		PubKeyConverter:    testscommon.NewPubkeyConverterMock(len(pkBytes0)),
+		PollingInterval:    time.Hour,
+	}
+}
+
+func touchFile(t *testing.T, filePath string, modTime time.Time) {
+	require.Nil(t, os.Chtimes(filePath, modTime, modTime))
+}
+
+func TestNewManagedKeysFileWatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysFileWatcher(t, &keysFileContent{})
+		args.FilePath = ""
+		watcher, err := keysManagement.NewManagedKeysFileWatcher(args)
+		assert.Equal(t, keysManagement.ErrEmptyFilePath, err)
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("nil keys loader should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysFileWatcher(t, &keysFileContent{})
+		args.KeysLoader = nil
+		watcher, err := keysManagement.NewManagedKeysFileWatcher(args)
+		assert.Equal(t, keysManagement.ErrNilKeysLoader, err)
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysFileWatcher(t, &keysFileContent{})
+		args.KeyGenerator = nil
+		watcher, err := keysManagement.NewManagedKeysFileWatcher(args)
+		assert.ErrorIs(t, err, keysManagement.ErrNilKeyGenerator)
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("nil managed peers holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysFileWatcher(t, &keysFileContent{})
+		args.ManagedPeersHolder = nil
+		watcher, err := keysManagement.NewManagedKeysFileWatcher(args)
+		assert.Equal(t, keysManagement.ErrNilManagedPeersHolder, err)
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysFileWatcher(t, &keysFileContent{})
+		args.PubKeyConverter = nil
+		watcher, err := keysManagement.NewManagedKeysFileWatcher(args)
+		assert.Equal(t, keysManagement.ErrNilPubKeyConverter, err)
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("invalid polling interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysFileWatcher(t, &keysFileContent{})
+		args.PollingInterval = time.Millisecond
+		watcher, err := keysManagement.NewManagedKeysFileWatcher(args)
+		assert.ErrorIs(t, err, keysManagement.ErrInvalidValue)
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		content := &keysFileContent{}
+		content.set(nil, skBytes0)
+		watcher, err := keysManagement.NewManagedKeysFileWatcher(createMockArgsManagedKeysFileWatcher(t, content))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(watcher))
+		assert.Equal(t, 1, content.getNumLoads())
+		assert.Nil(t, watcher.Close())
+	})
+}
+
+func TestManagedKeysFileWatcher_CheckFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unchanged file should not reload", func(t *testing.T) {
+		t.Parallel()
+
+		content := &keysFileContent{}
+		content.set(nil, skBytes0)
+		args := createMockArgsManagedKeysFileWatcher(t, content)
+		watcher, _ := keysManagement.NewManagedKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		watcher.CheckFile()
+		assert.Equal(t, 1, content.getNumLoads())
+	})
+	t.Run("missing file should not reload", func(t *testing.T) {
+		t.Parallel()
+
+		content := &keysFileContent{}
+		args := createMockArgsManagedKeysFileWatcher(t, content)
+		watcher, _ := keysManagement.NewManagedKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		require.Nil(t, os.Remove(args.FilePath))
+		watcher.CheckFile()
+		assert.Equal(t, 1, content.getNumLoads())
+	})
+	t.Run("changed file should add and remove keys", func(t *testing.T) {
+		t.Parallel()
+
+		content := &keysFileContent{}
+		content.set(nil, skBytes0)
+		args := createMockArgsManagedKeysFileWatcher(t, content)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		watcher, _ := keysManagement.NewManagedKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		content.set(nil, skBytes1)
+		touchFile(t, args.FilePath, time.Now().Add(time.Minute))
+		watcher.CheckFile()
+
+		assert.Equal(t, [][]byte{pkBytes1}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+	})
+	t.Run("key removed through the API should not be re-added", func(t *testing.T) {
+		t.Parallel()
+
+		content := &keysFileContent{}
+		content.set(nil, skBytes0, skBytes1)
+		args := createMockArgsManagedKeysFileWatcher(t, content)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes1)
+		watcher, _ := keysManagement.NewManagedKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		require.Nil(t, args.ManagedPeersHolder.RemoveManagedPeer(pkBytes1))
+
+		// the file is changed but still contains the key removed through the API
+		touchFile(t, args.FilePath, time.Now().Add(time.Minute))
+		watcher.CheckFile()
+		assert.Equal(t, [][]byte{pkBytes0}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+
+		// the key is added back once it is removed from the file and added again
+		content.set(nil, skBytes0)
+		touchFile(t, args.FilePath, time.Now().Add(time.Hour))
+		watcher.CheckFile()
+		content.set(nil, skBytes0, skBytes1)
+		touchFile(t, args.FilePath, time.Now().Add(2*time.Hour))
+		watcher.CheckFile()
+		assert.Equal(t, [][]byte{pkBytes0, pkBytes1}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+	})
+	t.Run("load error should retry on the next check", func(t *testing.T) {
+		t.Parallel()
+
+		content := &keysFileContent{}
+		content.set(nil, skBytes0)
+		args := createMockArgsManagedKeysFileWatcher(t, content)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		watcher, _ := keysManagement.NewManagedKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		content.set(errors.New("partially written file"))
+		touchFile(t, args.FilePath, time.Now().Add(time.Minute))
+		watcher.CheckFile()
+		assert.Equal(t, [][]byte{pkBytes0}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+
+		content.set(nil, skBytes0, skBytes1)
+		watcher.CheckFile()
+		assert.Equal(t, [][]byte{pkBytes0, pkBytes1}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+		assert.Equal(t, 3, content.getNumLoads())
+	})
+	t.Run("mismatched key pair should not change the managed keys", func(t *testing.T) {
+		t.Parallel()
+
+		content := &keysFileContent{}
+		content.set(nil, skBytes0)
+		args := createMockArgsManagedKeysFileWatcher(t, content)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		watcher, _ := keysManagement.NewManagedKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		content.set(nil, skBytes1)
+		content.publicKeys[0] = hex.EncodeToString(pkBytes0)
+		touchFile(t, args.FilePath, time.Now().Add(time.Minute))
+		watcher.CheckFile()
+
+		assert.Equal(t, [][]byte{pkBytes0}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+	})
+	t.Run("last key removal should be retried on the next file change", func(t *testing.T) {
+		t.Parallel()
+
+		content := &keysFileContent{}
+		content.set(nil, skBytes0)
+		args := createMockArgsManagedKeysFileWatcher(t, content)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		watcher, _ := keysManagement.NewManagedKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		content.set(nil)
+		touchFile(t, args.FilePath, time.Now().Add(time.Minute))
+		watcher.CheckFile()
+		assert.Equal(t, [][]byte{pkBytes0}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+
+		content.set(nil, skBytes1)
+		touchFile(t, args.FilePath, time.Now().Add(time.Hour))
+		watcher.CheckFile()
+		assert.Equal(t, [][]byte{pkBytes1}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+	})
+}
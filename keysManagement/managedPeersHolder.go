@@ -193,6 +193,35 @@ func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
 	return nil
 }
 
+// RemoveManagedPeer will remove the managed peer identified by the provided public key bytes. The node will stop
+// signing and sending heartbeat messages on behalf of the key. It errors if the key is not managed or if it is the
+// last managed key, as the node can not switch from the multikey mode at runtime
+func (holder *managedPeersHolder) RemoveManagedPeer(pkBytes []byte) error {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	pInfo, found := holder.data[string(pkBytes)]
+	if !found {
+		return fmt.Errorf("%w in RemoveManagedPeer for public key %s",
+			ErrMissingPublicKeyDefinition, hex.EncodeToString(pkBytes))
+	}
+	if len(holder.data) == 1 {
+		return fmt.Errorf("%w, public key %s", ErrCanNotRemoveLastManagedKey, hex.EncodeToString(pkBytes))
+	}
+
+	delete(holder.data, string(pkBytes))
+	delete(holder.pids, pInfo.pid)
+
+	log.Debug("removed key definition",
+		"hex public key", hex.EncodeToString(pkBytes),
+		"pid", pInfo.pid.Pretty(),
+		"machine ID", pInfo.machineID,
+		"name", pInfo.nodeName,
+		"identity", pInfo.nodeIdentity)
+
+	return nil
+}
+
 func (holder *managedPeersHolder) getPeerInfo(pkBytes []byte) *peerInfo {
 	holder.mut.RLock()
 	defer holder.mut.RUnlock()
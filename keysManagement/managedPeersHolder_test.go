@@ -351,6 +351,84 @@ func TestManagedPeersHolder_AddManagedPeer(t *testing.T) {
 	})
 }
 
+func TestManagedPeersHolder_RemoveManagedPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		_ = holder.AddManagedPeer(skBytes0)
+
+		err := holder.RemoveManagedPeer(pkBytes1)
+		assert.True(t, errors.Is(err, keysManagement.ErrMissingPublicKeyDefinition))
+		assert.True(t, holder.IsKeyRegistered(pkBytes0))
+	})
+	t.Run("last managed key should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		_ = holder.AddManagedPeer(skBytes0)
+
+		err := holder.RemoveManagedPeer(pkBytes0)
+		assert.True(t, errors.Is(err, keysManagement.ErrCanNotRemoveLastManagedKey))
+		assert.True(t, holder.IsKeyRegistered(pkBytes0))
+		assert.True(t, holder.IsMultiKeyMode())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		_ = holder.AddManagedPeer(skBytes0)
+		_ = holder.AddManagedPeer(skBytes1)
+
+		err := holder.RemoveManagedPeer(pkBytes0)
+		assert.Nil(t, err)
+		assert.False(t, holder.IsKeyRegistered(pkBytes0))
+		assert.False(t, holder.IsKeyManagedByCurrentNode(pkBytes0))
+		assert.Equal(t, [][]byte{pkBytes1}, holder.GetLoadedKeysByCurrentNode())
+		testManagedKeys(t, holder.GetManagedKeysByCurrentNode(), pkBytes1)
+
+		skRecovered, err := holder.GetPrivateKey(pkBytes0)
+		assert.Nil(t, skRecovered)
+		assert.True(t, errors.Is(err, keysManagement.ErrMissingPublicKeyDefinition))
+
+		// the key can be added back
+		err = holder.AddManagedPeer(skBytes0)
+		assert.Nil(t, err)
+		testManagedKeys(t, holder.GetManagedKeysByCurrentNode(), pkBytes0, pkBytes1)
+	})
+	t.Run("removed key should not be considered by the redundancy machine", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder()
+		args.MaxRoundsOfInactivity = 2
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		_ = holder.AddManagedPeer(skBytes0)
+		_ = holder.AddManagedPeer(skBytes1)
+		for i := 0; i < args.MaxRoundsOfInactivity+1; i++ {
+			holder.IncrementRoundsWithoutReceivedMessages(pkBytes0)
+			holder.IncrementRoundsWithoutReceivedMessages(pkBytes1)
+		}
+		expectedReason := fmt.Sprintf(keysManagement.RedundancyReasonForMultipleKeys, 2)
+		assert.Equal(t, expectedReason, holder.GetRedundancyStepInReason())
+
+		err := holder.RemoveManagedPeer(pkBytes0)
+		assert.Nil(t, err)
+		assert.Equal(t, keysManagement.RedundancyReasonForOneKey, holder.GetRedundancyStepInReason())
+		testManagedKeys(t, holder.GetManagedKeysByCurrentNode(), pkBytes1)
+
+		// the consensus and heartbeat components might still use the removed key for the current round
+		holder.IncrementRoundsWithoutReceivedMessages(pkBytes0)
+		holder.ResetRoundsWithoutReceivedMessages(pkBytes0, "pid")
+		holder.SetValidatorState(pkBytes0, true)
+		holder.SetNextPeerAuthenticationTime(pkBytes0, time.Now())
+		assert.False(t, holder.IsKeyManagedByCurrentNode(pkBytes0))
+		assert.False(t, holder.IsKeyValidator(pkBytes0))
+		assert.Equal(t, keysManagement.RedundancyReasonForOneKey, holder.GetRedundancyStepInReason())
+	})
+}
+
 func TestManagedPeersHolder_GetPrivateKey(t *testing.T) {
 	t.Parallel()
 
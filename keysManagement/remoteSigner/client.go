@@ -0,0 +1,109 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=plugins=grpc:. remoteSigner.proto
+
+package remoteSigner
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const minRequestTimeout = time.Second
+
+var log = logger.GetOrCreate("keysManagement/remoteSigner")
+
+// ArgsClient is the DTO used to create a new instance of client
+type ArgsClient struct {
+	Address        string
+	RequestTimeout time.Duration
+}
+
+// client sends the signing requests to a remote signer over gRPC. Only Unix sockets (unix:///path/to/signer.sock)
+// are supported: the requests are neither authenticated nor encrypted, so the access to the signer is only granted
+// by the permissions of the socket file
+type client struct {
+	conn           *grpc.ClientConn
+	signerClient   RemoteSignerClient
+	requestTimeout time.Duration
+}
+
+// NewClient creates a new instance of client
+func NewClient(args ArgsClient) (*client, error) {
+	if len(args.Address) == 0 {
+		return nil, ErrEmptyAddress
+	}
+	if !strings.HasPrefix(args.Address, unixSocketPrefix) {
+		return nil, fmt.Errorf("%w %s, only Unix sockets (%s) are supported", ErrUnsupportedAddress, args.Address, unixSocketPrefix)
+	}
+	socketPath := strings.TrimPrefix(args.Address, unixSocketPrefix)
+	if len(socketPath) == 0 {
+		return nil, fmt.Errorf("%w for the Unix socket path", ErrEmptyAddress)
+	}
+	if args.RequestTimeout < minRequestTimeout {
+		return nil, fmt.Errorf("%w for RequestTimeout, minimum %v, provided %v",
+			ErrInvalidValue, minRequestTimeout, args.RequestTimeout)
+	}
+
+	conn, err := grpc.Dial(args.Address,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			dialer := net.Dialer{}
+			return dialer.DialContext(ctx, "unix", socketPath)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(NewCodec())),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client{
+		conn:           conn,
+		signerClient:   NewRemoteSignerClient(conn),
+		requestTimeout: args.RequestTimeout,
+	}, nil
+}
+
+// PublicKeys returns the public keys held by the remote signer
+func (c *client) PublicKeys() ([][]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.requestTimeout)
+	defer cancel()
+
+	response, err := c.signerClient.PublicKeys(ctx, &PublicKeysRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRemoteSigner, status.Convert(err).Message())
+	}
+
+	return response.PublicKeys, nil
+}
+
+// Sign sends the signing request to the remote signer and returns the signature
+func (c *client) Sign(request *SignRequest) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.requestTimeout)
+	defer cancel()
+
+	response, err := c.signerClient.Sign(ctx, request)
+	if err != nil {
+		log.Debug("remote signer refused the signing request",
+			"public key", request.PublicKey, "type", request.Type, "round", request.Round, "error", err)
+		return nil, fmt.Errorf("%w: %s", ErrRemoteSigner, status.Convert(err).Message())
+	}
+
+	return response.Signature, nil
+}
+
+// Close closes the connection to the remote signer
+func (c *client) Close() error {
+	return c.conn.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *client) IsInterfaceNil() bool {
+	return c == nil
+}
@@ -0,0 +1,148 @@
+package remoteSigner_test
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := remoteSigner.NewClient(remoteSigner.ArgsClient{RequestTimeout: time.Second})
+		assert.Equal(t, remoteSigner.ErrEmptyAddress, err)
+		assert.True(t, check.IfNil(c))
+	})
+	t.Run("http address should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := remoteSigner.NewClient(remoteSigner.ArgsClient{Address: "http://127.0.0.1:8080", RequestTimeout: time.Second})
+		assert.True(t, errors.Is(err, remoteSigner.ErrUnsupportedAddress))
+		assert.True(t, check.IfNil(c))
+	})
+	t.Run("tcp address should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := remoteSigner.NewClient(remoteSigner.ArgsClient{Address: "127.0.0.1:8080", RequestTimeout: time.Second})
+		assert.True(t, errors.Is(err, remoteSigner.ErrUnsupportedAddress))
+		assert.True(t, check.IfNil(c))
+	})
+	t.Run("empty socket path should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := remoteSigner.NewClient(remoteSigner.ArgsClient{Address: "unix://", RequestTimeout: time.Second})
+		assert.True(t, errors.Is(err, remoteSigner.ErrEmptyAddress))
+		assert.True(t, check.IfNil(c))
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := remoteSigner.NewClient(remoteSigner.ArgsClient{Address: "unix:///tmp/signer.sock", RequestTimeout: time.Millisecond})
+		assert.True(t, errors.Is(err, remoteSigner.ErrInvalidValue))
+		assert.True(t, check.IfNil(c))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := remoteSigner.NewClient(remoteSigner.ArgsClient{Address: "unix:///tmp/signer.sock", RequestTimeout: time.Second})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(c))
+		assert.Nil(t, c.Close())
+	})
+}
+
+func TestClient_OverUnixSocket(t *testing.T) {
+	t.Parallel()
+
+	// the Unix socket path length is limited, t.TempDir might be too long
+	dir, err := os.MkdirTemp("", "signer")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	socketPath := filepath.Join(dir, "signer.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.Nil(t, err)
+
+	args, publicKeys := createMockArgsSignerServer(1)
+	args.Listener = listener
+	_ = createSignerServer(t, args)
+
+	c, _ := remoteSigner.NewClient(remoteSigner.ArgsClient{Address: "unix://" + socketPath, RequestTimeout: time.Second})
+	defer func() {
+		_ = c.Close()
+	}()
+
+	receivedPublicKeys, err := c.PublicKeys()
+	require.Nil(t, err)
+	assert.Equal(t, publicKeys, receivedPublicKeys)
+
+	request := &remoteSigner.SignRequest{
+		PublicKey: []byte("pk"),
+		Message:   []byte("header hash"),
+		Type:      remoteSigner.SignatureShareType,
+		Round:     signerRound,
+	}
+	_, err = c.Sign(request)
+	assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigner))
+
+	kg, _ := remoteSigner.NewKeyGenerator(args.KeyGenerator)
+	remoteKey, _ := kg.PrivateKeyFromByteArray(remoteSigner.NewRemotePrivateKeyBytes(publicKeys[0]))
+	createSingleSigner := func(round int64) crypto.SingleSigner {
+		singleSigner, _ := remoteSigner.NewSingleSigner(remoteSigner.ArgsSingleSigner{
+			SingleSigner: args.SingleSigner,
+			SignerClient: c,
+			RoundHandler: &testscommon.RoundHandlerMock{
+				IndexCalled: func() int64 {
+					return round
+				},
+			},
+			SignatureType: remoteSigner.SignatureType,
+		})
+
+		return singleSigner
+	}
+
+	singleSigner := createSingleSigner(signerRound)
+	signature, err := singleSigner.Sign(remoteKey, []byte("message"))
+	require.Nil(t, err)
+	assert.Nil(t, singleSigner.Verify(remoteKey.GeneratePublic(), []byte("message"), signature))
+
+	// a node reporting a round far from the signer round can not get messages signed
+	singleSigner = createSingleSigner(signerRound + 10)
+	signature, err = singleSigner.Sign(remoteKey, []byte("message"))
+	assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigner))
+	assert.Contains(t, err.Error(), remoteSigner.ErrInvalidRound.Error())
+	assert.Nil(t, signature)
+}
+
+func TestClient_UnreachableSignerShouldError(t *testing.T) {
+	t.Parallel()
+
+	c, _ := remoteSigner.NewClient(remoteSigner.ArgsClient{Address: "unix:///missing/signer.sock", RequestTimeout: time.Second})
+	defer func() {
+		_ = c.Close()
+	}()
+
+	publicKeys, err := c.PublicKeys()
+	assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigner))
+	assert.Nil(t, publicKeys)
+
+	signature, err := c.Sign(&remoteSigner.SignRequest{})
+	assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigner))
+	assert.Nil(t, signature)
+}
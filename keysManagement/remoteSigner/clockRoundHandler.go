@@ -0,0 +1,49 @@
+package remoteSigner
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ArgsClockRoundHandler is the DTO used to create a new instance of clockRoundHandler
+type ArgsClockRoundHandler struct {
+	GenesisTime   time.Time
+	RoundDuration time.Duration
+	StartRound    int64
+}
+
+// clockRoundHandler computes the current round from the genesis time and the round duration, the same way the nodes
+// do, so the signer does not have to trust the round sent by the nodes. The host clock should be kept in sync
+type clockRoundHandler struct {
+	genesisTime        time.Time
+	roundDuration      time.Duration
+	startRound         int64
+	currentTimeHandler func() time.Time
+}
+
+// NewClockRoundHandler creates a new instance of clockRoundHandler
+func NewClockRoundHandler(args ArgsClockRoundHandler) (*clockRoundHandler, error) {
+	if args.RoundDuration <= 0 {
+		return nil, fmt.Errorf("%w for RoundDuration, provided %v", ErrInvalidValue, args.RoundDuration)
+	}
+
+	return &clockRoundHandler{
+		genesisTime:        args.GenesisTime,
+		roundDuration:      args.RoundDuration,
+		startRound:         args.StartRound,
+		currentTimeHandler: time.Now,
+	}, nil
+}
+
+// Index returns the index of the current round
+func (handler *clockRoundHandler) Index() int64 {
+	delta := handler.currentTimeHandler().Sub(handler.genesisTime).Nanoseconds()
+
+	return int64(math.Floor(float64(delta)/float64(handler.roundDuration.Nanoseconds()))) + handler.startRound
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *clockRoundHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,60 @@
+package remoteSigner_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClockRoundHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid round duration should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := remoteSigner.NewClockRoundHandler(remoteSigner.ArgsClockRoundHandler{GenesisTime: time.Now()})
+		assert.True(t, errors.Is(err, remoteSigner.ErrInvalidValue))
+		assert.True(t, check.IfNil(handler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := remoteSigner.NewClockRoundHandler(remoteSigner.ArgsClockRoundHandler{
+			GenesisTime:   time.Now(),
+			RoundDuration: time.Second,
+		})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(handler))
+	})
+}
+
+func TestClockRoundHandler_Index(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Unix(1000, 0)
+	args := remoteSigner.ArgsClockRoundHandler{
+		GenesisTime:   genesisTime,
+		RoundDuration: 6 * time.Second,
+		StartRound:    100,
+	}
+	handler, _ := remoteSigner.NewClockRoundHandler(args)
+
+	currentTime := genesisTime
+	handler.SetCurrentTimeHandler(func() time.Time {
+		return currentTime
+	})
+	assert.Equal(t, int64(100), handler.Index())
+
+	currentTime = genesisTime.Add(5999 * time.Millisecond)
+	assert.Equal(t, int64(100), handler.Index())
+
+	currentTime = genesisTime.Add(6 * time.Second)
+	assert.Equal(t, int64(101), handler.Index())
+
+	currentTime = genesisTime.Add(61 * time.Second)
+	assert.Equal(t, int64(110), handler.Index())
+}
@@ -0,0 +1,35 @@
+package remoteSigner
+
+import (
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+const codecName = "proto"
+
+// gogoProtoCodec is the gRPC codec of the remote signer, marshalling the messages with the gogo protobuf marshaller.
+// The messages are wire compatible with the standard protobuf codecs, so the signer can be implemented in any language
+type gogoProtoCodec struct {
+	marshaller marshal.Marshalizer
+}
+
+// NewCodec creates the gRPC codec to be used by the remote signer server and by its clients
+func NewCodec() *gogoProtoCodec {
+	return &gogoProtoCodec{
+		marshaller: &marshal.GogoProtoMarshalizer{},
+	}
+}
+
+// Marshal returns the wire format of the message
+func (codec *gogoProtoCodec) Marshal(v interface{}) ([]byte, error) {
+	return codec.marshaller.Marshal(v)
+}
+
+// Unmarshal parses the wire format into the message
+func (codec *gogoProtoCodec) Unmarshal(data []byte, v interface{}) error {
+	return codec.marshaller.Unmarshal(v, data)
+}
+
+// Name returns the name of the codec
+func (codec *gogoProtoCodec) Name() string {
+	return codecName
+}
@@ -0,0 +1,36 @@
+package remoteSigner
+
+const (
+	// SignatureType is the type used for the block header signatures
+	SignatureType = "signature"
+	// RandomSeedType is the type used for the randomness seeds signed by the block proposers
+	RandomSeedType = "randomSeed"
+	// PeerSignatureType is the type used for the peer ID signatures attached to the consensus and heartbeat messages
+	PeerSignatureType = "peerSignature"
+	// SignatureShareType is the type used for the consensus signature shares
+	SignatureShareType = "signatureShare"
+
+	unixSocketPrefix = "unix://"
+)
+
+func isSingleSignatureType(signatureType string) bool {
+	switch signatureType {
+	case SignatureType, RandomSeedType, PeerSignatureType:
+		return true
+	default:
+		return false
+	}
+}
+
+func isKnownSignatureType(signatureType string) bool {
+	return signatureType == SignatureShareType || isSingleSignatureType(signatureType)
+}
+
+func newSignRequest(publicKey []byte, message []byte, signatureType string, round int64) *SignRequest {
+	return &SignRequest{
+		PublicKey: publicKey,
+		Message:   message,
+		Type:      signatureType,
+		Round:     round,
+	}
+}
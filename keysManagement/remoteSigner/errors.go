@@ -0,0 +1,57 @@
+package remoteSigner
+
+import "errors"
+
+// ErrEmptyAddress signals that an empty address was provided
+var ErrEmptyAddress = errors.New("empty address")
+
+// ErrUnsupportedAddress signals that the provided address is not a Unix socket address
+var ErrUnsupportedAddress = errors.New("unsupported address")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilSignerClient signals that a nil signer client was provided
+var ErrNilSignerClient = errors.New("nil signer client")
+
+// ErrNilRoundHandler signals that a nil round handler was provided
+var ErrNilRoundHandler = errors.New("nil round handler")
+
+// ErrNilSingleSigner signals that a nil single signer was provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilMultiSignerContainer signals that a nil multi signer container was provided
+var ErrNilMultiSignerContainer = errors.New("nil multi signer container")
+
+// ErrNilKeyGenerator signals that a nil key generator was provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilListener signals that a nil listener was provided
+var ErrNilListener = errors.New("nil listener")
+
+// ErrNilPersister signals that a nil persister was provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrNilSlashingProtector signals that a nil slashing protector was provided
+var ErrNilSlashingProtector = errors.New("nil slashing protector")
+
+// ErrNoPrivateKeys signals that no private keys were provided
+var ErrNoPrivateKeys = errors.New("no private keys")
+
+// ErrUnknownPublicKey signals that the requested public key is not held by the signer
+var ErrUnknownPublicKey = errors.New("unknown public key")
+
+// ErrUnknownSignatureType signals that an unknown signature type was requested
+var ErrUnknownSignatureType = errors.New("unknown signature type")
+
+// ErrEmptyMessage signals that an empty message was requested to be signed
+var ErrEmptyMessage = errors.New("empty message")
+
+// ErrInvalidRound signals that the requested round is too far from the round computed by the signer
+var ErrInvalidRound = errors.New("invalid round")
+
+// ErrDoubleSigning signals that a different message was already signed with the same key in the same round
+var ErrDoubleSigning = errors.New("double signing attempt refused")
+
+// ErrRemoteSigner signals that the remote signer could not fulfill the request
+var ErrRemoteSigner = errors.New("remote signer error")
@@ -0,0 +1,8 @@
+package remoteSigner
+
+import "time"
+
+// SetCurrentTimeHandler -
+func (handler *clockRoundHandler) SetCurrentTimeHandler(currentTimeHandler func() time.Time) {
+	handler.currentTimeHandler = currentTimeHandler
+}
@@ -0,0 +1,23 @@
+package remoteSigner
+
+// SignerClient defines the operations supported by a remote signer client
+type SignerClient interface {
+	PublicKeys() ([][]byte, error)
+	Sign(request *SignRequest) ([]byte, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// RoundHandler defines the round index provider used to annotate the signing requests on the node side and to
+// check them on the signer side
+type RoundHandler interface {
+	Index() int64
+	IsInterfaceNil() bool
+}
+
+// SlashingProtector defines the component able to refuse signing two different messages of the same type in the same round
+type SlashingProtector interface {
+	CheckAndRecord(publicKey []byte, round int64, signatureType string, message []byte) error
+	Close() error
+	IsInterfaceNil() bool
+}
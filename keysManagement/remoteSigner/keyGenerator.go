@@ -0,0 +1,46 @@
+package remoteSigner
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
+
+// keyGenerator wraps a key generator so it can also create the placeholders for the keys held by the remote signer
+type keyGenerator struct {
+	crypto.KeyGenerator
+}
+
+// NewKeyGenerator creates a new instance of keyGenerator
+func NewKeyGenerator(keyGen crypto.KeyGenerator) (*keyGenerator, error) {
+	if check.IfNil(keyGen) {
+		return nil, ErrNilKeyGenerator
+	}
+
+	return &keyGenerator{
+		KeyGenerator: keyGen,
+	}, nil
+}
+
+// PrivateKeyFromByteArray returns a remote private key if the provided bytes were created by NewRemotePrivateKeyBytes,
+// otherwise the call is forwarded to the wrapped key generator
+func (kg *keyGenerator) PrivateKeyFromByteArray(b []byte) (crypto.PrivateKey, error) {
+	publicKeyBytes, isRemote := getRemotePublicKeyBytes(b)
+	if !isRemote {
+		return kg.KeyGenerator.PrivateKeyFromByteArray(b)
+	}
+
+	publicKey, err := kg.KeyGenerator.PublicKeyFromByteArray(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &remotePrivateKey{
+		publicKey:      publicKey,
+		publicKeyBytes: publicKeyBytes,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kg *keyGenerator) IsInterfaceNil() bool {
+	return kg == nil
+}
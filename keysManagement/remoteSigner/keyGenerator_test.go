@@ -0,0 +1,72 @@
+package remoteSigner_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKeyGenerator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		kg, err := remoteSigner.NewKeyGenerator(nil)
+		assert.Equal(t, remoteSigner.ErrNilKeyGenerator, err)
+		assert.True(t, check.IfNil(kg))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		kg, err := remoteSigner.NewKeyGenerator(signing.NewKeyGenerator(mcl.NewSuiteBLS12()))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(kg))
+	})
+}
+
+func TestKeyGenerator_PrivateKeyFromByteArray(t *testing.T) {
+	t.Parallel()
+
+	blsKeyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	kg, _ := remoteSigner.NewKeyGenerator(blsKeyGen)
+	sk, pk := blsKeyGen.GeneratePair()
+	skBytes, _ := sk.ToByteArray()
+	pkBytes, _ := pk.ToByteArray()
+
+	t.Run("local private key should work", func(t *testing.T) {
+		t.Parallel()
+
+		localKey, err := kg.PrivateKeyFromByteArray(skBytes)
+		require.Nil(t, err)
+		assert.NotNil(t, localKey.Scalar())
+		recoveredBytes, _ := localKey.ToByteArray()
+		assert.Equal(t, skBytes, recoveredBytes)
+	})
+	t.Run("invalid remote public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		remoteKey, err := kg.PrivateKeyFromByteArray(remoteSigner.NewRemotePrivateKeyBytes([]byte("invalid")))
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(remoteKey))
+	})
+	t.Run("remote private key should work", func(t *testing.T) {
+		t.Parallel()
+
+		remoteKeyBytes := remoteSigner.NewRemotePrivateKeyBytes(pkBytes)
+		remoteKey, err := kg.PrivateKeyFromByteArray(remoteKeyBytes)
+		require.Nil(t, err)
+		assert.Nil(t, remoteKey.Scalar())
+		assert.Equal(t, pk.Suite(), remoteKey.Suite())
+
+		generatedPkBytes, _ := remoteKey.GeneratePublic().ToByteArray()
+		assert.Equal(t, pkBytes, generatedPkBytes)
+		recoveredBytes, _ := remoteKey.ToByteArray()
+		assert.Equal(t, remoteKeyBytes, recoveredBytes)
+	})
+}
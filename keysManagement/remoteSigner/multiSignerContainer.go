@@ -0,0 +1,86 @@
+package remoteSigner
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
+)
+
+// ArgsMultiSignerContainer is the DTO used to create a new instance of multiSignerContainer
+type ArgsMultiSignerContainer struct {
+	MultiSignerContainer cryptoCommon.MultiSignerContainer
+	SignerClient         SignerClient
+	RoundHandler         RoundHandler
+}
+
+// multiSignerContainer wraps the multi signers of the provided container so the signature shares for the remote
+// private keys are created by the remote signer
+type multiSignerContainer struct {
+	multiSignerContainer cryptoCommon.MultiSignerContainer
+	signerClient         SignerClient
+	roundHandler         RoundHandler
+}
+
+// NewMultiSignerContainer creates a new instance of multiSignerContainer
+func NewMultiSignerContainer(args ArgsMultiSignerContainer) (*multiSignerContainer, error) {
+	if check.IfNil(args.MultiSignerContainer) {
+		return nil, ErrNilMultiSignerContainer
+	}
+	if check.IfNil(args.SignerClient) {
+		return nil, ErrNilSignerClient
+	}
+	if check.IfNil(args.RoundHandler) {
+		return nil, ErrNilRoundHandler
+	}
+
+	return &multiSignerContainer{
+		multiSignerContainer: args.MultiSignerContainer,
+		signerClient:         args.SignerClient,
+		roundHandler:         args.RoundHandler,
+	}, nil
+}
+
+// GetMultiSigner returns the wrapped multi signer for the provided epoch
+func (container *multiSignerContainer) GetMultiSigner(epoch uint32) (crypto.MultiSigner, error) {
+	multiSigner, err := container.multiSignerContainer.GetMultiSigner(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &multiSignerWrapper{
+		MultiSigner:  multiSigner,
+		signerClient: container.signerClient,
+		roundHandler: container.roundHandler,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (container *multiSignerContainer) IsInterfaceNil() bool {
+	return container == nil
+}
+
+type multiSignerWrapper struct {
+	crypto.MultiSigner
+	signerClient SignerClient
+	roundHandler RoundHandler
+}
+
+// CreateSignatureShare creates the signature share using the remote signer if the private key is held by it
+func (wrapper *multiSignerWrapper) CreateSignatureShare(privateKeyBytes []byte, message []byte) ([]byte, error) {
+	publicKeyBytes, isRemote := getRemotePublicKeyBytes(privateKeyBytes)
+	if !isRemote {
+		return wrapper.MultiSigner.CreateSignatureShare(privateKeyBytes, message)
+	}
+	if len(message) == 0 {
+		return nil, crypto.ErrNilMessage
+	}
+
+	request := newSignRequest(publicKeyBytes, message, SignatureShareType, wrapper.roundHandler.Index())
+
+	return wrapper.signerClient.Sign(request)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrapper *multiSignerWrapper) IsInterfaceNil() bool {
+	return wrapper == nil
+}
@@ -0,0 +1,132 @@
+package remoteSigner_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsMultiSignerContainer() remoteSigner.ArgsMultiSignerContainer {
+	return remoteSigner.ArgsMultiSignerContainer{
+		MultiSignerContainer: &cryptoMocks.MultiSignerContainerStub{},
+		SignerClient:         &cryptoMocks.RemoteSignerClientStub{},
+		RoundHandler:         &testscommon.RoundHandlerMock{},
+	}
+}
+
+func TestNewMultiSignerContainer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil multi signer container should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiSignerContainer()
+		args.MultiSignerContainer = nil
+		container, err := remoteSigner.NewMultiSignerContainer(args)
+		assert.Equal(t, remoteSigner.ErrNilMultiSignerContainer, err)
+		assert.True(t, check.IfNil(container))
+	})
+	t.Run("nil signer client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiSignerContainer()
+		args.SignerClient = nil
+		container, err := remoteSigner.NewMultiSignerContainer(args)
+		assert.Equal(t, remoteSigner.ErrNilSignerClient, err)
+		assert.True(t, check.IfNil(container))
+	})
+	t.Run("nil round handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiSignerContainer()
+		args.RoundHandler = nil
+		container, err := remoteSigner.NewMultiSignerContainer(args)
+		assert.Equal(t, remoteSigner.ErrNilRoundHandler, err)
+		assert.True(t, check.IfNil(container))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		container, err := remoteSigner.NewMultiSignerContainer(createMockArgsMultiSignerContainer())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(container))
+	})
+}
+
+func TestMultiSignerContainer_GetMultiSigner(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("wrapped container errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiSignerContainer()
+		args.MultiSignerContainer = &cryptoMocks.MultiSignerContainerStub{
+			GetMultiSignerCalled: func(epoch uint32) (crypto.MultiSigner, error) {
+				return nil, expectedErr
+			},
+		}
+		container, _ := remoteSigner.NewMultiSignerContainer(args)
+
+		multiSigner, err := container.GetMultiSigner(1)
+		assert.Equal(t, expectedErr, err)
+		assert.True(t, check.IfNil(multiSigner))
+	})
+	t.Run("should create the signature shares remotely only for the remote keys", func(t *testing.T) {
+		t.Parallel()
+
+		numLocalCalls := 0
+		numRemoteCalls := 0
+		args := createMockArgsMultiSignerContainer()
+		args.MultiSignerContainer = &cryptoMocks.MultiSignerContainerStub{
+			GetMultiSignerCalled: func(epoch uint32) (crypto.MultiSigner, error) {
+				return &cryptoMocks.MultiSignerStub{
+					CreateSignatureShareCalled: func(privateKeyBytes []byte, message []byte) ([]byte, error) {
+						numLocalCalls++
+						return []byte("local share"), nil
+					},
+				}, nil
+			},
+		}
+		args.RoundHandler = &testscommon.RoundHandlerMock{
+			IndexCalled: func() int64 {
+				return 37
+			},
+		}
+		args.SignerClient = &cryptoMocks.RemoteSignerClientStub{
+			SignCalled: func(request *remoteSigner.SignRequest) ([]byte, error) {
+				numRemoteCalls++
+				require.Equal(t, remoteSigner.SignatureShareType, request.Type)
+				require.Equal(t, int64(37), request.Round)
+				assert.Equal(t, []byte("pk"), request.PublicKey)
+				return []byte("remote share"), nil
+			},
+		}
+		container, _ := remoteSigner.NewMultiSignerContainer(args)
+
+		multiSigner, err := container.GetMultiSigner(1)
+		require.Nil(t, err)
+
+		share, err := multiSigner.CreateSignatureShare([]byte("local sk"), []byte("header hash"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("local share"), share)
+
+		share, err = multiSigner.CreateSignatureShare(remoteSigner.NewRemotePrivateKeyBytes([]byte("pk")), []byte("header hash"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("remote share"), share)
+
+		share, err = multiSigner.CreateSignatureShare(remoteSigner.NewRemotePrivateKeyBytes([]byte("pk")), nil)
+		assert.Equal(t, crypto.ErrNilMessage, err)
+		assert.Nil(t, share)
+
+		assert.Equal(t, 1, numLocalCalls)
+		assert.Equal(t, 1, numRemoteCalls)
+	})
+}
@@ -0,0 +1,62 @@
+package remoteSigner
+
+import (
+	"bytes"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
+
+// remoteKeyMarker prefixes the byte representation of a private key held by the remote signer. The resulting
+// length never matches the length of a real BLS private key
+var remoteKeyMarker = []byte("remoteSignerKey:")
+
+// remotePrivateKey is a placeholder for a private key held by the remote signer. It only knows its public key, the
+// signing operations being forwarded to the remote signer by the signers defined in this package
+type remotePrivateKey struct {
+	publicKey      crypto.PublicKey
+	publicKeyBytes []byte
+}
+
+// NewRemotePrivateKeyBytes returns the byte representation of the private key held by the remote signer for the
+// provided public key. The result can be used wherever private key bytes are expected
+func NewRemotePrivateKeyBytes(publicKeyBytes []byte) []byte {
+	result := make([]byte, 0, len(remoteKeyMarker)+len(publicKeyBytes))
+	result = append(result, remoteKeyMarker...)
+
+	return append(result, publicKeyBytes...)
+}
+
+func getRemotePublicKeyBytes(privateKeyBytes []byte) ([]byte, bool) {
+	if !bytes.HasPrefix(privateKeyBytes, remoteKeyMarker) {
+		return nil, false
+	}
+
+	publicKeyBytes := privateKeyBytes[len(remoteKeyMarker):]
+
+	return publicKeyBytes, len(publicKeyBytes) > 0
+}
+
+// ToByteArray returns the remote key marker followed by the public key bytes
+func (rpk *remotePrivateKey) ToByteArray() ([]byte, error) {
+	return NewRemotePrivateKeyBytes(rpk.publicKeyBytes), nil
+}
+
+// GeneratePublic returns the public key of the remote private key
+func (rpk *remotePrivateKey) GeneratePublic() crypto.PublicKey {
+	return rpk.publicKey
+}
+
+// Suite returns the suite of the public key
+func (rpk *remotePrivateKey) Suite() crypto.Suite {
+	return rpk.publicKey.Suite()
+}
+
+// Scalar returns nil as the secret scalar is only known by the remote signer
+func (rpk *remotePrivateKey) Scalar() crypto.Scalar {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rpk *remotePrivateKey) IsInterfaceNil() bool {
+	return rpk == nil
+}
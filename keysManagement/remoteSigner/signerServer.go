@@ -0,0 +1,188 @@
+package remoteSigner
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ArgsSignerServer is the DTO used to create a new instance of signerServer
+type ArgsSignerServer struct {
+	KeyGenerator      crypto.KeyGenerator
+	SingleSigner      crypto.SingleSigner
+	SlashingProtector SlashingProtector
+	RoundHandler      RoundHandler
+	MaxRoundsDrift    uint32
+	PrivateKeys       [][]byte
+	Listener          net.Listener
+}
+
+// signerServer is the reference implementation of the remote signer, serving over gRPC the requests sent by the
+// clients. The round of each signing request is checked against the round computed by the signer, so a compromised
+// node can not get messages signed for other rounds than the current one
+type signerServer struct {
+	server            *grpc.Server
+	singleSigner      crypto.SingleSigner
+	slashingProtector SlashingProtector
+	roundHandler      RoundHandler
+	maxRoundsDrift    int64
+	privateKeys       map[string]crypto.PrivateKey
+	publicKeys        [][]byte
+}
+
+// NewSignerServer creates a new instance of signerServer, serving the clients on the provided listener
+func NewSignerServer(args ArgsSignerServer) (*signerServer, error) {
+	err := checkArgsSignerServer(args)
+	if err != nil {
+		return nil, err
+	}
+
+	server := &signerServer{
+		server:            grpc.NewServer(grpc.ForceServerCodec(NewCodec())),
+		singleSigner:      args.SingleSigner,
+		slashingProtector: args.SlashingProtector,
+		roundHandler:      args.RoundHandler,
+		maxRoundsDrift:    int64(args.MaxRoundsDrift),
+		privateKeys:       make(map[string]crypto.PrivateKey, len(args.PrivateKeys)),
+		publicKeys:        make([][]byte, 0, len(args.PrivateKeys)),
+	}
+
+	for i, privateKeyBytes := range args.PrivateKeys {
+		privateKey, errKey := args.KeyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+		if errKey != nil {
+			return nil, fmt.Errorf("%w, key index %d", errKey, i)
+		}
+
+		publicKeyBytes, errKey := privateKey.GeneratePublic().ToByteArray()
+		if errKey != nil {
+			return nil, fmt.Errorf("%w, key index %d", errKey, i)
+		}
+
+		server.privateKeys[string(publicKeyBytes)] = privateKey
+		server.publicKeys = append(server.publicKeys, publicKeyBytes)
+	}
+
+	RegisterRemoteSignerServer(server.server, server)
+	go server.serve(args.Listener)
+
+	return server, nil
+}
+
+func checkArgsSignerServer(args ArgsSignerServer) error {
+	if check.IfNil(args.KeyGenerator) {
+		return ErrNilKeyGenerator
+	}
+	if check.IfNil(args.SingleSigner) {
+		return ErrNilSingleSigner
+	}
+	if check.IfNil(args.SlashingProtector) {
+		return ErrNilSlashingProtector
+	}
+	if check.IfNil(args.RoundHandler) {
+		return ErrNilRoundHandler
+	}
+	if len(args.PrivateKeys) == 0 {
+		return ErrNoPrivateKeys
+	}
+	if args.Listener == nil {
+		return ErrNilListener
+	}
+
+	return nil
+}
+
+func (server *signerServer) serve(listener net.Listener) {
+	err := server.server.Serve(listener)
+	if err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		log.Error("remote signer stopped serving", "error", err)
+	}
+}
+
+// PublicKeys returns the public keys of the held private keys
+func (server *signerServer) PublicKeys(_ context.Context, _ *PublicKeysRequest) (*PublicKeysResponse, error) {
+	return &PublicKeysResponse{PublicKeys: server.publicKeys}, nil
+}
+
+// Sign signs the requested message if the round of the request matches the round computed by the signer and if no
+// other message of the same type was already signed with the same key in the same round
+func (server *signerServer) Sign(_ context.Context, request *SignRequest) (*SignResponse, error) {
+	signature, err := server.sign(request)
+	if err != nil {
+		log.Warn("signing request refused",
+			"public key", request.PublicKey, "type", request.Type, "round", request.Round, "error", err)
+		return nil, status.Error(getErrorCode(err), err.Error())
+	}
+
+	return &SignResponse{Signature: signature}, nil
+}
+
+func (server *signerServer) sign(request *SignRequest) ([]byte, error) {
+	if len(request.Message) == 0 {
+		return nil, ErrEmptyMessage
+	}
+
+	privateKey, found := server.privateKeys[string(request.PublicKey)]
+	if !found {
+		return nil, fmt.Errorf("%w %s", ErrUnknownPublicKey, hex.EncodeToString(request.PublicKey))
+	}
+	if !isKnownSignatureType(request.Type) {
+		return nil, fmt.Errorf("%w %s", ErrUnknownSignatureType, request.Type)
+	}
+
+	err := server.checkRound(request.Round)
+	if err != nil {
+		return nil, err
+	}
+
+	// each signature type is protected separately, as the same key signs, in the same round, a consensus signature
+	// share, the peer ID and, if it is the proposer, the randomness seed and the block header
+	err = server.slashingProtector.CheckAndRecord(request.PublicKey, request.Round, request.Type, request.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	return server.singleSigner.Sign(privateKey, request.Message)
+}
+
+// checkRound allows a small drift between the requested round and the round computed by the signer, as the clocks of
+// the node and of the signer are not perfectly synchronized
+func (server *signerServer) checkRound(round int64) error {
+	currentRound := server.roundHandler.Index()
+	drift := round - currentRound
+	if drift < 0 {
+		drift = -drift
+	}
+	if drift > server.maxRoundsDrift {
+		return fmt.Errorf("%w, requested round %d, signer round %d", ErrInvalidRound, round, currentRound)
+	}
+
+	return nil
+}
+
+func getErrorCode(err error) codes.Code {
+	if errors.Is(err, ErrInvalidRound) || errors.Is(err, ErrDoubleSigning) {
+		return codes.PermissionDenied
+	}
+
+	return codes.InvalidArgument
+}
+
+// Close stops the server, waiting for the pending requests to finish
+func (server *signerServer) Close() error {
+	server.server.GracefulStop()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (server *signerServer) IsInterfaceNil() bool {
+	return server == nil
+}
@@ -0,0 +1,238 @@
+package remoteSigner_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const signerRound = int64(5)
+
+func createMockArgsSignerServer(numKeys int) (remoteSigner.ArgsSignerServer, [][]byte) {
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKeys := make([][]byte, 0, numKeys)
+	publicKeys := make([][]byte, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		sk, pk := keyGen.GeneratePair()
+		skBytes, _ := sk.ToByteArray()
+		pkBytes, _ := pk.ToByteArray()
+		privateKeys = append(privateKeys, skBytes)
+		publicKeys = append(publicKeys, pkBytes)
+	}
+
+	slashingProtector, _ := remoteSigner.NewSlashingProtector(database.NewMemDB())
+
+	return remoteSigner.ArgsSignerServer{
+		KeyGenerator:      keyGen,
+		SingleSigner:      &mclSig.BlsSingleSigner{},
+		SlashingProtector: slashingProtector,
+		RoundHandler: &testscommon.RoundHandlerMock{
+			IndexCalled: func() int64 {
+				return signerRound
+			},
+		},
+		MaxRoundsDrift: 1,
+		PrivateKeys:    privateKeys,
+		Listener:       bufconn.Listen(1024 * 1024),
+	}, publicKeys
+}
+
+func createSignerServer(t *testing.T, args remoteSigner.ArgsSignerServer) remoteSigner.RemoteSignerServer {
+	server, err := remoteSigner.NewSignerServer(args)
+	require.Nil(t, err)
+
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+
+	return server
+}
+
+func requireSignError(t *testing.T, err error, expectedCode codes.Code, expectedErr error) {
+	require.NotNil(t, err)
+	assert.Equal(t, expectedCode, status.Code(err))
+	assert.True(t, strings.Contains(status.Convert(err).Message(), expectedErr.Error()))
+}
+
+func TestNewSignerServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerServer(1)
+		args.KeyGenerator = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		assert.Equal(t, remoteSigner.ErrNilKeyGenerator, err)
+		assert.True(t, check.IfNil(server))
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerServer(1)
+		args.SingleSigner = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		assert.Equal(t, remoteSigner.ErrNilSingleSigner, err)
+		assert.True(t, check.IfNil(server))
+	})
+	t.Run("nil slashing protector should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerServer(1)
+		args.SlashingProtector = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		assert.Equal(t, remoteSigner.ErrNilSlashingProtector, err)
+		assert.True(t, check.IfNil(server))
+	})
+	t.Run("nil round handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerServer(1)
+		args.RoundHandler = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		assert.Equal(t, remoteSigner.ErrNilRoundHandler, err)
+		assert.True(t, check.IfNil(server))
+	})
+	t.Run("no private keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerServer(0)
+		server, err := remoteSigner.NewSignerServer(args)
+		assert.Equal(t, remoteSigner.ErrNoPrivateKeys, err)
+		assert.True(t, check.IfNil(server))
+	})
+	t.Run("nil listener should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerServer(1)
+		args.Listener = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		assert.Equal(t, remoteSigner.ErrNilListener, err)
+		assert.True(t, check.IfNil(server))
+	})
+	t.Run("invalid private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerServer(2)
+		args.PrivateKeys[1] = []byte("invalid")
+		server, err := remoteSigner.NewSignerServer(args)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "key index 1"))
+		assert.True(t, check.IfNil(server))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerServer(2)
+		server, err := remoteSigner.NewSignerServer(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(server))
+		assert.Nil(t, server.Close())
+	})
+}
+
+func TestSignerServer_Sign(t *testing.T) {
+	t.Parallel()
+
+	args, publicKeys := createMockArgsSignerServer(1)
+	server := createSignerServer(t, args)
+	publicKey := publicKeys[0]
+	message := []byte("header hash")
+	otherMessage := []byte("other header hash")
+	ctx := context.Background()
+
+	verifySignature := func(messageBytes []byte, signature []byte) {
+		pk, _ := args.KeyGenerator.PublicKeyFromByteArray(publicKey)
+		assert.Nil(t, args.SingleSigner.Verify(pk, messageBytes, signature))
+	}
+
+	t.Run("empty message should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := server.Sign(ctx, &remoteSigner.SignRequest{PublicKey: publicKey, Type: remoteSigner.SignatureType, Round: signerRound})
+		requireSignError(t, err, codes.InvalidArgument, remoteSigner.ErrEmptyMessage)
+		assert.Nil(t, response)
+	})
+	t.Run("unknown public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := server.Sign(ctx, &remoteSigner.SignRequest{PublicKey: []byte("pk"), Message: message, Type: remoteSigner.SignatureType, Round: signerRound})
+		requireSignError(t, err, codes.InvalidArgument, remoteSigner.ErrUnknownPublicKey)
+		assert.Nil(t, response)
+	})
+	t.Run("unknown signature type should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := server.Sign(ctx, &remoteSigner.SignRequest{PublicKey: publicKey, Message: message, Type: "unknown", Round: signerRound})
+		requireSignError(t, err, codes.InvalidArgument, remoteSigner.ErrUnknownSignatureType)
+		assert.Nil(t, response)
+	})
+	t.Run("round too far from the signer round should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := server.Sign(ctx, &remoteSigner.SignRequest{PublicKey: publicKey, Message: message, Type: remoteSigner.SignatureShareType, Round: signerRound + 2})
+		requireSignError(t, err, codes.PermissionDenied, remoteSigner.ErrInvalidRound)
+		assert.Nil(t, response)
+
+		response, err = server.Sign(ctx, &remoteSigner.SignRequest{PublicKey: publicKey, Message: message, Type: remoteSigner.SignatureShareType, Round: signerRound - 2})
+		requireSignError(t, err, codes.PermissionDenied, remoteSigner.ErrInvalidRound)
+		assert.Nil(t, response)
+	})
+	t.Run("should sign within the allowed drift and refuse double signing", func(t *testing.T) {
+		t.Parallel()
+
+		round := signerRound - 1
+		response, err := server.Sign(ctx, &remoteSigner.SignRequest{PublicKey: publicKey, Message: message, Type: remoteSigner.SignatureShareType, Round: round})
+		require.Nil(t, err)
+		verifySignature(message, response.Signature)
+
+		response, err = server.Sign(ctx, &remoteSigner.SignRequest{PublicKey: publicKey, Message: otherMessage, Type: remoteSigner.SignatureShareType, Round: round})
+		requireSignError(t, err, codes.PermissionDenied, remoteSigner.ErrDoubleSigning)
+		assert.Nil(t, response)
+
+		// each signature type is protected separately
+		response, err = server.Sign(ctx, &remoteSigner.SignRequest{PublicKey: publicKey, Message: otherMessage, Type: remoteSigner.SignatureType, Round: round})
+		require.Nil(t, err)
+		verifySignature(otherMessage, response.Signature)
+	})
+	t.Run("should refuse a second header signature in the same round", func(t *testing.T) {
+		t.Parallel()
+
+		round := signerRound + 1
+		_, err := server.Sign(ctx, &remoteSigner.SignRequest{PublicKey: publicKey, Message: message, Type: remoteSigner.SignatureType, Round: round})
+		require.Nil(t, err)
+
+		_, err = server.Sign(ctx, &remoteSigner.SignRequest{PublicKey: publicKey, Message: otherMessage, Type: remoteSigner.SignatureType, Round: round})
+		requireSignError(t, err, codes.PermissionDenied, remoteSigner.ErrDoubleSigning)
+
+		// the randomness and peer signatures of the same round are not affected
+		_, err = server.Sign(ctx, &remoteSigner.SignRequest{PublicKey: publicKey, Message: otherMessage, Type: remoteSigner.RandomSeedType, Round: round})
+		assert.Nil(t, err)
+		_, err = server.Sign(ctx, &remoteSigner.SignRequest{PublicKey: publicKey, Message: otherMessage, Type: remoteSigner.PeerSignatureType, Round: round})
+		assert.Nil(t, err)
+	})
+}
+
+func TestSignerServer_PublicKeys(t *testing.T) {
+	t.Parallel()
+
+	args, publicKeys := createMockArgsSignerServer(2)
+	server := createSignerServer(t, args)
+
+	response, err := server.PublicKeys(context.Background(), &remoteSigner.PublicKeysRequest{})
+	require.Nil(t, err)
+	assert.Equal(t, publicKeys, response.PublicKeys)
+}
@@ -0,0 +1,68 @@
+package remoteSigner
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
+
+// ArgsSingleSigner is the DTO used to create a new instance of singleSigner
+type ArgsSingleSigner struct {
+	SingleSigner  crypto.SingleSigner
+	SignerClient  SignerClient
+	RoundHandler  RoundHandler
+	SignatureType string
+}
+
+// singleSigner forwards the signing requests for the remote private keys to the remote signer, all other
+// operations being handled by the wrapped single signer. Each instance requests a single type of signatures
+type singleSigner struct {
+	crypto.SingleSigner
+	signerClient  SignerClient
+	roundHandler  RoundHandler
+	signatureType string
+}
+
+// NewSingleSigner creates a new instance of singleSigner
+func NewSingleSigner(args ArgsSingleSigner) (*singleSigner, error) {
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if check.IfNil(args.SignerClient) {
+		return nil, ErrNilSignerClient
+	}
+	if check.IfNil(args.RoundHandler) {
+		return nil, ErrNilRoundHandler
+	}
+	if !isSingleSignatureType(args.SignatureType) {
+		return nil, fmt.Errorf("%w %s", ErrUnknownSignatureType, args.SignatureType)
+	}
+
+	return &singleSigner{
+		SingleSigner:  args.SingleSigner,
+		signerClient:  args.SignerClient,
+		roundHandler:  args.RoundHandler,
+		signatureType: args.SignatureType,
+	}, nil
+}
+
+// Sign signs the provided message using the remote signer if the private key is held by it
+func (ss *singleSigner) Sign(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	remoteKey, isRemote := private.(*remotePrivateKey)
+	if !isRemote {
+		return ss.SingleSigner.Sign(private, msg)
+	}
+	if len(msg) == 0 {
+		return nil, crypto.ErrNilMessage
+	}
+
+	request := newSignRequest(remoteKey.publicKeyBytes, msg, ss.signatureType, ss.roundHandler.Index())
+
+	return ss.signerClient.Sign(request)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *singleSigner) IsInterfaceNil() bool {
+	return ss == nil
+}
@@ -0,0 +1,155 @@
+package remoteSigner_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSingleSigner() remoteSigner.ArgsSingleSigner {
+	return remoteSigner.ArgsSingleSigner{
+		SingleSigner:  &cryptoMocks.SingleSignerStub{},
+		SignerClient:  &cryptoMocks.RemoteSignerClientStub{},
+		RoundHandler:  &testscommon.RoundHandlerMock{},
+		SignatureType: remoteSigner.SignatureType,
+	}
+}
+
+func TestNewSingleSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSingleSigner()
+		args.SingleSigner = nil
+		signer, err := remoteSigner.NewSingleSigner(args)
+		assert.Equal(t, remoteSigner.ErrNilSingleSigner, err)
+		assert.True(t, check.IfNil(signer))
+	})
+	t.Run("unknown signature type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSingleSigner()
+		args.SignatureType = remoteSigner.SignatureShareType
+		signer, err := remoteSigner.NewSingleSigner(args)
+		assert.True(t, errors.Is(err, remoteSigner.ErrUnknownSignatureType))
+		assert.True(t, check.IfNil(signer))
+	})
+	t.Run("nil signer client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSingleSigner()
+		args.SignerClient = nil
+		signer, err := remoteSigner.NewSingleSigner(args)
+		assert.Equal(t, remoteSigner.ErrNilSignerClient, err)
+		assert.True(t, check.IfNil(signer))
+	})
+	t.Run("nil round handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSingleSigner()
+		args.RoundHandler = nil
+		signer, err := remoteSigner.NewSingleSigner(args)
+		assert.Equal(t, remoteSigner.ErrNilRoundHandler, err)
+		assert.True(t, check.IfNil(signer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		signer, err := remoteSigner.NewSingleSigner(createMockArgsSingleSigner())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(signer))
+	})
+}
+
+func TestSingleSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	blsKeyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	kg, _ := remoteSigner.NewKeyGenerator(blsKeyGen)
+	localKey, pk := blsKeyGen.GeneratePair()
+	pkBytes, _ := pk.ToByteArray()
+	remoteKey, _ := kg.PrivateKeyFromByteArray(remoteSigner.NewRemotePrivateKeyBytes(pkBytes))
+	providedMessage := []byte("message")
+	expectedErr := errors.New("expected error")
+
+	t.Run("local private key should use the wrapped signer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSingleSigner()
+		args.SingleSigner = &cryptoMocks.SingleSignerStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				assert.Equal(t, localKey, private)
+				return []byte("local signature"), nil
+			},
+		}
+		args.SignerClient = &cryptoMocks.RemoteSignerClientStub{
+			SignCalled: func(request *remoteSigner.SignRequest) ([]byte, error) {
+				assert.Fail(t, "should have not called the remote signer")
+				return nil, nil
+			},
+		}
+		signer, _ := remoteSigner.NewSingleSigner(args)
+
+		signature, err := signer.Sign(localKey, providedMessage)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("local signature"), signature)
+	})
+	t.Run("empty message should error", func(t *testing.T) {
+		t.Parallel()
+
+		signer, _ := remoteSigner.NewSingleSigner(createMockArgsSingleSigner())
+
+		signature, err := signer.Sign(remoteKey, nil)
+		assert.Equal(t, crypto.ErrNilMessage, err)
+		assert.Nil(t, signature)
+	})
+	t.Run("remote signer errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSingleSigner()
+		args.SignerClient = &cryptoMocks.RemoteSignerClientStub{
+			SignCalled: func(request *remoteSigner.SignRequest) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		signer, _ := remoteSigner.NewSingleSigner(args)
+
+		signature, err := signer.Sign(remoteKey, providedMessage)
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, signature)
+	})
+	t.Run("remote private key should use the remote signer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSingleSigner()
+		args.RoundHandler = &testscommon.RoundHandlerMock{
+			IndexCalled: func() int64 {
+				return 37
+			},
+		}
+		args.SignerClient = &cryptoMocks.RemoteSignerClientStub{
+			SignCalled: func(request *remoteSigner.SignRequest) ([]byte, error) {
+				require.Equal(t, remoteSigner.SignatureType, request.Type)
+				require.Equal(t, int64(37), request.Round)
+				assert.Equal(t, providedMessage, request.Message)
+				return []byte("remote signature"), nil
+			},
+		}
+		signer, _ := remoteSigner.NewSingleSigner(args)
+
+		signature, err := signer.Sign(remoteKey, providedMessage)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("remote signature"), signature)
+	})
+}
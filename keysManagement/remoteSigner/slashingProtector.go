@@ -0,0 +1,72 @@
+package remoteSigner
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const roundSize = 8
+
+// slashingProtector persists, for each public key and signature type, the message signed in each round and refuses
+// to sign a different message of the same type for an already signed round
+type slashingProtector struct {
+	mut       sync.Mutex
+	persister storage.Persister
+}
+
+// NewSlashingProtector creates a new instance of slashingProtector
+func NewSlashingProtector(persister storage.Persister) (*slashingProtector, error) {
+	if check.IfNil(persister) {
+		return nil, ErrNilPersister
+	}
+
+	return &slashingProtector{
+		persister: persister,
+	}, nil
+}
+
+// CheckAndRecord returns ErrDoubleSigning if a different message of the same signature type was already signed with
+// the provided public key in the same round, otherwise it records the message. Signing the same message again is allowed
+func (sp *slashingProtector) CheckAndRecord(publicKey []byte, round int64, signatureType string, message []byte) error {
+	key := createSlashingProtectionKey(publicKey, round, signatureType)
+
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	signedMessage, err := sp.persister.Get(key)
+	if err == nil {
+		if bytes.Equal(signedMessage, message) {
+			return nil
+		}
+
+		return fmt.Errorf("%w, public key %s, round %d, type %s",
+			ErrDoubleSigning, hex.EncodeToString(publicKey), round, signatureType)
+	}
+
+	return sp.persister.Put(key, message)
+}
+
+func createSlashingProtectionKey(publicKey []byte, round int64, signatureType string) []byte {
+	key := make([]byte, len(publicKey)+roundSize+len(signatureType))
+	copy(key, publicKey)
+	binary.BigEndian.PutUint64(key[len(publicKey):], uint64(round))
+	copy(key[len(publicKey)+roundSize:], signatureType)
+
+	return key
+}
+
+// Close closes the underlying persister
+func (sp *slashingProtector) Close() error {
+	return sp.persister.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *slashingProtector) IsInterfaceNil() bool {
+	return sp == nil
+}
@@ -0,0 +1,56 @@
+package remoteSigner_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSlashingProtector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := remoteSigner.NewSlashingProtector(nil)
+		assert.Equal(t, remoteSigner.ErrNilPersister, err)
+		assert.True(t, check.IfNil(sp))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := remoteSigner.NewSlashingProtector(database.NewMemDB())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(sp))
+	})
+}
+
+func TestSlashingProtector_CheckAndRecord(t *testing.T) {
+	t.Parallel()
+
+	persister := database.NewMemDB()
+	sp, _ := remoteSigner.NewSlashingProtector(persister)
+
+	sigType := remoteSigner.SignatureShareType
+	assert.Nil(t, sp.CheckAndRecord([]byte("pk1"), 10, sigType, []byte("header hash 1")))
+	// signing the same message again is allowed
+	assert.Nil(t, sp.CheckAndRecord([]byte("pk1"), 10, sigType, []byte("header hash 1")))
+	// another key, another round or another signature type are not affected
+	assert.Nil(t, sp.CheckAndRecord([]byte("pk2"), 10, sigType, []byte("header hash 2")))
+	assert.Nil(t, sp.CheckAndRecord([]byte("pk1"), 11, sigType, []byte("header hash 2")))
+	assert.Nil(t, sp.CheckAndRecord([]byte("pk1"), 10, remoteSigner.SignatureType, []byte("header hash 2")))
+
+	err := sp.CheckAndRecord([]byte("pk1"), 10, sigType, []byte("header hash 2"))
+	assert.True(t, errors.Is(err, remoteSigner.ErrDoubleSigning))
+	err = sp.CheckAndRecord([]byte("pk1"), 10, remoteSigner.SignatureType, []byte("header hash 1"))
+	assert.True(t, errors.Is(err, remoteSigner.ErrDoubleSigning))
+
+	// the records survive a restart as long as the persister does
+	newSp, _ := remoteSigner.NewSlashingProtector(persister)
+	err = newSp.CheckAndRecord([]byte("pk2"), 10, sigType, []byte("header hash 1"))
+	assert.True(t, errors.Is(err, remoteSigner.ErrDoubleSigning))
+}
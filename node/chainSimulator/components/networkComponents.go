@@ -18,6 +18,8 @@ type networkComponentsHolder struct {
 	outputAntiFloodHandler                 factory.P2PAntifloodHandler
 	pubKeyCacher                           process.TimeCacher
 	peerBlackListHandler                   process.PeerBlackListCacher
+	antifloodDashboard                     process.AntifloodDashboardHandler
+	peerReputationHandler                  process.PeerReputationHandler
 	peerHonestyHandler                     factory.PeerHonestyHandler
 	preferredPeersHolderHandler            factory.PreferredPeersHolderHandler
 	peersRatingHandler                     p2p.PeersRatingHandler
@@ -40,6 +42,8 @@ func CreateNetworkComponents(network SyncedBroadcastNetworkHandler) (*networkCom
 		outputAntiFloodHandler:                 disabled.NewAntiFlooder(),
 		pubKeyCacher:                           &disabledAntiflood.TimeCache{},
 		peerBlackListHandler:                   &disabledAntiflood.PeerBlacklistCacher{},
+		antifloodDashboard:                     &disabledAntiflood.AntifloodDashboard{},
+		peerReputationHandler:                  &disabledAntiflood.PeerReputationHandler{},
 		peerHonestyHandler:                     disabled.NewPeerHonesty(),
 		preferredPeersHolderHandler:            disabledFactory.NewPreferredPeersHolder(),
 		peersRatingHandler:                     disabledBootstrap.NewDisabledPeersRatingHandler(),
@@ -78,6 +82,16 @@ func (holder *networkComponentsHolder) PeerBlackListHandler() process.PeerBlackL
 	return holder.peerBlackListHandler
 }
 
+// AntifloodDashboard returns the antiflood dashboard
+func (holder *networkComponentsHolder) AntifloodDashboard() process.AntifloodDashboardHandler {
+	return holder.antifloodDashboard
+}
+
+// PeerReputationHandler returns the peer reputation handler
+func (holder *networkComponentsHolder) PeerReputationHandler() process.PeerReputationHandler {
+	return holder.peerReputationHandler
+}
+
 // PeerHonestyHandler returns the peer honesty handler
 func (holder *networkComponentsHolder) PeerHonestyHandler() factory.PeerHonestyHandler {
 	return holder.peerHonestyHandler
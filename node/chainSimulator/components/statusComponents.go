@@ -24,6 +24,7 @@ import (
 type statusComponentsHolder struct {
 	closeHandler             *closeHandler
 	outportHandler           outport.OutportHandler
+	replayDriverFactory      outport.ReplayDriverFactory
 	softwareVersionChecker   statistics.SoftwareVersionChecker
 	managedPeerMonitor       common.ManagedPeersMonitor
 	appStatusHandler         core.AppStatusHandler
@@ -50,13 +51,18 @@ func CreateStatusComponents(shardID uint32, appStatusHandler core.AppStatusHandl
 	if err != nil {
 		return nil, err
 	}
-	instance.outportHandler, err = factory.CreateOutport(&factory.OutportFactoryArgs{
+	outportFactoryArgs := &factory.OutportFactoryArgs{
 		IsImportDB:               false,
 		ShardID:                  shardID,
 		RetrialInterval:          time.Second,
 		HostDriversArgs:          hostDriverArgs,
 		EventNotifierFactoryArgs: &factory.EventNotifierFactoryArgs{},
-	})
+	}
+	instance.outportHandler, err = factory.CreateOutport(outportFactoryArgs)
+	if err != nil {
+		return nil, err
+	}
+	instance.replayDriverFactory, err = factory.NewReplayDriverFactory(outportFactoryArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +101,11 @@ func (s *statusComponentsHolder) OutportHandler() outport.OutportHandler {
 	return s.outportHandler
 }
 
+// OutportReplayDriverFactory will return the factory of the drivers used by the outport replay
+func (s *statusComponentsHolder) OutportReplayDriverFactory() outport.ReplayDriverFactory {
+	return s.replayDriverFactory
+}
+
 // SoftwareVersionChecker will return the software version checker
 func (s *statusComponentsHolder) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	return s.softwareVersionChecker
@@ -55,6 +55,7 @@ func TestStatusComponentsHolder_Getters(t *testing.T) {
 	require.NoError(t, err)
 
 	require.NotNil(t, comp.OutportHandler())
+	require.NotNil(t, comp.OutportReplayDriverFactory())
 	require.NotNil(t, comp.SoftwareVersionChecker())
 	require.NotNil(t, comp.ManagedPeersMonitor())
 	require.Nil(t, comp.CheckSubcomponents())
@@ -36,6 +36,9 @@ func TestCreateStore(t *testing.T) {
 		dataRetriever.EpochByHashUnit,
 		dataRetriever.ResultsHashesByTxHashUnit,
 		dataRetriever.TrieEpochRootHashUnit,
+		dataRetriever.ValidatorsHistoryUnit,
+		dataRetriever.RewardsBreakdownUnit,
+		dataRetriever.ContractsVerificationUnit,
 		dataRetriever.ShardHdrNonceHashDataUnit,
 		dataRetriever.UnitType(101), // shard 2
 	}
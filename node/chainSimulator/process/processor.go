@@ -97,7 +97,7 @@ func (creator *blocksCreator) CreateNewBlock() error {
 	}
 
 	signingHandler := creator.nodeHandler.GetCryptoComponents().ConsensusSigningHandler()
-	randSeed, err := signingHandler.CreateSignatureForPublicKey(newHeader.GetPrevRandSeed(), blsKey.PubKey())
+	randSeed, err := signingHandler.CreateRandomSeedSignatureForPublicKey(newHeader.GetPrevRandSeed(), blsKey.PubKey())
 	if err != nil {
 		return err
 	}
@@ -252,7 +252,7 @@ func TestBlocksCreator_CreateNewBlock(t *testing.T) {
 		err = creator.CreateNewBlock()
 		require.NoError(t, err)
 	})
-	t.Run("CreateSignatureForPublicKey failure should error", func(t *testing.T) {
+	t.Run("CreateRandomSeedSignatureForPublicKey failure should error", func(t *testing.T) {
 		t.Parallel()
 
 		nodeHandler := getNodeHandler()
@@ -261,7 +261,7 @@ func TestBlocksCreator_CreateNewBlock(t *testing.T) {
 			return &mock.CryptoComponentsStub{
 				KeysHandlerField: kh,
 				SigHandler: &testsConsensus.SigningHandlerStub{
-					CreateSignatureForPublicKeyCalled: func(message []byte, publicKeyBytes []byte) ([]byte, error) {
+					CreateRandomSeedSignatureForPublicKeyCalled: func(message []byte, publicKeyBytes []byte) ([]byte, error) {
 						return nil, expectedErr
 					},
 				},
@@ -138,3 +138,9 @@ var ErrNilStatusHandler = errors.New("nil status handler")
 
 // ErrNilCreateTransactionArgs signals that create transaction args is nil
 var ErrNilCreateTransactionArgs = errors.New("nil args for create transaction")
+
+// ErrNodeNotInMultiKeyMode signals that the node was not started in multikey mode
+var ErrNodeNotInMultiKeyMode = errors.New("node was not started in multikey mode")
+
+// ErrNilConsensusComponents signals that the consensus components were not created
+var ErrNilConsensusComponents = errors.New("nil consensus components")
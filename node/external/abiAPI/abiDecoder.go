@@ -0,0 +1,562 @@
+package abiAPI
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	boolType                     = "bool"
+	bigUintType                  = "BigUint"
+	bigIntType                   = "BigInt"
+	addressType                  = "Address"
+	h256Type                     = "H256"
+	codeMetadataType             = "CodeMetadata"
+	optionType                   = "Option"
+	listType                     = "List"
+	vecType                      = "Vec"
+	managedVecType               = "ManagedVec"
+	tupleType                    = "tuple"
+	variadicType                 = "variadic"
+	countedVariadicType          = "counted-variadic"
+	optionalType                 = "optional"
+	multiType                    = "multi"
+	addressLength                = 32
+	h256Length                   = 32
+	codeMetadataLength           = 2
+	maxSizeForNumericOutput      = 4
+	enumVariantNameKey           = "name"
+	enumVariantFieldsKey         = "fields"
+	optionNoneFlag          byte = 0
+	optionSomeFlag          byte = 1
+)
+
+var unsignedTypesSizes = map[string]int{
+	"u8":    1,
+	"u16":   2,
+	"u32":   4,
+	"u64":   8,
+	"usize": 4,
+}
+
+var signedTypesSizes = map[string]int{
+	"i8":    1,
+	"i16":   2,
+	"i32":   4,
+	"i64":   8,
+	"isize": 4,
+}
+
+// bytesTypes are the variable length types returned as hex strings
+var bytesTypes = map[string]struct{}{
+	"bytes":         {},
+	"ManagedBuffer": {},
+	"BoxedBytes":    {},
+}
+
+// stringTypes are the variable length types returned as strings
+var stringTypes = map[string]struct{}{
+	"TokenIdentifier":           {},
+	"EgldOrEsdtTokenIdentifier": {},
+	"utf-8 string":              {},
+	"String":                    {},
+}
+
+// genericTypesNumArgs holds the number of type arguments of the generic types, 0 meaning at least one
+var genericTypesNumArgs = map[string]int{
+	optionType:          1,
+	listType:            1,
+	vecType:             1,
+	managedVecType:      1,
+	variadicType:        1,
+	countedVariadicType: 1,
+	optionalType:        1,
+	tupleType:           0,
+	multiType:           0,
+}
+
+func isKnownType(name string) bool {
+	_, isUnsigned := unsignedTypesSizes[name]
+	_, isSigned := signedTypesSizes[name]
+	_, isBytes := bytesTypes[name]
+	_, isString := stringTypes[name]
+	_, isGeneric := genericTypesNumArgs[name]
+
+	switch name {
+	case boolType, bigUintType, bigIntType, addressType, h256Type, codeMetadataType:
+		return true
+	default:
+		return isUnsigned || isSigned || isBytes || isString || isGeneric
+	}
+}
+
+func checkTypeArguments(expr *typeExpression) error {
+	numArgs, isGeneric := genericTypesNumArgs[expr.name]
+	_, isArray := getArrayLength(expr.name)
+	if isArray {
+		isGeneric = true
+		numArgs = 1
+	}
+
+	switch {
+	case !isGeneric && len(expr.args) > 0:
+		return fmt.Errorf("%w: %s does not accept type arguments", errInvalidTypeExpression, expr.name)
+	case isGeneric && numArgs == 0 && len(expr.args) == 0:
+		return fmt.Errorf("%w: %s needs type arguments", errInvalidTypeExpression, expr.name)
+	case isGeneric && numArgs > 0 && len(expr.args) != numArgs:
+		return fmt.Errorf("%w: %s needs %d type argument(s)", errInvalidTypeExpression, expr.name, numArgs)
+	default:
+		return nil
+	}
+}
+
+func isMultiValueType(name string) bool {
+	switch name {
+	case variadicType, countedVariadicType, optionalType, multiType:
+		return true
+	default:
+		return false
+	}
+}
+
+func isListType(name string) bool {
+	switch name {
+	case listType, vecType, managedVecType:
+		return true
+	default:
+		return false
+	}
+}
+
+// abiDecoder decodes arguments, return data and event topics using the types defined in a contract ABI
+type abiDecoder struct {
+	contract        *contractABI
+	pubKeyConverter core.PubkeyConverter
+}
+
+func newABIDecoder(contract *contractABI, pubKeyConverter core.PubkeyConverter) *abiDecoder {
+	return &abiDecoder{
+		contract:        contract,
+		pubKeyConverter: pubKeyConverter,
+	}
+}
+
+// decodeParameters decodes the provided top level encoded arguments in the order of the ABI parameters
+func (decoder *abiDecoder) decodeParameters(parameters []*abiParameter, args [][]byte) ([]*common.ABIDecodedValue, error) {
+	values := make([]*common.ABIDecodedValue, 0, len(parameters))
+	index := 0
+	for _, parameter := range parameters {
+		expr, err := decoder.contract.getParsedType(parameter.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := decoder.decodeMultiValue(expr, args, &index)
+		if err != nil {
+			return nil, fmt.Errorf("%w for parameter %s", err, getParameterDescription(parameter))
+		}
+
+		values = append(values, &common.ABIDecodedValue{
+			Name:  parameter.Name,
+			Type:  parameter.Type,
+			Value: value,
+		})
+	}
+
+	if index < len(args) {
+		return nil, fmt.Errorf("%w: expected %d, provided %d", errTooManyArguments, index, len(args))
+	}
+
+	return values, nil
+}
+
+func getParameterDescription(parameter *abiParameter) string {
+	if len(parameter.Name) > 0 {
+		return parameter.Name
+	}
+
+	return parameter.Type
+}
+
+// decodeMultiValue decodes a value that might span over several top level arguments, moving the index accordingly
+func (decoder *abiDecoder) decodeMultiValue(expr *typeExpression, args [][]byte, index *int) (interface{}, error) {
+	switch expr.name {
+	case variadicType, countedVariadicType:
+		items := make([]interface{}, 0)
+		for *index < len(args) {
+			item, err := decoder.decodeMultiValue(expr.args[0], args, index)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case optionalType:
+		if *index >= len(args) {
+			return nil, nil
+		}
+		return decoder.decodeMultiValue(expr.args[0], args, index)
+	case multiType:
+		items := make([]interface{}, 0, len(expr.args))
+		for _, arg := range expr.args {
+			item, err := decoder.decodeMultiValue(arg, args, index)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	default:
+		if *index >= len(args) {
+			return nil, errMissingArgument
+		}
+
+		value, err := decoder.decodeTopLevel(expr, args[*index])
+		if err != nil {
+			return nil, err
+		}
+		*index++
+
+		return value, nil
+	}
+}
+
+// decodeTopLevel decodes a value encoded as a whole argument, without length prefixes
+func (decoder *abiDecoder) decodeTopLevel(expr *typeExpression, data []byte) (interface{}, error) {
+	name := expr.name
+	if isMultiValueType(name) {
+		return nil, errMultiValueNotAllowed
+	}
+
+	size, isUnsigned := unsignedTypesSizes[name]
+	if isUnsigned {
+		if len(data) > size {
+			return nil, fmt.Errorf("%w: %d bytes provided for %s", errTrailingData, len(data), name)
+		}
+		return formatNumber(big.NewInt(0).SetBytes(data), size), nil
+	}
+	size, isSigned := signedTypesSizes[name]
+	if isSigned {
+		if len(data) > size {
+			return nil, fmt.Errorf("%w: %d bytes provided for %s", errTrailingData, len(data), name)
+		}
+		return formatNumber(twosComplementToBigInt(data), size), nil
+	}
+
+	switch {
+	case name == bigUintType:
+		return big.NewInt(0).SetBytes(data).String(), nil
+	case name == bigIntType:
+		return twosComplementToBigInt(data).String(), nil
+	case name == boolType:
+		return decodeBool(data)
+	case name == codeMetadataType:
+		return hex.EncodeToString(data), nil
+	case name == optionType:
+		if len(data) == 0 {
+			return nil, nil
+		}
+	case isListType(name):
+		reader := newDataReader(data)
+		items := make([]interface{}, 0)
+		for !reader.isExhausted() {
+			item, err := decoder.decodeNested(expr.args[0], reader)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	}
+
+	_, isBytes := bytesTypes[name]
+	if isBytes {
+		return hex.EncodeToString(data), nil
+	}
+	_, isString := stringTypes[name]
+	if isString {
+		return string(data), nil
+	}
+
+	definition, isCustomType := decoder.contract.types[name]
+	if isCustomType && definition.Type == explicitEnumTypeKind {
+		return string(data), nil
+	}
+	if isCustomType && definition.Type == enumTypeKind && len(data) == 0 {
+		return decoder.decodeEnumVariant(definition, 0, newDataReader(data))
+	}
+
+	reader := newDataReader(data)
+	value, err := decoder.decodeNested(expr, reader)
+	if err != nil {
+		return nil, err
+	}
+	if !reader.isExhausted() {
+		return nil, fmt.Errorf("%w after decoding %s", errTrailingData, expr.String())
+	}
+
+	return value, nil
+}
+
+// decodeNested decodes a value that is part of a larger encoded value
+func (decoder *abiDecoder) decodeNested(expr *typeExpression, reader *dataReader) (interface{}, error) {
+	name := expr.name
+	if isMultiValueType(name) {
+		return nil, errMultiValueNotAllowed
+	}
+
+	size, isUnsigned := unsignedTypesSizes[name]
+	if isUnsigned {
+		buff, err := reader.read(size)
+		if err != nil {
+			return nil, err
+		}
+		return formatNumber(big.NewInt(0).SetBytes(buff), size), nil
+	}
+	size, isSigned := signedTypesSizes[name]
+	if isSigned {
+		buff, err := reader.read(size)
+		if err != nil {
+			return nil, err
+		}
+		return formatNumber(twosComplementToBigInt(buff), size), nil
+	}
+	_, isBytes := bytesTypes[name]
+	if isBytes {
+		buff, err := reader.readLengthPrefixed()
+		if err != nil {
+			return nil, err
+		}
+		return hex.EncodeToString(buff), nil
+	}
+	_, isString := stringTypes[name]
+	if isString {
+		buff, err := reader.readLengthPrefixed()
+		if err != nil {
+			return nil, err
+		}
+		return string(buff), nil
+	}
+	arrayLength, isArray := getArrayLength(name)
+	if isArray {
+		return decoder.decodeNestedArray(expr.args[0], arrayLength, reader)
+	}
+
+	switch {
+	case name == bigUintType:
+		buff, err := reader.readLengthPrefixed()
+		if err != nil {
+			return nil, err
+		}
+		return big.NewInt(0).SetBytes(buff).String(), nil
+	case name == bigIntType:
+		buff, err := reader.readLengthPrefixed()
+		if err != nil {
+			return nil, err
+		}
+		return twosComplementToBigInt(buff).String(), nil
+	case name == boolType:
+		buff, err := reader.read(1)
+		if err != nil {
+			return nil, err
+		}
+		return decodeBool(buff)
+	case name == addressType:
+		buff, err := reader.read(addressLength)
+		if err != nil {
+			return nil, err
+		}
+		return decoder.pubKeyConverter.Encode(buff)
+	case name == h256Type:
+		buff, err := reader.read(h256Length)
+		if err != nil {
+			return nil, err
+		}
+		return hex.EncodeToString(buff), nil
+	case name == codeMetadataType:
+		buff, err := reader.read(codeMetadataLength)
+		if err != nil {
+			return nil, err
+		}
+		return hex.EncodeToString(buff), nil
+	case name == optionType:
+		return decoder.decodeNestedOption(expr.args[0], reader)
+	case isListType(name):
+		return decoder.decodeNestedList(expr.args[0], reader)
+	case name == tupleType:
+		items := make([]interface{}, 0, len(expr.args))
+		for _, arg := range expr.args {
+			item, err := decoder.decodeNested(arg, reader)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	default:
+		return decoder.decodeNestedCustomType(name, reader)
+	}
+}
+
+func (decoder *abiDecoder) decodeNestedOption(expr *typeExpression, reader *dataReader) (interface{}, error) {
+	flag, err := reader.read(1)
+	if err != nil {
+		return nil, err
+	}
+
+	switch flag[0] {
+	case optionNoneFlag:
+		return nil, nil
+	case optionSomeFlag:
+		return decoder.decodeNested(expr, reader)
+	default:
+		return nil, fmt.Errorf("%w: %d", errInvalidOptionFlag, flag[0])
+	}
+}
+
+func (decoder *abiDecoder) decodeNestedList(expr *typeExpression, reader *dataReader) (interface{}, error) {
+	numItems, err := reader.readCount()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]interface{}, 0)
+	for i := uint32(0); i < numItems; i++ {
+		item, errDecode := decoder.decodeNested(expr, reader)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// decodeNestedArray decodes a fixed length array. Arrays of bytes are returned as hex strings
+func (decoder *abiDecoder) decodeNestedArray(expr *typeExpression, length int, reader *dataReader) (interface{}, error) {
+	if expr.name == "u8" {
+		buff, err := reader.read(length)
+		if err != nil {
+			return nil, err
+		}
+		return hex.EncodeToString(buff), nil
+	}
+
+	items := make([]interface{}, 0, length)
+	for i := 0; i < length; i++ {
+		item, err := decoder.decodeNested(expr, reader)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (decoder *abiDecoder) decodeNestedCustomType(name string, reader *dataReader) (interface{}, error) {
+	definition, found := decoder.contract.types[name]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownType, name)
+	}
+
+	switch definition.Type {
+	case structTypeKind:
+		return decoder.decodeFields(definition.Fields, reader)
+	case enumTypeKind:
+		discriminant, err := reader.read(1)
+		if err != nil {
+			return nil, err
+		}
+		return decoder.decodeEnumVariant(definition, int(discriminant[0]), reader)
+	case explicitEnumTypeKind:
+		buff, err := reader.readLengthPrefixed()
+		if err != nil {
+			return nil, err
+		}
+		return string(buff), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownType, definition.Type)
+	}
+}
+
+func (decoder *abiDecoder) decodeFields(fields []*abiField, reader *dataReader) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		expr, err := decoder.contract.getParsedType(field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := decoder.decodeNested(expr, reader)
+		if err != nil {
+			return nil, fmt.Errorf("%w for field %s", err, field.Name)
+		}
+		values[field.Name] = value
+	}
+
+	return values, nil
+}
+
+// decodeEnumVariant returns the name of the variant for the variants without fields and an object holding the
+// name and the fields of the variant otherwise
+func (decoder *abiDecoder) decodeEnumVariant(definition *abiTypeDefinition, discriminant int, reader *dataReader) (interface{}, error) {
+	for _, variant := range definition.Variants {
+		if variant.Discriminant != discriminant {
+			continue
+		}
+		if len(variant.Fields) == 0 {
+			return variant.Name, nil
+		}
+
+		fields, err := decoder.decodeFields(variant.Fields, reader)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]interface{}{
+			enumVariantNameKey:   variant.Name,
+			enumVariantFieldsKey: fields,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %d", errInvalidEnumDiscriminant, discriminant)
+}
+
+func decodeBool(data []byte) (interface{}, error) {
+	if len(data) == 0 {
+		return false, nil
+	}
+	if len(data) == 1 && data[0] <= 1 {
+		return data[0] == 1, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", errInvalidBoolValue, hex.EncodeToString(data))
+}
+
+// formatNumber returns the small numbers as JSON numbers and the 64 bits numbers as strings, so they will not lose
+// precision in JSON clients
+func formatNumber(value *big.Int, size int) interface{} {
+	if size > maxSizeForNumericOutput {
+		return value.String()
+	}
+	if value.Sign() < 0 {
+		return value.Int64()
+	}
+
+	return value.Uint64()
+}
+
+func twosComplementToBigInt(data []byte) *big.Int {
+	value := big.NewInt(0).SetBytes(data)
+	if len(data) > 0 && data[0]&0x80 != 0 {
+		offset := big.NewInt(0).Lsh(big.NewInt(1), uint(len(data)*8))
+		value.Sub(value, offset)
+	}
+
+	return value
+}
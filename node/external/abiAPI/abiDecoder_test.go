@@ -0,0 +1,195 @@
+package abiAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDecoderABI = `{
+	"name": "Test",
+	"endpoints": [],
+	"types": {
+		"Payment": {
+			"type": "struct",
+			"fields": [
+				{"name": "token", "type": "TokenIdentifier"},
+				{"name": "nonce", "type": "u64"},
+				{"name": "amount", "type": "BigUint"}
+			]
+		},
+		"Status": {
+			"type": "enum",
+			"variants": [
+				{"name": "Inactive", "discriminant": 0},
+				{"name": "Active", "discriminant": 1, "fields": [{"name": "0", "type": "u32"}]}
+			]
+		}
+	}
+}`
+
+func createTestDecoder(t *testing.T, types ...string) *abiDecoder {
+	contract, err := newContractABI([]byte(testDecoderABI))
+	require.Nil(t, err)
+
+	for _, typeExpr := range types {
+		expr, errParse := contract.parseType(typeExpr)
+		require.Nil(t, errParse)
+		require.Nil(t, contract.validateTypeExpression(expr))
+	}
+
+	return newABIDecoder(contract, testscommon.RealWorldBech32PubkeyConverter)
+}
+
+func decodeHex(t *testing.T, hexData string) []byte {
+	data, err := hex.DecodeString(hexData)
+	require.Nil(t, err)
+
+	return data
+}
+
+func TestParseTypeExpression(t *testing.T) {
+	t.Parallel()
+
+	expr, err := parseTypeExpression("variadic<multi<Address, List<Option<u64>>>>")
+	require.Nil(t, err)
+	assert.Equal(t, "variadic<multi<Address,List<Option<u64>>>>", expr.String())
+
+	expr, err = parseTypeExpression("utf-8 string")
+	require.Nil(t, err)
+	assert.Equal(t, "utf-8 string", expr.name)
+
+	invalidExpressions := []string{"", "List<", "List<u8", "List<u8>>", "<u8>", "tuple<u8,>"}
+	for _, invalidExpression := range invalidExpressions {
+		_, err = parseTypeExpression(invalidExpression)
+		assert.True(t, errors.Is(err, errInvalidTypeExpression), invalidExpression)
+	}
+}
+
+func TestAbiDecoder_DecodeTopLevel(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		typeExpr string
+		data     string
+		expected interface{}
+	}{
+		{"u8", "", uint64(0)},
+		{"u32", "0100", uint64(256)},
+		{"u64", "ffffffffffffffff", "18446744073709551615"},
+		{"i8", "ff", int64(-1)},
+		{"i64", "80", "-128"},
+		{"BigUint", "0de0b6b3a7640000", "1000000000000000000"},
+		{"BigInt", "ff38", "-200"},
+		{"bool", "01", true},
+		{"bool", "", false},
+		{"TokenIdentifier", hex.EncodeToString([]byte("WEGLD-bd4d79")), "WEGLD-bd4d79"},
+		{"bytes", "abcd", "abcd"},
+		{"Address", testscommon.TestPubKeyHexAlice, testscommon.TestAddressAlice},
+		{"Option<u32>", "", nil},
+		{"Option<u32>", "0100000005", uint64(5)},
+		{"List<u16>", "00010002", []interface{}{uint64(1), uint64(2)}},
+		{"Status", "", "Inactive"},
+		{"Status", "0100000007", map[string]interface{}{"name": "Active", "fields": map[string]interface{}{"0": uint64(7)}}},
+		{"array4<u8>", "01020304", "01020304"},
+		{
+			"Payment",
+			"0000000c" + hex.EncodeToString([]byte("WEGLD-bd4d79")) + "0000000000000001" + "0000000164",
+			map[string]interface{}{"token": "WEGLD-bd4d79", "nonce": "1", "amount": "100"},
+		},
+		{
+			"tuple<u8,List<BigUint>>",
+			"07" + "00000001" + "0000000105",
+			[]interface{}{uint64(7), []interface{}{"5"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		decoder := createTestDecoder(t, testCase.typeExpr)
+		expr, _ := decoder.contract.getParsedType(testCase.typeExpr)
+
+		value, err := decoder.decodeTopLevel(expr, decodeHex(t, testCase.data))
+		require.Nil(t, err, testCase.typeExpr)
+		assert.Equal(t, testCase.expected, value, testCase.typeExpr)
+	}
+}
+
+func TestAbiDecoder_DecodeTopLevelErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		typeExpr    string
+		data        string
+		expectedErr error
+	}{
+		{"u8", "0102", errTrailingData},
+		{"bool", "02", errInvalidBoolValue},
+		{"Address", "0102", errNotEnoughData},
+		{"Option<u8>", "0201", errInvalidOptionFlag},
+		{"Status", "05", errInvalidEnumDiscriminant},
+		{"List<BigUint>", "00000005", errNotEnoughData},
+		{"tuple<u8>", "0102", errTrailingData},
+	}
+
+	for _, testCase := range testCases {
+		decoder := createTestDecoder(t, testCase.typeExpr)
+		expr, _ := decoder.contract.getParsedType(testCase.typeExpr)
+
+		value, err := decoder.decodeTopLevel(expr, decodeHex(t, testCase.data))
+		assert.True(t, errors.Is(err, testCase.expectedErr), testCase.typeExpr)
+		assert.Nil(t, value, testCase.typeExpr)
+	}
+}
+
+func TestAbiDecoder_DecodeParameters(t *testing.T) {
+	t.Parallel()
+
+	parameters := []*abiParameter{
+		{Name: "first", Type: "u8"},
+		{Name: "pairs", Type: "variadic<multi<Address,BigUint>>"},
+	}
+	decoder := createTestDecoder(t, "u8", "variadic<multi<Address,BigUint>>", "optional<u8>")
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := [][]byte{{7}, testscommon.TestPubKeyAlice, {10}, testscommon.TestPubKeyBob, {20}}
+		values, err := decoder.decodeParameters(parameters, args)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(values))
+		assert.Equal(t, "first", values[0].Name)
+		assert.Equal(t, uint64(7), values[0].Value)
+		assert.Equal(t, "variadic<multi<Address,BigUint>>", values[1].Type)
+		expectedPairs := []interface{}{
+			[]interface{}{testscommon.TestAddressAlice, "10"},
+			[]interface{}{testscommon.TestAddressBob, "20"},
+		}
+		assert.Equal(t, expectedPairs, values[1].Value)
+	})
+	t.Run("missing argument should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := [][]byte{{7}, testscommon.TestPubKeyAlice}
+		values, err := decoder.decodeParameters(parameters, args)
+		assert.True(t, errors.Is(err, errMissingArgument))
+		assert.Nil(t, values)
+	})
+	t.Run("too many arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		values, err := decoder.decodeParameters([]*abiParameter{{Type: "u8"}}, [][]byte{{1}, {2}})
+		assert.True(t, errors.Is(err, errTooManyArguments))
+		assert.Nil(t, values)
+	})
+	t.Run("missing optional argument should work", func(t *testing.T) {
+		t.Parallel()
+
+		values, err := decoder.decodeParameters([]*abiParameter{{Type: "optional<u8>"}}, nil)
+		require.Nil(t, err)
+		assert.Nil(t, values[0].Value)
+	})
+}
@@ -0,0 +1,265 @@
+package abiAPI
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const abiFileSuffix = ".abi.json"
+
+var log = logger.GetOrCreate("node/abiAPI")
+
+// ArgsABIRegistry holds the arguments needed to create an ABI registry
+type ArgsABIRegistry struct {
+	Directory       string
+	PubKeyConverter core.PubkeyConverter
+}
+
+type abiRegistry struct {
+	directory       string
+	pubKeyConverter core.PubkeyConverter
+	mutContracts    sync.RWMutex
+	contracts       map[string]*contractABI
+}
+
+// NewABIRegistry creates a registry holding the ABIs of the contracts, keyed by the contracts' addresses. The ABIs
+// found in the provided directory, in files named <address>.abi.json, are loaded at creation and the ABIs registered
+// afterwards are saved in the same directory
+func NewABIRegistry(args ArgsABIRegistry) (*abiRegistry, error) {
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, core.ErrNilPubkeyConverter
+	}
+
+	registry := &abiRegistry{
+		directory:       args.Directory,
+		pubKeyConverter: args.PubKeyConverter,
+		contracts:       make(map[string]*contractABI),
+	}
+
+	err := registry.loadDirectory()
+	if err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
+func (registry *abiRegistry) loadDirectory() error {
+	if len(registry.directory) == 0 {
+		return nil
+	}
+
+	err := os.MkdirAll(registry.directory, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(registry.directory)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), abiFileSuffix) {
+			continue
+		}
+
+		address := strings.TrimSuffix(entry.Name(), abiFileSuffix)
+		abiJSON, errRead := os.ReadFile(filepath.Join(registry.directory, entry.Name()))
+		if errRead != nil {
+			log.Warn("abiRegistry: cannot read abi file", "file", entry.Name(), "error", errRead)
+			continue
+		}
+
+		errRegister := registry.registerInMemory(address, abiJSON)
+		if errRegister != nil {
+			log.Warn("abiRegistry: cannot load abi file", "file", entry.Name(), "error", errRegister)
+			continue
+		}
+	}
+
+	log.Debug("abiRegistry: loaded contract abis", "directory", registry.directory, "num contracts", len(registry.contracts))
+
+	return nil
+}
+
+// RegisterABI parses the provided ABI JSON and registers it for the provided contract address, replacing any
+// previously registered ABI. The ABI is also saved in the registry's directory, if one was configured
+func (registry *abiRegistry) RegisterABI(address string, abiJSON []byte) error {
+	err := registry.registerInMemory(address, abiJSON)
+	if err != nil {
+		return err
+	}
+	if len(registry.directory) == 0 {
+		return nil
+	}
+
+	address, err = registry.canonicalAddress(address)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(registry.directory, address+abiFileSuffix), abiJSON, core.FileModeReadWrite)
+}
+
+func (registry *abiRegistry) registerInMemory(address string, abiJSON []byte) error {
+	address, err := registry.canonicalAddress(address)
+	if err != nil {
+		return err
+	}
+
+	contract, err := newContractABI(abiJSON)
+	if err != nil {
+		return err
+	}
+
+	registry.mutContracts.Lock()
+	registry.contracts[address] = contract
+	registry.mutContracts.Unlock()
+
+	return nil
+}
+
+// GetRegisteredAddresses returns the sorted addresses of the contracts that have a registered ABI
+func (registry *abiRegistry) GetRegisteredAddresses() []string {
+	registry.mutContracts.RLock()
+	addresses := make([]string, 0, len(registry.contracts))
+	for address := range registry.contracts {
+		addresses = append(addresses, address)
+	}
+	registry.mutContracts.RUnlock()
+
+	sort.Strings(addresses)
+
+	return addresses
+}
+
+// DecodeArguments decodes the arguments of a call of the provided contract endpoint
+func (registry *abiRegistry) DecodeArguments(address string, function string, args [][]byte) ([]*common.ABIDecodedValue, error) {
+	decoder, endpoint, err := registry.getEndpoint(address, function)
+	if err != nil {
+		return nil, err
+	}
+
+	return decoder.decodeParameters(endpoint.Inputs, args)
+}
+
+// DecodeReturnData decodes the data returned by the provided contract endpoint
+func (registry *abiRegistry) DecodeReturnData(address string, function string, returnData [][]byte) ([]*common.ABIDecodedValue, error) {
+	decoder, endpoint, err := registry.getEndpoint(address, function)
+	if err != nil {
+		return nil, err
+	}
+
+	return decoder.decodeParameters(endpoint.Outputs, returnData)
+}
+
+// DecodeEvent decodes the topics and the data of an event using the ABI of the contract that emitted it. The first
+// topic holds the event identifier, the next topics hold the indexed inputs and the data field holds the rest
+func (registry *abiRegistry) DecodeEvent(event *transaction.Events) (*common.ABIDecodedEvent, error) {
+	if event == nil {
+		return nil, ErrNilEvent
+	}
+	if len(event.Topics) == 0 {
+		return nil, ErrEventNotFound
+	}
+
+	decoder, err := registry.getDecoder(event.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	identifier := string(event.Topics[0])
+	abiEvent, found := decoder.contract.events[identifier]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrEventNotFound, identifier)
+	}
+
+	indexedInputs := make([]*abiParameter, 0, len(abiEvent.Inputs))
+	dataInputs := make([]*abiParameter, 0)
+	for _, input := range abiEvent.Inputs {
+		if input.Indexed {
+			indexedInputs = append(indexedInputs, input)
+			continue
+		}
+		dataInputs = append(dataInputs, input)
+	}
+
+	arguments, err := decoder.decodeParameters(indexedInputs, event.Topics[1:])
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the topics of event %s", err, identifier)
+	}
+
+	if len(dataInputs) > 0 {
+		dataArguments, errDecode := decoder.decodeParameters(dataInputs, [][]byte{event.Data})
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w while decoding the data of event %s", errDecode, identifier)
+		}
+		arguments = append(arguments, dataArguments...)
+	}
+
+	return &common.ABIDecodedEvent{
+		Address:    event.Address,
+		Identifier: identifier,
+		Arguments:  arguments,
+	}, nil
+}
+
+func (registry *abiRegistry) getEndpoint(address string, function string) (*abiDecoder, *abiEndpoint, error) {
+	decoder, err := registry.getDecoder(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint, found := decoder.contract.endpoints[function]
+	if !found {
+		return nil, nil, fmt.Errorf("%w: %s", ErrEndpointNotFound, function)
+	}
+
+	return decoder, endpoint, nil
+}
+
+func (registry *abiRegistry) getDecoder(address string) (*abiDecoder, error) {
+	address, err := registry.canonicalAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	registry.mutContracts.RLock()
+	contract, found := registry.contracts[address]
+	registry.mutContracts.RUnlock()
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrABINotFound, address)
+	}
+
+	return newABIDecoder(contract, registry.pubKeyConverter), nil
+}
+
+// canonicalAddress validates the provided address and returns its canonical encoded form
+func (registry *abiRegistry) canonicalAddress(address string) (string, error) {
+	addressBytes, err := registry.pubKeyConverter.Decode(address)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+	}
+	if bytes.Equal(addressBytes, make([]byte, len(addressBytes))) {
+		return "", fmt.Errorf("%w: empty address", ErrInvalidAddress)
+	}
+
+	return registry.pubKeyConverter.Encode(addressBytes)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (registry *abiRegistry) IsInterfaceNil() bool {
+	return registry == nil
+}
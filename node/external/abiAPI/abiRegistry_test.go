@@ -0,0 +1,207 @@
+package abiAPI
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAdderABI = `{
+	"name": "Adder",
+	"endpoints": [
+		{
+			"name": "getSum",
+			"mutability": "readonly",
+			"inputs": [],
+			"outputs": [{"type": "BigUint"}]
+		},
+		{
+			"name": "add",
+			"mutability": "mutable",
+			"inputs": [{"name": "value", "type": "BigUint"}],
+			"outputs": []
+		}
+	],
+	"events": [
+		{
+			"identifier": "added",
+			"inputs": [
+				{"name": "caller", "type": "Address", "indexed": true},
+				{"name": "value", "type": "BigUint"}
+			]
+		}
+	]
+}`
+
+func createMockArgsABIRegistry() ArgsABIRegistry {
+	return ArgsABIRegistry{
+		PubKeyConverter: testscommon.RealWorldBech32PubkeyConverter,
+	}
+}
+
+func TestNewABIRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsABIRegistry()
+		args.PubKeyConverter = nil
+		registry, err := NewABIRegistry(args)
+		assert.Equal(t, core.ErrNilPubkeyConverter, err)
+		assert.True(t, check.IfNil(registry))
+	})
+	t.Run("should load the abi files from the directory", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		err := os.WriteFile(filepath.Join(directory, testscommon.TestAddressAlice+abiFileSuffix), []byte(testAdderABI), core.FileModeReadWrite)
+		require.Nil(t, err)
+		err = os.WriteFile(filepath.Join(directory, testscommon.TestAddressBob+abiFileSuffix), []byte("not a json"), core.FileModeReadWrite)
+		require.Nil(t, err)
+		err = os.WriteFile(filepath.Join(directory, "readme.txt"), []byte(testAdderABI), core.FileModeReadWrite)
+		require.Nil(t, err)
+
+		args := createMockArgsABIRegistry()
+		args.Directory = directory
+		registry, err := NewABIRegistry(args)
+		require.Nil(t, err)
+		assert.False(t, check.IfNil(registry))
+		assert.Equal(t, []string{testscommon.TestAddressAlice}, registry.GetRegisteredAddresses())
+	})
+}
+
+func TestAbiRegistry_RegisterABI(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry, _ := NewABIRegistry(createMockArgsABIRegistry())
+		err := registry.RegisterABI("invalid", []byte(testAdderABI))
+		assert.True(t, errors.Is(err, ErrInvalidAddress))
+	})
+	t.Run("invalid abi should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry, _ := NewABIRegistry(createMockArgsABIRegistry())
+		err := registry.RegisterABI(testscommon.TestAddressAlice, []byte("{"))
+		assert.True(t, errors.Is(err, ErrInvalidABI))
+
+		unknownTypeABI := `{"endpoints": [{"name": "get", "outputs": [{"type": "MyStruct"}]}]}`
+		err = registry.RegisterABI(testscommon.TestAddressAlice, []byte(unknownTypeABI))
+		assert.True(t, errors.Is(err, ErrInvalidABI))
+		assert.Empty(t, registry.GetRegisteredAddresses())
+	})
+	t.Run("should register and save the abi", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsABIRegistry()
+		args.Directory = t.TempDir()
+		registry, _ := NewABIRegistry(args)
+
+		err := registry.RegisterABI(testscommon.TestAddressBob, []byte(testAdderABI))
+		require.Nil(t, err)
+		assert.Equal(t, []string{testscommon.TestAddressBob}, registry.GetRegisteredAddresses())
+
+		savedABI, err := os.ReadFile(filepath.Join(args.Directory, testscommon.TestAddressBob+abiFileSuffix))
+		require.Nil(t, err)
+		assert.Equal(t, testAdderABI, string(savedABI))
+
+		reloadedRegistry, err := NewABIRegistry(args)
+		require.Nil(t, err)
+		assert.Equal(t, []string{testscommon.TestAddressBob}, reloadedRegistry.GetRegisteredAddresses())
+	})
+}
+
+func TestAbiRegistry_DecodeArgumentsAndReturnData(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := NewABIRegistry(createMockArgsABIRegistry())
+	_ = registry.RegisterABI(testscommon.TestAddressAlice, []byte(testAdderABI))
+
+	values, err := registry.DecodeArguments(testscommon.TestAddressAlice, "add", [][]byte{{0x01, 0x00}})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(values))
+	assert.Equal(t, "value", values[0].Name)
+	assert.Equal(t, "BigUint", values[0].Type)
+	assert.Equal(t, "256", values[0].Value)
+
+	values, err = registry.DecodeReturnData(testscommon.TestAddressAlice, "getSum", [][]byte{{0x2a}})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(values))
+	assert.Equal(t, "42", values[0].Value)
+
+	values, err = registry.DecodeReturnData(testscommon.TestAddressAlice, "missing", nil)
+	assert.True(t, errors.Is(err, ErrEndpointNotFound))
+	assert.Nil(t, values)
+
+	values, err = registry.DecodeReturnData(testscommon.TestAddressBob, "getSum", nil)
+	assert.True(t, errors.Is(err, ErrABINotFound))
+	assert.Nil(t, values)
+}
+
+func TestAbiRegistry_DecodeEvent(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := NewABIRegistry(createMockArgsABIRegistry())
+	_ = registry.RegisterABI(testscommon.TestAddressAlice, []byte(testAdderABI))
+
+	t.Run("nil event should error", func(t *testing.T) {
+		t.Parallel()
+
+		decodedEvent, err := registry.DecodeEvent(nil)
+		assert.Equal(t, ErrNilEvent, err)
+		assert.Nil(t, decodedEvent)
+	})
+	t.Run("unknown event should error", func(t *testing.T) {
+		t.Parallel()
+
+		decodedEvent, err := registry.DecodeEvent(&transaction.Events{
+			Address: testscommon.TestAddressAlice,
+			Topics:  [][]byte{[]byte("removed")},
+		})
+		assert.True(t, errors.Is(err, ErrEventNotFound))
+		assert.Nil(t, decodedEvent)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		decodedEvent, err := registry.DecodeEvent(&transaction.Events{
+			Address:    testscommon.TestAddressAlice,
+			Identifier: "add",
+			Topics:     [][]byte{[]byte("added"), testscommon.TestPubKeyBob},
+			Data:       []byte{0x05},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, testscommon.TestAddressAlice, decodedEvent.Address)
+		assert.Equal(t, "added", decodedEvent.Identifier)
+		require.Equal(t, 2, len(decodedEvent.Arguments))
+		assert.Equal(t, testscommon.TestAddressBob, decodedEvent.Arguments[0].Value)
+		assert.Equal(t, "5", decodedEvent.Arguments[1].Value)
+	})
+}
+
+func TestDisabledABIRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry := NewDisabledABIRegistry()
+	assert.False(t, check.IfNil(registry))
+	assert.Equal(t, ErrABIRegistryDisabled, registry.RegisterABI(testscommon.TestAddressAlice, []byte(testAdderABI)))
+	assert.Empty(t, registry.GetRegisteredAddresses())
+
+	_, err := registry.DecodeArguments(testscommon.TestAddressAlice, "add", nil)
+	assert.Equal(t, ErrABIRegistryDisabled, err)
+	_, err = registry.DecodeReturnData(testscommon.TestAddressAlice, "getSum", nil)
+	assert.Equal(t, ErrABIRegistryDisabled, err)
+	_, err = registry.DecodeEvent(&transaction.Events{})
+	assert.Equal(t, ErrABIRegistryDisabled, err)
+}
@@ -0,0 +1,254 @@
+package abiAPI
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	structTypeKind       = "struct"
+	enumTypeKind         = "enum"
+	explicitEnumTypeKind = "explicit-enum"
+	arrayTypePrefix      = "array"
+)
+
+// abiDefinition holds the parts of a contract ABI JSON file needed to decode the contract's data
+type abiDefinition struct {
+	Name      string                        `json:"name"`
+	Endpoints []*abiEndpoint                `json:"endpoints"`
+	Events    []*abiEvent                   `json:"events"`
+	Types     map[string]*abiTypeDefinition `json:"types"`
+}
+
+type abiEndpoint struct {
+	Name    string          `json:"name"`
+	Inputs  []*abiParameter `json:"inputs"`
+	Outputs []*abiParameter `json:"outputs"`
+}
+
+type abiEvent struct {
+	Identifier string          `json:"identifier"`
+	Inputs     []*abiParameter `json:"inputs"`
+}
+
+type abiParameter struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed"`
+}
+
+type abiTypeDefinition struct {
+	Type     string            `json:"type"`
+	Fields   []*abiField       `json:"fields"`
+	Variants []*abiEnumVariant `json:"variants"`
+}
+
+type abiField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type abiEnumVariant struct {
+	Name         string      `json:"name"`
+	Discriminant int         `json:"discriminant"`
+	Fields       []*abiField `json:"fields"`
+}
+
+// contractABI is the validated and indexed form of a contract ABI
+type contractABI struct {
+	name      string
+	endpoints map[string]*abiEndpoint
+	events    map[string]*abiEvent
+	types     map[string]*abiTypeDefinition
+	parsed    map[string]*typeExpression
+}
+
+func newContractABI(abiJSON []byte) (*contractABI, error) {
+	definition := &abiDefinition{}
+	err := json.Unmarshal(abiJSON, definition)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidABI, err.Error())
+	}
+
+	contract := &contractABI{
+		name:      definition.Name,
+		endpoints: make(map[string]*abiEndpoint, len(definition.Endpoints)),
+		events:    make(map[string]*abiEvent, len(definition.Events)),
+		types:     definition.Types,
+		parsed:    make(map[string]*typeExpression),
+	}
+	if contract.types == nil {
+		contract.types = make(map[string]*abiTypeDefinition)
+	}
+
+	for _, endpoint := range definition.Endpoints {
+		if endpoint == nil || len(endpoint.Name) == 0 {
+			return nil, fmt.Errorf("%w: unnamed endpoint", ErrInvalidABI)
+		}
+		contract.endpoints[endpoint.Name] = endpoint
+	}
+	for _, event := range definition.Events {
+		if event == nil || len(event.Identifier) == 0 {
+			return nil, fmt.Errorf("%w: event without identifier", ErrInvalidABI)
+		}
+		contract.events[event.Identifier] = event
+	}
+
+	err = contract.validate()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidABI, err.Error())
+	}
+
+	return contract, nil
+}
+
+// validate checks that all the types used by the endpoints, the events and the custom types can be decoded
+func (contract *contractABI) validate() error {
+	for name, endpoint := range contract.endpoints {
+		err := contract.validateParameters(endpoint.Inputs)
+		if err != nil {
+			return fmt.Errorf("%w in the inputs of endpoint %s", err, name)
+		}
+		err = contract.validateParameters(endpoint.Outputs)
+		if err != nil {
+			return fmt.Errorf("%w in the outputs of endpoint %s", err, name)
+		}
+	}
+	for identifier, event := range contract.events {
+		err := contract.validateParameters(event.Inputs)
+		if err != nil {
+			return fmt.Errorf("%w in the inputs of event %s", err, identifier)
+		}
+	}
+	for name, definition := range contract.types {
+		err := contract.validateTypeDefinition(definition)
+		if err != nil {
+			return fmt.Errorf("%w in type %s", err, name)
+		}
+	}
+
+	return nil
+}
+
+func (contract *contractABI) validateParameters(parameters []*abiParameter) error {
+	for _, parameter := range parameters {
+		if parameter == nil {
+			return fmt.Errorf("%w: nil parameter", errInvalidTypeExpression)
+		}
+
+		expr, err := contract.parseType(parameter.Type)
+		if err != nil {
+			return err
+		}
+		err = contract.validateTypeExpression(expr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (contract *contractABI) validateTypeDefinition(definition *abiTypeDefinition) error {
+	if definition == nil {
+		return fmt.Errorf("%w: nil type definition", errUnknownType)
+	}
+
+	switch definition.Type {
+	case structTypeKind:
+		return contract.validateFields(definition.Fields)
+	case enumTypeKind, explicitEnumTypeKind:
+		for _, variant := range definition.Variants {
+			if variant == nil {
+				return fmt.Errorf("%w: nil enum variant", errInvalidEnumDiscriminant)
+			}
+			err := contract.validateFields(variant.Fields)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", errUnknownType, definition.Type)
+	}
+}
+
+func (contract *contractABI) validateFields(fields []*abiField) error {
+	for _, field := range fields {
+		if field == nil {
+			return fmt.Errorf("%w: nil field", errInvalidTypeExpression)
+		}
+
+		expr, err := contract.parseType(field.Type)
+		if err != nil {
+			return err
+		}
+		err = contract.validateTypeExpression(expr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (contract *contractABI) validateTypeExpression(expr *typeExpression) error {
+	_, isCustomType := contract.types[expr.name]
+	_, isArray := getArrayLength(expr.name)
+	if !isKnownType(expr.name) && !isCustomType && !isArray {
+		return fmt.Errorf("%w: %s", errUnknownType, expr.name)
+	}
+	err := checkTypeArguments(expr)
+	if err != nil {
+		return err
+	}
+
+	for _, arg := range expr.args {
+		err = contract.validateTypeExpression(arg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseType parses the type expression once, when the ABI is registered, so the decoding will reuse the result
+func (contract *contractABI) parseType(expression string) (*typeExpression, error) {
+	expr, found := contract.parsed[expression]
+	if found {
+		return expr, nil
+	}
+
+	expr, err := parseTypeExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	contract.parsed[expression] = expr
+
+	return expr, nil
+}
+
+func (contract *contractABI) getParsedType(expression string) (*typeExpression, error) {
+	expr, found := contract.parsed[expression]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownType, expression)
+	}
+
+	return expr, nil
+}
+
+func getArrayLength(typeName string) (int, bool) {
+	if !strings.HasPrefix(typeName, arrayTypePrefix) {
+		return 0, false
+	}
+
+	length, err := strconv.Atoi(strings.TrimPrefix(typeName, arrayTypePrefix))
+	if err != nil || length <= 0 {
+		return 0, false
+	}
+
+	return length, true
+}
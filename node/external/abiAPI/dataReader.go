@@ -0,0 +1,68 @@
+package abiAPI
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const lengthPrefixSize = 4
+
+// dataReader reads nested encoded values from a byte slice
+type dataReader struct {
+	data   []byte
+	offset int
+}
+
+func newDataReader(data []byte) *dataReader {
+	return &dataReader{
+		data: data,
+	}
+}
+
+func (reader *dataReader) read(numBytes int) ([]byte, error) {
+	if numBytes < 0 || numBytes > len(reader.data)-reader.offset {
+		return nil, fmt.Errorf("%w: needed %d bytes, remaining %d bytes", errNotEnoughData, numBytes, len(reader.data)-reader.offset)
+	}
+
+	result := reader.data[reader.offset : reader.offset+numBytes]
+	reader.offset += numBytes
+
+	return result, nil
+}
+
+// readCount reads the 4 bytes big endian number of items used by the nested encoding of lists
+func (reader *dataReader) readCount() (uint32, error) {
+	buff, err := reader.read(lengthPrefixSize)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint32(buff), nil
+}
+
+// readLength reads the 4 bytes big endian length prefix used by the nested encoding of variable length types
+func (reader *dataReader) readLength() (int, error) {
+	length, err := reader.readCount()
+	if err != nil {
+		return 0, err
+	}
+
+	if uint64(length) > uint64(len(reader.data)-reader.offset) {
+		return 0, fmt.Errorf("%w: length prefix %d exceeds the remaining %d bytes", errNotEnoughData, length, len(reader.data)-reader.offset)
+	}
+
+	return int(length), nil
+}
+
+func (reader *dataReader) readLengthPrefixed() ([]byte, error) {
+	length, err := reader.readLength()
+	if err != nil {
+		return nil, err
+	}
+
+	return reader.read(length)
+}
+
+func (reader *dataReader) isExhausted() bool {
+	return reader.offset >= len(reader.data)
+}
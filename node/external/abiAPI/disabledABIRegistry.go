@@ -0,0 +1,43 @@
+package abiAPI
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type disabledABIRegistry struct{}
+
+// NewDisabledABIRegistry returns a disabled implementation to be used when the ABI registry is not enabled
+func NewDisabledABIRegistry() *disabledABIRegistry {
+	return &disabledABIRegistry{}
+}
+
+// RegisterABI returns the ErrABIRegistryDisabled error
+func (registry *disabledABIRegistry) RegisterABI(_ string, _ []byte) error {
+	return ErrABIRegistryDisabled
+}
+
+// GetRegisteredAddresses returns an empty slice
+func (registry *disabledABIRegistry) GetRegisteredAddresses() []string {
+	return make([]string, 0)
+}
+
+// DecodeArguments returns the ErrABIRegistryDisabled error
+func (registry *disabledABIRegistry) DecodeArguments(_ string, _ string, _ [][]byte) ([]*common.ABIDecodedValue, error) {
+	return nil, ErrABIRegistryDisabled
+}
+
+// DecodeReturnData returns the ErrABIRegistryDisabled error
+func (registry *disabledABIRegistry) DecodeReturnData(_ string, _ string, _ [][]byte) ([]*common.ABIDecodedValue, error) {
+	return nil, ErrABIRegistryDisabled
+}
+
+// DecodeEvent returns the ErrABIRegistryDisabled error
+func (registry *disabledABIRegistry) DecodeEvent(_ *transaction.Events) (*common.ABIDecodedEvent, error) {
+	return nil, ErrABIRegistryDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (registry *disabledABIRegistry) IsInterfaceNil() bool {
+	return registry == nil
+}
@@ -0,0 +1,35 @@
+package abiAPI
+
+import "errors"
+
+// ErrABINotFound signals that no ABI was registered for the provided contract address
+var ErrABINotFound = errors.New("abi not found for the provided contract address")
+
+// ErrEndpointNotFound signals that the requested endpoint is not defined in the contract ABI
+var ErrEndpointNotFound = errors.New("endpoint not found in the contract abi")
+
+// ErrEventNotFound signals that the event is not defined in the contract ABI
+var ErrEventNotFound = errors.New("event not found in the contract abi")
+
+// ErrInvalidABI signals that the provided ABI could not be parsed
+var ErrInvalidABI = errors.New("invalid abi")
+
+// ErrInvalidAddress signals that an invalid contract address was provided
+var ErrInvalidAddress = errors.New("invalid contract address")
+
+// ErrABIRegistryDisabled signals that the ABI registry is disabled
+var ErrABIRegistryDisabled = errors.New("abi registry is disabled")
+
+// ErrNilEvent signals that a nil event was provided
+var ErrNilEvent = errors.New("nil event")
+
+var errInvalidTypeExpression = errors.New("invalid type expression")
+var errUnknownType = errors.New("unknown abi type")
+var errNotEnoughData = errors.New("not enough data to decode")
+var errTrailingData = errors.New("unexpected trailing data")
+var errMissingArgument = errors.New("missing argument")
+var errTooManyArguments = errors.New("too many arguments")
+var errInvalidBoolValue = errors.New("invalid bool value")
+var errInvalidOptionFlag = errors.New("invalid option flag")
+var errInvalidEnumDiscriminant = errors.New("invalid enum discriminant")
+var errMultiValueNotAllowed = errors.New("multi-value types can only be used as top level arguments")
@@ -0,0 +1,78 @@
+package abiAPI
+
+import (
+	"fmt"
+	"strings"
+)
+
+// typeExpression is the parsed form of an ABI type such as "variadic<multi<Address,List<u64>>>"
+type typeExpression struct {
+	name string
+	args []*typeExpression
+}
+
+// String returns the canonical form of the type expression
+func (te *typeExpression) String() string {
+	if len(te.args) == 0 {
+		return te.name
+	}
+
+	args := make([]string, 0, len(te.args))
+	for _, arg := range te.args {
+		args = append(args, arg.String())
+	}
+
+	return te.name + "<" + strings.Join(args, ",") + ">"
+}
+
+func parseTypeExpression(expression string) (*typeExpression, error) {
+	expr, rest, err := parseTypeExpressionPrefix(expression)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, expression)
+	}
+	if len(strings.TrimSpace(rest)) > 0 {
+		return nil, fmt.Errorf("%w: %s", errInvalidTypeExpression, expression)
+	}
+
+	return expr, nil
+}
+
+// parseTypeExpressionPrefix parses the type expression found at the beginning of the provided string and returns
+// the unparsed remainder
+func parseTypeExpressionPrefix(expression string) (*typeExpression, string, error) {
+	nameEnd := strings.IndexAny(expression, "<>,")
+	if nameEnd < 0 {
+		nameEnd = len(expression)
+	}
+
+	name := strings.TrimSpace(expression[:nameEnd])
+	if len(name) == 0 {
+		return nil, "", errInvalidTypeExpression
+	}
+
+	expr := &typeExpression{
+		name: name,
+	}
+	rest := expression[nameEnd:]
+	if !strings.HasPrefix(rest, "<") {
+		return expr, rest, nil
+	}
+
+	rest = rest[1:]
+	for {
+		arg, remainder, err := parseTypeExpressionPrefix(rest)
+		if err != nil {
+			return nil, "", err
+		}
+		expr.args = append(expr.args, arg)
+
+		switch {
+		case strings.HasPrefix(remainder, ","):
+			rest = remainder[1:]
+		case strings.HasPrefix(remainder, ">"):
+			return expr, remainder[1:], nil
+		default:
+			return nil, "", errInvalidTypeExpression
+		}
+	}
+}
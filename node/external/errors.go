@@ -46,3 +46,24 @@ var ErrNilManagedPeersMonitor = errors.New("nil managed peers monitor")
 
 // ErrNilNodesCoordinator signals a nil nodes coordinator has been provided
 var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilOutportReplayer signals a nil outport replayer has been provided
+var ErrNilOutportReplayer = errors.New("nil outport replayer")
+
+// ErrNilGovernanceHandler signals a nil governance handler has been provided
+var ErrNilGovernanceHandler = errors.New("nil governance handler")
+
+// ErrNilDelegationContractHandler signals a nil delegation contract handler has been provided
+var ErrNilDelegationContractHandler = errors.New("nil delegation contract handler")
+
+// ErrNilRewardsBreakdownHandler signals a nil rewards breakdown handler has been provided
+var ErrNilRewardsBreakdownHandler = errors.New("nil rewards breakdown handler")
+
+// ErrNilStakingInfoHandler signals a nil staking info handler has been provided
+var ErrNilStakingInfoHandler = errors.New("nil staking info handler")
+
+// ErrNilABIRegistry signals a nil ABI registry has been provided
+var ErrNilABIRegistry = errors.New("nil abi registry")
+
+// ErrNilContractsVerifier signals a nil contracts verifier has been provided
+var ErrNilContractsVerifier = errors.New("nil contracts verifier")
@@ -0,0 +1,13 @@
+package governanceAPI
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// ArgAPIGovernanceProcessor is the structure that stores the components needed to create an api governance processor
+type ArgAPIGovernanceProcessor struct {
+	QueryService           process.SCQueryService
+	AddressPubKeyConverter core.PubkeyConverter
+	EpochNotifier          process.EpochNotifier
+}
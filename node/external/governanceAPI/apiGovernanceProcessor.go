@@ -0,0 +1,281 @@
+package governanceAPI
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/vm"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+var log = logger.GetOrCreate("node/governanceAPI")
+
+const (
+	viewConfigFunction            = "viewConfig"
+	viewProposalFunction          = "viewProposal"
+	viewUserVoteHistoryFunction   = "viewUserVoteHistory"
+	viewVotingPowerFunction       = "viewVotingPower"
+	viewDelegatedVoteInfoFunction = "viewDelegatedVoteInfo"
+
+	numConfigValues            = 5
+	numProposalValues          = 13
+	numDelegatedVoteInfoValues = 4
+)
+
+const (
+	proposalStatusPending = "pending"
+	proposalStatusActive  = "active"
+	proposalStatusEnded   = "ended"
+	proposalStatusPassed  = "passed"
+	proposalStatusFailed  = "failed"
+)
+
+type apiGovernanceProcessor struct {
+	queryService           process.SCQueryService
+	addressPubKeyConverter core.PubkeyConverter
+	epochNotifier          process.EpochNotifier
+}
+
+// NewAPIGovernanceProcessor will create a new instance of apiGovernanceProcessor, able to decode the views of the
+// governance system smart contract
+func NewAPIGovernanceProcessor(args *ArgAPIGovernanceProcessor) (*apiGovernanceProcessor, error) {
+	err := checkNilArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiGovernanceProcessor{
+		queryService:           args.QueryService,
+		addressPubKeyConverter: args.AddressPubKeyConverter,
+		epochNotifier:          args.EpochNotifier,
+	}, nil
+}
+
+// GetConfig returns the current governance configuration
+func (agp *apiGovernanceProcessor) GetConfig() (*common.GovernanceConfigAPIResponse, error) {
+	returnData, err := agp.executeQuery(viewConfigFunction, make([][]byte, 0))
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != numConfigValues {
+		return nil, fmt.Errorf("%w, %s should have returned %d values", ErrInvalidGovernanceQueryResponse, viewConfigFunction, numConfigValues)
+	}
+
+	lastProposalNonce, err := strconv.ParseUint(string(returnData[4]), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w, invalid last proposal nonce: %s", ErrInvalidGovernanceQueryResponse, err.Error())
+	}
+
+	return &common.GovernanceConfigAPIResponse{
+		ProposalFee:       string(returnData[0]),
+		MinQuorum:         string(returnData[1]),
+		MinPassThreshold:  string(returnData[2]),
+		MinVetoThreshold:  string(returnData[3]),
+		LastProposalNonce: lastProposalNonce,
+	}, nil
+}
+
+// GetProposals returns all the governance proposals, ordered by their nonces
+func (agp *apiGovernanceProcessor) GetProposals() ([]*common.GovernanceProposalAPIResponse, error) {
+	config, err := agp.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*common.GovernanceProposalAPIResponse, 0, config.LastProposalNonce)
+	for nonce := uint64(1); nonce <= config.LastProposalNonce; nonce++ {
+		proposal, errGet := agp.GetProposal(nonce)
+		if errGet != nil {
+			return nil, fmt.Errorf("%w for proposal %d", errGet, nonce)
+		}
+
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals, nil
+}
+
+// GetProposal returns the governance proposal with the provided nonce
+func (agp *apiGovernanceProcessor) GetProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	returnData, err := agp.executeQuery(viewProposalFunction, [][]byte{big.NewInt(0).SetUint64(nonce).Bytes()})
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != numProposalValues {
+		return nil, fmt.Errorf("%w, %s should have returned %d values", ErrInvalidGovernanceQueryResponse, viewProposalFunction, numProposalValues)
+	}
+
+	proposal := &common.GovernanceProposalAPIResponse{
+		ProposalCost:   big.NewInt(0).SetBytes(returnData[0]).String(),
+		CommitHash:     string(returnData[1]),
+		Nonce:          big.NewInt(0).SetBytes(returnData[2]).Uint64(),
+		Issuer:         agp.addressPubKeyConverter.SilentEncode(returnData[3], log),
+		StartVoteEpoch: big.NewInt(0).SetBytes(returnData[4]).Uint64(),
+		EndVoteEpoch:   big.NewInt(0).SetBytes(returnData[5]).Uint64(),
+		QuorumStake:    big.NewInt(0).SetBytes(returnData[6]).String(),
+		Yes:            big.NewInt(0).SetBytes(returnData[7]).String(),
+		No:             big.NewInt(0).SetBytes(returnData[8]).String(),
+		Veto:           big.NewInt(0).SetBytes(returnData[9]).String(),
+		Abstain:        big.NewInt(0).SetBytes(returnData[10]).String(),
+		Closed:         isTrue(returnData[11]),
+		Passed:         isTrue(returnData[12]),
+	}
+	proposal.Status = agp.computeProposalStatus(proposal)
+
+	return proposal, nil
+}
+
+func (agp *apiGovernanceProcessor) computeProposalStatus(proposal *common.GovernanceProposalAPIResponse) string {
+	if proposal.Closed {
+		if proposal.Passed {
+			return proposalStatusPassed
+		}
+		return proposalStatusFailed
+	}
+
+	currentEpoch := uint64(agp.epochNotifier.CurrentEpoch())
+	if currentEpoch < proposal.StartVoteEpoch {
+		return proposalStatusPending
+	}
+	if currentEpoch <= proposal.EndVoteEpoch {
+		return proposalStatusActive
+	}
+
+	return proposalStatusEnded
+}
+
+// GetVotes returns the nonces of the proposals the provided address has voted for
+func (agp *apiGovernanceProcessor) GetVotes(address string) (*common.GovernanceVotesAPIResponse, error) {
+	addressBytes, err := agp.decodeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	returnData, err := agp.executeQuery(viewUserVoteHistoryFunction, [][]byte{addressBytes})
+	if err != nil {
+		return nil, err
+	}
+
+	// the delegated votes are returned first, each list being prefixed by its length
+	delegated, returnData, err := extractNonces(returnData)
+	if err != nil {
+		return nil, err
+	}
+	direct, returnData, err := extractNonces(returnData)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != 0 {
+		return nil, fmt.Errorf("%w, %s returned too many values", ErrInvalidGovernanceQueryResponse, viewUserVoteHistoryFunction)
+	}
+
+	return &common.GovernanceVotesAPIResponse{
+		Direct:    direct,
+		Delegated: delegated,
+	}, nil
+}
+
+func extractNonces(returnData [][]byte) ([]uint64, [][]byte, error) {
+	if len(returnData) == 0 {
+		return nil, nil, fmt.Errorf("%w, %s returned too few values", ErrInvalidGovernanceQueryResponse, viewUserVoteHistoryFunction)
+	}
+
+	numNonces := big.NewInt(0).SetBytes(returnData[0]).Uint64()
+	returnData = returnData[1:]
+	if uint64(len(returnData)) < numNonces {
+		return nil, nil, fmt.Errorf("%w, %s returned too few values", ErrInvalidGovernanceQueryResponse, viewUserVoteHistoryFunction)
+	}
+
+	nonces := make([]uint64, 0, numNonces)
+	for _, nonce := range returnData[:numNonces] {
+		nonces = append(nonces, big.NewInt(0).SetBytes(nonce).Uint64())
+	}
+
+	return nonces, returnData[numNonces:], nil
+}
+
+// GetVotingPower returns the voting power of the provided address
+func (agp *apiGovernanceProcessor) GetVotingPower(address string) (*big.Int, error) {
+	addressBytes, err := agp.decodeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	returnData, err := agp.executeQuery(viewVotingPowerFunction, [][]byte{addressBytes})
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != 1 {
+		return nil, fmt.Errorf("%w, %s should have returned one value", ErrInvalidGovernanceQueryResponse, viewVotingPowerFunction)
+	}
+
+	return big.NewInt(0).SetBytes(returnData[0]), nil
+}
+
+// GetDelegatedVoteInfo returns the voting stake and power used by the provided delegation contract on a proposal
+func (agp *apiGovernanceProcessor) GetDelegatedVoteInfo(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error) {
+	contractBytes, err := agp.decodeAddress(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	args := [][]byte{contractBytes, big.NewInt(0).SetUint64(nonce).Bytes()}
+	returnData, err := agp.executeQuery(viewDelegatedVoteInfoFunction, args)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != numDelegatedVoteInfoValues {
+		return nil, fmt.Errorf("%w, %s should have returned %d values", ErrInvalidGovernanceQueryResponse, viewDelegatedVoteInfoFunction, numDelegatedVoteInfoValues)
+	}
+
+	return &common.GovernanceDelegatedVoteInfoAPIResponse{
+		UsedStake:  big.NewInt(0).SetBytes(returnData[0]).String(),
+		UsedPower:  big.NewInt(0).SetBytes(returnData[1]).String(),
+		TotalStake: big.NewInt(0).SetBytes(returnData[2]).String(),
+		TotalPower: big.NewInt(0).SetBytes(returnData[3]).String(),
+	}, nil
+}
+
+func (agp *apiGovernanceProcessor) decodeAddress(address string) ([]byte, error) {
+	addressBytes, err := agp.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s", err, address)
+	}
+
+	return addressBytes, nil
+}
+
+func (agp *apiGovernanceProcessor) executeQuery(funcName string, args [][]byte) ([][]byte, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  vm.GovernanceSCAddress,
+		FuncName:   funcName,
+		CallerAddr: vm.GovernanceSCAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  args,
+	}
+
+	vmOutput, _, err := agp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, function: %s, return code: %v, message: %s", ErrGovernanceQueryFailed, funcName, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+// isTrue decodes the boolean values, returned by the governance contract as strings
+func isTrue(value []byte) bool {
+	return string(value) == strconv.FormatBool(true)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (agp *apiGovernanceProcessor) IsInterfaceNil() bool {
+	return agp == nil
+}
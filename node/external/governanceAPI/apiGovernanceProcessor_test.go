@@ -0,0 +1,447 @@
+package governanceAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+var issuer = []byte("issuer_address_with_32_bytes_len")
+
+func createMockArgs() *ArgAPIGovernanceProcessor {
+	return &ArgAPIGovernanceProcessor{
+		QueryService:           &mock.SCQueryServiceStub{},
+		AddressPubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		EpochNotifier:          &epochNotifier.EpochNotifierStub{},
+	}
+}
+
+func createQueryServiceStub(t *testing.T, results map[string][][]byte) *mock.SCQueryServiceStub {
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+			require.Equal(t, vm.GovernanceSCAddress, query.ScAddress)
+			require.Equal(t, vm.GovernanceSCAddress, query.CallerAddr)
+
+			key := query.FuncName
+			for _, arg := range query.Arguments {
+				key += "@" + hex.EncodeToString(arg)
+			}
+			returnData, found := results[key]
+			if !found {
+				return &vmcommon.VMOutput{
+					ReturnCode:    vmcommon.UserError,
+					ReturnMessage: "proposal was not found",
+				}, nil, nil
+			}
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: returnData,
+			}, nil, nil
+		},
+	}
+}
+
+func createProposalReturnData(nonce int64, startEpoch int64, endEpoch int64, closed bool, passed bool) [][]byte {
+	return [][]byte{
+		big.NewInt(1000).Bytes(),
+		[]byte("1db734c0315f9ec422b88f679ccfe3e0197b9d67"),
+		big.NewInt(nonce).Bytes(),
+		issuer,
+		big.NewInt(startEpoch).Bytes(),
+		big.NewInt(endEpoch).Bytes(),
+		big.NewInt(500).Bytes(),
+		big.NewInt(300).Bytes(),
+		big.NewInt(100).Bytes(),
+		big.NewInt(0).Bytes(),
+		big.NewInt(100).Bytes(),
+		[]byte(boolToString(closed)),
+		[]byte(boolToString(passed)),
+	}
+}
+
+func boolToString(value bool) string {
+	if value {
+		return "true"
+	}
+	return "false"
+}
+
+func configReturnData(lastProposalNonce string) [][]byte {
+	return [][]byte{[]byte("1000"), []byte("0.2"), []byte("0.5"), []byte("0.33"), []byte(lastProposalNonce)}
+}
+
+func TestNewAPIGovernanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil args should error", func(t *testing.T) {
+		t.Parallel()
+
+		agp, err := NewAPIGovernanceProcessor(nil)
+		require.Equal(t, ErrNilAPIGovernanceProcessorArg, err)
+		require.True(t, check.IfNil(agp))
+	})
+	t.Run("nil query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = nil
+		agp, err := NewAPIGovernanceProcessor(args)
+		require.Equal(t, ErrNilQueryService, err)
+		require.True(t, check.IfNil(agp))
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AddressPubKeyConverter = nil
+		agp, err := NewAPIGovernanceProcessor(args)
+		require.Equal(t, process.ErrNilPubkeyConverter, err)
+		require.True(t, check.IfNil(agp))
+	})
+	t.Run("nil epoch notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.EpochNotifier = nil
+		agp, err := NewAPIGovernanceProcessor(args)
+		require.Equal(t, process.ErrNilEpochNotifier, err)
+		require.True(t, check.IfNil(agp))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		agp, err := NewAPIGovernanceProcessor(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(agp))
+	})
+}
+
+func TestApiGovernanceProcessor_GetConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgs()
+		args.QueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		config, err := agp.GetConfig()
+		require.Nil(t, config)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("invalid last proposal nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			viewConfigFunction: configReturnData("invalid"),
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		config, err := agp.GetConfig()
+		require.Nil(t, config)
+		require.True(t, errors.Is(err, ErrInvalidGovernanceQueryResponse))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			viewConfigFunction: configReturnData("7"),
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		config, err := agp.GetConfig()
+		require.Nil(t, err)
+		require.Equal(t, &common.GovernanceConfigAPIResponse{
+			ProposalFee:       "1000",
+			MinQuorum:         "0.2",
+			MinPassThreshold:  "0.5",
+			MinVetoThreshold:  "0.33",
+			LastProposalNonce: 7,
+		}, config)
+	})
+}
+
+func TestApiGovernanceProcessor_GetProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("proposal not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		proposal, err := agp.GetProposal(1)
+		require.Nil(t, proposal)
+		require.True(t, errors.Is(err, ErrGovernanceQueryFailed))
+		require.Contains(t, err.Error(), "proposal was not found")
+	})
+	t.Run("invalid number of values should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			viewProposalFunction + "@01": createProposalReturnData(1, 10, 12, false, false)[1:],
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		proposal, err := agp.GetProposal(1)
+		require.Nil(t, proposal)
+		require.True(t, errors.Is(err, ErrInvalidGovernanceQueryResponse))
+	})
+	t.Run("should compute the status", func(t *testing.T) {
+		t.Parallel()
+
+		currentEpoch := uint32(11)
+		args := createMockArgs()
+		args.EpochNotifier = &epochNotifier.EpochNotifierStub{
+			CurrentEpochCalled: func() uint32 {
+				return currentEpoch
+			},
+		}
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			viewProposalFunction + "@01": createProposalReturnData(1, 12, 14, false, false),
+			viewProposalFunction + "@02": createProposalReturnData(2, 10, 12, false, false),
+			viewProposalFunction + "@03": createProposalReturnData(3, 8, 10, false, false),
+			viewProposalFunction + "@04": createProposalReturnData(4, 5, 7, true, true),
+			viewProposalFunction + "@05": createProposalReturnData(5, 5, 7, true, false),
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		expectedStatuses := []string{proposalStatusPending, proposalStatusActive, proposalStatusEnded, proposalStatusPassed, proposalStatusFailed}
+		for i, expectedStatus := range expectedStatuses {
+			proposal, err := agp.GetProposal(uint64(i + 1))
+			require.Nil(t, err)
+			require.Equal(t, expectedStatus, proposal.Status)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			viewProposalFunction + "@02": createProposalReturnData(2, 10, 12, true, true),
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		proposal, err := agp.GetProposal(2)
+		require.Nil(t, err)
+		require.Equal(t, &common.GovernanceProposalAPIResponse{
+			Nonce:          2,
+			CommitHash:     "1db734c0315f9ec422b88f679ccfe3e0197b9d67",
+			Issuer:         hex.EncodeToString(issuer),
+			ProposalCost:   "1000",
+			StartVoteEpoch: 10,
+			EndVoteEpoch:   12,
+			Status:         proposalStatusPassed,
+			QuorumStake:    "500",
+			Yes:            "300",
+			No:             "100",
+			Veto:           "0",
+			Abstain:        "100",
+			Closed:         true,
+			Passed:         true,
+		}, proposal)
+	})
+}
+
+func TestApiGovernanceProcessor_GetProposals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing proposal should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			viewConfigFunction:           configReturnData("2"),
+			viewProposalFunction + "@01": createProposalReturnData(1, 10, 12, false, false),
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		proposals, err := agp.GetProposals()
+		require.Nil(t, proposals)
+		require.True(t, errors.Is(err, ErrGovernanceQueryFailed))
+		require.Contains(t, err.Error(), "for proposal 2")
+	})
+	t.Run("no proposals should return empty list", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			viewConfigFunction: configReturnData("0"),
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		proposals, err := agp.GetProposals()
+		require.Nil(t, err)
+		require.Empty(t, proposals)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			viewConfigFunction:           configReturnData("2"),
+			viewProposalFunction + "@01": createProposalReturnData(1, 10, 12, false, false),
+			viewProposalFunction + "@02": createProposalReturnData(2, 11, 13, false, false),
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		proposals, err := agp.GetProposals()
+		require.Nil(t, err)
+		require.Equal(t, 2, len(proposals))
+		require.Equal(t, uint64(1), proposals[0].Nonce)
+		require.Equal(t, uint64(2), proposals[1].Nonce)
+	})
+}
+
+func TestApiGovernanceProcessor_GetVotes(t *testing.T) {
+	t.Parallel()
+
+	voter := []byte("voter_address_with_32_bytes_len")
+	voteHistoryKey := viewUserVoteHistoryFunction + "@" + hex.EncodeToString(voter)
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		agp, _ := NewAPIGovernanceProcessor(createMockArgs())
+
+		votes, err := agp.GetVotes("not hex")
+		require.Nil(t, votes)
+		require.Error(t, err)
+	})
+	t.Run("too few values should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			voteHistoryKey: {{2}, {1}},
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		votes, err := agp.GetVotes(hex.EncodeToString(voter))
+		require.Nil(t, votes)
+		require.True(t, errors.Is(err, ErrInvalidGovernanceQueryResponse))
+	})
+	t.Run("too many values should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			voteHistoryKey: {{0}, {0}, {1}},
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		votes, err := agp.GetVotes(hex.EncodeToString(voter))
+		require.Nil(t, votes)
+		require.True(t, errors.Is(err, ErrInvalidGovernanceQueryResponse))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			voteHistoryKey: {{1}, {3}, {2}, {1}, {2}},
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		votes, err := agp.GetVotes(hex.EncodeToString(voter))
+		require.Nil(t, err)
+		require.Equal(t, &common.GovernanceVotesAPIResponse{
+			Direct:    []uint64{1, 2},
+			Delegated: []uint64{3},
+		}, votes)
+	})
+}
+
+func TestApiGovernanceProcessor_GetVotingPower(t *testing.T) {
+	t.Parallel()
+
+	voter := []byte("voter_address_with_32_bytes_len")
+
+	t.Run("invalid number of values should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			viewVotingPowerFunction + "@" + hex.EncodeToString(voter): {},
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		votingPower, err := agp.GetVotingPower(hex.EncodeToString(voter))
+		require.Nil(t, votingPower)
+		require.True(t, errors.Is(err, ErrInvalidGovernanceQueryResponse))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			viewVotingPowerFunction + "@" + hex.EncodeToString(voter): {big.NewInt(12345).Bytes()},
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		votingPower, err := agp.GetVotingPower(hex.EncodeToString(voter))
+		require.Nil(t, err)
+		require.Equal(t, big.NewInt(12345), votingPower)
+	})
+}
+
+func TestApiGovernanceProcessor_GetDelegatedVoteInfo(t *testing.T) {
+	t.Parallel()
+
+	contract := []byte("delegation_contract_with_32_byte")
+	delegatedVoteInfoKey := viewDelegatedVoteInfoFunction + "@" + hex.EncodeToString(contract) + "@05"
+
+	t.Run("invalid number of values should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			delegatedVoteInfoKey: {{1}},
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		info, err := agp.GetDelegatedVoteInfo(hex.EncodeToString(contract), 5)
+		require.Nil(t, info)
+		require.True(t, errors.Is(err, ErrInvalidGovernanceQueryResponse))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.QueryService = createQueryServiceStub(t, map[string][][]byte{
+			delegatedVoteInfoKey: {big.NewInt(10).Bytes(), big.NewInt(20).Bytes(), big.NewInt(30).Bytes(), big.NewInt(40).Bytes()},
+		})
+		agp, _ := NewAPIGovernanceProcessor(args)
+
+		info, err := agp.GetDelegatedVoteInfo(hex.EncodeToString(contract), 5)
+		require.Nil(t, err)
+		require.Equal(t, &common.GovernanceDelegatedVoteInfoAPIResponse{
+			UsedStake:  "10",
+			UsedPower:  "20",
+			TotalStake: "30",
+			TotalPower: "40",
+		}, info)
+	})
+}
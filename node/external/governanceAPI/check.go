@@ -0,0 +1,23 @@
+package governanceAPI
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+func checkNilArgs(arg *ArgAPIGovernanceProcessor) error {
+	if arg == nil {
+		return ErrNilAPIGovernanceProcessorArg
+	}
+	if check.IfNil(arg.QueryService) {
+		return ErrNilQueryService
+	}
+	if check.IfNil(arg.AddressPubKeyConverter) {
+		return process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(arg.EpochNotifier) {
+		return process.ErrNilEpochNotifier
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package governanceAPI
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type disabledGovernanceProcessor struct{}
+
+// NewDisabledGovernanceProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledGovernanceProcessor() *disabledGovernanceProcessor {
+	return &disabledGovernanceProcessor{}
+}
+
+// GetConfig returns the ErrGovernanceNotAvailableOnShardNode error
+func (dgp *disabledGovernanceProcessor) GetConfig() (*common.GovernanceConfigAPIResponse, error) {
+	return nil, ErrGovernanceNotAvailableOnShardNode
+}
+
+// GetProposals returns the ErrGovernanceNotAvailableOnShardNode error
+func (dgp *disabledGovernanceProcessor) GetProposals() ([]*common.GovernanceProposalAPIResponse, error) {
+	return nil, ErrGovernanceNotAvailableOnShardNode
+}
+
+// GetProposal returns the ErrGovernanceNotAvailableOnShardNode error
+func (dgp *disabledGovernanceProcessor) GetProposal(_ uint64) (*common.GovernanceProposalAPIResponse, error) {
+	return nil, ErrGovernanceNotAvailableOnShardNode
+}
+
+// GetVotes returns the ErrGovernanceNotAvailableOnShardNode error
+func (dgp *disabledGovernanceProcessor) GetVotes(_ string) (*common.GovernanceVotesAPIResponse, error) {
+	return nil, ErrGovernanceNotAvailableOnShardNode
+}
+
+// GetVotingPower returns the ErrGovernanceNotAvailableOnShardNode error
+func (dgp *disabledGovernanceProcessor) GetVotingPower(_ string) (*big.Int, error) {
+	return nil, ErrGovernanceNotAvailableOnShardNode
+}
+
+// GetDelegatedVoteInfo returns the ErrGovernanceNotAvailableOnShardNode error
+func (dgp *disabledGovernanceProcessor) GetDelegatedVoteInfo(_ string, _ uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error) {
+	return nil, ErrGovernanceNotAvailableOnShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dgp *disabledGovernanceProcessor) IsInterfaceNil() bool {
+	return dgp == nil
+}
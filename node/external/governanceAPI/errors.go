@@ -0,0 +1,18 @@
+package governanceAPI
+
+import "errors"
+
+// ErrNilAPIGovernanceProcessorArg signals that nil arguments were provided
+var ErrNilAPIGovernanceProcessorArg = errors.New("nil api governance processor arguments")
+
+// ErrNilQueryService signals that a nil query service has been provided
+var ErrNilQueryService = errors.New("nil query service")
+
+// ErrGovernanceQueryFailed signals that a query on the governance system smart contract failed
+var ErrGovernanceQueryFailed = errors.New("governance query failed")
+
+// ErrInvalidGovernanceQueryResponse signals that the governance system smart contract returned an unexpected response
+var ErrInvalidGovernanceQueryResponse = errors.New("invalid governance query response")
+
+// ErrGovernanceNotAvailableOnShardNode signals that the governance data was requested from a shard node
+var ErrGovernanceNotAvailableOnShardNode = errors.New("governance data can not be returned by a shard node")
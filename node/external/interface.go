@@ -2,6 +2,7 @@ package external
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -58,6 +59,28 @@ type DelegatedListHandler interface {
 	IsInterfaceNil() bool
 }
 
+// DelegationContractHandler defines the behavior of a component able to decode the views of a delegation contract
+type DelegationContractHandler interface {
+	GetContractConfig(contract string) (*common.DelegationContractConfigAPIResponse, error)
+	GetNodeStates(contract string) (*common.DelegationNodeStatesAPIResponse, error)
+	GetDelegator(contract string, delegator string) (*common.DelegatorAPIResponse, error)
+	GetDelegators(ctx context.Context, contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// RewardsBreakdownHandler defines the behavior of a component able to return the rewards breakdown of an epoch
+type RewardsBreakdownHandler interface {
+	GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetOwnerRewardsBreakdown(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// StakingInfoHandler defines the behavior of a component able to aggregate the staking data of an owner
+type StakingInfoHandler interface {
+	GetOwnerStakingInfo(ctx context.Context, owner string) (*common.OwnerStakingInfoAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
 // APITransactionHandler defines what an API transaction handler should be able to do
 type APITransactionHandler interface {
 	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
@@ -68,5 +91,41 @@ type APITransactionHandler interface {
 	UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	PopulateComputedFields(tx *transaction.ApiTransactionResult)
 	UnmarshalReceipt(receiptBytes []byte) (*transaction.ApiReceipt, error)
+	DecodeTransaction(tx *transaction.ApiTransactionResult) *common.ABIDecodedTransactionAPIResponse
+	IsInterfaceNil() bool
+}
+
+// ABIRegistryHandler defines the behavior of a component holding the contracts ABIs, able to decode the contracts data
+type ABIRegistryHandler interface {
+	RegisterABI(address string, abiJSON []byte) error
+	GetRegisteredAddresses() []string
+	DecodeReturnData(address string, function string, returnData [][]byte) ([]*common.ABIDecodedValue, error)
+	IsInterfaceNil() bool
+}
+
+// ContractsVerifier defines the behavior of a component able to verify the smart contracts code against reproducible
+// build artifacts and to return the verification records
+type ContractsVerifier interface {
+	VerifyContract(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error)
+	GetVerifiedContract(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// OutportReplayer defines the behavior of a component able to replay stored blocks to an outport target
+type OutportReplayer interface {
+	StartReplay(startNonce uint64, endNonce uint64, target string) error
+	GetStatus() common.OutportReplayStatus
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// GovernanceHandler defines the behavior of a component able to decode the views of the governance system smart contract
+type GovernanceHandler interface {
+	GetConfig() (*common.GovernanceConfigAPIResponse, error)
+	GetProposals() ([]*common.GovernanceProposalAPIResponse, error)
+	GetProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetVotes(address string) (*common.GovernanceVotesAPIResponse, error)
+	GetVotingPower(address string) (*big.Int, error)
+	GetDelegatedVoteInfo(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error)
 	IsInterfaceNil() bool
 }
@@ -12,6 +12,7 @@ type ArgsNewLogsFacade struct {
 	StorageService  dataRetriever.StorageService
 	Marshaller      marshal.Marshalizer
 	PubKeyConverter core.PubkeyConverter
+	ABIDecoder      ABIDecoder
 }
 
 func (args *ArgsNewLogsFacade) check() error {
@@ -24,6 +25,9 @@ func (args *ArgsNewLogsFacade) check() error {
 	if check.IfNil(args.PubKeyConverter) {
 		return core.ErrNilPubkeyConverter
 	}
+	if check.IfNil(args.ABIDecoder) {
+		return errNilABIDecoder
+	}
 
 	return nil
 }
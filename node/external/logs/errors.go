@@ -5,3 +5,4 @@ import "errors"
 var errCannotCreateLogsFacade = errors.New("cannot create logs facade")
 var errCannotLoadLogs = errors.New("cannot load log(s)")
 var errCannotUnmarshalLog = errors.New("cannot unmarshal log")
+var errNilABIDecoder = errors.New("nil abi decoder")
@@ -0,0 +1,12 @@
+package logs
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// ABIDecoder defines the behavior of a component able to decode the events emitted by the contracts with a known ABI
+type ABIDecoder interface {
+	DecodeEvent(event *transaction.Events) (*common.ABIDecodedEvent, error)
+	IsInterfaceNil() bool
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -12,6 +13,7 @@ var log = logger.GetOrCreate("node/external/logs")
 type logsFacade struct {
 	repository *logsRepository
 	converter  *logsConverter
+	abiDecoder ABIDecoder
 }
 
 // NewLogsFacade creates a new logs facade
@@ -27,6 +29,7 @@ func NewLogsFacade(args ArgsNewLogsFacade) (*logsFacade, error) {
 	return &logsFacade{
 		repository: repository,
 		converter:  converter,
+		abiDecoder: args.ABIDecoder,
 	}, nil
 }
 
@@ -42,6 +45,12 @@ func (facade *logsFacade) GetLog(logKey []byte, epoch uint32) (*transaction.ApiL
 	return apiResource, nil
 }
 
+// GetLogs loads the transaction logs (from storage), as they were generated when processing the transactions.
+// The returned map is keyed by the log key (transaction hash)
+func (facade *logsFacade) GetLogs(logsKeys [][]byte, epoch uint32) (map[string]*transaction.Log, error) {
+	return facade.repository.getLogs(logsKeys, epoch)
+}
+
 // IncludeLogsInTransactions loads transaction logs from storage and includes them in the provided transaction objects
 // Note: the transaction objects MUST have the field "HashBytes" set in advance.
 func (facade *logsFacade) IncludeLogsInTransactions(txs []*transaction.ApiTransactionResult, logsKeys [][]byte, epoch uint32) error {
@@ -61,6 +70,32 @@ func (facade *logsFacade) IncludeLogsInTransactions(txs []*transaction.ApiTransa
 	return nil
 }
 
+// DecodeLogEvents decodes the events of the provided logs using the ABIs of the contracts that emitted them.
+// The events that cannot be decoded are skipped
+func (facade *logsFacade) DecodeLogEvents(logs *transaction.ApiLogs) []*common.ABIDecodedEvent {
+	decodedEvents := make([]*common.ABIDecodedEvent, 0)
+	if logs == nil {
+		return decodedEvents
+	}
+
+	for _, event := range logs.Events {
+		if event == nil {
+			continue
+		}
+
+		decodedEvent, err := facade.abiDecoder.DecodeEvent(event)
+		if err != nil {
+			log.Trace("logsFacade.DecodeLogEvents: cannot decode event",
+				"address", event.Address, "identifier", event.Identifier, "error", err)
+			continue
+		}
+
+		decodedEvents = append(decodedEvents, decodedEvent)
+	}
+
+	return decodedEvents
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (facade *logsFacade) IsInterfaceNil() bool {
 	return facade == nil
@@ -1,11 +1,13 @@
 package logs
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
@@ -18,6 +20,7 @@ func TestNewLogsFacade(t *testing.T) {
 			StorageService:  nil,
 			Marshaller:      marshallerMock.MarshalizerMock{},
 			PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+			ABIDecoder:      &testscommon.ABIRegistryStub{},
 		}
 
 		facade, err := NewLogsFacade(arguments)
@@ -31,6 +34,7 @@ func TestNewLogsFacade(t *testing.T) {
 			StorageService:  genericMocks.NewChainStorerMock(7),
 			Marshaller:      nil,
 			PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+			ABIDecoder:      &testscommon.ABIRegistryStub{},
 		}
 
 		facade, err := NewLogsFacade(arguments)
@@ -44,6 +48,7 @@ func TestNewLogsFacade(t *testing.T) {
 			StorageService:  genericMocks.NewChainStorerMock(7),
 			Marshaller:      marshallerMock.MarshalizerMock{},
 			PubKeyConverter: nil,
+			ABIDecoder:      &testscommon.ABIRegistryStub{},
 		}
 
 		facade, err := NewLogsFacade(arguments)
@@ -51,6 +56,20 @@ func TestNewLogsFacade(t *testing.T) {
 		require.ErrorContains(t, err, core.ErrNilPubkeyConverter.Error())
 		require.Nil(t, facade)
 	})
+
+	t.Run("NilABIDecoder", func(t *testing.T) {
+		arguments := ArgsNewLogsFacade{
+			StorageService:  genericMocks.NewChainStorerMock(7),
+			Marshaller:      marshallerMock.MarshalizerMock{},
+			PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+			ABIDecoder:      nil,
+		}
+
+		facade, err := NewLogsFacade(arguments)
+		require.ErrorIs(t, err, errCannotCreateLogsFacade)
+		require.ErrorContains(t, err, errNilABIDecoder.Error())
+		require.Nil(t, facade)
+	})
 }
 
 func TestLogsFacade_GetLogShouldWork(t *testing.T) {
@@ -61,6 +80,7 @@ func TestLogsFacade_GetLogShouldWork(t *testing.T) {
 		StorageService:  storageService,
 		Marshaller:      marshaller,
 		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		ABIDecoder:      &testscommon.ABIRegistryStub{},
 	}
 
 	testLog := &transaction.Log{
@@ -99,6 +119,7 @@ func TestLogsFacade_IncludeLogsInTransactionsShouldWork(t *testing.T) {
 		StorageService:  storageService,
 		Marshaller:      marshaller,
 		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		ABIDecoder:      &testscommon.ABIRegistryStub{},
 	}
 
 	facade, _ := NewLogsFacade(arguments)
@@ -145,6 +166,69 @@ func TestLogsFacade_IncludeLogsInTransactionsShouldWork(t *testing.T) {
 	require.Equal(t, "fourth", transactions[3].Logs.Events[0].Identifier)
 }
 
+func TestLogsFacade_GetLogsShouldWork(t *testing.T) {
+	storageService := genericMocks.NewChainStorerMock(7)
+	marshaller := &marshal.GogoProtoMarshalizer{}
+
+	arguments := ArgsNewLogsFacade{
+		StorageService:  storageService,
+		Marshaller:      marshaller,
+		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		ABIDecoder:      &testscommon.ABIRegistryStub{},
+	}
+
+	facade, _ := NewLogsFacade(arguments)
+
+	logOfFirst := &transaction.Log{
+		Address: []byte("first address"),
+		Events: []*transaction.Event{
+			{Identifier: []byte("first")},
+		},
+	}
+	logOfFirstBytes, _ := marshaller.Marshal(logOfFirst)
+	_ = storageService.Logs.Put([]byte{0xaa}, logOfFirstBytes)
+
+	logsByKey, err := facade.GetLogs([][]byte{{0xaa}, {0xbb}}, 7)
+	require.Nil(t, err)
+	require.Len(t, logsByKey, 1)
+	require.Equal(t, logOfFirst, logsByKey[string([]byte{0xaa})])
+}
+
+func TestLogsFacade_DecodeLogEventsShouldSkipTheEventsThatCannotBeDecoded(t *testing.T) {
+	t.Parallel()
+
+	arguments := ArgsNewLogsFacade{
+		StorageService:  genericMocks.NewChainStorerMock(7),
+		Marshaller:      &marshal.GogoProtoMarshalizer{},
+		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		ABIDecoder: &testscommon.ABIRegistryStub{
+			DecodeEventCalled: func(event *transaction.Events) (*common.ABIDecodedEvent, error) {
+				if event.Identifier != "known" {
+					return nil, errors.New("unknown event")
+				}
+
+				return &common.ABIDecodedEvent{Address: event.Address, Identifier: event.Identifier}, nil
+			},
+		},
+	}
+	facade, _ := NewLogsFacade(arguments)
+
+	require.Empty(t, facade.DecodeLogEvents(nil))
+
+	logs := &transaction.ApiLogs{
+		Events: []*transaction.Events{
+			{Address: "first", Identifier: "known"},
+			nil,
+			{Address: "second", Identifier: "unknown"},
+			{Address: "third", Identifier: "known"},
+		},
+	}
+	decodedEvents := facade.DecodeLogEvents(logs)
+	require.Len(t, decodedEvents, 2)
+	require.Equal(t, "first", decodedEvents[0].Address)
+	require.Equal(t, "third", decodedEvents[1].Address)
+}
+
 func TestLogsFacade_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -155,6 +239,7 @@ func TestLogsFacade_IsInterfaceNil(t *testing.T) {
 		StorageService:  genericMocks.NewChainStorerMock(7),
 		Marshaller:      &marshal.GogoProtoMarshalizer{},
 		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		ABIDecoder:      &testscommon.ABIRegistryStub{},
 	}
 	lf, _ = NewLogsFacade(arguments)
 	require.False(t, lf.IsInterfaceNil())
@@ -26,44 +26,58 @@ var log = logger.GetOrCreate("node/external")
 
 // ArgNodeApiResolver represents the DTO structure used in the NewNodeApiResolver constructor
 type ArgNodeApiResolver struct {
-	SCQueryService           SCQueryService
-	StatusMetricsHandler     StatusMetricsHandler
-	APITransactionEvaluator  TransactionEvaluator
-	TotalStakedValueHandler  TotalStakedValueHandler
-	DirectStakedListHandler  DirectStakedListHandler
-	DelegatedListHandler     DelegatedListHandler
-	APITransactionHandler    APITransactionHandler
-	APIBlockHandler          blockAPI.APIBlockHandler
-	APIInternalBlockHandler  blockAPI.APIInternalBlockHandler
-	GenesisNodesSetupHandler sharding.GenesisNodesSetupHandler
-	ValidatorPubKeyConverter core.PubkeyConverter
-	AccountsParser           genesis.AccountsParser
-	GasScheduleNotifier      common.GasScheduleNotifierAPI
-	ManagedPeersMonitor      common.ManagedPeersMonitor
-	PublicKey                string
-	NodesCoordinator         nodesCoordinator.NodesCoordinator
-	StorageManagers          []common.StorageManager
+	SCQueryService            SCQueryService
+	StatusMetricsHandler      StatusMetricsHandler
+	APITransactionEvaluator   TransactionEvaluator
+	TotalStakedValueHandler   TotalStakedValueHandler
+	DirectStakedListHandler   DirectStakedListHandler
+	DelegatedListHandler      DelegatedListHandler
+	APITransactionHandler     APITransactionHandler
+	APIBlockHandler           blockAPI.APIBlockHandler
+	APIInternalBlockHandler   blockAPI.APIInternalBlockHandler
+	GenesisNodesSetupHandler  sharding.GenesisNodesSetupHandler
+	ValidatorPubKeyConverter  core.PubkeyConverter
+	AccountsParser            genesis.AccountsParser
+	GasScheduleNotifier       common.GasScheduleNotifierAPI
+	ManagedPeersMonitor       common.ManagedPeersMonitor
+	PublicKey                 string
+	NodesCoordinator          nodesCoordinator.NodesCoordinator
+	StorageManagers           []common.StorageManager
+	OutportReplayer           OutportReplayer
+	GovernanceHandler         GovernanceHandler
+	DelegationContractHandler DelegationContractHandler
+	RewardsBreakdownHandler   RewardsBreakdownHandler
+	StakingInfoHandler        StakingInfoHandler
+	ABIRegistry               ABIRegistryHandler
+	ContractsVerifier         ContractsVerifier
 }
 
 // nodeApiResolver can resolve API requests
 type nodeApiResolver struct {
-	scQueryService           SCQueryService
-	statusMetricsHandler     StatusMetricsHandler
-	apiTransactionEvaluator  TransactionEvaluator
-	totalStakedValueHandler  TotalStakedValueHandler
-	directStakedListHandler  DirectStakedListHandler
-	delegatedListHandler     DelegatedListHandler
-	apiTransactionHandler    APITransactionHandler
-	apiBlockHandler          blockAPI.APIBlockHandler
-	apiInternalBlockHandler  blockAPI.APIInternalBlockHandler
-	genesisNodesSetupHandler sharding.GenesisNodesSetupHandler
-	validatorPubKeyConverter core.PubkeyConverter
-	accountsParser           genesis.AccountsParser
-	gasScheduleNotifier      common.GasScheduleNotifierAPI
-	managedPeersMonitor      common.ManagedPeersMonitor
-	publicKey                string
-	nodesCoordinator         nodesCoordinator.NodesCoordinator
-	storageManagers          []common.StorageManager
+	scQueryService            SCQueryService
+	statusMetricsHandler      StatusMetricsHandler
+	apiTransactionEvaluator   TransactionEvaluator
+	totalStakedValueHandler   TotalStakedValueHandler
+	directStakedListHandler   DirectStakedListHandler
+	delegatedListHandler      DelegatedListHandler
+	apiTransactionHandler     APITransactionHandler
+	apiBlockHandler           blockAPI.APIBlockHandler
+	apiInternalBlockHandler   blockAPI.APIInternalBlockHandler
+	genesisNodesSetupHandler  sharding.GenesisNodesSetupHandler
+	validatorPubKeyConverter  core.PubkeyConverter
+	accountsParser            genesis.AccountsParser
+	gasScheduleNotifier       common.GasScheduleNotifierAPI
+	managedPeersMonitor       common.ManagedPeersMonitor
+	publicKey                 string
+	nodesCoordinator          nodesCoordinator.NodesCoordinator
+	storageManagers           []common.StorageManager
+	outportReplayer           OutportReplayer
+	governanceHandler         GovernanceHandler
+	delegationContractHandler DelegationContractHandler
+	rewardsBreakdownHandler   RewardsBreakdownHandler
+	stakingInfoHandler        StakingInfoHandler
+	abiRegistry               ABIRegistryHandler
+	contractsVerifier         ContractsVerifier
 }
 
 // NewNodeApiResolver creates a new nodeApiResolver instance
@@ -113,25 +127,53 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.NodesCoordinator) {
 		return nil, ErrNilNodesCoordinator
 	}
+	if check.IfNil(arg.OutportReplayer) {
+		return nil, ErrNilOutportReplayer
+	}
+	if check.IfNil(arg.GovernanceHandler) {
+		return nil, ErrNilGovernanceHandler
+	}
+	if check.IfNil(arg.DelegationContractHandler) {
+		return nil, ErrNilDelegationContractHandler
+	}
+	if check.IfNil(arg.RewardsBreakdownHandler) {
+		return nil, ErrNilRewardsBreakdownHandler
+	}
+	if check.IfNil(arg.StakingInfoHandler) {
+		return nil, ErrNilStakingInfoHandler
+	}
+	if check.IfNil(arg.ABIRegistry) {
+		return nil, ErrNilABIRegistry
+	}
+	if check.IfNil(arg.ContractsVerifier) {
+		return nil, ErrNilContractsVerifier
+	}
 
 	return &nodeApiResolver{
-		scQueryService:           arg.SCQueryService,
-		statusMetricsHandler:     arg.StatusMetricsHandler,
-		apiTransactionEvaluator:  arg.APITransactionEvaluator,
-		totalStakedValueHandler:  arg.TotalStakedValueHandler,
-		directStakedListHandler:  arg.DirectStakedListHandler,
-		delegatedListHandler:     arg.DelegatedListHandler,
-		apiBlockHandler:          arg.APIBlockHandler,
-		apiTransactionHandler:    arg.APITransactionHandler,
-		apiInternalBlockHandler:  arg.APIInternalBlockHandler,
-		genesisNodesSetupHandler: arg.GenesisNodesSetupHandler,
-		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
-		accountsParser:           arg.AccountsParser,
-		gasScheduleNotifier:      arg.GasScheduleNotifier,
-		managedPeersMonitor:      arg.ManagedPeersMonitor,
-		publicKey:                arg.PublicKey,
-		nodesCoordinator:         arg.NodesCoordinator,
-		storageManagers:          arg.StorageManagers,
+		scQueryService:            arg.SCQueryService,
+		statusMetricsHandler:      arg.StatusMetricsHandler,
+		apiTransactionEvaluator:   arg.APITransactionEvaluator,
+		totalStakedValueHandler:   arg.TotalStakedValueHandler,
+		directStakedListHandler:   arg.DirectStakedListHandler,
+		delegatedListHandler:      arg.DelegatedListHandler,
+		apiBlockHandler:           arg.APIBlockHandler,
+		apiTransactionHandler:     arg.APITransactionHandler,
+		apiInternalBlockHandler:   arg.APIInternalBlockHandler,
+		genesisNodesSetupHandler:  arg.GenesisNodesSetupHandler,
+		validatorPubKeyConverter:  arg.ValidatorPubKeyConverter,
+		accountsParser:            arg.AccountsParser,
+		gasScheduleNotifier:       arg.GasScheduleNotifier,
+		managedPeersMonitor:       arg.ManagedPeersMonitor,
+		publicKey:                 arg.PublicKey,
+		nodesCoordinator:          arg.NodesCoordinator,
+		storageManagers:           arg.StorageManagers,
+		outportReplayer:           arg.OutportReplayer,
+		governanceHandler:         arg.GovernanceHandler,
+		delegationContractHandler: arg.DelegationContractHandler,
+		rewardsBreakdownHandler:   arg.RewardsBreakdownHandler,
+		stakingInfoHandler:        arg.StakingInfoHandler,
+		abiRegistry:               arg.ABIRegistry,
+		contractsVerifier:         arg.ContractsVerifier,
 	}, nil
 }
 
@@ -165,6 +207,7 @@ func (nar *nodeApiResolver) Close() error {
 		err := sm.Close()
 		log.LogIfError(err)
 	}
+	log.LogIfError(nar.outportReplayer.Close())
 
 	return nar.scQueryService.Close()
 }
@@ -416,6 +459,113 @@ func (nar *nodeApiResolver) GetWaitingEpochsLeftForPublicKey(publicKey string) (
 	return nar.nodesCoordinator.GetWaitingEpochsLeftForPublicKey(pkBytes)
 }
 
+// StartOutportReplay starts replaying the stored blocks in the provided nonce range to the provided outport target
+func (nar *nodeApiResolver) StartOutportReplay(startNonce uint64, endNonce uint64, target string) error {
+	return nar.outportReplayer.StartReplay(startNonce, endNonce, target)
+}
+
+// GetOutportReplayStatus returns the status of the current or of the last outport replay
+func (nar *nodeApiResolver) GetOutportReplayStatus() common.OutportReplayStatus {
+	return nar.outportReplayer.GetStatus()
+}
+
+// GetGovernanceConfig returns the current governance configuration
+func (nar *nodeApiResolver) GetGovernanceConfig() (*common.GovernanceConfigAPIResponse, error) {
+	return nar.governanceHandler.GetConfig()
+}
+
+// GetGovernanceProposals returns all the governance proposals
+func (nar *nodeApiResolver) GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error) {
+	return nar.governanceHandler.GetProposals()
+}
+
+// GetGovernanceProposal returns the governance proposal with the provided nonce
+func (nar *nodeApiResolver) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	return nar.governanceHandler.GetProposal(nonce)
+}
+
+// GetGovernanceVotes returns the governance proposals the provided address has voted for
+func (nar *nodeApiResolver) GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error) {
+	return nar.governanceHandler.GetVotes(address)
+}
+
+// GetGovernanceVotingPower returns the governance voting power of the provided address
+func (nar *nodeApiResolver) GetGovernanceVotingPower(address string) (*big.Int, error) {
+	return nar.governanceHandler.GetVotingPower(address)
+}
+
+// GetGovernanceDelegatedVoteInfo returns the voting stake and power used by the provided delegation contract on a proposal
+func (nar *nodeApiResolver) GetGovernanceDelegatedVoteInfo(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error) {
+	return nar.governanceHandler.GetDelegatedVoteInfo(contract, nonce)
+}
+
+// GetDelegationContractConfig returns the configuration of the provided delegation contract
+func (nar *nodeApiResolver) GetDelegationContractConfig(contract string) (*common.DelegationContractConfigAPIResponse, error) {
+	return nar.delegationContractHandler.GetContractConfig(contract)
+}
+
+// GetDelegationContractNodeStates returns the BLS keys of the provided delegation contract, grouped by their state
+func (nar *nodeApiResolver) GetDelegationContractNodeStates(contract string) (*common.DelegationNodeStatesAPIResponse, error) {
+	return nar.delegationContractHandler.GetNodeStates(contract)
+}
+
+// GetDelegationContractDelegator returns the funds of the provided delegator in the provided delegation contract
+func (nar *nodeApiResolver) GetDelegationContractDelegator(contract string, delegator string) (*common.DelegatorAPIResponse, error) {
+	return nar.delegationContractHandler.GetDelegator(contract, delegator)
+}
+
+// GetDelegationContractDelegators returns a page of the delegators list of the provided delegation contract
+func (nar *nodeApiResolver) GetDelegationContractDelegators(ctx context.Context, contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error) {
+	return nar.delegationContractHandler.GetDelegators(ctx, contract, page, pageSize)
+}
+
+// GetRewardsBreakdown returns the economics data and the rewards of all the eligible nodes in the provided epoch
+func (nar *nodeApiResolver) GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	return nar.rewardsBreakdownHandler.GetRewardsBreakdown(epoch)
+}
+
+// GetOwnerRewardsBreakdown returns the staking data and the rewards of the eligible nodes of the provided owner in the provided epoch
+func (nar *nodeApiResolver) GetOwnerRewardsBreakdown(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error) {
+	return nar.rewardsBreakdownHandler.GetOwnerRewardsBreakdown(epoch, owner)
+}
+
+// GetOwnerStakingInfo returns the staking data, the nodes status and the delegation positions of the provided owner
+func (nar *nodeApiResolver) GetOwnerStakingInfo(ctx context.Context, owner string) (*common.OwnerStakingInfoAPIResponse, error) {
+	return nar.stakingInfoHandler.GetOwnerStakingInfo(ctx, owner)
+}
+
+// RegisterContractABI registers the provided ABI for the provided contract address
+func (nar *nodeApiResolver) RegisterContractABI(address string, abiJSON []byte) error {
+	return nar.abiRegistry.RegisterABI(address, abiJSON)
+}
+
+// GetContractsWithABI returns the addresses of the contracts that have a registered ABI
+func (nar *nodeApiResolver) GetContractsWithABI() []string {
+	return nar.abiRegistry.GetRegisteredAddresses()
+}
+
+// DecodeSCQueryReturnData decodes the data returned by a SC query using the ABI registered for the queried contract
+func (nar *nodeApiResolver) DecodeSCQueryReturnData(scAddress string, funcName string, returnData [][]byte) ([]*common.ABIDecodedValue, error) {
+	return nar.abiRegistry.DecodeReturnData(scAddress, funcName, returnData)
+}
+
+// DecodeTransaction decodes the function call, the returned data and the events of the provided transaction
+func (nar *nodeApiResolver) DecodeTransaction(tx *transaction.ApiTransactionResult) *common.ABIDecodedTransactionAPIResponse {
+	return nar.apiTransactionHandler.DecodeTransaction(tx)
+}
+
+// VerifyContract checks the provided reproducible build artifact against the code of the contract deployed at the
+// provided address and stores the verification record
+func (nar *nodeApiResolver) VerifyContract(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error) {
+	return nar.contractsVerifier.VerifyContract(address, artifact)
+}
+
+// GetVerifiedContract returns the verification record of the contract deployed at the provided address, if the
+// verified code has the provided code hash
+func (nar *nodeApiResolver) GetVerifiedContract(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error) {
+	return nar.contractsVerifier.GetVerifiedContract(address, codeHash)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *nodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
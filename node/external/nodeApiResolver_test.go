@@ -20,6 +20,7 @@ import (
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
+	outportStubs "github.com/multiversx/mx-chain-go/testscommon/outport"
 	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/assert"
@@ -30,21 +31,28 @@ var expectedErr = errors.New("expected error")
 
 func createMockArgs() external.ArgNodeApiResolver {
 	return external.ArgNodeApiResolver{
-		SCQueryService:           &mock.SCQueryServiceStub{},
-		StatusMetricsHandler:     &testscommon.StatusMetricsStub{},
-		APITransactionEvaluator:  &mock.TransactionCostEstimatorMock{},
-		TotalStakedValueHandler:  &mock.StakeValuesProcessorStub{},
-		DirectStakedListHandler:  &mock.DirectStakedListProcessorStub{},
-		DelegatedListHandler:     &mock.DelegatedListProcessorStub{},
-		APIBlockHandler:          &mock.BlockAPIHandlerStub{},
-		APITransactionHandler:    &mock.TransactionAPIHandlerStub{},
-		APIInternalBlockHandler:  &mock.InternalBlockApiHandlerStub{},
-		GenesisNodesSetupHandler: &genesisMocks.NodesSetupStub{},
-		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
-		AccountsParser:           &genesisMocks.AccountsParserStub{},
-		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
-		ManagedPeersMonitor:      &testscommon.ManagedPeersMonitorStub{},
-		NodesCoordinator:         &shardingMocks.NodesCoordinatorStub{},
+		SCQueryService:            &mock.SCQueryServiceStub{},
+		StatusMetricsHandler:      &testscommon.StatusMetricsStub{},
+		APITransactionEvaluator:   &mock.TransactionCostEstimatorMock{},
+		TotalStakedValueHandler:   &mock.StakeValuesProcessorStub{},
+		DirectStakedListHandler:   &mock.DirectStakedListProcessorStub{},
+		DelegatedListHandler:      &mock.DelegatedListProcessorStub{},
+		APIBlockHandler:           &mock.BlockAPIHandlerStub{},
+		APITransactionHandler:     &mock.TransactionAPIHandlerStub{},
+		APIInternalBlockHandler:   &mock.InternalBlockApiHandlerStub{},
+		GenesisNodesSetupHandler:  &genesisMocks.NodesSetupStub{},
+		ValidatorPubKeyConverter:  &testscommon.PubkeyConverterMock{},
+		AccountsParser:            &genesisMocks.AccountsParserStub{},
+		GasScheduleNotifier:       &testscommon.GasScheduleNotifierMock{},
+		ManagedPeersMonitor:       &testscommon.ManagedPeersMonitorStub{},
+		NodesCoordinator:          &shardingMocks.NodesCoordinatorStub{},
+		OutportReplayer:           &outportStubs.OutportReplayerStub{},
+		GovernanceHandler:         &mock.GovernanceHandlerStub{},
+		DelegationContractHandler: &mock.DelegationContractHandlerStub{},
+		RewardsBreakdownHandler:   &mock.RewardsBreakdownHandlerStub{},
+		StakingInfoHandler:        &mock.StakingInfoHandlerStub{},
+		ABIRegistry:               &testscommon.ABIRegistryStub{},
+		ContractsVerifier:         &mock.ContractsVerifierStub{},
 	}
 }
 
@@ -136,6 +144,83 @@ func TestNewNodeApiResolver_NilNodesCoordinator(t *testing.T) {
 	assert.Equal(t, external.ErrNilNodesCoordinator, err)
 }
 
+func TestNewNodeApiResolver_NilOutportReplayer(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.OutportReplayer = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilOutportReplayer, err)
+}
+
+func TestNewNodeApiResolver_NilGovernanceHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.GovernanceHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGovernanceHandler, err)
+}
+
+func TestNewNodeApiResolver_NilDelegationContractHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.DelegationContractHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilDelegationContractHandler, err)
+}
+
+func TestNewNodeApiResolver_NilRewardsBreakdownHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.RewardsBreakdownHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilRewardsBreakdownHandler, err)
+}
+
+func TestNewNodeApiResolver_NilStakingInfoHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.StakingInfoHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilStakingInfoHandler, err)
+}
+
+func TestNewNodeApiResolver_NilABIRegistry(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.ABIRegistry = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilABIRegistry, err)
+}
+
+func TestNewNodeApiResolver_NilContractsVerifier(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.ContractsVerifier = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilContractsVerifier, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -158,11 +243,20 @@ func TestNodeApiResolver_CloseShouldReturnNil(t *testing.T) {
 			return nil
 		},
 	}
+	replayerCloseCalled := false
+	args.OutportReplayer = &outportStubs.OutportReplayerStub{
+		CloseCalled: func() error {
+			replayerCloseCalled = true
+
+			return nil
+		},
+	}
 	nar, _ := external.NewNodeApiResolver(args)
 
 	err := nar.Close()
 	assert.Nil(t, err)
 	assert.True(t, closeCalled)
+	assert.True(t, replayerCloseCalled)
 }
 
 func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
@@ -935,6 +1029,257 @@ func TestNodeApiResolver_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 	})
 }
 
+func TestNodeApiResolver_OutportReplay(t *testing.T) {
+	t.Parallel()
+
+	expectedStatus := common.OutportReplayStatus{
+		InProgress: true,
+		StartNonce: 10,
+		EndNonce:   20,
+	}
+	args := createMockArgs()
+	args.OutportReplayer = &outportStubs.OutportReplayerStub{
+		StartReplayCalled: func(startNonce uint64, endNonce uint64, target string) error {
+			require.Equal(t, uint64(10), startNonce)
+			require.Equal(t, uint64(20), endNonce)
+			require.Equal(t, "eventNotifier", target)
+			return expectedErr
+		},
+		GetStatusCalled: func() common.OutportReplayStatus {
+			return expectedStatus
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(args)
+
+	err := nar.StartOutportReplay(10, 20, "eventNotifier")
+	require.Equal(t, expectedErr, err)
+	require.Equal(t, expectedStatus, nar.GetOutportReplayStatus())
+}
+
+func TestNodeApiResolver_Governance(t *testing.T) {
+	t.Parallel()
+
+	providedConfig := &common.GovernanceConfigAPIResponse{LastProposalNonce: 2}
+	providedProposal := &common.GovernanceProposalAPIResponse{Nonce: 2}
+	providedVotes := &common.GovernanceVotesAPIResponse{Direct: []uint64{2}}
+	providedDelegatedVoteInfo := &common.GovernanceDelegatedVoteInfoAPIResponse{UsedStake: "10"}
+	args := createMockArgs()
+	args.GovernanceHandler = &mock.GovernanceHandlerStub{
+		GetConfigCalled: func() (*common.GovernanceConfigAPIResponse, error) {
+			return providedConfig, nil
+		},
+		GetProposalsCalled: func() ([]*common.GovernanceProposalAPIResponse, error) {
+			return []*common.GovernanceProposalAPIResponse{providedProposal}, nil
+		},
+		GetProposalCalled: func(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+			require.Equal(t, uint64(2), nonce)
+			return providedProposal, nil
+		},
+		GetVotesCalled: func(address string) (*common.GovernanceVotesAPIResponse, error) {
+			require.Equal(t, "address", address)
+			return providedVotes, nil
+		},
+		GetVotingPowerCalled: func(address string) (*big.Int, error) {
+			require.Equal(t, "address", address)
+			return big.NewInt(100), nil
+		},
+		GetDelegatedVoteInfoCalled: func(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error) {
+			require.Equal(t, "contract", contract)
+			require.Equal(t, uint64(2), nonce)
+			return providedDelegatedVoteInfo, nil
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(args)
+
+	config, err := nar.GetGovernanceConfig()
+	require.Nil(t, err)
+	require.Equal(t, providedConfig, config)
+
+	proposals, err := nar.GetGovernanceProposals()
+	require.Nil(t, err)
+	require.Equal(t, []*common.GovernanceProposalAPIResponse{providedProposal}, proposals)
+
+	proposal, err := nar.GetGovernanceProposal(2)
+	require.Nil(t, err)
+	require.Equal(t, providedProposal, proposal)
+
+	votes, err := nar.GetGovernanceVotes("address")
+	require.Nil(t, err)
+	require.Equal(t, providedVotes, votes)
+
+	votingPower, err := nar.GetGovernanceVotingPower("address")
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(100), votingPower)
+
+	delegatedVoteInfo, err := nar.GetGovernanceDelegatedVoteInfo("contract", 2)
+	require.Nil(t, err)
+	require.Equal(t, providedDelegatedVoteInfo, delegatedVoteInfo)
+}
+
+func TestNodeApiResolver_DelegationContract(t *testing.T) {
+	t.Parallel()
+
+	providedConfig := &common.DelegationContractConfigAPIResponse{Owner: "owner"}
+	providedNodeStates := &common.DelegationNodeStatesAPIResponse{Staked: []string{"bls1"}}
+	providedDelegator := &common.DelegatorAPIResponse{Address: "delegator"}
+	providedDelegators := &common.DelegatorsListAPIResponse{NumDelegators: 1}
+	args := createMockArgs()
+	args.DelegationContractHandler = &mock.DelegationContractHandlerStub{
+		GetContractConfigCalled: func(contract string) (*common.DelegationContractConfigAPIResponse, error) {
+			require.Equal(t, "contract", contract)
+			return providedConfig, nil
+		},
+		GetNodeStatesCalled: func(contract string) (*common.DelegationNodeStatesAPIResponse, error) {
+			require.Equal(t, "contract", contract)
+			return providedNodeStates, nil
+		},
+		GetDelegatorCalled: func(contract string, delegator string) (*common.DelegatorAPIResponse, error) {
+			require.Equal(t, "contract", contract)
+			require.Equal(t, "delegator", delegator)
+			return providedDelegator, nil
+		},
+		GetDelegatorsCalled: func(ctx context.Context, contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error) {
+			require.Equal(t, "contract", contract)
+			require.Equal(t, uint32(1), page)
+			require.Equal(t, uint32(10), pageSize)
+			return providedDelegators, nil
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(args)
+
+	config, err := nar.GetDelegationContractConfig("contract")
+	require.Nil(t, err)
+	require.Equal(t, providedConfig, config)
+
+	nodeStates, err := nar.GetDelegationContractNodeStates("contract")
+	require.Nil(t, err)
+	require.Equal(t, providedNodeStates, nodeStates)
+
+	delegator, err := nar.GetDelegationContractDelegator("contract", "delegator")
+	require.Nil(t, err)
+	require.Equal(t, providedDelegator, delegator)
+
+	delegators, err := nar.GetDelegationContractDelegators(context.Background(), "contract", 1, 10)
+	require.Nil(t, err)
+	require.Equal(t, providedDelegators, delegators)
+}
+
+func TestNodeApiResolver_RewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	providedRewardsBreakdown := &common.RewardsBreakdownAPIResponse{Epoch: 4}
+	providedOwnerRewardsBreakdown := &common.OwnerRewardsBreakdownAPIResponse{Owner: "owner"}
+	args := createMockArgs()
+	args.RewardsBreakdownHandler = &mock.RewardsBreakdownHandlerStub{
+		GetRewardsBreakdownCalled: func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+			require.Equal(t, uint32(4), epoch)
+			return providedRewardsBreakdown, nil
+		},
+		GetOwnerRewardsBreakdownCalled: func(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error) {
+			require.Equal(t, uint32(4), epoch)
+			require.Equal(t, "owner", owner)
+			return providedOwnerRewardsBreakdown, nil
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(args)
+
+	rewardsBreakdown, err := nar.GetRewardsBreakdown(4)
+	require.Nil(t, err)
+	require.Equal(t, providedRewardsBreakdown, rewardsBreakdown)
+
+	ownerRewardsBreakdown, err := nar.GetOwnerRewardsBreakdown(4, "owner")
+	require.Nil(t, err)
+	require.Equal(t, providedOwnerRewardsBreakdown, ownerRewardsBreakdown)
+}
+
+func TestNodeApiResolver_GetOwnerStakingInfo(t *testing.T) {
+	t.Parallel()
+
+	providedStakingInfo := &common.OwnerStakingInfoAPIResponse{Owner: "owner", TotalStaked: "2500"}
+	args := createMockArgs()
+	args.StakingInfoHandler = &mock.StakingInfoHandlerStub{
+		GetOwnerStakingInfoCalled: func(ctx context.Context, owner string) (*common.OwnerStakingInfoAPIResponse, error) {
+			require.Equal(t, "owner", owner)
+			return providedStakingInfo, nil
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(args)
+
+	stakingInfo, err := nar.GetOwnerStakingInfo(context.Background(), "owner")
+	require.Nil(t, err)
+	require.Equal(t, providedStakingInfo, stakingInfo)
+}
+
+func TestNodeApiResolver_ContractABIs(t *testing.T) {
+	t.Parallel()
+
+	registeredABIs := make(map[string][]byte)
+	providedDecodedValues := []*common.ABIDecodedValue{{Type: "BigUint", Value: "42"}}
+	providedDecodedTransaction := &common.ABIDecodedTransactionAPIResponse{Function: "add"}
+	args := createMockArgs()
+	args.ABIRegistry = &testscommon.ABIRegistryStub{
+		RegisterABICalled: func(address string, abiJSON []byte) error {
+			registeredABIs[address] = abiJSON
+			return nil
+		},
+		GetRegisteredAddressesCalled: func() []string {
+			return []string{"contract"}
+		},
+		DecodeReturnDataCalled: func(address string, function string, returnData [][]byte) ([]*common.ABIDecodedValue, error) {
+			require.Equal(t, "contract", address)
+			require.Equal(t, "getSum", function)
+			require.Equal(t, [][]byte{{42}}, returnData)
+			return providedDecodedValues, nil
+		},
+	}
+	args.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		DecodeTransactionCalled: func(tx *transaction.ApiTransactionResult) *common.ABIDecodedTransactionAPIResponse {
+			return providedDecodedTransaction
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(args)
+
+	err := nar.RegisterContractABI("contract", []byte("abi"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("abi"), registeredABIs["contract"])
+	require.Equal(t, []string{"contract"}, nar.GetContractsWithABI())
+
+	decodedValues, err := nar.DecodeSCQueryReturnData("contract", "getSum", [][]byte{{42}})
+	require.Nil(t, err)
+	require.Equal(t, providedDecodedValues, decodedValues)
+
+	require.Equal(t, providedDecodedTransaction, nar.DecodeTransaction(&transaction.ApiTransactionResult{}))
+}
+
+func TestNodeApiResolver_ContractsVerification(t *testing.T) {
+	t.Parallel()
+
+	providedArtifact := &common.ContractVerificationArtifact{Code: "0061736d"}
+	providedRecord := &common.VerifiedContractAPIResponse{Address: "contract", CodeHash: "aabb"}
+	args := createMockArgs()
+	args.ContractsVerifier = &mock.ContractsVerifierStub{
+		VerifyContractCalled: func(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error) {
+			require.Equal(t, "contract", address)
+			require.Equal(t, providedArtifact, artifact)
+			return providedRecord, nil
+		},
+		GetVerifiedContractCalled: func(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error) {
+			require.Equal(t, "contract", address)
+			require.Equal(t, []byte{0xaa, 0xbb}, codeHash)
+			return providedRecord, nil
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(args)
+
+	record, err := nar.VerifyContract("contract", providedArtifact)
+	require.Nil(t, err)
+	require.Equal(t, providedRecord, record)
+
+	record, err = nar.GetVerifiedContract("contract", []byte{0xaa, 0xbb})
+	require.Nil(t, err)
+	require.Equal(t, providedRecord, record)
+}
+
 func TestNodeApiResolver_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
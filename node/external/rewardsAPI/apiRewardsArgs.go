@@ -0,0 +1,15 @@
+package rewardsAPI
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+// ArgAPIRewardsProcessor is the structure that stores the components needed to create an api rewards processor
+type ArgAPIRewardsProcessor struct {
+	RewardsBreakdownStorer   storage.Storer
+	Marshaller               marshal.Marshalizer
+	AddressPubKeyConverter   core.PubkeyConverter
+	ValidatorPubKeyConverter core.PubkeyConverter
+}
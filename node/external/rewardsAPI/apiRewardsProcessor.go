@@ -0,0 +1,178 @@
+package rewardsAPI
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("node/rewardsAPI")
+
+type apiRewardsProcessor struct {
+	rewardsBreakdownStorer   storage.Storer
+	marshaller               marshal.Marshalizer
+	addressPubKeyConverter   core.PubkeyConverter
+	validatorPubKeyConverter core.PubkeyConverter
+}
+
+// NewAPIRewardsProcessor will create a new instance of apiRewardsProcessor, able to return the rewards breakdown
+// recorded by the metachain at the start of each epoch
+func NewAPIRewardsProcessor(args *ArgAPIRewardsProcessor) (*apiRewardsProcessor, error) {
+	err := checkNilArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiRewardsProcessor{
+		rewardsBreakdownStorer:   args.RewardsBreakdownStorer,
+		marshaller:               args.Marshaller,
+		addressPubKeyConverter:   args.AddressPubKeyConverter,
+		validatorPubKeyConverter: args.ValidatorPubKeyConverter,
+	}, nil
+}
+
+// GetRewardsBreakdown returns the economics data and the rewards of all the eligible nodes in the provided epoch
+func (arp *apiRewardsProcessor) GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	rewardsBreakdown, err := arp.getRewardsBreakdown(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.RewardsBreakdownAPIResponse{
+		Epoch:                            rewardsBreakdown.Epoch,
+		TotalSupply:                      bigIntToString(rewardsBreakdown.TotalSupply),
+		TotalToDistribute:                bigIntToString(rewardsBreakdown.TotalToDistribute),
+		TotalNewlyMinted:                 bigIntToString(rewardsBreakdown.TotalNewlyMinted),
+		RewardsPerBlock:                  bigIntToString(rewardsBreakdown.RewardsPerBlock),
+		RewardsForProtocolSustainability: bigIntToString(rewardsBreakdown.RewardsForProtocolSustainability),
+		NodePrice:                        bigIntToString(rewardsBreakdown.NodePrice),
+		DeveloperFees:                    bigIntToString(rewardsBreakdown.DeveloperFees),
+		LeaderFees:                       bigIntToString(rewardsBreakdown.LeaderFees),
+		RewardsForBlocks:                 bigIntToString(rewardsBreakdown.RewardsForBlocks),
+		BaseRewards:                      bigIntToString(rewardsBreakdown.BaseRewards),
+		TopUpRewards:                     bigIntToString(rewardsBreakdown.TopUpRewards),
+		TotalStakeEligible:               bigIntToString(rewardsBreakdown.TotalStakeEligible),
+		TotalTopUpEligible:               bigIntToString(rewardsBreakdown.TotalTopUpEligible),
+		NumberOfBlocks:                   rewardsBreakdown.NumberOfBlocks,
+		Owners:                           make([]*common.OwnerRewardsBreakdownAPIResponse, 0, len(rewardsBreakdown.Owners)),
+	}
+	for _, ownerBreakdown := range rewardsBreakdown.Owners {
+		response.Owners = append(response.Owners, arp.createOwnerRewardsBreakdownResponse(ownerBreakdown))
+	}
+
+	return response, nil
+}
+
+// GetOwnerRewardsBreakdown returns the staking data and the rewards of the eligible nodes of the provided owner
+// in the provided epoch
+func (arp *apiRewardsProcessor) GetOwnerRewardsBreakdown(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error) {
+	ownerBytes, err := arp.addressPubKeyConverter.Decode(owner)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s", err, owner)
+	}
+
+	rewardsBreakdown, err := arp.getRewardsBreakdown(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ownerBreakdown := range rewardsBreakdown.Owners {
+		if bytes.Equal(ownerBreakdown.Owner, ownerBytes) {
+			return arp.createOwnerRewardsBreakdownResponse(ownerBreakdown), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w, owner: %s, epoch: %d", ErrOwnerNotFoundInRewardsBreakdown, owner, epoch)
+}
+
+func (arp *apiRewardsProcessor) getRewardsBreakdown(epoch uint32) (*epochStart.RewardsBreakdown, error) {
+	buff, err := arp.rewardsBreakdownStorer.Get([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w for epoch %d", ErrRewardsBreakdownNotFound, epoch)
+	}
+
+	rewardsBreakdown := &epochStart.RewardsBreakdown{}
+	err = arp.marshaller.Unmarshal(rewardsBreakdown, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return rewardsBreakdown, nil
+}
+
+func (arp *apiRewardsProcessor) createOwnerRewardsBreakdownResponse(ownerBreakdown *epochStart.OwnerRewardsBreakdown) *common.OwnerRewardsBreakdownAPIResponse {
+	owner := ""
+	if len(ownerBreakdown.Owner) > 0 {
+		owner = arp.addressPubKeyConverter.SilentEncode(ownerBreakdown.Owner, log)
+	}
+
+	baseRewards := big.NewInt(0)
+	topUpRewards := big.NewInt(0)
+	leaderFees := big.NewInt(0)
+	nodes := make([]*common.NodeRewardsBreakdownAPIResponse, 0, len(ownerBreakdown.Nodes))
+	for _, nodeBreakdown := range ownerBreakdown.Nodes {
+		baseRewards.Add(baseRewards, getOrZero(nodeBreakdown.BaseReward))
+		topUpRewards.Add(topUpRewards, getOrZero(nodeBreakdown.TopUpReward))
+		leaderFees.Add(leaderFees, getOrZero(nodeBreakdown.LeaderFees))
+
+		nodes = append(nodes, arp.createNodeRewardsBreakdownResponse(nodeBreakdown))
+	}
+
+	totalRewards := big.NewInt(0).Add(baseRewards, topUpRewards)
+	totalRewards.Add(totalRewards, leaderFees)
+
+	return &common.OwnerRewardsBreakdownAPIResponse{
+		Owner:          owner,
+		NumStakedNodes: ownerBreakdown.NumStakedNodes,
+		TotalStaked:    bigIntToString(ownerBreakdown.TotalStaked),
+		TotalTopUp:     bigIntToString(ownerBreakdown.TotalTopUp),
+		BaseRewards:    baseRewards.String(),
+		TopUpRewards:   topUpRewards.String(),
+		LeaderFees:     leaderFees.String(),
+		TotalRewards:   totalRewards.String(),
+		Nodes:          nodes,
+	}
+}
+
+func (arp *apiRewardsProcessor) createNodeRewardsBreakdownResponse(nodeBreakdown *epochStart.NodeRewardsBreakdown) *common.NodeRewardsBreakdownAPIResponse {
+	totalReward := big.NewInt(0).Add(getOrZero(nodeBreakdown.BaseReward), getOrZero(nodeBreakdown.TopUpReward))
+	totalReward.Add(totalReward, getOrZero(nodeBreakdown.LeaderFees))
+
+	return &common.NodeRewardsBreakdownAPIResponse{
+		BlsKey:                     arp.validatorPubKeyConverter.SilentEncode(nodeBreakdown.BlsKey, log),
+		RewardAddress:              arp.addressPubKeyConverter.SilentEncode(nodeBreakdown.RewardAddress, log),
+		ShardId:                    nodeBreakdown.ShardId,
+		Rating:                     nodeBreakdown.Rating,
+		NumSelectedInSuccessBlocks: nodeBreakdown.NumSelectedInSuccessBlocks,
+		Offline:                    nodeBreakdown.Offline,
+		TopUpStake:                 bigIntToString(nodeBreakdown.TopUpStake),
+		BaseReward:                 bigIntToString(nodeBreakdown.BaseReward),
+		TopUpReward:                bigIntToString(nodeBreakdown.TopUpReward),
+		LeaderFees:                 bigIntToString(nodeBreakdown.LeaderFees),
+		TotalReward:                totalReward.String(),
+	}
+}
+
+func getOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return value
+}
+
+func bigIntToString(value *big.Int) string {
+	return getOrZero(value).String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (arp *apiRewardsProcessor) IsInterfaceNil() bool {
+	return arp == nil
+}
@@ -0,0 +1,276 @@
+package rewardsAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	owner1 = []byte("owner1_address_with_32_bytes_len")
+	owner2 = []byte("owner2_address_with_32_bytes_len")
+)
+
+func createMockArgs() *ArgAPIRewardsProcessor {
+	return &ArgAPIRewardsProcessor{
+		RewardsBreakdownStorer:   genericMocks.NewStorerMock(),
+		Marshaller:               &marshal.GogoProtoMarshalizer{},
+		AddressPubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+		ValidatorPubKeyConverter: testscommon.NewPubkeyConverterMock(96),
+	}
+}
+
+func createRewardsBreakdown() *epochStart.RewardsBreakdown {
+	return &epochStart.RewardsBreakdown{
+		Epoch:                            4,
+		TotalSupply:                      big.NewInt(1000000),
+		TotalToDistribute:                big.NewInt(1000),
+		TotalNewlyMinted:                 big.NewInt(800),
+		RewardsPerBlock:                  big.NewInt(10),
+		RewardsForProtocolSustainability: big.NewInt(100),
+		NodePrice:                        big.NewInt(2500),
+		DeveloperFees:                    big.NewInt(30),
+		LeaderFees:                       big.NewInt(70),
+		RewardsForBlocks:                 big.NewInt(800),
+		BaseRewards:                      big.NewInt(600),
+		TopUpRewards:                     big.NewInt(200),
+		TotalStakeEligible:               big.NewInt(10000),
+		TotalTopUpEligible:               big.NewInt(2500),
+		NumberOfBlocks:                   80,
+		Owners: []*epochStart.OwnerRewardsBreakdown{
+			{
+				Owner:          owner1,
+				NumStakedNodes: 2,
+				TotalStaked:    big.NewInt(7500),
+				TotalTopUp:     big.NewInt(2500),
+				Nodes: []*epochStart.NodeRewardsBreakdown{
+					{
+						BlsKey:                     []byte("bls1"),
+						RewardAddress:              owner1,
+						ShardId:                    0,
+						Rating:                     5000001,
+						NumSelectedInSuccessBlocks: 40,
+						TopUpStake:                 big.NewInt(1250),
+						BaseReward:                 big.NewInt(300),
+						TopUpReward:                big.NewInt(100),
+						LeaderFees:                 big.NewInt(35),
+					},
+					{
+						BlsKey:                     []byte("bls2"),
+						RewardAddress:              owner1,
+						ShardId:                    1,
+						Rating:                     5000002,
+						NumSelectedInSuccessBlocks: 40,
+						TopUpStake:                 big.NewInt(1250),
+						BaseReward:                 big.NewInt(300),
+						TopUpReward:                big.NewInt(100),
+						LeaderFees:                 big.NewInt(35),
+					},
+				},
+			},
+			{
+				Owner:          owner2,
+				NumStakedNodes: 1,
+				TotalStaked:    big.NewInt(2500),
+				TotalTopUp:     big.NewInt(0),
+				Nodes: []*epochStart.NodeRewardsBreakdown{
+					{
+						BlsKey:      []byte("bls3"),
+						ShardId:     core.MetachainShardId,
+						Offline:     true,
+						TopUpStake:  big.NewInt(0),
+						BaseReward:  big.NewInt(0),
+						TopUpReward: big.NewInt(0),
+						LeaderFees:  big.NewInt(0),
+					},
+				},
+			},
+		},
+	}
+}
+
+func saveRewardsBreakdown(t *testing.T, args *ArgAPIRewardsProcessor, rewardsBreakdown *epochStart.RewardsBreakdown) {
+	buff, err := args.Marshaller.Marshal(rewardsBreakdown)
+	require.Nil(t, err)
+
+	err = args.RewardsBreakdownStorer.Put([]byte(core.EpochStartIdentifier(rewardsBreakdown.Epoch)), buff)
+	require.Nil(t, err)
+}
+
+func TestNewAPIRewardsProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil args should error", func(t *testing.T) {
+		t.Parallel()
+
+		processor, err := NewAPIRewardsProcessor(nil)
+		require.Equal(t, ErrNilAPIRewardsProcessorArg, err)
+		require.True(t, check.IfNil(processor))
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.RewardsBreakdownStorer = nil
+		processor, err := NewAPIRewardsProcessor(args)
+		require.True(t, errors.Is(err, process.ErrNilStorage))
+		require.True(t, check.IfNil(processor))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Marshaller = nil
+		processor, err := NewAPIRewardsProcessor(args)
+		require.Equal(t, process.ErrNilMarshalizer, err)
+		require.True(t, check.IfNil(processor))
+	})
+	t.Run("nil address pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AddressPubKeyConverter = nil
+		processor, err := NewAPIRewardsProcessor(args)
+		require.True(t, errors.Is(err, process.ErrNilPubkeyConverter))
+		require.True(t, check.IfNil(processor))
+	})
+	t.Run("nil validator pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ValidatorPubKeyConverter = nil
+		processor, err := NewAPIRewardsProcessor(args)
+		require.True(t, errors.Is(err, process.ErrNilPubkeyConverter))
+		require.True(t, check.IfNil(processor))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		processor, err := NewAPIRewardsProcessor(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(processor))
+	})
+}
+
+func TestApiRewardsProcessor_GetRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		processor, _ := NewAPIRewardsProcessor(createMockArgs())
+		response, err := processor.GetRewardsBreakdown(4)
+		require.True(t, errors.Is(err, ErrRewardsBreakdownNotFound))
+		require.Nil(t, response)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		saveRewardsBreakdown(t, args, createRewardsBreakdown())
+		processor, _ := NewAPIRewardsProcessor(args)
+
+		response, err := processor.GetRewardsBreakdown(4)
+		require.Nil(t, err)
+		require.Equal(t, uint32(4), response.Epoch)
+		require.Equal(t, "1000000", response.TotalSupply)
+		require.Equal(t, "100", response.RewardsForProtocolSustainability)
+		require.Equal(t, "30", response.DeveloperFees)
+		require.Equal(t, "600", response.BaseRewards)
+		require.Equal(t, "200", response.TopUpRewards)
+		require.Equal(t, uint64(80), response.NumberOfBlocks)
+		require.Equal(t, 2, len(response.Owners))
+
+		owner1Response := response.Owners[0]
+		require.Equal(t, hex.EncodeToString(owner1), owner1Response.Owner)
+		require.Equal(t, "7500", owner1Response.TotalStaked)
+		require.Equal(t, "2500", owner1Response.TotalTopUp)
+		require.Equal(t, "600", owner1Response.BaseRewards)
+		require.Equal(t, "200", owner1Response.TopUpRewards)
+		require.Equal(t, "70", owner1Response.LeaderFees)
+		require.Equal(t, "870", owner1Response.TotalRewards)
+		require.Equal(t, 2, len(owner1Response.Nodes))
+		require.Equal(t, hex.EncodeToString([]byte("bls2")), owner1Response.Nodes[1].BlsKey)
+		require.Equal(t, hex.EncodeToString(owner1), owner1Response.Nodes[1].RewardAddress)
+		require.Equal(t, uint32(1), owner1Response.Nodes[1].ShardId)
+		require.Equal(t, uint32(5000002), owner1Response.Nodes[1].Rating)
+		require.Equal(t, "1250", owner1Response.Nodes[1].TopUpStake)
+		require.Equal(t, "435", owner1Response.Nodes[1].TotalReward)
+
+		owner2Response := response.Owners[1]
+		require.Equal(t, "0", owner2Response.TotalRewards)
+		require.True(t, owner2Response.Nodes[0].Offline)
+	})
+}
+
+func TestApiRewardsProcessor_GetOwnerRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		processor, _ := NewAPIRewardsProcessor(createMockArgs())
+		response, err := processor.GetOwnerRewardsBreakdown(4, "invalid")
+		require.NotNil(t, err)
+		require.Nil(t, response)
+	})
+	t.Run("missing epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		processor, _ := NewAPIRewardsProcessor(createMockArgs())
+		response, err := processor.GetOwnerRewardsBreakdown(4, hex.EncodeToString(owner1))
+		require.True(t, errors.Is(err, ErrRewardsBreakdownNotFound))
+		require.Nil(t, response)
+	})
+	t.Run("owner without eligible nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		saveRewardsBreakdown(t, args, createRewardsBreakdown())
+		processor, _ := NewAPIRewardsProcessor(args)
+
+		response, err := processor.GetOwnerRewardsBreakdown(4, hex.EncodeToString([]byte("owner3_address_with_32_bytes_len")))
+		require.True(t, errors.Is(err, ErrOwnerNotFoundInRewardsBreakdown))
+		require.Nil(t, response)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		saveRewardsBreakdown(t, args, createRewardsBreakdown())
+		processor, _ := NewAPIRewardsProcessor(args)
+
+		response, err := processor.GetOwnerRewardsBreakdown(4, hex.EncodeToString(owner2))
+		require.Nil(t, err)
+		require.Equal(t, hex.EncodeToString(owner2), response.Owner)
+		require.Equal(t, int64(1), response.NumStakedNodes)
+		require.Equal(t, "2500", response.TotalStaked)
+		require.Equal(t, 1, len(response.Nodes))
+		require.Equal(t, core.MetachainShardId, response.Nodes[0].ShardId)
+	})
+}
+
+func TestDisabledRewardsProcessor(t *testing.T) {
+	t.Parallel()
+
+	processor := NewDisabledRewardsProcessor()
+	require.False(t, check.IfNil(processor))
+
+	response, err := processor.GetRewardsBreakdown(4)
+	require.Equal(t, ErrRewardsBreakdownNotAvailableOnShardNode, err)
+	require.Nil(t, response)
+
+	ownerResponse, err := processor.GetOwnerRewardsBreakdown(4, hex.EncodeToString(owner1))
+	require.Equal(t, ErrRewardsBreakdownNotAvailableOnShardNode, err)
+	require.Nil(t, ownerResponse)
+}
@@ -0,0 +1,28 @@
+package rewardsAPI
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+func checkNilArgs(arg *ArgAPIRewardsProcessor) error {
+	if arg == nil {
+		return ErrNilAPIRewardsProcessorArg
+	}
+	if check.IfNil(arg.RewardsBreakdownStorer) {
+		return fmt.Errorf("%w for rewards breakdown", process.ErrNilStorage)
+	}
+	if check.IfNil(arg.Marshaller) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(arg.AddressPubKeyConverter) {
+		return fmt.Errorf("%w for addresses", process.ErrNilPubkeyConverter)
+	}
+	if check.IfNil(arg.ValidatorPubKeyConverter) {
+		return fmt.Errorf("%w for validator public keys", process.ErrNilPubkeyConverter)
+	}
+
+	return nil
+}
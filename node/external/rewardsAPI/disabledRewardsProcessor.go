@@ -0,0 +1,27 @@
+package rewardsAPI
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type disabledRewardsProcessor struct{}
+
+// NewDisabledRewardsProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledRewardsProcessor() *disabledRewardsProcessor {
+	return &disabledRewardsProcessor{}
+}
+
+// GetRewardsBreakdown returns the ErrRewardsBreakdownNotAvailableOnShardNode error
+func (drp *disabledRewardsProcessor) GetRewardsBreakdown(_ uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	return nil, ErrRewardsBreakdownNotAvailableOnShardNode
+}
+
+// GetOwnerRewardsBreakdown returns the ErrRewardsBreakdownNotAvailableOnShardNode error
+func (drp *disabledRewardsProcessor) GetOwnerRewardsBreakdown(_ uint32, _ string) (*common.OwnerRewardsBreakdownAPIResponse, error) {
+	return nil, ErrRewardsBreakdownNotAvailableOnShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (drp *disabledRewardsProcessor) IsInterfaceNil() bool {
+	return drp == nil
+}
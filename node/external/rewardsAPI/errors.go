@@ -0,0 +1,15 @@
+package rewardsAPI
+
+import "errors"
+
+// ErrNilAPIRewardsProcessorArg signals that nil arguments were provided
+var ErrNilAPIRewardsProcessorArg = errors.New("nil api rewards processor arguments")
+
+// ErrRewardsBreakdownNotFound signals that the rewards breakdown of the requested epoch was not found
+var ErrRewardsBreakdownNotFound = errors.New("rewards breakdown not found")
+
+// ErrOwnerNotFoundInRewardsBreakdown signals that the requested owner had no eligible nodes in the requested epoch
+var ErrOwnerNotFoundInRewardsBreakdown = errors.New("owner not found in the rewards breakdown")
+
+// ErrRewardsBreakdownNotAvailableOnShardNode signals that the rewards breakdown was requested from a shard node
+var ErrRewardsBreakdownNotAvailableOnShardNode = errors.New("rewards breakdown can not be returned by a shard node")
@@ -42,6 +42,8 @@ type apiTransactionProcessor struct {
 	transactionResultsProcessor *apiTransactionResultsProcessor
 	refundDetector              *refundDetector
 	gasUsedAndFeeProcessor      *gasUsedAndFeeProcessor
+	logsFacade                  LogsFacade
+	abiDecoder                  ABIDecoder
 }
 
 // NewAPITransactionProcessor will create a new instance of apiTransactionProcessor
@@ -82,6 +84,8 @@ func NewAPITransactionProcessor(args *ArgAPITransactionProcessor) (*apiTransacti
 		transactionResultsProcessor: txResultsProc,
 		refundDetector:              refundDetectorInstance,
 		gasUsedAndFeeProcessor:      gasUsedAndFeeProc,
+		logsFacade:                  args.LogsFacade,
+		abiDecoder:                  args.ABIDecoder,
 	}, nil
 }
 
@@ -54,6 +54,7 @@ func createMockArgAPITransactionProcessor() *ArgAPITransactionProcessor {
 		FeeComputer:              &testscommon.FeeComputerStub{},
 		TxTypeHandler:            &testscommon.TxTypeHandlerMock{},
 		LogsFacade:               &testscommon.LogsFacadeStub{},
+		ABIDecoder:               &testscommon.ABIRegistryStub{},
 		DataFieldParser: &testscommon.DataFieldParserStub{
 			ParseCalled: func(dataField []byte, sender, receiver []byte, _ uint32) *datafield.ResponseParseData {
 				return &datafield.ResponseParseData{}
@@ -181,6 +182,16 @@ func TestNewAPITransactionProcessor(t *testing.T) {
 		_, err := NewAPITransactionProcessor(arguments)
 		require.Equal(t, ErrNilDataFieldParser, err)
 	})
+
+	t.Run("NilABIDecoder", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createMockArgAPITransactionProcessor()
+		arguments.ABIDecoder = nil
+
+		_, err := NewAPITransactionProcessor(arguments)
+		require.Equal(t, ErrNilABIDecoder, err)
+	})
 }
 
 func TestNode_GetTransactionInvalidHashShouldErr(t *testing.T) {
@@ -454,6 +465,7 @@ func TestNode_GetTransactionWithResultsFromStorage(t *testing.T) {
 		FeeComputer:              feeComputer,
 		TxTypeHandler:            &testscommon.TxTypeHandlerMock{},
 		LogsFacade:               &testscommon.LogsFacadeStub{},
+		ABIDecoder:               &testscommon.ABIRegistryStub{},
 		DataFieldParser: &testscommon.DataFieldParserStub{
 			ParseCalled: func(dataField []byte, sender, receiver []byte, _ uint32) *datafield.ResponseParseData {
 				return &datafield.ResponseParseData{}
@@ -1026,6 +1038,7 @@ func createAPITransactionProc(t *testing.T, epoch uint32, withDbLookupExt bool)
 		FeeComputer:              &testscommon.FeeComputerStub{},
 		TxTypeHandler:            &testscommon.TxTypeHandlerMock{},
 		LogsFacade:               &testscommon.LogsFacadeStub{},
+		ABIDecoder:               &testscommon.ABIRegistryStub{},
 		DataFieldParser:          dataFieldParser,
 	}
 	apiTransactionProc, err := NewAPITransactionProcessor(args)
@@ -20,6 +20,9 @@ var ErrNilFeeComputer = errors.New("nil fee computer")
 // ErrNilLogsFacade signals that the logs facade is nil
 var ErrNilLogsFacade = errors.New("nil logs facade")
 
+// ErrNilABIDecoder signals that a nil ABI decoder has been provided
+var ErrNilABIDecoder = errors.New("nil abi decoder")
+
 var errCannotLoadReceipts = errors.New("cannot load receipt(s)")
 var errCannotLoadContractResults = errors.New("cannot load contract result(s)")
 
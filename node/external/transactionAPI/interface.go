@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
 
@@ -23,6 +24,14 @@ type FeesProcessorHandler interface {
 // LogsFacade defines the interface of a logs facade
 type LogsFacade interface {
 	GetLog(logKey []byte, epoch uint32) (*transaction.ApiLogs, error)
+	DecodeLogEvents(logs *transaction.ApiLogs) []*common.ABIDecodedEvent
+	IsInterfaceNil() bool
+}
+
+// ABIDecoder defines the behavior of a component able to decode the calls of the contracts with a known ABI
+type ABIDecoder interface {
+	DecodeArguments(address string, function string, args [][]byte) ([]*common.ABIDecodedValue, error)
+	DecodeReturnData(address string, function string, returnData [][]byte) ([]*common.ABIDecodedValue, error)
 	IsInterfaceNil() bool
 }
 
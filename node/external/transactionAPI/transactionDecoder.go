@@ -0,0 +1,137 @@
+package transactionAPI
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const argumentsSeparator = "@"
+
+type contractCall struct {
+	contract  string
+	function  string
+	arguments []*common.ABIDecodedValue
+}
+
+// DecodeTransaction decodes the function call, the returned data and the events of the provided transaction, using the
+// ABIs of the involved contracts. Only the parts that can be decoded are returned
+func (atp *apiTransactionProcessor) DecodeTransaction(tx *transaction.ApiTransactionResult) *common.ABIDecodedTransactionAPIResponse {
+	response := &common.ABIDecodedTransactionAPIResponse{
+		Events: make([]*common.ABIDecodedEvent, 0),
+	}
+	if tx == nil {
+		return response
+	}
+
+	call, found := atp.decodeContractCall(tx)
+	if found {
+		response.Contract = call.contract
+		response.Function = call.function
+		response.Arguments = call.arguments
+		response.ReturnData = atp.decodeReturnData(tx, call)
+	}
+
+	response.Events = append(response.Events, atp.logsFacade.DecodeLogEvents(tx.Logs)...)
+	for _, scr := range tx.SmartContractResults {
+		if scr == nil {
+			continue
+		}
+		response.Events = append(response.Events, atp.logsFacade.DecodeLogEvents(scr.Logs)...)
+	}
+
+	return response
+}
+
+// decodeContractCall searches the called function in the transaction data field. The function is either the first
+// token of the data field or, for the calls made through token transfer built-in functions, the token matching the
+// function found by the data field parser
+func (atp *apiTransactionProcessor) decodeContractCall(tx *transaction.ApiTransactionResult) (*contractCall, bool) {
+	tokens := strings.Split(string(tx.Data), argumentsSeparator)
+	if len(tokens[0]) == 0 {
+		return nil, false
+	}
+
+	contracts := append([]string{tx.Receiver}, tx.Receivers...)
+	call, found := atp.decodeContractCallArguments(contracts, tokens[0], tokens[1:])
+	if found || len(tx.Function) == 0 {
+		return call, found
+	}
+
+	encodedFunction := hex.EncodeToString([]byte(tx.Function))
+	for idx := 1; idx < len(tokens); idx++ {
+		if tokens[idx] == encodedFunction {
+			return atp.decodeContractCallArguments(contracts, tx.Function, tokens[idx+1:])
+		}
+	}
+
+	return nil, false
+}
+
+func (atp *apiTransactionProcessor) decodeContractCallArguments(contracts []string, function string, encodedArgs []string) (*contractCall, bool) {
+	args, err := decodeHexArguments(encodedArgs)
+	if err != nil {
+		return nil, false
+	}
+
+	for _, contract := range contracts {
+		if len(contract) == 0 {
+			continue
+		}
+
+		arguments, errDecode := atp.abiDecoder.DecodeArguments(contract, function, args)
+		if errDecode != nil {
+			log.Trace("apiTransactionProcessor.decodeContractCallArguments", "contract", contract, "function", function, "error", errDecode)
+			continue
+		}
+
+		return &contractCall{
+			contract:  contract,
+			function:  function,
+			arguments: arguments,
+		}, true
+	}
+
+	return nil, false
+}
+
+// decodeReturnData decodes the data returned by the called contract, found in the smart contract result that holds
+// the ok return code
+func (atp *apiTransactionProcessor) decodeReturnData(tx *transaction.ApiTransactionResult, call *contractCall) []*common.ABIDecodedValue {
+	for _, scr := range tx.SmartContractResults {
+		if scr == nil || scr.SndAddr != call.contract || !strings.HasPrefix(scr.Data, okReturnCodeMarker) {
+			continue
+		}
+
+		tokens := strings.Split(scr.Data, argumentsSeparator)
+		returnData, err := decodeHexArguments(tokens[2:])
+		if err != nil {
+			return nil
+		}
+
+		values, err := atp.abiDecoder.DecodeReturnData(call.contract, call.function, returnData)
+		if err != nil {
+			log.Trace("apiTransactionProcessor.decodeReturnData", "contract", call.contract, "function", call.function, "error", err)
+			return nil
+		}
+
+		return values
+	}
+
+	return nil
+}
+
+func decodeHexArguments(encodedArgs []string) ([][]byte, error) {
+	args := make([][]byte, 0, len(encodedArgs))
+	for _, encodedArg := range encodedArgs {
+		arg, err := hex.DecodeString(encodedArg)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+
+	return args, nil
+}
@@ -0,0 +1,124 @@
+package transactionAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testContract = "contract"
+	testFunction = "add"
+)
+
+var errNoABI = errors.New("no abi")
+
+func createTransactionDecoderProcessor(t *testing.T, abiDecoder ABIDecoder, logsFacade LogsFacade) *apiTransactionProcessor {
+	args := createMockArgAPITransactionProcessor()
+	args.ABIDecoder = abiDecoder
+	args.LogsFacade = logsFacade
+	atp, err := NewAPITransactionProcessor(args)
+	require.Nil(t, err)
+
+	return atp
+}
+
+func createTestABIDecoder() *testscommon.ABIRegistryStub {
+	return &testscommon.ABIRegistryStub{
+		DecodeArgumentsCalled: func(address string, function string, args [][]byte) ([]*common.ABIDecodedValue, error) {
+			if address != testContract || function != testFunction {
+				return nil, errNoABI
+			}
+
+			return []*common.ABIDecodedValue{{Name: "value", Type: "bytes", Value: hex.EncodeToString(args[0])}}, nil
+		},
+		DecodeReturnDataCalled: func(address string, function string, returnData [][]byte) ([]*common.ABIDecodedValue, error) {
+			return []*common.ABIDecodedValue{{Type: "bytes", Value: hex.EncodeToString(returnData[0])}}, nil
+		},
+	}
+}
+
+func TestApiTransactionProcessor_DecodeTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should return an empty response", func(t *testing.T) {
+		t.Parallel()
+
+		atp := createTransactionDecoderProcessor(t, createTestABIDecoder(), &testscommon.LogsFacadeStub{})
+		response := atp.DecodeTransaction(nil)
+		assert.Empty(t, response.Function)
+		assert.Empty(t, response.Events)
+	})
+	t.Run("direct contract call should decode arguments, return data and events", func(t *testing.T) {
+		t.Parallel()
+
+		logsFacade := &testscommon.LogsFacadeStub{
+			DecodeLogEventsCalled: func(logs *transaction.ApiLogs) []*common.ABIDecodedEvent {
+				if logs == nil {
+					return nil
+				}
+
+				return []*common.ABIDecodedEvent{{Address: logs.Address}}
+			},
+		}
+		atp := createTransactionDecoderProcessor(t, createTestABIDecoder(), logsFacade)
+
+		tx := &transaction.ApiTransactionResult{
+			Receiver: testContract,
+			Data:     []byte("add@0a"),
+			Logs:     &transaction.ApiLogs{Address: "tx logs"},
+			SmartContractResults: []*transaction.ApiSmartContractResult{
+				{SndAddr: "other", Data: "@6f6b@01"},
+				{SndAddr: testContract, Data: "@6f6b@0b", Logs: &transaction.ApiLogs{Address: "scr logs"}},
+			},
+		}
+		response := atp.DecodeTransaction(tx)
+		assert.Equal(t, testContract, response.Contract)
+		assert.Equal(t, testFunction, response.Function)
+		require.Len(t, response.Arguments, 1)
+		assert.Equal(t, "0a", response.Arguments[0].Value)
+		require.Len(t, response.ReturnData, 1)
+		assert.Equal(t, "0b", response.ReturnData[0].Value)
+		require.Len(t, response.Events, 2)
+		assert.Equal(t, "tx logs", response.Events[0].Address)
+		assert.Equal(t, "scr logs", response.Events[1].Address)
+	})
+	t.Run("contract call through a transfer function should decode the function arguments", func(t *testing.T) {
+		t.Parallel()
+
+		atp := createTransactionDecoderProcessor(t, createTestABIDecoder(), &testscommon.LogsFacadeStub{})
+
+		tx := &transaction.ApiTransactionResult{
+			Receiver:  "sender",
+			Receivers: []string{testContract},
+			Function:  testFunction,
+			Data:      []byte("MultiESDTNFTTransfer@aa@01@bb@00@05@" + hex.EncodeToString([]byte(testFunction)) + "@0c"),
+		}
+		response := atp.DecodeTransaction(tx)
+		assert.Equal(t, testContract, response.Contract)
+		assert.Equal(t, testFunction, response.Function)
+		require.Len(t, response.Arguments, 1)
+		assert.Equal(t, "0c", response.Arguments[0].Value)
+		assert.Nil(t, response.ReturnData)
+	})
+	t.Run("contract without abi should not decode the call", func(t *testing.T) {
+		t.Parallel()
+
+		atp := createTransactionDecoderProcessor(t, createTestABIDecoder(), &testscommon.LogsFacadeStub{})
+
+		tx := &transaction.ApiTransactionResult{
+			Receiver: "unknown",
+			Data:     []byte("add@0a"),
+		}
+		response := atp.DecodeTransaction(tx)
+		assert.Empty(t, response.Contract)
+		assert.Empty(t, response.Function)
+		assert.Nil(t, response.Arguments)
+	})
+}
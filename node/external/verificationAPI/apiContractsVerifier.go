@@ -0,0 +1,204 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. contractVerificationRecord.proto
+package verificationAPI
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("node/verificationAPI")
+
+type apiContractsVerifier struct {
+	contractsVerificationStorer storage.Storer
+	accountsRepository          state.AccountsRepository
+	marshaller                  marshal.Marshalizer
+	hasher                      hashing.Hasher
+	addressPubKeyConverter      core.PubkeyConverter
+}
+
+// NewAPIContractsVerifier will create a new instance of apiContractsVerifier, able to check the reproducible build
+// artifacts of the smart contracts against the deployed code and to keep the resulted verification records
+func NewAPIContractsVerifier(args *ArgAPIContractsVerifier) (*apiContractsVerifier, error) {
+	err := checkNilArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiContractsVerifier{
+		contractsVerificationStorer: args.ContractsVerificationStorer,
+		accountsRepository:          args.AccountsRepository,
+		marshaller:                  args.Marshaller,
+		hasher:                      args.Hasher,
+		addressPubKeyConverter:      args.AddressPubKeyConverter,
+	}, nil
+}
+
+// VerifyContract checks the code from the provided artifact against the code of the contract deployed at the
+// provided address, on the final state. On success, the verification record is stored, replacing the existing one.
+// The source metadata of the artifact is not verified, being stored and returned as unverified metadata
+func (acv *apiContractsVerifier) VerifyContract(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error) {
+	if artifact == nil {
+		return nil, ErrNilVerificationArtifact
+	}
+
+	addressBytes, err := acv.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s", err, address)
+	}
+	if !core.IsSmartContractAddress(addressBytes) {
+		return nil, fmt.Errorf("%w: %s", ErrNotASmartContract, address)
+	}
+
+	code, err := checkArtifact(artifact)
+	if err != nil {
+		return nil, err
+	}
+
+	deployedCodeHash, err := acv.getDeployedCodeHash(addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	codeHash := acv.hasher.Compute(string(code))
+	if !bytes.Equal(codeHash, deployedCodeHash) {
+		return nil, fmt.Errorf("%w: provided %s, deployed %s", ErrCodeHashMismatch, hex.EncodeToString(codeHash), hex.EncodeToString(deployedCodeHash))
+	}
+
+	deployedCode, _, err := acv.accountsRepository.GetCodeWithBlockInfo(deployedCodeHash, api.AccountQueryOptions{OnFinalBlock: true})
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(code, deployedCode) {
+		return nil, ErrCodeMismatch
+	}
+
+	record := &ContractVerificationRecord{
+		CodeHash:        codeHash,
+		CompilerVersion: artifact.CompilerVersion,
+		SourceHash:      strings.ToLower(artifact.SourceHash),
+		SourceURL:       artifact.SourceURL,
+		BuildImage:      artifact.BuildImage,
+		ABI:             artifact.ABI,
+		Timestamp:       time.Now().Unix(),
+	}
+	buff, err := acv.marshaller.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	err = acv.contractsVerificationStorer.Put(addressBytes, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("apiContractsVerifier.VerifyContract: contract verified", "address", address, "code hash", codeHash)
+
+	return recordToAPIResponse(address, record), nil
+}
+
+// GetVerifiedContract returns the verification record of the contract deployed at the provided address, if the
+// verified code is the one having the provided code hash. Otherwise, as it happens when the contract was never verified
+// or it was upgraded since, nil is returned
+func (acv *apiContractsVerifier) GetVerifiedContract(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error) {
+	addressBytes, err := acv.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s", err, address)
+	}
+	if len(codeHash) == 0 {
+		return nil, nil
+	}
+
+	buff, err := acv.contractsVerificationStorer.Get(addressBytes)
+	if storage.IsNotFoundInStorageErr(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := &ContractVerificationRecord{}
+	err = acv.marshaller.Unmarshal(record, buff)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(record.CodeHash, codeHash) {
+		return nil, nil
+	}
+
+	return recordToAPIResponse(address, record), nil
+}
+
+func recordToAPIResponse(address string, record *ContractVerificationRecord) *common.VerifiedContractAPIResponse {
+	return &common.VerifiedContractAPIResponse{
+		Address:  address,
+		CodeHash: hex.EncodeToString(record.CodeHash),
+		UnverifiedMetadata: &common.SubmittedContractMetadata{
+			CompilerVersion: record.CompilerVersion,
+			SourceHash:      record.SourceHash,
+			SourceURL:       record.SourceURL,
+			BuildImage:      record.BuildImage,
+			ABI:             record.ABI,
+		},
+		Timestamp: record.Timestamp,
+	}
+}
+
+func (acv *apiContractsVerifier) getDeployedCodeHash(address []byte) ([]byte, error) {
+	account, _, err := acv.accountsRepository.GetAccountWithBlockInfo(address, api.AccountQueryOptions{OnFinalBlock: true})
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, state.ErrWrongTypeAssertion
+	}
+
+	codeHash := userAccount.GetCodeHash()
+	if len(codeHash) == 0 {
+		return nil, fmt.Errorf("%w: no code deployed", ErrNotASmartContract)
+	}
+
+	return codeHash, nil
+}
+
+func checkArtifact(artifact *common.ContractVerificationArtifact) ([]byte, error) {
+	code, err := hex.DecodeString(artifact.Code)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCode, err.Error())
+	}
+	if len(code) == 0 {
+		return nil, ErrInvalidCode
+	}
+	if len(strings.TrimSpace(artifact.CompilerVersion)) == 0 {
+		return nil, ErrEmptyCompilerVersion
+	}
+
+	sourceHash, err := hex.DecodeString(artifact.SourceHash)
+	if err != nil || len(sourceHash) == 0 {
+		return nil, ErrInvalidSourceHash
+	}
+	if len(artifact.ABI) > 0 && !json.Valid(artifact.ABI) {
+		return nil, ErrInvalidABI
+	}
+
+	return code, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (acv *apiContractsVerifier) IsInterfaceNil() bool {
+	return acv == nil
+}
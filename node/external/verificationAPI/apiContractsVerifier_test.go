@@ -0,0 +1,351 @@
+package verificationAPI
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	expectedErr       = errors.New("expected error")
+	contractAddress   = append(make([]byte, 10), []byte("contract_address_with_")...)
+	userAddress       = []byte("user_address_with_32_bytes_len..")
+	contractCode      = []byte("contract wasm code")
+	contractSourceABI = json.RawMessage(`{"name":"Adder"}`)
+)
+
+func createMockArgs() *ArgAPIContractsVerifier {
+	hasher := &hashingMocks.HasherMock{}
+	codeHash := hasher.Compute(string(contractCode))
+
+	return &ArgAPIContractsVerifier{
+		ContractsVerificationStorer: genericMocks.NewStorerMock(),
+		AccountsRepository: &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				return &stateMock.UserAccountStub{
+					Address:  address,
+					CodeHash: codeHash,
+				}, nil, nil
+			},
+			GetCodeWithBlockInfoCalled: func(hash []byte, options api.AccountQueryOptions) ([]byte, common.BlockInfo, error) {
+				if string(hash) != string(codeHash) {
+					return nil, nil, nil
+				}
+
+				return contractCode, nil, nil
+			},
+		},
+		Marshaller:             &marshal.GogoProtoMarshalizer{},
+		Hasher:                 hasher,
+		AddressPubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+	}
+}
+
+func createArtifact() *common.ContractVerificationArtifact {
+	return &common.ContractVerificationArtifact{
+		Code:            hex.EncodeToString(contractCode),
+		CompilerVersion: "rustc 1.76.0",
+		SourceHash:      "ABCDEF01",
+		SourceURL:       "https://example.com/adder.zip",
+		BuildImage:      "multiversx/sdk-rust-contract-builder:v6.1.0",
+		ABI:             contractSourceABI,
+	}
+}
+
+func TestNewAPIContractsVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil args should error", func(t *testing.T) {
+		t.Parallel()
+
+		acv, err := NewAPIContractsVerifier(nil)
+		require.Equal(t, ErrNilAPIContractsVerifierArg, err)
+		require.True(t, check.IfNil(acv))
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ContractsVerificationStorer = nil
+		acv, err := NewAPIContractsVerifier(args)
+		require.True(t, errors.Is(err, process.ErrNilStorage))
+		require.True(t, check.IfNil(acv))
+	})
+	t.Run("nil accounts repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AccountsRepository = nil
+		acv, err := NewAPIContractsVerifier(args)
+		require.Equal(t, ErrNilAccountsRepository, err)
+		require.True(t, check.IfNil(acv))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Marshaller = nil
+		acv, err := NewAPIContractsVerifier(args)
+		require.Equal(t, process.ErrNilMarshalizer, err)
+		require.True(t, check.IfNil(acv))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Hasher = nil
+		acv, err := NewAPIContractsVerifier(args)
+		require.Equal(t, process.ErrNilHasher, err)
+		require.True(t, check.IfNil(acv))
+	})
+	t.Run("nil address pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AddressPubKeyConverter = nil
+		acv, err := NewAPIContractsVerifier(args)
+		require.Equal(t, process.ErrNilPubkeyConverter, err)
+		require.True(t, check.IfNil(acv))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		acv, err := NewAPIContractsVerifier(createMockArgs())
+		require.NoError(t, err)
+		require.False(t, check.IfNil(acv))
+	})
+}
+
+func TestApiContractsVerifier_VerifyContract(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil artifact should error", func(t *testing.T) {
+		t.Parallel()
+
+		acv, _ := NewAPIContractsVerifier(createMockArgs())
+		record, err := acv.VerifyContract(hex.EncodeToString(contractAddress), nil)
+		require.Equal(t, ErrNilVerificationArtifact, err)
+		require.Nil(t, record)
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		acv, _ := NewAPIContractsVerifier(createMockArgs())
+		record, err := acv.VerifyContract("invalid", createArtifact())
+		require.Error(t, err)
+		require.Nil(t, record)
+	})
+	t.Run("user address should error", func(t *testing.T) {
+		t.Parallel()
+
+		acv, _ := NewAPIContractsVerifier(createMockArgs())
+		record, err := acv.VerifyContract(hex.EncodeToString(userAddress), createArtifact())
+		require.True(t, errors.Is(err, ErrNotASmartContract))
+		require.Nil(t, record)
+	})
+	t.Run("invalid artifact should error", func(t *testing.T) {
+		t.Parallel()
+
+		acv, _ := NewAPIContractsVerifier(createMockArgs())
+		address := hex.EncodeToString(contractAddress)
+
+		artifact := createArtifact()
+		artifact.Code = "not hex"
+		_, err := acv.VerifyContract(address, artifact)
+		require.True(t, errors.Is(err, ErrInvalidCode))
+
+		artifact = createArtifact()
+		artifact.Code = ""
+		_, err = acv.VerifyContract(address, artifact)
+		require.True(t, errors.Is(err, ErrInvalidCode))
+
+		artifact = createArtifact()
+		artifact.CompilerVersion = " "
+		_, err = acv.VerifyContract(address, artifact)
+		require.Equal(t, ErrEmptyCompilerVersion, err)
+
+		artifact = createArtifact()
+		artifact.SourceHash = "not hex"
+		_, err = acv.VerifyContract(address, artifact)
+		require.Equal(t, ErrInvalidSourceHash, err)
+
+		artifact = createArtifact()
+		artifact.ABI = json.RawMessage("{")
+		_, err = acv.VerifyContract(address, artifact)
+		require.Equal(t, ErrInvalidABI, err)
+	})
+	t.Run("accounts repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AccountsRepository = &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		acv, _ := NewAPIContractsVerifier(args)
+		record, err := acv.VerifyContract(hex.EncodeToString(contractAddress), createArtifact())
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, record)
+	})
+	t.Run("account without code should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AccountsRepository = &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				return &stateMock.UserAccountStub{}, nil, nil
+			},
+		}
+		acv, _ := NewAPIContractsVerifier(args)
+		record, err := acv.VerifyContract(hex.EncodeToString(contractAddress), createArtifact())
+		require.True(t, errors.Is(err, ErrNotASmartContract))
+		require.Nil(t, record)
+	})
+	t.Run("different code should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		acv, _ := NewAPIContractsVerifier(args)
+		artifact := createArtifact()
+		artifact.Code = hex.EncodeToString([]byte("other wasm code"))
+		record, err := acv.VerifyContract(hex.EncodeToString(contractAddress), artifact)
+		require.True(t, errors.Is(err, ErrCodeHashMismatch))
+		require.Nil(t, record)
+
+		has := args.ContractsVerificationStorer.Has(contractAddress)
+		require.Error(t, has)
+	})
+	t.Run("code not matching the deployed one should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		accountsRepository := args.AccountsRepository.(*stateMock.AccountsRepositoryStub)
+		accountsRepository.GetCodeWithBlockInfoCalled = func(codeHash []byte, options api.AccountQueryOptions) ([]byte, common.BlockInfo, error) {
+			return []byte("deployed code"), nil, nil
+		}
+		acv, _ := NewAPIContractsVerifier(args)
+		record, err := acv.VerifyContract(hex.EncodeToString(contractAddress), createArtifact())
+		require.Equal(t, ErrCodeMismatch, err)
+		require.Nil(t, record)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		accountsRepository := args.AccountsRepository.(*stateMock.AccountsRepositoryStub)
+		getAccountWithBlockInfo := accountsRepository.GetAccountWithBlockInfoCalled
+		accountsRepository.GetAccountWithBlockInfoCalled = func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			require.True(t, options.OnFinalBlock)
+			return getAccountWithBlockInfo(address, options)
+		}
+		acv, _ := NewAPIContractsVerifier(args)
+		address := hex.EncodeToString(contractAddress)
+		expectedCodeHash := hex.EncodeToString(args.Hasher.Compute(string(contractCode)))
+
+		record, err := acv.VerifyContract(address, createArtifact())
+		require.NoError(t, err)
+		require.Equal(t, address, record.Address)
+		require.Equal(t, expectedCodeHash, record.CodeHash)
+		require.Equal(t, "rustc 1.76.0", record.UnverifiedMetadata.CompilerVersion)
+		require.Equal(t, "abcdef01", record.UnverifiedMetadata.SourceHash)
+		require.Equal(t, "https://example.com/adder.zip", record.UnverifiedMetadata.SourceURL)
+		require.Equal(t, "multiversx/sdk-rust-contract-builder:v6.1.0", record.UnverifiedMetadata.BuildImage)
+		require.Equal(t, contractSourceABI, record.UnverifiedMetadata.ABI)
+		require.NotZero(t, record.Timestamp)
+
+		storedRecord, err := acv.GetVerifiedContract(address, args.Hasher.Compute(string(contractCode)))
+		require.NoError(t, err)
+		require.Equal(t, record, storedRecord)
+	})
+}
+
+func TestApiContractsVerifier_GetVerifiedContract(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	acv, _ := NewAPIContractsVerifier(args)
+	address := hex.EncodeToString(contractAddress)
+	codeHash := args.Hasher.Compute(string(contractCode))
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		record, err := acv.GetVerifiedContract("invalid", codeHash)
+		require.Error(t, err)
+		require.Nil(t, record)
+	})
+	t.Run("not verified contract should return nil", func(t *testing.T) {
+		record, err := acv.GetVerifiedContract(address, codeHash)
+		require.NoError(t, err)
+		require.Nil(t, record)
+	})
+	t.Run("upgraded contract should return nil", func(t *testing.T) {
+		_, err := acv.VerifyContract(address, createArtifact())
+		require.NoError(t, err)
+
+		record, err := acv.GetVerifiedContract(address, []byte("new code hash"))
+		require.NoError(t, err)
+		require.Nil(t, record)
+
+		record, err = acv.GetVerifiedContract(address, nil)
+		require.NoError(t, err)
+		require.Nil(t, record)
+	})
+	t.Run("storage error should error", func(t *testing.T) {
+		argsWithStorageErr := createMockArgs()
+		argsWithStorageErr.ContractsVerificationStorer = &storageStubs.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		acvWithStorageErr, _ := NewAPIContractsVerifier(argsWithStorageErr)
+
+		record, err := acvWithStorageErr.GetVerifiedContract(address, codeHash)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, record)
+	})
+	t.Run("key not found error should return nil", func(t *testing.T) {
+		argsWithKeyNotFound := createMockArgs()
+		argsWithKeyNotFound.ContractsVerificationStorer = genericMocks.NewStorerMockWithErrKeyNotFound(0)
+		acvWithKeyNotFound, _ := NewAPIContractsVerifier(argsWithKeyNotFound)
+
+		record, err := acvWithKeyNotFound.GetVerifiedContract(address, codeHash)
+		require.NoError(t, err)
+		require.Nil(t, record)
+	})
+	t.Run("corrupted record should error", func(t *testing.T) {
+		_ = args.ContractsVerificationStorer.Put(contractAddress, []byte("corrupted record"))
+
+		record, err := acv.GetVerifiedContract(address, codeHash)
+		require.Error(t, err)
+		require.Nil(t, record)
+	})
+}
+
+func TestDisabledContractsVerifier(t *testing.T) {
+	t.Parallel()
+
+	dcv := NewDisabledContractsVerifier()
+	require.False(t, check.IfNil(dcv))
+
+	record, err := dcv.VerifyContract(hex.EncodeToString(contractAddress), createArtifact())
+	require.Equal(t, ErrContractsVerificationNotAvailableOnMetachain, err)
+	require.Nil(t, record)
+
+	record, err = dcv.GetVerifiedContract(hex.EncodeToString(contractAddress), []byte("code hash"))
+	require.NoError(t, err)
+	require.Nil(t, record)
+}
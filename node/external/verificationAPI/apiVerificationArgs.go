@@ -0,0 +1,18 @@
+package verificationAPI
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+// ArgAPIContractsVerifier is the structure that stores the components needed to create an api contracts verifier
+type ArgAPIContractsVerifier struct {
+	ContractsVerificationStorer storage.Storer
+	AccountsRepository          state.AccountsRepository
+	Marshaller                  marshal.Marshalizer
+	Hasher                      hashing.Hasher
+	AddressPubKeyConverter      core.PubkeyConverter
+}
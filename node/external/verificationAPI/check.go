@@ -0,0 +1,31 @@
+package verificationAPI
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+func checkNilArgs(arg *ArgAPIContractsVerifier) error {
+	if arg == nil {
+		return ErrNilAPIContractsVerifierArg
+	}
+	if check.IfNil(arg.ContractsVerificationStorer) {
+		return fmt.Errorf("%w for contracts verification", process.ErrNilStorage)
+	}
+	if check.IfNil(arg.AccountsRepository) {
+		return ErrNilAccountsRepository
+	}
+	if check.IfNil(arg.Marshaller) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(arg.Hasher) {
+		return process.ErrNilHasher
+	}
+	if check.IfNil(arg.AddressPubKeyConverter) {
+		return process.ErrNilPubkeyConverter
+	}
+
+	return nil
+}
@@ -0,0 +1,711 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: contractVerificationRecord.proto
+
+package verificationAPI
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ContractVerificationRecord holds the stored verification of a smart contract: the hash of the code checked against
+// the deployed one, along with the source metadata supplied by the submitter, which is not checked by the node
+type ContractVerificationRecord struct {
+	CodeHash        []byte `protobuf:"bytes,1,opt,name=CodeHash,proto3" json:"CodeHash,omitempty"`
+	CompilerVersion string `protobuf:"bytes,2,opt,name=CompilerVersion,proto3" json:"CompilerVersion,omitempty"`
+	SourceHash      string `protobuf:"bytes,3,opt,name=SourceHash,proto3" json:"SourceHash,omitempty"`
+	SourceURL       string `protobuf:"bytes,4,opt,name=SourceURL,proto3" json:"SourceURL,omitempty"`
+	BuildImage      string `protobuf:"bytes,5,opt,name=BuildImage,proto3" json:"BuildImage,omitempty"`
+	ABI             []byte `protobuf:"bytes,6,opt,name=ABI,proto3" json:"ABI,omitempty"`
+	Timestamp       int64  `protobuf:"varint,7,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+}
+
+func (m *ContractVerificationRecord) Reset()      { *m = ContractVerificationRecord{} }
+func (*ContractVerificationRecord) ProtoMessage() {}
+func (*ContractVerificationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5db052bc090a5790, []int{0}
+}
+func (m *ContractVerificationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractVerificationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContractVerificationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractVerificationRecord.Merge(m, src)
+}
+func (m *ContractVerificationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractVerificationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractVerificationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractVerificationRecord proto.InternalMessageInfo
+
+func (m *ContractVerificationRecord) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *ContractVerificationRecord) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
+}
+
+func (m *ContractVerificationRecord) GetSourceHash() string {
+	if m != nil {
+		return m.SourceHash
+	}
+	return ""
+}
+
+func (m *ContractVerificationRecord) GetSourceURL() string {
+	if m != nil {
+		return m.SourceURL
+	}
+	return ""
+}
+
+func (m *ContractVerificationRecord) GetBuildImage() string {
+	if m != nil {
+		return m.BuildImage
+	}
+	return ""
+}
+
+func (m *ContractVerificationRecord) GetABI() []byte {
+	if m != nil {
+		return m.ABI
+	}
+	return nil
+}
+
+func (m *ContractVerificationRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ContractVerificationRecord)(nil), "proto.ContractVerificationRecord")
+}
+
+func init() { proto.RegisterFile("contractVerificationRecord.proto", fileDescriptor_5db052bc090a5790) }
+
+var fileDescriptor_5db052bc090a5790 = []byte{
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4e, 0x02, 0x31,
+	0x18, 0xc7, 0xfb, 0x89, 0xa0, 0x34, 0x26, 0x98, 0x4e, 0x0d, 0x31, 0x5f, 0x2e, 0x4e, 0xb7, 0x08,
+	0x83, 0x4f, 0x00, 0x2c, 0x5e, 0xe2, 0x60, 0x4e, 0x65, 0x70, 0x3b, 0x4a, 0x39, 0x9a, 0x70, 0x7c,
+	0xa4, 0xf4, 0x9c, 0x7d, 0x04, 0x1f, 0xc3, 0x47, 0x71, 0x64, 0x64, 0x94, 0xb2, 0x38, 0xf2, 0x08,
+	0x86, 0x5e, 0x22, 0xc4, 0xc4, 0xa9, 0xdf, 0xef, 0xd7, 0xfe, 0xff, 0x69, 0x3e, 0x1e, 0x29, 0x9a,
+	0x3b, 0x9b, 0x29, 0x37, 0xd4, 0xd6, 0x4c, 0x8c, 0xca, 0x9c, 0xa1, 0x79, 0xaa, 0x15, 0xd9, 0x71,
+	0x67, 0x61, 0xc9, 0x91, 0xa8, 0x87, 0xa3, 0x7d, 0x93, 0x1b, 0x37, 0x2d, 0x47, 0x1d, 0x45, 0x45,
+	0x37, 0xa7, 0x9c, 0xba, 0x41, 0x8f, 0xca, 0x49, 0xa0, 0x00, 0x61, 0xaa, 0x52, 0xd7, 0x3b, 0xe0,
+	0xed, 0xc1, 0xbf, 0xd5, 0xa2, 0xcd, 0xcf, 0x07, 0x34, 0xd6, 0x77, 0xd9, 0x72, 0x2a, 0x21, 0x82,
+	0xf8, 0x22, 0xfd, 0x65, 0x11, 0xf3, 0xd6, 0x80, 0x8a, 0x85, 0x99, 0x69, 0x3b, 0xd4, 0x76, 0x69,
+	0x68, 0x2e, 0x4f, 0x22, 0x88, 0x9b, 0xe9, 0x5f, 0x2d, 0x90, 0xf3, 0x47, 0x2a, 0xad, 0xaa, 0x7a,
+	0x6a, 0xe1, 0xd1, 0x91, 0x11, 0x57, 0xbc, 0x59, 0xd1, 0x73, 0x7a, 0x2f, 0x4f, 0xc3, 0xf5, 0x41,
+	0xec, 0xd3, 0xfd, 0xd2, 0xcc, 0xc6, 0x49, 0x91, 0xe5, 0x5a, 0xd6, 0xab, 0xf4, 0xc1, 0x88, 0x4b,
+	0x5e, 0xeb, 0xf5, 0x13, 0xd9, 0x08, 0xdf, 0xdb, 0x8f, 0xfb, 0xbe, 0x27, 0x53, 0xe8, 0xa5, 0xcb,
+	0x8a, 0x85, 0x3c, 0x8b, 0x20, 0xae, 0xa5, 0x07, 0xd1, 0x4f, 0x56, 0x1b, 0x64, 0xeb, 0x0d, 0xb2,
+	0xdd, 0x06, 0xe1, 0xcd, 0x23, 0x7c, 0x78, 0x84, 0x4f, 0x8f, 0xb0, 0xf2, 0x08, 0x6b, 0x8f, 0xf0,
+	0xe5, 0x11, 0xbe, 0x3d, 0xb2, 0x9d, 0x47, 0x78, 0xdf, 0x22, 0x5b, 0x6d, 0x91, 0xad, 0xb7, 0xc8,
+	0x5e, 0x5a, 0xaf, 0x47, 0x1b, 0xea, 0x3d, 0x24, 0xa3, 0x46, 0x58, 0xe2, 0xed, 0xcf, 0x00, 0xea,
+	0xb0, 0x19, 0xf5, 0x9e, 0x01, 0x00, 0x00,
+}
+
+func (this *ContractVerificationRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractVerificationRecord)
+	if !ok {
+		that2, ok := that.(ContractVerificationRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
+	}
+	if this.CompilerVersion != that1.CompilerVersion {
+		return false
+	}
+	if this.SourceHash != that1.SourceHash {
+		return false
+	}
+	if this.SourceURL != that1.SourceURL {
+		return false
+	}
+	if this.BuildImage != that1.BuildImage {
+		return false
+	}
+	if !bytes.Equal(this.ABI, that1.ABI) {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
+}
+func (this *ContractVerificationRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&verificationAPI.ContractVerificationRecord{")
+	s = append(s, "CodeHash: "+fmt.Sprintf("%#v", this.CodeHash)+",\n")
+	s = append(s, "CompilerVersion: "+fmt.Sprintf("%#v", this.CompilerVersion)+",\n")
+	s = append(s, "SourceHash: "+fmt.Sprintf("%#v", this.SourceHash)+",\n")
+	s = append(s, "SourceURL: "+fmt.Sprintf("%#v", this.SourceURL)+",\n")
+	s = append(s, "BuildImage: "+fmt.Sprintf("%#v", this.BuildImage)+",\n")
+	s = append(s, "ABI: "+fmt.Sprintf("%#v", this.ABI)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringContractVerificationRecord(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ContractVerificationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractVerificationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractVerificationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintContractVerificationRecord(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ABI) > 0 {
+		i -= len(m.ABI)
+		copy(dAtA[i:], m.ABI)
+		i = encodeVarintContractVerificationRecord(dAtA, i, uint64(len(m.ABI)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BuildImage) > 0 {
+		i -= len(m.BuildImage)
+		copy(dAtA[i:], m.BuildImage)
+		i = encodeVarintContractVerificationRecord(dAtA, i, uint64(len(m.BuildImage)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourceURL) > 0 {
+		i -= len(m.SourceURL)
+		copy(dAtA[i:], m.SourceURL)
+		i = encodeVarintContractVerificationRecord(dAtA, i, uint64(len(m.SourceURL)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceHash) > 0 {
+		i -= len(m.SourceHash)
+		copy(dAtA[i:], m.SourceHash)
+		i = encodeVarintContractVerificationRecord(dAtA, i, uint64(len(m.SourceHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CompilerVersion) > 0 {
+		i -= len(m.CompilerVersion)
+		copy(dAtA[i:], m.CompilerVersion)
+		i = encodeVarintContractVerificationRecord(dAtA, i, uint64(len(m.CompilerVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintContractVerificationRecord(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintContractVerificationRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovContractVerificationRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ContractVerificationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovContractVerificationRecord(uint64(l))
+	}
+	l = len(m.CompilerVersion)
+	if l > 0 {
+		n += 1 + l + sovContractVerificationRecord(uint64(l))
+	}
+	l = len(m.SourceHash)
+	if l > 0 {
+		n += 1 + l + sovContractVerificationRecord(uint64(l))
+	}
+	l = len(m.SourceURL)
+	if l > 0 {
+		n += 1 + l + sovContractVerificationRecord(uint64(l))
+	}
+	l = len(m.BuildImage)
+	if l > 0 {
+		n += 1 + l + sovContractVerificationRecord(uint64(l))
+	}
+	l = len(m.ABI)
+	if l > 0 {
+		n += 1 + l + sovContractVerificationRecord(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovContractVerificationRecord(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func sovContractVerificationRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozContractVerificationRecord(x uint64) (n int) {
+	return sovContractVerificationRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ContractVerificationRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContractVerificationRecord{`,
+		`CodeHash:` + fmt.Sprintf("%v", this.CodeHash) + `,`,
+		`CompilerVersion:` + fmt.Sprintf("%v", this.CompilerVersion) + `,`,
+		`SourceHash:` + fmt.Sprintf("%v", this.SourceHash) + `,`,
+		`SourceURL:` + fmt.Sprintf("%v", this.SourceURL) + `,`,
+		`BuildImage:` + fmt.Sprintf("%v", this.BuildImage) + `,`,
+		`ABI:` + fmt.Sprintf("%v", this.ABI) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringContractVerificationRecord(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ContractVerificationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractVerificationRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractVerificationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractVerificationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractVerificationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompilerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractVerificationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompilerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractVerificationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractVerificationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractVerificationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ABI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractVerificationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ABI = append(m.ABI[:0], dAtA[iNdEx:postIndex]...)
+			if m.ABI == nil {
+				m.ABI = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractVerificationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractVerificationRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthContractVerificationRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipContractVerificationRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowContractVerificationRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowContractVerificationRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowContractVerificationRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthContractVerificationRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupContractVerificationRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthContractVerificationRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthContractVerificationRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowContractVerificationRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupContractVerificationRecord = fmt.Errorf("proto: unexpected end of group")
+)
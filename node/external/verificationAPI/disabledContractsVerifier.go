@@ -0,0 +1,27 @@
+package verificationAPI
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type disabledContractsVerifier struct{}
+
+// NewDisabledContractsVerifier returns a disabled implementation to be used on metachain nodes
+func NewDisabledContractsVerifier() *disabledContractsVerifier {
+	return &disabledContractsVerifier{}
+}
+
+// VerifyContract returns the ErrContractsVerificationNotAvailableOnMetachain error
+func (dcv *disabledContractsVerifier) VerifyContract(_ string, _ *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error) {
+	return nil, ErrContractsVerificationNotAvailableOnMetachain
+}
+
+// GetVerifiedContract returns nil as no contract can be verified
+func (dcv *disabledContractsVerifier) GetVerifiedContract(_ string, _ []byte) (*common.VerifiedContractAPIResponse, error) {
+	return nil, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dcv *disabledContractsVerifier) IsInterfaceNil() bool {
+	return dcv == nil
+}
@@ -0,0 +1,36 @@
+package verificationAPI
+
+import "errors"
+
+// ErrNilAPIContractsVerifierArg signals that nil arguments were provided
+var ErrNilAPIContractsVerifierArg = errors.New("nil api contracts verifier arguments")
+
+// ErrNilAccountsRepository signals that a nil accounts repository was provided
+var ErrNilAccountsRepository = errors.New("nil accounts repository")
+
+// ErrNilVerificationArtifact signals that a nil verification artifact was provided
+var ErrNilVerificationArtifact = errors.New("nil verification artifact")
+
+// ErrInvalidCode signals that the provided contract code is empty or not hex encoded
+var ErrInvalidCode = errors.New("invalid contract code")
+
+// ErrEmptyCompilerVersion signals that the compiler version was not provided
+var ErrEmptyCompilerVersion = errors.New("empty compiler version")
+
+// ErrInvalidSourceHash signals that the provided source hash is empty or not hex encoded
+var ErrInvalidSourceHash = errors.New("invalid source hash")
+
+// ErrInvalidABI signals that the provided ABI is not a valid JSON
+var ErrInvalidABI = errors.New("invalid abi")
+
+// ErrNotASmartContract signals that the provided address does not belong to a smart contract
+var ErrNotASmartContract = errors.New("address is not a smart contract")
+
+// ErrCodeHashMismatch signals that the hash of the provided code differs from the code hash of the deployed contract
+var ErrCodeHashMismatch = errors.New("code hash mismatch")
+
+// ErrCodeMismatch signals that the provided code differs from the deployed one
+var ErrCodeMismatch = errors.New("code mismatch")
+
+// ErrContractsVerificationNotAvailableOnMetachain signals that a contract verification was requested from a metachain node
+var ErrContractsVerificationNotAvailableOnMetachain = errors.New("contracts verification is not available on a metachain node")
@@ -15,6 +15,7 @@ type TransactionAPIHandlerStub struct {
 	UnmarshalTransactionCalled                  func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	UnmarshalReceiptCalled                      func(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	PopulateComputedFieldsCalled                func(tx *transaction.ApiTransactionResult)
+	DecodeTransactionCalled                     func(tx *transaction.ApiTransactionResult) *common.ABIDecodedTransactionAPIResponse
 }
 
 // GetTransaction -
@@ -86,6 +87,15 @@ func (tas *TransactionAPIHandlerStub) PopulateComputedFields(tx *transaction.Api
 	}
 }
 
+// DecodeTransaction -
+func (tas *TransactionAPIHandlerStub) DecodeTransaction(tx *transaction.ApiTransactionResult) *common.ABIDecodedTransactionAPIResponse {
+	if tas.DecodeTransactionCalled != nil {
+		return tas.DecodeTransactionCalled(tx)
+	}
+
+	return &common.ABIDecodedTransactionAPIResponse{}
+}
+
 // IsInterfaceNil -
 func (tas *TransactionAPIHandlerStub) IsInterfaceNil() bool {
 	return tas == nil
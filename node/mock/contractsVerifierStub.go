@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// ContractsVerifierStub -
+type ContractsVerifierStub struct {
+	VerifyContractCalled      func(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error)
+	GetVerifiedContractCalled func(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error)
+}
+
+// VerifyContract -
+func (cvs *ContractsVerifierStub) VerifyContract(address string, artifact *common.ContractVerificationArtifact) (*common.VerifiedContractAPIResponse, error) {
+	if cvs.VerifyContractCalled != nil {
+		return cvs.VerifyContractCalled(address, artifact)
+	}
+
+	return nil, nil
+}
+
+// GetVerifiedContract -
+func (cvs *ContractsVerifierStub) GetVerifiedContract(address string, codeHash []byte) (*common.VerifiedContractAPIResponse, error) {
+	if cvs.GetVerifiedContractCalled != nil {
+		return cvs.GetVerifiedContractCalled(address, codeHash)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (cvs *ContractsVerifierStub) IsInterfaceNil() bool {
+	return cvs == nil
+}
@@ -0,0 +1,56 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// DelegationContractHandlerStub -
+type DelegationContractHandlerStub struct {
+	GetContractConfigCalled func(contract string) (*common.DelegationContractConfigAPIResponse, error)
+	GetNodeStatesCalled     func(contract string) (*common.DelegationNodeStatesAPIResponse, error)
+	GetDelegatorCalled      func(contract string, delegator string) (*common.DelegatorAPIResponse, error)
+	GetDelegatorsCalled     func(ctx context.Context, contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error)
+}
+
+// GetContractConfig -
+func (dchs *DelegationContractHandlerStub) GetContractConfig(contract string) (*common.DelegationContractConfigAPIResponse, error) {
+	if dchs.GetContractConfigCalled != nil {
+		return dchs.GetContractConfigCalled(contract)
+	}
+
+	return nil, nil
+}
+
+// GetNodeStates -
+func (dchs *DelegationContractHandlerStub) GetNodeStates(contract string) (*common.DelegationNodeStatesAPIResponse, error) {
+	if dchs.GetNodeStatesCalled != nil {
+		return dchs.GetNodeStatesCalled(contract)
+	}
+
+	return nil, nil
+}
+
+// GetDelegator -
+func (dchs *DelegationContractHandlerStub) GetDelegator(contract string, delegator string) (*common.DelegatorAPIResponse, error) {
+	if dchs.GetDelegatorCalled != nil {
+		return dchs.GetDelegatorCalled(contract, delegator)
+	}
+
+	return nil, nil
+}
+
+// GetDelegators -
+func (dchs *DelegationContractHandlerStub) GetDelegators(ctx context.Context, contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error) {
+	if dchs.GetDelegatorsCalled != nil {
+		return dchs.GetDelegatorsCalled(ctx, contract, page, pageSize)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (dchs *DelegationContractHandlerStub) IsInterfaceNil() bool {
+	return dchs == nil
+}
@@ -0,0 +1,72 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/factory"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// ConsensusComponentsStub -
+type ConsensusComponentsStub struct {
+	ChronologyField                consensus.ChronologyHandler
+	ConsensusWorkerField           factory.ConsensusWorker
+	BroadcastMessengerField        consensus.BroadcastMessenger
+	ConsensusGroupSizeField        int
+	BootstrapperField              process.Bootstrapper
+	SlashingProtectionHandlerField consensus.SlashingProtectionHandler
+}
+
+// Create -
+func (ccs *ConsensusComponentsStub) Create() error {
+	return nil
+}
+
+// Close -
+func (ccs *ConsensusComponentsStub) Close() error {
+	return nil
+}
+
+// CheckSubcomponents -
+func (ccs *ConsensusComponentsStub) CheckSubcomponents() error {
+	return nil
+}
+
+// Chronology -
+func (ccs *ConsensusComponentsStub) Chronology() consensus.ChronologyHandler {
+	return ccs.ChronologyField
+}
+
+// ConsensusWorker -
+func (ccs *ConsensusComponentsStub) ConsensusWorker() factory.ConsensusWorker {
+	return ccs.ConsensusWorkerField
+}
+
+// BroadcastMessenger -
+func (ccs *ConsensusComponentsStub) BroadcastMessenger() consensus.BroadcastMessenger {
+	return ccs.BroadcastMessengerField
+}
+
+// ConsensusGroupSize -
+func (ccs *ConsensusComponentsStub) ConsensusGroupSize() (int, error) {
+	return ccs.ConsensusGroupSizeField, nil
+}
+
+// Bootstrapper -
+func (ccs *ConsensusComponentsStub) Bootstrapper() process.Bootstrapper {
+	return ccs.BootstrapperField
+}
+
+// SlashingProtectionHandler -
+func (ccs *ConsensusComponentsStub) SlashingProtectionHandler() consensus.SlashingProtectionHandler {
+	return ccs.SlashingProtectionHandlerField
+}
+
+// String -
+func (ccs *ConsensusComponentsStub) String() string {
+	return "ConsensusComponentsStub"
+}
+
+// IsInterfaceNil -
+func (ccs *ConsensusComponentsStub) IsInterfaceNil() bool {
+	return ccs == nil
+}
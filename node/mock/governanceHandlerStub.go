@@ -0,0 +1,76 @@
+package mock
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// GovernanceHandlerStub -
+type GovernanceHandlerStub struct {
+	GetConfigCalled            func() (*common.GovernanceConfigAPIResponse, error)
+	GetProposalsCalled         func() ([]*common.GovernanceProposalAPIResponse, error)
+	GetProposalCalled          func(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetVotesCalled             func(address string) (*common.GovernanceVotesAPIResponse, error)
+	GetVotingPowerCalled       func(address string) (*big.Int, error)
+	GetDelegatedVoteInfoCalled func(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error)
+}
+
+// GetConfig -
+func (ghs *GovernanceHandlerStub) GetConfig() (*common.GovernanceConfigAPIResponse, error) {
+	if ghs.GetConfigCalled != nil {
+		return ghs.GetConfigCalled()
+	}
+
+	return nil, nil
+}
+
+// GetProposals -
+func (ghs *GovernanceHandlerStub) GetProposals() ([]*common.GovernanceProposalAPIResponse, error) {
+	if ghs.GetProposalsCalled != nil {
+		return ghs.GetProposalsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetProposal -
+func (ghs *GovernanceHandlerStub) GetProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	if ghs.GetProposalCalled != nil {
+		return ghs.GetProposalCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetVotes -
+func (ghs *GovernanceHandlerStub) GetVotes(address string) (*common.GovernanceVotesAPIResponse, error) {
+	if ghs.GetVotesCalled != nil {
+		return ghs.GetVotesCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetVotingPower -
+func (ghs *GovernanceHandlerStub) GetVotingPower(address string) (*big.Int, error) {
+	if ghs.GetVotingPowerCalled != nil {
+		return ghs.GetVotingPowerCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetDelegatedVoteInfo -
+func (ghs *GovernanceHandlerStub) GetDelegatedVoteInfo(contract string, nonce uint64) (*common.GovernanceDelegatedVoteInfoAPIResponse, error) {
+	if ghs.GetDelegatedVoteInfoCalled != nil {
+		return ghs.GetDelegatedVoteInfoCalled(contract, nonce)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (ghs *GovernanceHandlerStub) IsInterfaceNil() bool {
+	return ghs == nil
+}
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// RewardsBreakdownHandlerStub -
+type RewardsBreakdownHandlerStub struct {
+	GetRewardsBreakdownCalled      func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetOwnerRewardsBreakdownCalled func(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error)
+}
+
+// GetRewardsBreakdown -
+func (rbhs *RewardsBreakdownHandlerStub) GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	if rbhs.GetRewardsBreakdownCalled != nil {
+		return rbhs.GetRewardsBreakdownCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// GetOwnerRewardsBreakdown -
+func (rbhs *RewardsBreakdownHandlerStub) GetOwnerRewardsBreakdown(epoch uint32, owner string) (*common.OwnerRewardsBreakdownAPIResponse, error) {
+	if rbhs.GetOwnerRewardsBreakdownCalled != nil {
+		return rbhs.GetOwnerRewardsBreakdownCalled(epoch, owner)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (rbhs *RewardsBreakdownHandlerStub) IsInterfaceNil() bool {
+	return rbhs == nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// StakingInfoHandlerStub -
+type StakingInfoHandlerStub struct {
+	GetOwnerStakingInfoCalled func(ctx context.Context, owner string) (*common.OwnerStakingInfoAPIResponse, error)
+}
+
+// GetOwnerStakingInfo -
+func (sihs *StakingInfoHandlerStub) GetOwnerStakingInfo(ctx context.Context, owner string) (*common.OwnerStakingInfoAPIResponse, error) {
+	if sihs.GetOwnerStakingInfoCalled != nil {
+		return sihs.GetOwnerStakingInfoCalled(ctx, owner)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (sihs *StakingInfoHandlerStub) IsInterfaceNil() bool {
+	return sihs == nil
+}
@@ -25,6 +25,7 @@ import (
 	disabledSig "github.com/multiversx/mx-chain-crypto-go/signing/disabled/singlesig"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/facade"
@@ -1071,6 +1072,17 @@ func (n *Node) AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, erro
 	return n.processComponents.ValidatorsProvider().GetAuctionList()
 }
 
+// AuctionSimulationApi will return the auction list resulted after applying the provided hypothetical changes
+func (n *Node) AuctionSimulationApi(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error) {
+	return n.processComponents.ValidatorsProvider().SimulateAuction(request)
+}
+
+// ValidatorHistoryApi will return the statistics of the provided validator, as recorded at the end of each epoch from the
+// provided range
+func (n *Node) ValidatorHistoryApi(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error) {
+	return n.processComponents.ValidatorsProvider().GetValidatorHistory(blsKey, fromEpoch, toEpoch)
+}
+
 // DirectTrigger will start the hardfork trigger
 func (n *Node) DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error {
 	return n.processComponents.HardforkTrigger().Trigger(epoch, withEarlyEndOfEpoch)
@@ -1169,6 +1181,157 @@ func (n *Node) GetConnectedPeersRatingsOnMainNetwork() (string, error) {
 	return n.networkComponents.PeersRatingMonitor().GetConnectedPeersRatings(n.networkComponents.NetworkMessenger())
 }
 
+// GetAntifloodQuotas returns the current status of the input antiflood quota flood preventers
+func (n *Node) GetAntifloodQuotas() []*common.AntifloodQuotaStatus {
+	return n.networkComponents.AntifloodDashboard().GetQuotasStatus()
+}
+
+// GetAntifloodTopics returns the current status of the input antiflood topic flood preventer
+func (n *Node) GetAntifloodTopics() []*common.AntifloodTopicStatus {
+	return n.networkComponents.AntifloodDashboard().GetTopicsStatus()
+}
+
+// GetAntifloodBlacklistedPeers returns the peers currently blacklisted by the antiflood components
+func (n *Node) GetAntifloodBlacklistedPeers() []*common.BlacklistedPeer {
+	return n.networkComponents.AntifloodDashboard().GetBlacklistedPeers()
+}
+
+// SetAntifloodQuota changes the limits of the antiflood quota flood preventer with the provided name
+func (n *Node) SetAntifloodQuota(name string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	return n.networkComponents.AntifloodDashboard().SetMaxQuota(name, maxMessagesPerPeer, maxTotalSizePerPeer)
+}
+
+// SetAntifloodTopicMaxMessages changes the maximum number of messages per peer accepted on the provided topic
+func (n *Node) SetAntifloodTopicMaxMessages(topic string, maxMessagesPerPeer uint32) error {
+	return n.networkComponents.AntifloodDashboard().SetMaxMessagesForTopic(topic, maxMessagesPerPeer)
+}
+
+// AddAntifloodBlacklistedPeer manually blacklists the provided peer for the provided duration
+func (n *Node) AddAntifloodBlacklistedPeer(pid string, duration time.Duration) error {
+	peerID, err := core.NewPeerID(pid)
+	if err != nil {
+		return fmt.Errorf("%w for provided peer %s", err, pid)
+	}
+
+	return n.networkComponents.AntifloodDashboard().BlacklistPeer(peerID, duration)
+}
+
+// RemoveAntifloodBlacklistedPeer manually removes the provided peer from the blacklist
+func (n *Node) RemoveAntifloodBlacklistedPeer(pid string) error {
+	peerID, err := core.NewPeerID(pid)
+	if err != nil {
+		return fmt.Errorf("%w for provided peer %s", err, pid)
+	}
+
+	return n.networkComponents.AntifloodDashboard().RemoveBlacklistedPeer(peerID)
+}
+
+// ExportPeerReputation returns the blacklisted peers and the peers ratings, as they would be persisted
+func (n *Node) ExportPeerReputation() *common.PeerReputationSnapshot {
+	return n.networkComponents.PeerReputationHandler().Export()
+}
+
+// ImportPeerReputation applies the provided blacklisted peers and peers ratings
+func (n *Node) ImportPeerReputation(snapshot *common.PeerReputationSnapshot) error {
+	return n.networkComponents.PeerReputationHandler().Import(snapshot)
+}
+
+// AddManagedKey loads the provided hex encoded BLS private key as a key managed by the current node and returns
+// the associated public key. The node must have been started in multikey mode. The key is not written in the
+// allValidatorsKeys.pem file, so it will no longer be managed after a restart unless it is added there as well
+func (n *Node) AddManagedKey(privateKeyHex string) (string, error) {
+	managedPeersHolder := n.cryptoComponents.ManagedPeersHolder()
+	if !managedPeersHolder.IsMultiKeyMode() {
+		return "", ErrNodeNotInMultiKeyMode
+	}
+
+	privateKeyBytes, err := hex.DecodeString(privateKeyHex)
+	if err != nil {
+		return "", fmt.Errorf("%w for provided private key", err)
+	}
+
+	privateKey, err := n.cryptoComponents.BlockSignKeyGen().PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return "", err
+	}
+
+	err = managedPeersHolder.AddManagedPeer(privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	publicKey, err := n.coreComponents.ValidatorPubKeyConverter().Encode(publicKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	log.Info("managed key added, the change is runtime-only and will be lost on restart", "public key", publicKey)
+
+	return publicKey, nil
+}
+
+// RemoveManagedKey releases the provided BLS public key, the node will no longer sign or send heartbeat messages
+// on its behalf. The key is not removed from the allValidatorsKeys.pem file, so it will be managed again after a restart
+func (n *Node) RemoveManagedKey(publicKey string) error {
+	managedPeersHolder := n.cryptoComponents.ManagedPeersHolder()
+	if !managedPeersHolder.IsMultiKeyMode() {
+		return ErrNodeNotInMultiKeyMode
+	}
+
+	publicKeyBytes, err := n.coreComponents.ValidatorPubKeyConverter().Decode(publicKey)
+	if err != nil {
+		return fmt.Errorf("%w for provided public key %s", err, publicKey)
+	}
+
+	err = managedPeersHolder.RemoveManagedPeer(publicKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	log.Info("managed key removed, the change is runtime-only and will be lost on restart", "public key", publicKey)
+
+	return nil
+}
+
+// ExportSlashingProtection returns the last signed block of each validator key, as persisted by the slashing protection
+func (n *Node) ExportSlashingProtection() (*common.SlashingProtectionSnapshot, error) {
+	slashingProtectionHandler, err := n.getSlashingProtectionHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	return slashingProtectionHandler.Export(), nil
+}
+
+// ImportSlashingProtection merges the provided signed block records, usually exported from another machine
+// running the same keys, into the slashing protection
+func (n *Node) ImportSlashingProtection(snapshot *common.SlashingProtectionSnapshot) error {
+	slashingProtectionHandler, err := n.getSlashingProtectionHandler()
+	if err != nil {
+		return err
+	}
+
+	return slashingProtectionHandler.Import(snapshot)
+}
+
+func (n *Node) getSlashingProtectionHandler() (consensus.SlashingProtectionHandler, error) {
+	if check.IfNil(n.consensusComponents) {
+		return nil, ErrNilConsensusComponents
+	}
+
+	slashingProtectionHandler := n.consensusComponents.SlashingProtectionHandler()
+	if check.IfNil(slashingProtectionHandler) {
+		return nil, ErrNilConsensusComponents
+	}
+
+	return slashingProtectionHandler, nil
+}
+
 // GetEpochStartDataAPI returns epoch start data of a given epoch
 func (n *Node) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	if epoch == 0 {
@@ -1427,6 +1427,7 @@ func (nr *nodeRunner) CreateManagedNetworkComponents(
 		NodeOperationMode:     common.NormalOperation,
 		ConnectionWatcherType: nr.configs.PreferencesConfig.Preferences.ConnectionWatcherType,
 		CryptoComponents:      cryptoComponents,
+		PathManager:           coreComponents.PathHandler(),
 	}
 	if nr.configs.ImportDbConfig.IsImportDBMode {
 		networkComponentsFactoryArgs.BootstrapWaitTime = 0
@@ -50,6 +50,8 @@ import (
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/bootstrapMocks"
+	consensusMocks "github.com/multiversx/mx-chain-go/testscommon/consensus"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
 	dataRetrieverMock "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
 	"github.com/multiversx/mx-chain-go/testscommon/dblookupext"
 	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
@@ -3858,6 +3860,316 @@ func TestNode_GetPeerInfoUnknownPeerShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, node.ErrUnknownPeerID))
 }
 
+func TestNode_AntifloodDashboardMethods(t *testing.T) {
+	t.Parallel()
+
+	providedPid := core.PeerID("pid")
+	expectedErr := errors.New("expected error")
+	blacklistCalled := false
+	removeCalled := false
+	networkComponents := getDefaultNetworkComponents()
+	networkComponents.AntifloodDashboardField = &testscommon.AntifloodDashboardStub{
+		GetQuotasStatusCalled: func() []*common.AntifloodQuotaStatus {
+			return []*common.AntifloodQuotaStatus{{Name: "quota"}}
+		},
+		SetMaxQuotaCalled: func(name string, baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+			return expectedErr
+		},
+		BlacklistPeerCalled: func(pid core.PeerID, duration time.Duration) error {
+			blacklistCalled = true
+			assert.Equal(t, providedPid, pid)
+			assert.Equal(t, time.Minute, duration)
+			return nil
+		},
+		RemoveBlacklistedPeerCalled: func(pid core.PeerID) error {
+			removeCalled = true
+			assert.Equal(t, providedPid, pid)
+			return nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithNetworkComponents(networkComponents),
+	)
+
+	assert.Equal(t, "quota", n.GetAntifloodQuotas()[0].Name)
+	assert.Empty(t, n.GetAntifloodTopics())
+	assert.Empty(t, n.GetAntifloodBlacklistedPeers())
+	assert.Equal(t, expectedErr, n.SetAntifloodQuota("quota", 1, 1))
+	assert.Nil(t, n.SetAntifloodTopicMaxMessages("topic", 1))
+
+	err := n.AddAntifloodBlacklistedPeer("invalid base58 0OIl", time.Minute)
+	assert.NotNil(t, err)
+	err = n.RemoveAntifloodBlacklistedPeer("invalid base58 0OIl")
+	assert.NotNil(t, err)
+	assert.False(t, blacklistCalled)
+	assert.False(t, removeCalled)
+
+	err = n.AddAntifloodBlacklistedPeer(providedPid.Pretty(), time.Minute)
+	assert.Nil(t, err)
+	err = n.RemoveAntifloodBlacklistedPeer(providedPid.Pretty())
+	assert.Nil(t, err)
+	assert.True(t, blacklistCalled)
+	assert.True(t, removeCalled)
+}
+
+func TestNode_PeerReputationMethods(t *testing.T) {
+	t.Parallel()
+
+	providedSnapshot := &common.PeerReputationSnapshot{
+		BlacklistedPeers: []*common.BlacklistedPeer{{Pid: "pid", ExpiresAt: 100}},
+	}
+	expectedErr := errors.New("expected error")
+	networkComponents := getDefaultNetworkComponents()
+	networkComponents.PeerReputationHandlerField = &testscommon.PeerReputationHandlerStub{
+		ExportCalled: func() *common.PeerReputationSnapshot {
+			return providedSnapshot
+		},
+		ImportCalled: func(snapshot *common.PeerReputationSnapshot) error {
+			assert.Equal(t, providedSnapshot, snapshot)
+			return expectedErr
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithNetworkComponents(networkComponents),
+	)
+
+	assert.Equal(t, providedSnapshot, n.ExportPeerReputation())
+	assert.Equal(t, expectedErr, n.ImportPeerReputation(providedSnapshot))
+}
+
+func TestNode_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	providedPrivateKey := []byte("private key")
+	providedPublicKey := []byte("public key")
+	createCryptoComponents := func(holder common.ManagedPeersHolder) *nodeMockFactory.CryptoComponentsMock {
+		cryptoComponents := getDefaultCryptoComponents()
+		cryptoComponents.ManagedPeersHolderField = holder
+		cryptoComponents.BlKeyGen = &cryptoMocks.KeyGenStub{
+			PrivateKeyFromByteArrayStub: func(b []byte) (crypto.PrivateKey, error) {
+				assert.Equal(t, providedPrivateKey, b)
+				return &cryptoMocks.PrivateKeyStub{
+					GeneratePublicStub: func() crypto.PublicKey {
+						return &cryptoMocks.PublicKeyStub{
+							ToByteArrayStub: func() ([]byte, error) {
+								return providedPublicKey, nil
+							},
+						}
+					},
+				}, nil
+			},
+		}
+
+		return cryptoComponents
+	}
+
+	t.Run("single key mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithCryptoComponents(createCryptoComponents(&testscommon.ManagedPeersHolderStub{})),
+		)
+
+		publicKey, err := n.AddManagedKey(hex.EncodeToString(providedPrivateKey))
+		assert.Equal(t, node.ErrNodeNotInMultiKeyMode, err)
+		assert.Empty(t, publicKey)
+	})
+	t.Run("invalid hex private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder := &testscommon.ManagedPeersHolderStub{
+			IsMultiKeyModeCalled: func() bool {
+				return true
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithCryptoComponents(createCryptoComponents(holder)),
+		)
+
+		publicKey, err := n.AddManagedKey("not a hex")
+		assert.NotNil(t, err)
+		assert.Empty(t, publicKey)
+	})
+	t.Run("managed peers holder errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		holder := &testscommon.ManagedPeersHolderStub{
+			IsMultiKeyModeCalled: func() bool {
+				return true
+			},
+			AddManagedPeerCalled: func(privateKeyBytes []byte) error {
+				return expectedErr
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithCryptoComponents(createCryptoComponents(holder)),
+		)
+
+		publicKey, err := n.AddManagedKey(hex.EncodeToString(providedPrivateKey))
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, publicKey)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		addCalled := false
+		holder := &testscommon.ManagedPeersHolderStub{
+			IsMultiKeyModeCalled: func() bool {
+				return true
+			},
+			AddManagedPeerCalled: func(privateKeyBytes []byte) error {
+				assert.Equal(t, providedPrivateKey, privateKeyBytes)
+				addCalled = true
+				return nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithCryptoComponents(createCryptoComponents(holder)),
+		)
+
+		publicKey, err := n.AddManagedKey(hex.EncodeToString(providedPrivateKey))
+		assert.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString(providedPublicKey), publicKey)
+		assert.True(t, addCalled)
+	})
+}
+
+func TestNode_RemoveManagedKey(t *testing.T) {
+	t.Parallel()
+
+	providedPublicKey := []byte("public key")
+	t.Run("single key mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		cryptoComponents := getDefaultCryptoComponents()
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithCryptoComponents(cryptoComponents),
+		)
+
+		err := n.RemoveManagedKey(hex.EncodeToString(providedPublicKey))
+		assert.Equal(t, node.ErrNodeNotInMultiKeyMode, err)
+	})
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		cryptoComponents := getDefaultCryptoComponents()
+		cryptoComponents.ManagedPeersHolderField = &testscommon.ManagedPeersHolderStub{
+			IsMultiKeyModeCalled: func() bool {
+				return true
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithCryptoComponents(cryptoComponents),
+		)
+
+		err := n.RemoveManagedKey("not a hex")
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		cryptoComponents := getDefaultCryptoComponents()
+		cryptoComponents.ManagedPeersHolderField = &testscommon.ManagedPeersHolderStub{
+			IsMultiKeyModeCalled: func() bool {
+				return true
+			},
+			RemoveManagedPeerCalled: func(pkBytes []byte) error {
+				assert.Equal(t, providedPublicKey, pkBytes)
+				return expectedErr
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithCryptoComponents(cryptoComponents),
+		)
+
+		err := n.RemoveManagedKey(hex.EncodeToString(providedPublicKey))
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestNode_ExportSlashingProtection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil consensus components should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+
+		snapshot, err := n.ExportSlashingProtection()
+		assert.Nil(t, snapshot)
+		assert.Equal(t, node.ErrNilConsensusComponents, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedSnapshot := &common.SlashingProtectionSnapshot{
+			Records: []*common.SignedBlockRecord{
+				{PublicKey: "aa", Epoch: 1, Round: 10, HeaderHash: "bb"},
+			},
+		}
+		consensusComponents := &nodeMockFactory.ConsensusComponentsStub{
+			SlashingProtectionHandlerField: &consensusMocks.SlashingProtectionHandlerStub{
+				ExportCalled: func() *common.SlashingProtectionSnapshot {
+					return providedSnapshot
+				},
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithConsensusComponents(consensusComponents),
+		)
+
+		snapshot, err := n.ExportSlashingProtection()
+		assert.Nil(t, err)
+		assert.Equal(t, providedSnapshot, snapshot)
+	})
+}
+
+func TestNode_ImportSlashingProtection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil slashing protection handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithConsensusComponents(&nodeMockFactory.ConsensusComponentsStub{}),
+		)
+
+		err := n.ImportSlashingProtection(&common.SlashingProtectionSnapshot{})
+		assert.Equal(t, node.ErrNilConsensusComponents, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		providedSnapshot := &common.SlashingProtectionSnapshot{}
+		consensusComponents := &nodeMockFactory.ConsensusComponentsStub{
+			SlashingProtectionHandlerField: &consensusMocks.SlashingProtectionHandlerStub{
+				ImportCalled: func(snapshot *common.SlashingProtectionSnapshot) error {
+					assert.True(t, snapshot == providedSnapshot)
+					return expectedErr
+				},
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithConsensusComponents(consensusComponents),
+		)
+
+		err := n.ImportSlashingProtection(providedSnapshot)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
 func TestNode_ShouldWork(t *testing.T) {
 	t.Parallel()
 
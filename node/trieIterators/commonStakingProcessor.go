@@ -1,9 +1,14 @@
 package trieIterators
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/errChan"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/state"
@@ -16,6 +21,21 @@ type stakingValidatorInfo struct {
 	topUpValue       *big.Int
 }
 
+const numDelegatorFundsValues = 4
+
+type unBondingFund struct {
+	value           *big.Int
+	remainingEpochs uint64
+}
+
+type delegatorFunds struct {
+	activeStake      *big.Int
+	claimableRewards *big.Int
+	unStaked         *big.Int
+	unBondable       *big.Int
+	unDelegatedList  []*unBondingFund
+}
+
 type commonStakingProcessor struct {
 	queryService process.SCQueryService
 	accounts     *AccountsWrapper
@@ -63,3 +83,182 @@ func (csp *commonStakingProcessor) getAccount(scAddress []byte) (state.UserAccou
 
 	return account, nil
 }
+
+func (csp *commonStakingProcessor) getDelegatorsList(delegationSC []byte, addressLength int, ctx context.Context) ([][]byte, error) {
+	delegatorAccount, err := csp.getAccount(delegationSC)
+	if err != nil {
+		return nil, fmt.Errorf("%w for delegationSC %s", err, hex.EncodeToString(delegationSC))
+	}
+
+	chLeaves := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err = delegatorAccount.GetAllLeaves(chLeaves, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delegators := make([][]byte, 0)
+	for leaf := range chLeaves.LeavesChan {
+		leafKey := leaf.Key()
+		if len(leafKey) != addressLength {
+			continue
+		}
+
+		delegators = append(delegators, leafKey)
+	}
+
+	err = chLeaves.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+
+	return delegators, nil
+}
+
+// isDelegator checks the data trie of the delegation contract, which holds the delegators data under their addresses,
+// so no query is executed for the contracts the delegator never used
+func (csp *commonStakingProcessor) isDelegator(delegationSC []byte, delegator []byte) (bool, error) {
+	delegationAccount, err := csp.getAccount(delegationSC)
+	if err != nil {
+		return false, fmt.Errorf("%w for delegationSC %s", err, hex.EncodeToString(delegationSC))
+	}
+
+	delegatorData, _, err := delegationAccount.RetrieveValue(delegator)
+	if err != nil {
+		return false, fmt.Errorf("%w for delegationSC %s", err, hex.EncodeToString(delegationSC))
+	}
+
+	return len(delegatorData) > 0, nil
+}
+
+func (csp *commonStakingProcessor) getActiveFund(delegationSC []byte, delegator []byte) (*big.Int, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  delegationSC,
+		FuncName:   "getUserActiveStake",
+		CallerAddr: delegationSC,
+		CallValue:  big.NewInt(0),
+		Arguments:  [][]byte{delegator},
+	}
+
+	vmOutput, _, err := csp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	if len(vmOutput.ReturnData) != 1 {
+		return nil, fmt.Errorf("%w, getActiveFund function should have returned one value", epochStart.ErrExecutingSystemScCode)
+	}
+
+	value := big.NewInt(0).SetBytes(vmOutput.ReturnData[0])
+
+	return value, nil
+}
+
+func (csp *commonStakingProcessor) getAllDelegationContractAddresses() ([][]byte, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  vm.DelegationManagerSCAddress,
+		FuncName:   "getAllContractAddresses",
+		CallerAddr: vm.DelegationManagerSCAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  make([][]byte, 0),
+	}
+
+	vmOutput, _, err := csp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+func (csp *commonStakingProcessor) getDelegatorFunds(delegationSC []byte, delegator []byte) (*delegatorFunds, error) {
+	fundsData, err := csp.executeDelegationQuery(delegationSC, "getDelegatorFundsData", delegator)
+	if err != nil {
+		return nil, err
+	}
+	if len(fundsData) != numDelegatorFundsValues {
+		return nil, fmt.Errorf("%w, getDelegatorFundsData function should have returned %d values", epochStart.ErrExecutingSystemScCode, numDelegatorFundsValues)
+	}
+
+	unDelegatedData, err := csp.executeDelegationQuery(delegationSC, "getUserUnDelegatedList", delegator)
+	if err != nil {
+		return nil, err
+	}
+	unDelegatedList, err := parseUnBondingFunds(unDelegatedData)
+	if err != nil {
+		return nil, fmt.Errorf("%w for getUserUnDelegatedList function", err)
+	}
+
+	return &delegatorFunds{
+		activeStake:      big.NewInt(0).SetBytes(fundsData[0]),
+		claimableRewards: big.NewInt(0).SetBytes(fundsData[1]),
+		unStaked:         big.NewInt(0).SetBytes(fundsData[2]),
+		unBondable:       big.NewInt(0).SetBytes(fundsData[3]),
+		unDelegatedList:  unDelegatedList,
+	}, nil
+}
+
+func (csp *commonStakingProcessor) executeDelegationQuery(contractAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+	return csp.executeQuery(contractAddress, contractAddress, funcName, args...)
+}
+
+func (csp *commonStakingProcessor) executeQuery(scAddress []byte, callerAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+	vmOutput, err := csp.queryVM(scAddress, callerAddress, funcName, args...)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, function: %s, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, funcName, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+func (csp *commonStakingProcessor) queryVM(scAddress []byte, callerAddress []byte, funcName string, args ...[]byte) (*vmcommon.VMOutput, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  scAddress,
+		FuncName:   funcName,
+		CallerAddr: callerAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  args,
+	}
+	if scQuery.Arguments == nil {
+		scQuery.Arguments = make([][]byte, 0)
+	}
+
+	vmOutput, _, err := csp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+// parseUnBondingFunds decodes the pairs of unstaked value and number of epochs left until the value can be withdrawn
+func parseUnBondingFunds(returnData [][]byte) ([]*unBondingFund, error) {
+	if len(returnData)%2 != 0 {
+		return nil, fmt.Errorf("%w, expected pairs of values", epochStart.ErrExecutingSystemScCode)
+	}
+
+	funds := make([]*unBondingFund, 0, len(returnData)/2)
+	for i := 0; i < len(returnData); i += 2 {
+		funds = append(funds, &unBondingFund{
+			value:           big.NewInt(0).SetBytes(returnData[i]),
+			remainingEpochs: big.NewInt(0).SetBytes(returnData[i+1]).Uint64(),
+		})
+	}
+
+	return funds, nil
+}
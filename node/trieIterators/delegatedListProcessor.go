@@ -10,12 +10,6 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
-	"github.com/multiversx/mx-chain-go/common"
-	"github.com/multiversx/mx-chain-go/common/errChan"
-	"github.com/multiversx/mx-chain-go/epochStart"
-	"github.com/multiversx/mx-chain-go/process"
-	"github.com/multiversx/mx-chain-go/vm"
-	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
 type delegatedListProcessor struct {
@@ -60,28 +54,8 @@ func (dlp *delegatedListProcessor) GetDelegatorsList(ctx context.Context) ([]*ap
 	return dlp.mapToSlice(delegatorsInfo), nil
 }
 
-func (dlp *delegatedListProcessor) getAllDelegationContractAddresses() ([][]byte, error) {
-	scQuery := &process.SCQuery{
-		ScAddress:  vm.DelegationManagerSCAddress,
-		FuncName:   "getAllContractAddresses",
-		CallerAddr: vm.DelegationManagerSCAddress,
-		CallValue:  big.NewInt(0),
-		Arguments:  make([][]byte, 0),
-	}
-
-	vmOutput, _, err := dlp.queryService.ExecuteQuery(scQuery)
-	if err != nil {
-		return nil, err
-	}
-	if vmOutput.ReturnCode != vmcommon.Ok {
-		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
-	}
-
-	return vmOutput.ReturnData, nil
-}
-
 func (dlp *delegatedListProcessor) getDelegatorsInfo(delegationSC []byte, delegatorsMap map[string]*api.Delegator, ctx context.Context) error {
-	delegatorsList, err := dlp.getDelegatorsList(delegationSC, ctx)
+	delegatorsList, err := dlp.getDelegatorsList(delegationSC, dlp.publicKeyConverter.Len(), ctx)
 	if err != nil {
 		return err
 	}
@@ -126,69 +100,6 @@ func (dlp *delegatedListProcessor) getDelegatorsInfo(delegationSC []byte, delega
 	return nil
 }
 
-func (dlp *delegatedListProcessor) getDelegatorsList(delegationSC []byte, ctx context.Context) ([][]byte, error) {
-	delegatorAccount, err := dlp.getAccount(delegationSC)
-	if err != nil {
-		return nil, fmt.Errorf("%w for delegationSC %s", err, hex.EncodeToString(delegationSC))
-	}
-
-	chLeaves := &common.TrieIteratorChannels{
-		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
-		ErrChan:    errChan.NewErrChanWrapper(),
-	}
-	err = delegatorAccount.GetAllLeaves(chLeaves, ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	delegators := make([][]byte, 0)
-	for leaf := range chLeaves.LeavesChan {
-		leafKey := leaf.Key()
-		if len(leafKey) != dlp.publicKeyConverter.Len() {
-			continue
-		}
-
-		delegators = append(delegators, leafKey)
-	}
-
-	err = chLeaves.ErrChan.ReadFromChanNonBlocking()
-	if err != nil {
-		return nil, err
-	}
-
-	if common.IsContextDone(ctx) {
-		return nil, ErrTrieOperationsTimeout
-	}
-
-	return delegators, nil
-}
-
-func (dlp *delegatedListProcessor) getActiveFund(delegationSC []byte, delegator []byte) (*big.Int, error) {
-	scQuery := &process.SCQuery{
-		ScAddress:  delegationSC,
-		FuncName:   "getUserActiveStake",
-		CallerAddr: delegationSC,
-		CallValue:  big.NewInt(0),
-		Arguments:  [][]byte{delegator},
-	}
-
-	vmOutput, _, err := dlp.queryService.ExecuteQuery(scQuery)
-	if err != nil {
-		return nil, err
-	}
-	if vmOutput.ReturnCode != vmcommon.Ok {
-		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
-	}
-
-	if len(vmOutput.ReturnData) != 1 {
-		return nil, fmt.Errorf("%w, getActiveFund function should have returned one value", epochStart.ErrExecutingSystemScCode)
-	}
-
-	value := big.NewInt(0).SetBytes(vmOutput.ReturnData[0])
-
-	return value, nil
-}
-
 func (dlp *delegatedListProcessor) mapToSlice(mapDelegators map[string]*api.Delegator) []*api.Delegator {
 	keys := make([]string, 0, len(mapDelegators))
 	for key := range mapDelegators {
@@ -0,0 +1,277 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart"
+)
+
+const (
+	numContractConfigValues   = 10
+	maxDelegatorsPageSize     = 1000
+	stakedNodesStateMarker    = "staked"
+	notStakedNodesStateMarker = "notStaked"
+	unStakedNodesStateMarker  = "unStaked"
+)
+
+// ArgDelegationContractProcessor represents the arguments DTO used in the delegation contract processor constructor
+type ArgDelegationContractProcessor struct {
+	ArgTrieIteratorProcessor
+	ValidatorPubKeyConverter core.PubkeyConverter
+}
+
+type delegationContractProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter       core.PubkeyConverter
+	validatorPubKeyConverter core.PubkeyConverter
+}
+
+// NewDelegationContractProcessor will create a new instance of delegationContractProcessor, able to decode the views
+// of a delegation contract and to iterate over its delegators
+func NewDelegationContractProcessor(arg ArgDelegationContractProcessor) (*delegationContractProcessor, error) {
+	err := checkArguments(arg.ArgTrieIteratorProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.ValidatorPubKeyConverter) {
+		return nil, fmt.Errorf("%w for validator public keys", ErrNilPubkeyConverter)
+	}
+
+	return &delegationContractProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter:       arg.PublicKeyConverter,
+		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+	}, nil
+}
+
+// GetContractConfig returns the configuration of the provided delegation contract
+func (dcp *delegationContractProcessor) GetContractConfig(contract string) (*common.DelegationContractConfigAPIResponse, error) {
+	contractAddress, err := dcp.decodeAddress(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	returnData, err := dcp.executeDelegationQuery(contractAddress, "getContractConfig")
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != numContractConfigValues {
+		return nil, fmt.Errorf("%w, getContractConfig function should have returned %d values", epochStart.ErrExecutingSystemScCode, numContractConfigValues)
+	}
+
+	totalActiveStake, err := dcp.executeSingleValueQuery(contractAddress, "getTotalActiveStake")
+	if err != nil {
+		return nil, err
+	}
+
+	numDelegators, err := dcp.executeSingleValueQuery(contractAddress, "getNumUsers")
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.DelegationContractConfigAPIResponse{
+		Owner:                       dcp.publicKeyConverter.SilentEncode(returnData[0], log),
+		ServiceFee:                  big.NewInt(0).SetBytes(returnData[1]).Uint64(),
+		MaxDelegationCap:            big.NewInt(0).SetBytes(returnData[2]).String(),
+		InitialOwnerFunds:           big.NewInt(0).SetBytes(returnData[3]).String(),
+		AutomaticActivation:         isTrue(returnData[4]),
+		WithDelegationCap:           isTrue(returnData[5]),
+		ChangeableServiceFee:        isTrue(returnData[6]),
+		CheckCapOnReDelegateRewards: isTrue(returnData[7]),
+		CreatedNonce:                big.NewInt(0).SetBytes(returnData[8]).Uint64(),
+		UnBondPeriodInEpochs:        big.NewInt(0).SetBytes(returnData[9]).Uint64(),
+		TotalActiveStake:            totalActiveStake.String(),
+		NumDelegators:               numDelegators.Uint64(),
+	}, nil
+}
+
+// GetNodeStates returns the BLS keys of the provided delegation contract, grouped by their state
+func (dcp *delegationContractProcessor) GetNodeStates(contract string) (*common.DelegationNodeStatesAPIResponse, error) {
+	contractAddress, err := dcp.decodeAddress(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	returnData, err := dcp.executeDelegationQuery(contractAddress, "getAllNodeStates")
+	if err != nil {
+		return nil, err
+	}
+
+	nodeStates := &common.DelegationNodeStatesAPIResponse{
+		Staked:    make([]string, 0),
+		NotStaked: make([]string, 0),
+		UnStaked:  make([]string, 0),
+	}
+
+	// the keys are returned grouped by their state, each group being preceded by the state marker
+	var currentList *[]string
+	for _, value := range returnData {
+		switch string(value) {
+		case stakedNodesStateMarker:
+			currentList = &nodeStates.Staked
+			continue
+		case notStakedNodesStateMarker:
+			currentList = &nodeStates.NotStaked
+			continue
+		case unStakedNodesStateMarker:
+			currentList = &nodeStates.UnStaked
+			continue
+		}
+
+		if currentList == nil {
+			return nil, fmt.Errorf("%w, getAllNodeStates function returned a BLS key without a state", epochStart.ErrExecutingSystemScCode)
+		}
+		*currentList = append(*currentList, dcp.validatorPubKeyConverter.SilentEncode(value, log))
+	}
+
+	return nodeStates, nil
+}
+
+// GetDelegator returns the funds of the provided delegator in the provided delegation contract
+func (dcp *delegationContractProcessor) GetDelegator(contract string, delegator string) (*common.DelegatorAPIResponse, error) {
+	contractAddress, err := dcp.decodeAddress(contract)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := dcp.decodeAddress(delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	funds, err := dcp.getDelegatorFunds(contractAddress, delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	unDelegatedList := make([]*common.DelegationUnDelegatedFundAPIResponse, 0, len(funds.unDelegatedList))
+	for _, unDelegatedFund := range funds.unDelegatedList {
+		unDelegatedList = append(unDelegatedList, &common.DelegationUnDelegatedFundAPIResponse{
+			Value:           unDelegatedFund.value.String(),
+			RemainingEpochs: unDelegatedFund.remainingEpochs,
+		})
+	}
+
+	return &common.DelegatorAPIResponse{
+		Address:          delegator,
+		ActiveStake:      funds.activeStake.String(),
+		ClaimableRewards: funds.claimableRewards.String(),
+		UnStaked:         funds.unStaked.String(),
+		UnBondable:       funds.unBondable.String(),
+		UnDelegatedList:  unDelegatedList,
+	}, nil
+}
+
+// GetDelegators returns a page of the delegators list of the provided delegation contract, ordered by their addresses.
+// The address sized keys of the contract's data trie which do not belong to delegators are filtered out before
+// paginating, so the number of delegators and the pages only account for the real delegators
+func (dcp *delegationContractProcessor) GetDelegators(ctx context.Context, contract string, page uint32, pageSize uint32) (*common.DelegatorsListAPIResponse, error) {
+	if pageSize == 0 || pageSize > maxDelegatorsPageSize {
+		return nil, fmt.Errorf("%w, provided: %d, maximum allowed: %d", ErrInvalidPageSize, pageSize, maxDelegatorsPageSize)
+	}
+
+	contractAddress, err := dcp.decodeAddress(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	dcp.accounts.Lock()
+	defer dcp.accounts.Unlock()
+
+	delegators, err := dcp.getDelegatorsStake(ctx, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.DelegatorsListAPIResponse{
+		Delegators:    make([]*common.DelegatorStakeAPIResponse, 0),
+		NumDelegators: uint64(len(delegators)),
+		Page:          page,
+		PageSize:      pageSize,
+	}
+
+	startIndex := uint64(page) * uint64(pageSize)
+	if startIndex >= uint64(len(delegators)) {
+		return response, nil
+	}
+	endIndex := startIndex + uint64(pageSize)
+	if endIndex > uint64(len(delegators)) {
+		endIndex = uint64(len(delegators))
+	}
+
+	response.Delegators = append(response.Delegators, delegators[startIndex:endIndex]...)
+
+	return response, nil
+}
+
+// getDelegatorsStake returns the active stake of all the delegators of the provided contract, ordered by their addresses
+func (dcp *delegationContractProcessor) getDelegatorsStake(ctx context.Context, contractAddress []byte) ([]*common.DelegatorStakeAPIResponse, error) {
+	delegatorsList, err := dcp.getDelegatorsList(contractAddress, dcp.publicKeyConverter.Len(), ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(delegatorsList, func(i, j int) bool {
+		return bytes.Compare(delegatorsList[i], delegatorsList[j]) < 0
+	})
+
+	delegators := make([]*common.DelegatorStakeAPIResponse, 0, len(delegatorsList))
+	for _, delegatorAddress := range delegatorsList {
+		if common.IsContextDone(ctx) {
+			return nil, ErrTrieOperationsTimeout
+		}
+
+		value, errGet := dcp.getActiveFund(contractAddress, delegatorAddress)
+		if errGet != nil {
+			// delegatorAddress byte slice might not represent a real delegator address
+			continue
+		}
+
+		delegators = append(delegators, &common.DelegatorStakeAPIResponse{
+			Address:     dcp.publicKeyConverter.SilentEncode(delegatorAddress, log),
+			ActiveStake: value.String(),
+		})
+	}
+
+	return delegators, nil
+}
+
+func (dcp *delegationContractProcessor) decodeAddress(address string) ([]byte, error) {
+	addressBytes, err := dcp.publicKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s", err, address)
+	}
+
+	return addressBytes, nil
+}
+
+func (dcp *delegationContractProcessor) executeSingleValueQuery(contractAddress []byte, funcName string) (*big.Int, error) {
+	returnData, err := dcp.executeDelegationQuery(contractAddress, funcName)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != 1 {
+		return nil, fmt.Errorf("%w, %s function should have returned one value", epochStart.ErrExecutingSystemScCode, funcName)
+	}
+
+	return big.NewInt(0).SetBytes(returnData[0]), nil
+}
+
+// isTrue decodes the boolean values, returned by the delegation contract as strings
+func isTrue(value []byte) bool {
+	return string(value) == "true"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dcp *delegationContractProcessor) IsInterfaceNil() bool {
+	return dcp == nil
+}
@@ -0,0 +1,381 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+var delegationContract = []byte("delegationSc")
+
+func createMockDelegationContractArgs() ArgDelegationContractProcessor {
+	arg := createMockArgs()
+	arg.PublicKeyConverter = testscommon.NewPubkeyConverterMock(len(delegationContract))
+
+	return ArgDelegationContractProcessor{
+		ArgTrieIteratorProcessor: arg,
+		ValidatorPubKeyConverter: testscommon.NewPubkeyConverterMock(96),
+	}
+}
+
+func createDelegationQueryServiceStub(t *testing.T, results map[string][][]byte) *mock.SCQueryServiceStub {
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+			require.Equal(t, query.ScAddress, query.CallerAddr)
+			require.Equal(t, big.NewInt(0), query.CallValue)
+
+			key := query.FuncName
+			for _, arg := range query.Arguments {
+				key += "@" + string(arg)
+			}
+			returnData, found := results[key]
+			if !found {
+				return &vmcommon.VMOutput{
+					ReturnCode:    vmcommon.UserError,
+					ReturnMessage: "view function works only for existing delegators",
+				}, nil, nil
+			}
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: returnData,
+			}, nil, nil
+		},
+	}
+}
+
+func TestNewDelegationContractProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegationContractArgs()
+		arg.Accounts = nil
+		dcp, err := NewDelegationContractProcessor(arg)
+		require.Equal(t, ErrNilAccountsAdapter, err)
+		require.Nil(t, dcp)
+	})
+	t.Run("nil validator public key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegationContractArgs()
+		arg.ValidatorPubKeyConverter = nil
+		dcp, err := NewDelegationContractProcessor(arg)
+		require.True(t, errors.Is(err, ErrNilPubkeyConverter))
+		require.Nil(t, dcp)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dcp, err := NewDelegationContractProcessor(createMockDelegationContractArgs())
+		require.Nil(t, err)
+		require.NotNil(t, dcp)
+	})
+}
+
+func TestDelegationContractProcessor_GetContractConfig(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("ownerAddress")
+	contractConfig := [][]byte{
+		owner,
+		big.NewInt(1000).Bytes(),
+		big.NewInt(5000).Bytes(),
+		big.NewInt(1250).Bytes(),
+		[]byte("true"),
+		[]byte("true"),
+		[]byte("false"),
+		[]byte("true"),
+		big.NewInt(37).Bytes(),
+		big.NewInt(10).Bytes(),
+	}
+
+	t.Run("invalid contract address should error", func(t *testing.T) {
+		t.Parallel()
+
+		dcp, _ := NewDelegationContractProcessor(createMockDelegationContractArgs())
+		config, err := dcp.GetContractConfig("invalid")
+		require.Nil(t, config)
+		require.Error(t, err)
+	})
+	t.Run("query fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegationContractArgs()
+		arg.QueryService = createDelegationQueryServiceStub(t, map[string][][]byte{})
+		dcp, _ := NewDelegationContractProcessor(arg)
+
+		config, err := dcp.GetContractConfig(hex.EncodeToString(delegationContract))
+		require.Nil(t, config)
+		require.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+	})
+	t.Run("invalid number of values should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegationContractArgs()
+		arg.QueryService = createDelegationQueryServiceStub(t, map[string][][]byte{
+			"getContractConfig": contractConfig[1:],
+		})
+		dcp, _ := NewDelegationContractProcessor(arg)
+
+		config, err := dcp.GetContractConfig(hex.EncodeToString(delegationContract))
+		require.Nil(t, config)
+		require.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegationContractArgs()
+		arg.QueryService = createDelegationQueryServiceStub(t, map[string][][]byte{
+			"getContractConfig":   contractConfig,
+			"getTotalActiveStake": {big.NewInt(4000).Bytes()},
+			"getNumUsers":         {big.NewInt(3).Bytes()},
+		})
+		dcp, _ := NewDelegationContractProcessor(arg)
+
+		config, err := dcp.GetContractConfig(hex.EncodeToString(delegationContract))
+		require.Nil(t, err)
+		require.Equal(t, &common.DelegationContractConfigAPIResponse{
+			Owner:                       hex.EncodeToString(owner),
+			ServiceFee:                  1000,
+			MaxDelegationCap:            "5000",
+			InitialOwnerFunds:           "1250",
+			AutomaticActivation:         true,
+			WithDelegationCap:           true,
+			ChangeableServiceFee:        false,
+			CheckCapOnReDelegateRewards: true,
+			CreatedNonce:                37,
+			UnBondPeriodInEpochs:        10,
+			TotalActiveStake:            "4000",
+			NumDelegators:               3,
+		}, config)
+	})
+}
+
+func TestDelegationContractProcessor_GetNodeStates(t *testing.T) {
+	t.Parallel()
+
+	t.Run("key without state should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegationContractArgs()
+		arg.QueryService = createDelegationQueryServiceStub(t, map[string][][]byte{
+			"getAllNodeStates": {[]byte("bls1")},
+		})
+		dcp, _ := NewDelegationContractProcessor(arg)
+
+		nodeStates, err := dcp.GetNodeStates(hex.EncodeToString(delegationContract))
+		require.Nil(t, nodeStates)
+		require.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+	})
+	t.Run("no nodes should return empty lists", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegationContractArgs()
+		arg.QueryService = createDelegationQueryServiceStub(t, map[string][][]byte{
+			"getAllNodeStates": {},
+		})
+		dcp, _ := NewDelegationContractProcessor(arg)
+
+		nodeStates, err := dcp.GetNodeStates(hex.EncodeToString(delegationContract))
+		require.Nil(t, err)
+		require.Equal(t, &common.DelegationNodeStatesAPIResponse{
+			Staked:    []string{},
+			NotStaked: []string{},
+			UnStaked:  []string{},
+		}, nodeStates)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegationContractArgs()
+		arg.QueryService = createDelegationQueryServiceStub(t, map[string][][]byte{
+			"getAllNodeStates": {
+				[]byte("staked"), []byte("bls1"), []byte("bls2"),
+				[]byte("notStaked"), []byte("bls3"),
+				[]byte("unStaked"), []byte("bls4"),
+			},
+		})
+		dcp, _ := NewDelegationContractProcessor(arg)
+
+		nodeStates, err := dcp.GetNodeStates(hex.EncodeToString(delegationContract))
+		require.Nil(t, err)
+		require.Equal(t, &common.DelegationNodeStatesAPIResponse{
+			Staked:    []string{hex.EncodeToString([]byte("bls1")), hex.EncodeToString([]byte("bls2"))},
+			NotStaked: []string{hex.EncodeToString([]byte("bls3"))},
+			UnStaked:  []string{hex.EncodeToString([]byte("bls4"))},
+		}, nodeStates)
+	})
+}
+
+func TestDelegationContractProcessor_GetDelegator(t *testing.T) {
+	t.Parallel()
+
+	delegator := []byte("delegator001")
+	encodedDelegator := hex.EncodeToString(delegator)
+	fundsData := [][]byte{big.NewInt(100).Bytes(), big.NewInt(7).Bytes(), big.NewInt(30).Bytes(), big.NewInt(10).Bytes()}
+
+	t.Run("not a delegator should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegationContractArgs()
+		arg.QueryService = createDelegationQueryServiceStub(t, map[string][][]byte{})
+		dcp, _ := NewDelegationContractProcessor(arg)
+
+		delegatorInfo, err := dcp.GetDelegator(hex.EncodeToString(delegationContract), encodedDelegator)
+		require.Nil(t, delegatorInfo)
+		require.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+		require.Contains(t, err.Error(), "view function works only for existing delegators")
+	})
+	t.Run("odd number of undelegated values should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegationContractArgs()
+		arg.QueryService = createDelegationQueryServiceStub(t, map[string][][]byte{
+			"getDelegatorFundsData@" + string(delegator):  fundsData,
+			"getUserUnDelegatedList@" + string(delegator): {big.NewInt(20).Bytes()},
+		})
+		dcp, _ := NewDelegationContractProcessor(arg)
+
+		delegatorInfo, err := dcp.GetDelegator(hex.EncodeToString(delegationContract), encodedDelegator)
+		require.Nil(t, delegatorInfo)
+		require.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegationContractArgs()
+		arg.QueryService = createDelegationQueryServiceStub(t, map[string][][]byte{
+			"getDelegatorFundsData@" + string(delegator): fundsData,
+			"getUserUnDelegatedList@" + string(delegator): {
+				big.NewInt(20).Bytes(), big.NewInt(3).Bytes(),
+				big.NewInt(10).Bytes(), big.NewInt(0).Bytes(),
+			},
+		})
+		dcp, _ := NewDelegationContractProcessor(arg)
+
+		delegatorInfo, err := dcp.GetDelegator(hex.EncodeToString(delegationContract), encodedDelegator)
+		require.Nil(t, err)
+		require.Equal(t, &common.DelegatorAPIResponse{
+			Address:          encodedDelegator,
+			ActiveStake:      "100",
+			ClaimableRewards: "7",
+			UnStaked:         "30",
+			UnBondable:       "10",
+			UnDelegatedList: []*common.DelegationUnDelegatedFundAPIResponse{
+				{Value: "20", RemainingEpochs: 3},
+				{Value: "10", RemainingEpochs: 0},
+			},
+		}, delegatorInfo)
+	})
+}
+
+func TestDelegationContractProcessor_GetDelegators(t *testing.T) {
+	t.Parallel()
+
+	delegators := [][]byte{[]byte("delegator003"), []byte("delegator001"), []byte("aNotDelegate"), []byte("delegator002"), []byte("short")}
+	createArgs := func(timeSleep time.Duration) ArgDelegationContractProcessor {
+		arg := createMockDelegationContractArgs()
+		arg.QueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+				require.Equal(t, "getUserActiveStake", query.FuncName)
+				if bytes.Equal(query.Arguments[0], []byte("aNotDelegate")) {
+					return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil, nil
+				}
+
+				value := big.NewInt(0).SetBytes(query.Arguments[0][len(query.Arguments[0])-1:])
+				return &vmcommon.VMOutput{
+					ReturnData: [][]byte{value.Bytes()},
+				}, nil, nil
+			},
+		}
+		arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+				require.Equal(t, delegationContract, addressContainer)
+				return createScAccount(addressContainer, delegators, addressContainer, timeSleep), nil
+			},
+		}
+
+		return arg
+	}
+
+	t.Run("invalid page size should error", func(t *testing.T) {
+		t.Parallel()
+
+		dcp, _ := NewDelegationContractProcessor(createArgs(0))
+
+		list, err := dcp.GetDelegators(context.Background(), hex.EncodeToString(delegationContract), 0, 0)
+		require.Nil(t, list)
+		require.True(t, errors.Is(err, ErrInvalidPageSize))
+
+		list, err = dcp.GetDelegators(context.Background(), hex.EncodeToString(delegationContract), 0, maxDelegatorsPageSize+1)
+		require.Nil(t, list)
+		require.True(t, errors.Is(err, ErrInvalidPageSize))
+	})
+	t.Run("context timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		dcp, _ := NewDelegationContractProcessor(createArgs(time.Second))
+
+		ctxWithTimeout, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+
+		list, err := dcp.GetDelegators(ctxWithTimeout, hex.EncodeToString(delegationContract), 0, 10)
+		require.Nil(t, list)
+		require.Equal(t, ErrTrieOperationsTimeout, err)
+	})
+	t.Run("should return the requested pages", func(t *testing.T) {
+		t.Parallel()
+
+		dcp, _ := NewDelegationContractProcessor(createArgs(0))
+		contract := hex.EncodeToString(delegationContract)
+
+		list, err := dcp.GetDelegators(context.Background(), contract, 0, 2)
+		require.Nil(t, err)
+		require.Equal(t, &common.DelegatorsListAPIResponse{
+			Delegators: []*common.DelegatorStakeAPIResponse{
+				{Address: hex.EncodeToString([]byte("delegator001")), ActiveStake: fmt.Sprintf("%d", '1')},
+				{Address: hex.EncodeToString([]byte("delegator002")), ActiveStake: fmt.Sprintf("%d", '2')},
+			},
+			NumDelegators: 3,
+			Page:          0,
+			PageSize:      2,
+		}, list)
+
+		list, err = dcp.GetDelegators(context.Background(), contract, 1, 2)
+		require.Nil(t, err)
+		require.Equal(t, []*common.DelegatorStakeAPIResponse{
+			{Address: hex.EncodeToString([]byte("delegator003")), ActiveStake: fmt.Sprintf("%d", '3')},
+		}, list.Delegators)
+
+		list, err = dcp.GetDelegators(context.Background(), contract, 2, 2)
+		require.Nil(t, err)
+		require.Empty(t, list.Delegators)
+		require.Equal(t, uint64(3), list.NumDelegators)
+	})
+}
+
+func TestDelegationContractProcessor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var dcp *delegationContractProcessor
+	require.True(t, dcp.IsInterfaceNil())
+
+	dcp, _ = NewDelegationContractProcessor(createMockDelegationContractArgs())
+	require.False(t, dcp.IsInterfaceNil())
+}
@@ -0,0 +1,42 @@
+package disabled
+
+import (
+	"context"
+	"errors"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+var errCannotReturnDelegationContractDataFromShardNode = errors.New("delegation contract data cannot be returned by a shard node")
+
+type delegationContractProcessor struct{}
+
+// NewDisabledDelegationContractProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledDelegationContractProcessor() *delegationContractProcessor {
+	return &delegationContractProcessor{}
+}
+
+// GetContractConfig returns the errCannotReturnDelegationContractDataFromShardNode error
+func (dcp *delegationContractProcessor) GetContractConfig(_ string) (*common.DelegationContractConfigAPIResponse, error) {
+	return nil, errCannotReturnDelegationContractDataFromShardNode
+}
+
+// GetNodeStates returns the errCannotReturnDelegationContractDataFromShardNode error
+func (dcp *delegationContractProcessor) GetNodeStates(_ string) (*common.DelegationNodeStatesAPIResponse, error) {
+	return nil, errCannotReturnDelegationContractDataFromShardNode
+}
+
+// GetDelegator returns the errCannotReturnDelegationContractDataFromShardNode error
+func (dcp *delegationContractProcessor) GetDelegator(_ string, _ string) (*common.DelegatorAPIResponse, error) {
+	return nil, errCannotReturnDelegationContractDataFromShardNode
+}
+
+// GetDelegators returns the errCannotReturnDelegationContractDataFromShardNode error
+func (dcp *delegationContractProcessor) GetDelegators(_ context.Context, _ string, _ uint32, _ uint32) (*common.DelegatorsListAPIResponse, error) {
+	return nil, errCannotReturnDelegationContractDataFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dcp *delegationContractProcessor) IsInterfaceNil() bool {
+	return dcp == nil
+}
@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"context"
+	"errors"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+var errCannotReturnStakingInfoFromShardNode = errors.New("staking info cannot be returned by a shard node")
+
+type stakingInfoProcessor struct{}
+
+// NewDisabledStakingInfoProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledStakingInfoProcessor() *stakingInfoProcessor {
+	return &stakingInfoProcessor{}
+}
+
+// GetOwnerStakingInfo returns the errCannotReturnStakingInfoFromShardNode error
+func (sip *stakingInfoProcessor) GetOwnerStakingInfo(_ context.Context, _ string) (*common.OwnerStakingInfoAPIResponse, error) {
+	return nil, errCannotReturnStakingInfoFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sip *stakingInfoProcessor) IsInterfaceNil() bool {
+	return sip == nil
+}
@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/node/trieIterators"
+	"github.com/multiversx/mx-chain-go/node/trieIterators/disabled"
+)
+
+// CreateDelegationContractHandler will create a new instance of DelegationContractHandler
+func CreateDelegationContractHandler(args trieIterators.ArgDelegationContractProcessor) (external.DelegationContractHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return disabled.NewDisabledDelegationContractProcessor(), nil
+	}
+
+	return trieIterators.NewDelegationContractProcessor(args)
+}
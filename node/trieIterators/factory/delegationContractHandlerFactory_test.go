@@ -0,0 +1,50 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/node/trieIterators"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDelegationContractHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgDelegationContractProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: 0,
+		},
+	}
+
+	delegationContractHandler, err := CreateDelegationContractHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.delegationContractProcessor", fmt.Sprintf("%T", delegationContractHandler))
+}
+
+func TestCreateDelegationContractHandler_DelegationContractProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgDelegationContractProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: core.MetachainShardId,
+			Accounts: &trieIterators.AccountsWrapper{
+				Mutex:           &sync.Mutex{},
+				AccountsAdapter: &stateMock.AccountsStub{},
+			},
+			PublicKeyConverter: &testscommon.PubkeyConverterMock{},
+			QueryService:       &mock.SCQueryServiceStub{},
+		},
+		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
+	}
+
+	delegationContractHandler, err := CreateDelegationContractHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.delegationContractProcessor", fmt.Sprintf("%T", delegationContractHandler))
+}
@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/node/trieIterators"
+	"github.com/multiversx/mx-chain-go/node/trieIterators/disabled"
+)
+
+// CreateStakingInfoHandler will create a new instance of StakingInfoHandler
+func CreateStakingInfoHandler(args trieIterators.ArgStakingInfoProcessor) (external.StakingInfoHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return disabled.NewDisabledStakingInfoProcessor(), nil
+	}
+
+	return trieIterators.NewStakingInfoProcessor(args)
+}
@@ -0,0 +1,57 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/node/trieIterators"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
+	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStakingInfoHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgStakingInfoProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: 0,
+		},
+	}
+
+	stakingInfoHandler, err := CreateStakingInfoHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.stakingInfoProcessor", fmt.Sprintf("%T", stakingInfoHandler))
+}
+
+func TestCreateStakingInfoHandler_StakingInfoProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgStakingInfoProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: core.MetachainShardId,
+			Accounts: &trieIterators.AccountsWrapper{
+				Mutex:           &sync.Mutex{},
+				AccountsAdapter: &stateMock.AccountsStub{},
+			},
+			PublicKeyConverter: &testscommon.PubkeyConverterMock{},
+			QueryService:       &mock.SCQueryServiceStub{},
+		},
+		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
+		NodesCoordinator:         &shardingMocks.NodesCoordinatorStub{},
+		EpochNotifier:            &epochNotifier.EpochNotifierStub{},
+		EnableEpochsHandler:      enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.StakingV4StartedFlag),
+	}
+
+	stakingInfoHandler, err := CreateStakingInfoHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.stakingInfoProcessor", fmt.Sprintf("%T", stakingInfoHandler))
+}
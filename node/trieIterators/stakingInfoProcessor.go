@@ -0,0 +1,343 @@
+package trieIterators
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const (
+	numValidatorStakingValues = 3
+	jailedKeyStatus           = "jailed"
+	stakedKeyStatus           = "staked"
+	unStakedKeyStatus         = "unStaked"
+	noBlsKeysReturnMessage    = "no bls keys"
+)
+
+// ArgStakingInfoProcessor represents the arguments DTO used in the staking info processor constructor
+type ArgStakingInfoProcessor struct {
+	ArgTrieIteratorProcessor
+	ValidatorPubKeyConverter core.PubkeyConverter
+	NodesCoordinator         nodesCoordinator.NodesCoordinator
+	EpochNotifier            process.EpochNotifier
+	EnableEpochsHandler      common.EnableEpochsHandler
+	UnBondPeriodInEpochs     uint32
+	UnBondPeriodInRounds     uint64
+}
+
+type stakingInfoProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter       core.PubkeyConverter
+	validatorPubKeyConverter core.PubkeyConverter
+	nodesCoordinator         nodesCoordinator.NodesCoordinator
+	epochNotifier            process.EpochNotifier
+	enableEpochsHandler      common.EnableEpochsHandler
+	unBondPeriodInEpochs     uint32
+	unBondPeriodInRounds     uint64
+}
+
+// NewStakingInfoProcessor will create a new instance of stakingInfoProcessor, able to aggregate the staking data,
+// the nodes status and the delegation positions of an owner
+func NewStakingInfoProcessor(arg ArgStakingInfoProcessor) (*stakingInfoProcessor, error) {
+	err := checkArguments(arg.ArgTrieIteratorProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.ValidatorPubKeyConverter) {
+		return nil, fmt.Errorf("%w for validator public keys", ErrNilPubkeyConverter)
+	}
+	if check.IfNil(arg.NodesCoordinator) {
+		return nil, ErrNilNodesCoordinator
+	}
+	if check.IfNil(arg.EpochNotifier) {
+		return nil, ErrNilEpochNotifier
+	}
+	if check.IfNil(arg.EnableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+	err = core.CheckHandlerCompatibility(arg.EnableEpochsHandler, []core.EnableEpochFlag{
+		common.StakingV4StartedFlag,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &stakingInfoProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter:       arg.PublicKeyConverter,
+		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+		nodesCoordinator:         arg.NodesCoordinator,
+		epochNotifier:            arg.EpochNotifier,
+		enableEpochsHandler:      arg.EnableEpochsHandler,
+		unBondPeriodInEpochs:     arg.UnBondPeriodInEpochs,
+		unBondPeriodInRounds:     arg.UnBondPeriodInRounds,
+	}, nil
+}
+
+// GetOwnerStakingInfo returns the staked and unstaked values of the provided owner, the status of its nodes and its
+// delegation positions, along with the epoch starting with which each unstaked amount can be withdrawn
+func (sip *stakingInfoProcessor) GetOwnerStakingInfo(ctx context.Context, owner string) (*common.OwnerStakingInfoAPIResponse, error) {
+	sip.accounts.Lock()
+	defer sip.accounts.Unlock()
+
+	ownerAddress, err := sip.publicKeyConverter.Decode(owner)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s", err, owner)
+	}
+
+	currentEpoch := sip.epochNotifier.CurrentEpoch()
+	response := &common.OwnerStakingInfoAPIResponse{
+		Owner:                owner,
+		CurrentEpoch:         currentEpoch,
+		UnBondPeriodInEpochs: sip.unBondPeriodInEpochs,
+		UnBondPeriodInRounds: sip.unBondPeriodInRounds,
+		TotalStaked:          "0",
+		TopUp:                "0",
+		TotalUnStaked:        "0",
+		TotalWithdrawable:    "0",
+		Nodes:                make([]*common.StakedNodeAPIResponse, 0),
+		UnStakedList:         make([]*common.UnBondingFundAPIResponse, 0),
+		TotalDelegated:       "0",
+		Delegations:          make([]*common.DelegationPositionAPIResponse, 0),
+	}
+
+	err = sip.addValidatorData(response, ownerAddress, currentEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sip.addDelegationPositions(ctx, response, ownerAddress, currentEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (sip *stakingInfoProcessor) addValidatorData(response *common.OwnerStakingInfoAPIResponse, ownerAddress []byte, currentEpoch uint32) error {
+	funcName := "getTotalStakedTopUpStakedBlsKeys"
+	vmOutput, err := sip.queryVM(vm.ValidatorSCAddress, vm.ValidatorSCAddress, funcName, ownerAddress)
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode == vmcommon.UserError {
+		// the owner is not registered in the validator system smart contract, it can only have delegation positions
+		return nil
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%w, function: %s, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, funcName, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+	if len(vmOutput.ReturnData) < numValidatorStakingValues {
+		return fmt.Errorf("%w, %s function should have at least %d values", epochStart.ErrExecutingSystemScCode, funcName, numValidatorStakingValues)
+	}
+
+	response.TopUp = big.NewInt(0).SetBytes(vmOutput.ReturnData[0]).String()
+	response.TotalStaked = big.NewInt(0).SetBytes(vmOutput.ReturnData[1]).String()
+	response.NumActiveNodes = big.NewInt(0).SetBytes(vmOutput.ReturnData[2]).Uint64()
+
+	response.Nodes, err = sip.getNodes(ownerAddress, currentEpoch)
+	if err != nil {
+		return err
+	}
+
+	unStakedData, err := sip.executeQuery(vm.ValidatorSCAddress, vm.ValidatorSCAddress, "getUnStakedTokensList", ownerAddress)
+	if err != nil {
+		return err
+	}
+	unStakedFunds, err := parseUnBondingFunds(unStakedData)
+	if err != nil {
+		return fmt.Errorf("%w for getUnStakedTokensList function", err)
+	}
+
+	totalUnStaked := big.NewInt(0)
+	totalWithdrawable := big.NewInt(0)
+	for _, unStakedFund := range unStakedFunds {
+		totalUnStaked.Add(totalUnStaked, unStakedFund.value)
+		if unStakedFund.remainingEpochs == 0 {
+			totalWithdrawable.Add(totalWithdrawable, unStakedFund.value)
+		}
+	}
+	response.UnStakedList = createUnBondingFundsResponse(unStakedFunds, currentEpoch)
+	response.TotalUnStaked = totalUnStaked.String()
+	response.TotalWithdrawable = totalWithdrawable.String()
+
+	return nil
+}
+
+func (sip *stakingInfoProcessor) getNodes(ownerAddress []byte, currentEpoch uint32) ([]*common.StakedNodeAPIResponse, error) {
+	funcName := "getBlsKeysStatus"
+	vmOutput, err := sip.queryVM(vm.ValidatorSCAddress, vm.ValidatorSCAddress, funcName, ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode == vmcommon.UserError && vmOutput.ReturnMessage == noBlsKeysReturnMessage {
+		// all the nodes of the owner were unbonded, while part of its stake is still locked
+		return make([]*common.StakedNodeAPIResponse, 0), nil
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, function: %s, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, funcName, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+	if len(vmOutput.ReturnData)%2 != 0 {
+		return nil, fmt.Errorf("%w, %s function should have returned pairs of values", epochStart.ErrExecutingSystemScCode, funcName)
+	}
+
+	var nodesLists map[string]common.PeerType
+	nodes := make([]*common.StakedNodeAPIResponse, 0, len(vmOutput.ReturnData)/2)
+	for i := 0; i < len(vmOutput.ReturnData); i += 2 {
+		blsKey := vmOutput.ReturnData[i]
+		node := &common.StakedNodeAPIResponse{
+			BlsKey: sip.validatorPubKeyConverter.SilentEncode(blsKey, log),
+			Status: string(vmOutput.ReturnData[i+1]),
+		}
+
+		switch node.Status {
+		case jailedKeyStatus:
+			node.Status = string(common.JailedList)
+		case stakedKeyStatus:
+			if nodesLists == nil {
+				nodesLists, err = sip.getNodesLists(currentEpoch)
+				if err != nil {
+					return nil, err
+				}
+			}
+			node.Status = sip.getStakedNodeStatus(blsKey, nodesLists)
+		case unStakedKeyStatus:
+			node.RemainingUnBondRounds, err = sip.getRemainingUnBondRounds(blsKey)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
+func (sip *stakingInfoProcessor) getNodesLists(epoch uint32) (map[string]common.PeerType, error) {
+	eligible, err := sip.nodesCoordinator.GetAllEligibleValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, err
+	}
+	waiting, err := sip.nodesCoordinator.GetAllWaitingValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesLists := make(map[string]common.PeerType)
+	for _, blsKeys := range eligible {
+		for _, blsKey := range blsKeys {
+			nodesLists[string(blsKey)] = common.EligibleList
+		}
+	}
+	for _, blsKeys := range waiting {
+		for _, blsKey := range blsKeys {
+			nodesLists[string(blsKey)] = common.WaitingList
+		}
+	}
+
+	return nodesLists, nil
+}
+
+// getStakedNodeStatus refines the staked status using the nodes lists of the current epoch. A staked node which is
+// neither eligible nor waiting is in the auction list after staking v4, or a new node before it
+func (sip *stakingInfoProcessor) getStakedNodeStatus(blsKey []byte, nodesLists map[string]common.PeerType) string {
+	peerType, found := nodesLists[string(blsKey)]
+	if found {
+		return string(peerType)
+	}
+	if sip.enableEpochsHandler.IsFlagEnabled(common.StakingV4StartedFlag) {
+		return string(common.AuctionList)
+	}
+
+	return string(common.NewList)
+}
+
+func (sip *stakingInfoProcessor) getRemainingUnBondRounds(blsKey []byte) (uint64, error) {
+	returnData, err := sip.executeQuery(vm.StakingSCAddress, vm.ValidatorSCAddress, "getRemainingUnBondPeriod", blsKey)
+	if err != nil {
+		return 0, err
+	}
+	if len(returnData) != 1 {
+		return 0, fmt.Errorf("%w, getRemainingUnBondPeriod function should have returned one value", epochStart.ErrExecutingSystemScCode)
+	}
+
+	return big.NewInt(0).SetBytes(returnData[0]).Uint64(), nil
+}
+
+func (sip *stakingInfoProcessor) addDelegationPositions(
+	ctx context.Context,
+	response *common.OwnerStakingInfoAPIResponse,
+	ownerAddress []byte,
+	currentEpoch uint32,
+) error {
+	delegationScAddresses, err := sip.getAllDelegationContractAddresses()
+	if err != nil {
+		return err
+	}
+
+	totalDelegated := big.NewInt(0)
+	for _, delegationSC := range delegationScAddresses {
+		if common.IsContextDone(ctx) {
+			return ErrStakingInfoOperationsTimeout
+		}
+
+		isDelegator, errCheck := sip.isDelegator(delegationSC, ownerAddress)
+		if errCheck != nil {
+			return errCheck
+		}
+		if !isDelegator {
+			continue
+		}
+
+		funds, errGet := sip.getDelegatorFunds(delegationSC, ownerAddress)
+		if errGet != nil {
+			return fmt.Errorf("%w for delegationSC %s", errGet, hex.EncodeToString(delegationSC))
+		}
+
+		totalDelegated.Add(totalDelegated, funds.activeStake)
+		response.Delegations = append(response.Delegations, &common.DelegationPositionAPIResponse{
+			Contract:         sip.publicKeyConverter.SilentEncode(delegationSC, log),
+			ActiveStake:      funds.activeStake.String(),
+			ClaimableRewards: funds.claimableRewards.String(),
+			UnStaked:         funds.unStaked.String(),
+			UnBondable:       funds.unBondable.String(),
+			UnDelegatedList:  createUnBondingFundsResponse(funds.unDelegatedList, currentEpoch),
+		})
+	}
+	response.TotalDelegated = totalDelegated.String()
+
+	return nil
+}
+
+func createUnBondingFundsResponse(funds []*unBondingFund, currentEpoch uint32) []*common.UnBondingFundAPIResponse {
+	response := make([]*common.UnBondingFundAPIResponse, 0, len(funds))
+	for _, fund := range funds {
+		response = append(response, &common.UnBondingFundAPIResponse{
+			Value:           fund.value.String(),
+			RemainingEpochs: fund.remainingEpochs,
+			WithdrawEpoch:   currentEpoch + uint32(fund.remainingEpochs),
+			Withdrawable:    fund.remainingEpochs == 0,
+		})
+	}
+
+	return response
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sip *stakingInfoProcessor) IsInterfaceNil() bool {
+	return sip == nil
+}
@@ -0,0 +1,443 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
+	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	stakingOwner        = []byte("stakingOwner")
+	delegationContract1 = []byte("delegation01")
+	delegationContract2 = []byte("delegation02")
+)
+
+func createMockStakingInfoArgs() ArgStakingInfoProcessor {
+	arg := createMockArgs()
+	arg.PublicKeyConverter = testscommon.NewPubkeyConverterMock(len(stakingOwner))
+
+	arg.Accounts.AccountsAdapter = createDelegationAccountsStub(map[string][]byte{
+		string(delegationContract2): stakingOwner,
+	})
+
+	return ArgStakingInfoProcessor{
+		ArgTrieIteratorProcessor: arg,
+		ValidatorPubKeyConverter: testscommon.NewPubkeyConverterMock(96),
+		NodesCoordinator:         &shardingMocks.NodesCoordinatorStub{},
+		EpochNotifier: &epochNotifier.EpochNotifierStub{
+			CurrentEpochCalled: func() uint32 {
+				return 10
+			},
+		},
+		EnableEpochsHandler:  enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.StakingV4StartedFlag),
+		UnBondPeriodInEpochs: 10,
+		UnBondPeriodInRounds: 100,
+	}
+}
+
+// createDelegationAccountsStub provides the delegation contracts accounts, each one holding in its data trie the
+// delegator found in the delegators map
+func createDelegationAccountsStub(delegators map[string][]byte) *stateMock.AccountsStub {
+	return &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			dtt := &trieMock.DataTrieTrackerStub{
+				RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+					if bytes.Equal(delegators[string(address)], key) {
+						return []byte("delegator data"), 0, nil
+					}
+
+					return nil, 0, nil
+				},
+			}
+
+			return accounts.NewUserAccount(address, dtt, &trieMock.TrieLeafParserStub{})
+		},
+	}
+}
+
+// createStakingQueryServiceStub answers the queries found in the results map, keyed by the contract address, the
+// function name and the arguments. All the other queries end with a user error
+func createStakingQueryServiceStub(t *testing.T, results map[string][][]byte, userErrors map[string]string) *mock.SCQueryServiceStub {
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+			require.Equal(t, big.NewInt(0), query.CallValue)
+
+			key := string(query.ScAddress) + ":" + query.FuncName
+			for _, arg := range query.Arguments {
+				key += "@" + string(arg)
+			}
+			returnData, found := results[key]
+			if !found {
+				return &vmcommon.VMOutput{
+					ReturnCode:    vmcommon.UserError,
+					ReturnMessage: userErrors[key],
+				}, nil, nil
+			}
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: returnData,
+			}, nil, nil
+		},
+	}
+}
+
+func createStakingQueryResults() map[string][][]byte {
+	validatorSC := string(vm.ValidatorSCAddress) + ":"
+	stakingSC := string(vm.StakingSCAddress) + ":"
+	owner := "@" + string(stakingOwner)
+
+	return map[string][][]byte{
+		validatorSC + "getTotalStakedTopUpStakedBlsKeys" + owner: {
+			big.NewInt(1500).Bytes(), big.NewInt(9000).Bytes(), big.NewInt(3).Bytes(),
+			[]byte("eligibleKey"), []byte("waitingKey"), []byte("auctionKey"),
+		},
+		validatorSC + "getBlsKeysStatus" + owner: {
+			[]byte("eligibleKey"), []byte("staked"),
+			[]byte("waitingKey"), []byte("staked"),
+			[]byte("auctionKey"), []byte("staked"),
+			[]byte("jailedKey"), []byte("jailed"),
+			[]byte("queuedKey"), []byte("queued"),
+			[]byte("unStakedKey"), []byte("unStaked"),
+		},
+		validatorSC + "getUnStakedTokensList" + owner: {
+			big.NewInt(2500).Bytes(), big.NewInt(0).Bytes(),
+			big.NewInt(1000).Bytes(), big.NewInt(4).Bytes(),
+		},
+		stakingSC + "getRemainingUnBondPeriod@unStakedKey": {big.NewInt(40).Bytes()},
+		string(vm.DelegationManagerSCAddress) + ":getAllContractAddresses": {
+			delegationContract1, delegationContract2,
+		},
+		string(delegationContract2) + ":getDelegatorFundsData" + owner: {
+			big.NewInt(300).Bytes(), big.NewInt(7).Bytes(), big.NewInt(50).Bytes(), big.NewInt(20).Bytes(),
+		},
+		string(delegationContract2) + ":getUserUnDelegatedList" + owner: {
+			big.NewInt(30).Bytes(), big.NewInt(2).Bytes(),
+			big.NewInt(20).Bytes(), big.NewInt(0).Bytes(),
+		},
+	}
+}
+
+func createNodesCoordinatorStub() *shardingMocks.NodesCoordinatorStub {
+	return &shardingMocks.NodesCoordinatorStub{
+		GetAllEligibleValidatorsPublicKeysCalled: func(epoch uint32) (map[uint32][][]byte, error) {
+			return map[uint32][][]byte{
+				core.MetachainShardId: {[]byte("eligibleKey")},
+			}, nil
+		},
+		GetAllWaitingValidatorsPublicKeysCalled: func(epoch uint32) (map[uint32][][]byte, error) {
+			return map[uint32][][]byte{
+				0: {[]byte("waitingKey")},
+			}, nil
+		},
+	}
+}
+
+func TestNewStakingInfoProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockStakingInfoArgs()
+		arg.Accounts = nil
+		sip, err := NewStakingInfoProcessor(arg)
+		require.Equal(t, ErrNilAccountsAdapter, err)
+		require.True(t, check.IfNil(sip))
+	})
+	t.Run("nil validator public key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockStakingInfoArgs()
+		arg.ValidatorPubKeyConverter = nil
+		sip, err := NewStakingInfoProcessor(arg)
+		require.True(t, errors.Is(err, ErrNilPubkeyConverter))
+		require.True(t, check.IfNil(sip))
+	})
+	t.Run("nil nodes coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockStakingInfoArgs()
+		arg.NodesCoordinator = nil
+		sip, err := NewStakingInfoProcessor(arg)
+		require.Equal(t, ErrNilNodesCoordinator, err)
+		require.True(t, check.IfNil(sip))
+	})
+	t.Run("nil epoch notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockStakingInfoArgs()
+		arg.EpochNotifier = nil
+		sip, err := NewStakingInfoProcessor(arg)
+		require.Equal(t, ErrNilEpochNotifier, err)
+		require.True(t, check.IfNil(sip))
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockStakingInfoArgs()
+		arg.EnableEpochsHandler = nil
+		sip, err := NewStakingInfoProcessor(arg)
+		require.Equal(t, ErrNilEnableEpochsHandler, err)
+		require.True(t, check.IfNil(sip))
+	})
+	t.Run("invalid enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockStakingInfoArgs()
+		arg.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStubWithNoFlagsDefined()
+		sip, err := NewStakingInfoProcessor(arg)
+		require.True(t, errors.Is(err, core.ErrInvalidEnableEpochsHandler))
+		require.True(t, check.IfNil(sip))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sip, err := NewStakingInfoProcessor(createMockStakingInfoArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(sip))
+	})
+}
+
+func TestStakingInfoProcessor_GetOwnerStakingInfo(t *testing.T) {
+	t.Parallel()
+
+	encodedOwner := hex.EncodeToString(stakingOwner)
+
+	t.Run("invalid owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		sip, _ := NewStakingInfoProcessor(createMockStakingInfoArgs())
+		stakingInfo, err := sip.GetOwnerStakingInfo(context.Background(), "invalid")
+		require.NotNil(t, err)
+		require.Nil(t, stakingInfo)
+	})
+	t.Run("validator query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		arg := createMockStakingInfoArgs()
+		arg.QueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		sip, _ := NewStakingInfoProcessor(arg)
+
+		stakingInfo, err := sip.GetOwnerStakingInfo(context.Background(), encodedOwner)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, stakingInfo)
+	})
+	t.Run("nodes coordinator error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		arg := createMockStakingInfoArgs()
+		arg.QueryService = createStakingQueryServiceStub(t, createStakingQueryResults(), nil)
+		arg.NodesCoordinator = &shardingMocks.NodesCoordinatorStub{
+			GetAllEligibleValidatorsPublicKeysCalled: func(epoch uint32) (map[uint32][][]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		sip, _ := NewStakingInfoProcessor(arg)
+
+		stakingInfo, err := sip.GetOwnerStakingInfo(context.Background(), encodedOwner)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, stakingInfo)
+	})
+	t.Run("odd number of unstaked values should error", func(t *testing.T) {
+		t.Parallel()
+
+		results := createStakingQueryResults()
+		results[string(vm.ValidatorSCAddress)+":getUnStakedTokensList@"+string(stakingOwner)] = [][]byte{big.NewInt(2500).Bytes()}
+		arg := createMockStakingInfoArgs()
+		arg.QueryService = createStakingQueryServiceStub(t, results, nil)
+		arg.NodesCoordinator = createNodesCoordinatorStub()
+		sip, _ := NewStakingInfoProcessor(arg)
+
+		stakingInfo, err := sip.GetOwnerStakingInfo(context.Background(), encodedOwner)
+		require.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+		require.Nil(t, stakingInfo)
+	})
+	t.Run("context done should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockStakingInfoArgs()
+		arg.QueryService = createStakingQueryServiceStub(t, createStakingQueryResults(), nil)
+		arg.NodesCoordinator = createNodesCoordinatorStub()
+		sip, _ := NewStakingInfoProcessor(arg)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		stakingInfo, err := sip.GetOwnerStakingInfo(ctx, encodedOwner)
+		require.Equal(t, ErrStakingInfoOperationsTimeout, err)
+		require.Nil(t, stakingInfo)
+	})
+	t.Run("delegation contract account error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		arg := createMockStakingInfoArgs()
+		arg.QueryService = createStakingQueryServiceStub(t, createStakingQueryResults(), nil)
+		arg.NodesCoordinator = createNodesCoordinatorStub()
+		arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return nil, expectedErr
+			},
+		}
+		sip, _ := NewStakingInfoProcessor(arg)
+
+		stakingInfo, err := sip.GetOwnerStakingInfo(context.Background(), encodedOwner)
+		require.True(t, errors.Is(err, expectedErr))
+		require.Nil(t, stakingInfo)
+	})
+	t.Run("delegator funds query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		results := createStakingQueryResults()
+		delete(results, string(delegationContract2)+":getDelegatorFundsData@"+string(stakingOwner))
+		arg := createMockStakingInfoArgs()
+		arg.QueryService = createStakingQueryServiceStub(t, results, nil)
+		arg.NodesCoordinator = createNodesCoordinatorStub()
+		sip, _ := NewStakingInfoProcessor(arg)
+
+		stakingInfo, err := sip.GetOwnerStakingInfo(context.Background(), encodedOwner)
+		require.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+		require.Nil(t, stakingInfo)
+	})
+	t.Run("owner without any staking data should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockStakingInfoArgs()
+		arg.QueryService = createStakingQueryServiceStub(t, map[string][][]byte{
+			string(vm.DelegationManagerSCAddress) + ":getAllContractAddresses": {delegationContract1},
+		}, nil)
+		sip, _ := NewStakingInfoProcessor(arg)
+
+		stakingInfo, err := sip.GetOwnerStakingInfo(context.Background(), encodedOwner)
+		require.Nil(t, err)
+		require.Equal(t, &common.OwnerStakingInfoAPIResponse{
+			Owner:                encodedOwner,
+			CurrentEpoch:         10,
+			UnBondPeriodInEpochs: 10,
+			UnBondPeriodInRounds: 100,
+			TotalStaked:          "0",
+			TopUp:                "0",
+			TotalUnStaked:        "0",
+			TotalWithdrawable:    "0",
+			Nodes:                make([]*common.StakedNodeAPIResponse, 0),
+			UnStakedList:         make([]*common.UnBondingFundAPIResponse, 0),
+			TotalDelegated:       "0",
+			Delegations:          make([]*common.DelegationPositionAPIResponse, 0),
+		}, stakingInfo)
+	})
+	t.Run("owner without bls keys should work", func(t *testing.T) {
+		t.Parallel()
+
+		results := createStakingQueryResults()
+		blsKeysStatusKey := string(vm.ValidatorSCAddress) + ":getBlsKeysStatus@" + string(stakingOwner)
+		delete(results, blsKeysStatusKey)
+		arg := createMockStakingInfoArgs()
+		arg.QueryService = createStakingQueryServiceStub(t, results, map[string]string{
+			blsKeysStatusKey: noBlsKeysReturnMessage,
+		})
+		sip, _ := NewStakingInfoProcessor(arg)
+
+		stakingInfo, err := sip.GetOwnerStakingInfo(context.Background(), encodedOwner)
+		require.Nil(t, err)
+		require.Equal(t, "9000", stakingInfo.TotalStaked)
+		require.Empty(t, stakingInfo.Nodes)
+		require.Equal(t, 2, len(stakingInfo.UnStakedList))
+	})
+	t.Run("staked nodes before staking v4 should be new", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockStakingInfoArgs()
+		arg.QueryService = createStakingQueryServiceStub(t, createStakingQueryResults(), nil)
+		arg.NodesCoordinator = createNodesCoordinatorStub()
+		arg.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub()
+		sip, _ := NewStakingInfoProcessor(arg)
+
+		stakingInfo, err := sip.GetOwnerStakingInfo(context.Background(), encodedOwner)
+		require.Nil(t, err)
+		require.Equal(t, string(common.NewList), stakingInfo.Nodes[2].Status)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockStakingInfoArgs()
+		arg.QueryService = createStakingQueryServiceStub(t, createStakingQueryResults(), nil)
+		arg.NodesCoordinator = createNodesCoordinatorStub()
+		sip, _ := NewStakingInfoProcessor(arg)
+
+		stakingInfo, err := sip.GetOwnerStakingInfo(context.Background(), encodedOwner)
+		require.Nil(t, err)
+		require.Equal(t, &common.OwnerStakingInfoAPIResponse{
+			Owner:                encodedOwner,
+			CurrentEpoch:         10,
+			UnBondPeriodInEpochs: 10,
+			UnBondPeriodInRounds: 100,
+			TotalStaked:          "9000",
+			TopUp:                "1500",
+			NumActiveNodes:       3,
+			TotalUnStaked:        "3500",
+			TotalWithdrawable:    "2500",
+			Nodes: []*common.StakedNodeAPIResponse{
+				{BlsKey: hex.EncodeToString([]byte("eligibleKey")), Status: string(common.EligibleList)},
+				{BlsKey: hex.EncodeToString([]byte("waitingKey")), Status: string(common.WaitingList)},
+				{BlsKey: hex.EncodeToString([]byte("auctionKey")), Status: string(common.AuctionList)},
+				{BlsKey: hex.EncodeToString([]byte("jailedKey")), Status: string(common.JailedList)},
+				{BlsKey: hex.EncodeToString([]byte("queuedKey")), Status: "queued"},
+				{BlsKey: hex.EncodeToString([]byte("unStakedKey")), Status: "unStaked", RemainingUnBondRounds: 40},
+			},
+			UnStakedList: []*common.UnBondingFundAPIResponse{
+				{Value: "2500", RemainingEpochs: 0, WithdrawEpoch: 10, Withdrawable: true},
+				{Value: "1000", RemainingEpochs: 4, WithdrawEpoch: 14, Withdrawable: false},
+			},
+			TotalDelegated: "300",
+			Delegations: []*common.DelegationPositionAPIResponse{
+				{
+					Contract:         hex.EncodeToString(delegationContract2),
+					ActiveStake:      "300",
+					ClaimableRewards: "7",
+					UnStaked:         "50",
+					UnBondable:       "20",
+					UnDelegatedList: []*common.UnBondingFundAPIResponse{
+						{Value: "30", RemainingEpochs: 2, WithdrawEpoch: 12, Withdrawable: false},
+						{Value: "20", RemainingEpochs: 0, WithdrawEpoch: 10, Withdrawable: true},
+					},
+				},
+			},
+		}, stakingInfo)
+	})
+}
+
+func TestStakingInfoProcessor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var sip *stakingInfoProcessor
+	require.True(t, sip.IsInterfaceNil())
+
+	sip, _ = NewStakingInfoProcessor(createMockStakingInfoArgs())
+	require.False(t, sip.IsInterfaceNil())
+}
@@ -0,0 +1,22 @@
+package disabled
+
+import (
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+)
+
+type disabledOutportBlockFilter struct{}
+
+// NewDisabledOutportBlockFilter will create a new instance of disabledOutportBlockFilter
+func NewDisabledOutportBlockFilter() *disabledOutportBlockFilter {
+	return new(disabledOutportBlockFilter)
+}
+
+// FilterOutportBlock returns the provided block as it is
+func (dobf *disabledOutportBlockFilter) FilterOutportBlock(outportBlock *outportcore.OutportBlock) *outportcore.OutportBlock {
+	return outportBlock
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dobf *disabledOutportBlockFilter) IsInterfaceNil() bool {
+	return dobf == nil
+}
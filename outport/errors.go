@@ -17,3 +17,30 @@ var ErrNilPubKeyConverter = errors.New("nil pub key converter")
 var errNilSaveBlockArgs = errors.New("nil save blocks args provided")
 
 var errNilHeaderAndBodyArgs = errors.New("nil header and body args provided")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrInvalidMaxBacklog signals that an invalid maximum backlog value was provided
+var ErrInvalidMaxBacklog = errors.New("invalid maximum backlog")
+
+// ErrEmptyDriverIdentifier signals that an empty driver identifier was provided
+var ErrEmptyDriverIdentifier = errors.New("empty driver identifier")
+
+// ErrNotLoopbackAddress signals that a non-loopback address has been provided for a server which does not authenticate its clients
+var ErrNotLoopbackAddress = errors.New("not a loopback address")
+
+// ErrQueuedDriverClosed signals that the queued driver was closed
+var ErrQueuedDriverClosed = errors.New("queued driver closed")
+
+var errUnknownQueuedEventType = errors.New("unknown queued event type")
+
+var errEmptyQueuedEvent = errors.New("empty queued event")
+
+var errCorruptedAcknowledgedOffset = errors.New("corrupted acknowledged offset")
+
+// ErrUnsupportedReplayTarget signals that the provided replay target is not configured or can not be replayed to
+var ErrUnsupportedReplayTarget = errors.New("unsupported replay target")
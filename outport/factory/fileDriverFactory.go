@@ -0,0 +1,27 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/file"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsFileDriverFactory holds the arguments needed for creating a file driver
+type ArgsFileDriverFactory struct {
+	FileConfig config.FileDriversConfig
+}
+
+var fileDriverLog = logger.GetOrCreate("outport/factory/filedriver")
+
+// CreateFileDriver will create a new instance of outport.Driver writing the data in rolling segment files
+func CreateFileDriver(args ArgsFileDriverFactory) (outport.Driver, error) {
+	return file.NewFileDriver(file.ArgsFileDriver{
+		Path:                  args.FileConfig.Path,
+		Format:                args.FileConfig.Format,
+		MaxSegmentSizeInBytes: args.FileConfig.MaxSegmentSizeInMB * core.MegabyteSize,
+		RotateOnEpochChange:   args.FileConfig.RotateOnEpochChange,
+		Log:                   fileDriverLog,
+	})
+}
@@ -0,0 +1,28 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateFileDriver(t *testing.T) {
+	t.Parallel()
+
+	args := ArgsFileDriverFactory{
+		FileConfig: config.FileDriversConfig{
+			Enabled:            true,
+			Path:               t.TempDir(),
+			Format:             "ndjson",
+			MaxSegmentSizeInMB: 1,
+		},
+	}
+
+	driver, err := CreateFileDriver(args)
+	require.Nil(t, err)
+	require.NotNil(t, driver)
+	require.Equal(t, "*file.fileDriver", fmt.Sprintf("%T", driver))
+	require.Nil(t, driver.Close())
+}
@@ -0,0 +1,76 @@
+package factory
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/stream"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsGRPCDriverFactory holds the arguments needed for creating a gRPC stream driver
+type ArgsGRPCDriverFactory struct {
+	GRPCConfig       config.GRPCDriversConfig
+	Marshaller       marshal.Marshalizer
+	AddressConverter core.PubkeyConverter
+}
+
+var grpcDriverLog = logger.GetOrCreate("outport/factory/grpcdriver")
+
+// CreateGRPCDriver will create a new instance of outport.Driver streaming the data over gRPC
+func CreateGRPCDriver(args ArgsGRPCDriverFactory) (outport.Driver, error) {
+	blockFilter, err := createOutportBlockFilter(args.GRPCConfig.Filter, args.AddressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkLoopbackAddress(args.GRPCConfig.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", args.GRPCConfig.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := stream.NewStreamDriver(stream.ArgsStreamDriver{
+		Marshaller:               args.Marshaller,
+		Filter:                   blockFilter,
+		Listener:                 listener,
+		BufferSize:               args.GRPCConfig.BufferSize,
+		MaxInFlight:              args.GRPCConfig.MaxInFlight,
+		MaxConsumers:             args.GRPCConfig.MaxConsumers,
+		DropMessagesIfNoConsumer: args.GRPCConfig.DropMessagesIfNoConsumer,
+		Log:                      grpcDriverLog,
+	})
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	return driver, nil
+}
+
+// checkLoopbackAddress makes sure the stream is only served locally, as the consumers are neither authenticated nor
+// is the connection encrypted. Remote consumers should connect through a tunnel or a TLS terminating proxy
+func checkLoopbackAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if host == "localhost" {
+		return nil
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("%w: %s", outport.ErrNotLoopbackAddress, address)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/filter"
+	"github.com/multiversx/mx-chain-go/outport/stream"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsGRPCDriverFactory() ArgsGRPCDriverFactory {
+	return ArgsGRPCDriverFactory{
+		GRPCConfig: config.GRPCDriversConfig{
+			Enabled:      true,
+			URL:          "127.0.0.1:0",
+			BufferSize:   10,
+			MaxInFlight:  5,
+			MaxConsumers: 2,
+		},
+		Marshaller:       &marshal.GogoProtoMarshalizer{},
+		AddressConverter: &testscommon.PubkeyConverterStub{},
+	}
+}
+
+func TestCreateGRPCDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid filter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGRPCDriverFactory()
+		args.GRPCConfig.Filter = config.OutportFilterConfig{
+			Enabled:          true,
+			TransactionTypes: []string{"unknown"},
+		}
+
+		driver, err := CreateGRPCDriver(args)
+		require.Nil(t, driver)
+		require.ErrorIs(t, err, filter.ErrInvalidTransactionType)
+	})
+	t.Run("invalid URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGRPCDriverFactory()
+		args.GRPCConfig.URL = "invalid URL"
+
+		driver, err := CreateGRPCDriver(args)
+		require.Nil(t, driver)
+		require.NotNil(t, err)
+	})
+	t.Run("not a loopback address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGRPCDriverFactory()
+		for _, url := range []string{":0", "0.0.0.0:0", "10.0.0.1:0", "example.com:0"} {
+			args.GRPCConfig.URL = url
+			driver, err := CreateGRPCDriver(args)
+			require.Nil(t, driver)
+			require.ErrorIs(t, err, outport.ErrNotLoopbackAddress)
+		}
+	})
+	t.Run("invalid driver arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGRPCDriverFactory()
+		args.GRPCConfig.BufferSize = 0
+
+		driver, err := CreateGRPCDriver(args)
+		require.Nil(t, driver)
+		require.Equal(t, stream.ErrInvalidBufferSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		driver, err := CreateGRPCDriver(createMockArgsGRPCDriverFactory())
+		require.Nil(t, err)
+		require.Equal(t, "*stream.streamDriver", fmt.Sprintf("%T", driver))
+		require.Nil(t, driver.Close())
+	})
+}
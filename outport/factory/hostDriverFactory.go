@@ -3,6 +3,7 @@ package factory
 import (
 	"github.com/multiversx/mx-chain-communication-go/websocket/data"
 	"github.com/multiversx/mx-chain-communication-go/websocket/factory"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/outport"
@@ -11,14 +12,20 @@ import (
 )
 
 type ArgsHostDriverFactory struct {
-	HostConfig config.HostDriversConfig
-	Marshaller marshal.Marshalizer
+	HostConfig       config.HostDriversConfig
+	Marshaller       marshal.Marshalizer
+	AddressConverter core.PubkeyConverter
 }
 
 var log = logger.GetOrCreate("outport/factory/hostdriver")
 
 // CreateHostDriver will create a new instance of outport.Driver
 func CreateHostDriver(args ArgsHostDriverFactory) (outport.Driver, error) {
+	blockFilter, err := createOutportBlockFilter(args.HostConfig.Filter, args.AddressConverter)
+	if err != nil {
+		return nil, err
+	}
+
 	wsHost, err := factory.CreateWebSocketHost(factory.ArgsWebSocketHost{
 		WebSocketConfig: data.WebSocketConfig{
 			URL:                        args.HostConfig.URL,
@@ -41,5 +48,6 @@ func CreateHostDriver(args ArgsHostDriverFactory) (outport.Driver, error) {
 		Marshaller: args.Marshaller,
 		SenderHost: wsHost,
 		Log:        log,
+		Filter:     blockFilter,
 	})
 }
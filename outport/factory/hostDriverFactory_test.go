@@ -6,6 +6,8 @@ import (
 
 	"github.com/multiversx/mx-chain-communication-go/websocket/data"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport/filter"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/stretchr/testify/require"
 )
@@ -28,3 +30,31 @@ func TestCreateHostDriver(t *testing.T) {
 	require.NotNil(t, driver)
 	require.Equal(t, "*host.hostDriver", fmt.Sprintf("%T", driver))
 }
+
+func TestCreateHostDriver_WithFilter(t *testing.T) {
+	t.Parallel()
+
+	args := ArgsHostDriverFactory{
+		HostConfig: config.HostDriversConfig{
+			URL:                "localhost",
+			RetryDurationInSec: 1,
+			MarshallerType:     "json",
+			Mode:               data.ModeClient,
+			Filter: config.OutportFilterConfig{
+				Enabled:          true,
+				TransactionTypes: []string{"unknown"},
+			},
+		},
+		Marshaller:       &marshallerMock.MarshalizerStub{},
+		AddressConverter: &testscommon.PubkeyConverterStub{},
+	}
+
+	driver, err := CreateHostDriver(args)
+	require.Nil(t, driver)
+	require.ErrorIs(t, err, filter.ErrInvalidTransactionType)
+
+	args.HostConfig.Filter.TransactionTypes = []string{"normal", "unsigned"}
+	driver, err = CreateHostDriver(args)
+	require.Nil(t, err)
+	require.NotNil(t, driver)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/outport/notifier"
 )
@@ -18,6 +19,8 @@ type EventNotifierFactoryArgs struct {
 	Password          string
 	RequestTimeoutSec int
 	Marshaller        marshal.Marshalizer
+	Filter            config.OutportFilterConfig
+	AddressConverter  core.PubkeyConverter
 }
 
 // CreateEventNotifier will create a new event notifier client instance
@@ -43,10 +46,16 @@ func CreateEventNotifier(args *EventNotifierFactoryArgs) (outport.Driver, error)
 		return nil, err
 	}
 
+	blockFilter, err := createOutportBlockFilter(args.Filter, args.AddressConverter)
+	if err != nil {
+		return nil, err
+	}
+
 	notifierArgs := notifier.ArgsEventNotifier{
 		HttpClient:     httpClient,
 		Marshaller:     args.Marshaller,
 		BlockContainer: blockContainer,
+		Filter:         blockFilter,
 	}
 
 	return notifier.NewEventNotifier(notifierArgs)
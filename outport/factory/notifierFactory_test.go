@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/stretchr/testify/require"
 )
@@ -42,4 +44,32 @@ func TestCreateEventNotifier(t *testing.T) {
 		require.Nil(t, err)
 		require.NotNil(t, en)
 	})
+
+	t.Run("nil address converter with enabled filter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNotifierFactoryArgs()
+		args.Filter = config.OutportFilterConfig{
+			Enabled: true,
+		}
+
+		en, err := factory.CreateEventNotifier(args)
+		require.Nil(t, en)
+		require.Equal(t, core.ErrNilPubkeyConverter, err)
+	})
+
+	t.Run("should work with enabled filter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNotifierFactoryArgs()
+		args.Filter = config.OutportFilterConfig{
+			Enabled:          true,
+			EventIdentifiers: []string{"ESDTTransfer"},
+		}
+		args.AddressConverter = &testscommon.PubkeyConverterStub{}
+
+		en, err := factory.CreateEventNotifier(args)
+		require.Nil(t, err)
+		require.NotNil(t, en)
+	})
 }
@@ -0,0 +1,27 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport/disabled"
+	"github.com/multiversx/mx-chain-go/outport/filter"
+)
+
+type outportBlockFilter interface {
+	FilterOutportBlock(outportBlock *outportcore.OutportBlock) *outportcore.OutportBlock
+	IsInterfaceNil() bool
+}
+
+// createOutportBlockFilter returns the filter reducing the blocks pushed to a driver, or a disabled one if the
+// filtering is not enabled for that driver
+func createOutportBlockFilter(filterConfig config.OutportFilterConfig, addressConverter core.PubkeyConverter) (outportBlockFilter, error) {
+	if !filterConfig.Enabled {
+		return disabled.NewDisabledOutportBlockFilter(), nil
+	}
+
+	return filter.NewOutportBlockFilter(filter.ArgsOutportBlockFilter{
+		Config:           filterConfig,
+		AddressConverter: addressConverter,
+	})
+}
@@ -1,12 +1,28 @@
 package factory
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	indexerFactory "github.com/multiversx/mx-chain-es-indexer-go/process/factory"
 	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const (
+	elasticDriverIdentifier  = "elasticIndexer"
+	notifierDriverIdentifier = "eventNotifier"
+	hostDriverIdentifier     = "hostDriver"
+	fileDriverIdentifier     = "fileDriver"
+	grpcDriverIdentifier     = "grpcDriver"
+
+	// the number of bytes of the destination hash used in the driver identifiers
+	driverIdentifierHashLength = 8
 )
 
 // OutportFactoryArgs holds the factory arguments of different outport drivers
@@ -17,6 +33,17 @@ type OutportFactoryArgs struct {
 	ElasticIndexerFactoryArgs indexerFactory.ArgsIndexerFactory
 	EventNotifierFactoryArgs  *EventNotifierFactoryArgs
 	HostDriversArgs           []ArgsHostDriverFactory
+	FileDriversArgs           []ArgsFileDriverFactory
+	GRPCDriversArgs           []ArgsGRPCDriverFactory
+	QueueArgs                 ArgsOutportQueueFactory
+}
+
+// ArgsOutportQueueFactory holds the arguments needed to wrap each driver in a persistent queue
+type ArgsOutportQueueFactory struct {
+	Enabled      bool
+	MaxBacklog   uint64
+	Marshaller   marshal.Marshalizer
+	CreateStorer func(driverIdentifier string) (storage.Storer, error)
 }
 
 // CreateOutport will create a new instance of OutportHandler
@@ -45,78 +72,182 @@ func CreateOutport(args *OutportFactoryArgs) (outport.OutportHandler, error) {
 }
 
 func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFactoryArgs) error {
-	err := createAndSubscribeElasticDriverIfNeeded(outport, args.ElasticIndexerFactoryArgs)
+	err := createAndSubscribeElasticDriverIfNeeded(outport, args)
 	if err != nil {
 		return err
 	}
 
-	err = createAndSubscribeEventNotifierIfNeeded(outport, args.EventNotifierFactoryArgs)
+	err = createAndSubscribeEventNotifierIfNeeded(outport, args)
 	if err != nil {
 		return err
 	}
 
 	for idx := 0; idx < len(args.HostDriversArgs); idx++ {
-		err = createAndSubscribeHostDriverIfNeeded(outport, args.HostDriversArgs[idx])
+		err = createAndSubscribeHostDriverIfNeeded(outport, args, idx)
 		if err != nil {
 			return fmt.Errorf("%w when calling createAndSubscribeHostDriverIfNeeded, host driver index %d", err, idx)
 		}
 	}
 
+	for idx := 0; idx < len(args.FileDriversArgs); idx++ {
+		err = createAndSubscribeFileDriverIfNeeded(outport, args, idx)
+		if err != nil {
+			return fmt.Errorf("%w when calling createAndSubscribeFileDriverIfNeeded, file driver index %d", err, idx)
+		}
+	}
+
+	for idx := 0; idx < len(args.GRPCDriversArgs); idx++ {
+		err = createAndSubscribeGRPCDriverIfNeeded(outport, args, idx)
+		if err != nil {
+			return fmt.Errorf("%w when calling createAndSubscribeGRPCDriverIfNeeded, gRPC driver index %d", err, idx)
+		}
+	}
+
 	return nil
 }
 
 func createAndSubscribeElasticDriverIfNeeded(
 	outport outport.OutportHandler,
-	args indexerFactory.ArgsIndexerFactory,
+	args *OutportFactoryArgs,
 ) error {
-	if !args.Enabled {
+	if !args.ElasticIndexerFactoryArgs.Enabled {
 		return nil
 	}
 
-	elasticDriver, err := indexerFactory.NewIndexer(args)
+	elasticDriver, err := indexerFactory.NewIndexer(args.ElasticIndexerFactoryArgs)
 	if err != nil {
 		return err
 	}
 
-	return outport.SubscribeDriver(elasticDriver)
+	return subscribeDriver(outport, elasticDriver, elasticDriverIdentifier, args)
 }
 
 func createAndSubscribeEventNotifierIfNeeded(
 	outport outport.OutportHandler,
-	args *EventNotifierFactoryArgs,
+	args *OutportFactoryArgs,
 ) error {
-	if !args.Enabled {
+	if !args.EventNotifierFactoryArgs.Enabled {
 		return nil
 	}
 
-	eventNotifier, err := CreateEventNotifier(args)
+	eventNotifier, err := CreateEventNotifier(args.EventNotifierFactoryArgs)
 	if err != nil {
 		return err
 	}
 
-	return outport.SubscribeDriver(eventNotifier)
+	return subscribeDriver(outport, eventNotifier, notifierDriverIdentifier, args)
 }
 
 func checkArguments(args *OutportFactoryArgs) error {
 	if args == nil {
 		return outport.ErrNilArgsOutportFactory
 	}
+	if !args.QueueArgs.Enabled {
+		return nil
+	}
+	if check.IfNil(args.QueueArgs.Marshaller) {
+		return outport.ErrNilMarshaller
+	}
+	if args.QueueArgs.CreateStorer == nil {
+		return outport.ErrNilStorer
+	}
 
 	return nil
 }
 
 func createAndSubscribeHostDriverIfNeeded(
 	outport outport.OutportHandler,
-	args ArgsHostDriverFactory,
+	args *OutportFactoryArgs,
+	idx int,
+) error {
+	hostDriverArgs := args.HostDriversArgs[idx]
+	if !hostDriverArgs.HostConfig.Enabled {
+		return nil
+	}
+
+	hostDriver, err := CreateHostDriver(hostDriverArgs)
+	if err != nil {
+		return err
+	}
+
+	identifier := createDriverIdentifier(hostDriverIdentifier, hostDriverArgs.HostConfig.URL)
+	return subscribeDriver(outport, hostDriver, identifier, args)
+}
+
+func createAndSubscribeFileDriverIfNeeded(
+	outport outport.OutportHandler,
+	args *OutportFactoryArgs,
+	idx int,
+) error {
+	fileDriverArgs := args.FileDriversArgs[idx]
+	if !fileDriverArgs.FileConfig.Enabled {
+		return nil
+	}
+
+	fileDriver, err := CreateFileDriver(fileDriverArgs)
+	if err != nil {
+		return err
+	}
+
+	identifier := createDriverIdentifier(fileDriverIdentifier, fileDriverArgs.FileConfig.Path)
+	return subscribeDriver(outport, fileDriver, identifier, args)
+}
+
+func createAndSubscribeGRPCDriverIfNeeded(
+	outport outport.OutportHandler,
+	args *OutportFactoryArgs,
+	idx int,
 ) error {
-	if !args.HostConfig.Enabled {
+	grpcDriverArgs := args.GRPCDriversArgs[idx]
+	if !grpcDriverArgs.GRPCConfig.Enabled {
 		return nil
 	}
 
-	hostDriver, err := CreateHostDriver(args)
+	grpcDriver, err := CreateGRPCDriver(grpcDriverArgs)
+	if err != nil {
+		return err
+	}
+
+	identifier := createDriverIdentifier(grpcDriverIdentifier, grpcDriverArgs.GRPCConfig.URL)
+	return subscribeDriver(outport, grpcDriver, identifier, args)
+}
+
+// createDriverIdentifier derives the driver identifier from its destination so the driver keeps its queue even if
+// the drivers are reordered, added or removed in the configuration
+func createDriverIdentifier(prefix string, destination string) string {
+	hash := sha256.Sum256([]byte(destination))
+
+	return fmt.Sprintf("%s_%s", prefix, hex.EncodeToString(hash[:driverIdentifierHashLength]))
+}
+
+// subscribeDriver will subscribe the driver as it is or, if the queue is enabled, wrapped in a queued driver
+func subscribeDriver(
+	outportHandler outport.OutportHandler,
+	driver outport.Driver,
+	identifier string,
+	args *OutportFactoryArgs,
+) error {
+	if !args.QueueArgs.Enabled {
+		return outportHandler.SubscribeDriver(driver)
+	}
+
+	storer, err := args.QueueArgs.CreateStorer(identifier)
+	if err != nil {
+		return fmt.Errorf("%w while creating the outport queue storer for driver %s", err, identifier)
+	}
+
+	queuedDriver, err := outport.NewQueuedDriver(outport.ArgsQueuedDriver{
+		Driver:          driver,
+		Storer:          storer,
+		Marshaller:      args.QueueArgs.Marshaller,
+		Identifier:      identifier,
+		MaxBacklog:      args.QueueArgs.MaxBacklog,
+		RetrialInterval: args.RetrialInterval,
+	})
 	if err != nil {
+		_ = storer.Close()
 		return err
 	}
 
-	return outport.SubscribeDriver(hostDriver)
+	return outportHandler.SubscribeDriver(queuedDriver)
 }
@@ -2,6 +2,7 @@ package factory_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/multiversx/mx-chain-go/outport/factory"
 	notifierFactory "github.com/multiversx/mx-chain-go/outport/factory"
 	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
 	"github.com/multiversx/mx-chain-storage-go/testscommon"
 	"github.com/stretchr/testify/require"
 )
@@ -161,6 +164,127 @@ func TestCreateOutport_SubscribeMultipleHostDrivers(t *testing.T) {
 	require.True(t, outPort.HasDrivers())
 }
 
+func TestCreateOutport_SubscribeFileDrivers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := &factory.OutportFactoryArgs{
+			RetrialInterval: time.Second,
+			EventNotifierFactoryArgs: &notifierFactory.EventNotifierFactoryArgs{
+				Enabled: false,
+			},
+			FileDriversArgs: []notifierFactory.ArgsFileDriverFactory{
+				{
+					FileConfig: config.FileDriversConfig{
+						Enabled:            true,
+						Path:               t.TempDir(),
+						Format:             "xml",
+						MaxSegmentSizeInMB: 1,
+					},
+				},
+			},
+		}
+
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, outPort)
+		require.ErrorContains(t, err, "file driver index 0")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := &factory.OutportFactoryArgs{
+			RetrialInterval: time.Second,
+			EventNotifierFactoryArgs: &notifierFactory.EventNotifierFactoryArgs{
+				Enabled: false,
+			},
+			FileDriversArgs: []notifierFactory.ArgsFileDriverFactory{
+				{
+					FileConfig: config.FileDriversConfig{
+						Enabled: false,
+					},
+				},
+				{
+					FileConfig: config.FileDriversConfig{
+						Enabled:            true,
+						Path:               t.TempDir(),
+						Format:             "proto",
+						MaxSegmentSizeInMB: 1,
+					},
+				},
+			},
+		}
+
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, err)
+		require.True(t, outPort.HasDrivers())
+		require.Nil(t, outPort.Close())
+	})
+}
+
+func TestCreateOutport_SubscribeGRPCDrivers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := &factory.OutportFactoryArgs{
+			RetrialInterval: time.Second,
+			EventNotifierFactoryArgs: &notifierFactory.EventNotifierFactoryArgs{
+				Enabled: false,
+			},
+			GRPCDriversArgs: []notifierFactory.ArgsGRPCDriverFactory{
+				{
+					GRPCConfig: config.GRPCDriversConfig{
+						Enabled:      true,
+						URL:          "127.0.0.1:0",
+						BufferSize:   0,
+						MaxInFlight:  1,
+						MaxConsumers: 1,
+					},
+					Marshaller: &mock.MarshalizerMock{},
+				},
+			},
+		}
+
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, outPort)
+		require.ErrorContains(t, err, "gRPC driver index 0")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := &factory.OutportFactoryArgs{
+			RetrialInterval: time.Second,
+			EventNotifierFactoryArgs: &notifierFactory.EventNotifierFactoryArgs{
+				Enabled: false,
+			},
+			GRPCDriversArgs: []notifierFactory.ArgsGRPCDriverFactory{
+				{
+					GRPCConfig: config.GRPCDriversConfig{
+						Enabled: false,
+					},
+				},
+				{
+					GRPCConfig: config.GRPCDriversConfig{
+						Enabled:      true,
+						URL:          "127.0.0.1:0",
+						BufferSize:   10,
+						MaxInFlight:  1,
+						MaxConsumers: 1,
+					},
+					Marshaller: &mock.MarshalizerMock{},
+				},
+			},
+		}
+
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, err)
+		require.True(t, outPort.HasDrivers())
+		require.Nil(t, outPort.Close())
+	})
+}
 func TestCreateAndSubscribeDriversShouldReturnError(t *testing.T) {
 	args := &factory.OutportFactoryArgs{
 		RetrialInterval: time.Second,
@@ -188,3 +312,122 @@ func TestCreateAndSubscribeDriversShouldReturnError(t *testing.T) {
 	require.Nil(t, outPort)
 	require.ErrorIs(t, err, data.ErrInvalidWebSocketHostMode)
 }
+
+func TestCreateOutport_QueuedDrivers(t *testing.T) {
+	t.Parallel()
+
+	createArgs := func() *factory.OutportFactoryArgs {
+		args := createMockArgsOutportHandler(false, true)
+		args.EventNotifierFactoryArgs.Marshaller = &mock.MarshalizerMock{}
+		args.EventNotifierFactoryArgs.RequestTimeoutSec = 1
+		args.QueueArgs = factory.ArgsOutportQueueFactory{
+			Enabled:    true,
+			MaxBacklog: 10,
+			Marshaller: &mock.MarshalizerMock{},
+			CreateStorer: func(driverIdentifier string) (storage.Storer, error) {
+				return genericMocks.NewStorerMock(), nil
+			},
+		}
+
+		return args
+	}
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.QueueArgs.Marshaller = nil
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, outPort)
+		require.Equal(t, outport.ErrNilMarshaller, err)
+	})
+	t.Run("nil create storer function should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.QueueArgs.CreateStorer = nil
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, outPort)
+		require.Equal(t, outport.ErrNilStorer, err)
+	})
+	t.Run("create storer fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.QueueArgs.CreateStorer = func(driverIdentifier string) (storage.Storer, error) {
+			return nil, expectedErr
+		}
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, outPort)
+		require.ErrorIs(t, err, expectedErr)
+	})
+	t.Run("invalid max backlog should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.QueueArgs.MaxBacklog = 0
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, outPort)
+		require.Equal(t, outport.ErrInvalidMaxBacklog, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		identifiers := make([]string, 0)
+		args := createArgs()
+		args.QueueArgs.CreateStorer = func(driverIdentifier string) (storage.Storer, error) {
+			identifiers = append(identifiers, driverIdentifier)
+			return genericMocks.NewStorerMock(), nil
+		}
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, err)
+
+		defer func() {
+			_ = outPort.Close()
+		}()
+
+		require.True(t, outPort.HasDrivers())
+		require.Equal(t, []string{"eventNotifier"}, identifiers)
+	})
+	t.Run("file driver identifier should not depend on the driver index", func(t *testing.T) {
+		t.Parallel()
+
+		path := t.TempDir()
+		fileDriverArgs := notifierFactory.ArgsFileDriverFactory{
+			FileConfig: config.FileDriversConfig{
+				Enabled:            true,
+				Path:               path,
+				Format:             "proto",
+				MaxSegmentSizeInMB: 1,
+			},
+		}
+		createIdentifier := func(fileDriversArgs []notifierFactory.ArgsFileDriverFactory) string {
+			identifiers := make([]string, 0)
+			args := createArgs()
+			args.EventNotifierFactoryArgs.Enabled = false
+			args.FileDriversArgs = fileDriversArgs
+			args.QueueArgs.CreateStorer = func(driverIdentifier string) (storage.Storer, error) {
+				identifiers = append(identifiers, driverIdentifier)
+				return genericMocks.NewStorerMock(), nil
+			}
+			outPort, err := factory.CreateOutport(args)
+			require.Nil(t, err)
+			require.Nil(t, outPort.Close())
+			require.Equal(t, 1, len(identifiers))
+
+			return identifiers[0]
+		}
+
+		identifier := createIdentifier([]notifierFactory.ArgsFileDriverFactory{fileDriverArgs})
+		require.True(t, strings.HasPrefix(identifier, "fileDriver_"))
+
+		disabledFileDriverArgs := notifierFactory.ArgsFileDriverFactory{
+			FileConfig: config.FileDriversConfig{
+				Enabled: false,
+				Path:    "another path",
+			},
+		}
+		require.Equal(t, identifier, createIdentifier([]notifierFactory.ArgsFileDriverFactory{disabledFileDriverArgs, fileDriverArgs}))
+	})
+}
@@ -0,0 +1,84 @@
+package factory
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-communication-go/websocket/data"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	indexerFactory "github.com/multiversx/mx-chain-es-indexer-go/process/factory"
+	"github.com/multiversx/mx-chain-go/outport"
+)
+
+// replayDriverFactory creates, for a replay target, a new driver that is not subscribed to the outport handler, so
+// the replayed blocks are not sent to the live drivers
+type replayDriverFactory struct {
+	args *OutportFactoryArgs
+}
+
+// NewReplayDriverFactory creates a new instance of replayDriverFactory
+func NewReplayDriverFactory(args *OutportFactoryArgs) (*replayDriverFactory, error) {
+	if args == nil {
+		return nil, outport.ErrNilArgsOutportFactory
+	}
+
+	return &replayDriverFactory{
+		args: args,
+	}, nil
+}
+
+// CreateReplayDriver creates a dedicated driver for the provided target. The target is either the elastic indexer,
+// the event notifier or the URL of a host driver running in client mode. The file, the gRPC and the server mode host
+// drivers own their destination, which is already used by the live driver, so they can not be replay targets
+func (rdf *replayDriverFactory) CreateReplayDriver(target string) (outport.Driver, error) {
+	driver, err := rdf.createDriver(target)
+	if err != nil {
+		return nil, err
+	}
+
+	err = driver.SetCurrentSettings(outportcore.OutportConfig{
+		ShardID:          rdf.args.ShardID,
+		IsInImportDBMode: rdf.args.IsImportDB,
+	})
+	if err != nil {
+		_ = driver.Close()
+		return nil, err
+	}
+
+	return driver, nil
+}
+
+func (rdf *replayDriverFactory) createDriver(target string) (outport.Driver, error) {
+	switch target {
+	case elasticDriverIdentifier:
+		if !rdf.args.ElasticIndexerFactoryArgs.Enabled {
+			break
+		}
+
+		return indexerFactory.NewIndexer(rdf.args.ElasticIndexerFactoryArgs)
+	case notifierDriverIdentifier:
+		if rdf.args.EventNotifierFactoryArgs == nil || !rdf.args.EventNotifierFactoryArgs.Enabled {
+			break
+		}
+
+		return CreateEventNotifier(rdf.args.EventNotifierFactoryArgs)
+	}
+
+	for _, hostDriverArgs := range rdf.args.HostDriversArgs {
+		hostConfig := hostDriverArgs.HostConfig
+		if !hostConfig.Enabled || hostConfig.URL != target {
+			continue
+		}
+		if hostConfig.Mode == data.ModeServer {
+			return nil, fmt.Errorf("%w, host driver %s runs in server mode", outport.ErrUnsupportedReplayTarget, target)
+		}
+
+		return CreateHostDriver(hostDriverArgs)
+	}
+
+	return nil, fmt.Errorf("%w: %s", outport.ErrUnsupportedReplayTarget, target)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rdf *replayDriverFactory) IsInterfaceNil() bool {
+	return rdf == nil
+}
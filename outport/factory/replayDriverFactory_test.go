@@ -0,0 +1,110 @@
+package factory_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-communication-go/websocket/data"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReplayDriverFactory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil args should error", func(t *testing.T) {
+		t.Parallel()
+
+		rdf, err := factory.NewReplayDriverFactory(nil)
+		require.Equal(t, outport.ErrNilArgsOutportFactory, err)
+		require.True(t, check.IfNil(rdf))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rdf, err := factory.NewReplayDriverFactory(&factory.OutportFactoryArgs{})
+		require.Nil(t, err)
+		require.False(t, check.IfNil(rdf))
+	})
+}
+
+func TestReplayDriverFactory_CreateReplayDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("event notifier should work", func(t *testing.T) {
+		t.Parallel()
+
+		rdf, _ := factory.NewReplayDriverFactory(&factory.OutportFactoryArgs{
+			EventNotifierFactoryArgs: createMockNotifierFactoryArgs(),
+		})
+
+		driver, err := rdf.CreateReplayDriver("eventNotifier")
+		require.Nil(t, err)
+		require.False(t, check.IfNil(driver))
+		require.Nil(t, driver.Close())
+	})
+	t.Run("disabled event notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNotifierFactoryArgs()
+		args.Enabled = false
+		rdf, _ := factory.NewReplayDriverFactory(&factory.OutportFactoryArgs{
+			EventNotifierFactoryArgs: args,
+		})
+
+		driver, err := rdf.CreateReplayDriver("eventNotifier")
+		require.True(t, errors.Is(err, outport.ErrUnsupportedReplayTarget))
+		require.True(t, check.IfNil(driver))
+	})
+	t.Run("disabled elastic indexer should error", func(t *testing.T) {
+		t.Parallel()
+
+		rdf, _ := factory.NewReplayDriverFactory(&factory.OutportFactoryArgs{})
+
+		driver, err := rdf.CreateReplayDriver("elasticIndexer")
+		require.True(t, errors.Is(err, outport.ErrUnsupportedReplayTarget))
+		require.True(t, check.IfNil(driver))
+	})
+	t.Run("unknown target should error", func(t *testing.T) {
+		t.Parallel()
+
+		rdf, _ := factory.NewReplayDriverFactory(&factory.OutportFactoryArgs{
+			FileDriversArgs: []factory.ArgsFileDriverFactory{
+				{
+					FileConfig: config.FileDriversConfig{
+						Enabled: true,
+						Path:    "path",
+					},
+				},
+			},
+		})
+
+		driver, err := rdf.CreateReplayDriver("path")
+		require.True(t, errors.Is(err, outport.ErrUnsupportedReplayTarget))
+		require.True(t, check.IfNil(driver))
+	})
+	t.Run("server mode host driver should error", func(t *testing.T) {
+		t.Parallel()
+
+		rdf, _ := factory.NewReplayDriverFactory(&factory.OutportFactoryArgs{
+			HostDriversArgs: []factory.ArgsHostDriverFactory{
+				{
+					HostConfig: config.HostDriversConfig{
+						Enabled: true,
+						URL:     "localhost:22111",
+						Mode:    data.ModeServer,
+					},
+					Marshaller: &marshallerMock.MarshalizerMock{},
+				},
+			},
+		})
+
+		driver, err := rdf.CreateReplayDriver("localhost:22111")
+		require.True(t, errors.Is(err, outport.ErrUnsupportedReplayTarget))
+		require.True(t, check.IfNil(driver))
+	})
+}
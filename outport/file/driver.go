@@ -0,0 +1,214 @@
+package file
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+// ArgsFileDriver holds the arguments needed for creating a new fileDriver
+type ArgsFileDriver struct {
+	Path                  string
+	Format                string
+	MaxSegmentSizeInBytes uint64
+	RotateOnEpochChange   bool
+	Log                   core.Logger
+}
+
+type emptyBlockCreatorsContainer interface {
+	Get(headerType core.HeaderType) (block.EmptyBlockCreator, error)
+}
+
+// fileDriver writes every outport event in rolling segment files, so the batch jobs can ingest them without running a
+// websocket server. The sealed segments are listed in an index file
+type fileDriver struct {
+	marshaller    marshal.Marshalizer
+	blockCreators emptyBlockCreatorsContainer
+	mutWriter     sync.Mutex
+	writer        *segmentWriter
+	currentEpoch  uint32
+	isClosed      bool
+}
+
+// NewFileDriver will create a new instance of fileDriver
+func NewFileDriver(args ArgsFileDriver) (*fileDriver, error) {
+	if len(args.Path) == 0 {
+		return nil, ErrEmptyPath
+	}
+	if args.MaxSegmentSizeInBytes == 0 {
+		return nil, ErrInvalidMaxSegmentSize
+	}
+	if check.IfNil(args.Log) {
+		return nil, core.ErrNilLogger
+	}
+
+	codec, marshaller, err := createCodecAndMarshaller(args.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	blockCreators, err := createBlockCreators()
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := newSegmentWriter(args.Path, codec, args.MaxSegmentSizeInBytes, args.RotateOnEpochChange, args.Log)
+	if err != nil {
+		return nil, err
+	}
+
+	args.Log.Info("file driver: writing the outport data",
+		"path", args.Path,
+		"format", args.Format,
+		"max segment size", core.ConvertBytes(args.MaxSegmentSizeInBytes),
+		"rotate on epoch change", args.RotateOnEpochChange)
+
+	return &fileDriver{
+		marshaller:    marshaller,
+		blockCreators: blockCreators,
+		writer:        writer,
+	}, nil
+}
+
+func createBlockCreators() (emptyBlockCreatorsContainer, error) {
+	container := block.NewEmptyBlockCreatorsContainer()
+	err := container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.ShardHeaderV2, block.NewEmptyHeaderV2Creator())
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.MetaHeader, block.NewEmptyMetaBlockCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	return container, nil
+}
+
+// SaveBlock will write the block
+func (fd *fileDriver) SaveBlock(outportBlock *outport.OutportBlock) error {
+	return fd.handleBlockAction(outportBlock, outportBlock.GetBlockData(), outport.TopicSaveBlock)
+}
+
+// RevertIndexedBlock will write the reverted block
+func (fd *fileDriver) RevertIndexedBlock(blockData *outport.BlockData) error {
+	return fd.handleBlockAction(blockData, blockData, outport.TopicRevertIndexedBlock)
+}
+
+// SaveRoundsInfo will write the rounds info
+func (fd *fileDriver) SaveRoundsInfo(roundsInfos *outport.RoundsInfo) error {
+	return fd.handleAction(roundsInfos, outport.TopicSaveRoundsInfo, nil)
+}
+
+// SaveValidatorsPubKeys will write the validators' public keys
+func (fd *fileDriver) SaveValidatorsPubKeys(validatorsPubKeys *outport.ValidatorsPubKeys) error {
+	return fd.handleAction(validatorsPubKeys, outport.TopicSaveValidatorsPubKeys, nil)
+}
+
+// SaveValidatorsRating will write the validators' rating
+func (fd *fileDriver) SaveValidatorsRating(validatorsRating *outport.ValidatorsRating) error {
+	return fd.handleAction(validatorsRating, outport.TopicSaveValidatorsRating, nil)
+}
+
+// SaveAccounts will write the accounts
+func (fd *fileDriver) SaveAccounts(accounts *outport.Accounts) error {
+	return fd.handleAction(accounts, outport.TopicSaveAccounts, nil)
+}
+
+// FinalizedBlock will write the finalized block
+func (fd *fileDriver) FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	return fd.handleAction(finalizedBlock, outport.TopicFinalizedBlock, nil)
+}
+
+// GetMarshaller returns the internal marshaller, matching the configured format
+func (fd *fileDriver) GetMarshaller() marshal.Marshalizer {
+	return fd.marshaller
+}
+
+// SetCurrentSettings will write the current settings
+func (fd *fileDriver) SetCurrentSettings(config outport.OutportConfig) error {
+	return fd.handleAction(&config, outport.TopicSettings, nil)
+}
+
+// RegisterHandler will do nothing, the segment files are not acknowledged
+func (fd *fileDriver) RegisterHandler(_ func() error, _ string) error {
+	return nil
+}
+
+func (fd *fileDriver) handleBlockAction(args interface{}, blockData *outport.BlockData, topic string) error {
+	if blockData == nil {
+		return fd.handleAction(args, topic, nil)
+	}
+
+	creator, err := fd.blockCreators.Get(core.HeaderType(blockData.HeaderType))
+	if err != nil {
+		return fmt.Errorf("%w for header type %s", err, blockData.HeaderType)
+	}
+
+	header, err := block.GetHeaderFromBytes(fd.marshaller, creator, blockData.HeaderBytes)
+	if err != nil {
+		return fmt.Errorf("%w while unmarshalling the header for topic %s", err, topic)
+	}
+
+	return fd.handleAction(args, topic, header)
+}
+
+// handleAction writes the event; the events which are not related to a block are recorded in the epoch of the last block
+func (fd *fileDriver) handleAction(args interface{}, topic string, header data.HeaderHandler) error {
+	payload, err := fd.marshaller.Marshal(args)
+	if err != nil {
+		return fmt.Errorf("%w while marshaling data for topic %s", err, topic)
+	}
+
+	fd.mutWriter.Lock()
+	defer fd.mutWriter.Unlock()
+
+	if fd.isClosed {
+		return ErrDriverIsClosed
+	}
+
+	nonce := uint64(0)
+	if !check.IfNil(header) {
+		fd.currentEpoch = header.GetEpoch()
+		nonce = header.GetNonce()
+	}
+
+	err = fd.writer.write(&record{
+		Topic:   topic,
+		Epoch:   fd.currentEpoch,
+		Nonce:   nonce,
+		Payload: payload,
+	})
+	if err != nil {
+		return fmt.Errorf("%w while writing the segment for topic %s", err, topic)
+	}
+
+	return nil
+}
+
+// Close will seal the current segment and close the index file
+func (fd *fileDriver) Close() error {
+	fd.mutWriter.Lock()
+	defer fd.mutWriter.Unlock()
+
+	if fd.isClosed {
+		return nil
+	}
+	fd.isClosed = true
+
+	return fd.writer.close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fd *fileDriver) IsInterfaceNil() bool {
+	return fd == nil
+}
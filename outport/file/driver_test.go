@@ -0,0 +1,311 @@
+package file
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/require"
+)
+
+var log = logger.GetOrCreate("test")
+
+func createMockArgs(t *testing.T) ArgsFileDriver {
+	return ArgsFileDriver{
+		Path:                  t.TempDir(),
+		Format:                FormatNDJSON,
+		MaxSegmentSizeInBytes: core.MegabyteSize,
+		RotateOnEpochChange:   false,
+		Log:                   log,
+	}
+}
+
+func createOutportBlock(t *testing.T, driver *fileDriver, header data.HeaderHandler) *outport.OutportBlock {
+	headerBytes, headerType, err := outport.GetHeaderBytesAndType(driver.GetMarshaller(), header)
+	require.Nil(t, err)
+
+	return &outport.OutportBlock{
+		ShardID: header.GetShardID(),
+		BlockData: &outport.BlockData{
+			ShardID:     header.GetShardID(),
+			HeaderBytes: headerBytes,
+			HeaderType:  string(headerType),
+			HeaderHash:  []byte("hash"),
+		},
+		HighestFinalBlockNonce: header.GetNonce(),
+	}
+}
+
+func readIndex(t *testing.T, dir string) []*SegmentInfo {
+	file, err := os.Open(filepath.Join(dir, indexFileName))
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	segments := make([]*SegmentInfo, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		info := &SegmentInfo{}
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), info))
+		segments = append(segments, info)
+	}
+
+	return segments
+}
+
+func readRecords(t *testing.T, dir string, segment string, codec recordCodec) []*record {
+	file, err := os.Open(filepath.Join(dir, segment))
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	records := make([]*record, 0)
+	reader := bufio.NewReader(file)
+	for {
+		rec, _, errDecode := codec.decodeNext(reader)
+		if errDecode != nil {
+			break
+		}
+		records = append(records, rec)
+	}
+
+	return records
+}
+
+func TestNewFileDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Path = ""
+
+		driver, err := NewFileDriver(args)
+		require.Nil(t, driver)
+		require.Equal(t, ErrEmptyPath, err)
+	})
+	t.Run("invalid max segment size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.MaxSegmentSizeInBytes = 0
+
+		driver, err := NewFileDriver(args)
+		require.Nil(t, driver)
+		require.Equal(t, ErrInvalidMaxSegmentSize, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Log = nil
+
+		driver, err := NewFileDriver(args)
+		require.Nil(t, driver)
+		require.Equal(t, core.ErrNilLogger, err)
+	})
+	t.Run("unsupported format should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Format = "xml"
+
+		driver, err := NewFileDriver(args)
+		require.Nil(t, driver)
+		require.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Path = filepath.Join(args.Path, "segments")
+
+		driver, err := NewFileDriver(args)
+		require.Nil(t, err)
+		require.False(t, driver.IsInterfaceNil())
+		require.Nil(t, driver.RegisterHandler(nil, outport.TopicSaveBlock))
+		require.FileExists(t, filepath.Join(args.Path, indexFileName))
+		require.Nil(t, driver.Close())
+	})
+}
+
+func TestFileDriver_NDJSONFormat(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	driver, _ := NewFileDriver(args)
+
+	roundsInfo := &outport.RoundsInfo{
+		ShardID:    1,
+		RoundsInfo: []*outport.RoundInfo{{Round: 9, Epoch: 2}},
+	}
+	require.Nil(t, driver.SaveRoundsInfo(roundsInfo))
+	outportBlock := createOutportBlock(t, driver, &block.Header{Nonce: 10, Epoch: 2, ShardID: 1})
+	require.Nil(t, driver.SaveBlock(outportBlock))
+	require.Nil(t, driver.FinalizedBlock(&outport.FinalizedBlock{ShardID: 1, HeaderHash: []byte("hash")}))
+	require.Nil(t, driver.SaveAccounts(&outport.Accounts{ShardID: 1, BlockTimestamp: 100}))
+	require.Nil(t, driver.SaveValidatorsRating(&outport.ValidatorsRating{ShardID: 1, Epoch: 2}))
+	require.Nil(t, driver.SaveValidatorsPubKeys(&outport.ValidatorsPubKeys{ShardID: 1, Epoch: 2}))
+	require.Nil(t, driver.SetCurrentSettings(outport.OutportConfig{ShardID: 1}))
+	require.Nil(t, driver.RevertIndexedBlock(outportBlock.BlockData))
+
+	// nothing is indexed until the segment is sealed
+	require.Empty(t, readIndex(t, args.Path))
+	require.Nil(t, driver.Close())
+	require.Nil(t, driver.Close())
+	require.Equal(t, ErrDriverIsClosed, driver.SaveRoundsInfo(roundsInfo))
+
+	segments := readIndex(t, args.Path)
+	require.Len(t, segments, 1)
+	require.Equal(t, "segment-000000000001.ndjson", segments[0].Segment)
+	require.Equal(t, uint32(0), segments[0].FirstEpoch)
+	require.Equal(t, uint32(2), segments[0].LastEpoch)
+	require.Equal(t, uint64(10), segments[0].FirstNonce)
+	require.Equal(t, uint64(10), segments[0].LastNonce)
+	require.Equal(t, uint64(8), segments[0].NumRecords)
+
+	stat, err := os.Stat(filepath.Join(args.Path, segments[0].Segment))
+	require.Nil(t, err)
+	require.Equal(t, uint64(stat.Size()), segments[0].SizeInBytes)
+
+	records := readRecords(t, args.Path, segments[0].Segment, &ndjsonCodec{})
+	require.Len(t, records, 8)
+	expectedTopics := []string{
+		outport.TopicSaveRoundsInfo,
+		outport.TopicSaveBlock,
+		outport.TopicFinalizedBlock,
+		outport.TopicSaveAccounts,
+		outport.TopicSaveValidatorsRating,
+		outport.TopicSaveValidatorsPubKeys,
+		outport.TopicSettings,
+		outport.TopicRevertIndexedBlock,
+	}
+	for idx, rec := range records {
+		require.Equal(t, expectedTopics[idx], rec.Topic)
+	}
+	require.Equal(t, uint64(10), records[1].Nonce)
+	require.Equal(t, uint32(2), records[2].Epoch)
+
+	recoveredRoundsInfo := &outport.RoundsInfo{}
+	require.Nil(t, json.Unmarshal(records[0].Payload, recoveredRoundsInfo))
+	require.Equal(t, roundsInfo, recoveredRoundsInfo)
+
+	recoveredBlock := &outport.OutportBlock{}
+	require.Nil(t, json.Unmarshal(records[1].Payload, recoveredBlock))
+	require.Equal(t, outportBlock, recoveredBlock)
+}
+
+func TestFileDriver_ProtoFormat(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.Format = FormatProto
+	driver, _ := NewFileDriver(args)
+
+	outportBlock := createOutportBlock(t, driver, &block.MetaBlock{Nonce: 7, Epoch: 1})
+	require.Nil(t, driver.SaveBlock(outportBlock))
+	require.Nil(t, driver.Close())
+
+	segments := readIndex(t, args.Path)
+	require.Len(t, segments, 1)
+	require.Equal(t, "segment-000000000001.pb", segments[0].Segment)
+
+	records := readRecords(t, args.Path, segments[0].Segment, &protoCodec{})
+	require.Len(t, records, 1)
+	require.Equal(t, outport.TopicSaveBlock, records[0].Topic)
+	require.Equal(t, uint32(1), records[0].Epoch)
+	require.Equal(t, uint64(7), records[0].Nonce)
+
+	recoveredBlock := &outport.OutportBlock{}
+	require.Nil(t, driver.GetMarshaller().Unmarshal(recoveredBlock, records[0].Payload))
+	require.Equal(t, outportBlock.BlockData.HeaderBytes, recoveredBlock.BlockData.HeaderBytes)
+	require.Equal(t, outportBlock.HighestFinalBlockNonce, recoveredBlock.HighestFinalBlockNonce)
+}
+
+func TestFileDriver_SaveBlockWithInvalidHeaderShouldError(t *testing.T) {
+	t.Parallel()
+
+	driver, _ := NewFileDriver(createMockArgs(t))
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	err := driver.SaveBlock(&outport.OutportBlock{
+		BlockData: &outport.BlockData{
+			HeaderType: "unknown",
+		},
+	})
+	require.Error(t, err)
+
+	err = driver.SaveBlock(&outport.OutportBlock{
+		BlockData: &outport.BlockData{
+			HeaderType:  string(core.ShardHeaderV1),
+			HeaderBytes: []byte("not a header"),
+		},
+	})
+	require.Error(t, err)
+}
+
+func TestFileDriver_RotateOnEpochChange(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.RotateOnEpochChange = true
+	driver, _ := NewFileDriver(args)
+
+	require.Nil(t, driver.SaveBlock(createOutportBlock(t, driver, &block.HeaderV2{Header: &block.Header{Nonce: 1, Epoch: 0}})))
+	require.Nil(t, driver.SaveBlock(createOutportBlock(t, driver, &block.HeaderV2{Header: &block.Header{Nonce: 2, Epoch: 0}})))
+	require.Nil(t, driver.SaveBlock(createOutportBlock(t, driver, &block.HeaderV2{Header: &block.Header{Nonce: 3, Epoch: 1}})))
+	require.Nil(t, driver.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: []byte("hash")}))
+
+	segments := readIndex(t, args.Path)
+	require.Len(t, segments, 1)
+	require.Equal(t, &SegmentInfo{
+		Segment:     "segment-000000000001.ndjson",
+		FirstEpoch:  0,
+		LastEpoch:   0,
+		FirstNonce:  1,
+		LastNonce:   2,
+		NumRecords:  2,
+		SizeInBytes: segments[0].SizeInBytes,
+	}, segments[0])
+
+	require.Nil(t, driver.Close())
+
+	segments = readIndex(t, args.Path)
+	require.Len(t, segments, 2)
+	require.Equal(t, "segment-000000000002.ndjson", segments[1].Segment)
+	require.Equal(t, uint32(1), segments[1].FirstEpoch)
+	require.Equal(t, uint64(3), segments[1].FirstNonce)
+	require.Equal(t, uint64(2), segments[1].NumRecords)
+}
+
+func TestFileDriver_RotateOnSize(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.MaxSegmentSizeInBytes = 1
+	driver, _ := NewFileDriver(args)
+
+	numRecords := 5
+	for i := 0; i < numRecords; i++ {
+		require.Nil(t, driver.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: []byte("hash")}))
+	}
+	require.Nil(t, driver.Close())
+
+	segments := readIndex(t, args.Path)
+	require.Len(t, segments, numRecords)
+	for _, segment := range segments {
+		require.Equal(t, uint64(1), segment.NumRecords)
+	}
+}
@@ -0,0 +1,17 @@
+package file
+
+import "errors"
+
+// ErrDriverIsClosed signals that the file driver was closed while trying to perform actions
+var ErrDriverIsClosed = errors.New("file driver is closed")
+
+// ErrEmptyPath signals that an empty segments directory path has been provided
+var ErrEmptyPath = errors.New("empty segments path")
+
+// ErrInvalidMaxSegmentSize signals that an invalid maximum segment size has been provided
+var ErrInvalidMaxSegmentSize = errors.New("invalid maximum segment size")
+
+// ErrUnsupportedFormat signals that an unsupported segment format has been provided
+var ErrUnsupportedFormat = errors.New("unsupported segment format")
+
+var errInvalidRecord = errors.New("invalid record")
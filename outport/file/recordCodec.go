@@ -0,0 +1,173 @@
+package file
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+const (
+	// FormatNDJSON writes every record as a JSON object on its own line, the payload being JSON marshalled
+	FormatNDJSON = "ndjson"
+	// FormatProto writes every record as a length-prefixed binary frame, the payload being protobuf marshalled
+	FormatProto = "proto"
+
+	ndjsonExtension = ".ndjson"
+	protoExtension  = ".pb"
+
+	frameLengthSize = 4
+	topicLengthSize = 2
+	epochSize       = 4
+	nonceSize       = 8
+)
+
+// record is the unit written in the segment files, one for each outport event
+type record struct {
+	Topic   string
+	Epoch   uint32
+	Nonce   uint64
+	Payload []byte
+}
+
+type recordCodec interface {
+	encode(rec *record) ([]byte, error)
+	decodeNext(reader *bufio.Reader) (*record, int, error)
+	extension() string
+}
+
+func createCodecAndMarshaller(format string) (recordCodec, marshal.Marshalizer, error) {
+	switch format {
+	case FormatNDJSON:
+		return &ndjsonCodec{}, &marshal.JsonMarshalizer{}, nil
+	case FormatProto:
+		return &protoCodec{}, &marshal.GogoProtoMarshalizer{}, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+}
+
+type ndjsonRecord struct {
+	Topic   string          `json:"topic"`
+	Epoch   uint32          `json:"epoch"`
+	Nonce   uint64          `json:"nonce,omitempty"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// ndjsonCodec writes each record on a line: {"topic":"SaveBlock","epoch":1,"nonce":2,"payload":{...}}
+type ndjsonCodec struct{}
+
+func (codec *ndjsonCodec) encode(rec *record) ([]byte, error) {
+	line, err := json.Marshal(&ndjsonRecord{
+		Topic:   rec.Topic,
+		Epoch:   rec.Epoch,
+		Nonce:   rec.Nonce,
+		Payload: rec.Payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(line, '\n'), nil
+}
+
+func (codec *ndjsonCodec) decodeNext(reader *bufio.Reader) (*record, int, error) {
+	line, err := reader.ReadBytes('\n')
+	if err == io.EOF && len(line) > 0 {
+		// the last line was not completely written
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rec := &ndjsonRecord{}
+	err = json.Unmarshal(line, rec)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %s", errInvalidRecord, err.Error())
+	}
+
+	return &record{
+		Topic:   rec.Topic,
+		Epoch:   rec.Epoch,
+		Nonce:   rec.Nonce,
+		Payload: rec.Payload,
+	}, len(line), nil
+}
+
+func (codec *ndjsonCodec) extension() string {
+	return ndjsonExtension
+}
+
+// protoCodec writes each record as a frame: frame length (4 bytes) | topic length (2 bytes) | topic | epoch (4 bytes) |
+// nonce (8 bytes) | protobuf payload. All the integers are big endian
+type protoCodec struct{}
+
+func (codec *protoCodec) encode(rec *record) ([]byte, error) {
+	if len(rec.Topic) > math.MaxUint16 {
+		return nil, fmt.Errorf("%w: topic too long", errInvalidRecord)
+	}
+
+	frameLength := topicLengthSize + len(rec.Topic) + epochSize + nonceSize + len(rec.Payload)
+	if uint64(frameLength) > math.MaxUint32 {
+		return nil, fmt.Errorf("%w: payload too large", errInvalidRecord)
+	}
+
+	frame := make([]byte, 0, frameLengthSize+frameLength)
+	frame = binary.BigEndian.AppendUint32(frame, uint32(frameLength))
+	frame = binary.BigEndian.AppendUint16(frame, uint16(len(rec.Topic)))
+	frame = append(frame, rec.Topic...)
+	frame = binary.BigEndian.AppendUint32(frame, rec.Epoch)
+	frame = binary.BigEndian.AppendUint64(frame, rec.Nonce)
+	frame = append(frame, rec.Payload...)
+
+	return frame, nil
+}
+
+func (codec *protoCodec) decodeNext(reader *bufio.Reader) (*record, int, error) {
+	lengthBytes := make([]byte, frameLengthSize)
+	n, err := io.ReadFull(reader, lengthBytes)
+	if err == io.EOF {
+		return nil, 0, err
+	}
+	if err != nil {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+
+	frameLength := binary.BigEndian.Uint32(lengthBytes)
+	if frameLength < topicLengthSize+epochSize+nonceSize {
+		return nil, 0, fmt.Errorf("%w: frame too short", errInvalidRecord)
+	}
+
+	frame := make([]byte, frameLength)
+	_, err = io.ReadFull(reader, frame)
+	if err != nil {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+
+	topicLength := int(binary.BigEndian.Uint16(frame[:topicLengthSize]))
+	if topicLengthSize+topicLength+epochSize+nonceSize > len(frame) {
+		return nil, 0, fmt.Errorf("%w: topic length out of bounds", errInvalidRecord)
+	}
+
+	offset := topicLengthSize
+	rec := &record{
+		Topic: string(frame[offset : offset+topicLength]),
+	}
+	offset += topicLength
+	rec.Epoch = binary.BigEndian.Uint32(frame[offset : offset+epochSize])
+	offset += epochSize
+	rec.Nonce = binary.BigEndian.Uint64(frame[offset : offset+nonceSize])
+	offset += nonceSize
+	rec.Payload = frame[offset:]
+
+	return rec, n + len(frame), nil
+}
+
+func (codec *protoCodec) extension() string {
+	return protoExtension
+}
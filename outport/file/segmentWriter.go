@@ -0,0 +1,341 @@
+package file
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+const (
+	segmentPrefix   = "segment-"
+	indexFileName   = "index.ndjson"
+	filePermissions = 0644
+	dirPermissions  = 0755
+)
+
+// SegmentInfo describes a sealed segment file. The sealed segments are listed, one per line, in the index file
+// so the downstream jobs know which files are complete and can be ingested
+type SegmentInfo struct {
+	Segment     string `json:"segment"`
+	FirstEpoch  uint32 `json:"firstEpoch"`
+	LastEpoch   uint32 `json:"lastEpoch"`
+	FirstNonce  uint64 `json:"firstNonce,omitempty"`
+	LastNonce   uint64 `json:"lastNonce,omitempty"`
+	NumRecords  uint64 `json:"numRecords"`
+	SizeInBytes uint64 `json:"sizeInBytes"`
+}
+
+func (info *SegmentInfo) addRecord(rec *record, size int) {
+	if info.NumRecords == 0 {
+		info.FirstEpoch = rec.Epoch
+	}
+	info.LastEpoch = rec.Epoch
+	if rec.Nonce > 0 {
+		if info.FirstNonce == 0 {
+			info.FirstNonce = rec.Nonce
+		}
+		info.LastNonce = rec.Nonce
+	}
+	info.NumRecords++
+	info.SizeInBytes += uint64(size)
+}
+
+// segmentWriter appends the records to the current segment file, sealing it and opening a new one when it reaches
+// the maximum size or, if configured, when the epoch changes. It is not concurrent safe
+type segmentWriter struct {
+	dir                 string
+	codec               recordCodec
+	maxSegmentSize      uint64
+	rotateOnEpochChange bool
+	log                 core.Logger
+
+	nextSequence uint64
+	segment      *os.File
+	info         *SegmentInfo
+	index        *os.File
+}
+
+func newSegmentWriter(
+	dir string,
+	codec recordCodec,
+	maxSegmentSize uint64,
+	rotateOnEpochChange bool,
+	log core.Logger,
+) (*segmentWriter, error) {
+	err := os.MkdirAll(dir, dirPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	sw := &segmentWriter{
+		dir:                 dir,
+		codec:               codec,
+		maxSegmentSize:      maxSegmentSize,
+		rotateOnEpochChange: rotateOnEpochChange,
+		log:                 log,
+		nextSequence:        1,
+	}
+
+	sw.index, err = os.OpenFile(filepath.Join(dir, indexFileName), os.O_CREATE|os.O_APPEND|os.O_WRONLY, filePermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sw.sealLeftoverSegments()
+	if err != nil {
+		_ = sw.index.Close()
+		return nil, err
+	}
+
+	return sw, nil
+}
+
+// sealLeftoverSegments adds to the index the segments written before a crash or an unclean shutdown
+func (sw *segmentWriter) sealLeftoverSegments() error {
+	indexed, err := readIndexedSegments(filepath.Join(sw.dir, indexFileName))
+	if err != nil {
+		return err
+	}
+
+	sequences, err := sw.listSegments()
+	if err != nil {
+		return err
+	}
+
+	for _, sequence := range sequences {
+		sw.nextSequence = sequence + 1
+
+		name := sw.segmentName(sequence)
+		_, isIndexed := indexed[name]
+		if isIndexed {
+			continue
+		}
+
+		info, errRecover := sw.recoverSegment(name)
+		if errRecover != nil {
+			return fmt.Errorf("%w while recovering segment %s", errRecover, name)
+		}
+		if info == nil {
+			continue
+		}
+
+		err = sw.appendToIndex(info)
+		if err != nil {
+			return err
+		}
+
+		sw.log.Info("file driver: sealed leftover segment", "segment", name, "num records", info.NumRecords)
+	}
+
+	return nil
+}
+
+func readIndexedSegments(indexPath string) (map[string]struct{}, error) {
+	indexed := make(map[string]struct{})
+	file, err := os.Open(indexPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		info := &SegmentInfo{}
+		err = json.Unmarshal(scanner.Bytes(), info)
+		if err != nil {
+			// an index line can only be corrupted by a crash while writing it, the segment will be indexed again
+			continue
+		}
+
+		indexed[info.Segment] = struct{}{}
+	}
+
+	return indexed, scanner.Err()
+}
+
+func (sw *segmentWriter) listSegments() ([]uint64, error) {
+	entries, err := os.ReadDir(sw.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	sequences := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, segmentPrefix) || !strings.HasSuffix(name, sw.codec.extension()) {
+			continue
+		}
+
+		sequenceString := strings.TrimSuffix(strings.TrimPrefix(name, segmentPrefix), sw.codec.extension())
+		sequence, errParse := strconv.ParseUint(sequenceString, 10, 64)
+		if errParse != nil {
+			continue
+		}
+
+		sequences = append(sequences, sequence)
+	}
+
+	sort.Slice(sequences, func(i, j int) bool {
+		return sequences[i] < sequences[j]
+	})
+
+	return sequences, nil
+}
+
+// recoverSegment reads all the complete records of the segment and truncates a partially written last record
+func (sw *segmentWriter) recoverSegment(name string) (*SegmentInfo, error) {
+	path := filepath.Join(sw.dir, name)
+	file, err := os.OpenFile(path, os.O_RDWR, filePermissions)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	info := &SegmentInfo{
+		Segment: name,
+	}
+	reader := bufio.NewReader(file)
+	for {
+		rec, size, errDecode := sw.codec.decodeNext(reader)
+		if errDecode == io.EOF {
+			break
+		}
+		if errDecode != nil {
+			sw.log.Warn("file driver: truncating the partially written segment",
+				"segment", name, "valid size", info.SizeInBytes, "error", errDecode)
+			err = file.Truncate(int64(info.SizeInBytes))
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		info.addRecord(rec, size)
+	}
+
+	if info.NumRecords == 0 {
+		return nil, os.Remove(path)
+	}
+
+	return info, file.Sync()
+}
+
+func (sw *segmentWriter) write(rec *record) error {
+	encoded, err := sw.codec.encode(rec)
+	if err != nil {
+		return err
+	}
+
+	if sw.shouldRotate(rec, len(encoded)) {
+		err = sw.seal()
+		if err != nil {
+			return err
+		}
+	}
+
+	if sw.segment == nil {
+		err = sw.openNewSegment()
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = sw.segment.Write(encoded)
+	if err != nil {
+		return err
+	}
+
+	sw.info.addRecord(rec, len(encoded))
+
+	return nil
+}
+
+func (sw *segmentWriter) shouldRotate(rec *record, size int) bool {
+	if sw.segment == nil {
+		return false
+	}
+	if sw.rotateOnEpochChange && rec.Epoch != sw.info.LastEpoch {
+		return true
+	}
+
+	return sw.info.SizeInBytes+uint64(size) > sw.maxSegmentSize
+}
+
+func (sw *segmentWriter) openNewSegment() error {
+	name := sw.segmentName(sw.nextSequence)
+	segment, err := os.OpenFile(filepath.Join(sw.dir, name), os.O_CREATE|os.O_EXCL|os.O_WRONLY, filePermissions)
+	if err != nil {
+		return err
+	}
+
+	sw.nextSequence++
+	sw.segment = segment
+	sw.info = &SegmentInfo{
+		Segment: name,
+	}
+
+	return nil
+}
+
+// seal flushes and closes the current segment, then adds it to the index
+func (sw *segmentWriter) seal() error {
+	if sw.segment == nil {
+		return nil
+	}
+
+	err := sw.segment.Sync()
+	if err != nil {
+		return err
+	}
+
+	err = sw.segment.Close()
+	if err != nil {
+		return err
+	}
+
+	info := sw.info
+	sw.segment = nil
+	sw.info = nil
+
+	return sw.appendToIndex(info)
+}
+
+func (sw *segmentWriter) appendToIndex(info *SegmentInfo) error {
+	line, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	_, err = sw.index.Write(append(line, '\n'))
+	if err != nil {
+		return err
+	}
+
+	return sw.index.Sync()
+}
+
+func (sw *segmentWriter) segmentName(sequence uint64) string {
+	return fmt.Sprintf("%s%012d%s", segmentPrefix, sequence, sw.codec.extension())
+}
+
+func (sw *segmentWriter) close() error {
+	err := sw.seal()
+	if err != nil {
+		_ = sw.index.Close()
+		return err
+	}
+
+	return sw.index.Close()
+}
@@ -0,0 +1,118 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeAndCrash(t *testing.T, dir string, codec recordCodec, numRecords int, garbage []byte) {
+	sw, err := newSegmentWriter(dir, codec, 1024*1024, false, log)
+	require.Nil(t, err)
+
+	for i := 0; i < numRecords; i++ {
+		require.Nil(t, sw.write(&record{Topic: "topic", Epoch: 3, Nonce: uint64(i + 1), Payload: []byte(`{"a":1}`)}))
+	}
+
+	if sw.segment == nil {
+		require.Nil(t, sw.openNewSegment())
+	}
+
+	// the segment is never sealed, as it would happen on a crash
+	_, err = sw.segment.Write(garbage)
+	require.Nil(t, err)
+	require.Nil(t, sw.segment.Close())
+	require.Nil(t, sw.index.Close())
+}
+
+func TestSegmentWriter_SealLeftoverSegments(t *testing.T) {
+	t.Parallel()
+
+	t.Run("partially written ndjson record should be truncated", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		codec := &ndjsonCodec{}
+		writeAndCrash(t, dir, codec, 2, []byte(`{"topic":"SaveBl`))
+
+		sw, err := newSegmentWriter(dir, codec, 1024*1024, false, log)
+		require.Nil(t, err)
+		require.Equal(t, uint64(2), sw.nextSequence)
+
+		segments := readIndex(t, dir)
+		require.Len(t, segments, 1)
+		require.Equal(t, uint64(2), segments[0].NumRecords)
+		require.Equal(t, uint64(1), segments[0].FirstNonce)
+		require.Equal(t, uint64(2), segments[0].LastNonce)
+		require.Equal(t, uint32(3), segments[0].LastEpoch)
+
+		stat, err := os.Stat(filepath.Join(dir, segments[0].Segment))
+		require.Nil(t, err)
+		require.Equal(t, uint64(stat.Size()), segments[0].SizeInBytes)
+
+		// the new records go in a new segment
+		require.Nil(t, sw.write(&record{Topic: "topic", Epoch: 3, Nonce: 3}))
+		require.Nil(t, sw.close())
+
+		segments = readIndex(t, dir)
+		require.Len(t, segments, 2)
+		require.Equal(t, "segment-000000000002.ndjson", segments[1].Segment)
+		require.Equal(t, uint64(3), segments[1].FirstNonce)
+	})
+	t.Run("partially written proto frame should be truncated", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		codec := &protoCodec{}
+		writeAndCrash(t, dir, codec, 3, []byte{0, 0, 1, 0, 5})
+
+		sw, err := newSegmentWriter(dir, codec, 1024*1024, false, log)
+		require.Nil(t, err)
+		require.Nil(t, sw.close())
+
+		segments := readIndex(t, dir)
+		require.Len(t, segments, 1)
+		require.Equal(t, uint64(3), segments[0].NumRecords)
+
+		records := readRecords(t, dir, segments[0].Segment, codec)
+		require.Len(t, records, 3)
+		require.Equal(t, []byte(`{"a":1}`), records[2].Payload)
+	})
+	t.Run("leftover segment without complete records should be removed", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		codec := &ndjsonCodec{}
+		writeAndCrash(t, dir, codec, 0, []byte(`{"topic"`))
+
+		sw, err := newSegmentWriter(dir, codec, 1024*1024, false, log)
+		require.Nil(t, err)
+		require.Nil(t, sw.close())
+
+		require.Empty(t, readIndex(t, dir))
+		require.NoFileExists(t, filepath.Join(dir, "segment-000000000001.ndjson"))
+	})
+	t.Run("indexed segments should not be indexed again", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		codec := &ndjsonCodec{}
+		sw, err := newSegmentWriter(dir, codec, 1024*1024, false, log)
+		require.Nil(t, err)
+		require.Nil(t, sw.write(&record{Topic: "topic"}))
+		require.Nil(t, sw.close())
+
+		// other files are ignored
+		require.Nil(t, os.WriteFile(filepath.Join(dir, "segment-abc.ndjson"), []byte("{}"), filePermissions))
+		require.Nil(t, os.WriteFile(filepath.Join(dir, "segment-000000000007.pb"), []byte("{}"), filePermissions))
+
+		sw, err = newSegmentWriter(dir, codec, 1024*1024, false, log)
+		require.Nil(t, err)
+		require.Equal(t, uint64(2), sw.nextSequence)
+		require.Nil(t, sw.close())
+
+		require.Len(t, readIndex(t, dir), 1)
+	})
+}
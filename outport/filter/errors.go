@@ -0,0 +1,9 @@
+package filter
+
+import "errors"
+
+// ErrInvalidTransactionType signals that an unknown transaction type was provided in the filter configuration
+var ErrInvalidTransactionType = errors.New("invalid transaction type")
+
+// ErrInvalidAddress signals that an address provided in the filter configuration could not be decoded
+var ErrInvalidAddress = errors.New("invalid address")
@@ -0,0 +1,383 @@
+package filter
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+var knownTxTypes = map[transaction.TxType]struct{}{
+	transaction.TxTypeNormal:   {},
+	transaction.TxTypeUnsigned: {},
+	transaction.TxTypeReward:   {},
+	transaction.TxTypeInvalid:  {},
+}
+
+// ArgsOutportBlockFilter holds the arguments needed for creating a new outportBlockFilter
+type ArgsOutportBlockFilter struct {
+	Config           config.OutportFilterConfig
+	AddressConverter core.PubkeyConverter
+}
+
+// logMatch holds what the log of a transaction matched
+type logMatch struct {
+	hasAddress bool
+	hasEvent   bool
+}
+
+// outportBlockFilter reduces the transaction pool and the altered accounts of an outport block to the entries
+// matching the configured addresses, tokens, event identifiers and transaction types. All the configured criteria
+// must match, while an entry matches a criterion if it matches any of its values
+type outportBlockFilter struct {
+	addresses        map[string]struct{}
+	encodedAddresses map[string]struct{}
+	tokens           map[string]struct{}
+	identifiers      map[string]struct{}
+	txTypes          map[transaction.TxType]struct{}
+	addressConverter core.PubkeyConverter
+}
+
+// NewOutportBlockFilter will create a new instance of outportBlockFilter
+func NewOutportBlockFilter(args ArgsOutportBlockFilter) (*outportBlockFilter, error) {
+	if check.IfNil(args.AddressConverter) {
+		return nil, core.ErrNilPubkeyConverter
+	}
+
+	obf := &outportBlockFilter{
+		addresses:        make(map[string]struct{}, len(args.Config.Addresses)),
+		encodedAddresses: make(map[string]struct{}, len(args.Config.Addresses)),
+		tokens:           sliceToMap(args.Config.Tokens),
+		identifiers:      sliceToMap(args.Config.EventIdentifiers),
+		txTypes:          make(map[transaction.TxType]struct{}, len(args.Config.TransactionTypes)),
+		addressConverter: args.AddressConverter,
+	}
+
+	for _, address := range args.Config.Addresses {
+		decoded, err := args.AddressConverter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: %s", ErrInvalidAddress, address, err.Error())
+		}
+
+		obf.addresses[string(decoded)] = struct{}{}
+		obf.encodedAddresses[address] = struct{}{}
+	}
+
+	for _, txType := range args.Config.TransactionTypes {
+		_, isKnown := knownTxTypes[transaction.TxType(txType)]
+		if !isKnown {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTransactionType, txType)
+		}
+
+		obf.txTypes[transaction.TxType(txType)] = struct{}{}
+	}
+
+	return obf, nil
+}
+
+func sliceToMap(values []string) map[string]struct{} {
+	result := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		result[value] = struct{}{}
+	}
+
+	return result
+}
+
+// FilterOutportBlock returns a reduced copy of the provided outport block. The block metadata is kept as it is, so
+// the consumers can still order the blocks. The provided block is not altered, as it is shared between the drivers
+func (obf *outportBlockFilter) FilterOutportBlock(outportBlock *outport.OutportBlock) *outport.OutportBlock {
+	if outportBlock == nil {
+		return nil
+	}
+
+	filteredPool := obf.filterTransactionPool(outportBlock.TransactionPool)
+
+	return &outport.OutportBlock{
+		ShardID:                outportBlock.ShardID,
+		BlockData:              outportBlock.BlockData,
+		TransactionPool:        filteredPool,
+		HeaderGasConsumption:   outportBlock.HeaderGasConsumption,
+		AlteredAccounts:        obf.filterAlteredAccounts(outportBlock.AlteredAccounts, filteredPool),
+		NotarizedHeadersHashes: outportBlock.NotarizedHeadersHashes,
+		NumberOfShards:         outportBlock.NumberOfShards,
+		SignersIndexes:         outportBlock.SignersIndexes,
+		HighestFinalBlockNonce: outportBlock.HighestFinalBlockNonce,
+		HighestFinalBlockHash:  outportBlock.HighestFinalBlockHash,
+	}
+}
+
+func (obf *outportBlockFilter) filterTransactionPool(pool *outport.TransactionPool) *outport.TransactionPool {
+	if pool == nil {
+		return nil
+	}
+
+	logsMatches := obf.matchLogs(pool.Logs)
+	keptHashes := make(map[string]struct{})
+	filteredPool := &outport.TransactionPool{
+		Transactions:         make(map[string]*outport.TxInfo),
+		SmartContractResults: make(map[string]*outport.SCRInfo),
+		Rewards:              make(map[string]*outport.RewardInfo),
+		Receipts:             make(map[string]*receipt.Receipt),
+		InvalidTxs:           make(map[string]*outport.TxInfo),
+		Logs:                 make([]*outport.LogData, 0),
+
+		ScheduledExecutedSCRSHashesPrevBlock:       pool.ScheduledExecutedSCRSHashesPrevBlock,
+		ScheduledExecutedInvalidTxsHashesPrevBlock: pool.ScheduledExecutedInvalidTxsHashesPrevBlock,
+	}
+
+	for hash, txInfo := range pool.Transactions {
+		tx := txInfo.GetTransaction()
+		if obf.isMatch(transaction.TxTypeNormal, logsMatches[hash], tx.GetSndAddr(), tx.GetRcvAddr()) {
+			filteredPool.Transactions[hash] = txInfo
+			keptHashes[hash] = struct{}{}
+		}
+	}
+
+	for hash, txInfo := range pool.InvalidTxs {
+		tx := txInfo.GetTransaction()
+		if obf.isMatch(transaction.TxTypeInvalid, logsMatches[hash], tx.GetSndAddr(), tx.GetRcvAddr()) {
+			filteredPool.InvalidTxs[hash] = txInfo
+			keptHashes[hash] = struct{}{}
+		}
+	}
+
+	for hash, rewardInfo := range pool.Rewards {
+		if obf.isMatch(transaction.TxTypeReward, logsMatches[hash], rewardInfo.GetReward().GetRcvAddr()) {
+			filteredPool.Rewards[hash] = rewardInfo
+			keptHashes[hash] = struct{}{}
+		}
+	}
+
+	// the results of a kept transaction are kept as well, so the consumer can follow its execution
+	for hash, scrInfo := range pool.SmartContractResults {
+		scr := scrInfo.GetSmartContractResult()
+		_, isOriginalTxKept := keptHashes[hex.EncodeToString(scr.GetOriginalTxHash())]
+		isMatch := obf.isMatch(transaction.TxTypeUnsigned, logsMatches[hash], scr.GetSndAddr(), scr.GetRcvAddr(), scr.GetOriginalSender())
+		if isOriginalTxKept || isMatch {
+			filteredPool.SmartContractResults[hash] = scrInfo
+			keptHashes[hash] = struct{}{}
+		}
+	}
+
+	for hash, rec := range pool.Receipts {
+		_, isTxKept := keptHashes[hex.EncodeToString(rec.GetTxHash())]
+		if isTxKept {
+			filteredPool.Receipts[hash] = rec
+		}
+	}
+
+	for _, logData := range pool.Logs {
+		_, isTxKept := keptHashes[logData.GetTxHash()]
+		isOrphanMatch := !isInPool(pool, logData.GetTxHash()) && obf.matchesContent(logsMatches[logData.GetTxHash()])
+		if isTxKept || isOrphanMatch {
+			filteredPool.Logs = append(filteredPool.Logs, logData)
+		}
+	}
+
+	return filteredPool
+}
+
+func isInPool(pool *outport.TransactionPool, hash string) bool {
+	_, found := pool.Transactions[hash]
+	if found {
+		return true
+	}
+	_, found = pool.SmartContractResults[hash]
+	if found {
+		return true
+	}
+	_, found = pool.InvalidTxs[hash]
+	if found {
+		return true
+	}
+	_, found = pool.Rewards[hash]
+
+	return found
+}
+
+func (obf *outportBlockFilter) matchLogs(logs []*outport.LogData) map[string]logMatch {
+	matches := make(map[string]logMatch, len(logs))
+	for _, logData := range logs {
+		match := matches[logData.GetTxHash()]
+		log := logData.GetLog()
+		if obf.isAddressMatch(log.GetAddress()) {
+			match.hasAddress = true
+		}
+
+		for _, event := range log.GetEvents() {
+			if obf.isAddressMatch(event.GetAddress()) {
+				match.hasAddress = true
+			}
+			if obf.isEventMatch(event) {
+				match.hasEvent = true
+			}
+		}
+
+		matches[logData.GetTxHash()] = match
+	}
+
+	return matches
+}
+
+func (obf *outportBlockFilter) isEventMatch(event *transaction.Event) bool {
+	if len(obf.identifiers) > 0 {
+		_, found := obf.identifiers[string(event.GetIdentifier())]
+		if !found {
+			return false
+		}
+	}
+	if len(obf.tokens) > 0 {
+		// the token events hold the token identifier as the first topic
+		topics := event.GetTopics()
+		if len(topics) == 0 {
+			return false
+		}
+		_, found := obf.tokens[string(topics[0])]
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (obf *outportBlockFilter) isMatch(txType transaction.TxType, match logMatch, addresses ...[]byte) bool {
+	if len(obf.txTypes) > 0 {
+		_, found := obf.txTypes[txType]
+		if !found {
+			return false
+		}
+	}
+
+	return obf.matchesContent(match, addresses...)
+}
+
+func (obf *outportBlockFilter) matchesContent(match logMatch, addresses ...[]byte) bool {
+	hasEventFilter := len(obf.tokens) > 0 || len(obf.identifiers) > 0
+	if hasEventFilter && !match.hasEvent {
+		return false
+	}
+	if len(obf.addresses) == 0 || match.hasAddress {
+		return true
+	}
+
+	for _, address := range addresses {
+		if obf.isAddressMatch(address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (obf *outportBlockFilter) isAddressMatch(address []byte) bool {
+	_, found := obf.addresses[string(address)]
+	return found
+}
+
+// filterAlteredAccounts keeps the accounts having a configured address or holding a configured token. When filtering
+// by event identifiers or transaction types, only the accounts involved in the kept entries are kept
+func (obf *outportBlockFilter) filterAlteredAccounts(
+	accounts map[string]*alteredAccount.AlteredAccount,
+	filteredPool *outport.TransactionPool,
+) map[string]*alteredAccount.AlteredAccount {
+	hasContentFilter := len(obf.encodedAddresses) > 0 || len(obf.tokens) > 0
+	hasEntriesFilter := len(obf.identifiers) > 0 || len(obf.txTypes) > 0
+	if !hasContentFilter && !hasEntriesFilter {
+		return accounts
+	}
+
+	var involvedAddresses map[string]struct{}
+	if hasEntriesFilter {
+		involvedAddresses = obf.getInvolvedAddresses(filteredPool)
+	}
+
+	filteredAccounts := make(map[string]*alteredAccount.AlteredAccount)
+	for address, account := range accounts {
+		if hasEntriesFilter {
+			_, isInvolved := involvedAddresses[address]
+			if !isInvolved {
+				continue
+			}
+		}
+		if hasContentFilter && !obf.isAlteredAccountMatch(address, account) {
+			continue
+		}
+
+		filteredAccounts[address] = account
+	}
+
+	return filteredAccounts
+}
+
+// getInvolvedAddresses returns the encoded addresses of the senders, the receivers and the log emitters of the
+// provided transaction pool
+func (obf *outportBlockFilter) getInvolvedAddresses(pool *outport.TransactionPool) map[string]struct{} {
+	involvedAddresses := make(map[string]struct{})
+	addAddresses := func(addresses ...[]byte) {
+		for _, address := range addresses {
+			if len(address) == 0 {
+				continue
+			}
+			encoded, err := obf.addressConverter.Encode(address)
+			if err != nil {
+				continue
+			}
+			involvedAddresses[encoded] = struct{}{}
+		}
+	}
+
+	if pool == nil {
+		return involvedAddresses
+	}
+
+	for _, txInfo := range pool.Transactions {
+		addAddresses(txInfo.GetTransaction().GetSndAddr(), txInfo.GetTransaction().GetRcvAddr())
+	}
+	for _, txInfo := range pool.InvalidTxs {
+		addAddresses(txInfo.GetTransaction().GetSndAddr(), txInfo.GetTransaction().GetRcvAddr())
+	}
+	for _, rewardInfo := range pool.Rewards {
+		addAddresses(rewardInfo.GetReward().GetRcvAddr())
+	}
+	for _, scrInfo := range pool.SmartContractResults {
+		scr := scrInfo.GetSmartContractResult()
+		addAddresses(scr.GetSndAddr(), scr.GetRcvAddr(), scr.GetOriginalSender())
+	}
+	for _, logData := range pool.Logs {
+		addAddresses(logData.GetLog().GetAddress())
+		for _, event := range logData.GetLog().GetEvents() {
+			addAddresses(event.GetAddress())
+		}
+	}
+
+	return involvedAddresses
+}
+
+func (obf *outportBlockFilter) isAlteredAccountMatch(address string, account *alteredAccount.AlteredAccount) bool {
+	_, found := obf.encodedAddresses[address]
+	if found {
+		return true
+	}
+
+	for _, token := range account.GetTokens() {
+		_, found = obf.tokens[token.GetIdentifier()]
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (obf *outportBlockFilter) IsInterfaceNil() bool {
+	return obf == nil
+}
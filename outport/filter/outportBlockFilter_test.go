@@ -0,0 +1,332 @@
+package filter
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice    = []byte("alice")
+	bob      = []byte("bob")
+	carol    = []byte("carol")
+	contract = []byte("contract")
+	eve      = []byte("eve")
+
+	txHash1      = []byte("tx1")
+	txHash2      = []byte("tx2")
+	invalidHash  = []byte("invalid")
+	scrHash1     = []byte("scr1")
+	scrHash2     = []byte("scr2")
+	rewardHash   = []byte("reward")
+	orphanHash   = []byte("orphan")
+	receiptHash1 = "receipt1"
+	receiptHash2 = "receipt2"
+)
+
+func encode(value []byte) string {
+	return hex.EncodeToString(value)
+}
+
+func createMockArgs(cfg config.OutportFilterConfig) ArgsOutportBlockFilter {
+	cfg.Enabled = true
+	return ArgsOutportBlockFilter{
+		Config:           cfg,
+		AddressConverter: testscommon.NewPubkeyConverterMock(32),
+	}
+}
+
+func createOutportBlock() *outport.OutportBlock {
+	return &outport.OutportBlock{
+		ShardID:                1,
+		BlockData:              &outport.BlockData{HeaderHash: []byte("header hash")},
+		HeaderGasConsumption:   &outport.HeaderGasConsumption{GasProvided: 10},
+		NotarizedHeadersHashes: []string{"notarized"},
+		NumberOfShards:         3,
+		SignersIndexes:         []uint64{1, 2},
+		HighestFinalBlockNonce: 5,
+		HighestFinalBlockHash:  []byte("final hash"),
+		TransactionPool: &outport.TransactionPool{
+			Transactions: map[string]*outport.TxInfo{
+				encode(txHash1): {Transaction: &transaction.Transaction{SndAddr: alice, RcvAddr: contract}},
+				encode(txHash2): {Transaction: &transaction.Transaction{SndAddr: bob, RcvAddr: carol}},
+			},
+			InvalidTxs: map[string]*outport.TxInfo{
+				encode(invalidHash): {Transaction: &transaction.Transaction{SndAddr: alice, RcvAddr: bob}},
+			},
+			SmartContractResults: map[string]*outport.SCRInfo{
+				encode(scrHash1): {SmartContractResult: &smartContractResult.SmartContractResult{
+					SndAddr:        contract,
+					RcvAddr:        carol,
+					OriginalTxHash: txHash1,
+				}},
+				encode(scrHash2): {SmartContractResult: &smartContractResult.SmartContractResult{
+					SndAddr:        carol,
+					RcvAddr:        bob,
+					OriginalTxHash: txHash2,
+				}},
+			},
+			Rewards: map[string]*outport.RewardInfo{
+				encode(rewardHash): {Reward: &rewardTx.RewardTx{RcvAddr: alice}},
+			},
+			Receipts: map[string]*receipt.Receipt{
+				receiptHash1: {TxHash: txHash1},
+				receiptHash2: {TxHash: txHash2},
+			},
+			Logs: []*outport.LogData{
+				{
+					TxHash: encode(txHash1),
+					Log: &transaction.Log{
+						Address: contract,
+						Events: []*transaction.Event{
+							{
+								Address:    contract,
+								Identifier: []byte(core.BuiltInFunctionESDTTransfer),
+								Topics:     [][]byte{[]byte("TKN-123456")},
+							},
+						},
+					},
+				},
+				{
+					TxHash: encode(orphanHash),
+					Log: &transaction.Log{
+						Address: eve,
+						Events: []*transaction.Event{
+							{
+								Address:    eve,
+								Identifier: []byte(core.WriteLogIdentifier),
+							},
+						},
+					},
+				},
+			},
+			ScheduledExecutedSCRSHashesPrevBlock: []string{"scheduled"},
+		},
+		AlteredAccounts: map[string]*alteredAccount.AlteredAccount{
+			encode(alice): {Address: encode(alice)},
+			encode(bob):   {Address: encode(bob)},
+			encode(eve): {
+				Address: encode(eve),
+				Tokens:  []*alteredAccount.AccountTokenData{{Identifier: "TKN-123456"}},
+			},
+		},
+	}
+}
+
+func requireKeys(t *testing.T, values interface{}, expectedKeys ...[]byte) {
+	require.Len(t, values, len(expectedKeys))
+	for _, key := range expectedKeys {
+		require.Contains(t, values, encode(key))
+	}
+}
+
+func requireLogs(t *testing.T, logs []*outport.LogData, expectedHashes ...[]byte) {
+	require.Len(t, logs, len(expectedHashes))
+	for idx, hash := range expectedHashes {
+		require.Equal(t, encode(hash), logs[idx].TxHash)
+	}
+}
+
+func requireMetadata(t *testing.T, original *outport.OutportBlock, filtered *outport.OutportBlock) {
+	require.Equal(t, original.ShardID, filtered.ShardID)
+	require.True(t, original.BlockData == filtered.BlockData)
+	require.True(t, original.HeaderGasConsumption == filtered.HeaderGasConsumption)
+	require.Equal(t, original.NotarizedHeadersHashes, filtered.NotarizedHeadersHashes)
+	require.Equal(t, original.NumberOfShards, filtered.NumberOfShards)
+	require.Equal(t, original.SignersIndexes, filtered.SignersIndexes)
+	require.Equal(t, original.HighestFinalBlockNonce, filtered.HighestFinalBlockNonce)
+	require.Equal(t, original.HighestFinalBlockHash, filtered.HighestFinalBlockHash)
+	require.Equal(t, original.TransactionPool.ScheduledExecutedSCRSHashesPrevBlock, filtered.TransactionPool.ScheduledExecutedSCRSHashesPrevBlock)
+}
+
+func TestNewOutportBlockFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(config.OutportFilterConfig{})
+		args.AddressConverter = nil
+
+		obf, err := NewOutportBlockFilter(args)
+		require.Nil(t, obf)
+		require.Equal(t, core.ErrNilPubkeyConverter, err)
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		obf, err := NewOutportBlockFilter(createMockArgs(config.OutportFilterConfig{
+			Addresses: []string{"not an address"},
+		}))
+		require.Nil(t, obf)
+		require.ErrorIs(t, err, ErrInvalidAddress)
+		require.Contains(t, err.Error(), "not an address")
+	})
+	t.Run("invalid transaction type should error", func(t *testing.T) {
+		t.Parallel()
+
+		obf, err := NewOutportBlockFilter(createMockArgs(config.OutportFilterConfig{
+			TransactionTypes: []string{"normal", "receipt"},
+		}))
+		require.Nil(t, obf)
+		require.ErrorIs(t, err, ErrInvalidTransactionType)
+		require.Contains(t, err.Error(), "receipt")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		obf, err := NewOutportBlockFilter(createMockArgs(config.OutportFilterConfig{
+			Addresses:        []string{encode(alice)},
+			Tokens:           []string{"TKN-123456"},
+			EventIdentifiers: []string{core.BuiltInFunctionESDTTransfer},
+			TransactionTypes: []string{"normal", "unsigned", "reward", "invalid"},
+		}))
+		require.Nil(t, err)
+		require.False(t, obf.IsInterfaceNil())
+	})
+}
+
+func TestOutportBlockFilter_FilterOutportBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil block and nil pool", func(t *testing.T) {
+		t.Parallel()
+
+		obf, _ := NewOutportBlockFilter(createMockArgs(config.OutportFilterConfig{}))
+		require.Nil(t, obf.FilterOutportBlock(nil))
+
+		filtered := obf.FilterOutportBlock(&outport.OutportBlock{HighestFinalBlockNonce: 3})
+		require.Nil(t, filtered.TransactionPool)
+		require.Equal(t, uint64(3), filtered.HighestFinalBlockNonce)
+	})
+	t.Run("by address", func(t *testing.T) {
+		t.Parallel()
+
+		obf, _ := NewOutportBlockFilter(createMockArgs(config.OutportFilterConfig{
+			Addresses: []string{encode(alice)},
+		}))
+		original := createOutportBlock()
+		filtered := obf.FilterOutportBlock(original)
+
+		pool := filtered.TransactionPool
+		requireKeys(t, pool.Transactions, txHash1)
+		requireKeys(t, pool.InvalidTxs, invalidHash)
+		requireKeys(t, pool.Rewards, rewardHash)
+		// the results of the kept transaction are kept as well
+		requireKeys(t, pool.SmartContractResults, scrHash1)
+		require.Len(t, pool.Receipts, 1)
+		require.Contains(t, pool.Receipts, receiptHash1)
+		requireLogs(t, pool.Logs, txHash1)
+		requireKeys(t, filtered.AlteredAccounts, alice)
+		requireMetadata(t, original, filtered)
+
+		// the provided block is not altered
+		require.Len(t, original.TransactionPool.Transactions, 2)
+		require.Len(t, original.TransactionPool.Logs, 2)
+		require.Len(t, original.AlteredAccounts, 3)
+	})
+	t.Run("by log event address", func(t *testing.T) {
+		t.Parallel()
+
+		obf, _ := NewOutportBlockFilter(createMockArgs(config.OutportFilterConfig{
+			Addresses: []string{encode(eve)},
+		}))
+		filtered := obf.FilterOutportBlock(createOutportBlock())
+
+		pool := filtered.TransactionPool
+		require.Empty(t, pool.Transactions)
+		require.Empty(t, pool.SmartContractResults)
+		requireLogs(t, pool.Logs, orphanHash)
+		requireKeys(t, filtered.AlteredAccounts, eve)
+	})
+	t.Run("by token", func(t *testing.T) {
+		t.Parallel()
+
+		obf, _ := NewOutportBlockFilter(createMockArgs(config.OutportFilterConfig{
+			Tokens: []string{"TKN-123456"},
+		}))
+		filtered := obf.FilterOutportBlock(createOutportBlock())
+
+		pool := filtered.TransactionPool
+		requireKeys(t, pool.Transactions, txHash1)
+		require.Empty(t, pool.InvalidTxs)
+		require.Empty(t, pool.Rewards)
+		requireKeys(t, pool.SmartContractResults, scrHash1)
+		requireLogs(t, pool.Logs, txHash1)
+		requireKeys(t, filtered.AlteredAccounts, eve)
+	})
+	t.Run("by event identifier", func(t *testing.T) {
+		t.Parallel()
+
+		obf, _ := NewOutportBlockFilter(createMockArgs(config.OutportFilterConfig{
+			EventIdentifiers: []string{core.WriteLogIdentifier},
+		}))
+		filtered := obf.FilterOutportBlock(createOutportBlock())
+
+		pool := filtered.TransactionPool
+		require.Empty(t, pool.Transactions)
+		require.Empty(t, pool.SmartContractResults)
+		require.Empty(t, pool.Receipts)
+		requireLogs(t, pool.Logs, orphanHash)
+		// only the accounts involved in the kept entries are kept
+		requireKeys(t, filtered.AlteredAccounts, eve)
+	})
+	t.Run("by transaction type", func(t *testing.T) {
+		t.Parallel()
+
+		obf, _ := NewOutportBlockFilter(createMockArgs(config.OutportFilterConfig{
+			TransactionTypes: []string{string(transaction.TxTypeReward), string(transaction.TxTypeInvalid)},
+		}))
+		filtered := obf.FilterOutportBlock(createOutportBlock())
+
+		pool := filtered.TransactionPool
+		require.Empty(t, pool.Transactions)
+		require.Empty(t, pool.SmartContractResults)
+		requireKeys(t, pool.InvalidTxs, invalidHash)
+		requireKeys(t, pool.Rewards, rewardHash)
+		// the emitter of the orphan log is involved in the kept entries as well
+		requireKeys(t, filtered.AlteredAccounts, alice, bob, eve)
+
+		obf, _ = NewOutportBlockFilter(createMockArgs(config.OutportFilterConfig{
+			TransactionTypes: []string{string(transaction.TxTypeReward)},
+		}))
+		filtered = obf.FilterOutportBlock(createOutportBlock())
+		requireKeys(t, filtered.TransactionPool.Rewards, rewardHash)
+		requireKeys(t, filtered.AlteredAccounts, alice, eve)
+	})
+	t.Run("all the criteria should match", func(t *testing.T) {
+		t.Parallel()
+
+		obf, _ := NewOutportBlockFilter(createMockArgs(config.OutportFilterConfig{
+			Addresses:        []string{encode(contract)},
+			EventIdentifiers: []string{core.BuiltInFunctionESDTTransfer},
+			TransactionTypes: []string{string(transaction.TxTypeNormal)},
+		}))
+		filtered := obf.FilterOutportBlock(createOutportBlock())
+
+		pool := filtered.TransactionPool
+		requireKeys(t, pool.Transactions, txHash1)
+		requireKeys(t, pool.SmartContractResults, scrHash1)
+		require.Empty(t, pool.InvalidTxs)
+		requireLogs(t, pool.Logs, txHash1)
+		require.Empty(t, filtered.AlteredAccounts)
+
+		obf, _ = NewOutportBlockFilter(createMockArgs(config.OutportFilterConfig{
+			Addresses:        []string{encode(bob)},
+			EventIdentifiers: []string{core.BuiltInFunctionESDTTransfer},
+		}))
+		filtered = obf.FilterOutportBlock(createOutportBlock())
+		require.Empty(t, filtered.TransactionPool.Transactions)
+		require.Empty(t, filtered.TransactionPool.Logs)
+	})
+}
@@ -15,6 +15,7 @@ type ArgsHostDriver struct {
 	Marshaller marshal.Marshalizer
 	SenderHost SenderHost
 	Log        core.Logger
+	Filter     OutportBlockFilter
 }
 
 type hostDriver struct {
@@ -23,6 +24,7 @@ type hostDriver struct {
 	isClosed    atomic.Flag
 	log         core.Logger
 	payloadProc payloadProcessorHandler
+	filter      OutportBlockFilter
 }
 
 // NewHostDriver will create a new instance of hostDriver
@@ -36,6 +38,9 @@ func NewHostDriver(args ArgsHostDriver) (*hostDriver, error) {
 	if check.IfNil(args.Log) {
 		return nil, core.ErrNilLogger
 	}
+	if check.IfNil(args.Filter) {
+		return nil, ErrNilOutportBlockFilter
+	}
 
 	payloadProc, err := newPayloadProcessor(args.Log)
 	if err != nil {
@@ -53,12 +58,13 @@ func NewHostDriver(args ArgsHostDriver) (*hostDriver, error) {
 		log:         args.Log,
 		isClosed:    atomic.Flag{},
 		payloadProc: payloadProc,
+		filter:      args.Filter,
 	}, nil
 }
 
-// SaveBlock will handle the saving of block
+// SaveBlock will handle the saving of block, reduced by the configured filter
 func (o *hostDriver) SaveBlock(outportBlock *outport.OutportBlock) error {
-	return o.handleAction(outportBlock, outport.TopicSaveBlock)
+	return o.handleAction(o.filter.FilterOutportBlock(outportBlock), outport.TopicSaveBlock)
 }
 
 // RevertIndexedBlock will handle the action of reverting the indexed block
@@ -22,6 +22,7 @@ func getMockArgs() ArgsHostDriver {
 		Marshaller: &marshal.JsonMarshalizer{},
 		SenderHost: &outportStubs.SenderHostStub{},
 		Log:        log,
+		Filter:     &outportStubs.OutportBlockFilterStub{},
 	}
 }
 
@@ -50,6 +51,17 @@ func TestNewWebsocketOutportDriverNodePart(t *testing.T) {
 		require.Equal(t, core.ErrNilLogger, err)
 	})
 
+	t.Run("nil filter", func(t *testing.T) {
+		t.Parallel()
+
+		args := getMockArgs()
+		args.Filter = nil
+
+		o, err := NewHostDriver(args)
+		require.Nil(t, o)
+		require.Equal(t, ErrNilOutportBlockFilter, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -95,6 +107,34 @@ func TestWebsocketOutportDriverNodePart_SaveBlock(t *testing.T) {
 		err = o.SaveBlock(&outport.OutportBlock{})
 		require.NoError(t, err)
 	})
+
+	t.Run("SaveBlock - should send the filtered block", func(t *testing.T) {
+		t.Parallel()
+
+		filteredBlock := &outport.OutportBlock{HighestFinalBlockNonce: 7}
+		args := getMockArgs()
+		args.Filter = &outportStubs.OutportBlockFilterStub{
+			FilterOutportBlockCalled: func(outportBlock *outport.OutportBlock) *outport.OutportBlock {
+				return filteredBlock
+			},
+		}
+		expectedPayload, _ := args.Marshaller.Marshal(filteredBlock)
+		wasSent := false
+		args.SenderHost = &outportStubs.SenderHostStub{
+			SendCalled: func(payload []byte, topic string) error {
+				require.Equal(t, expectedPayload, payload)
+				require.Equal(t, outport.TopicSaveBlock, topic)
+				wasSent = true
+				return nil
+			},
+		}
+		o, err := NewHostDriver(args)
+		require.NoError(t, err)
+
+		err = o.SaveBlock(&outport.OutportBlock{HighestFinalBlockNonce: 10})
+		require.NoError(t, err)
+		require.True(t, wasSent)
+	})
 }
 
 func TestWebsocketOutportDriverNodePart_FinalizedBlock(t *testing.T) {
@@ -7,3 +7,6 @@ var ErrHostIsClosed = errors.New("server is closed")
 
 // ErrNilHost signals that a nil host has been provided
 var ErrNilHost = errors.New("nil host provided")
+
+// ErrNilOutportBlockFilter signals that a nil outport block filter has been provided
+var ErrNilOutportBlockFilter = errors.New("nil outport block filter")
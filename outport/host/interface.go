@@ -1,6 +1,9 @@
 package host
 
-import "github.com/multiversx/mx-chain-communication-go/websocket"
+import (
+	"github.com/multiversx/mx-chain-communication-go/websocket"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+)
 
 // SenderHost defines the actions that a host sender should do
 type SenderHost interface {
@@ -14,3 +17,9 @@ type payloadProcessorHandler interface {
 	websocket.PayloadHandler
 	SetHandlerFuncForTopic(handler func() error, topic string) error
 }
+
+// OutportBlockFilter defines the behavior of a component able to reduce an outport block before being pushed
+type OutportBlockFilter interface {
+	FilterOutportBlock(outportBlock *outport.OutportBlock) *outport.OutportBlock
+	IsInterfaceNil() bool
+}
@@ -44,3 +44,9 @@ type DataProviderOutport interface {
 	PrepareOutportSaveBlockData(arg process.ArgPrepareOutportSaveBlockData) (*outportcore.OutportBlockWithHeaderAndBody, error)
 	IsInterfaceNil() bool
 }
+
+// ReplayDriverFactory defines the component able to create a dedicated driver for a replay target
+type ReplayDriverFactory interface {
+	CreateReplayDriver(target string) (Driver, error)
+	IsInterfaceNil() bool
+}
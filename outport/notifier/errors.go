@@ -15,3 +15,6 @@ var ErrNilMarshaller = errors.New("nil marshaller")
 
 // ErrNilBlockContainerHandler signals that a nil block container handler has been provided
 var ErrNilBlockContainerHandler = errors.New("nil bock container handler")
+
+// ErrNilOutportBlockFilter signals that a nil outport block filter has been provided
+var ErrNilOutportBlockFilter = errors.New("nil outport block filter")
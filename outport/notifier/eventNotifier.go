@@ -23,6 +23,7 @@ type eventNotifier struct {
 	httpClient     httpClientHandler
 	marshalizer    marshal.Marshalizer
 	blockContainer BlockContainerHandler
+	filter         OutportBlockFilter
 }
 
 // ArgsEventNotifier defines the arguments needed for event notifier creation
@@ -30,6 +31,7 @@ type ArgsEventNotifier struct {
 	HttpClient     httpClientHandler
 	Marshaller     marshal.Marshalizer
 	BlockContainer BlockContainerHandler
+	Filter         OutportBlockFilter
 }
 
 // NewEventNotifier creates a new instance of the eventNotifier
@@ -44,6 +46,7 @@ func NewEventNotifier(args ArgsEventNotifier) (*eventNotifier, error) {
 		httpClient:     args.HttpClient,
 		marshalizer:    args.Marshaller,
 		blockContainer: args.BlockContainer,
+		filter:         args.Filter,
 	}, nil
 }
 
@@ -57,17 +60,20 @@ func checkEventNotifierArgs(args ArgsEventNotifier) error {
 	if check.IfNilReflect(args.BlockContainer) {
 		return ErrNilBlockContainerHandler
 	}
+	if check.IfNil(args.Filter) {
+		return ErrNilOutportBlockFilter
+	}
 
 	return nil
 }
 
-// SaveBlock converts block data in order to be pushed to subscribers
+// SaveBlock converts block data, reduced by the configured filter, in order to be pushed to subscribers
 func (en *eventNotifier) SaveBlock(args *outport.OutportBlock) error {
 	if args.BlockData != nil {
 		log.Debug("eventNotifier: SaveBlock called at block", "block hash", args.BlockData.HeaderHash)
 	}
 
-	err := en.httpClient.Post(pushEventEndpoint, args)
+	err := en.httpClient.Post(pushEventEndpoint, en.filter.FilterOutportBlock(args))
 	if err != nil {
 		return fmt.Errorf("%w in eventNotifier.SaveBlock while posting block data", err)
 	}
@@ -22,6 +22,7 @@ func createMockEventNotifierArgs() notifier.ArgsEventNotifier {
 		HttpClient:     &mock.HTTPClientStub{},
 		Marshaller:     &marshallerMock.MarshalizerMock{},
 		BlockContainer: &outportStub.BlockContainerStub{},
+		Filter:         &outportStub.OutportBlockFilterStub{},
 	}
 }
 
@@ -61,6 +62,17 @@ func TestNewEventNotifier(t *testing.T) {
 		require.Equal(t, notifier.ErrNilBlockContainerHandler, err)
 	})
 
+	t.Run("nil filter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventNotifierArgs()
+		args.Filter = nil
+
+		en, err := notifier.NewEventNotifier(args)
+		require.Nil(t, en)
+		require.Equal(t, notifier.ErrNilOutportBlockFilter, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -149,6 +161,32 @@ func TestSaveBlock(t *testing.T) {
 
 		require.True(t, wasCalled)
 	})
+
+	t.Run("should post the filtered block", func(t *testing.T) {
+		t.Parallel()
+
+		filteredBlock := &outport.OutportBlock{BlockData: &outport.BlockData{HeaderHash: []byte("hash")}}
+		args := createMockEventNotifierArgs()
+		args.Filter = &outportStub.OutportBlockFilterStub{
+			FilterOutportBlockCalled: func(outportBlock *outport.OutportBlock) *outport.OutportBlock {
+				return filteredBlock
+			},
+		}
+		wasCalled := false
+		args.HttpClient = &mock.HTTPClientStub{
+			PostCalled: func(route string, payload interface{}) error {
+				require.True(t, payload == filteredBlock)
+				wasCalled = true
+				return nil
+			},
+		}
+
+		en, _ := notifier.NewEventNotifier(args)
+
+		err := en.SaveBlock(&outport.OutportBlock{BlockData: &outport.BlockData{}})
+		require.Nil(t, err)
+		require.True(t, wasCalled)
+	})
 }
 
 func TestRevertIndexedBlock(t *testing.T) {
@@ -3,6 +3,7 @@ package notifier
 import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
 )
 
 type httpClientHandler interface {
@@ -14,3 +15,9 @@ type httpClientHandler interface {
 type BlockContainerHandler interface {
 	Get(headerType core.HeaderType) (block.EmptyBlockCreator, error)
 }
+
+// OutportBlockFilter defines the behavior of a component able to reduce an outport block before being pushed
+type OutportBlockFilter interface {
+	FilterOutportBlock(outportBlock *outport.OutportBlock) *outport.OutportBlock
+	IsInterfaceNil() bool
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -54,7 +55,7 @@ func (o *outport) SaveBlock(args *outportcore.OutportBlockWithHeaderAndBody) err
 	}
 
 	for _, driver := range o.drivers {
-		blockData, err := prepareBlockData(args.HeaderDataWithBody, driver)
+		blockData, err := PrepareBlockData(args.HeaderDataWithBody, driver.GetMarshaller())
 		if err != nil {
 			return err
 		}
@@ -66,15 +67,16 @@ func (o *outport) SaveBlock(args *outportcore.OutportBlockWithHeaderAndBody) err
 	return nil
 }
 
-func prepareBlockData(
+// PrepareBlockData creates the block data of the provided header and body, marshalling the header with the
+// marshaller of the driver the block data is sent to
+func PrepareBlockData(
 	headerBodyData *outportcore.HeaderDataWithBody,
-	driver Driver,
+	marshaller marshal.Marshalizer,
 ) (*outportcore.BlockData, error) {
 	if headerBodyData == nil {
-		return nil, fmt.Errorf("outport.prepareBlockData error: %w", errNilHeaderAndBodyArgs)
+		return nil, fmt.Errorf("outport.PrepareBlockData error: %w", errNilHeaderAndBodyArgs)
 	}
 
-	marshaller := driver.GetMarshaller()
 	headerBytes, headerType, err := outportcore.GetHeaderBytesAndType(marshaller, headerBodyData.Header)
 	if err != nil {
 		return nil, err
@@ -154,7 +156,7 @@ func (o *outport) RevertIndexedBlock(headerDataWithBody *outportcore.HeaderDataW
 	defer o.mutex.RUnlock()
 
 	for _, driver := range o.drivers {
-		blockData, err := prepareBlockData(headerDataWithBody, driver)
+		blockData, err := PrepareBlockData(headerDataWithBody, driver.GetMarshaller())
 		if err != nil {
 			return err
 		}
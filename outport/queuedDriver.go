@@ -0,0 +1,414 @@
+package outport
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const (
+	eventSaveBlock byte = iota + 1
+	eventRevertIndexedBlock
+	eventSaveRoundsInfo
+	eventSaveValidatorsPubKeys
+	eventSaveValidatorsRating
+	eventSaveAccounts
+	eventFinalizedBlock
+)
+
+const sequenceKeyLength = 8
+
+var acknowledgedOffsetKey = []byte("acknowledgedOffset")
+
+// ArgsQueuedDriver holds the arguments needed to create a queued driver
+type ArgsQueuedDriver struct {
+	Driver          Driver
+	Storer          storage.Storer
+	Marshaller      marshal.Marshalizer
+	Identifier      string
+	MaxBacklog      uint64
+	RetrialInterval time.Duration
+}
+
+// queuedDriver wraps a Driver and decouples it from the caller: each event is appended to a persistent
+// storer under an increasing sequence number and is delivered to the wrapped driver on a separate go routine.
+// The sequence number of the next event to be delivered (the acknowledged offset) is also persisted, so the
+// events that were not delivered before a restart will be delivered afterward. The caller is blocked only when
+// the number of not yet delivered events reaches the maximum backlog.
+type queuedDriver struct {
+	driver          Driver
+	storer          storage.Storer
+	marshaller      marshal.Marshalizer
+	identifier      string
+	maxBacklog      uint64
+	retrialInterval time.Duration
+
+	mutQueue     sync.Mutex
+	queueChanged *sync.Cond
+	nextSequence uint64
+	acknowledged uint64
+	closed       bool
+
+	chanClose    chan struct{}
+	chanLoopDone chan struct{}
+	closeOnce    sync.Once
+}
+
+// NewQueuedDriver creates a new queued driver instance and starts delivering the previously stored events, if any
+func NewQueuedDriver(args ArgsQueuedDriver) (*queuedDriver, error) {
+	err := checkArgsQueuedDriver(args)
+	if err != nil {
+		return nil, err
+	}
+
+	qd := &queuedDriver{
+		driver:          args.Driver,
+		storer:          args.Storer,
+		marshaller:      args.Marshaller,
+		identifier:      args.Identifier,
+		maxBacklog:      args.MaxBacklog,
+		retrialInterval: args.RetrialInterval,
+		chanClose:       make(chan struct{}),
+		chanLoopDone:    make(chan struct{}),
+	}
+	qd.queueChanged = sync.NewCond(&qd.mutQueue)
+
+	err = qd.loadOffsets()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("queuedDriver: created",
+		"driver", qd.identifier,
+		"acknowledged offset", qd.acknowledged,
+		"backlog", qd.nextSequence-qd.acknowledged)
+
+	go qd.processLoop()
+
+	return qd, nil
+}
+
+func checkArgsQueuedDriver(args ArgsQueuedDriver) error {
+	if check.IfNil(args.Driver) {
+		return ErrNilDriver
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if len(args.Identifier) == 0 {
+		return ErrEmptyDriverIdentifier
+	}
+	if args.MaxBacklog == 0 {
+		return ErrInvalidMaxBacklog
+	}
+	if args.RetrialInterval < minimumRetrialInterval {
+		return fmt.Errorf("%w, provided: %d, minimum: %d", ErrInvalidRetrialInterval, args.RetrialInterval, minimumRetrialInterval)
+	}
+
+	return nil
+}
+
+func (qd *queuedDriver) loadOffsets() error {
+	offsetBytes, err := qd.storer.Get(acknowledgedOffsetKey)
+	if err == nil {
+		if len(offsetBytes) != sequenceKeyLength {
+			return fmt.Errorf("%w for driver %s", errCorruptedAcknowledgedOffset, qd.identifier)
+		}
+		qd.acknowledged = binary.BigEndian.Uint64(offsetBytes)
+	}
+	qd.nextSequence = qd.acknowledged
+
+	staleKeys := make([][]byte, 0)
+	qd.storer.RangeKeys(func(key []byte, _ []byte) bool {
+		if len(key) != sequenceKeyLength {
+			return true
+		}
+
+		sequence := binary.BigEndian.Uint64(key)
+		if sequence < qd.acknowledged {
+			staleKeys = append(staleKeys, key)
+			return true
+		}
+		if sequence >= qd.nextSequence {
+			qd.nextSequence = sequence + 1
+		}
+
+		return true
+	})
+
+	// events that were delivered but not removed because of an unclean shutdown
+	for _, key := range staleKeys {
+		log.LogIfError(qd.storer.Remove(key))
+	}
+
+	return nil
+}
+
+// SaveBlock stores the outport block in the queue
+func (qd *queuedDriver) SaveBlock(outportBlock *outportcore.OutportBlock) error {
+	return qd.enqueue(eventSaveBlock, outportBlock)
+}
+
+// RevertIndexedBlock stores the revert event in the queue
+func (qd *queuedDriver) RevertIndexedBlock(blockData *outportcore.BlockData) error {
+	return qd.enqueue(eventRevertIndexedBlock, blockData)
+}
+
+// SaveRoundsInfo stores the rounds info in the queue
+func (qd *queuedDriver) SaveRoundsInfo(roundsInfos *outportcore.RoundsInfo) error {
+	return qd.enqueue(eventSaveRoundsInfo, roundsInfos)
+}
+
+// SaveValidatorsPubKeys stores the validators public keys in the queue
+func (qd *queuedDriver) SaveValidatorsPubKeys(validatorsPubKeys *outportcore.ValidatorsPubKeys) error {
+	return qd.enqueue(eventSaveValidatorsPubKeys, validatorsPubKeys)
+}
+
+// SaveValidatorsRating stores the validators rating in the queue
+func (qd *queuedDriver) SaveValidatorsRating(validatorsRating *outportcore.ValidatorsRating) error {
+	return qd.enqueue(eventSaveValidatorsRating, validatorsRating)
+}
+
+// SaveAccounts stores the accounts in the queue
+func (qd *queuedDriver) SaveAccounts(accounts *outportcore.Accounts) error {
+	return qd.enqueue(eventSaveAccounts, accounts)
+}
+
+// FinalizedBlock stores the finalized block event in the queue
+func (qd *queuedDriver) FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock) error {
+	return qd.enqueue(eventFinalizedBlock, finalizedBlock)
+}
+
+// GetMarshaller returns the marshaller of the wrapped driver
+func (qd *queuedDriver) GetMarshaller() marshal.Marshalizer {
+	return qd.driver.GetMarshaller()
+}
+
+// SetCurrentSettings forwards the settings to the wrapped driver
+func (qd *queuedDriver) SetCurrentSettings(config outportcore.OutportConfig) error {
+	return qd.driver.SetCurrentSettings(config)
+}
+
+// RegisterHandler registers the handler on the wrapped driver
+func (qd *queuedDriver) RegisterHandler(handlerFunction func() error, topic string) error {
+	return qd.driver.RegisterHandler(handlerFunction, topic)
+}
+
+// Backlog returns the number of events that were not yet delivered to the wrapped driver
+func (qd *queuedDriver) Backlog() uint64 {
+	qd.mutQueue.Lock()
+	defer qd.mutQueue.Unlock()
+
+	return qd.nextSequence - qd.acknowledged
+}
+
+func (qd *queuedDriver) enqueue(eventType byte, payload interface{}) error {
+	payloadBytes, err := qd.marshaller.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	qd.mutQueue.Lock()
+	defer qd.mutQueue.Unlock()
+
+	isBacklogFull := qd.nextSequence-qd.acknowledged >= qd.maxBacklog
+	if isBacklogFull && !qd.closed {
+		log.Warn("queuedDriver: backlog limit reached, waiting for the driver to consume events",
+			"driver", qd.identifier, "max backlog", qd.maxBacklog)
+	}
+	for qd.nextSequence-qd.acknowledged >= qd.maxBacklog && !qd.closed {
+		qd.queueChanged.Wait()
+	}
+	if qd.closed {
+		return ErrQueuedDriverClosed
+	}
+
+	record := make([]byte, 0, len(payloadBytes)+1)
+	record = append(record, eventType)
+	record = append(record, payloadBytes...)
+
+	err = qd.storer.Put(sequenceToKey(qd.nextSequence), record)
+	if err != nil {
+		return err
+	}
+
+	qd.nextSequence++
+	qd.queueChanged.Broadcast()
+
+	return nil
+}
+
+func (qd *queuedDriver) processLoop() {
+	defer close(qd.chanLoopDone)
+
+	for {
+		sequence, ok := qd.waitNextSequence()
+		if !ok {
+			return
+		}
+
+		delivered := qd.deliver(sequence)
+		if !delivered {
+			return
+		}
+
+		qd.acknowledge(sequence)
+	}
+}
+
+func (qd *queuedDriver) waitNextSequence() (uint64, bool) {
+	qd.mutQueue.Lock()
+	defer qd.mutQueue.Unlock()
+
+	for qd.nextSequence == qd.acknowledged && !qd.closed {
+		qd.queueChanged.Wait()
+	}
+
+	return qd.acknowledged, !qd.closed
+}
+
+// deliver returns false only if the driver was closed before the event was delivered
+func (qd *queuedDriver) deliver(sequence uint64) bool {
+	record, err := qd.storer.Get(sequenceToKey(sequence))
+	if err != nil {
+		log.Error("queuedDriver: cannot load event, skipping",
+			"driver", qd.identifier, "sequence", sequence, "error", err)
+		return true
+	}
+
+	for {
+		err = qd.callDriver(record)
+		if err == nil {
+			return true
+		}
+		if err == errUnknownQueuedEventType || err == errEmptyQueuedEvent {
+			log.Error("queuedDriver: invalid event, skipping",
+				"driver", qd.identifier, "sequence", sequence, "error", err)
+			return true
+		}
+
+		log.Error("queuedDriver: error calling driver, will retry",
+			"driver", qd.identifier,
+			"sequence", sequence,
+			"retrial in", qd.retrialInterval,
+			"error", err)
+
+		select {
+		case <-qd.chanClose:
+			return false
+		case <-time.After(qd.retrialInterval):
+		}
+	}
+}
+
+func (qd *queuedDriver) callDriver(record []byte) error {
+	if len(record) == 0 {
+		return errEmptyQueuedEvent
+	}
+
+	payload := record[1:]
+	switch record[0] {
+	case eventSaveBlock:
+		outportBlock := &outportcore.OutportBlock{}
+		return qd.unmarshalAndCall(payload, outportBlock, func() error { return qd.driver.SaveBlock(outportBlock) })
+	case eventRevertIndexedBlock:
+		blockData := &outportcore.BlockData{}
+		return qd.unmarshalAndCall(payload, blockData, func() error { return qd.driver.RevertIndexedBlock(blockData) })
+	case eventSaveRoundsInfo:
+		roundsInfo := &outportcore.RoundsInfo{}
+		return qd.unmarshalAndCall(payload, roundsInfo, func() error { return qd.driver.SaveRoundsInfo(roundsInfo) })
+	case eventSaveValidatorsPubKeys:
+		validatorsPubKeys := &outportcore.ValidatorsPubKeys{}
+		return qd.unmarshalAndCall(payload, validatorsPubKeys, func() error { return qd.driver.SaveValidatorsPubKeys(validatorsPubKeys) })
+	case eventSaveValidatorsRating:
+		validatorsRating := &outportcore.ValidatorsRating{}
+		return qd.unmarshalAndCall(payload, validatorsRating, func() error { return qd.driver.SaveValidatorsRating(validatorsRating) })
+	case eventSaveAccounts:
+		accounts := &outportcore.Accounts{}
+		return qd.unmarshalAndCall(payload, accounts, func() error { return qd.driver.SaveAccounts(accounts) })
+	case eventFinalizedBlock:
+		finalizedBlock := &outportcore.FinalizedBlock{}
+		return qd.unmarshalAndCall(payload, finalizedBlock, func() error { return qd.driver.FinalizedBlock(finalizedBlock) })
+	default:
+		return errUnknownQueuedEventType
+	}
+}
+
+func (qd *queuedDriver) unmarshalAndCall(payload []byte, obj interface{}, handler func() error) error {
+	err := qd.marshaller.Unmarshal(obj, payload)
+	if err != nil {
+		// a payload that can not be decoded will never be delivered
+		log.Error("queuedDriver: cannot decode event", "driver", qd.identifier, "error", err)
+		return errUnknownQueuedEventType
+	}
+
+	return handler()
+}
+
+func (qd *queuedDriver) acknowledge(sequence uint64) {
+	offsetBytes := make([]byte, sequenceKeyLength)
+	binary.BigEndian.PutUint64(offsetBytes, sequence+1)
+
+	err := qd.storer.Put(acknowledgedOffsetKey, offsetBytes)
+	if err != nil {
+		// the event will be delivered again after a restart
+		log.Warn("queuedDriver: cannot persist acknowledged offset",
+			"driver", qd.identifier, "sequence", sequence, "error", err)
+	}
+	log.LogIfError(qd.storer.Remove(sequenceToKey(sequence)), "driver", qd.identifier, "sequence", sequence)
+
+	qd.mutQueue.Lock()
+	qd.acknowledged = sequence + 1
+	qd.queueChanged.Broadcast()
+	qd.mutQueue.Unlock()
+
+	log.Trace("queuedDriver: event delivered", "driver", qd.identifier, "sequence", sequence)
+}
+
+// Close stops the delivery loop and closes the wrapped driver and the storer. The events that were not
+// delivered remain in the storer
+func (qd *queuedDriver) Close() error {
+	var err error
+	qd.closeOnce.Do(func() {
+		qd.mutQueue.Lock()
+		qd.closed = true
+		qd.queueChanged.Broadcast()
+		qd.mutQueue.Unlock()
+		close(qd.chanClose)
+
+		// closing the driver first will unblock a delivery in progress
+		err = qd.driver.Close()
+		<-qd.chanLoopDone
+
+		errStorer := qd.storer.Close()
+		if errStorer != nil {
+			log.Error("queuedDriver: cannot close storer", "driver", qd.identifier, "error", errStorer)
+			err = errStorer
+		}
+
+		log.Debug("queuedDriver: closed", "driver", qd.identifier, "backlog", qd.Backlog())
+	})
+
+	return err
+}
+
+func sequenceToKey(sequence uint64) []byte {
+	key := make([]byte, sequenceKeyLength)
+	binary.BigEndian.PutUint64(key, sequence)
+
+	return key
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qd *queuedDriver) IsInterfaceNil() bool {
+	return qd == nil
+}
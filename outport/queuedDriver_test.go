@@ -0,0 +1,369 @@
+package outport
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/outport/mock"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsQueuedDriver() ArgsQueuedDriver {
+	return ArgsQueuedDriver{
+		Driver:          &mock.DriverStub{},
+		Storer:          testscommon.CreateMemUnit(),
+		Marshaller:      &marshal.GogoProtoMarshalizer{},
+		Identifier:      "driver",
+		MaxBacklog:      10,
+		RetrialInterval: minimumRetrialInterval,
+	}
+}
+
+// createReopenableStorer returns a storer that keeps its data after being closed, as a persistent storer would
+func createReopenableStorer() storage.Storer {
+	storer := testscommon.CreateMemUnit()
+	return &storageStubs.StorerStub{
+		PutCalled:       storer.Put,
+		GetCalled:       storer.Get,
+		RemoveCalled:    storer.Remove,
+		RangeKeysCalled: storer.RangeKeys,
+	}
+}
+
+func TestNewQueuedDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil driver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver()
+		args.Driver = nil
+		qd, err := NewQueuedDriver(args)
+		assert.Equal(t, ErrNilDriver, err)
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver()
+		args.Storer = nil
+		qd, err := NewQueuedDriver(args)
+		assert.Equal(t, ErrNilStorer, err)
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver()
+		args.Marshaller = nil
+		qd, err := NewQueuedDriver(args)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("empty identifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver()
+		args.Identifier = ""
+		qd, err := NewQueuedDriver(args)
+		assert.Equal(t, ErrEmptyDriverIdentifier, err)
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("invalid max backlog should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver()
+		args.MaxBacklog = 0
+		qd, err := NewQueuedDriver(args)
+		assert.Equal(t, ErrInvalidMaxBacklog, err)
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("invalid retrial interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver()
+		args.RetrialInterval = time.Millisecond
+		qd, err := NewQueuedDriver(args)
+		assert.ErrorIs(t, err, ErrInvalidRetrialInterval)
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("corrupted acknowledged offset should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver()
+		_ = args.Storer.Put(acknowledgedOffsetKey, []byte("corrupted"))
+		qd, err := NewQueuedDriver(args)
+		assert.ErrorIs(t, err, errCorruptedAcknowledgedOffset)
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		qd, err := NewQueuedDriver(createMockArgsQueuedDriver())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(qd))
+		assert.Nil(t, qd.Close())
+	})
+}
+
+func TestQueuedDriver_ShouldDeliverAllEventTypesInOrder(t *testing.T) {
+	t.Parallel()
+
+	mutCalls := sync.Mutex{}
+	calls := make([]string, 0)
+	recordCall := func(call string) {
+		mutCalls.Lock()
+		calls = append(calls, call)
+		mutCalls.Unlock()
+	}
+	getNumCalls := func() int {
+		mutCalls.Lock()
+		defer mutCalls.Unlock()
+
+		return len(calls)
+	}
+
+	args := createMockArgsQueuedDriver()
+	args.Storer = createReopenableStorer()
+	args.Driver = &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			assert.Equal(t, []byte("hash"), outportBlock.BlockData.HeaderHash)
+			recordCall("SaveBlock")
+			return nil
+		},
+		RevertIndexedBlockCalled: func(blockData *outportcore.BlockData) error {
+			assert.Equal(t, uint32(1), blockData.ShardID)
+			recordCall("RevertIndexedBlock")
+			return nil
+		},
+		SaveRoundsInfoCalled: func(roundsInfos *outportcore.RoundsInfo) error {
+			assert.Equal(t, uint32(2), roundsInfos.ShardID)
+			recordCall("SaveRoundsInfo")
+			return nil
+		},
+		SaveValidatorsPubKeysCalled: func(validatorsPubKeys *outportcore.ValidatorsPubKeys) error {
+			assert.Equal(t, uint32(3), validatorsPubKeys.Epoch)
+			recordCall("SaveValidatorsPubKeys")
+			return nil
+		},
+		SaveValidatorsRatingCalled: func(validatorsRating *outportcore.ValidatorsRating) error {
+			assert.Equal(t, uint32(4), validatorsRating.Epoch)
+			recordCall("SaveValidatorsRating")
+			return nil
+		},
+		SaveAccountsCalled: func(accounts *outportcore.Accounts) error {
+			assert.Equal(t, uint64(5), accounts.BlockTimestamp)
+			recordCall("SaveAccounts")
+			return nil
+		},
+		FinalizedBlockCalled: func(finalizedBlock *outportcore.FinalizedBlock) error {
+			assert.Equal(t, []byte("final"), finalizedBlock.HeaderHash)
+			recordCall("FinalizedBlock")
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	assert.Nil(t, qd.SaveBlock(&outportcore.OutportBlock{BlockData: &outportcore.BlockData{HeaderHash: []byte("hash")}}))
+	assert.Nil(t, qd.RevertIndexedBlock(&outportcore.BlockData{ShardID: 1}))
+	assert.Nil(t, qd.SaveRoundsInfo(&outportcore.RoundsInfo{ShardID: 2}))
+	assert.Nil(t, qd.SaveValidatorsPubKeys(&outportcore.ValidatorsPubKeys{Epoch: 3}))
+	assert.Nil(t, qd.SaveValidatorsRating(&outportcore.ValidatorsRating{Epoch: 4}))
+	assert.Nil(t, qd.SaveAccounts(&outportcore.Accounts{BlockTimestamp: 5}))
+	assert.Nil(t, qd.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: []byte("final")}))
+
+	require.Eventually(t, func() bool {
+		return getNumCalls() == 7
+	}, time.Second*2, time.Millisecond*10)
+	assert.Equal(t, uint64(0), qd.Backlog())
+	assert.Nil(t, qd.Close())
+
+	expectedCalls := []string{
+		"SaveBlock",
+		"RevertIndexedBlock",
+		"SaveRoundsInfo",
+		"SaveValidatorsPubKeys",
+		"SaveValidatorsRating",
+		"SaveAccounts",
+		"FinalizedBlock",
+	}
+	assert.Equal(t, expectedCalls, calls)
+
+	offsetBytes, err := args.Storer.Get(acknowledgedOffsetKey)
+	assert.Nil(t, err)
+	assert.Equal(t, sequenceToKey(7), offsetBytes)
+}
+
+func TestQueuedDriver_FailingDriverShouldNotBlockTheCaller(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsQueuedDriver()
+	args.Driver = &mock.DriverStub{
+		FinalizedBlockCalled: func(finalizedBlock *outportcore.FinalizedBlock) error {
+			return errors.New("driver is down")
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	for i := 0; i < 5; i++ {
+		err := qd.FinalizedBlock(&outportcore.FinalizedBlock{})
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, uint64(5), qd.Backlog())
+
+	assert.Nil(t, qd.Close())
+	assert.Equal(t, ErrQueuedDriverClosed, qd.FinalizedBlock(&outportcore.FinalizedBlock{}))
+}
+
+func TestQueuedDriver_ShouldBlockWhenBacklogLimitIsReached(t *testing.T) {
+	t.Parallel()
+
+	chanDriverAvailable := make(chan struct{})
+	args := createMockArgsQueuedDriver()
+	args.MaxBacklog = 2
+	args.Driver = &mock.DriverStub{
+		FinalizedBlockCalled: func(finalizedBlock *outportcore.FinalizedBlock) error {
+			<-chanDriverAvailable
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+	defer func() {
+		_ = qd.Close()
+	}()
+
+	assert.Nil(t, qd.FinalizedBlock(&outportcore.FinalizedBlock{}))
+	assert.Nil(t, qd.FinalizedBlock(&outportcore.FinalizedBlock{}))
+
+	chanEnqueued := make(chan struct{})
+	go func() {
+		_ = qd.FinalizedBlock(&outportcore.FinalizedBlock{})
+		close(chanEnqueued)
+	}()
+
+	select {
+	case <-chanEnqueued:
+		assert.Fail(t, "should have blocked")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	close(chanDriverAvailable)
+
+	select {
+	case <-chanEnqueued:
+	case <-time.After(time.Second * 2):
+		assert.Fail(t, "should have been unblocked")
+	}
+}
+
+func TestQueuedDriver_CloseShouldUnblockTheCaller(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsQueuedDriver()
+	args.MaxBacklog = 1
+	args.Driver = &mock.DriverStub{
+		FinalizedBlockCalled: func(finalizedBlock *outportcore.FinalizedBlock) error {
+			return errors.New("driver is down")
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+	assert.Nil(t, qd.FinalizedBlock(&outportcore.FinalizedBlock{}))
+
+	chanErr := make(chan error)
+	go func() {
+		chanErr <- qd.FinalizedBlock(&outportcore.FinalizedBlock{})
+	}()
+
+	time.Sleep(time.Millisecond * 50)
+	assert.Nil(t, qd.Close())
+
+	select {
+	case err := <-chanErr:
+		assert.Equal(t, ErrQueuedDriverClosed, err)
+	case <-time.After(time.Second * 2):
+		assert.Fail(t, "should have been unblocked")
+	}
+}
+
+func TestQueuedDriver_PendingEventsShouldBeDeliveredAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsQueuedDriver()
+	args.Storer = createReopenableStorer()
+	args.Driver = &mock.DriverStub{
+		SaveRoundsInfoCalled: func(roundsInfos *outportcore.RoundsInfo) error {
+			if roundsInfos.ShardID == 0 {
+				return nil
+			}
+
+			return errors.New("driver is down")
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	for shardID := uint32(0); shardID < 3; shardID++ {
+		_ = qd.SaveRoundsInfo(&outportcore.RoundsInfo{ShardID: shardID})
+	}
+	require.Eventually(t, func() bool {
+		return qd.Backlog() == 2
+	}, time.Second*2, time.Millisecond*10)
+	assert.Nil(t, qd.Close())
+
+	mutDelivered := sync.Mutex{}
+	delivered := make([]uint32, 0)
+	args.Driver = &mock.DriverStub{
+		SaveRoundsInfoCalled: func(roundsInfos *outportcore.RoundsInfo) error {
+			mutDelivered.Lock()
+			delivered = append(delivered, roundsInfos.ShardID)
+			mutDelivered.Unlock()
+
+			return nil
+		},
+	}
+	qd, _ = NewQueuedDriver(args)
+	assert.Equal(t, uint64(2), qd.Backlog())
+
+	require.Eventually(t, func() bool {
+		return qd.Backlog() == 0
+	}, time.Second*2, time.Millisecond*10)
+	assert.Nil(t, qd.Close())
+
+	assert.Equal(t, []uint32{1, 2}, delivered)
+}
+
+func TestQueuedDriver_ShouldForwardToTheWrappedDriver(t *testing.T) {
+	t.Parallel()
+
+	settingsCalled := false
+	registerCalled := false
+	args := createMockArgsQueuedDriver()
+	args.Driver = &mock.DriverStub{
+		SetCurrentSettingsCalled: func(config outportcore.OutportConfig) error {
+			settingsCalled = true
+			return nil
+		},
+		RegisterHandlerCalled: func(handlerFunction func() error, topic string) error {
+			registerCalled = true
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+	defer func() {
+		_ = qd.Close()
+	}()
+
+	assert.Nil(t, qd.SetCurrentSettings(outportcore.OutportConfig{}))
+	assert.Nil(t, qd.RegisterHandler(func() error { return nil }, outportcore.TopicSettings))
+	assert.True(t, settingsCalled)
+	assert.True(t, registerCalled)
+	assert.False(t, check.IfNil(qd.GetMarshaller()))
+}
@@ -0,0 +1,831 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/outport"
+	outportProcess "github.com/multiversx/mx-chain-go/outport/process"
+	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts/shared"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/smartContract"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/state"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+var log = logger.GetOrCreate("outport/replay")
+
+// ArgsBlockReplayer holds the arguments needed to create a block replayer
+type ArgsBlockReplayer struct {
+	ShardCoordinator             sharding.Coordinator
+	Store                        dataRetriever.StorageService
+	Marshaller                   marshal.Marshalizer
+	Uint64ByteSliceConverter     typeConverters.Uint64ByteSliceConverter
+	ReceiptsRepository           ReceiptsRepository
+	LogsFacade                   LogsFacade
+	TransactionsFeeHandler       outportProcess.TransactionsFeeHandler
+	AlteredAccountsProvider      outportProcess.AlteredAccountsProviderHandler
+	AccountsRepository           state.AccountsRepository
+	ScheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
+	NodesCoordinator             nodesCoordinator.NodesCoordinator
+	EconomicsData                EconomicsDataHandler
+	GasComputer                  GasComputer
+	BlockChain                   data.ChainHandler
+	DriverFactory                outport.ReplayDriverFactory
+}
+
+// blockReplayer is able to reconstruct, from the node's storage, the outport blocks for a nonce range and to push
+// them to a dedicated driver created for the requested target, so that target can be backfilled without a new sync.
+// The live drivers do not receive the replayed blocks
+type blockReplayer struct {
+	shardCoordinator             sharding.Coordinator
+	store                        dataRetriever.StorageService
+	marshaller                   marshal.Marshalizer
+	uint64ByteSliceConverter     typeConverters.Uint64ByteSliceConverter
+	receiptsRepository           ReceiptsRepository
+	logsFacade                   LogsFacade
+	transactionsFeeHandler       outportProcess.TransactionsFeeHandler
+	alteredAccountsProvider      outportProcess.AlteredAccountsProviderHandler
+	accountsRepository           state.AccountsRepository
+	scheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
+	nodesCoordinator             nodesCoordinator.NodesCoordinator
+	economicsData                EconomicsDataHandler
+	gasComputer                  GasComputer
+	blockChain                   data.ChainHandler
+	driverFactory                outport.ReplayDriverFactory
+
+	mutStatus sync.RWMutex
+	status    common.OutportReplayStatus
+	chanClose chan struct{}
+	closeOnce sync.Once
+}
+
+// NewBlockReplayer creates a new block replayer instance
+func NewBlockReplayer(args ArgsBlockReplayer) (*blockReplayer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockReplayer{
+		shardCoordinator:             args.ShardCoordinator,
+		store:                        args.Store,
+		marshaller:                   args.Marshaller,
+		uint64ByteSliceConverter:     args.Uint64ByteSliceConverter,
+		receiptsRepository:           args.ReceiptsRepository,
+		logsFacade:                   args.LogsFacade,
+		transactionsFeeHandler:       args.TransactionsFeeHandler,
+		alteredAccountsProvider:      args.AlteredAccountsProvider,
+		accountsRepository:           args.AccountsRepository,
+		scheduledTxsExecutionHandler: args.ScheduledTxsExecutionHandler,
+		nodesCoordinator:             args.NodesCoordinator,
+		economicsData:                args.EconomicsData,
+		gasComputer:                  args.GasComputer,
+		blockChain:                   args.BlockChain,
+		driverFactory:                args.DriverFactory,
+		chanClose:                    make(chan struct{}),
+	}, nil
+}
+
+func checkArgs(args ArgsBlockReplayer) error {
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.Store) {
+		return ErrNilStorageService
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return ErrNilUint64ByteSliceConverter
+	}
+	if check.IfNil(args.ReceiptsRepository) {
+		return ErrNilReceiptsRepository
+	}
+	if check.IfNil(args.LogsFacade) {
+		return ErrNilLogsFacade
+	}
+	if check.IfNil(args.TransactionsFeeHandler) {
+		return ErrNilTransactionsFeeHandler
+	}
+	if check.IfNil(args.AlteredAccountsProvider) {
+		return ErrNilAlteredAccountsProvider
+	}
+	if check.IfNil(args.AccountsRepository) {
+		return ErrNilAccountsRepository
+	}
+	if check.IfNil(args.ScheduledTxsExecutionHandler) {
+		return ErrNilScheduledTxsExecutionHandler
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.EconomicsData) {
+		return ErrNilEconomicsData
+	}
+	if check.IfNil(args.GasComputer) {
+		return ErrNilGasComputer
+	}
+	if check.IfNil(args.BlockChain) {
+		return ErrNilBlockChain
+	}
+	if check.IfNil(args.DriverFactory) {
+		return ErrNilReplayDriverFactory
+	}
+
+	return nil
+}
+
+// StartReplay starts, in background, the replay of the blocks in the [startNonce, endNonce] range to the provided
+// target. Only one replay can run at a time; its progress can be followed by calling GetStatus
+func (br *blockReplayer) StartReplay(startNonce uint64, endNonce uint64, target string) error {
+	if startNonce == 0 || startNonce > endNonce {
+		return fmt.Errorf("%w, start nonce: %d, end nonce: %d", ErrInvalidNonceRange, startNonce, endNonce)
+	}
+	if len(target) == 0 {
+		return ErrEmptyReplayTarget
+	}
+
+	br.mutStatus.Lock()
+	defer br.mutStatus.Unlock()
+
+	if br.status.InProgress {
+		return ErrReplayInProgress
+	}
+	if br.isClosed() {
+		return ErrReplayerClosed
+	}
+
+	driver, err := br.driverFactory.CreateReplayDriver(target)
+	if err != nil {
+		return err
+	}
+
+	br.status = common.OutportReplayStatus{
+		InProgress: true,
+		Target:     target,
+		StartNonce: startNonce,
+		EndNonce:   endNonce,
+	}
+
+	go br.replayBlocks(driver, startNonce, endNonce)
+
+	return nil
+}
+
+// GetStatus returns the status of the current or of the last replay
+func (br *blockReplayer) GetStatus() common.OutportReplayStatus {
+	br.mutStatus.RLock()
+	defer br.mutStatus.RUnlock()
+
+	return br.status
+}
+
+func (br *blockReplayer) replayBlocks(driver outport.Driver, startNonce uint64, endNonce uint64) {
+	defer func() {
+		log.LogIfError(driver.Close())
+	}()
+
+	log.Info("outport replay started", "start nonce", startNonce, "end nonce", endNonce)
+
+	var err error
+	for nonce := startNonce; nonce <= endNonce; nonce++ {
+		if br.isClosed() {
+			err = ErrReplayerClosed
+			break
+		}
+
+		err = br.replayBlock(driver, nonce)
+		if err != nil {
+			err = fmt.Errorf("%w while replaying block with nonce %d", err, nonce)
+			break
+		}
+
+		br.mutStatus.Lock()
+		br.status.LastReplayedNonce = nonce
+		br.status.NumReplayedBlocks++
+		br.mutStatus.Unlock()
+	}
+
+	br.mutStatus.Lock()
+	br.status.InProgress = false
+	if err != nil {
+		br.status.Error = err.Error()
+	}
+	status := br.status
+	br.mutStatus.Unlock()
+
+	if err != nil {
+		log.Error("outport replay failed", "num replayed blocks", status.NumReplayedBlocks, "error", err)
+		return
+	}
+
+	log.Info("outport replay finished", "num replayed blocks", status.NumReplayedBlocks)
+}
+
+func (br *blockReplayer) replayBlock(driver outport.Driver, nonce uint64) error {
+	headerHash, header, err := br.getHeaderByNonce(nonce)
+	if err != nil {
+		return err
+	}
+
+	body, err := br.getBody(header)
+	if err != nil {
+		return err
+	}
+
+	receiptsHolder, err := br.receiptsRepository.LoadReceipts(header, headerHash)
+	if err != nil {
+		return err
+	}
+	intraShardMiniBlocks := receiptsHolder.GetMiniblocks()
+
+	pool, err := br.createPool(header, body, intraShardMiniBlocks)
+	if err != nil {
+		return err
+	}
+
+	err = br.transactionsFeeHandler.PutFeeAndGasUsed(pool)
+	if err != nil {
+		return fmt.Errorf("transactionsFeeHandler.PutFeeAndGasUsed %w", err)
+	}
+
+	gasConsumption, err := br.computeGasConsumption(header, body, pool)
+	if err != nil {
+		return err
+	}
+
+	alteredAccounts, err := br.getAlteredAccounts(header, headerHash, pool)
+	if err != nil {
+		return err
+	}
+
+	signersIndexes, err := br.getSignersIndexes(header)
+	if err != nil {
+		// the consensus group for old epochs might not be available anymore
+		log.Debug("outport replay: cannot compute the signers indexes", "nonce", nonce, "error", err)
+	}
+
+	blockData, err := outport.PrepareBlockData(&outportcore.HeaderDataWithBody{
+		Body:                 body,
+		Header:               header,
+		HeaderHash:           headerHash,
+		IntraShardMiniBlocks: intraShardMiniBlocks,
+	}, driver.GetMarshaller())
+	if err != nil {
+		return err
+	}
+
+	// the replayed blocks are already final, the highest final block is the current one, so the consumers finality
+	// does not move backwards. No finalized block event is emitted for the replayed blocks
+	highestFinalBlockNonce, highestFinalBlockHash, _ := br.blockChain.GetFinalBlockInfo()
+	err = driver.SaveBlock(&outportcore.OutportBlock{
+		ShardID:                br.shardCoordinator.SelfId(),
+		BlockData:              blockData,
+		TransactionPool:        pool,
+		HeaderGasConsumption:   gasConsumption,
+		AlteredAccounts:        alteredAccounts,
+		NotarizedHeadersHashes: getNotarizedHeadersHashes(header),
+		NumberOfShards:         br.shardCoordinator.NumberOfShards(),
+		SignersIndexes:         signersIndexes,
+		HighestFinalBlockNonce: highestFinalBlockNonce,
+		HighestFinalBlockHash:  highestFinalBlockHash,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Debug("outport replay: block replayed", "nonce", nonce, "hash", headerHash)
+
+	return nil
+}
+
+func (br *blockReplayer) getHeaderByNonce(nonce uint64) ([]byte, data.HeaderHandler, error) {
+	selfShardID := br.shardCoordinator.SelfId()
+	nonceToHashUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(selfShardID)
+	headerUnit := dataRetriever.BlockHeaderUnit
+	if selfShardID == core.MetachainShardId {
+		nonceToHashUnit = dataRetriever.MetaHdrNonceHashDataUnit
+		headerUnit = dataRetriever.MetaBlockUnit
+	}
+
+	headerHash, err := br.store.Get(nonceToHashUnit, br.uint64ByteSliceConverter.ToByteSlice(nonce))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	headerBytes, err := br.store.Get(headerUnit, headerHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header, err := process.UnmarshalHeader(selfShardID, br.marshaller, headerBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return headerHash, header, nil
+}
+
+func (br *blockReplayer) getBody(header data.HeaderHandler) (*block.Body, error) {
+	storer, err := br.store.GetStorer(dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	body := &block.Body{
+		MiniBlocks: make([]*block.MiniBlock, 0, len(header.GetMiniBlockHeaderHandlers())),
+	}
+	for _, miniBlockHeader := range header.GetMiniBlockHeaderHandlers() {
+		miniBlockBytes, errGet := storer.GetFromEpoch(miniBlockHeader.GetHash(), header.GetEpoch())
+		if errGet != nil {
+			return nil, fmt.Errorf("%w for miniblock %s", errGet, hex.EncodeToString(miniBlockHeader.GetHash()))
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = br.marshaller.Unmarshal(miniBlock, miniBlockBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return body, nil
+}
+
+func (br *blockReplayer) createPool(
+	header data.HeaderHandler,
+	body *block.Body,
+	intraShardMiniBlocks []*block.MiniBlock,
+) (*outportcore.TransactionPool, error) {
+	pool := &outportcore.TransactionPool{
+		Transactions:         make(map[string]*outportcore.TxInfo),
+		SmartContractResults: make(map[string]*outportcore.SCRInfo),
+		InvalidTxs:           make(map[string]*outportcore.TxInfo),
+		Rewards:              make(map[string]*outportcore.RewardInfo),
+		Receipts:             make(map[string]*receipt.Receipt),
+		Logs:                 make([]*outportcore.LogData, 0),
+	}
+
+	miniBlockHeaders := header.GetMiniBlockHeaderHandlers()
+	logsKeys := make([][]byte, 0)
+	executionOrder := uint32(0)
+	for idx, miniBlock := range body.MiniBlocks {
+		txHashes := getExecutedTxHashes(miniBlock, miniBlockHeaders[idx])
+		txsKeys, err := br.addMiniBlockToPool(pool, miniBlock.Type, txHashes, header.GetEpoch(), &executionOrder)
+		if err != nil {
+			return nil, err
+		}
+		logsKeys = append(logsKeys, txsKeys...)
+	}
+	for _, miniBlock := range intraShardMiniBlocks {
+		txsKeys, err := br.addMiniBlockToPool(pool, miniBlock.Type, miniBlock.TxHashes, header.GetEpoch(), &executionOrder)
+		if err != nil {
+			return nil, err
+		}
+		logsKeys = append(logsKeys, txsKeys...)
+	}
+
+	logsByKey, err := br.logsFacade.GetLogs(logsKeys, header.GetEpoch())
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range logsKeys {
+		txLog, found := logsByKey[string(key)]
+		if !found {
+			continue
+		}
+
+		pool.Logs = append(pool.Logs, &outportcore.LogData{
+			TxHash: hex.EncodeToString(key),
+			Log:    txLog,
+		})
+	}
+
+	return pool, nil
+}
+
+// addMiniBlockToPool adds the transactions to the pool and returns the keys of the transactions that may have logs
+func (br *blockReplayer) addMiniBlockToPool(
+	pool *outportcore.TransactionPool,
+	miniBlockType block.Type,
+	txHashes [][]byte,
+	epoch uint32,
+	executionOrder *uint32,
+) ([][]byte, error) {
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock:
+		txs, err := br.loadTransactions(dataRetriever.TransactionUnit, txHashes, epoch, func() data.TransactionHandler {
+			return &transaction.Transaction{}
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		destination := pool.Transactions
+		if miniBlockType == block.InvalidBlock {
+			destination = pool.InvalidTxs
+		}
+		for _, txHash := range txHashes {
+			tx, ok := txs[string(txHash)].(*transaction.Transaction)
+			if !ok {
+				continue
+			}
+
+			destination[hex.EncodeToString(txHash)] = &outportcore.TxInfo{
+				Transaction:    tx,
+				FeeInfo:        newFeeInfo(),
+				ExecutionOrder: nextExecutionOrder(executionOrder),
+			}
+		}
+
+		return txHashes, nil
+	case block.SmartContractResultBlock:
+		scrs, err := br.loadTransactions(dataRetriever.UnsignedTransactionUnit, txHashes, epoch, func() data.TransactionHandler {
+			return &smartContractResult.SmartContractResult{}
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, txHash := range txHashes {
+			scr, ok := scrs[string(txHash)].(*smartContractResult.SmartContractResult)
+			if !ok {
+				continue
+			}
+
+			pool.SmartContractResults[hex.EncodeToString(txHash)] = &outportcore.SCRInfo{
+				SmartContractResult: scr,
+				FeeInfo:             newFeeInfo(),
+				ExecutionOrder:      nextExecutionOrder(executionOrder),
+			}
+		}
+
+		return txHashes, nil
+	case block.RewardsBlock:
+		rewards, err := br.loadTransactions(dataRetriever.RewardTransactionUnit, txHashes, epoch, func() data.TransactionHandler {
+			return &rewardTx.RewardTx{}
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, txHash := range txHashes {
+			reward, ok := rewards[string(txHash)].(*rewardTx.RewardTx)
+			if !ok {
+				continue
+			}
+
+			pool.Rewards[hex.EncodeToString(txHash)] = &outportcore.RewardInfo{
+				Reward:         reward,
+				ExecutionOrder: nextExecutionOrder(executionOrder),
+			}
+		}
+
+		return nil, nil
+	case block.ReceiptBlock:
+		// the receipts are saved in the unsigned transactions unit
+		receipts, err := br.loadTransactions(dataRetriever.UnsignedTransactionUnit, txHashes, epoch, func() data.TransactionHandler {
+			return &receipt.Receipt{}
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, txHash := range txHashes {
+			rcpt, ok := receipts[string(txHash)].(*receipt.Receipt)
+			if !ok {
+				continue
+			}
+
+			pool.Receipts[hex.EncodeToString(txHash)] = rcpt
+		}
+
+		return nil, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (br *blockReplayer) loadTransactions(
+	unit dataRetriever.UnitType,
+	txHashes [][]byte,
+	epoch uint32,
+	createEmptyTx func() data.TransactionHandler,
+) (map[string]data.TransactionHandler, error) {
+	txs := make(map[string]data.TransactionHandler, len(txHashes))
+	if len(txHashes) == 0 {
+		return txs, nil
+	}
+
+	storer, err := br.store.GetStorer(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs, err := storer.GetBulkFromEpoch(txHashes, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pair := range pairs {
+		tx := createEmptyTx()
+		err = br.marshaller.Unmarshal(tx, pair.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w for transaction %s", err, hex.EncodeToString(pair.Key))
+		}
+
+		txs[string(pair.Key)] = tx
+	}
+
+	if len(txs) != len(txHashes) {
+		return nil, fmt.Errorf("%w in unit %s, num requested: %d, num found: %d", ErrMissingTransactions, unit.String(), len(txHashes), len(txs))
+	}
+
+	return txs, nil
+}
+
+// computeGasConsumption rebuilds the gas consumption counters of the block, which are not stored. The gas provided is
+// computed the way the processing did, for the transactions and the incoming results executed in the block, while the
+// gas refunded and the gas penalized are read back from the refunds and the penalties issued in the block
+func (br *blockReplayer) computeGasConsumption(
+	header data.HeaderHandler,
+	body *block.Body,
+	pool *outportcore.TransactionPool,
+) (*outportcore.HeaderGasConsumption, error) {
+	gasProvided, err := br.computeGasProvided(header, body, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	gasRefunded, gasPenalized := br.computeGasRefundedAndPenalized(pool)
+
+	return &outportcore.HeaderGasConsumption{
+		GasProvided:    gasProvided,
+		GasRefunded:    gasRefunded,
+		GasPenalized:   gasPenalized,
+		MaxGasPerBlock: br.economicsData.MaxGasLimitPerBlock(br.shardCoordinator.SelfId()),
+	}, nil
+}
+
+func (br *blockReplayer) computeGasProvided(header data.HeaderHandler, body *block.Body, pool *outportcore.TransactionPool) (uint64, error) {
+	selfShardID := br.shardCoordinator.SelfId()
+	miniBlockHeaders := header.GetMiniBlockHeaderHandlers()
+	gasProvided := uint64(0)
+	for idx, miniBlock := range body.MiniBlocks {
+		for _, txHash := range getExecutedTxHashes(miniBlock, miniBlockHeaders[idx]) {
+			tx, found := getGasConsumingTx(pool, miniBlock, selfShardID, hex.EncodeToString(txHash))
+			if !found {
+				continue
+			}
+
+			gasProvidedInSenderShard, gasProvidedInReceiverShard, err := br.gasComputer.ComputeGasProvidedByTx(miniBlock.SenderShardID, miniBlock.ReceiverShardID, tx)
+			if err != nil {
+				return 0, fmt.Errorf("%w for transaction %s", err, hex.EncodeToString(txHash))
+			}
+
+			if miniBlock.SenderShardID == selfShardID {
+				gasProvided += gasProvidedInSenderShard
+				continue
+			}
+			gasProvided += gasProvidedInReceiverShard
+		}
+	}
+
+	return gasProvided, nil
+}
+
+// getGasConsumingTx returns the transaction if it consumed gas of the block: the transactions and the results coming
+// from other shards. The results generated in the block consumed the gas of the transactions that generated them
+func getGasConsumingTx(
+	pool *outportcore.TransactionPool,
+	miniBlock *block.MiniBlock,
+	selfShardID uint32,
+	txHash string,
+) (data.TransactionHandler, bool) {
+	switch {
+	case miniBlock.Type == block.TxBlock:
+		txInfo, found := pool.Transactions[txHash]
+		if !found {
+			return nil, false
+		}
+		return txInfo.Transaction, true
+	case miniBlock.Type == block.SmartContractResultBlock && miniBlock.SenderShardID != selfShardID:
+		scrInfo, found := pool.SmartContractResults[txHash]
+		if !found {
+			return nil, false
+		}
+		return scrInfo.SmartContractResult, true
+	default:
+		return nil, false
+	}
+}
+
+func (br *blockReplayer) computeGasRefundedAndPenalized(pool *outportcore.TransactionPool) (uint64, uint64) {
+	selfShardID := br.shardCoordinator.SelfId()
+	gasRefunded := uint64(0)
+	gasPenalized := uint64(0)
+	for _, scrInfo := range pool.SmartContractResults {
+		scr := scrInfo.SmartContractResult
+		// the results received from other shards were refunded or penalized in their sender shard
+		if br.shardCoordinator.ComputeId(scr.SndAddr) != selfShardID {
+			continue
+		}
+
+		gasRefunded += br.computeRefundedGas(scr)
+		gasPenalized += getPenalizedGas(scr.ReturnMessage)
+	}
+
+	// the informative results, as the ones of the penalized calls, are saved as logs instead of results
+	for _, logData := range pool.Logs {
+		if logData.Log == nil {
+			continue
+		}
+
+		for _, event := range logData.Log.Events {
+			isWriteLog := event != nil && string(event.Identifier) == core.WriteLogIdentifier && len(event.Topics) > 1
+			if isWriteLog {
+				gasPenalized += getPenalizedGas(event.Topics[1])
+			}
+		}
+	}
+
+	return gasRefunded, gasPenalized
+}
+
+func (br *blockReplayer) computeRefundedGas(scr *smartContractResult.SmartContractResult) uint64 {
+	isRefundForRelayer := string(scr.ReturnMessage) == core.GasRefundForRelayerMessage
+	isRefund := scr.CallType == vm.DirectCall && scr.Value != nil && scr.Value.Sign() > 0 &&
+		(hasOkReturnCode(scr.Data) || isRefundForRelayer)
+	if !isRefund {
+		return 0
+	}
+
+	gasPrice := br.economicsData.GasPriceForProcessing(scr)
+	if gasPrice == 0 {
+		return 0
+	}
+
+	return big.NewInt(0).Div(scr.Value, big.NewInt(0).SetUint64(gasPrice)).Uint64()
+}
+
+func hasOkReturnCode(scrData []byte) bool {
+	okReturnData := []byte("@" + hex.EncodeToString([]byte(vmcommon.Ok.String())))
+	okReturnDataOldVersion := []byte("@" + vmcommon.Ok.String())
+
+	return bytes.HasPrefix(scrData, okReturnData) || bytes.HasPrefix(scrData, okReturnDataOldVersion)
+}
+
+// getPenalizedGas returns the gas penalized for providing too much gas, read from the return message
+func getPenalizedGas(returnMessage []byte) uint64 {
+	idx := bytes.Index(returnMessage, []byte(smartContract.TooMuchGasProvidedMessage))
+	if idx < 0 {
+		return 0
+	}
+	message := string(returnMessage[idx:])
+
+	gasProvided, gasUsed := uint64(0), uint64(0)
+	_, err := fmt.Sscanf(message, smartContract.TooMuchGasProvidedMessage+" for processing: gas provided = %d, gas used = %d", &gasProvided, &gasUsed)
+	if err == nil && gasProvided >= gasUsed {
+		return gasProvided - gasUsed
+	}
+
+	// backwards compatible message, holding the gas remained
+	gasNeeded, gasRemained := uint64(0), uint64(0)
+	_, err = fmt.Sscanf(message, smartContract.TooMuchGasProvidedMessage+": gas needed = %d, gas remained = %d", &gasNeeded, &gasRemained)
+	if err == nil {
+		return gasRemained
+	}
+
+	return 0
+}
+
+func (br *blockReplayer) getAlteredAccounts(
+	header data.HeaderHandler,
+	headerHash []byte,
+	pool *outportcore.TransactionPool,
+) (map[string]*alteredAccount.AlteredAccount, error) {
+	blockRootHash, err := br.scheduledTxsExecutionHandler.GetScheduledRootHashForHeaderWithEpoch(headerHash, header.GetEpoch())
+	if err != nil {
+		blockRootHash = header.GetRootHash()
+	}
+
+	// the accounts are read from the historical state, as they were right after the block was processed
+	return br.alteredAccountsProvider.ExtractAlteredAccountsFromPool(pool, shared.AlteredAccountsOptions{
+		WithAdditionalOutportData:    true,
+		WithCustomAccountsRepository: true,
+		AccountsRepository:           br.accountsRepository,
+		AccountQueryOptions: api.AccountQueryOptions{
+			BlockHash:     headerHash,
+			BlockNonce:    core.OptionalUint64{HasValue: true, Value: header.GetNonce()},
+			BlockRootHash: blockRootHash,
+			HintEpoch:     core.OptionalUint32{HasValue: true, Value: header.GetEpoch()},
+		},
+	})
+}
+
+func (br *blockReplayer) getSignersIndexes(header data.HeaderHandler) ([]uint64, error) {
+	selfShardID := br.shardCoordinator.SelfId()
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 && selfShardID != core.MetachainShardId {
+		epoch--
+	}
+
+	pubKeys, err := br.nodesCoordinator.GetConsensusValidatorsPublicKeys(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		selfShardID,
+		epoch,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return br.nodesCoordinator.GetValidatorsIndexes(pubKeys, epoch)
+}
+
+func getNotarizedHeadersHashes(header data.HeaderHandler) []string {
+	metaHeader, ok := header.(data.MetaHeaderHandler)
+	if !ok {
+		return nil
+	}
+
+	hashes := make([]string, 0, len(metaHeader.GetShardInfoHandlers()))
+	for _, shardData := range metaHeader.GetShardInfoHandlers() {
+		hashes = append(hashes, hex.EncodeToString(shardData.GetHeaderHash()))
+	}
+
+	return hashes
+}
+
+func getExecutedTxHashes(miniBlock *block.MiniBlock, miniBlockHeader data.MiniBlockHeaderHandler) [][]byte {
+	firstIndex := int(miniBlockHeader.GetIndexOfFirstTxProcessed())
+	lastIndex := int(miniBlockHeader.GetIndexOfLastTxProcessed())
+	if firstIndex < 0 || lastIndex >= len(miniBlock.TxHashes) || firstIndex > lastIndex {
+		return miniBlock.TxHashes
+	}
+
+	return miniBlock.TxHashes[firstIndex : lastIndex+1]
+}
+
+func nextExecutionOrder(executionOrder *uint32) uint32 {
+	order := *executionOrder
+	*executionOrder++
+
+	return order
+}
+
+func newFeeInfo() *outportcore.FeeInfo {
+	return &outportcore.FeeInfo{
+		GasUsed:        0,
+		Fee:            big.NewInt(0),
+		InitialPaidFee: big.NewInt(0),
+	}
+}
+
+func (br *blockReplayer) isClosed() bool {
+	select {
+	case <-br.chanClose:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close stops the replay in progress, if any
+func (br *blockReplayer) Close() error {
+	br.closeOnce.Do(func() {
+		close(br.chanClose)
+	})
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (br *blockReplayer) IsInterfaceNil() bool {
+	return br == nil
+}
@@ -0,0 +1,567 @@
+package replay
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/mock"
+	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts/shared"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	outportStub "github.com/multiversx/mx-chain-go/testscommon/outport"
+	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+const replayTarget = "eventNotifier"
+
+func createMockArgsBlockReplayer() ArgsBlockReplayer {
+	return ArgsBlockReplayer{
+		ShardCoordinator:             testscommon.NewMultiShardsCoordinatorMock(2),
+		Store:                        genericMocks.NewChainStorerMock(0),
+		Marshaller:                   &marshallerMock.MarshalizerMock{},
+		Uint64ByteSliceConverter:     testscommon.NewNonceHashConverterMock(),
+		ReceiptsRepository:           &testscommon.ReceiptsRepositoryStub{},
+		LogsFacade:                   &testscommon.LogsFacadeStub{},
+		TransactionsFeeHandler:       &outportStub.TransactionsFeeHandlerStub{},
+		AlteredAccountsProvider:      &testscommon.AlteredAccountsProviderStub{},
+		AccountsRepository:           &state.AccountsRepositoryStub{},
+		ScheduledTxsExecutionHandler: &testscommon.ScheduledTxsExecutionStub{},
+		NodesCoordinator:             &shardingMocks.NodesCoordinatorStub{},
+		EconomicsData:                &economicsmocks.EconomicsHandlerStub{},
+		GasComputer:                  &testscommon.GasHandlerStub{},
+		BlockChain:                   &testscommon.ChainHandlerStub{},
+		DriverFactory:                &outportStub.ReplayDriverFactoryStub{},
+	}
+}
+
+func createDriverFactory(driver outport.Driver) *outportStub.ReplayDriverFactoryStub {
+	return &outportStub.ReplayDriverFactoryStub{
+		CreateReplayDriverCalled: func(target string) (outport.Driver, error) {
+			return driver, nil
+		},
+	}
+}
+
+func storeBlock(t *testing.T, args ArgsBlockReplayer, nonce uint64, miniBlocks []*block.MiniBlock) []byte {
+	miniBlockHeaders := make([]block.MiniBlockHeader, 0, len(miniBlocks))
+	for idx, miniBlock := range miniBlocks {
+		miniBlockBytes, err := args.Marshaller.Marshal(miniBlock)
+		require.Nil(t, err)
+
+		miniBlockHash := []byte{byte(nonce), byte(idx)}
+		require.Nil(t, args.Store.Put(dataRetriever.MiniBlockUnit, miniBlockHash, miniBlockBytes))
+		miniBlockHeaders = append(miniBlockHeaders, block.MiniBlockHeader{
+			Hash:    miniBlockHash,
+			Type:    miniBlock.Type,
+			TxCount: uint32(len(miniBlock.TxHashes)),
+		})
+	}
+
+	header := &block.Header{
+		Nonce:            nonce,
+		Round:            nonce,
+		RootHash:         []byte("root hash"),
+		MiniBlockHeaders: miniBlockHeaders,
+	}
+	headerBytes, err := args.Marshaller.Marshal(header)
+	require.Nil(t, err)
+
+	headerHash := []byte{'h', byte(nonce)}
+	require.Nil(t, args.Store.Put(dataRetriever.BlockHeaderUnit, headerHash, headerBytes))
+	require.Nil(t, args.Store.Put(dataRetriever.ShardHdrNonceHashDataUnit, args.Uint64ByteSliceConverter.ToByteSlice(nonce), headerHash))
+
+	return headerHash
+}
+
+func storeObject(t *testing.T, args ArgsBlockReplayer, unit dataRetriever.UnitType, key []byte, object interface{}) {
+	buff, err := args.Marshaller.Marshal(object)
+	require.Nil(t, err)
+	require.Nil(t, args.Store.Put(unit, key, buff))
+}
+
+func waitReplayToFinish(t *testing.T, replayer *blockReplayer) common.OutportReplayStatus {
+	timeout := time.After(time.Second * 5)
+	for {
+		status := replayer.GetStatus()
+		if !status.InProgress {
+			return status
+		}
+
+		select {
+		case <-timeout:
+			require.Fail(t, "timeout while waiting for the replay to finish")
+			return status
+		case <-time.After(time.Millisecond * 10):
+		}
+	}
+}
+
+func TestNewBlockReplayer(t *testing.T) {
+	t.Parallel()
+
+	testNilArg := func(setNil func(args *ArgsBlockReplayer), expectedErr error) {
+		args := createMockArgsBlockReplayer()
+		setNil(&args)
+
+		replayer, err := NewBlockReplayer(args)
+		require.Nil(t, replayer)
+		require.Equal(t, expectedErr, err)
+	}
+
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.ShardCoordinator = nil }, ErrNilShardCoordinator)
+	})
+	t.Run("nil storage service should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.Store = nil }, ErrNilStorageService)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.Marshaller = nil }, ErrNilMarshaller)
+	})
+	t.Run("nil uint64 byte slice converter should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.Uint64ByteSliceConverter = nil }, ErrNilUint64ByteSliceConverter)
+	})
+	t.Run("nil receipts repository should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.ReceiptsRepository = nil }, ErrNilReceiptsRepository)
+	})
+	t.Run("nil logs facade should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.LogsFacade = nil }, ErrNilLogsFacade)
+	})
+	t.Run("nil transactions fee handler should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.TransactionsFeeHandler = nil }, ErrNilTransactionsFeeHandler)
+	})
+	t.Run("nil altered accounts provider should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.AlteredAccountsProvider = nil }, ErrNilAlteredAccountsProvider)
+	})
+	t.Run("nil accounts repository should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.AccountsRepository = nil }, ErrNilAccountsRepository)
+	})
+	t.Run("nil scheduled txs execution handler should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.ScheduledTxsExecutionHandler = nil }, ErrNilScheduledTxsExecutionHandler)
+	})
+	t.Run("nil nodes coordinator should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.NodesCoordinator = nil }, ErrNilNodesCoordinator)
+	})
+	t.Run("nil economics data should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.EconomicsData = nil }, ErrNilEconomicsData)
+	})
+	t.Run("nil gas computer should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.GasComputer = nil }, ErrNilGasComputer)
+	})
+	t.Run("nil block chain should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.BlockChain = nil }, ErrNilBlockChain)
+	})
+	t.Run("nil driver factory should error", func(t *testing.T) {
+		testNilArg(func(args *ArgsBlockReplayer) { args.DriverFactory = nil }, ErrNilReplayDriverFactory)
+	})
+	t.Run("should work", func(t *testing.T) {
+		replayer, err := NewBlockReplayer(createMockArgsBlockReplayer())
+		require.Nil(t, err)
+		require.False(t, replayer.IsInterfaceNil())
+		require.Equal(t, common.OutportReplayStatus{}, replayer.GetStatus())
+	})
+}
+
+func TestBlockReplayer_StartReplay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce range should error", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, _ := NewBlockReplayer(createMockArgsBlockReplayer())
+
+		err := replayer.StartReplay(0, 10, replayTarget)
+		require.ErrorIs(t, err, ErrInvalidNonceRange)
+
+		err = replayer.StartReplay(11, 10, replayTarget)
+		require.ErrorIs(t, err, ErrInvalidNonceRange)
+	})
+	t.Run("empty target should error", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, _ := NewBlockReplayer(createMockArgsBlockReplayer())
+
+		err := replayer.StartReplay(1, 10, "")
+		require.Equal(t, ErrEmptyReplayTarget, err)
+	})
+	t.Run("driver creation error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockReplayer()
+		args.DriverFactory = &outportStub.ReplayDriverFactoryStub{
+			CreateReplayDriverCalled: func(target string) (outport.Driver, error) {
+				require.Equal(t, replayTarget, target)
+				return nil, expectedErr
+			},
+		}
+		replayer, _ := NewBlockReplayer(args)
+
+		err := replayer.StartReplay(1, 10, replayTarget)
+		require.Equal(t, expectedErr, err)
+		require.False(t, replayer.GetStatus().InProgress)
+	})
+	t.Run("closed replayer should error", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, _ := NewBlockReplayer(createMockArgsBlockReplayer())
+		require.Nil(t, replayer.Close())
+		require.Nil(t, replayer.Close())
+
+		err := replayer.StartReplay(1, 10, replayTarget)
+		require.Equal(t, ErrReplayerClosed, err)
+	})
+	t.Run("replay in progress should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockReplayer()
+		storeBlock(t, args, 1, nil)
+		chanRelease := make(chan struct{})
+		args.DriverFactory = createDriverFactory(&mock.DriverStub{
+			SaveBlockCalled: func(_ *outportcore.OutportBlock) error {
+				<-chanRelease
+				return nil
+			},
+		})
+		replayer, _ := NewBlockReplayer(args)
+
+		require.Nil(t, replayer.StartReplay(1, 1, replayTarget))
+		require.Equal(t, ErrReplayInProgress, replayer.StartReplay(1, 1, replayTarget))
+
+		close(chanRelease)
+		status := waitReplayToFinish(t, replayer)
+		require.Empty(t, status.Error)
+		require.Equal(t, uint64(1), status.NumReplayedBlocks)
+	})
+	t.Run("missing block should record the error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockReplayer()
+		storeBlock(t, args, 1, nil)
+		replayer, _ := NewBlockReplayer(args)
+
+		require.Nil(t, replayer.StartReplay(1, 2, replayTarget))
+		status := waitReplayToFinish(t, replayer)
+		require.Equal(t, uint64(1), status.LastReplayedNonce)
+		require.Equal(t, uint64(1), status.NumReplayedBlocks)
+		require.Contains(t, status.Error, "nonce 2")
+	})
+	t.Run("missing transaction should record the error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockReplayer()
+		storeObject(t, args, dataRetriever.TransactionUnit, []byte("tx"), &transaction.Transaction{Nonce: 7})
+		storeBlock(t, args, 1, []*block.MiniBlock{
+			{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx"), []byte("missing tx")}},
+		})
+		args.DriverFactory = createDriverFactory(&mock.DriverStub{
+			SaveBlockCalled: func(_ *outportcore.OutportBlock) error {
+				require.Fail(t, "should have not saved a block with missing transactions")
+				return nil
+			},
+		})
+		replayer, _ := NewBlockReplayer(args)
+
+		require.Nil(t, replayer.StartReplay(1, 1, replayTarget))
+		status := waitReplayToFinish(t, replayer)
+		require.Zero(t, status.NumReplayedBlocks)
+		require.Contains(t, status.Error, ErrMissingTransactions.Error())
+		require.Contains(t, status.Error, "nonce 1")
+	})
+	t.Run("driver error should record the error and close the driver", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockReplayer()
+		storeBlock(t, args, 1, nil)
+		chanClosed := make(chan struct{})
+		args.DriverFactory = createDriverFactory(&mock.DriverStub{
+			SaveBlockCalled: func(_ *outportcore.OutportBlock) error {
+				return expectedErr
+			},
+			CloseCalled: func() error {
+				close(chanClosed)
+				return nil
+			},
+		})
+		replayer, _ := NewBlockReplayer(args)
+
+		require.Nil(t, replayer.StartReplay(1, 1, replayTarget))
+		status := waitReplayToFinish(t, replayer)
+		require.Zero(t, status.NumReplayedBlocks)
+		require.Contains(t, status.Error, expectedErr.Error())
+
+		select {
+		case <-chanClosed:
+		case <-time.After(time.Second):
+			require.Fail(t, "the replay driver was not closed")
+		}
+	})
+	t.Run("should replay the stored blocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockReplayer()
+		tx := &transaction.Transaction{Nonce: 7, SndAddr: []byte("sender"), RcvAddr: []byte("receiver")}
+		scr := &smartContractResult.SmartContractResult{Nonce: 8, OriginalTxHash: []byte("tx")}
+		storeObject(t, args, dataRetriever.TransactionUnit, []byte("tx"), tx)
+		storeObject(t, args, dataRetriever.UnsignedTransactionUnit, []byte("scr"), scr)
+		firstHash := storeBlock(t, args, 1, []*block.MiniBlock{
+			{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx")}},
+			{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scr")}},
+		})
+		secondHash := storeBlock(t, args, 2, nil)
+
+		providedLog := &transaction.Log{Address: []byte("receiver")}
+		args.LogsFacade = &testscommon.LogsFacadeStub{
+			GetLogsCalled: func(logsKeys [][]byte, epoch uint32) (map[string]*transaction.Log, error) {
+				return map[string]*transaction.Log{"tx": providedLog}, nil
+			},
+		}
+		providedAlteredAccounts := map[string]*alteredAccount.AlteredAccount{
+			"sender": {Address: "sender"},
+		}
+		args.AlteredAccountsProvider = &testscommon.AlteredAccountsProviderStub{
+			ExtractAlteredAccountsFromPoolCalled: func(_ *outportcore.TransactionPool, options shared.AlteredAccountsOptions) (map[string]*alteredAccount.AlteredAccount, error) {
+				require.True(t, options.WithCustomAccountsRepository)
+				require.Equal(t, args.AccountsRepository, options.AccountsRepository)
+				require.Equal(t, []byte("root hash"), options.AccountQueryOptions.BlockRootHash)
+				return providedAlteredAccounts, nil
+			},
+		}
+		args.ScheduledTxsExecutionHandler = &testscommon.ScheduledTxsExecutionStub{
+			GetScheduledRootHashForHeaderWithEpochCalled: func(headerHash []byte, epoch uint32) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		numFeeCalls := 0
+		args.TransactionsFeeHandler = &outportStub.TransactionsFeeHandlerStub{
+			PutFeeAndGasUsedCalled: func(pool *outportcore.TransactionPool) error {
+				numFeeCalls++
+				return nil
+			},
+		}
+
+		args.BlockChain = &testscommon.ChainHandlerStub{
+			GetFinalBlockInfoCalled: func() (uint64, []byte, []byte) {
+				return 100, []byte("final hash"), []byte("final root hash")
+			},
+		}
+
+		mut := sync.Mutex{}
+		savedBlocks := make([]*outportcore.OutportBlock, 0)
+		args.DriverFactory = createDriverFactory(&mock.DriverStub{
+			SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+				mut.Lock()
+				savedBlocks = append(savedBlocks, outportBlock)
+				mut.Unlock()
+				return nil
+			},
+			FinalizedBlockCalled: func(_ *outportcore.FinalizedBlock) error {
+				require.Fail(t, "should have not emitted a finalized block for a replayed block")
+				return nil
+			},
+		})
+		replayer, _ := NewBlockReplayer(args)
+
+		require.Nil(t, replayer.StartReplay(1, 2, replayTarget))
+		status := waitReplayToFinish(t, replayer)
+		require.Equal(t, common.OutportReplayStatus{
+			Target:            replayTarget,
+			StartNonce:        1,
+			EndNonce:          2,
+			LastReplayedNonce: 2,
+			NumReplayedBlocks: 2,
+		}, status)
+		require.Equal(t, 2, numFeeCalls)
+
+		mut.Lock()
+		defer mut.Unlock()
+
+		require.Len(t, savedBlocks, 2)
+
+		firstBlock := savedBlocks[0]
+		require.Equal(t, firstHash, firstBlock.BlockData.HeaderHash)
+		header := &block.Header{}
+		require.Nil(t, args.Marshaller.Unmarshal(header, firstBlock.BlockData.HeaderBytes))
+		require.Equal(t, uint64(1), header.GetNonce())
+		require.Len(t, firstBlock.BlockData.Body.MiniBlocks, 2)
+		require.Equal(t, uint32(0), firstBlock.ShardID)
+		require.Equal(t, providedAlteredAccounts, firstBlock.AlteredAccounts)
+		require.Equal(t, uint64(100), firstBlock.HighestFinalBlockNonce)
+		require.Equal(t, []byte("final hash"), firstBlock.HighestFinalBlockHash)
+
+		pool := firstBlock.TransactionPool
+		txInfo := pool.Transactions[hex.EncodeToString([]byte("tx"))]
+		require.NotNil(t, txInfo)
+		require.Equal(t, tx, txInfo.Transaction)
+		require.Equal(t, uint32(0), txInfo.ExecutionOrder)
+		scrInfo := pool.SmartContractResults[hex.EncodeToString([]byte("scr"))]
+		require.NotNil(t, scrInfo)
+		require.Equal(t, scr, scrInfo.SmartContractResult)
+		require.Equal(t, uint32(1), scrInfo.ExecutionOrder)
+		require.Equal(t, []*outportcore.LogData{{TxHash: hex.EncodeToString([]byte("tx")), Log: providedLog}}, pool.Logs)
+
+		require.Equal(t, secondHash, savedBlocks[1].BlockData.HeaderHash)
+		require.Empty(t, savedBlocks[1].TransactionPool.Transactions)
+		require.Equal(t, uint64(100), savedBlocks[1].HighestFinalBlockNonce)
+	})
+	t.Run("should rebuild the gas consumption", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockReplayer()
+		shardCoordinator := testscommon.NewMultiShardsCoordinatorMock(2)
+		shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+			return uint32(address[len(address)-1]) % 2
+		}
+		args.ShardCoordinator = shardCoordinator
+
+		crossShardTx := &transaction.Transaction{Nonce: 1, SndAddr: []byte("sender0"), RcvAddr: []byte("contract1"), GasLimit: 100}
+		incomingScr := &smartContractResult.SmartContractResult{Nonce: 2, SndAddr: []byte("contract1"), RcvAddr: []byte("contract0"), GasLimit: 200}
+		refundScr := &smartContractResult.SmartContractResult{
+			Nonce:    3,
+			SndAddr:  []byte("contract0"),
+			RcvAddr:  []byte("sender1"),
+			Value:    big.NewInt(1000),
+			GasPrice: 100,
+			Data:     []byte("@" + hex.EncodeToString([]byte("ok"))),
+			CallType: vm.DirectCall,
+		}
+		penalizedScr := &smartContractResult.SmartContractResult{
+			Nonce:         4,
+			SndAddr:       []byte("contract0"),
+			RcvAddr:       []byte("sender1"),
+			Value:         big.NewInt(0),
+			ReturnMessage: []byte("@too much gas provided for processing: gas provided = 500, gas used = 300"),
+		}
+		storeObject(t, args, dataRetriever.TransactionUnit, []byte("tx"), crossShardTx)
+		storeObject(t, args, dataRetriever.UnsignedTransactionUnit, []byte("incoming scr"), incomingScr)
+		storeObject(t, args, dataRetriever.UnsignedTransactionUnit, []byte("refund scr"), refundScr)
+		storeObject(t, args, dataRetriever.UnsignedTransactionUnit, []byte("penalized scr"), penalizedScr)
+		storeBlock(t, args, 1, []*block.MiniBlock{
+			{Type: block.TxBlock, SenderShardID: 0, ReceiverShardID: 1, TxHashes: [][]byte{[]byte("tx")}},
+			{Type: block.SmartContractResultBlock, SenderShardID: 1, ReceiverShardID: 0, TxHashes: [][]byte{[]byte("incoming scr")}},
+			{Type: block.SmartContractResultBlock, SenderShardID: 0, ReceiverShardID: 1, TxHashes: [][]byte{[]byte("refund scr"), []byte("penalized scr")}},
+		})
+
+		args.LogsFacade = &testscommon.LogsFacadeStub{
+			GetLogsCalled: func(logsKeys [][]byte, epoch uint32) (map[string]*transaction.Log, error) {
+				return map[string]*transaction.Log{
+					"incoming scr": {
+						Events: []*transaction.Event{
+							{
+								Identifier: []byte(core.WriteLogIdentifier),
+								Topics:     [][]byte{[]byte("sender1"), []byte("@too much gas provided: gas needed = 5, gas remained = 7")},
+							},
+						},
+					},
+				}, nil
+			},
+		}
+		args.GasComputer = &testscommon.GasHandlerStub{
+			ComputeGasProvidedByTxCalled: func(txSenderShardId uint32, txReceiverShardId uint32, txHandler data.TransactionHandler) (uint64, uint64, error) {
+				switch txHandler.GetNonce() {
+				case crossShardTx.Nonce:
+					return 10, 90, nil
+				case incomingScr.Nonce:
+					return 20, 180, nil
+				default:
+					require.Fail(t, "should have not computed the gas provided by the results generated in the block")
+					return 0, 0, nil
+				}
+			},
+		}
+		args.EconomicsData = &economicsmocks.EconomicsHandlerStub{
+			MaxGasLimitPerBlockCalled: func(shardID uint32) uint64 {
+				return 1500
+			},
+			GasPriceForProcessingCalled: func(tx data.TransactionWithFeeHandler) uint64 {
+				return tx.GetGasPrice() / 10
+			},
+		}
+
+		var savedBlock *outportcore.OutportBlock
+		mut := sync.Mutex{}
+		args.DriverFactory = createDriverFactory(&mock.DriverStub{
+			SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+				mut.Lock()
+				savedBlock = outportBlock
+				mut.Unlock()
+				return nil
+			},
+		})
+		replayer, _ := NewBlockReplayer(args)
+
+		require.Nil(t, replayer.StartReplay(1, 1, replayTarget))
+		status := waitReplayToFinish(t, replayer)
+		require.Empty(t, status.Error)
+
+		mut.Lock()
+		defer mut.Unlock()
+
+		require.NotNil(t, savedBlock)
+		require.Equal(t, &outportcore.HeaderGasConsumption{
+			GasProvided:    10 + 180,
+			GasRefunded:    100,
+			GasPenalized:   200 + 7,
+			MaxGasPerBlock: 1500,
+		}, savedBlock.HeaderGasConsumption)
+	})
+	t.Run("metachain should read the meta blocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockReplayer()
+		shardCoordinator := testscommon.NewMultiShardsCoordinatorMock(2)
+		shardCoordinator.CurrentShard = core.MetachainShardId
+		args.ShardCoordinator = shardCoordinator
+
+		metaBlock := &block.MetaBlock{
+			Nonce: 1,
+			ShardInfo: []block.ShardData{
+				{HeaderHash: []byte("shard header")},
+			},
+		}
+		storeObject(t, args, dataRetriever.MetaBlockUnit, []byte("meta hash"), metaBlock)
+		require.Nil(t, args.Store.Put(dataRetriever.MetaHdrNonceHashDataUnit, args.Uint64ByteSliceConverter.ToByteSlice(1), []byte("meta hash")))
+
+		var savedBlock *outportcore.OutportBlock
+		mut := sync.Mutex{}
+		args.DriverFactory = createDriverFactory(&mock.DriverStub{
+			SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+				mut.Lock()
+				savedBlock = outportBlock
+				mut.Unlock()
+				return nil
+			},
+		})
+		replayer, _ := NewBlockReplayer(args)
+
+		require.Nil(t, replayer.StartReplay(1, 1, replayTarget))
+		status := waitReplayToFinish(t, replayer)
+		require.Empty(t, status.Error)
+
+		mut.Lock()
+		defer mut.Unlock()
+
+		require.NotNil(t, savedBlock)
+		require.Equal(t, core.MetachainShardId, savedBlock.ShardID)
+		require.Equal(t, []string{hex.EncodeToString([]byte("shard header"))}, savedBlock.NotarizedHeadersHashes)
+	})
+}
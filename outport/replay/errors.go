@@ -0,0 +1,63 @@
+package replay
+
+import "errors"
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilUint64ByteSliceConverter signals that a nil uint64 byte slice converter has been provided
+var ErrNilUint64ByteSliceConverter = errors.New("nil uint64 byte slice converter")
+
+// ErrNilReceiptsRepository signals that a nil receipts repository has been provided
+var ErrNilReceiptsRepository = errors.New("nil receipts repository")
+
+// ErrNilLogsFacade signals that a nil logs facade has been provided
+var ErrNilLogsFacade = errors.New("nil logs facade")
+
+// ErrNilTransactionsFeeHandler signals that a nil transactions fee handler has been provided
+var ErrNilTransactionsFeeHandler = errors.New("nil transactions fee handler")
+
+// ErrNilAlteredAccountsProvider signals that a nil altered accounts provider has been provided
+var ErrNilAlteredAccountsProvider = errors.New("nil altered accounts provider")
+
+// ErrNilAccountsRepository signals that a nil accounts repository has been provided
+var ErrNilAccountsRepository = errors.New("nil accounts repository")
+
+// ErrNilScheduledTxsExecutionHandler signals that a nil scheduled txs execution handler has been provided
+var ErrNilScheduledTxsExecutionHandler = errors.New("nil scheduled txs execution handler")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilEconomicsData signals that a nil economics data handler has been provided
+var ErrNilEconomicsData = errors.New("nil economics data")
+
+// ErrNilGasComputer signals that a nil gas computer has been provided
+var ErrNilGasComputer = errors.New("nil gas computer")
+
+// ErrNilBlockChain signals that a nil block chain handler has been provided
+var ErrNilBlockChain = errors.New("nil block chain")
+
+// ErrNilReplayDriverFactory signals that a nil replay driver factory has been provided
+var ErrNilReplayDriverFactory = errors.New("nil replay driver factory")
+
+// ErrEmptyReplayTarget signals that an empty replay target has been provided
+var ErrEmptyReplayTarget = errors.New("empty replay target")
+
+// ErrInvalidNonceRange signals that an invalid nonce range has been provided
+var ErrInvalidNonceRange = errors.New("invalid nonce range")
+
+// ErrReplayInProgress signals that another replay is in progress
+var ErrReplayInProgress = errors.New("another replay is in progress")
+
+// ErrReplayerClosed signals that the replayer was closed
+var ErrReplayerClosed = errors.New("replayer closed")
+
+// ErrMissingTransactions signals that not all the transactions of a replayed block were found in storage
+var ErrMissingTransactions = errors.New("missing transactions")
@@ -0,0 +1,32 @@
+package replay
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// LogsFacade defines the component able to load the transaction logs from storage
+type LogsFacade interface {
+	GetLogs(logsKeys [][]byte, epoch uint32) (map[string]*transaction.Log, error)
+	IsInterfaceNil() bool
+}
+
+// GasComputer defines the component able to compute the gas provided by a transaction in its sender and receiver shards
+type GasComputer interface {
+	ComputeGasProvidedByTx(txSenderShardId uint32, txReceiverShardId uint32, txHandler data.TransactionHandler) (uint64, uint64, error)
+	IsInterfaceNil() bool
+}
+
+// EconomicsDataHandler defines the economics data needed to rebuild the gas consumption of the replayed blocks
+type EconomicsDataHandler interface {
+	MaxGasLimitPerBlock(shardID uint32) uint64
+	GasPriceForProcessing(tx data.TransactionWithFeeHandler) uint64
+	IsInterfaceNil() bool
+}
+
+// ReceiptsRepository defines the component able to load the intra shard miniblocks of a block from storage
+type ReceiptsRepository interface {
+	LoadReceipts(header data.HeaderHandler, headerHash []byte) (common.ReceiptsHolder, error)
+	IsInterfaceNil() bool
+}
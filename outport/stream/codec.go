@@ -0,0 +1,35 @@
+package stream
+
+import (
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+const codecName = "proto"
+
+// gogoProtoCodec is the gRPC codec of the outport stream, marshalling the messages with the gogo protobuf marshaller
+// used by the rest of the outport. The messages are wire compatible with the standard protobuf codecs
+type gogoProtoCodec struct {
+	marshaller marshal.Marshalizer
+}
+
+// NewCodec creates the gRPC codec to be used by the outport stream server and by the Go consumers
+func NewCodec() *gogoProtoCodec {
+	return &gogoProtoCodec{
+		marshaller: &marshal.GogoProtoMarshalizer{},
+	}
+}
+
+// Marshal returns the wire format of the message
+func (codec *gogoProtoCodec) Marshal(v interface{}) ([]byte, error) {
+	return codec.marshaller.Marshal(v)
+}
+
+// Unmarshal parses the wire format into the message
+func (codec *gogoProtoCodec) Unmarshal(data []byte, v interface{}) error {
+	return codec.marshaller.Unmarshal(v, data)
+}
+
+// Name returns the name of the codec
+func (codec *gogoProtoCodec) Name() string {
+	return codecName
+}
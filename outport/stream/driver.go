@@ -0,0 +1,378 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=plugins=grpc:. outportStream.proto
+
+package stream
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"google.golang.org/grpc"
+)
+
+// ArgsStreamDriver holds the arguments needed for creating a new streamDriver
+type ArgsStreamDriver struct {
+	Marshaller               marshal.Marshalizer
+	Filter                   OutportBlockFilter
+	Listener                 net.Listener
+	BufferSize               uint32
+	MaxInFlight              uint32
+	MaxConsumers             uint32
+	DropMessagesIfNoConsumer bool
+	Log                      core.Logger
+}
+
+type emptyBlockCreatorsContainer interface {
+	Get(headerType core.HeaderType) (block.EmptyBlockCreator, error)
+}
+
+// consumer holds the stream position of a consumer. It is kept after the consumer disconnects, so the stream
+// is resumed after the last acknowledged message on reconnect
+type consumer struct {
+	ackedOffset uint64
+	sentOffset  uint64
+	inFlight    []uint64
+	startNonce  uint64
+	topics      map[string]struct{}
+	isStreaming bool
+	isEvicted   bool
+}
+
+// streamDriver buffers the outport events and streams them over gRPC to the subscribed consumers. A message is
+// kept until all the known consumers acknowledge it. When the buffer is full, the consumers which did not acknowledge
+// the oldest message are evicted and the message is dropped, so a slow or dead consumer never stalls the node.
+// The evicted consumers are remembered, so they can not silently resume after the dropped messages, until they
+// subscribe again with a buffered start offset or until they are forgotten to make room for the newly evicted ones.
+// The number of consumers is limited, while each of them controls how many unacknowledged messages it receives at once
+type streamDriver struct {
+	marshaller               marshal.Marshalizer
+	filter                   OutportBlockFilter
+	blockCreators            emptyBlockCreatorsContainer
+	server                   *grpc.Server
+	bufferSize               int
+	maxInFlight              uint32
+	maxConsumers             int
+	dropMessagesIfNoConsumer bool
+	log                      core.Logger
+
+	mut          sync.Mutex
+	messages     []*OutportMessage
+	nextOffset   uint64
+	consumers    map[string]*consumer
+	evicted      map[string]uint64
+	evictedOrder []string
+	changed      chan struct{}
+	closeChan    chan struct{}
+	isClosed     bool
+}
+
+// NewStreamDriver will create a new instance of streamDriver, serving the consumers on the provided listener
+func NewStreamDriver(args ArgsStreamDriver) (*streamDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	blockCreators, err := createBlockCreators()
+	if err != nil {
+		return nil, err
+	}
+
+	sd := &streamDriver{
+		marshaller:               args.Marshaller,
+		filter:                   args.Filter,
+		blockCreators:            blockCreators,
+		server:                   grpc.NewServer(grpc.ForceServerCodec(NewCodec())),
+		bufferSize:               int(args.BufferSize),
+		maxInFlight:              args.MaxInFlight,
+		maxConsumers:             int(args.MaxConsumers),
+		dropMessagesIfNoConsumer: args.DropMessagesIfNoConsumer,
+		log:                      args.Log,
+		messages:                 make([]*OutportMessage, 0, args.BufferSize),
+		nextOffset:               1,
+		consumers:                make(map[string]*consumer),
+		evicted:                  make(map[string]uint64),
+		evictedOrder:             make([]string, 0),
+		changed:                  make(chan struct{}),
+		closeChan:                make(chan struct{}),
+	}
+
+	RegisterOutportStreamServer(sd.server, sd)
+	go sd.serve(args.Listener)
+
+	return sd, nil
+}
+
+func checkArgs(args ArgsStreamDriver) error {
+	if check.IfNil(args.Marshaller) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Filter) {
+		return ErrNilOutportBlockFilter
+	}
+	if args.Listener == nil {
+		return ErrNilListener
+	}
+	if args.BufferSize == 0 {
+		return ErrInvalidBufferSize
+	}
+	if args.MaxInFlight == 0 {
+		return ErrInvalidMaxInFlight
+	}
+	if args.MaxConsumers == 0 {
+		return ErrInvalidMaxConsumers
+	}
+	if check.IfNil(args.Log) {
+		return core.ErrNilLogger
+	}
+
+	return nil
+}
+
+func createBlockCreators() (emptyBlockCreatorsContainer, error) {
+	container := block.NewEmptyBlockCreatorsContainer()
+	err := container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.ShardHeaderV2, block.NewEmptyHeaderV2Creator())
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.MetaHeader, block.NewEmptyMetaBlockCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	return container, nil
+}
+
+func (sd *streamDriver) serve(listener net.Listener) {
+	sd.log.Info("stream driver: serving the outport stream", "address", listener.Addr().String())
+
+	err := sd.server.Serve(listener)
+	if err != nil {
+		sd.log.Error("stream driver: the outport stream server stopped", "error", err)
+	}
+}
+
+// SaveBlock will buffer the block, reduced by the configured filter
+func (sd *streamDriver) SaveBlock(outportBlock *outport.OutportBlock) error {
+	return sd.handleBlockAction(sd.filter.FilterOutportBlock(outportBlock), outportBlock.GetBlockData(), outport.TopicSaveBlock)
+}
+
+// RevertIndexedBlock will buffer the reverted block
+func (sd *streamDriver) RevertIndexedBlock(blockData *outport.BlockData) error {
+	return sd.handleBlockAction(blockData, blockData, outport.TopicRevertIndexedBlock)
+}
+
+// SaveRoundsInfo will buffer the rounds info
+func (sd *streamDriver) SaveRoundsInfo(roundsInfos *outport.RoundsInfo) error {
+	return sd.handleAction(roundsInfos, outport.TopicSaveRoundsInfo, 0)
+}
+
+// SaveValidatorsPubKeys will buffer the validators' public keys
+func (sd *streamDriver) SaveValidatorsPubKeys(validatorsPubKeys *outport.ValidatorsPubKeys) error {
+	return sd.handleAction(validatorsPubKeys, outport.TopicSaveValidatorsPubKeys, 0)
+}
+
+// SaveValidatorsRating will buffer the validators' rating
+func (sd *streamDriver) SaveValidatorsRating(validatorsRating *outport.ValidatorsRating) error {
+	return sd.handleAction(validatorsRating, outport.TopicSaveValidatorsRating, 0)
+}
+
+// SaveAccounts will buffer the accounts
+func (sd *streamDriver) SaveAccounts(accounts *outport.Accounts) error {
+	return sd.handleAction(accounts, outport.TopicSaveAccounts, 0)
+}
+
+// FinalizedBlock will buffer the finalized block
+func (sd *streamDriver) FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	return sd.handleAction(finalizedBlock, outport.TopicFinalizedBlock, 0)
+}
+
+// GetMarshaller returns the internal marshaller
+func (sd *streamDriver) GetMarshaller() marshal.Marshalizer {
+	return sd.marshaller
+}
+
+// SetCurrentSettings will buffer the current settings
+func (sd *streamDriver) SetCurrentSettings(config outport.OutportConfig) error {
+	return sd.handleAction(&config, outport.TopicSettings, 0)
+}
+
+// RegisterHandler will do nothing, the consumers acknowledge the messages through the Acknowledge call
+func (sd *streamDriver) RegisterHandler(_ func() error, _ string) error {
+	return nil
+}
+
+func (sd *streamDriver) handleBlockAction(args interface{}, blockData *outport.BlockData, topic string) error {
+	if blockData == nil {
+		return sd.handleAction(args, topic, 0)
+	}
+
+	header, err := sd.getHeader(blockData)
+	if err != nil {
+		return fmt.Errorf("%w while unmarshalling the header for topic %s", err, topic)
+	}
+
+	return sd.handleAction(args, topic, header.GetNonce())
+}
+
+func (sd *streamDriver) getHeader(blockData *outport.BlockData) (data.HeaderHandler, error) {
+	creator, err := sd.blockCreators.Get(core.HeaderType(blockData.HeaderType))
+	if err != nil {
+		return nil, fmt.Errorf("%w for header type %s", err, blockData.HeaderType)
+	}
+
+	return block.GetHeaderFromBytes(sd.marshaller, creator, blockData.HeaderBytes)
+}
+
+func (sd *streamDriver) handleAction(args interface{}, topic string, nonce uint64) error {
+	payload, err := sd.marshaller.Marshal(args)
+	if err != nil {
+		return fmt.Errorf("%w while marshaling data for topic %s", err, topic)
+	}
+
+	sd.mut.Lock()
+	defer sd.mut.Unlock()
+
+	if sd.isClosed {
+		return ErrDriverIsClosed
+	}
+	if sd.dropMessagesIfNoConsumer && len(sd.consumers) == 0 {
+		return nil
+	}
+
+	sd.trimAcknowledgedMessages()
+	if len(sd.messages) >= sd.bufferSize {
+		sd.dropOldestMessage()
+	}
+
+	sd.messages = append(sd.messages, &OutportMessage{
+		Offset:  sd.nextOffset,
+		Topic:   topic,
+		Nonce:   nonce,
+		Payload: payload,
+	})
+	sd.nextOffset++
+	sd.notifyChange()
+
+	return nil
+}
+
+// trimAcknowledgedMessages removes the messages acknowledged by all the known consumers. Should be called under mutex
+func (sd *streamDriver) trimAcknowledgedMessages() {
+	if len(sd.consumers) == 0 {
+		return
+	}
+
+	minAckedOffset := uint64(0)
+	isFirst := true
+	for _, c := range sd.consumers {
+		if isFirst || c.ackedOffset < minAckedOffset {
+			minAckedOffset = c.ackedOffset
+			isFirst = false
+		}
+	}
+
+	numAcknowledged := 0
+	for numAcknowledged < len(sd.messages) && sd.messages[numAcknowledged].Offset <= minAckedOffset {
+		numAcknowledged++
+	}
+
+	sd.messages = sd.messages[numAcknowledged:]
+}
+
+// dropOldestMessage evicts the consumers which did not acknowledge the oldest buffered message, then drops it.
+// The acknowledged offset of each evicted consumer is remembered. Should be called under mutex
+func (sd *streamDriver) dropOldestMessage() {
+	oldestOffset := sd.messages[0].Offset
+	for consumerID, c := range sd.consumers {
+		if c.ackedOffset >= oldestOffset {
+			continue
+		}
+
+		sd.log.Warn("stream driver: evicting consumer as the buffer is full",
+			"consumer", consumerID, "acknowledged offset", c.ackedOffset, "oldest offset", oldestOffset)
+		c.isEvicted = true
+		delete(sd.consumers, consumerID)
+		sd.rememberEvictedConsumer(consumerID, c.ackedOffset)
+	}
+
+	sd.messages = sd.messages[1:]
+}
+
+// rememberEvictedConsumer keeps the acknowledged offset of an evicted consumer. At most the maximum number of
+// consumers are remembered, the oldest evicted ones being forgotten first. Should be called under mutex
+func (sd *streamDriver) rememberEvictedConsumer(consumerID string, ackedOffset uint64) {
+	sd.evicted[consumerID] = ackedOffset
+	sd.evictedOrder = append(sd.evictedOrder, consumerID)
+	for len(sd.evictedOrder) > sd.maxConsumers {
+		delete(sd.evicted, sd.evictedOrder[0])
+		sd.evictedOrder = sd.evictedOrder[1:]
+	}
+}
+
+// forgetEvictedConsumer removes the provided consumer from the evicted ones. Should be called under mutex
+func (sd *streamDriver) forgetEvictedConsumer(consumerID string) {
+	delete(sd.evicted, consumerID)
+	for idx, evictedID := range sd.evictedOrder {
+		if evictedID == consumerID {
+			sd.evictedOrder = append(sd.evictedOrder[:idx], sd.evictedOrder[idx+1:]...)
+			return
+		}
+	}
+}
+
+// notifyChange wakes up all the subscriptions waiting for messages or acknowledges. Should be called under mutex
+func (sd *streamDriver) notifyChange() {
+	close(sd.changed)
+	sd.changed = make(chan struct{})
+}
+
+// getMessage returns the first buffered message starting with the provided offset. Should be called under mutex
+func (sd *streamDriver) getMessage(offset uint64) *OutportMessage {
+	if len(sd.messages) == 0 {
+		return nil
+	}
+
+	firstOffset := sd.messages[0].Offset
+	if offset < firstOffset {
+		return sd.messages[0]
+	}
+
+	idx := offset - firstOffset
+	if idx >= uint64(len(sd.messages)) {
+		return nil
+	}
+
+	return sd.messages[idx]
+}
+
+// Close will stop the outport stream server
+func (sd *streamDriver) Close() error {
+	sd.mut.Lock()
+	if sd.isClosed {
+		sd.mut.Unlock()
+		return nil
+	}
+	sd.isClosed = true
+	close(sd.closeChan)
+	sd.mut.Unlock()
+
+	sd.server.Stop()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sd *streamDriver) IsInterfaceNil() bool {
+	return sd == nil
+}
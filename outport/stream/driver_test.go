@@ -0,0 +1,559 @@
+package stream
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	outportStubs "github.com/multiversx/mx-chain-go/testscommon/outport"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	noMessageTimeout = 100 * time.Millisecond
+	receiveTimeout   = 5 * time.Second
+)
+
+var log = logger.GetOrCreate("test")
+
+func createMockArgs() ArgsStreamDriver {
+	return ArgsStreamDriver{
+		Marshaller:   &marshal.GogoProtoMarshalizer{},
+		Filter:       &outportStubs.OutportBlockFilterStub{},
+		Listener:     bufconn.Listen(1024 * 1024),
+		BufferSize:   10,
+		MaxInFlight:  10,
+		MaxConsumers: 10,
+		Log:          log,
+	}
+}
+
+func createDriverAndClient(t *testing.T, args ArgsStreamDriver) (*streamDriver, OutportStreamClient) {
+	listener := args.Listener.(*bufconn.Listener)
+	driver, err := NewStreamDriver(args)
+	require.Nil(t, err)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(NewCodec())),
+	)
+	require.Nil(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		_ = driver.Close()
+	})
+
+	return driver, NewOutportStreamClient(conn)
+}
+
+type testSubscription struct {
+	messages chan *OutportMessage
+	err      chan error
+}
+
+// subscribe receives the messages on a single goroutine, as a gRPC stream does not allow concurrent receives
+func subscribe(t *testing.T, client OutportStreamClient, request *SubscribeRequest) (*testSubscription, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	subscription, err := client.Subscribe(ctx, request)
+	require.Nil(t, err)
+
+	ts := &testSubscription{
+		messages: make(chan *OutportMessage, 100),
+		err:      make(chan error, 1),
+	}
+	go func() {
+		for {
+			message, errRecv := subscription.Recv()
+			if errRecv != nil {
+				ts.err <- errRecv
+				return
+			}
+			ts.messages <- message
+		}
+	}()
+
+	return ts, cancel
+}
+
+func receiveMessage(t *testing.T, subscription *testSubscription) *OutportMessage {
+	select {
+	case message := <-subscription.messages:
+		return message
+	case err := <-subscription.err:
+		require.Fail(t, "unexpected error", err.Error())
+	case <-time.After(receiveTimeout):
+		require.Fail(t, "timeout receiving message")
+	}
+
+	return nil
+}
+
+func receiveError(t *testing.T, subscription *testSubscription) error {
+	select {
+	case message := <-subscription.messages:
+		require.Fail(t, "unexpected message", "offset %d", message.Offset)
+	case err := <-subscription.err:
+		return err
+	case <-time.After(receiveTimeout):
+		require.Fail(t, "timeout receiving error")
+	}
+
+	return nil
+}
+
+func receiveOffsets(t *testing.T, subscription *testSubscription, numMessages int) []uint64 {
+	offsets := make([]uint64, 0, numMessages)
+	for i := 0; i < numMessages; i++ {
+		offsets = append(offsets, receiveMessage(t, subscription).Offset)
+	}
+
+	return offsets
+}
+
+func requireNoMessage(t *testing.T, subscription *testSubscription) {
+	select {
+	case message := <-subscription.messages:
+		require.Fail(t, "unexpected message", "offset %d", message.Offset)
+	case <-time.After(noMessageTimeout):
+	}
+}
+
+func saveFinalizedBlocks(t *testing.T, driver *streamDriver, numBlocks int) {
+	for i := 0; i < numBlocks; i++ {
+		require.Nil(t, driver.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: []byte("hash")}))
+	}
+}
+
+func createOutportBlock(t *testing.T, marshaller marshal.Marshalizer, nonce uint64) *outport.OutportBlock {
+	headerBytes, headerType, err := outport.GetHeaderBytesAndType(marshaller, &block.Header{Nonce: nonce})
+	require.Nil(t, err)
+
+	return &outport.OutportBlock{
+		BlockData: &outport.BlockData{
+			HeaderBytes: headerBytes,
+			HeaderType:  string(headerType),
+		},
+	}
+}
+
+func requireStatusCode(t *testing.T, err error, code codes.Code) {
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, code, st.Code())
+}
+
+func TestNewStreamDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Marshaller = nil
+
+		driver, err := NewStreamDriver(args)
+		require.Nil(t, driver)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil filter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Filter = nil
+
+		driver, err := NewStreamDriver(args)
+		require.Nil(t, driver)
+		require.Equal(t, ErrNilOutportBlockFilter, err)
+	})
+	t.Run("nil listener should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Listener = nil
+
+		driver, err := NewStreamDriver(args)
+		require.Nil(t, driver)
+		require.Equal(t, ErrNilListener, err)
+	})
+	t.Run("invalid buffer size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.BufferSize = 0
+
+		driver, err := NewStreamDriver(args)
+		require.Nil(t, driver)
+		require.Equal(t, ErrInvalidBufferSize, err)
+	})
+	t.Run("invalid max in flight should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxInFlight = 0
+
+		driver, err := NewStreamDriver(args)
+		require.Nil(t, driver)
+		require.Equal(t, ErrInvalidMaxInFlight, err)
+	})
+	t.Run("invalid max consumers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxConsumers = 0
+
+		driver, err := NewStreamDriver(args)
+		require.Nil(t, driver)
+		require.Equal(t, ErrInvalidMaxConsumers, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Log = nil
+
+		driver, err := NewStreamDriver(args)
+		require.Nil(t, driver)
+		require.Equal(t, core.ErrNilLogger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		driver, err := NewStreamDriver(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, driver.IsInterfaceNil())
+		require.Nil(t, driver.RegisterHandler(nil, outport.TopicSaveBlock))
+		require.Nil(t, driver.Close())
+		require.Nil(t, driver.Close())
+		require.Equal(t, ErrDriverIsClosed, driver.SaveAccounts(&outport.Accounts{}))
+	})
+}
+
+func TestStreamDriver_SubscribeShouldRespectMaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	driver, client := createDriverAndClient(t, createMockArgs())
+	saveFinalizedBlocks(t, driver, 3)
+
+	subscription, _ := subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer", MaxInFlight: 2})
+	require.Equal(t, []uint64{1, 2}, receiveOffsets(t, subscription, 2))
+	requireNoMessage(t, subscription)
+
+	_, err := client.Acknowledge(context.Background(), &AcknowledgeRequest{ConsumerID: "consumer", Offset: 2})
+	require.Nil(t, err)
+
+	require.Equal(t, []uint64{3}, receiveOffsets(t, subscription, 1))
+}
+
+func TestStreamDriver_PayloadShouldBeTheFilteredBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	filteredBlock := createOutportBlock(t, args.Marshaller, 7)
+	filteredBlock.HighestFinalBlockNonce = 6
+	args.Filter = &outportStubs.OutportBlockFilterStub{
+		FilterOutportBlockCalled: func(outportBlock *outport.OutportBlock) *outport.OutportBlock {
+			return filteredBlock
+		},
+	}
+	driver, client := createDriverAndClient(t, args)
+
+	require.Nil(t, driver.SaveBlock(createOutportBlock(t, args.Marshaller, 7)))
+
+	subscription, _ := subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer"})
+	message := receiveMessage(t, subscription)
+	require.Equal(t, outport.TopicSaveBlock, message.Topic)
+	require.Equal(t, uint64(7), message.Nonce)
+
+	receivedBlock := &outport.OutportBlock{}
+	require.Nil(t, args.Marshaller.Unmarshal(receivedBlock, message.Payload))
+	require.Equal(t, filteredBlock, receivedBlock)
+}
+
+func TestStreamDriver_SubscribeWithTopicsAndStartNonce(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	driver, client := createDriverAndClient(t, args)
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		require.Nil(t, driver.SaveBlock(createOutportBlock(t, args.Marshaller, nonce)))
+		saveFinalizedBlocks(t, driver, 1)
+	}
+
+	subscription, _ := subscribe(t, client, &SubscribeRequest{
+		ConsumerID: "consumer",
+		StartNonce: 2,
+		Topics:     []string{outport.TopicSaveBlock},
+	})
+
+	first := receiveMessage(t, subscription)
+	require.Equal(t, uint64(2), first.Nonce)
+	second := receiveMessage(t, subscription)
+	require.Equal(t, uint64(3), second.Nonce)
+	requireNoMessage(t, subscription)
+
+	// the skipped messages are acknowledged together with the sent ones
+	_, err := client.Acknowledge(context.Background(), &AcknowledgeRequest{ConsumerID: "consumer", Offset: second.Offset})
+	require.Nil(t, err)
+
+	driver.mut.Lock()
+	require.Equal(t, uint64(6), driver.consumers["consumer"].ackedOffset)
+	require.Empty(t, driver.messages)
+	driver.mut.Unlock()
+}
+
+func TestStreamDriver_ShouldResumeAfterTheLastAcknowledgedMessage(t *testing.T) {
+	t.Parallel()
+
+	driver, client := createDriverAndClient(t, createMockArgs())
+	saveFinalizedBlocks(t, driver, 3)
+
+	subscription, cancel := subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer"})
+	require.Equal(t, []uint64{1, 2, 3}, receiveOffsets(t, subscription, 3))
+	_, err := client.Acknowledge(context.Background(), &AcknowledgeRequest{ConsumerID: "consumer", Offset: 1})
+	require.Nil(t, err)
+
+	cancel()
+	require.Eventually(t, func() bool {
+		driver.mut.Lock()
+		defer driver.mut.Unlock()
+
+		return !driver.consumers["consumer"].isStreaming
+	}, time.Second, 10*time.Millisecond)
+
+	subscription, _ = subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer"})
+	require.Equal(t, []uint64{2, 3}, receiveOffsets(t, subscription, 2))
+
+	// a new consumer starts with the oldest buffered message
+	newSubscription, _ := subscribe(t, client, &SubscribeRequest{ConsumerID: "new consumer"})
+	require.Equal(t, []uint64{2, 3}, receiveOffsets(t, newSubscription, 2))
+}
+
+func TestStreamDriver_BufferFullShouldEvictTheLaggingConsumers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.BufferSize = 2
+	driver, client := createDriverAndClient(t, args)
+
+	subscription, _ := subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer", MaxInFlight: 2})
+	require.Eventually(t, func() bool {
+		driver.mut.Lock()
+		defer driver.mut.Unlock()
+
+		return len(driver.consumers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	saveFinalizedBlocks(t, driver, 2)
+	require.Equal(t, []uint64{1, 2}, receiveOffsets(t, subscription, 2))
+	_, err := client.Acknowledge(context.Background(), &AcknowledgeRequest{ConsumerID: "consumer", Offset: 1})
+	require.Nil(t, err)
+
+	// the acknowledged message makes room for a new one
+	saveFinalizedBlocks(t, driver, 1)
+	require.Equal(t, []uint64{3}, receiveOffsets(t, subscription, 1))
+
+	// the consumer did not acknowledge the oldest message so it is evicted instead of stalling the node
+	saveFinalizedBlocks(t, driver, 1)
+	requireStatusCode(t, receiveError(t, subscription), codes.ResourceExhausted)
+	_, err = client.Acknowledge(context.Background(), &AcknowledgeRequest{ConsumerID: "consumer", Offset: 2})
+	requireStatusCode(t, err, codes.OutOfRange)
+
+	// subscribing again with a new ID starts with the oldest buffered message
+	subscription, _ = subscribe(t, client, &SubscribeRequest{ConsumerID: "new consumer"})
+	require.Equal(t, []uint64{3, 4}, receiveOffsets(t, subscription, 2))
+}
+
+func TestStreamDriver_EvictedConsumerShouldNotResumeAfterTheDroppedMessages(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.BufferSize = 2
+	driver, client := createDriverAndClient(t, args)
+
+	subscription, cancel := subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer", MaxInFlight: 1})
+	require.Eventually(t, func() bool {
+		driver.mut.Lock()
+		defer driver.mut.Unlock()
+
+		return len(driver.consumers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	saveFinalizedBlocks(t, driver, 1)
+	require.Equal(t, []uint64{1}, receiveOffsets(t, subscription, 1))
+	_, err := client.Acknowledge(context.Background(), &AcknowledgeRequest{ConsumerID: "consumer", Offset: 1})
+	require.Nil(t, err)
+	cancel()
+
+	// the messages 2 and 3 are not acknowledged, so the consumer is evicted and the message 2 is dropped
+	saveFinalizedBlocks(t, driver, 3)
+
+	subscription, _ = subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer"})
+	err = receiveError(t, subscription)
+	requireStatusCode(t, err, codes.OutOfRange)
+	st, _ := status.FromError(err)
+	require.Contains(t, st.Message(), "acknowledged offset 1, first available offset 3")
+
+	// a start offset which is not buffered anymore is rejected as well
+	subscription, _ = subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer", StartOffset: 2})
+	requireStatusCode(t, receiveError(t, subscription), codes.OutOfRange)
+
+	// accepting the gap, the consumer resumes with the first available message
+	subscription, _ = subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer", StartOffset: 3})
+	require.Equal(t, []uint64{3}, receiveOffsets(t, subscription, 1))
+	_, err = client.Acknowledge(context.Background(), &AcknowledgeRequest{ConsumerID: "consumer", Offset: 3})
+	require.Nil(t, err)
+
+	driver.mut.Lock()
+	require.Empty(t, driver.evicted)
+	require.Empty(t, driver.evictedOrder)
+	driver.mut.Unlock()
+}
+
+func TestStreamDriver_EvictedConsumersShouldBeForgottenAboveTheMaximumNumberOfConsumers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.BufferSize = 1
+	args.MaxConsumers = 2
+	driver, client := createDriverAndClient(t, args)
+
+	for _, consumerID := range []string{"consumer1", "consumer2", "consumer3"} {
+		_, cancel := subscribe(t, client, &SubscribeRequest{ConsumerID: consumerID})
+		require.Eventually(t, func() bool {
+			driver.mut.Lock()
+			defer driver.mut.Unlock()
+
+			return len(driver.consumers) == 1
+		}, time.Second, 10*time.Millisecond)
+		cancel()
+
+		// the consumer does not acknowledge the message, so it is evicted when the next one is saved
+		saveFinalizedBlocks(t, driver, 2)
+	}
+
+	driver.mut.Lock()
+	defer driver.mut.Unlock()
+
+	require.Empty(t, driver.consumers)
+	require.Equal(t, []string{"consumer2", "consumer3"}, driver.evictedOrder)
+	require.Len(t, driver.evicted, 2)
+}
+
+func TestStreamDriver_BufferFullWithoutConsumersShouldDropTheOldestMessages(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.BufferSize = 2
+	driver, client := createDriverAndClient(t, args)
+
+	saveFinalizedBlocks(t, driver, 5)
+
+	subscription, _ := subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer"})
+	require.Equal(t, []uint64{4, 5}, receiveOffsets(t, subscription, 2))
+}
+
+func TestStreamDriver_DropMessagesIfNoConsumer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.BufferSize = 1
+	args.DropMessagesIfNoConsumer = true
+	driver, client := createDriverAndClient(t, args)
+
+	saveFinalizedBlocks(t, driver, 5)
+
+	subscription, _ := subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer"})
+	requireNoMessage(t, subscription)
+	require.Eventually(t, func() bool {
+		driver.mut.Lock()
+		defer driver.mut.Unlock()
+
+		return len(driver.consumers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// the dropped messages do not take an offset
+	saveFinalizedBlocks(t, driver, 1)
+	require.Equal(t, []uint64{1}, receiveOffsets(t, subscription, 1))
+}
+
+func TestStreamDriver_SubscribeErrors(t *testing.T) {
+	t.Parallel()
+
+	driver, client := createDriverAndClient(t, createMockArgs())
+	saveFinalizedBlocks(t, driver, 1)
+
+	subscription, _ := subscribe(t, client, &SubscribeRequest{})
+	requireStatusCode(t, receiveError(t, subscription), codes.InvalidArgument)
+
+	subscription, _ = subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer"})
+	require.Equal(t, []uint64{1}, receiveOffsets(t, subscription, 1))
+
+	duplicated, _ := subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer"})
+	requireStatusCode(t, receiveError(t, duplicated), codes.AlreadyExists)
+
+	invalidOffset, _ := subscribe(t, client, &SubscribeRequest{ConsumerID: "other consumer", StartOffset: 3})
+	requireStatusCode(t, receiveError(t, invalidOffset), codes.OutOfRange)
+
+	_ = driver.Close()
+	require.NotNil(t, receiveError(t, subscription))
+}
+
+func TestStreamDriver_SubscribeShouldRespectMaxConsumers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.MaxConsumers = 1
+	driver, client := createDriverAndClient(t, args)
+	saveFinalizedBlocks(t, driver, 2)
+
+	subscription, cancel := subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer1", MaxInFlight: 1})
+	require.Equal(t, []uint64{1}, receiveOffsets(t, subscription, 1))
+
+	rejected, _ := subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer2"})
+	requireStatusCode(t, receiveError(t, rejected), codes.ResourceExhausted)
+
+	// a known consumer can always subscribe again
+	cancel()
+	require.Eventually(t, func() bool {
+		driver.mut.Lock()
+		defer driver.mut.Unlock()
+
+		return !driver.consumers["consumer1"].isStreaming
+	}, time.Second, 10*time.Millisecond)
+	subscription, _ = subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer1", StartOffset: 2})
+	require.Equal(t, []uint64{1, 2}, receiveOffsets(t, subscription, 2))
+}
+
+func TestStreamDriver_AcknowledgeErrors(t *testing.T) {
+	t.Parallel()
+
+	driver, client := createDriverAndClient(t, createMockArgs())
+	saveFinalizedBlocks(t, driver, 2)
+
+	_, err := client.Acknowledge(context.Background(), &AcknowledgeRequest{ConsumerID: "unknown", Offset: 1})
+	requireStatusCode(t, err, codes.NotFound)
+
+	subscription, _ := subscribe(t, client, &SubscribeRequest{ConsumerID: "consumer", MaxInFlight: 1})
+	require.Equal(t, []uint64{1}, receiveOffsets(t, subscription, 1))
+
+	_, err = client.Acknowledge(context.Background(), &AcknowledgeRequest{ConsumerID: "consumer", Offset: 2})
+	requireStatusCode(t, err, codes.InvalidArgument)
+}
@@ -0,0 +1,42 @@
+package stream
+
+import "errors"
+
+// ErrDriverIsClosed signals that the driver was closed while trying to perform actions
+var ErrDriverIsClosed = errors.New("driver is closed")
+
+// ErrNilListener signals that a nil listener has been provided
+var ErrNilListener = errors.New("nil listener")
+
+// ErrNilOutportBlockFilter signals that a nil outport block filter has been provided
+var ErrNilOutportBlockFilter = errors.New("nil outport block filter")
+
+// ErrInvalidBufferSize signals that an invalid buffer size has been provided
+var ErrInvalidBufferSize = errors.New("invalid buffer size")
+
+// ErrInvalidMaxInFlight signals that an invalid maximum number of in-flight messages has been provided
+var ErrInvalidMaxInFlight = errors.New("invalid max in flight")
+
+// ErrInvalidMaxConsumers signals that an invalid maximum number of consumers has been provided
+var ErrInvalidMaxConsumers = errors.New("invalid max consumers")
+
+// ErrTooManyConsumers signals that the maximum number of consumers was reached
+var ErrTooManyConsumers = errors.New("too many consumers")
+
+// ErrInvalidStartOffset signals that the provided start offset is not buffered
+var ErrInvalidStartOffset = errors.New("invalid start offset")
+
+// ErrConsumerEvicted signals that the consumer was evicted as it did not acknowledge the oldest message of a full buffer
+var ErrConsumerEvicted = errors.New("consumer evicted as the messages buffer is full")
+
+// ErrEmptyConsumerID signals that an empty consumer ID has been provided
+var ErrEmptyConsumerID = errors.New("empty consumer ID")
+
+// ErrConsumerAlreadySubscribed signals that the consumer already has an active subscription
+var ErrConsumerAlreadySubscribed = errors.New("consumer already subscribed")
+
+// ErrUnknownConsumer signals that the consumer never subscribed
+var ErrUnknownConsumer = errors.New("unknown consumer")
+
+// ErrOffsetNotSent signals that the consumer acknowledged an offset which was not sent yet
+var ErrOffsetNotSent = errors.New("offset not sent")
@@ -0,0 +1,11 @@
+package stream
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+)
+
+// OutportBlockFilter defines the behavior of a component able to reduce an outport block before being pushed
+type OutportBlockFilter interface {
+	FilterOutportBlock(outportBlock *outport.OutportBlock) *outport.OutportBlock
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,1489 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: outportStream.proto
+
+package stream
+
+import (
+	bytes "bytes"
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeRequest is sent by a consumer to start receiving the outport messages. The start offset is only used by
+// the new or evicted consumers, to start with a buffered message instead of the oldest one
+type SubscribeRequest struct {
+	ConsumerID  string   `protobuf:"bytes,1,opt,name=ConsumerID,proto3" json:"ConsumerID,omitempty"`
+	StartNonce  uint64   `protobuf:"varint,2,opt,name=StartNonce,proto3" json:"StartNonce,omitempty"`
+	Topics      []string `protobuf:"bytes,3,rep,name=Topics,proto3" json:"Topics,omitempty"`
+	MaxInFlight uint32   `protobuf:"varint,4,opt,name=MaxInFlight,proto3" json:"MaxInFlight,omitempty"`
+	StartOffset uint64   `protobuf:"varint,5,opt,name=StartOffset,proto3" json:"StartOffset,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()      { *m = SubscribeRequest{} }
+func (*SubscribeRequest) ProtoMessage() {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e1b966f6367590b, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetConsumerID() string {
+	if m != nil {
+		return m.ConsumerID
+	}
+	return ""
+}
+
+func (m *SubscribeRequest) GetStartNonce() uint64 {
+	if m != nil {
+		return m.StartNonce
+	}
+	return 0
+}
+
+func (m *SubscribeRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetMaxInFlight() uint32 {
+	if m != nil {
+		return m.MaxInFlight
+	}
+	return 0
+}
+
+func (m *SubscribeRequest) GetStartOffset() uint64 {
+	if m != nil {
+		return m.StartOffset
+	}
+	return 0
+}
+
+// OutportMessage holds an outport event marshalled with the driver's marshaller
+type OutportMessage struct {
+	Offset  uint64 `protobuf:"varint,1,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Topic   string `protobuf:"bytes,2,opt,name=Topic,proto3" json:"Topic,omitempty"`
+	Nonce   uint64 `protobuf:"varint,3,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Payload []byte `protobuf:"bytes,4,opt,name=Payload,proto3" json:"Payload,omitempty"`
+}
+
+func (m *OutportMessage) Reset()      { *m = OutportMessage{} }
+func (*OutportMessage) ProtoMessage() {}
+func (*OutportMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e1b966f6367590b, []int{1}
+}
+func (m *OutportMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutportMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OutportMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutportMessage.Merge(m, src)
+}
+func (m *OutportMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutportMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutportMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutportMessage proto.InternalMessageInfo
+
+func (m *OutportMessage) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *OutportMessage) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *OutportMessage) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *OutportMessage) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// AcknowledgeRequest confirms that the consumer processed all the messages up to and including the offset
+type AcknowledgeRequest struct {
+	ConsumerID string `protobuf:"bytes,1,opt,name=ConsumerID,proto3" json:"ConsumerID,omitempty"`
+	Offset     uint64 `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+}
+
+func (m *AcknowledgeRequest) Reset()      { *m = AcknowledgeRequest{} }
+func (*AcknowledgeRequest) ProtoMessage() {}
+func (*AcknowledgeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e1b966f6367590b, []int{2}
+}
+func (m *AcknowledgeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcknowledgeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AcknowledgeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcknowledgeRequest.Merge(m, src)
+}
+func (m *AcknowledgeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcknowledgeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcknowledgeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcknowledgeRequest proto.InternalMessageInfo
+
+func (m *AcknowledgeRequest) GetConsumerID() string {
+	if m != nil {
+		return m.ConsumerID
+	}
+	return ""
+}
+
+func (m *AcknowledgeRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+// AcknowledgeResponse is returned on a successful acknowledge
+type AcknowledgeResponse struct {
+}
+
+func (m *AcknowledgeResponse) Reset()      { *m = AcknowledgeResponse{} }
+func (*AcknowledgeResponse) ProtoMessage() {}
+func (*AcknowledgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e1b966f6367590b, []int{3}
+}
+func (m *AcknowledgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcknowledgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AcknowledgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcknowledgeResponse.Merge(m, src)
+}
+func (m *AcknowledgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcknowledgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcknowledgeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcknowledgeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "proto.SubscribeRequest")
+	proto.RegisterType((*OutportMessage)(nil), "proto.OutportMessage")
+	proto.RegisterType((*AcknowledgeRequest)(nil), "proto.AcknowledgeRequest")
+	proto.RegisterType((*AcknowledgeResponse)(nil), "proto.AcknowledgeResponse")
+}
+
+func init() { proto.RegisterFile("outportStream.proto", fileDescriptor_9e1b966f6367590b) }
+
+var fileDescriptor_9e1b966f6367590b = []byte{
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xbb, 0x6e, 0xdb, 0x40,
+	0x10, 0xe4, 0xea, 0x15, 0xf0, 0x14, 0x05, 0xc1, 0x29, 0x4a, 0x18, 0x16, 0x07, 0x82, 0x15, 0x9b,
+	0x48, 0x41, 0x52, 0x07, 0xc8, 0x43, 0x08, 0x20, 0x20, 0x8a, 0x02, 0x2a, 0x55, 0x3a, 0x92, 0x3a,
+	0x51, 0x44, 0x24, 0x1e, 0xcd, 0x3b, 0xc2, 0x76, 0xe7, 0x4f, 0x70, 0xe1, 0x8f, 0x70, 0xe5, 0xef,
+	0x70, 0xa9, 0x52, 0xa5, 0x75, 0x6a, 0x5c, 0xea, 0x13, 0x0c, 0x1d, 0x69, 0x9b, 0xb2, 0x5d, 0xb8,
+	0x22, 0x67, 0x76, 0x6e, 0x77, 0x76, 0x07, 0xb5, 0x59, 0x26, 0x12, 0x96, 0x8a, 0xb1, 0x48, 0xa9,
+	0xb7, 0xe8, 0x26, 0x29, 0x13, 0x0c, 0xd7, 0xd5, 0xc7, 0xfc, 0x10, 0x46, 0x62, 0x96, 0xf9, 0xdd,
+	0x80, 0x2d, 0x7a, 0x21, 0x0b, 0x59, 0x4f, 0xd1, 0x7e, 0x36, 0x55, 0x48, 0x01, 0xf5, 0x97, 0xbf,
+	0xb2, 0x2f, 0x00, 0xbd, 0x1e, 0x67, 0x3e, 0x0f, 0xd2, 0xc8, 0xa7, 0x2e, 0x3d, 0xc8, 0x28, 0x17,
+	0x98, 0x20, 0xf4, 0x83, 0xc5, 0x3c, 0x5b, 0xd0, 0x74, 0xd0, 0x37, 0xc0, 0x02, 0x47, 0x77, 0x4b,
+	0xcc, 0xae, 0x3e, 0x16, 0x5e, 0x2a, 0x7e, 0xb3, 0x38, 0xa0, 0x46, 0xc5, 0x02, 0xa7, 0xe6, 0x96,
+	0x18, 0xfc, 0x16, 0x35, 0xfe, 0xb2, 0x24, 0x0a, 0xb8, 0x51, 0xb5, 0xaa, 0x8e, 0xee, 0x16, 0x08,
+	0x5b, 0xa8, 0x39, 0xf4, 0x8e, 0x06, 0xf1, 0xcf, 0x79, 0x14, 0xce, 0x84, 0x51, 0xb3, 0xc0, 0x69,
+	0xb9, 0x65, 0x6a, 0xa7, 0x50, 0x7d, 0x46, 0xd3, 0x29, 0xa7, 0xc2, 0xa8, 0xab, 0xd6, 0x65, 0xca,
+	0x8e, 0xd1, 0xab, 0x51, 0xbe, 0xfd, 0x90, 0x72, 0xee, 0x85, 0x6a, 0x5a, 0x21, 0x07, 0x25, 0x2f,
+	0x10, 0x7e, 0x83, 0xea, 0x6a, 0xae, 0x32, 0xa8, 0xbb, 0x39, 0xd8, 0xb1, 0xb9, 0xed, 0xaa, 0x12,
+	0xe7, 0x00, 0x1b, 0xe8, 0xc5, 0x1f, 0xef, 0x78, 0xce, 0xbc, 0x89, 0x72, 0xf5, 0xd2, 0xbd, 0x85,
+	0xf6, 0x2f, 0x84, 0xbf, 0x05, 0xff, 0x63, 0x76, 0x38, 0xa7, 0x93, 0xf0, 0xd9, 0x17, 0xba, 0xf7,
+	0x54, 0x29, 0x7b, 0xb2, 0x3b, 0xa8, 0xbd, 0xd7, 0x8d, 0x27, 0x2c, 0xe6, 0xf4, 0xd3, 0x19, 0xa0,
+	0xd6, 0xa8, 0x9c, 0x29, 0xfe, 0x82, 0xf4, 0xbb, 0x58, 0xf0, 0xbb, 0x3c, 0xac, 0xee, 0xc3, 0xa0,
+	0xcc, 0x4e, 0x51, 0xd8, 0xbf, 0xc8, 0x47, 0xc0, 0x7d, 0xd4, 0x2c, 0xcd, 0xc1, 0xef, 0x0b, 0xdd,
+	0xe3, 0x4d, 0x4c, 0xf3, 0xa9, 0x52, 0x6e, 0xeb, 0xfb, 0xd7, 0xe5, 0x9a, 0x68, 0xab, 0x35, 0xd1,
+	0xb6, 0x6b, 0x02, 0x27, 0x92, 0xc0, 0xb9, 0x24, 0x70, 0x29, 0x09, 0x2c, 0x25, 0x81, 0x95, 0x24,
+	0x70, 0x25, 0x09, 0x5c, 0x4b, 0xa2, 0x6d, 0x25, 0x81, 0xd3, 0x0d, 0xd1, 0x96, 0x1b, 0xa2, 0xad,
+	0x36, 0x44, 0xfb, 0xd7, 0xe0, 0x6a, 0x0d, 0xbf, 0xa1, 0x9a, 0x7f, 0xbe, 0x19, 0x00, 0x7f, 0x69,
+	0x36, 0xcb, 0xb2, 0x02, 0x00, 0x00,
+}
+
+func (this *SubscribeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubscribeRequest)
+	if !ok {
+		that2, ok := that.(SubscribeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ConsumerID != that1.ConsumerID {
+		return false
+	}
+	if this.StartNonce != that1.StartNonce {
+		return false
+	}
+	if len(this.Topics) != len(that1.Topics) {
+		return false
+	}
+	for i := range this.Topics {
+		if this.Topics[i] != that1.Topics[i] {
+			return false
+		}
+	}
+	if this.MaxInFlight != that1.MaxInFlight {
+		return false
+	}
+	if this.StartOffset != that1.StartOffset {
+		return false
+	}
+	return true
+}
+func (this *OutportMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutportMessage)
+	if !ok {
+		that2, ok := that.(OutportMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Topic != that1.Topic {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	return true
+}
+func (this *AcknowledgeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AcknowledgeRequest)
+	if !ok {
+		that2, ok := that.(AcknowledgeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ConsumerID != that1.ConsumerID {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	return true
+}
+func (this *AcknowledgeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AcknowledgeResponse)
+	if !ok {
+		that2, ok := that.(AcknowledgeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *SubscribeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&stream.SubscribeRequest{")
+	s = append(s, "ConsumerID: "+fmt.Sprintf("%#v", this.ConsumerID)+",\n")
+	s = append(s, "StartNonce: "+fmt.Sprintf("%#v", this.StartNonce)+",\n")
+	s = append(s, "Topics: "+fmt.Sprintf("%#v", this.Topics)+",\n")
+	s = append(s, "MaxInFlight: "+fmt.Sprintf("%#v", this.MaxInFlight)+",\n")
+	s = append(s, "StartOffset: "+fmt.Sprintf("%#v", this.StartOffset)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OutportMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&stream.OutportMessage{")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Topic: "+fmt.Sprintf("%#v", this.Topic)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AcknowledgeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&stream.AcknowledgeRequest{")
+	s = append(s, "ConsumerID: "+fmt.Sprintf("%#v", this.ConsumerID)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AcknowledgeResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&stream.AcknowledgeResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringOutportStream(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// OutportStreamClient is the client API for OutportStream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OutportStreamClient interface {
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (OutportStream_SubscribeClient, error)
+	Acknowledge(ctx context.Context, in *AcknowledgeRequest, opts ...grpc.CallOption) (*AcknowledgeResponse, error)
+}
+
+type outportStreamClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOutportStreamClient(cc *grpc.ClientConn) OutportStreamClient {
+	return &outportStreamClient{cc}
+}
+
+func (c *outportStreamClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (OutportStream_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_OutportStream_serviceDesc.Streams[0], "/proto.OutportStream/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &outportStreamSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OutportStream_SubscribeClient interface {
+	Recv() (*OutportMessage, error)
+	grpc.ClientStream
+}
+
+type outportStreamSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *outportStreamSubscribeClient) Recv() (*OutportMessage, error) {
+	m := new(OutportMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *outportStreamClient) Acknowledge(ctx context.Context, in *AcknowledgeRequest, opts ...grpc.CallOption) (*AcknowledgeResponse, error) {
+	out := new(AcknowledgeResponse)
+	err := c.cc.Invoke(ctx, "/proto.OutportStream/Acknowledge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OutportStreamServer is the server API for OutportStream service.
+type OutportStreamServer interface {
+	Subscribe(*SubscribeRequest, OutportStream_SubscribeServer) error
+	Acknowledge(context.Context, *AcknowledgeRequest) (*AcknowledgeResponse, error)
+}
+
+// UnimplementedOutportStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedOutportStreamServer struct {
+}
+
+func (*UnimplementedOutportStreamServer) Subscribe(req *SubscribeRequest, srv OutportStream_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedOutportStreamServer) Acknowledge(ctx context.Context, req *AcknowledgeRequest) (*AcknowledgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Acknowledge not implemented")
+}
+
+func RegisterOutportStreamServer(s *grpc.Server, srv OutportStreamServer) {
+	s.RegisterService(&_OutportStream_serviceDesc, srv)
+}
+
+func _OutportStream_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OutportStreamServer).Subscribe(m, &outportStreamSubscribeServer{stream})
+}
+
+type OutportStream_SubscribeServer interface {
+	Send(*OutportMessage) error
+	grpc.ServerStream
+}
+
+type outportStreamSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *outportStreamSubscribeServer) Send(m *OutportMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OutportStream_Acknowledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutportStreamServer).Acknowledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OutportStream/Acknowledge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutportStreamServer).Acknowledge(ctx, req.(*AcknowledgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _OutportStream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.OutportStream",
+	HandlerType: (*OutportStreamServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Acknowledge",
+			Handler:    _OutportStream_Acknowledge_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _OutportStream_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "outportStream.proto",
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartOffset != 0 {
+		i = encodeVarintOutportStream(dAtA, i, uint64(m.StartOffset))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxInFlight != 0 {
+		i = encodeVarintOutportStream(dAtA, i, uint64(m.MaxInFlight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintOutportStream(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StartNonce != 0 {
+		i = encodeVarintOutportStream(dAtA, i, uint64(m.StartNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerID) > 0 {
+		i -= len(m.ConsumerID)
+		copy(dAtA[i:], m.ConsumerID)
+		i = encodeVarintOutportStream(dAtA, i, uint64(len(m.ConsumerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutportMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutportMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutportMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintOutportStream(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintOutportStream(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintOutportStream(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Offset != 0 {
+		i = encodeVarintOutportStream(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcknowledgeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcknowledgeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcknowledgeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintOutportStream(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerID) > 0 {
+		i -= len(m.ConsumerID)
+		copy(dAtA[i:], m.ConsumerID)
+		i = encodeVarintOutportStream(dAtA, i, uint64(len(m.ConsumerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcknowledgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcknowledgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcknowledgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOutportStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOutportStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerID)
+	if l > 0 {
+		n += 1 + l + sovOutportStream(uint64(l))
+	}
+	if m.StartNonce != 0 {
+		n += 1 + sovOutportStream(uint64(m.StartNonce))
+	}
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovOutportStream(uint64(l))
+		}
+	}
+	if m.MaxInFlight != 0 {
+		n += 1 + sovOutportStream(uint64(m.MaxInFlight))
+	}
+	if m.StartOffset != 0 {
+		n += 1 + sovOutportStream(uint64(m.StartOffset))
+	}
+	return n
+}
+
+func (m *OutportMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovOutportStream(uint64(m.Offset))
+	}
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovOutportStream(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovOutportStream(uint64(m.Nonce))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovOutportStream(uint64(l))
+	}
+	return n
+}
+
+func (m *AcknowledgeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerID)
+	if l > 0 {
+		n += 1 + l + sovOutportStream(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovOutportStream(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *AcknowledgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovOutportStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOutportStream(x uint64) (n int) {
+	return sovOutportStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SubscribeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SubscribeRequest{`,
+		`ConsumerID:` + fmt.Sprintf("%v", this.ConsumerID) + `,`,
+		`StartNonce:` + fmt.Sprintf("%v", this.StartNonce) + `,`,
+		`Topics:` + fmt.Sprintf("%v", this.Topics) + `,`,
+		`MaxInFlight:` + fmt.Sprintf("%v", this.MaxInFlight) + `,`,
+		`StartOffset:` + fmt.Sprintf("%v", this.StartOffset) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OutportMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OutportMessage{`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AcknowledgeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AcknowledgeRequest{`,
+		`ConsumerID:` + fmt.Sprintf("%v", this.ConsumerID) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AcknowledgeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AcknowledgeResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringOutportStream(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutportStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutportStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartNonce", wireType)
+			}
+			m.StartNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutportStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutportStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInFlight", wireType)
+			}
+			m.MaxInFlight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutportStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInFlight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartOffset", wireType)
+			}
+			m.StartOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutportStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutportStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutportMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutportStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutportMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutportMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutportStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutportStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutportStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutportStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutportStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcknowledgeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutportStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcknowledgeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcknowledgeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutportStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutportStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutportStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcknowledgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutportStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcknowledgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcknowledgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutportStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutportStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOutportStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOutportStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutportStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutportStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOutportStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOutportStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOutportStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOutportStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOutportStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOutportStream = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,238 @@
+package stream
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Subscribe streams the buffered and the upcoming messages to the consumer. A known consumer resumes after its last
+// acknowledged message, while a new one starts with the requested start offset or, if not provided, with the oldest
+// buffered message. An evicted consumer is rejected, as the messages after its last acknowledged one were dropped,
+// unless it accepts the gap by requesting a buffered start offset. The block events with a nonce lower than the
+// requested start nonce and the events outside the requested topics are skipped
+func (sd *streamDriver) Subscribe(request *SubscribeRequest, server OutportStream_SubscribeServer) error {
+	if len(request.ConsumerID) == 0 {
+		return status.Error(codes.InvalidArgument, ErrEmptyConsumerID.Error())
+	}
+
+	c, err := sd.startSubscription(request)
+	if err != nil {
+		return err
+	}
+	defer sd.stopSubscription(c)
+
+	maxInFlight := sd.maxInFlight
+	if request.MaxInFlight > 0 && request.MaxInFlight < maxInFlight {
+		maxInFlight = request.MaxInFlight
+	}
+
+	sd.log.Debug("stream driver: consumer subscribed", "consumer", request.ConsumerID,
+		"start nonce", request.StartNonce, "topics", request.Topics, "max in flight", maxInFlight)
+
+	for {
+		message, errNext := sd.waitNextMessage(server.Context(), c, maxInFlight)
+		if errNext != nil {
+			return errNext
+		}
+
+		errNext = server.Send(message)
+		if errNext != nil {
+			sd.log.Debug("stream driver: consumer disconnected", "consumer", request.ConsumerID, "error", errNext)
+			return errNext
+		}
+	}
+}
+
+func (sd *streamDriver) startSubscription(request *SubscribeRequest) (*consumer, error) {
+	sd.mut.Lock()
+	defer sd.mut.Unlock()
+
+	if sd.isClosed {
+		return nil, status.Error(codes.Unavailable, ErrDriverIsClosed.Error())
+	}
+
+	c, found := sd.consumers[request.ConsumerID]
+	if !found {
+		var err error
+		c, err = sd.addConsumer(request)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if c.isStreaming {
+		return nil, status.Error(codes.AlreadyExists, ErrConsumerAlreadySubscribed.Error())
+	}
+
+	c.isStreaming = true
+	c.sentOffset = c.ackedOffset
+	c.inFlight = make([]uint64, 0)
+	c.startNonce = request.StartNonce
+	c.topics = make(map[string]struct{}, len(request.Topics))
+	for _, topic := range request.Topics {
+		c.topics[topic] = struct{}{}
+	}
+
+	return c, nil
+}
+
+// addConsumer adds a new or an evicted consumer, starting with the requested offset. Should be called under mutex
+func (sd *streamDriver) addConsumer(request *SubscribeRequest) (*consumer, error) {
+	ackedOffset, isEvicted := sd.evicted[request.ConsumerID]
+	if isEvicted && request.StartOffset == 0 {
+		return nil, sd.createEvictedError(ackedOffset)
+	}
+	if len(sd.consumers) >= sd.maxConsumers {
+		return nil, status.Errorf(codes.ResourceExhausted, "%s, maximum %d", ErrTooManyConsumers.Error(), sd.maxConsumers)
+	}
+
+	firstAvailableOffset := sd.getFirstAvailableOffset()
+	startOffset := request.StartOffset
+	if startOffset == 0 {
+		startOffset = firstAvailableOffset
+	}
+	if startOffset < firstAvailableOffset || startOffset > sd.nextOffset {
+		return nil, status.Errorf(codes.OutOfRange, "%s: %d, first available offset %d, next offset %d",
+			ErrInvalidStartOffset.Error(), startOffset, firstAvailableOffset, sd.nextOffset)
+	}
+
+	sd.forgetEvictedConsumer(request.ConsumerID)
+	c := &consumer{
+		ackedOffset: startOffset - 1,
+	}
+	sd.consumers[request.ConsumerID] = c
+
+	return c, nil
+}
+
+// getFirstAvailableOffset returns the offset of the oldest buffered message or, if the buffer is empty, the offset
+// of the next message. Should be called under mutex
+func (sd *streamDriver) getFirstAvailableOffset() uint64 {
+	if len(sd.messages) > 0 {
+		return sd.messages[0].Offset
+	}
+
+	return sd.nextOffset
+}
+
+// createEvictedError returns the error which tells an evicted consumer the gap in its stream. Should be called under mutex
+func (sd *streamDriver) createEvictedError(ackedOffset uint64) error {
+	firstAvailableOffset := sd.getFirstAvailableOffset()
+
+	return status.Errorf(codes.OutOfRange, "%s, acknowledged offset %d, first available offset %d",
+		ErrConsumerEvicted.Error(), ackedOffset, firstAvailableOffset)
+}
+
+func (sd *streamDriver) stopSubscription(c *consumer) {
+	sd.mut.Lock()
+	defer sd.mut.Unlock()
+
+	c.isStreaming = false
+}
+
+func (sd *streamDriver) waitNextMessage(ctx context.Context, c *consumer, maxInFlight uint32) (*OutportMessage, error) {
+	for {
+		message, changed, err := sd.getNextMessage(c, maxInFlight)
+		if err != nil || message != nil {
+			return message, err
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-sd.closeChan:
+			return nil, status.Error(codes.Unavailable, ErrDriverIsClosed.Error())
+		}
+	}
+}
+
+// getNextMessage returns the next message to be sent to the consumer or, if there is none or the consumer has too
+// many unacknowledged messages, the channel signaling the next change. An evicted consumer has to subscribe again
+func (sd *streamDriver) getNextMessage(c *consumer, maxInFlight uint32) (*OutportMessage, chan struct{}, error) {
+	sd.mut.Lock()
+	defer sd.mut.Unlock()
+
+	if sd.isClosed {
+		return nil, nil, status.Error(codes.Unavailable, ErrDriverIsClosed.Error())
+	}
+	if c.isEvicted {
+		return nil, nil, status.Error(codes.ResourceExhausted, ErrConsumerEvicted.Error())
+	}
+
+	c.removeAcknowledged()
+	for uint32(len(c.inFlight)) < maxInFlight {
+		message := sd.getMessage(c.sentOffset + 1)
+		if message == nil {
+			break
+		}
+
+		c.sentOffset = message.Offset
+		if c.shouldSend(message) {
+			c.inFlight = append(c.inFlight, message.Offset)
+			return message, nil, nil
+		}
+		if len(c.inFlight) == 0 {
+			// nothing is waiting for an acknowledge, so the skipped message is acknowledged on consumer's behalf
+			c.ackedOffset = message.Offset
+		}
+	}
+
+	return nil, sd.changed, nil
+}
+
+// Acknowledge marks all the messages up to and including the provided offset as processed by the consumer
+func (sd *streamDriver) Acknowledge(_ context.Context, request *AcknowledgeRequest) (*AcknowledgeResponse, error) {
+	sd.mut.Lock()
+	defer sd.mut.Unlock()
+
+	ackedOffset, isEvicted := sd.evicted[request.ConsumerID]
+	if isEvicted {
+		return nil, sd.createEvictedError(ackedOffset)
+	}
+
+	c, found := sd.consumers[request.ConsumerID]
+	if !found {
+		return nil, status.Error(codes.NotFound, ErrUnknownConsumer.Error())
+	}
+	if request.Offset > c.sentOffset {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %d, last sent offset %d", ErrOffsetNotSent.Error(), request.Offset, c.sentOffset)
+	}
+	if request.Offset > c.ackedOffset {
+		c.ackedOffset = request.Offset
+		c.removeAcknowledged()
+		if len(c.inFlight) == 0 {
+			// the messages skipped after the last sent one are acknowledged as well
+			c.ackedOffset = c.sentOffset
+		}
+		sd.trimAcknowledgedMessages()
+		sd.notifyChange()
+	}
+
+	return &AcknowledgeResponse{}, nil
+}
+
+func (c *consumer) removeAcknowledged() {
+	if c.sentOffset < c.ackedOffset {
+		c.sentOffset = c.ackedOffset
+	}
+
+	numAcknowledged := 0
+	for numAcknowledged < len(c.inFlight) && c.inFlight[numAcknowledged] <= c.ackedOffset {
+		numAcknowledged++
+	}
+	c.inFlight = c.inFlight[numAcknowledged:]
+}
+
+func (c *consumer) shouldSend(message *OutportMessage) bool {
+	if message.Nonce > 0 && message.Nonce < c.startNonce {
+		return false
+	}
+	if len(c.topics) == 0 {
+		return true
+	}
+
+	_, found := c.topics[message.Topic]
+	return found
+}
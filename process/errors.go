@@ -978,6 +978,9 @@ var ErrNilOrEmptyList = errors.New("nil or empty provided list")
 // ErrNilScQueryElement signals that a nil sc query service element was provided
 var ErrNilScQueryElement = errors.New("nil SC query service element")
 
+// ErrNilSCQueryCache signals that a nil SC query cache was provided
+var ErrNilSCQueryCache = errors.New("nil SC query cache")
+
 // ErrMaxAccumulatedFeesExceeded signals that max accumulated fees has been exceeded
 var ErrMaxAccumulatedFeesExceeded = errors.New("max accumulated fees has been exceeded")
 
@@ -1226,3 +1229,42 @@ var ErrInvalidAsyncArguments = errors.New("invalid arguments to process async/ca
 
 // ErrNilSentSignatureTracker defines the error for setting a nil SentSignatureTracker
 var ErrNilSentSignatureTracker = errors.New("nil sent signature tracker")
+
+// ErrNilFloodPreventer signals that a nil flood preventer has been provided
+var ErrNilFloodPreventer = errors.New("nil flood preventer")
+
+// ErrFloodPreventerNotFound signals that the requested flood preventer was not found
+var ErrFloodPreventerNotFound = errors.New("flood preventer not found")
+
+// ErrAntifloodDisabled signals that the antiflood mechanism is disabled
+var ErrAntifloodDisabled = errors.New("antiflood is disabled")
+
+// ErrNilPeerReputationSnapshot signals that a nil peer reputation snapshot has been provided
+var ErrNilPeerReputationSnapshot = errors.New("nil peer reputation snapshot")
+
+// ErrPeerReputationDisabled signals that the peer reputation persistence is disabled
+var ErrPeerReputationDisabled = errors.New("peer reputation persistence is disabled")
+
+// ErrNilAuctionSimulationRequest signals that a nil auction simulation request has been provided
+var ErrNilAuctionSimulationRequest = errors.New("nil auction simulation request")
+
+// ErrInvalidTopUpDelta signals that an invalid top up delta has been provided
+var ErrInvalidTopUpDelta = errors.New("invalid top up delta")
+
+// ErrBlsKeyNotOwned signals that the provided BLS key is not owned by the provided owner
+var ErrBlsKeyNotOwned = errors.New("BLS key is not owned by the provided owner")
+
+// ErrDuplicatedUnStakedNode signals that the same BLS key was provided more than once as unStaked in an auction simulation
+var ErrDuplicatedUnStakedNode = errors.New("duplicated unStaked node")
+
+// ErrTooManySimulatedNodes signals that too many new nodes were requested in an auction simulation
+var ErrTooManySimulatedNodes = errors.New("too many simulated new nodes")
+
+// ErrInvalidEpochsRange signals that an invalid range of epochs has been provided
+var ErrInvalidEpochsRange = errors.New("invalid epochs range")
+
+// ErrValidatorsHistoryNotAvailableOnShardNode signals that the validators history was requested from a shard node
+var ErrValidatorsHistoryNotAvailableOnShardNode = errors.New("validators history can not be returned by a shard node")
+
+// ErrNilPeersRatingHandler signals that a nil peers rating handler has been provided
+var ErrNilPeersRatingHandler = errors.New("nil peers rating handler")
@@ -319,6 +319,8 @@ type TransactionLogProcessorDatabase interface {
 type ValidatorsProvider interface {
 	GetLatestValidators() map[string]*validator.ValidatorStatistics
 	GetAuctionList() ([]*common.AuctionListValidatorAPIResponse, error)
+	SimulateAuction(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error)
+	GetValidatorHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error)
 	ForceUpdate() error
 	IsInterfaceNil() bool
 	Close() error
@@ -758,6 +760,13 @@ type PeerBlackListCacher interface {
 	IsInterfaceNil() bool
 }
 
+// PeerBlackListManager is a PeerBlackListCacher that is also able to list and remove the blacklisted peers
+type PeerBlackListManager interface {
+	PeerBlackListCacher
+	Remove(pid core.PeerID)
+	GetBlacklistedPeers() map[core.PeerID]time.Time
+}
+
 // PeerShardMapper can return the public key of a provided peer ID
 type PeerShardMapper interface {
 	UpdatePeerIDPublicKeyPair(pid core.PeerID, pk []byte)
@@ -900,16 +909,56 @@ type FloodPreventer interface {
 	IsInterfaceNil() bool
 }
 
+// QuotaFloodPreventer defines a flood preventer whose per-peer quotas can be inspected and changed at runtime
+type QuotaFloodPreventer interface {
+	FloodPreventer
+	Name() string
+	GetQuotaStatus() *common.AntifloodQuotaStatus
+	SetMaxQuota(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+}
+
 // TopicFloodPreventer defines the behavior of a component that is able to signal that too many events occurred
 // on a provided identifier between Reset calls, on a given topic
 type TopicFloodPreventer interface {
 	IncreaseLoad(pid core.PeerID, topic string, numMessages uint32) error
 	ResetForTopic(topic string)
+	ResetForRegisteredTopics()
 	ResetForNotRegisteredTopics()
 	SetMaxMessagesForTopic(topic string, maxNum uint32)
+	GetTopicsStatus() []*common.AntifloodTopicStatus
+	IsInterfaceNil() bool
+}
+
+// AntifloodDashboardHandler defines the behavior of a component able to report the state of the input antiflood
+// mechanism and to tune it at runtime
+type AntifloodDashboardHandler interface {
+	GetQuotasStatus() []*common.AntifloodQuotaStatus
+	GetTopicsStatus() []*common.AntifloodTopicStatus
+	GetBlacklistedPeers() []*common.BlacklistedPeer
+	SetMaxQuota(name string, baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetMaxMessagesForTopic(topic string, maxNum uint32) error
+	BlacklistPeer(pid core.PeerID, duration time.Duration) error
+	RemoveBlacklistedPeer(pid core.PeerID) error
+	IsInterfaceNil() bool
+}
+
+// PeerReputationHandler is able to export, import and persist the peers blacklist and ratings
+type PeerReputationHandler interface {
+	Export() *common.PeerReputationSnapshot
+	Import(snapshot *common.PeerReputationSnapshot) error
+	Save() error
+	Close() error
 	IsInterfaceNil() bool
 }
 
+// PeersRatingTracker defines the behavior of a peers rating handler that also keeps the moment of the last update of
+// each rating, so the ratings can be exported and imported
+type PeersRatingTracker interface {
+	p2p.PeersRatingHandler
+	GetRatings() []*common.PeerRatingInfo
+	SetRating(pid core.PeerID, rating int32, lastUpdate int64)
+}
+
 // P2PAntifloodHandler defines the behavior of a component able to signal that the system is too busy (or flooded) processing
 // p2p messages
 type P2PAntifloodHandler interface {
@@ -937,6 +986,17 @@ type SCQueryService interface {
 	IsInterfaceNil() bool
 }
 
+// SCQueryCacheHandler defines the caches shared by the SC query services when executing queries on historical blocks
+type SCQueryCacheHandler interface {
+	GetHistoricalRoot(headerHash []byte) (data.HeaderHandler, []byte, bool)
+	PutHistoricalRoot(headerHash []byte, header data.HeaderHandler, rootHash []byte)
+	GetHistoricalTrie(rootHash []byte) (common.Trie, bool)
+	PutHistoricalTrie(rootHash []byte, recreatedTrie common.Trie)
+	GetQueryResult(key []byte) (*vmcommon.VMOutput, common.BlockInfo, bool)
+	PutQueryResult(key []byte, vmOutput *vmcommon.VMOutput, blockInfo common.BlockInfo)
+	IsInterfaceNil() bool
+}
+
 // EpochStartDataCreator defines the functionality for node to create epoch start data
 type EpochStartDataCreator interface {
 	CreateEpochStartData() (*block.EpochStart, error)
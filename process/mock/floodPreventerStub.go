@@ -1,12 +1,18 @@
 package mock
 
-import "github.com/multiversx/mx-chain-core-go/core"
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+)
 
 // FloodPreventerStub -
 type FloodPreventerStub struct {
 	IncreaseLoadCalled       func(pid core.PeerID, size uint64) error
 	ApplyConsensusSizeCalled func(size int)
 	ResetCalled              func()
+	NameCalled               func() string
+	GetQuotaStatusCalled     func() *common.AntifloodQuotaStatus
+	SetMaxQuotaCalled        func(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
 }
 
 // IncreaseLoad -
@@ -26,6 +32,33 @@ func (fps *FloodPreventerStub) Reset() {
 	fps.ResetCalled()
 }
 
+// Name -
+func (fps *FloodPreventerStub) Name() string {
+	if fps.NameCalled != nil {
+		return fps.NameCalled()
+	}
+
+	return ""
+}
+
+// GetQuotaStatus -
+func (fps *FloodPreventerStub) GetQuotaStatus() *common.AntifloodQuotaStatus {
+	if fps.GetQuotaStatusCalled != nil {
+		return fps.GetQuotaStatusCalled()
+	}
+
+	return &common.AntifloodQuotaStatus{}
+}
+
+// SetMaxQuota -
+func (fps *FloodPreventerStub) SetMaxQuota(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	if fps.SetMaxQuotaCalled != nil {
+		return fps.SetMaxQuotaCalled(baseMaxNumMessagesPerPeer, maxTotalSizePerPeer)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (fps *FloodPreventerStub) IsInterfaceNil() bool {
 	return fps == nil
@@ -8,9 +8,11 @@ import (
 
 // PeerBlackListHandlerStub -
 type PeerBlackListHandlerStub struct {
-	UpsertCalled func(pid core.PeerID, span time.Duration) error
-	HasCalled    func(pid core.PeerID) bool
-	SweepCalled  func()
+	UpsertCalled              func(pid core.PeerID, span time.Duration) error
+	HasCalled                 func(pid core.PeerID) bool
+	SweepCalled               func()
+	RemoveCalled              func(pid core.PeerID)
+	GetBlacklistedPeersCalled func() map[core.PeerID]time.Time
 }
 
 // Upsert -
@@ -40,6 +42,24 @@ func (pblhs *PeerBlackListHandlerStub) Sweep() {
 	pblhs.SweepCalled()
 }
 
+// Remove -
+func (pblhs *PeerBlackListHandlerStub) Remove(pid core.PeerID) {
+	if pblhs.RemoveCalled == nil {
+		return
+	}
+
+	pblhs.RemoveCalled(pid)
+}
+
+// GetBlacklistedPeers -
+func (pblhs *PeerBlackListHandlerStub) GetBlacklistedPeers() map[core.PeerID]time.Time {
+	if pblhs.GetBlacklistedPeersCalled == nil {
+		return make(map[core.PeerID]time.Time)
+	}
+
+	return pblhs.GetBlacklistedPeersCalled()
+}
+
 // IsInterfaceNil -
 func (pblhs *PeerBlackListHandlerStub) IsInterfaceNil() bool {
 	return pblhs == nil
@@ -1,12 +1,16 @@
 package mock
 
-import "github.com/multiversx/mx-chain-core-go/core"
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+)
 
 // TopicAntiFloodStub -
 type TopicAntiFloodStub struct {
 	IncreaseLoadCalled           func(pid core.PeerID, topic string, numMessages uint32) error
 	ResetForTopicCalled          func(topic string)
 	SetMaxMessagesForTopicCalled func(topic string, num uint32)
+	GetTopicsStatusCalled        func() []*common.AntifloodTopicStatus
 }
 
 // IncreaseLoad -
@@ -25,6 +29,10 @@ func (t *TopicAntiFloodStub) ResetForTopic(topic string) {
 	}
 }
 
+// ResetForRegisteredTopics -
+func (t *TopicAntiFloodStub) ResetForRegisteredTopics() {
+}
+
 // ResetForNotRegisteredTopics -
 func (t *TopicAntiFloodStub) ResetForNotRegisteredTopics() {
 }
@@ -36,6 +44,15 @@ func (t *TopicAntiFloodStub) SetMaxMessagesForTopic(topic string, num uint32) {
 	}
 }
 
+// GetTopicsStatus -
+func (t *TopicAntiFloodStub) GetTopicsStatus() []*common.AntifloodTopicStatus {
+	if t.GetTopicsStatusCalled != nil {
+		return t.GetTopicsStatusCalled()
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (t *TopicAntiFloodStub) IsInterfaceNil() bool {
 	return t == nil
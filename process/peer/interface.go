@@ -17,6 +17,9 @@ type StakingDataProviderAPI interface {
 	ComputeUnQualifiedNodes(validatorInfos state.ShardValidatorsInfoMapHandler) ([][]byte, map[string][][]byte, error)
 	FillValidatorInfo(validator state.ValidatorInfoHandler) error
 	GetOwnersData() map[string]*epochStart.OwnerData
+	GetBlsKeyOwner(blsKey []byte) (string, error)
+	SetOwnersDataOverride(ownersData map[string]*epochStart.OwnerData)
+	ResetOwnersDataOverride()
 	Clean()
 	IsInterfaceNil() bool
 }
@@ -10,12 +10,15 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/validator"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/epochStart/notifier"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
 )
 
 var _ process.ValidatorsProvider = (*validatorsProvider)(nil)
@@ -38,6 +41,12 @@ type validatorsProvider struct {
 	addressPubKeyConverter       core.PubkeyConverter
 	stakingDataProvider          StakingDataProviderAPI
 	auctionListSelector          epochStart.AuctionListSelector
+	validatorsHistoryStorer      storage.Storer
+	isValidatorsHistoryEnabled   bool
+	mutSaveValidatorsHistory     sync.Mutex
+	mutPendingHistory            sync.Mutex
+	pendingValidatorsHistory     []*validatorsHistoryRecord
+	marshaller                   marshal.Marshalizer
 
 	maxRating    uint32
 	currentEpoch uint32
@@ -53,6 +62,8 @@ type ArgValidatorsProvider struct {
 	AddressPubKeyConverter            core.PubkeyConverter
 	StakingDataProvider               StakingDataProviderAPI
 	AuctionListSelector               epochStart.AuctionListSelector
+	ValidatorsHistoryStorer           storage.Storer
+	Marshaller                        marshal.Marshalizer
 	StartEpoch                        uint32
 	MaxRating                         uint32
 }
@@ -83,6 +94,12 @@ func NewValidatorsProvider(
 	if check.IfNil(args.AuctionListSelector) {
 		return nil, epochStart.ErrNilAuctionListSelector
 	}
+	if check.IfNil(args.ValidatorsHistoryStorer) {
+		return nil, fmt.Errorf("%w for validators history", process.ErrNilStorage)
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, process.ErrNilMarshalizer
+	}
 	if args.MaxRating == 0 {
 		return nil, process.ErrMaxRatingZero
 	}
@@ -90,6 +107,9 @@ func NewValidatorsProvider(
 		return nil, process.ErrInvalidCacheRefreshIntervalInSec
 	}
 
+	// the validators history is computed only by the metachain, the shard nodes receive a nil storer
+	_, isValidatorsHistoryDisabled := args.ValidatorsHistoryStorer.(*storageunit.NilStorer)
+
 	currentContext, cancelfunc := context.WithCancel(context.Background())
 
 	valProvider := &validatorsProvider{
@@ -109,6 +129,10 @@ func NewValidatorsProvider(
 		addressPubKeyConverter:       args.AddressPubKeyConverter,
 		currentEpoch:                 args.StartEpoch,
 		auctionListSelector:          args.AuctionListSelector,
+		validatorsHistoryStorer:      args.ValidatorsHistoryStorer,
+		isValidatorsHistoryEnabled:   !isValidatorsHistoryDisabled,
+		pendingValidatorsHistory:     make([]*validatorsHistoryRecord, 0),
+		marshaller:                   args.Marshaller,
 	}
 
 	go valProvider.startRefreshProcess(currentContext)
@@ -180,6 +204,7 @@ func (vp *validatorsProvider) epochStartEventHandler() nodesCoordinator.EpochSta
 				"shard", hdr.GetShardID(),
 				"round", hdr.GetRound(),
 				"epoch", hdr.GetEpoch())
+			vp.saveValidatorsHistory(hdr.GetEpoch())
 			go func() {
 				vp.refreshCache <- hdr.GetEpoch()
 			}()
@@ -30,6 +30,11 @@ func (vp *validatorsProvider) updateAuctionListCacheIfNeeded() error {
 	vp.auctionMutex.Lock()
 	defer vp.auctionMutex.Unlock()
 
+	return vp.updateAuctionListCacheIfExpired()
+}
+
+// this func should be called under mutex protection
+func (vp *validatorsProvider) updateAuctionListCacheIfExpired() error {
 	shouldUpdate := time.Since(vp.lastAuctionCacheUpdate) > vp.cacheRefreshIntervalDuration
 
 	if shouldUpdate {
@@ -72,12 +77,13 @@ func (vp *validatorsProvider) createValidatorsAuctionCache(validatorsMap state.S
 		return nil, err
 	}
 
-	selectedNodes, err := vp.getSelectedNodesFromAuction(validatorsMap)
+	selectedNodes, err := vp.getSelectedNodesFromAuction(validatorsMap, vp.cachedRandomness)
 	if err != nil {
 		return nil, err
 	}
 
-	auctionListValidators, qualifiedOwners := vp.getAuctionListValidatorsAPIResponse(selectedNodes)
+	ownersData := vp.stakingDataProvider.GetOwnersData()
+	auctionListValidators, qualifiedOwners := vp.getAuctionListValidatorsAPIResponse(selectedNodes, ownersData)
 	sortList(auctionListValidators, qualifiedOwners)
 	return auctionListValidators, nil
 }
@@ -95,9 +101,10 @@ func (vp *validatorsProvider) fillAllValidatorsInfo(validatorsMap state.ShardVal
 }
 
 // this func should be called under mutex protection
-func (vp *validatorsProvider) getSelectedNodesFromAuction(validatorsMap state.ShardValidatorsInfoMapHandler) ([]state.ValidatorInfoHandler, error) {
-	randomness := vp.cachedRandomness
-
+func (vp *validatorsProvider) getSelectedNodesFromAuction(
+	validatorsMap state.ShardValidatorsInfoMapHandler,
+	randomness []byte,
+) ([]state.ValidatorInfoHandler, error) {
 	err := vp.auctionListSelector.SelectNodesFromAuctionList(validatorsMap, randomness)
 	if err != nil {
 		return nil, err
@@ -153,11 +160,12 @@ func getNumQualified(nodes []*common.AuctionNode) uint32 {
 
 func (vp *validatorsProvider) getAuctionListValidatorsAPIResponse(
 	selectedNodes []state.ValidatorInfoHandler,
+	ownersData map[string]*epochStart.OwnerData,
 ) ([]*common.AuctionListValidatorAPIResponse, map[string]bool) {
 	auctionListValidators := make([]*common.AuctionListValidatorAPIResponse, 0)
 	qualifiedOwners := make(map[string]bool)
 
-	for ownerPubKey, ownerData := range vp.stakingDataProvider.GetOwnersData() {
+	for ownerPubKey, ownerData := range ownersData {
 		numAuctionNodes := len(ownerData.AuctionList)
 		if numAuctionNodes > 0 {
 			ownerEncodedPubKey := vp.addressPubKeyConverter.SilentEncode([]byte(ownerPubKey), log)
@@ -0,0 +1,320 @@
+package peer
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+const simulatedBlsKeyPrefix = "simulated"
+const blsKeyLength = 96
+const maxNumSimulatedNewNodes = 100
+
+type auctionSimulationChange struct {
+	owner         []byte
+	topUpDelta    *big.Int
+	unStakedNodes [][]byte
+	numNewNodes   uint32
+}
+
+// SimulateAuction runs the auction selection on the data of the current auction list, after applying the provided
+// hypothetical changes. The number of available slots is not affected by the changes and neither is the total top up
+// of an owner when unStaking or adding nodes
+func (vp *validatorsProvider) SimulateAuction(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error) {
+	if request == nil {
+		return nil, process.ErrNilAuctionSimulationRequest
+	}
+
+	changes, err := vp.decodeAuctionSimulationChanges(request.Changes)
+	if err != nil {
+		return nil, err
+	}
+
+	vp.auctionMutex.Lock()
+	defer vp.auctionMutex.Unlock()
+
+	// the simulation starts from the root hash and the randomness of the cached auction list, so the current
+	// selection matches the one returned by GetAuctionList
+	err = vp.updateAuctionListCacheIfExpired()
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash := vp.cachedRandomness
+	validatorsMap, err := vp.validatorStatistics.GetValidatorInfoForRootHash(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	defer vp.stakingDataProvider.Clean()
+
+	err = vp.fillAllValidatorsInfo(validatorsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	ownersData := vp.stakingDataProvider.GetOwnersData()
+	currentSelectedNodes, err := vp.getSelectedNodesFromAuction(copyValidatorsMap(validatorsMap), rootHash)
+	if err != nil {
+		return nil, err
+	}
+	currentAuctionList, _ := vp.getAuctionListValidatorsAPIResponse(currentSelectedNodes, ownersData)
+
+	simulatedValidatorsMap := copyValidatorsMap(validatorsMap)
+	simulatedOwnersData, err := vp.applyAuctionSimulationChanges(changes, ownersData, simulatedValidatorsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	vp.stakingDataProvider.SetOwnersDataOverride(simulatedOwnersData)
+	simulatedSelectedNodes, err := vp.getSelectedNodesFromAuction(simulatedValidatorsMap, rootHash)
+	vp.stakingDataProvider.ResetOwnersDataOverride()
+	if err != nil {
+		return nil, err
+	}
+
+	simulatedAuctionList, qualifiedOwners := vp.getAuctionListValidatorsAPIResponse(simulatedSelectedNodes, simulatedOwnersData)
+	sortList(simulatedAuctionList, qualifiedOwners)
+
+	return &common.AuctionSimulationAPIResponse{
+		CurrentThreshold:   computeAuctionThreshold(currentAuctionList),
+		SimulatedThreshold: computeAuctionThreshold(simulatedAuctionList),
+		AuctionList:        simulatedAuctionList,
+		ChangedNodes:       computeChangedNodes(currentAuctionList, simulatedAuctionList),
+	}, nil
+}
+
+func (vp *validatorsProvider) decodeAuctionSimulationChanges(changes []*common.AuctionSimulationOwnerChange) ([]*auctionSimulationChange, error) {
+	decodedChanges := make([]*auctionSimulationChange, 0, len(changes))
+	numNewNodes := uint32(0)
+	seenUnStakedNodes := make(map[string]struct{})
+	for _, change := range changes {
+		if change == nil {
+			continue
+		}
+
+		owner, err := vp.addressPubKeyConverter.Decode(change.Owner)
+		if err != nil {
+			return nil, fmt.Errorf("%w for owner %s", err, change.Owner)
+		}
+
+		topUpDelta := big.NewInt(0)
+		if len(change.TopUpDelta) > 0 {
+			_, ok := topUpDelta.SetString(change.TopUpDelta, 10)
+			if !ok {
+				return nil, fmt.Errorf("%w for owner %s: %s", process.ErrInvalidTopUpDelta, change.Owner, change.TopUpDelta)
+			}
+		}
+
+		unStakedNodes := make([][]byte, 0, len(change.UnStakedNodes))
+		for _, encodedBlsKey := range change.UnStakedNodes {
+			blsKey, errDecode := vp.validatorPubKeyConverter.Decode(encodedBlsKey)
+			if errDecode != nil {
+				return nil, fmt.Errorf("%w for BLS key %s", errDecode, encodedBlsKey)
+			}
+			_, isDuplicated := seenUnStakedNodes[string(blsKey)]
+			if isDuplicated {
+				return nil, fmt.Errorf("%w, BLS key: %s", process.ErrDuplicatedUnStakedNode, encodedBlsKey)
+			}
+			seenUnStakedNodes[string(blsKey)] = struct{}{}
+			unStakedNodes = append(unStakedNodes, blsKey)
+		}
+
+		numNewNodes += change.NumNewNodes
+		if numNewNodes > maxNumSimulatedNewNodes || numNewNodes < change.NumNewNodes {
+			return nil, fmt.Errorf("%w, maximum allowed: %d", process.ErrTooManySimulatedNodes, maxNumSimulatedNewNodes)
+		}
+
+		decodedChanges = append(decodedChanges, &auctionSimulationChange{
+			owner:         owner,
+			topUpDelta:    topUpDelta,
+			unStakedNodes: unStakedNodes,
+			numNewNodes:   change.NumNewNodes,
+		})
+	}
+
+	return decodedChanges, nil
+}
+
+// this func should be called under mutex protection
+func (vp *validatorsProvider) applyAuctionSimulationChanges(
+	changes []*auctionSimulationChange,
+	ownersData map[string]*epochStart.OwnerData,
+	validatorsMap state.ShardValidatorsInfoMapHandler,
+) (map[string]*epochStart.OwnerData, error) {
+	simulatedOwnersData := make(map[string]*epochStart.OwnerData, len(ownersData))
+	for owner, ownerData := range ownersData {
+		simulatedOwnersData[owner] = epochStart.CopyOwnerData(ownerData)
+	}
+
+	numSimulatedNodes := 0
+	for _, change := range changes {
+		ownerData, found := simulatedOwnersData[string(change.owner)]
+		if !found {
+			ownerData = &epochStart.OwnerData{
+				TotalTopUp:   big.NewInt(0),
+				TopUpPerNode: big.NewInt(0),
+				AuctionList:  make([]state.ValidatorInfoHandler, 0),
+				Qualified:    true,
+			}
+			simulatedOwnersData[string(change.owner)] = ownerData
+		}
+
+		if change.topUpDelta.Sign() != 0 {
+			ownerData.TotalTopUp.Add(ownerData.TotalTopUp, change.topUpDelta)
+			ownerData.Qualified = ownerData.TotalTopUp.Sign() >= 0
+		}
+
+		for _, blsKey := range change.unStakedNodes {
+			err := vp.unStakeSimulatedNode(change.owner, blsKey, ownerData)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for i := uint32(0); i < change.numNewNodes; i++ {
+			newNode := createSimulatedNode(numSimulatedNodes)
+			numSimulatedNodes++
+
+			err := validatorsMap.Add(newNode)
+			if err != nil {
+				return nil, err
+			}
+
+			ownerData.AuctionList = append(ownerData.AuctionList, newNode)
+			ownerData.NumStakedNodes++
+		}
+
+		ownerData.TopUpPerNode = big.NewInt(0)
+		if ownerData.NumStakedNodes > 0 {
+			ownerData.TopUpPerNode.Div(ownerData.TotalTopUp, big.NewInt(ownerData.NumStakedNodes))
+		}
+	}
+
+	return simulatedOwnersData, nil
+}
+
+func (vp *validatorsProvider) unStakeSimulatedNode(owner []byte, blsKey []byte, ownerData *epochStart.OwnerData) error {
+	blsKeyOwner, err := vp.stakingDataProvider.GetBlsKeyOwner(blsKey)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal([]byte(blsKeyOwner), owner) {
+		return fmt.Errorf("%w, BLS key: %s", process.ErrBlsKeyNotOwned, vp.validatorPubKeyConverter.SilentEncode(blsKey, log))
+	}
+
+	if ownerData.NumStakedNodes > 0 {
+		ownerData.NumStakedNodes--
+	}
+
+	for idx, node := range ownerData.AuctionList {
+		if bytes.Equal(node.GetPublicKey(), blsKey) {
+			ownerData.AuctionList = append(ownerData.AuctionList[:idx], ownerData.AuctionList[idx+1:]...)
+			return nil
+		}
+	}
+
+	if ownerData.NumActiveNodes > 0 {
+		ownerData.NumActiveNodes--
+	}
+
+	return nil
+}
+
+func createSimulatedNode(index int) state.ValidatorInfoHandler {
+	blsKey := make([]byte, blsKeyLength)
+	copy(blsKey, fmt.Sprintf("%s%d", simulatedBlsKeyPrefix, index))
+
+	return &state.ValidatorInfo{
+		PublicKey: blsKey,
+		List:      string(common.AuctionList),
+	}
+}
+
+func copyValidatorsMap(validatorsMap state.ShardValidatorsInfoMapHandler) state.ShardValidatorsInfoMapHandler {
+	validatorsMapCopy := state.NewShardValidatorsInfoMap()
+	for _, validator := range validatorsMap.GetAllValidatorsInfo() {
+		_ = validatorsMapCopy.Add(validator.ShallowClone())
+	}
+
+	return validatorsMapCopy
+}
+
+// computeAuctionThreshold returns the minimum qualified top up of the owners with selected nodes
+func computeAuctionThreshold(auctionList []*common.AuctionListValidatorAPIResponse) string {
+	var threshold *big.Int
+	for _, owner := range auctionList {
+		if getNumQualified(owner.Nodes) == 0 {
+			continue
+		}
+
+		qualifiedTopUp, ok := big.NewInt(0).SetString(owner.QualifiedTopUp, 10)
+		if !ok {
+			continue
+		}
+		if threshold == nil || qualifiedTopUp.Cmp(threshold) < 0 {
+			threshold = qualifiedTopUp
+		}
+	}
+
+	if threshold == nil {
+		return "0"
+	}
+
+	return threshold.String()
+}
+
+// computeChangedNodes returns the nodes whose selection status differs between the current and the simulated auction
+func computeChangedNodes(
+	currentAuctionList []*common.AuctionListValidatorAPIResponse,
+	simulatedAuctionList []*common.AuctionListValidatorAPIResponse,
+) []*common.AuctionSimulationNodeChange {
+	currentNodes := getAuctionNodesStatus(currentAuctionList)
+	simulatedNodes := getAuctionNodesStatus(simulatedAuctionList)
+
+	changedNodes := make([]*common.AuctionSimulationNodeChange, 0)
+	for blsKey, simulatedNode := range simulatedNodes {
+		currentNode, found := currentNodes[blsKey]
+		wasSelected := found && currentNode.Selected
+		if wasSelected != simulatedNode.Selected {
+			changedNodes = append(changedNodes, simulatedNode)
+		}
+	}
+	for blsKey, currentNode := range currentNodes {
+		_, found := simulatedNodes[blsKey]
+		if !found && currentNode.Selected {
+			changedNodes = append(changedNodes, &common.AuctionSimulationNodeChange{
+				BlsKey:   currentNode.BlsKey,
+				Owner:    currentNode.Owner,
+				Selected: false,
+			})
+		}
+	}
+
+	sort.Slice(changedNodes, func(i, j int) bool {
+		return changedNodes[i].BlsKey < changedNodes[j].BlsKey
+	})
+
+	return changedNodes
+}
+
+func getAuctionNodesStatus(auctionList []*common.AuctionListValidatorAPIResponse) map[string]*common.AuctionSimulationNodeChange {
+	nodes := make(map[string]*common.AuctionSimulationNodeChange)
+	for _, owner := range auctionList {
+		for _, node := range owner.Nodes {
+			nodes[node.BlsKey] = &common.AuctionSimulationNodeChange{
+				BlsKey:   node.BlsKey,
+				Owner:    owner.Owner,
+				Selected: node.Qualified,
+			}
+		}
+	}
+
+	return nodes
+}
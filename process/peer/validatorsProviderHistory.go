@@ -0,0 +1,185 @@
+package peer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+const (
+	maxValidatorHistoryEpochs   = 100
+	maxPendingValidatorsHistory = 10
+	epochSizeInBytes            = 4
+)
+
+type validatorsHistoryRecord struct {
+	rootHash []byte
+	epoch    uint32
+}
+
+// GetValidatorHistory returns the statistics of the provided validator, as recorded at the end of each epoch from the
+// provided range. If not provided, the range ends with the current epoch and spans the maximum number of epochs allowed.
+// The epochs without a record for the validator are skipped. The history is recorded only by the metachain nodes
+func (vp *validatorsProvider) GetValidatorHistory(
+	blsKey string,
+	fromEpoch core.OptionalUint32,
+	toEpoch core.OptionalUint32,
+) ([]*common.ValidatorEpochStatisticsAPIResponse, error) {
+	if !vp.isValidatorsHistoryEnabled {
+		return nil, process.ErrValidatorsHistoryNotAvailableOnShardNode
+	}
+
+	blsKeyBytes, err := vp.validatorPubKeyConverter.Decode(blsKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w for BLS key %s", err, blsKey)
+	}
+
+	startEpoch, endEpoch, err := vp.computeHistoryEpochsRange(fromEpoch, toEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*common.ValidatorEpochStatisticsAPIResponse, 0)
+	for epoch := uint64(startEpoch); epoch <= uint64(endEpoch); epoch++ {
+		buff, errGet := vp.validatorsHistoryStorer.Get(createValidatorHistoryKey(blsKeyBytes, uint32(epoch)))
+		if errGet != nil {
+			continue
+		}
+
+		validatorInfo := &state.ValidatorInfo{}
+		err = vp.marshaller.Unmarshal(validatorInfo, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(history, vp.createValidatorEpochStatistics(uint32(epoch), validatorInfo))
+	}
+
+	return history, nil
+}
+
+func (vp *validatorsProvider) computeHistoryEpochsRange(fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) (uint32, uint32, error) {
+	endEpoch := toEpoch.Value
+	if !toEpoch.HasValue {
+		vp.lock.RLock()
+		endEpoch = vp.currentEpoch
+		vp.lock.RUnlock()
+	}
+
+	startEpoch := fromEpoch.Value
+	if !fromEpoch.HasValue {
+		startEpoch = 0
+		if endEpoch >= maxValidatorHistoryEpochs {
+			startEpoch = endEpoch - maxValidatorHistoryEpochs + 1
+		}
+	}
+
+	if startEpoch > endEpoch {
+		return 0, 0, fmt.Errorf("%w, fromEpoch %d is greater than toEpoch %d", process.ErrInvalidEpochsRange, startEpoch, endEpoch)
+	}
+	if endEpoch-startEpoch >= maxValidatorHistoryEpochs {
+		return 0, 0, fmt.Errorf("%w, at most %d epochs can be requested", process.ErrInvalidEpochsRange, maxValidatorHistoryEpochs)
+	}
+
+	return startEpoch, endEpoch, nil
+}
+
+func (vp *validatorsProvider) createValidatorEpochStatistics(epoch uint32, validatorInfo *state.ValidatorInfo) *common.ValidatorEpochStatisticsAPIResponse {
+	return &common.ValidatorEpochStatisticsAPIResponse{
+		Epoch:                         epoch,
+		ShardId:                       validatorInfo.GetShardId(),
+		ValidatorStatus:               validatorInfo.GetList(),
+		Rating:                        float32(validatorInfo.GetRating()) * 100 / float32(vp.maxRating),
+		TempRating:                    float32(validatorInfo.GetTempRating()) * 100 / float32(vp.maxRating),
+		NumLeaderSuccess:              validatorInfo.GetLeaderSuccess(),
+		NumLeaderFailure:              validatorInfo.GetLeaderFailure(),
+		NumValidatorSuccess:           validatorInfo.GetValidatorSuccess(),
+		NumValidatorFailure:           validatorInfo.GetValidatorFailure(),
+		NumValidatorIgnoredSignatures: validatorInfo.GetValidatorIgnoredSignatures(),
+	}
+}
+
+// saveValidatorsHistory records the statistics of all validators under the epoch that has just ended. It is called
+// on the epoch start event, when the last finalized peer accounts state still holds the statistics of the ended epoch.
+// The epochs which could not be saved are retried on the next epoch start events, while their root hash is available
+func (vp *validatorsProvider) saveValidatorsHistory(newEpoch uint32) {
+	if !vp.isValidatorsHistoryEnabled || newEpoch == 0 {
+		return
+	}
+
+	rootHash := vp.validatorStatistics.LastFinalizedRootHash()
+	if len(rootHash) == 0 {
+		log.Warn("validatorsProvider - could not save the validators history, empty root hash", "epoch", newEpoch-1)
+		return
+	}
+
+	vp.mutPendingHistory.Lock()
+	vp.pendingValidatorsHistory = append(vp.pendingValidatorsHistory, &validatorsHistoryRecord{
+		rootHash: rootHash,
+		epoch:    newEpoch - 1,
+	})
+	if len(vp.pendingValidatorsHistory) > maxPendingValidatorsHistory {
+		log.Error("validatorsProvider - dropping the validators history", "epoch", vp.pendingValidatorsHistory[0].epoch)
+		vp.pendingValidatorsHistory = vp.pendingValidatorsHistory[1:]
+	}
+	vp.mutPendingHistory.Unlock()
+
+	go vp.savePendingValidatorsHistory()
+}
+
+func (vp *validatorsProvider) savePendingValidatorsHistory() {
+	vp.mutSaveValidatorsHistory.Lock()
+	defer vp.mutSaveValidatorsHistory.Unlock()
+
+	vp.mutPendingHistory.Lock()
+	pendingHistory := vp.pendingValidatorsHistory
+	vp.pendingValidatorsHistory = make([]*validatorsHistoryRecord, 0)
+	vp.mutPendingHistory.Unlock()
+
+	failedHistory := make([]*validatorsHistoryRecord, 0)
+	for _, record := range pendingHistory {
+		err := vp.saveValidatorsHistoryForRootHash(record.rootHash, record.epoch)
+		if err != nil {
+			log.Warn("validatorsProvider - could not save the validators history, will retry on the next epoch start",
+				"epoch", record.epoch, "error", err)
+			failedHistory = append(failedHistory, record)
+		}
+	}
+
+	vp.mutPendingHistory.Lock()
+	vp.pendingValidatorsHistory = append(failedHistory, vp.pendingValidatorsHistory...)
+	vp.mutPendingHistory.Unlock()
+}
+
+func (vp *validatorsProvider) saveValidatorsHistoryForRootHash(rootHash []byte, epoch uint32) error {
+	validatorsMap, err := vp.validatorStatistics.GetValidatorInfoForRootHash(rootHash)
+	if err != nil {
+		return err
+	}
+
+	for _, validatorInfo := range validatorsMap.GetAllValidatorsInfo() {
+		buff, errMarshal := vp.marshaller.Marshal(validatorInfo)
+		if errMarshal != nil {
+			return errMarshal
+		}
+
+		errPut := vp.validatorsHistoryStorer.Put(createValidatorHistoryKey(validatorInfo.GetPublicKey(), epoch), buff)
+		if errPut != nil {
+			return errPut
+		}
+	}
+
+	return nil
+}
+
+func createValidatorHistoryKey(blsKey []byte, epoch uint32) []byte {
+	key := make([]byte, len(blsKey)+epochSizeInBytes)
+	copy(key, blsKey)
+	binary.BigEndian.PutUint32(key[len(blsKey):], epoch)
+
+	return key
+}
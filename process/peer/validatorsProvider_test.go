@@ -22,7 +22,10 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/stakingcommon"
 	"github.com/pkg/errors"
@@ -111,6 +114,24 @@ func TestNewValidatorsProvider_WithNilAuctionListSelectorShouldErr(t *testing.T)
 	require.Equal(t, epochStart.ErrNilAuctionListSelector, err)
 }
 
+func TestNewValidatorsProvider_WithNilValidatorsHistoryStorerShouldErr(t *testing.T) {
+	arg := createDefaultValidatorsProviderArg()
+	arg.ValidatorsHistoryStorer = nil
+	vp, err := NewValidatorsProvider(arg)
+
+	require.Nil(t, vp)
+	require.True(t, errors.Is(err, process.ErrNilStorage))
+}
+
+func TestNewValidatorsProvider_WithNilMarshallerShouldErr(t *testing.T) {
+	arg := createDefaultValidatorsProviderArg()
+	arg.Marshaller = nil
+	vp, err := NewValidatorsProvider(arg)
+
+	require.Nil(t, vp)
+	require.Equal(t, process.ErrNilMarshalizer, err)
+}
+
 func TestValidatorsProvider_GetLatestValidatorsSecondHashDoesNotExist(t *testing.T) {
 	mut := sync.Mutex{}
 	root := []byte("rootHash")
@@ -1179,5 +1200,450 @@ func createDefaultValidatorsProviderArg() ArgValidatorsProvider {
 		ValidatorPubKeyConverter: testscommon.NewPubkeyConverterMock(32),
 		AddressPubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
 		AuctionListSelector:      &stakingcommon.AuctionListSelectorStub{},
+		ValidatorsHistoryStorer:  genericMocks.NewStorerMock(),
+		Marshaller:               &marshallerMock.MarshalizerMock{},
 	}
 }
+
+func TestValidatorsProvider_SimulateAuction(t *testing.T) {
+	t.Parallel()
+
+	owner1 := []byte("owner1")
+	owner2 := []byte("owner2")
+	encodedOwner1 := hex.EncodeToString(owner1)
+	encodedOwner2 := hex.EncodeToString(owner2)
+
+	t.Run("nil request should error", func(t *testing.T) {
+		t.Parallel()
+
+		vp, _ := NewValidatorsProvider(createDefaultValidatorsProviderArg())
+		simulation, err := vp.SimulateAuction(nil)
+		require.Nil(t, simulation)
+		require.Equal(t, process.ErrNilAuctionSimulationRequest, err)
+	})
+	t.Run("invalid owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		vp, _ := NewValidatorsProvider(createDefaultValidatorsProviderArg())
+		simulation, err := vp.SimulateAuction(&common.AuctionSimulationAPIRequest{
+			Changes: []*common.AuctionSimulationOwnerChange{{Owner: "not hex"}},
+		})
+		require.Nil(t, simulation)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not hex")
+	})
+	t.Run("invalid top up delta should error", func(t *testing.T) {
+		t.Parallel()
+
+		vp, _ := NewValidatorsProvider(createDefaultValidatorsProviderArg())
+		simulation, err := vp.SimulateAuction(&common.AuctionSimulationAPIRequest{
+			Changes: []*common.AuctionSimulationOwnerChange{{Owner: encodedOwner1, TopUpDelta: "1.5"}},
+		})
+		require.Nil(t, simulation)
+		require.True(t, errors.Is(err, process.ErrInvalidTopUpDelta))
+	})
+	t.Run("too many new nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		vp, _ := NewValidatorsProvider(createDefaultValidatorsProviderArg())
+		simulation, err := vp.SimulateAuction(&common.AuctionSimulationAPIRequest{
+			Changes: []*common.AuctionSimulationOwnerChange{
+				{Owner: encodedOwner1, NumNewNodes: maxNumSimulatedNewNodes},
+				{Owner: encodedOwner2, NumNewNodes: 1},
+			},
+		})
+		require.Nil(t, simulation)
+		require.True(t, errors.Is(err, process.ErrTooManySimulatedNodes))
+	})
+	t.Run("duplicated unStaked node should error", func(t *testing.T) {
+		t.Parallel()
+
+		encodedBlsKey := hex.EncodeToString([]byte("pk1"))
+		vp, _ := NewValidatorsProvider(createDefaultValidatorsProviderArg())
+		simulation, err := vp.SimulateAuction(&common.AuctionSimulationAPIRequest{
+			Changes: []*common.AuctionSimulationOwnerChange{
+				{Owner: encodedOwner1, UnStakedNodes: []string{encodedBlsKey}},
+				{Owner: encodedOwner1, UnStakedNodes: []string{encodedBlsKey}},
+			},
+		})
+		require.Nil(t, simulation)
+		require.True(t, errors.Is(err, process.ErrDuplicatedUnStakedNode))
+	})
+	t.Run("unStaking a node of another owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createDefaultValidatorsProviderArg()
+		args.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{
+			LastFinalizedRootHashCalled: func() []byte {
+				return []byte("root hash")
+			},
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+				return state.NewShardValidatorsInfoMap(), nil
+			},
+		}
+		cleanCalled := &coreAtomic.Flag{}
+		args.StakingDataProvider = &stakingcommon.StakingDataProviderStub{
+			GetBlsKeyOwnerCalled: func(blsKey []byte) (string, error) {
+				return string(owner2), nil
+			},
+			CleanCalled: func() {
+				cleanCalled.SetValue(true)
+			},
+		}
+		vp, _ := NewValidatorsProvider(args)
+		simulation, err := vp.SimulateAuction(&common.AuctionSimulationAPIRequest{
+			Changes: []*common.AuctionSimulationOwnerChange{
+				{Owner: encodedOwner1, UnStakedNodes: []string{hex.EncodeToString([]byte("pk1"))}},
+			},
+		})
+		require.Nil(t, simulation)
+		require.True(t, errors.Is(err, process.ErrBlsKeyNotOwned))
+		require.True(t, cleanCalled.IsSet())
+	})
+	t.Run("should use the root hash and the randomness of the auction list", func(t *testing.T) {
+		t.Parallel()
+
+		args := createDefaultValidatorsProviderArg()
+		args.CacheRefreshIntervalDurationInSec = time.Hour
+
+		rootHashes := [][]byte{[]byte("root hash 1"), []byte("root hash 2")}
+		auctionListRead := &coreAtomic.Flag{}
+		args.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{
+			LastFinalizedRootHashCalled: func() []byte {
+				if auctionListRead.IsSet() {
+					return rootHashes[1]
+				}
+				return rootHashes[0]
+			},
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+				return state.NewShardValidatorsInfoMap(), nil
+			},
+		}
+		randomnessValues := make([][]byte, 0)
+		args.AuctionListSelector = &stakingcommon.AuctionListSelectorStub{
+			SelectNodesFromAuctionListCalled: func(_ state.ShardValidatorsInfoMapHandler, randomness []byte) error {
+				randomnessValues = append(randomnessValues, randomness)
+				return nil
+			},
+		}
+
+		vp, _ := NewValidatorsProvider(args)
+		_, err := vp.GetAuctionList()
+		require.Nil(t, err)
+		auctionListRead.SetValue(true)
+
+		_, err = vp.SimulateAuction(&common.AuctionSimulationAPIRequest{})
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{rootHashes[0], rootHashes[0], rootHashes[0]}, randomnessValues)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createDefaultValidatorsProviderArg()
+
+		v0 := &state.ValidatorInfo{PublicKey: []byte("pk0"), List: string(common.EligibleList)}
+		v1 := &state.ValidatorInfo{PublicKey: []byte("pk1"), List: string(common.AuctionList)}
+		v2 := &state.ValidatorInfo{PublicKey: []byte("pk2"), List: string(common.AuctionList)}
+		v3 := &state.ValidatorInfo{PublicKey: []byte("pk3"), List: string(common.AuctionList)}
+
+		ownersData := map[string]*epochStart.OwnerData{
+			string(owner1): {
+				NumActiveNodes: 1,
+				NumStakedNodes: 3,
+				TotalTopUp:     big.NewInt(300),
+				TopUpPerNode:   big.NewInt(100),
+				AuctionList:    []state.ValidatorInfoHandler{v1, v2},
+				Qualified:      true,
+			},
+			string(owner2): {
+				NumStakedNodes: 1,
+				TotalTopUp:     big.NewInt(50),
+				TopUpPerNode:   big.NewInt(50),
+				AuctionList:    []state.ValidatorInfoHandler{v3},
+				Qualified:      true,
+			},
+		}
+
+		args.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{
+			LastFinalizedRootHashCalled: func() []byte {
+				return []byte("root hash")
+			},
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+				validatorsMap := state.NewShardValidatorsInfoMap()
+				_ = validatorsMap.SetValidatorsInShard(0, []state.ValidatorInfoHandler{v0, v1, v2, v3})
+				return validatorsMap, nil
+			},
+		}
+
+		var ownersDataOverride map[string]*epochStart.OwnerData
+		stakingDataProvider := &stakingcommon.StakingDataProviderStub{
+			GetOwnersDataCalled: func() map[string]*epochStart.OwnerData {
+				if ownersDataOverride != nil {
+					return ownersDataOverride
+				}
+				return ownersData
+			},
+			SetOwnersDataOverrideCalled: func(ownersData map[string]*epochStart.OwnerData) {
+				ownersDataOverride = ownersData
+			},
+			ResetOwnersDataOverrideCalled: func() {
+				ownersDataOverride = nil
+			},
+			GetBlsKeyOwnerCalled: func(blsKey []byte) (string, error) {
+				return string(owner1), nil
+			},
+		}
+		args.StakingDataProvider = stakingDataProvider
+
+		// selects all the auction nodes of the owners with a top up per node of at least 100
+		args.AuctionListSelector = &stakingcommon.AuctionListSelectorStub{
+			SelectNodesFromAuctionListCalled: func(validatorsInfoMap state.ShardValidatorsInfoMapHandler, randomness []byte) error {
+				for _, ownerData := range stakingDataProvider.GetOwnersData() {
+					if ownerData.TopUpPerNode.Cmp(big.NewInt(100)) < 0 {
+						continue
+					}
+					for _, node := range ownerData.AuctionList {
+						selectedNode := node.ShallowClone()
+						selectedNode.SetList(string(common.SelectedFromAuctionList))
+						_ = validatorsInfoMap.Replace(node, selectedNode)
+					}
+				}
+				return nil
+			},
+		}
+
+		vp, _ := NewValidatorsProvider(args)
+		simulation, err := vp.SimulateAuction(&common.AuctionSimulationAPIRequest{
+			Changes: []*common.AuctionSimulationOwnerChange{
+				{
+					Owner:         encodedOwner1,
+					UnStakedNodes: []string{hex.EncodeToString(v2.PublicKey)},
+					NumNewNodes:   1,
+				},
+				{
+					Owner:      encodedOwner2,
+					TopUpDelta: "250",
+				},
+			},
+		})
+		require.Nil(t, err)
+		require.Nil(t, ownersDataOverride)
+		require.Equal(t, big.NewInt(50), ownersData[string(owner2)].TotalTopUp)
+
+		simulatedNode := hex.EncodeToString(createSimulatedNode(0).GetPublicKey())
+		expectedSimulation := &common.AuctionSimulationAPIResponse{
+			CurrentThreshold:   "100",
+			SimulatedThreshold: "100",
+			AuctionList: []*common.AuctionListValidatorAPIResponse{
+				{
+					Owner:          encodedOwner2,
+					NumStakedNodes: 1,
+					TotalTopUp:     "300",
+					TopUpPerNode:   "300",
+					QualifiedTopUp: "300",
+					Nodes: []*common.AuctionNode{
+						{BlsKey: hex.EncodeToString(v3.PublicKey), Qualified: true},
+					},
+				},
+				{
+					Owner:          encodedOwner1,
+					NumStakedNodes: 3,
+					TotalTopUp:     "300",
+					TopUpPerNode:   "100",
+					QualifiedTopUp: "100",
+					Nodes: []*common.AuctionNode{
+						{BlsKey: hex.EncodeToString(v1.PublicKey), Qualified: true},
+						{BlsKey: simulatedNode, Qualified: true},
+					},
+				},
+			},
+			ChangedNodes: []*common.AuctionSimulationNodeChange{
+				{BlsKey: hex.EncodeToString(v2.PublicKey), Owner: encodedOwner1, Selected: false},
+				{BlsKey: hex.EncodeToString(v3.PublicKey), Owner: encodedOwner2, Selected: true},
+				{BlsKey: simulatedNode, Owner: encodedOwner1, Selected: true},
+			},
+		}
+		require.Equal(t, expectedSimulation, simulation)
+	})
+}
+
+func TestValidatorsProvider_GetValidatorHistory(t *testing.T) {
+	t.Parallel()
+
+	blsKey := []byte("blsKey")
+	encodedBlsKey := hex.EncodeToString(blsKey)
+	createValidatorsMap := func(leaderSuccess uint32) state.ShardValidatorsInfoMapHandler {
+		validatorsMap := state.NewShardValidatorsInfoMap()
+		_ = validatorsMap.Add(&state.ValidatorInfo{
+			PublicKey:     blsKey,
+			ShardId:       1,
+			List:          string(common.EligibleList),
+			Rating:        50,
+			TempRating:    60,
+			LeaderSuccess: leaderSuccess,
+		})
+		_ = validatorsMap.Add(&state.ValidatorInfo{
+			PublicKey: []byte("otherBlsKey"),
+			List:      string(common.WaitingList),
+		})
+
+		return validatorsMap
+	}
+
+	t.Run("shard node should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createDefaultValidatorsProviderArg()
+		arg.ValidatorsHistoryStorer = storageunit.NewNilStorer()
+		vp, _ := NewValidatorsProvider(arg)
+		history, err := vp.GetValidatorHistory(encodedBlsKey, core.OptionalUint32{}, core.OptionalUint32{})
+		require.Nil(t, history)
+		require.Equal(t, process.ErrValidatorsHistoryNotAvailableOnShardNode, err)
+	})
+	t.Run("invalid BLS key should error", func(t *testing.T) {
+		t.Parallel()
+
+		vp, _ := NewValidatorsProvider(createDefaultValidatorsProviderArg())
+		history, err := vp.GetValidatorHistory("not hex", core.OptionalUint32{}, core.OptionalUint32{})
+		require.Nil(t, history)
+		require.Error(t, err)
+	})
+	t.Run("fromEpoch greater than toEpoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		vp, _ := NewValidatorsProvider(createDefaultValidatorsProviderArg())
+		history, err := vp.GetValidatorHistory(
+			encodedBlsKey,
+			core.OptionalUint32{Value: 5, HasValue: true},
+			core.OptionalUint32{Value: 4, HasValue: true},
+		)
+		require.Nil(t, history)
+		require.True(t, errors.Is(err, process.ErrInvalidEpochsRange))
+	})
+	t.Run("too many epochs should error", func(t *testing.T) {
+		t.Parallel()
+
+		vp, _ := NewValidatorsProvider(createDefaultValidatorsProviderArg())
+		history, err := vp.GetValidatorHistory(
+			encodedBlsKey,
+			core.OptionalUint32{Value: 0, HasValue: true},
+			core.OptionalUint32{Value: maxValidatorHistoryEpochs, HasValue: true},
+		)
+		require.Nil(t, history)
+		require.True(t, errors.Is(err, process.ErrInvalidEpochsRange))
+	})
+	t.Run("should return the saved epochs from the range", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createDefaultValidatorsProviderArg()
+		arg.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+				return createValidatorsMap(uint32(len(rootHash))), nil
+			},
+		}
+		vp, _ := NewValidatorsProvider(arg)
+		err := vp.saveValidatorsHistoryForRootHash([]byte("rootHash1"), 1)
+		require.Nil(t, err)
+		err = vp.saveValidatorsHistoryForRootHash([]byte("rootHash22"), 2)
+		require.Nil(t, err)
+		err = vp.saveValidatorsHistoryForRootHash([]byte("rootHash333"), 4)
+		require.Nil(t, err)
+
+		history, err := vp.GetValidatorHistory(
+			encodedBlsKey,
+			core.OptionalUint32{Value: 2, HasValue: true},
+			core.OptionalUint32{Value: 5, HasValue: true},
+		)
+		require.NoError(t, err)
+		require.Equal(t, []*common.ValidatorEpochStatisticsAPIResponse{
+			{
+				Epoch:            2,
+				ShardId:          1,
+				ValidatorStatus:  string(common.EligibleList),
+				Rating:           50,
+				TempRating:       60,
+				NumLeaderSuccess: 10,
+			},
+			{
+				Epoch:            4,
+				ShardId:          1,
+				ValidatorStatus:  string(common.EligibleList),
+				Rating:           50,
+				TempRating:       60,
+				NumLeaderSuccess: 11,
+			},
+		}, history)
+
+		history, err = vp.GetValidatorHistory(encodedBlsKey, core.OptionalUint32{}, core.OptionalUint32{})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(history)) // the current epoch is 1, so only the first epoch is in range
+		require.Equal(t, uint32(1), history[0].Epoch)
+	})
+	t.Run("should save the ended epoch on epoch start", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createDefaultValidatorsProviderArg()
+		epochStartNotifier := &mock.EpochStartNotifierStub{}
+		arg.EpochStartEventNotifier = epochStartNotifier
+		arg.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{
+			LastFinalizedRootHashCalled: func() []byte {
+				return []byte("rootHash")
+			},
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+				return createValidatorsMap(7), nil
+			},
+		}
+		vp, _ := NewValidatorsProvider(arg)
+
+		epochStartNotifier.NotifyAll(&block.MetaBlock{Epoch: 3})
+
+		require.Eventually(t, func() bool {
+			history, err := vp.GetValidatorHistory(
+				encodedBlsKey,
+				core.OptionalUint32{Value: 0, HasValue: true},
+				core.OptionalUint32{Value: 3, HasValue: true},
+			)
+			return err == nil && len(history) == 1 && history[0].Epoch == 2 && history[0].NumLeaderSuccess == 7
+		}, time.Second, time.Millisecond*10)
+	})
+	t.Run("failed epoch should be saved on the next epoch start", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createDefaultValidatorsProviderArg()
+		epochStartNotifier := &mock.EpochStartNotifierStub{}
+		arg.EpochStartEventNotifier = epochStartNotifier
+		expectedErr := errors.New("expected error")
+		shouldFail := &coreAtomic.Flag{}
+		shouldFail.SetValue(true)
+		arg.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{
+			LastFinalizedRootHashCalled: func() []byte {
+				return []byte("rootHash")
+			},
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+				if shouldFail.IsSet() {
+					return nil, expectedErr
+				}
+				return createValidatorsMap(7), nil
+			},
+		}
+		vp, _ := NewValidatorsProvider(arg)
+
+		epochStartNotifier.NotifyAll(&block.MetaBlock{Epoch: 3})
+		require.Eventually(t, func() bool {
+			vp.mutPendingHistory.Lock()
+			defer vp.mutPendingHistory.Unlock()
+			return len(vp.pendingValidatorsHistory) == 1
+		}, time.Second, time.Millisecond*10)
+
+		shouldFail.Reset()
+		epochStartNotifier.NotifyAll(&block.MetaBlock{Epoch: 4})
+		require.Eventually(t, func() bool {
+			history, err := vp.GetValidatorHistory(
+				encodedBlsKey,
+				core.OptionalUint32{Value: 0, HasValue: true},
+				core.OptionalUint32{Value: 4, HasValue: true},
+			)
+			return err == nil && len(history) == 2 && history[0].Epoch == 2 && history[1].Epoch == 3
+		}, time.Second, time.Millisecond*10)
+	})
+}
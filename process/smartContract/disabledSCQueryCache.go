@@ -0,0 +1,47 @@
+package smartContract
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+type disabledSCQueryCache struct {
+}
+
+// NewDisabledSCQueryCache returns a SC query cache implementation that does not hold anything
+func NewDisabledSCQueryCache() *disabledSCQueryCache {
+	return &disabledSCQueryCache{}
+}
+
+// GetHistoricalRoot returns nils and false
+func (cache *disabledSCQueryCache) GetHistoricalRoot(_ []byte) (data.HeaderHandler, []byte, bool) {
+	return nil, nil, false
+}
+
+// PutHistoricalRoot does nothing
+func (cache *disabledSCQueryCache) PutHistoricalRoot(_ []byte, _ data.HeaderHandler, _ []byte) {
+}
+
+// GetHistoricalTrie returns nil and false
+func (cache *disabledSCQueryCache) GetHistoricalTrie(_ []byte) (common.Trie, bool) {
+	return nil, false
+}
+
+// PutHistoricalTrie does nothing
+func (cache *disabledSCQueryCache) PutHistoricalTrie(_ []byte, _ common.Trie) {
+}
+
+// GetQueryResult returns nils and false
+func (cache *disabledSCQueryCache) GetQueryResult(_ []byte) (*vmcommon.VMOutput, common.BlockInfo, bool) {
+	return nil, nil, false
+}
+
+// PutQueryResult does nothing
+func (cache *disabledSCQueryCache) PutQueryResult(_ []byte, _ *vmcommon.VMOutput, _ common.BlockInfo) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cache *disabledSCQueryCache) IsInterfaceNil() bool {
+	return cache == nil
+}
@@ -0,0 +1,280 @@
+package smartContract
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/cache"
+	"github.com/multiversx/mx-chain-go/storage/disabled"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+var _ process.SCQueryCacheHandler = (*scQueryCache)(nil)
+
+// ArgsSCQueryCache defines the arguments needed to create the cache shared by the SC query services
+type ArgsSCQueryCache struct {
+	HistoricalRootsCacheCapacity int
+	HistoricalTriesCacheCapacity int
+	QueryResultsCacheCapacity    int
+	AppStatusHandler             core.AppStatusHandler
+}
+
+type historicalRoot struct {
+	header   data.HeaderHandler
+	rootHash []byte
+}
+
+type queryResult struct {
+	vmOutput  *vmcommon.VMOutput
+	blockInfo common.BlockInfo
+}
+
+type scQueryCache struct {
+	historicalRoots  storage.Cacher
+	historicalTries  storage.Cacher
+	queryResults     storage.Cacher
+	appStatusHandler core.AppStatusHandler
+}
+
+// NewSCQueryCache creates the cache shared by all the SC query services. It holds the recently resolved historical
+// roots, the state tries recreated on them and the results of the queries executed on historical blocks.
+// A capacity of 0 disables the corresponding cache
+func NewSCQueryCache(args ArgsSCQueryCache) (*scQueryCache, error) {
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, process.ErrNilAppStatusHandler
+	}
+
+	historicalRoots, err := createLRUCache(args.HistoricalRootsCacheCapacity)
+	if err != nil {
+		return nil, fmt.Errorf("%w for historical roots cache", err)
+	}
+	historicalTries, err := createLRUCache(args.HistoricalTriesCacheCapacity)
+	if err != nil {
+		return nil, fmt.Errorf("%w for historical tries cache", err)
+	}
+	queryResults, err := createLRUCache(args.QueryResultsCacheCapacity)
+	if err != nil {
+		return nil, fmt.Errorf("%w for query results cache", err)
+	}
+
+	args.AppStatusHandler.SetUInt64Value(common.MetricSCQueryHistoricalRootsCacheHits, 0)
+	args.AppStatusHandler.SetUInt64Value(common.MetricSCQueryHistoricalRootsCacheMisses, 0)
+	args.AppStatusHandler.SetUInt64Value(common.MetricSCQueryHistoricalTriesCacheHits, 0)
+	args.AppStatusHandler.SetUInt64Value(common.MetricSCQueryHistoricalTriesCacheMisses, 0)
+	args.AppStatusHandler.SetUInt64Value(common.MetricSCQueryResultsCacheHits, 0)
+	args.AppStatusHandler.SetUInt64Value(common.MetricSCQueryResultsCacheMisses, 0)
+
+	return &scQueryCache{
+		historicalRoots:  historicalRoots,
+		historicalTries:  historicalTries,
+		queryResults:     queryResults,
+		appStatusHandler: args.AppStatusHandler,
+	}, nil
+}
+
+func createLRUCache(capacity int) (storage.Cacher, error) {
+	if capacity < 0 {
+		return nil, fmt.Errorf("%w, capacity: %d", process.ErrInvalidValue, capacity)
+	}
+	if capacity == 0 {
+		return disabled.NewCache(), nil
+	}
+
+	return cache.NewLRUCache(capacity)
+}
+
+// GetHistoricalRoot returns the header and the root hash to be used when querying the block with the provided hash
+func (sqc *scQueryCache) GetHistoricalRoot(headerHash []byte) (data.HeaderHandler, []byte, bool) {
+	value, found := sqc.historicalRoots.Get(headerHash)
+	root, ok := value.(*historicalRoot)
+	if !found || !ok {
+		sqc.appStatusHandler.Increment(common.MetricSCQueryHistoricalRootsCacheMisses)
+		return nil, nil, false
+	}
+
+	sqc.appStatusHandler.Increment(common.MetricSCQueryHistoricalRootsCacheHits)
+	return root.header, root.rootHash, true
+}
+
+// PutHistoricalRoot saves the header and the root hash to be used when querying the block with the provided hash
+func (sqc *scQueryCache) PutHistoricalRoot(headerHash []byte, header data.HeaderHandler, rootHash []byte) {
+	root := &historicalRoot{
+		header:   header,
+		rootHash: rootHash,
+	}
+	sqc.historicalRoots.Put(headerHash, root, 0)
+}
+
+// GetHistoricalTrie returns the state trie already recreated on the provided root hash, along with its loaded nodes
+func (sqc *scQueryCache) GetHistoricalTrie(rootHash []byte) (common.Trie, bool) {
+	value, found := sqc.historicalTries.Get(rootHash)
+	recreatedTrie, ok := value.(common.Trie)
+	if !found || !ok {
+		sqc.appStatusHandler.Increment(common.MetricSCQueryHistoricalTriesCacheMisses)
+		return nil, false
+	}
+
+	sqc.appStatusHandler.Increment(common.MetricSCQueryHistoricalTriesCacheHits)
+	return recreatedTrie, true
+}
+
+// PutHistoricalTrie saves the state trie recreated on the provided root hash
+func (sqc *scQueryCache) PutHistoricalTrie(rootHash []byte, recreatedTrie common.Trie) {
+	if check.IfNil(recreatedTrie) {
+		return
+	}
+
+	sqc.historicalTries.Put(rootHash, recreatedTrie, 0)
+}
+
+// GetQueryResult returns a copy of the cached result of a query executed on a historical block, so the callers can
+// not alter the cached one
+func (sqc *scQueryCache) GetQueryResult(key []byte) (*vmcommon.VMOutput, common.BlockInfo, bool) {
+	value, found := sqc.queryResults.Get(key)
+	result, ok := value.(*queryResult)
+	if !found || !ok {
+		sqc.appStatusHandler.Increment(common.MetricSCQueryResultsCacheMisses)
+		return nil, nil, false
+	}
+
+	sqc.appStatusHandler.Increment(common.MetricSCQueryResultsCacheHits)
+	return copyVMOutput(result.vmOutput), result.blockInfo, true
+}
+
+// PutQueryResult saves a copy of the result of a query executed on a historical block
+func (sqc *scQueryCache) PutQueryResult(key []byte, vmOutput *vmcommon.VMOutput, blockInfo common.BlockInfo) {
+	if vmOutput == nil {
+		return
+	}
+
+	result := &queryResult{
+		vmOutput:  copyVMOutput(vmOutput),
+		blockInfo: blockInfo,
+	}
+	sqc.queryResults.Put(key, result, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sqc *scQueryCache) IsInterfaceNil() bool {
+	return sqc == nil
+}
+
+func copyVMOutput(vmOutput *vmcommon.VMOutput) *vmcommon.VMOutput {
+	vmOutputCopy := *vmOutput
+	vmOutputCopy.ReturnData = copyByteSlices(vmOutput.ReturnData)
+	vmOutputCopy.DeletedAccounts = copyByteSlices(vmOutput.DeletedAccounts)
+	vmOutputCopy.TouchedAccounts = copyByteSlices(vmOutput.TouchedAccounts)
+	if vmOutput.GasRefund != nil {
+		vmOutputCopy.GasRefund = big.NewInt(0).Set(vmOutput.GasRefund)
+	}
+
+	if vmOutput.OutputAccounts != nil {
+		vmOutputCopy.OutputAccounts = make(map[string]*vmcommon.OutputAccount, len(vmOutput.OutputAccounts))
+		for address, outputAccount := range vmOutput.OutputAccounts {
+			vmOutputCopy.OutputAccounts[address] = copyOutputAccount(outputAccount)
+		}
+	}
+
+	if vmOutput.Logs != nil {
+		vmOutputCopy.Logs = make([]*vmcommon.LogEntry, 0, len(vmOutput.Logs))
+		for _, logEntry := range vmOutput.Logs {
+			vmOutputCopy.Logs = append(vmOutputCopy.Logs, copyLogEntry(logEntry))
+		}
+	}
+
+	return &vmOutputCopy
+}
+
+func copyOutputAccount(outputAccount *vmcommon.OutputAccount) *vmcommon.OutputAccount {
+	if outputAccount == nil {
+		return nil
+	}
+
+	outputAccountCopy := *outputAccount
+	outputAccountCopy.Address = copyBytes(outputAccount.Address)
+	outputAccountCopy.Code = copyBytes(outputAccount.Code)
+	outputAccountCopy.CodeMetadata = copyBytes(outputAccount.CodeMetadata)
+	outputAccountCopy.CodeDeployerAddress = copyBytes(outputAccount.CodeDeployerAddress)
+	if outputAccount.Balance != nil {
+		outputAccountCopy.Balance = big.NewInt(0).Set(outputAccount.Balance)
+	}
+	if outputAccount.BalanceDelta != nil {
+		outputAccountCopy.BalanceDelta = big.NewInt(0).Set(outputAccount.BalanceDelta)
+	}
+
+	if outputAccount.StorageUpdates != nil {
+		outputAccountCopy.StorageUpdates = make(map[string]*vmcommon.StorageUpdate, len(outputAccount.StorageUpdates))
+		for key, storageUpdate := range outputAccount.StorageUpdates {
+			if storageUpdate == nil {
+				outputAccountCopy.StorageUpdates[key] = nil
+				continue
+			}
+
+			storageUpdateCopy := *storageUpdate
+			storageUpdateCopy.Offset = copyBytes(storageUpdate.Offset)
+			storageUpdateCopy.Data = copyBytes(storageUpdate.Data)
+			outputAccountCopy.StorageUpdates[key] = &storageUpdateCopy
+		}
+	}
+
+	if outputAccount.OutputTransfers != nil {
+		outputAccountCopy.OutputTransfers = make([]vmcommon.OutputTransfer, 0, len(outputAccount.OutputTransfers))
+		for _, outputTransfer := range outputAccount.OutputTransfers {
+			outputTransferCopy := outputTransfer
+			outputTransferCopy.Data = copyBytes(outputTransfer.Data)
+			outputTransferCopy.AsyncData = copyBytes(outputTransfer.AsyncData)
+			outputTransferCopy.SenderAddress = copyBytes(outputTransfer.SenderAddress)
+			if outputTransfer.Value != nil {
+				outputTransferCopy.Value = big.NewInt(0).Set(outputTransfer.Value)
+			}
+			outputAccountCopy.OutputTransfers = append(outputAccountCopy.OutputTransfers, outputTransferCopy)
+		}
+	}
+
+	return &outputAccountCopy
+}
+
+func copyLogEntry(logEntry *vmcommon.LogEntry) *vmcommon.LogEntry {
+	if logEntry == nil {
+		return nil
+	}
+
+	logEntryCopy := *logEntry
+	logEntryCopy.Identifier = copyBytes(logEntry.Identifier)
+	logEntryCopy.Address = copyBytes(logEntry.Address)
+	logEntryCopy.Topics = copyByteSlices(logEntry.Topics)
+	logEntryCopy.Data = copyByteSlices(logEntry.Data)
+
+	return &logEntryCopy
+}
+
+func copyByteSlices(slices [][]byte) [][]byte {
+	if slices == nil {
+		return nil
+	}
+
+	slicesCopy := make([][]byte, 0, len(slices))
+	for _, slice := range slices {
+		slicesCopy = append(slicesCopy, copyBytes(slice))
+	}
+
+	return slicesCopy
+}
+
+func copyBytes(buff []byte) []byte {
+	if buff == nil {
+		return nil
+	}
+
+	return append(make([]byte, 0, len(buff)), buff...)
+}
+
+func isHistoricalQuery(query *process.SCQuery) bool {
+	return len(query.BlockHash) > 0 || query.BlockNonce.HasValue
+}
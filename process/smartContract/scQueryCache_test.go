@@ -0,0 +1,247 @@
+package smartContract
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/process"
+	statusHandlerMock "github.com/multiversx/mx-chain-go/testscommon/statusHandler"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSCQueryCache() ArgsSCQueryCache {
+	return ArgsSCQueryCache{
+		HistoricalRootsCacheCapacity: 10,
+		HistoricalTriesCacheCapacity: 10,
+		QueryResultsCacheCapacity:    10,
+		AppStatusHandler:             &statusHandlerMock.AppStatusHandlerStub{},
+	}
+}
+
+func TestNewSCQueryCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil app status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSCQueryCache()
+		args.AppStatusHandler = nil
+		queryCache, err := NewSCQueryCache(args)
+		assert.Equal(t, process.ErrNilAppStatusHandler, err)
+		assert.True(t, check.IfNil(queryCache))
+	})
+	t.Run("negative historical roots capacity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSCQueryCache()
+		args.HistoricalRootsCacheCapacity = -1
+		queryCache, err := NewSCQueryCache(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+		assert.True(t, check.IfNil(queryCache))
+	})
+	t.Run("negative historical tries capacity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSCQueryCache()
+		args.HistoricalTriesCacheCapacity = -1
+		queryCache, err := NewSCQueryCache(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+		assert.True(t, check.IfNil(queryCache))
+	})
+	t.Run("negative query results capacity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSCQueryCache()
+		args.QueryResultsCacheCapacity = -1
+		queryCache, err := NewSCQueryCache(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+		assert.True(t, check.IfNil(queryCache))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := make(map[string]uint64)
+		args := createMockArgsSCQueryCache()
+		args.AppStatusHandler = &statusHandlerMock.AppStatusHandlerStub{
+			SetUInt64ValueHandler: func(key string, value uint64) {
+				metrics[key] = value
+			},
+		}
+		queryCache, err := NewSCQueryCache(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(queryCache))
+		assert.Equal(t, 6, len(metrics))
+	})
+}
+
+func TestScQueryCache_HistoricalRoots(t *testing.T) {
+	t.Parallel()
+
+	metrics := make(map[string]uint64)
+	args := createMockArgsSCQueryCache()
+	args.AppStatusHandler = &statusHandlerMock.AppStatusHandlerStub{
+		IncrementHandler: func(key string) {
+			metrics[key]++
+		},
+	}
+	queryCache, _ := NewSCQueryCache(args)
+
+	header, rootHash, found := queryCache.GetHistoricalRoot([]byte("hash"))
+	assert.False(t, found)
+	assert.Nil(t, header)
+	assert.Nil(t, rootHash)
+
+	providedHeader := &block.Header{Nonce: 37}
+	queryCache.PutHistoricalRoot([]byte("hash"), providedHeader, []byte("root hash"))
+	header, rootHash, found = queryCache.GetHistoricalRoot([]byte("hash"))
+	assert.True(t, found)
+	assert.Equal(t, providedHeader, header)
+	assert.Equal(t, []byte("root hash"), rootHash)
+
+	assert.Equal(t, uint64(1), metrics[common.MetricSCQueryHistoricalRootsCacheHits])
+	assert.Equal(t, uint64(1), metrics[common.MetricSCQueryHistoricalRootsCacheMisses])
+}
+
+func TestScQueryCache_HistoricalTries(t *testing.T) {
+	t.Parallel()
+
+	metrics := make(map[string]uint64)
+	args := createMockArgsSCQueryCache()
+	args.AppStatusHandler = &statusHandlerMock.AppStatusHandlerStub{
+		IncrementHandler: func(key string) {
+			metrics[key]++
+		},
+	}
+	queryCache, _ := NewSCQueryCache(args)
+
+	recreatedTrie, found := queryCache.GetHistoricalTrie([]byte("root hash"))
+	assert.False(t, found)
+	assert.Nil(t, recreatedTrie)
+
+	queryCache.PutHistoricalTrie([]byte("nil trie"), nil)
+	_, found = queryCache.GetHistoricalTrie([]byte("nil trie"))
+	assert.False(t, found)
+
+	providedTrie := &trieMock.TrieStub{}
+	queryCache.PutHistoricalTrie([]byte("root hash"), providedTrie)
+	recreatedTrie, found = queryCache.GetHistoricalTrie([]byte("root hash"))
+	assert.True(t, found)
+	assert.True(t, recreatedTrie == providedTrie)
+
+	assert.Equal(t, uint64(1), metrics[common.MetricSCQueryHistoricalTriesCacheHits])
+	assert.Equal(t, uint64(2), metrics[common.MetricSCQueryHistoricalTriesCacheMisses])
+}
+
+func TestScQueryCache_QueryResults(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := make(map[string]uint64)
+		args := createMockArgsSCQueryCache()
+		args.AppStatusHandler = &statusHandlerMock.AppStatusHandlerStub{
+			IncrementHandler: func(key string) {
+				metrics[key]++
+			},
+		}
+		queryCache, _ := NewSCQueryCache(args)
+
+		vmOutput, blockInfo, found := queryCache.GetQueryResult([]byte("key"))
+		assert.False(t, found)
+		assert.Nil(t, vmOutput)
+		assert.Nil(t, blockInfo)
+
+		providedVMOutput := &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("data")}}
+		providedBlockInfo := holders.NewBlockInfo([]byte("hash"), 37, []byte("root hash"))
+		queryCache.PutQueryResult([]byte("key"), providedVMOutput, providedBlockInfo)
+		vmOutput, blockInfo, found = queryCache.GetQueryResult([]byte("key"))
+		assert.True(t, found)
+		assert.Equal(t, providedVMOutput, vmOutput)
+		assert.Equal(t, providedBlockInfo, blockInfo)
+
+		assert.Equal(t, uint64(1), metrics[common.MetricSCQueryResultsCacheHits])
+		assert.Equal(t, uint64(1), metrics[common.MetricSCQueryResultsCacheMisses])
+	})
+	t.Run("should return copies of the cached results", func(t *testing.T) {
+		t.Parallel()
+
+		queryCache, _ := NewSCQueryCache(createMockArgsSCQueryCache())
+
+		providedVMOutput := &vmcommon.VMOutput{
+			ReturnData: [][]byte{[]byte("data")},
+			GasRefund:  big.NewInt(10),
+			OutputAccounts: map[string]*vmcommon.OutputAccount{
+				"address": {
+					Address: []byte("address"),
+					StorageUpdates: map[string]*vmcommon.StorageUpdate{
+						"key": {Offset: []byte("key"), Data: []byte("value")},
+					},
+					OutputTransfers: []vmcommon.OutputTransfer{{Value: big.NewInt(1), Data: []byte("transfer")}},
+				},
+			},
+			Logs: []*vmcommon.LogEntry{{Identifier: []byte("event"), Topics: [][]byte{[]byte("topic")}}},
+		}
+		queryCache.PutQueryResult([]byte("key"), providedVMOutput, nil)
+		providedVMOutput.ReturnData[0][0] = 'x'
+
+		vmOutput, _, found := queryCache.GetQueryResult([]byte("key"))
+		require.True(t, found)
+		assert.Equal(t, [][]byte{[]byte("data")}, vmOutput.ReturnData)
+
+		vmOutput.ReturnData[0] = []byte("altered")
+		vmOutput.GasRefund.SetUint64(0)
+		vmOutput.OutputAccounts["address"].StorageUpdates["key"].Data[0] = 'x'
+		vmOutput.OutputAccounts["address"].OutputTransfers[0].Value.SetUint64(0)
+		vmOutput.Logs[0].Topics[0][0] = 'x'
+		delete(vmOutput.OutputAccounts, "address")
+
+		vmOutput, _, found = queryCache.GetQueryResult([]byte("key"))
+		require.True(t, found)
+		assert.Equal(t, [][]byte{[]byte("data")}, vmOutput.ReturnData)
+		assert.Equal(t, big.NewInt(10), vmOutput.GasRefund)
+		require.NotNil(t, vmOutput.OutputAccounts["address"])
+		assert.Equal(t, []byte("value"), vmOutput.OutputAccounts["address"].StorageUpdates["key"].Data)
+		assert.Equal(t, big.NewInt(1), vmOutput.OutputAccounts["address"].OutputTransfers[0].Value)
+		assert.Equal(t, [][]byte{[]byte("topic")}, vmOutput.Logs[0].Topics)
+	})
+	t.Run("zero capacity should not cache", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSCQueryCache()
+		args.QueryResultsCacheCapacity = 0
+		queryCache, err := NewSCQueryCache(args)
+		require.Nil(t, err)
+
+		queryCache.PutQueryResult([]byte("key"), &vmcommon.VMOutput{}, nil)
+		_, _, found := queryCache.GetQueryResult([]byte("key"))
+		assert.False(t, found)
+	})
+}
+
+func TestDisabledSCQueryCache(t *testing.T) {
+	t.Parallel()
+
+	queryCache := NewDisabledSCQueryCache()
+	assert.False(t, check.IfNil(queryCache))
+
+	queryCache.PutHistoricalRoot([]byte("hash"), &block.Header{}, []byte("root hash"))
+	_, _, found := queryCache.GetHistoricalRoot([]byte("hash"))
+	assert.False(t, found)
+
+	queryCache.PutHistoricalTrie([]byte("root hash"), &trieMock.TrieStub{})
+	_, found = queryCache.GetHistoricalTrie([]byte("root hash"))
+	assert.False(t, found)
+
+	queryCache.PutQueryResult([]byte("key"), &vmcommon.VMOutput{}, nil)
+	_, _, found = queryCache.GetQueryResult([]byte("key"))
+	assert.False(t, found)
+}
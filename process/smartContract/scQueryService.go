@@ -2,6 +2,7 @@ package smartContract
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math/big"
@@ -22,6 +23,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
 	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-common-go/parsers"
@@ -52,6 +54,7 @@ type SCQueryService struct {
 	marshaller                 marshal.Marshalizer
 	hasher                     hashing.Hasher
 	uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
+	queryCache                 process.SCQueryCacheHandler
 	isInHistoricalBalancesMode bool
 }
 
@@ -72,6 +75,7 @@ type ArgsNewSCQueryService struct {
 	Marshaller                 marshal.Marshalizer
 	Hasher                     hashing.Hasher
 	Uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
+	QueryCache                 process.SCQueryCacheHandler
 	IsInHistoricalBalancesMode bool
 }
 
@@ -104,6 +108,7 @@ func NewSCQueryService(
 		marshaller:                 args.Marshaller,
 		hasher:                     args.Hasher,
 		uint64ByteSliceConverter:   args.Uint64ByteSliceConverter,
+		queryCache:                 args.QueryCache,
 		isInHistoricalBalancesMode: args.IsInHistoricalBalancesMode,
 	}, nil
 }
@@ -151,6 +156,9 @@ func checkArgs(args ArgsNewSCQueryService) error {
 	if check.IfNil(args.Uint64ByteSliceConverter) {
 		return process.ErrNilUint64Converter
 	}
+	if check.IfNil(args.QueryCache) {
+		return process.ErrNilSCQueryCache
+	}
 
 	return nil
 }
@@ -168,12 +176,75 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 		return nil, nil, process.ErrEmptyFunctionName
 	}
 
+	if isHistoricalQuery(query) {
+		return service.executeHistoricalQuery(query)
+	}
+
 	service.mutRunSc.Lock()
 	defer service.mutRunSc.Unlock()
 
 	return service.executeScCall(query, 0)
 }
 
+// executeHistoricalQuery resolves the block coordinates and serves the query from the shared results cache, if possible,
+// without waiting for the VM to become available. A historical block does not change, so the result of the query only
+// depends on the resolved block header and on the call itself. The root hash alone is not enough, as the VM also receives
+// the nonce and the timestamp of the header, and distinct blocks, such as the empty ones, can share the same root hash
+func (service *SCQueryService) executeHistoricalQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+	err := service.checkSyncState(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockHeader, blockRootHash, err := service.extractBlockHeaderAndRootHash(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockHash, err := core.CalculateHash(service.marshaller, service.hasher, blockHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	query = prepareScQuery(query)
+	resultKey := service.computeQueryResultKey(blockHash, query)
+	vmOutput, blockInfo, found := service.queryCache.GetQueryResult(resultKey)
+	if found {
+		return vmOutput, blockInfo, nil
+	}
+
+	service.mutRunSc.Lock()
+	defer service.mutRunSc.Unlock()
+
+	vmOutput, blockInfo, err = service.executeScCallOnBlock(query, 0, blockHeader, blockRootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	service.queryCache.PutQueryResult(resultKey, vmOutput, blockInfo)
+
+	return vmOutput, blockInfo, nil
+}
+
+func (service *SCQueryService) computeQueryResultKey(blockHash []byte, query *process.SCQuery) []byte {
+	keyParts := [][]byte{
+		blockHash,
+		query.ScAddress,
+		query.CallerAddr,
+		[]byte(query.CallValue.String()),
+		[]byte(query.FuncName),
+	}
+	keyParts = append(keyParts, query.Arguments...)
+
+	buff := make([]byte, 0)
+	for _, part := range keyParts {
+		buff = binary.BigEndian.AppendUint32(buff, uint32(len(part)))
+		buff = append(buff, part...)
+	}
+
+	return service.hasher.Compute(string(buff))
+}
+
 func (service *SCQueryService) shouldAllowQueriesExecution() bool {
 	select {
 	case <-service.allowExternalQueriesChan:
@@ -186,9 +257,9 @@ func (service *SCQueryService) shouldAllowQueriesExecution() bool {
 func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, common.BlockInfo, error) {
 	logQueryService.Trace("executeScCall", "address", query.ScAddress, "function", query.FuncName, "blockNonce", query.BlockNonce.Value, "blockHash", query.BlockHash)
 
-	shouldEarlyExitBecauseOfSyncState := query.ShouldBeSynced && service.bootstrapper.GetNodeState() == common.NsNotSynchronized
-	if shouldEarlyExitBecauseOfSyncState {
-		return nil, nil, process.ErrNodeIsNotSynced
+	err := service.checkSyncState(query)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	blockHeader, blockRootHash, err := service.extractBlockHeaderAndRootHash(query)
@@ -196,6 +267,25 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 		return nil, nil, err
 	}
 
+	return service.executeScCallOnBlock(query, gasPrice, blockHeader, blockRootHash)
+}
+
+func (service *SCQueryService) checkSyncState(query *process.SCQuery) error {
+	shouldEarlyExitBecauseOfSyncState := query.ShouldBeSynced && service.bootstrapper.GetNodeState() == common.NsNotSynchronized
+	if shouldEarlyExitBecauseOfSyncState {
+		return process.ErrNodeIsNotSynced
+	}
+
+	return nil
+}
+
+func (service *SCQueryService) executeScCallOnBlock(
+	query *process.SCQuery,
+	gasPrice uint64,
+	blockHeader data.HeaderHandler,
+	blockRootHash []byte,
+) (*vmcommon.VMOutput, common.BlockInfo, error) {
+	var err error
 	if len(blockRootHash) > 0 {
 		err = service.apiBlockChain.SetCurrentBlockHeaderAndRootHash(blockHeader, blockRootHash)
 		if err != nil {
@@ -257,7 +347,32 @@ func (service *SCQueryService) recreateTrie(blockRootHash []byte, blockHeader da
 	}
 
 	accountsAdapter := service.blockChainHook.GetAccountsAdapter()
+	recreatedTrieHandler, canShareTrie := accountsAdapter.(state.RecreatedTrieHandler)
+	if canShareTrie {
+		recreatedTrie, found := service.queryCache.GetHistoricalTrie(blockRootHash)
+		if found {
+			logQueryService.Trace("reusing recreated trie", "block", blockHeader.GetNonce(), "rootHash", blockRootHash)
+			return recreatedTrieHandler.SetRecreatedTrie(blockRootHash, recreatedTrie)
+		}
+	}
+
+	err := service.recreateAccountsAdapterTrie(accountsAdapter, blockRootHash, blockHeader)
+	if err != nil {
+		return err
+	}
+
+	if canShareTrie {
+		service.queryCache.PutHistoricalTrie(blockRootHash, recreatedTrieHandler.GetRecreatedTrie())
+	}
+
+	return nil
+}
 
+func (service *SCQueryService) recreateAccountsAdapterTrie(
+	accountsAdapter state.AccountsAdapter,
+	blockRootHash []byte,
+	blockHeader data.HeaderHandler,
+) error {
 	if service.isInHistoricalBalancesMode {
 		logQueryService.Trace("calling RecreateTrieFromEpoch", "block", blockHeader.GetNonce(), "rootHash", blockRootHash)
 		holder := holders.NewRootHashHolder(blockRootHash, core.OptionalUint32{Value: blockHeader.GetEpoch(), HasValue: true})
@@ -272,26 +387,44 @@ func (service *SCQueryService) recreateTrie(blockRootHash []byte, blockHeader da
 // TODO: extract duplicated code with nodeBlocks.go
 func (service *SCQueryService) extractBlockHeaderAndRootHash(query *process.SCQuery) (data.HeaderHandler, []byte, error) {
 	if len(query.BlockHash) > 0 {
-		currentHeader, err := service.getBlockHeaderByHash(query.BlockHash)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		return service.getRootHashForBlock(currentHeader)
+		return service.getHistoricalRoot(query.BlockHash)
 	}
 
 	if query.BlockNonce.HasValue {
-		currentHeader, _, err := service.getBlockHeaderByNonce(query.BlockNonce.Value)
+		headerHash, err := service.getBlockHashByNonce(query.BlockNonce.Value)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		return service.getRootHashForBlock(currentHeader)
+		return service.getHistoricalRoot(headerHash)
 	}
 
 	return service.mainBlockChain.GetCurrentBlockHeader(), service.mainBlockChain.GetCurrentBlockRootHash(), nil
 }
 
+// getHistoricalRoot returns the header and the root hash for the block with the provided hash. The result is shared
+// with the other SC query services, as it is deterministic for a given block hash
+func (service *SCQueryService) getHistoricalRoot(headerHash []byte) (data.HeaderHandler, []byte, error) {
+	blockHeader, blockRootHash, found := service.queryCache.GetHistoricalRoot(headerHash)
+	if found {
+		return blockHeader, blockRootHash, nil
+	}
+
+	currentHeader, err := service.getBlockHeaderByHash(headerHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockHeader, blockRootHash, err = service.getRootHashForBlock(currentHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	service.queryCache.PutHistoricalRoot(headerHash, blockHeader, blockRootHash)
+
+	return blockHeader, blockRootHash, nil
+}
+
 func (service *SCQueryService) getRootHashForBlock(currentHeader data.HeaderHandler) (data.HeaderHandler, []byte, error) {
 	blockHeader, _, err := service.getBlockHeaderByNonce(currentHeader.GetNonce() + 1)
 	if err != nil {
@@ -23,9 +23,12 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/dblookupext"
 	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	stateMocks "github.com/multiversx/mx-chain-go/testscommon/state"
+	statusHandlerMock "github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -61,6 +64,7 @@ func createMockArgumentsForSCQuery() ArgsNewSCQueryService {
 		Marshaller:                 &marshallerMock.MarshalizerStub{},
 		Hasher:                     &testscommon.HasherStub{},
 		Uint64ByteSliceConverter:   &mock.Uint64ByteSliceConverterMock{},
+		QueryCache:                 NewDisabledSCQueryCache(),
 		IsInHistoricalBalancesMode: false,
 	}
 }
@@ -199,6 +203,16 @@ func TestNewSCQueryService(t *testing.T) {
 		assert.Nil(t, target)
 		assert.Equal(t, process.ErrNilUint64Converter, err)
 	})
+	t.Run("nil QueryCache should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForSCQuery()
+		args.QueryCache = nil
+		target, err := NewSCQueryService(args)
+
+		assert.Nil(t, target)
+		assert.Equal(t, process.ErrNilSCQueryCache, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -572,6 +586,283 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 	})
 }
 
+func TestExecuteQuery_HistoricalQueriesShouldUseTheQueryCache(t *testing.T) {
+	t.Parallel()
+
+	numRuns := 0
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			numRuns++
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{input.Arguments[0]},
+			}, nil
+		},
+	}
+	argsNewSCQuery := createMockArgumentsForSCQuery()
+	argsNewSCQuery.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+			return mockVM, nil
+		},
+	}
+	providedRootHash := []byte("provided root hash")
+	argsNewSCQuery.Marshaller = &marshallerMock.MarshalizerMock{}
+	argsNewSCQuery.Hasher = &hashingMocks.HasherMock{}
+	numStorageReads := 0
+	argsNewSCQuery.StorageService = &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			return &storageStubs.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					numStorageReads++
+					return []byte("header hash"), nil
+				},
+				GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+					numStorageReads++
+					hdr := &block.Header{
+						RootHash: providedRootHash,
+					}
+					return argsNewSCQuery.Marshaller.Marshal(hdr)
+				},
+			}, nil
+		},
+	}
+	numRecreateTrie := 0
+	argsNewSCQuery.BlockChainHook = &testscommon.BlockChainHookStub{
+		GetAccountsAdapterCalled: func() state.AccountsAdapter {
+			return &stateMocks.AccountsStub{
+				RecreateTrieCalled: func(rootHash []byte) error {
+					numRecreateTrie++
+					assert.Equal(t, providedRootHash, rootHash)
+					return nil
+				},
+			}
+		},
+	}
+	statusMetrics := make(map[string]uint64)
+	argsNewSCQuery.QueryCache, _ = NewSCQueryCache(ArgsSCQueryCache{
+		HistoricalRootsCacheCapacity: 10,
+		QueryResultsCacheCapacity:    10,
+		AppStatusHandler: &statusHandlerMock.AppStatusHandlerStub{
+			IncrementHandler: func(key string) {
+				statusMetrics[key]++
+			},
+		},
+	})
+
+	target, _ := NewSCQueryService(argsNewSCQuery)
+
+	createQuery := func(argument []byte) *process.SCQuery {
+		return &process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+			Arguments: [][]byte{argument},
+			BlockHash: []byte("provided hash"),
+		}
+	}
+
+	vmOutput, _, err := target.ExecuteQuery(createQuery([]byte("arg1")))
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("arg1")}, vmOutput.ReturnData)
+
+	vmOutput, _, err = target.ExecuteQuery(createQuery([]byte("arg1")))
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("arg1")}, vmOutput.ReturnData)
+
+	vmOutput, _, err = target.ExecuteQuery(createQuery([]byte("arg2")))
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("arg2")}, vmOutput.ReturnData)
+
+	assert.Equal(t, 2, numRuns)
+	assert.Equal(t, 2, numRecreateTrie)
+	assert.Equal(t, 3, numStorageReads)
+	assert.Equal(t, uint64(2), statusMetrics[common.MetricSCQueryHistoricalRootsCacheHits])
+	assert.Equal(t, uint64(1), statusMetrics[common.MetricSCQueryHistoricalRootsCacheMisses])
+	assert.Equal(t, uint64(1), statusMetrics[common.MetricSCQueryResultsCacheHits])
+	assert.Equal(t, uint64(2), statusMetrics[common.MetricSCQueryResultsCacheMisses])
+}
+
+func TestExecuteQuery_HistoricalQueriesOnBlocksWithTheSameRootHashShouldNotShareResults(t *testing.T) {
+	t.Parallel()
+
+	numRuns := 0
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			numRuns++
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+			}, nil
+		},
+	}
+	argsNewSCQuery := createMockArgumentsForSCQuery()
+	argsNewSCQuery.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+			return mockVM, nil
+		},
+	}
+	sharedRootHash := []byte("shared root hash")
+	argsNewSCQuery.Marshaller = &marshallerMock.MarshalizerMock{}
+	argsNewSCQuery.Hasher = &hashingMocks.HasherMock{}
+	headers := map[string]*block.Header{
+		"hash1": {Nonce: 1, RootHash: sharedRootHash},
+		"hash2": {Nonce: 2, RootHash: sharedRootHash},
+	}
+	argsNewSCQuery.StorageService = &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			return &storageStubs.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					return []byte("next hash"), nil
+				},
+				GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+					hdr, found := headers[string(key)]
+					if !found {
+						hdr = &block.Header{Nonce: 3, RootHash: sharedRootHash}
+					}
+					return argsNewSCQuery.Marshaller.Marshal(hdr)
+				},
+			}, nil
+		},
+	}
+	argsNewSCQuery.BlockChainHook = &testscommon.BlockChainHookStub{
+		GetAccountsAdapterCalled: func() state.AccountsAdapter {
+			return &stateMocks.AccountsStub{
+				RecreateTrieCalled: func(rootHash []byte) error {
+					return nil
+				},
+			}
+		},
+	}
+	argsNewSCQuery.QueryCache, _ = NewSCQueryCache(ArgsSCQueryCache{
+		HistoricalRootsCacheCapacity: 10,
+		QueryResultsCacheCapacity:    10,
+		AppStatusHandler:             &statusHandlerMock.AppStatusHandlerStub{},
+	})
+
+	target, _ := NewSCQueryService(argsNewSCQuery)
+
+	createQuery := func(blockHash []byte) *process.SCQuery {
+		return &process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+			BlockHash: blockHash,
+		}
+	}
+
+	_, blockInfo1, err := target.ExecuteQuery(createQuery([]byte("hash1")))
+	require.Nil(t, err)
+	_, blockInfo2, err := target.ExecuteQuery(createQuery([]byte("hash2")))
+	require.Nil(t, err)
+	_, cachedBlockInfo1, err := target.ExecuteQuery(createQuery([]byte("hash1")))
+	require.Nil(t, err)
+
+	assert.Equal(t, 2, numRuns)
+	assert.Equal(t, uint64(1), blockInfo1.GetNonce())
+	assert.Equal(t, uint64(2), blockInfo2.GetNonce())
+	assert.Equal(t, blockInfo1, cachedBlockInfo1)
+}
+
+type accountsWithRecreatedTrieStub struct {
+	*stateMocks.AccountsStub
+	recreatedTrie common.Trie
+	setRootHash   []byte
+}
+
+func (stub *accountsWithRecreatedTrieStub) GetRecreatedTrie() common.Trie {
+	return stub.recreatedTrie
+}
+
+func (stub *accountsWithRecreatedTrieStub) SetRecreatedTrie(rootHash []byte, recreatedTrie common.Trie) error {
+	stub.setRootHash = rootHash
+	stub.recreatedTrie = recreatedTrie
+	return nil
+}
+
+func TestExecuteQuery_HistoricalQueriesShouldShareTheRecreatedTrie(t *testing.T) {
+	t.Parallel()
+
+	providedRootHash := []byte("provided root hash")
+	providedTrie := &trieMock.TrieStub{}
+	numRecreateTrie := 0
+	createAccountsAdapter := func() *accountsWithRecreatedTrieStub {
+		accounts := &accountsWithRecreatedTrieStub{}
+		accounts.AccountsStub = &stateMocks.AccountsStub{
+			RecreateTrieCalled: func(rootHash []byte) error {
+				numRecreateTrie++
+				accounts.recreatedTrie = providedTrie
+				return nil
+			},
+		}
+
+		return accounts
+	}
+
+	queryCache, _ := NewSCQueryCache(ArgsSCQueryCache{
+		HistoricalRootsCacheCapacity: 10,
+		HistoricalTriesCacheCapacity: 10,
+		QueryResultsCacheCapacity:    10,
+		AppStatusHandler:             &statusHandlerMock.AppStatusHandlerStub{},
+	})
+	createQueryService := func(accounts state.AccountsAdapter) *SCQueryService {
+		argsNewSCQuery := createMockArgumentsForSCQuery()
+		argsNewSCQuery.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return &mock.VMExecutionHandlerStub{
+					RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+						return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+					},
+				}, nil
+			},
+		}
+		argsNewSCQuery.Marshaller = &marshallerMock.MarshalizerMock{}
+		argsNewSCQuery.Hasher = &hashingMocks.HasherMock{}
+		argsNewSCQuery.StorageService = &storageStubs.ChainStorerStub{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+				return &storageStubs.StorerStub{
+					GetCalled: func(key []byte) ([]byte, error) {
+						return []byte("header hash"), nil
+					},
+					GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+						return argsNewSCQuery.Marshaller.Marshal(&block.Header{RootHash: providedRootHash})
+					},
+				}, nil
+			},
+		}
+		argsNewSCQuery.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return accounts
+			},
+		}
+		argsNewSCQuery.QueryCache = queryCache
+
+		target, _ := NewSCQueryService(argsNewSCQuery)
+		return target
+	}
+
+	firstAccounts := createAccountsAdapter()
+	secondAccounts := createAccountsAdapter()
+	firstService := createQueryService(firstAccounts)
+	secondService := createQueryService(secondAccounts)
+
+	createQuery := func(argument []byte) *process.SCQuery {
+		return &process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+			Arguments: [][]byte{argument},
+			BlockHash: []byte("provided hash"),
+		}
+	}
+
+	_, _, err := firstService.ExecuteQuery(createQuery([]byte("arg1")))
+	require.Nil(t, err)
+	_, _, err = secondService.ExecuteQuery(createQuery([]byte("arg2")))
+	require.Nil(t, err)
+
+	assert.Equal(t, 1, numRecreateTrie)
+	assert.Equal(t, providedRootHash, secondAccounts.setRootHash)
+	assert.True(t, secondAccounts.recreatedTrie == providedTrie)
+}
+
 func TestSCQueryService_RecreateTrie(t *testing.T) {
 	t.Parallel()
 
@@ -1166,6 +1457,7 @@ func TestNewSCQueryService_CloseShouldWork(t *testing.T) {
 		Marshaller:               &marshallerMock.MarshalizerStub{},
 		Hasher:                   &testscommon.HasherStub{},
 		Uint64ByteSliceConverter: &mock.Uint64ByteSliceConverterMock{},
+		QueryCache:               NewDisabledSCQueryCache(),
 	}
 
 	target, _ := NewSCQueryService(argsNewSCQueryService)
@@ -0,0 +1,154 @@
+package antiflood
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+var _ process.AntifloodDashboardHandler = (*antifloodDashboard)(nil)
+
+// ArgsAntifloodDashboard holds the arguments needed to create a new antiflood dashboard
+type ArgsAntifloodDashboard struct {
+	FloodPreventers     []process.QuotaFloodPreventer
+	TopicFloodPreventer process.TopicFloodPreventer
+	BlackListHandler    process.PeerBlackListManager
+}
+
+type antifloodDashboard struct {
+	floodPreventers     map[string]process.QuotaFloodPreventer
+	topicFloodPreventer process.TopicFloodPreventer
+	blackListHandler    process.PeerBlackListManager
+}
+
+// NewAntifloodDashboard creates a component able to report the state of the antiflood components and to tune
+// their limits at runtime
+func NewAntifloodDashboard(args ArgsAntifloodDashboard) (*antifloodDashboard, error) {
+	if len(args.FloodPreventers) == 0 {
+		return nil, process.ErrEmptyFloodPreventerList
+	}
+	if check.IfNil(args.TopicFloodPreventer) {
+		return nil, process.ErrNilTopicFloodPreventer
+	}
+	if check.IfNil(args.BlackListHandler) {
+		return nil, process.ErrNilBlackListCacher
+	}
+
+	floodPreventers := make(map[string]process.QuotaFloodPreventer, len(args.FloodPreventers))
+	for idx, fp := range args.FloodPreventers {
+		if check.IfNil(fp) {
+			return nil, fmt.Errorf("%w at index %d", process.ErrNilFloodPreventer, idx)
+		}
+		floodPreventers[fp.Name()] = fp
+	}
+
+	return &antifloodDashboard{
+		floodPreventers:     floodPreventers,
+		topicFloodPreventer: args.TopicFloodPreventer,
+		blackListHandler:    args.BlackListHandler,
+	}, nil
+}
+
+// GetQuotasStatus returns the limits and the per-peer quotas of all flood preventers, sorted by name
+func (ad *antifloodDashboard) GetQuotasStatus() []*common.AntifloodQuotaStatus {
+	result := make([]*common.AntifloodQuotaStatus, 0, len(ad.floodPreventers))
+	for _, fp := range ad.floodPreventers {
+		result = append(result, fp.GetQuotaStatus())
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// GetTopicsStatus returns the limits and the per-peer counters of all known topics
+func (ad *antifloodDashboard) GetTopicsStatus() []*common.AntifloodTopicStatus {
+	return ad.topicFloodPreventer.GetTopicsStatus()
+}
+
+// GetBlacklistedPeers returns the blacklisted peers, sorted by their ban expiry time
+func (ad *antifloodDashboard) GetBlacklistedPeers() []*common.BlacklistedPeer {
+	blacklistedPeers := ad.blackListHandler.GetBlacklistedPeers()
+
+	result := make([]*common.BlacklistedPeer, 0, len(blacklistedPeers))
+	for pid, expiryTime := range blacklistedPeers {
+		result = append(result, &common.BlacklistedPeer{
+			Pid:       pid.Pretty(),
+			ExpiresAt: expiryTime.Unix(),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].ExpiresAt == result[j].ExpiresAt {
+			return result[i].Pid < result[j].Pid
+		}
+
+		return result[i].ExpiresAt < result[j].ExpiresAt
+	})
+
+	return result
+}
+
+// SetMaxQuota changes the per-peer limits of the flood preventer with the provided name
+func (ad *antifloodDashboard) SetMaxQuota(name string, baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	fp, found := ad.floodPreventers[name]
+	if !found {
+		return fmt.Errorf("%w, name %s", process.ErrFloodPreventerNotFound, name)
+	}
+
+	return fp.SetMaxQuota(baseMaxNumMessagesPerPeer, maxTotalSizePerPeer)
+}
+
+// SetMaxMessagesForTopic changes the maximum number of messages that can be received from a peer on the provided topic
+func (ad *antifloodDashboard) SetMaxMessagesForTopic(topic string, maxNum uint32) error {
+	if len(topic) == 0 {
+		return fmt.Errorf("%w, empty topic", process.ErrInvalidValue)
+	}
+	if maxNum == 0 {
+		return fmt.Errorf("%w, max number of messages for topic %s should be greater than 0", process.ErrInvalidValue, topic)
+	}
+
+	ad.topicFloodPreventer.SetMaxMessagesForTopic(topic, maxNum)
+
+	return nil
+}
+
+// BlacklistPeer manually adds the provided peer to the blacklist for the given duration
+func (ad *antifloodDashboard) BlacklistPeer(pid core.PeerID, duration time.Duration) error {
+	if duration <= 0 {
+		return fmt.Errorf("%w, ban duration should be positive", process.ErrInvalidValue)
+	}
+
+	err := ad.blackListHandler.Upsert(pid, duration)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("manually blacklisted peer", "pid", pid.Pretty(), "duration", duration)
+
+	return nil
+}
+
+// RemoveBlacklistedPeer manually removes the provided peer from the blacklist
+func (ad *antifloodDashboard) RemoveBlacklistedPeer(pid core.PeerID) error {
+	if len(pid) == 0 {
+		return process.ErrEmptyPeerID
+	}
+
+	ad.blackListHandler.Remove(pid)
+	log.Debug("manually removed peer from blacklist", "pid", pid.Pretty())
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ad *antifloodDashboard) IsInterfaceNil() bool {
+	return ad == nil
+}
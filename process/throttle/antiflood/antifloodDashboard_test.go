@@ -0,0 +1,211 @@
+package antiflood_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsAntifloodDashboard() antiflood.ArgsAntifloodDashboard {
+	return antiflood.ArgsAntifloodDashboard{
+		FloodPreventers: []process.QuotaFloodPreventer{
+			&mock.FloodPreventerStub{
+				NameCalled: func() string {
+					return "fast"
+				},
+			},
+		},
+		TopicFloodPreventer: &mock.TopicAntiFloodStub{},
+		BlackListHandler:    &mock.PeerBlackListHandlerStub{},
+	}
+}
+
+func TestNewAntifloodDashboard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty flood preventers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAntifloodDashboard()
+		args.FloodPreventers = nil
+		ad, err := antiflood.NewAntifloodDashboard(args)
+		assert.True(t, check.IfNil(ad))
+		assert.Equal(t, process.ErrEmptyFloodPreventerList, err)
+	})
+	t.Run("nil flood preventer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAntifloodDashboard()
+		args.FloodPreventers = append(args.FloodPreventers, nil)
+		ad, err := antiflood.NewAntifloodDashboard(args)
+		assert.True(t, check.IfNil(ad))
+		assert.True(t, errors.Is(err, process.ErrNilFloodPreventer))
+	})
+	t.Run("nil topic flood preventer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAntifloodDashboard()
+		args.TopicFloodPreventer = nil
+		ad, err := antiflood.NewAntifloodDashboard(args)
+		assert.True(t, check.IfNil(ad))
+		assert.Equal(t, process.ErrNilTopicFloodPreventer, err)
+	})
+	t.Run("nil blacklist handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAntifloodDashboard()
+		args.BlackListHandler = nil
+		ad, err := antiflood.NewAntifloodDashboard(args)
+		assert.True(t, check.IfNil(ad))
+		assert.Equal(t, process.ErrNilBlackListCacher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ad, err := antiflood.NewAntifloodDashboard(createMockArgsAntifloodDashboard())
+		assert.False(t, check.IfNil(ad))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAntifloodDashboard_GetQuotasStatusShouldBeSorted(t *testing.T) {
+	t.Parallel()
+
+	createFloodPreventer := func(name string) *mock.FloodPreventerStub {
+		return &mock.FloodPreventerStub{
+			NameCalled: func() string {
+				return name
+			},
+			GetQuotaStatusCalled: func() *common.AntifloodQuotaStatus {
+				return &common.AntifloodQuotaStatus{Name: name}
+			},
+		}
+	}
+
+	args := createMockArgsAntifloodDashboard()
+	args.FloodPreventers = []process.QuotaFloodPreventer{
+		createFloodPreventer("slow"),
+		createFloodPreventer("fast"),
+		createFloodPreventer("out_of_specs"),
+	}
+	ad, _ := antiflood.NewAntifloodDashboard(args)
+
+	status := ad.GetQuotasStatus()
+	assert.Equal(t, 3, len(status))
+	assert.Equal(t, "fast", status[0].Name)
+	assert.Equal(t, "out_of_specs", status[1].Name)
+	assert.Equal(t, "slow", status[2].Name)
+}
+
+func TestAntifloodDashboard_SetMaxQuota(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown flood preventer should error", func(t *testing.T) {
+		t.Parallel()
+
+		ad, _ := antiflood.NewAntifloodDashboard(createMockArgsAntifloodDashboard())
+		err := ad.SetMaxQuota("unknown", 1, 1)
+		assert.True(t, errors.Is(err, process.ErrFloodPreventerNotFound))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedNumMessages uint32
+		var providedSize uint64
+		args := createMockArgsAntifloodDashboard()
+		args.FloodPreventers = []process.QuotaFloodPreventer{
+			&mock.FloodPreventerStub{
+				NameCalled: func() string {
+					return "fast"
+				},
+				SetMaxQuotaCalled: func(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+					providedNumMessages = baseMaxNumMessagesPerPeer
+					providedSize = maxTotalSizePerPeer
+					return nil
+				},
+			},
+		}
+		ad, _ := antiflood.NewAntifloodDashboard(args)
+
+		err := ad.SetMaxQuota("fast", 10, 1000)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(10), providedNumMessages)
+		assert.Equal(t, uint64(1000), providedSize)
+	})
+}
+
+func TestAntifloodDashboard_SetMaxMessagesForTopic(t *testing.T) {
+	t.Parallel()
+
+	setCalled := false
+	args := createMockArgsAntifloodDashboard()
+	args.TopicFloodPreventer = &mock.TopicAntiFloodStub{
+		SetMaxMessagesForTopicCalled: func(topic string, num uint32) {
+			assert.Equal(t, "topic", topic)
+			assert.Equal(t, uint32(100), num)
+			setCalled = true
+		},
+	}
+	ad, _ := antiflood.NewAntifloodDashboard(args)
+
+	err := ad.SetMaxMessagesForTopic("", 100)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	err = ad.SetMaxMessagesForTopic("topic", 0)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	assert.False(t, setCalled)
+
+	err = ad.SetMaxMessagesForTopic("topic", 100)
+	assert.Nil(t, err)
+	assert.True(t, setCalled)
+}
+
+func TestAntifloodDashboard_BlacklistOperations(t *testing.T) {
+	t.Parallel()
+
+	blacklisted := make(map[core.PeerID]time.Time)
+	args := createMockArgsAntifloodDashboard()
+	args.BlackListHandler = &mock.PeerBlackListHandlerStub{
+		UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+			blacklisted[pid] = time.Unix(int64(span.Seconds()), 0)
+			return nil
+		},
+		RemoveCalled: func(pid core.PeerID) {
+			delete(blacklisted, pid)
+		},
+		GetBlacklistedPeersCalled: func() map[core.PeerID]time.Time {
+			return blacklisted
+		},
+	}
+	ad, _ := antiflood.NewAntifloodDashboard(args)
+
+	err := ad.BlacklistPeer("pid1", 0)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	err = ad.BlacklistPeer("pid1", time.Minute)
+	assert.Nil(t, err)
+	err = ad.BlacklistPeer("pid2", time.Second)
+	assert.Nil(t, err)
+
+	blacklistedPeers := ad.GetBlacklistedPeers()
+	assert.Equal(t, 2, len(blacklistedPeers))
+	assert.Equal(t, core.PeerID("pid2").Pretty(), blacklistedPeers[0].Pid)
+	assert.Equal(t, int64(1), blacklistedPeers[0].ExpiresAt)
+	assert.Equal(t, core.PeerID("pid1").Pretty(), blacklistedPeers[1].Pid)
+	assert.Equal(t, int64(60), blacklistedPeers[1].ExpiresAt)
+
+	err = ad.RemoveBlacklistedPeer("")
+	assert.Equal(t, process.ErrEmptyPeerID, err)
+
+	err = ad.RemoveBlacklistedPeer("pid1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ad.GetBlacklistedPeers()))
+}
@@ -0,0 +1,18 @@
+package blackList
+
+import "time"
+
+// SetGetTimeHandler -
+func (pbc *peerBlackListCache) SetGetTimeHandler(handler func() time.Time) {
+	pbc.getTimeHandler = handler
+}
+
+// SetGetTimeHandler -
+func (prs *peerReputationStorer) SetGetTimeHandler(handler func() time.Time) {
+	prs.getTimeHandler = handler
+}
+
+// SetGetTimeHandler -
+func (prt *peersRatingTracker) SetGetTimeHandler(handler func() time.Time) {
+	prt.getTimeHandler = handler
+}
@@ -0,0 +1,104 @@
+package blackList
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+var _ process.PeerBlackListManager = (*peerBlackListCache)(nil)
+
+// peerBlackListCache keeps the blacklisted peers together with the moment their ban expires
+type peerBlackListCache struct {
+	mut            sync.RWMutex
+	expiryTimes    map[core.PeerID]time.Time
+	getTimeHandler func() time.Time
+}
+
+// NewPeerBlackListCache creates a new peer blacklist cache that, besides the regular time cache operations,
+// is able to list and remove the blacklisted peers
+func NewPeerBlackListCache() *peerBlackListCache {
+	return &peerBlackListCache{
+		expiryTimes:    make(map[core.PeerID]time.Time),
+		getTimeHandler: time.Now,
+	}
+}
+
+// Upsert will add the pid with the provided ban duration. If the pid already exists, the ban will be extended
+// if the new expiry time is later than the existing one
+func (pbc *peerBlackListCache) Upsert(pid core.PeerID, duration time.Duration) error {
+	if len(pid) == 0 {
+		return process.ErrEmptyPeerID
+	}
+
+	expiryTime := pbc.getTimeHandler().Add(duration)
+
+	pbc.mut.Lock()
+	defer pbc.mut.Unlock()
+
+	existing, found := pbc.expiryTimes[pid]
+	if found && existing.After(expiryTime) {
+		return nil
+	}
+	pbc.expiryTimes[pid] = expiryTime
+
+	return nil
+}
+
+// Has returns true if the pid is blacklisted and its ban did not expire
+func (pbc *peerBlackListCache) Has(pid core.PeerID) bool {
+	pbc.mut.RLock()
+	defer pbc.mut.RUnlock()
+
+	expiryTime, found := pbc.expiryTimes[pid]
+	if !found {
+		return false
+	}
+
+	return pbc.getTimeHandler().Before(expiryTime)
+}
+
+// Sweep removes all the peers whose ban expired
+func (pbc *peerBlackListCache) Sweep() {
+	now := pbc.getTimeHandler()
+
+	pbc.mut.Lock()
+	defer pbc.mut.Unlock()
+
+	for pid, expiryTime := range pbc.expiryTimes {
+		if !now.Before(expiryTime) {
+			delete(pbc.expiryTimes, pid)
+		}
+	}
+}
+
+// Remove removes the pid from the blacklist
+func (pbc *peerBlackListCache) Remove(pid core.PeerID) {
+	pbc.mut.Lock()
+	delete(pbc.expiryTimes, pid)
+	pbc.mut.Unlock()
+}
+
+// GetBlacklistedPeers returns the currently blacklisted peers together with their ban expiry times
+func (pbc *peerBlackListCache) GetBlacklistedPeers() map[core.PeerID]time.Time {
+	now := pbc.getTimeHandler()
+
+	pbc.mut.RLock()
+	defer pbc.mut.RUnlock()
+
+	result := make(map[core.PeerID]time.Time, len(pbc.expiryTimes))
+	for pid, expiryTime := range pbc.expiryTimes {
+		if now.Before(expiryTime) {
+			result[pid] = expiryTime
+		}
+	}
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pbc *peerBlackListCache) IsInterfaceNil() bool {
+	return pbc == nil
+}
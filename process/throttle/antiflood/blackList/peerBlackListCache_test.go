@@ -0,0 +1,92 @@
+package blackList_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/blackList"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPeerBlackListCache(t *testing.T) {
+	t.Parallel()
+
+	pbc := blackList.NewPeerBlackListCache()
+	assert.False(t, check.IfNil(pbc))
+	assert.Empty(t, pbc.GetBlacklistedPeers())
+}
+
+func TestPeerBlackListCache_UpsertEmptyPidShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pbc := blackList.NewPeerBlackListCache()
+	err := pbc.Upsert("", time.Second)
+	assert.Equal(t, process.ErrEmptyPeerID, err)
+}
+
+func TestPeerBlackListCache_UpsertAndHas(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	pbc := blackList.NewPeerBlackListCache()
+	pbc.SetGetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	pid := core.PeerID("pid")
+	assert.False(t, pbc.Has(pid))
+
+	err := pbc.Upsert(pid, time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, pbc.Has(pid))
+
+	// a shorter ban should not reduce the existing one
+	err = pbc.Upsert(pid, time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, currentTime.Add(time.Minute), pbc.GetBlacklistedPeers()[pid])
+
+	// a longer ban should extend the existing one
+	err = pbc.Upsert(pid, time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, currentTime.Add(time.Hour), pbc.GetBlacklistedPeers()[pid])
+
+	currentTime = currentTime.Add(time.Hour)
+	assert.False(t, pbc.Has(pid))
+	assert.Empty(t, pbc.GetBlacklistedPeers())
+}
+
+func TestPeerBlackListCache_Sweep(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	pbc := blackList.NewPeerBlackListCache()
+	pbc.SetGetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	_ = pbc.Upsert("pid1", time.Second)
+	_ = pbc.Upsert("pid2", time.Minute)
+
+	currentTime = currentTime.Add(time.Second)
+	pbc.Sweep()
+
+	blacklistedPeers := pbc.GetBlacklistedPeers()
+	assert.Equal(t, 1, len(blacklistedPeers))
+	_, found := blacklistedPeers["pid2"]
+	assert.True(t, found)
+}
+
+func TestPeerBlackListCache_Remove(t *testing.T) {
+	t.Parallel()
+
+	pbc := blackList.NewPeerBlackListCache()
+	_ = pbc.Upsert("pid", time.Minute)
+	assert.True(t, pbc.Has("pid"))
+
+	pbc.Remove("pid")
+	assert.False(t, pbc.Has("pid"))
+	assert.Empty(t, pbc.GetBlacklistedPeers())
+}
@@ -0,0 +1,285 @@
+package blackList
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+var _ process.PeerReputationHandler = (*peerReputationStorer)(nil)
+
+const peerReputationKey = "peerReputation"
+
+// ArgsPeerReputationStorer is the DTO used to create a new instance of peerReputationStorer
+type ArgsPeerReputationStorer struct {
+	Storer             storage.Storer
+	Marshaller         marshal.Marshalizer
+	BlackListHandler   process.PeerBlackListManager
+	PeersRatingTracker process.PeersRatingTracker
+	RatingsExpiry      time.Duration
+}
+
+// peerReputationStorer is able to persist the blacklisted peers and the peers ratings and to reload them on start
+type peerReputationStorer struct {
+	mutOperation       sync.Mutex
+	storer             storage.Storer
+	marshaller         marshal.Marshalizer
+	blackListHandler   process.PeerBlackListManager
+	peersRatingTracker process.PeersRatingTracker
+	ratingsExpiry      time.Duration
+	getTimeHandler     func() time.Time
+}
+
+type importedBlacklistedPeer struct {
+	pid      core.PeerID
+	duration time.Duration
+}
+
+type importedPeerRating struct {
+	pid       core.PeerID
+	rating    int32
+	expiresAt int64
+}
+
+// NewPeerReputationStorer creates a new instance of peerReputationStorer
+func NewPeerReputationStorer(args ArgsPeerReputationStorer) (*peerReputationStorer, error) {
+	err := checkArgsPeerReputationStorer(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &peerReputationStorer{
+		storer:             args.Storer,
+		marshaller:         args.Marshaller,
+		blackListHandler:   args.BlackListHandler,
+		peersRatingTracker: args.PeersRatingTracker,
+		ratingsExpiry:      args.RatingsExpiry,
+		getTimeHandler:     time.Now,
+	}, nil
+}
+
+func checkArgsPeerReputationStorer(args ArgsPeerReputationStorer) error {
+	if check.IfNil(args.Storer) {
+		return process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshaller) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.BlackListHandler) {
+		return process.ErrNilBlackListCacher
+	}
+	if check.IfNil(args.PeersRatingTracker) {
+		return process.ErrNilPeersRatingHandler
+	}
+	if args.RatingsExpiry < time.Second {
+		return fmt.Errorf("%w for RatingsExpiry", process.ErrInvalidValue)
+	}
+
+	return nil
+}
+
+// Load reads the previously persisted snapshot, if any, and applies it
+func (prs *peerReputationStorer) Load() error {
+	buff, err := prs.storer.Get([]byte(peerReputationKey))
+	if err != nil {
+		log.Debug("peerReputationStorer.Load: no persisted peer reputation found", "error", err)
+		return nil
+	}
+
+	snapshot := &common.PeerReputationSnapshot{}
+	err = prs.marshaller.Unmarshal(snapshot, buff)
+	if err != nil {
+		return err
+	}
+
+	return prs.Import(snapshot)
+}
+
+// Save persists the current blacklisted peers and peers ratings
+func (prs *peerReputationStorer) Save() error {
+	snapshot := prs.Export()
+	buff, err := prs.marshaller.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	log.Trace("peerReputationStorer.Save",
+		"num blacklisted peers", len(snapshot.BlacklistedPeers),
+		"num ratings", len(snapshot.Ratings))
+
+	return prs.storer.Put([]byte(peerReputationKey), buff)
+}
+
+// Export returns the current blacklisted peers and peers ratings. A rating expires after the configured duration,
+// counted from its last update, and the expired ratings are not exported. The current state is not changed
+func (prs *peerReputationStorer) Export() *common.PeerReputationSnapshot {
+	prs.mutOperation.Lock()
+	defer prs.mutOperation.Unlock()
+
+	blacklistedPeersMap := prs.blackListHandler.GetBlacklistedPeers()
+	blacklistedPeers := make([]*common.BlacklistedPeer, 0, len(blacklistedPeersMap))
+	for pid, expiryTime := range blacklistedPeersMap {
+		blacklistedPeers = append(blacklistedPeers, &common.BlacklistedPeer{
+			Pid:       pid.Pretty(),
+			ExpiresAt: expiryTime.Unix(),
+		})
+	}
+	sort.Slice(blacklistedPeers, func(i, j int) bool {
+		return blacklistedPeers[i].Pid < blacklistedPeers[j].Pid
+	})
+
+	ratings := prs.getRatingsToExport()
+	sort.Slice(ratings, func(i, j int) bool {
+		return ratings[i].Pid < ratings[j].Pid
+	})
+
+	return &common.PeerReputationSnapshot{
+		BlacklistedPeers: blacklistedPeers,
+		Ratings:          ratings,
+	}
+}
+
+func (prs *peerReputationStorer) getRatingsToExport() []*common.PeerRating {
+	now := prs.getTimeHandler().Unix()
+	ratingsInfo := prs.peersRatingTracker.GetRatings()
+	ratings := make([]*common.PeerRating, 0, len(ratingsInfo))
+	for _, ratingInfo := range ratingsInfo {
+		expiresAt := time.Unix(ratingInfo.LastUpdate, 0).Add(prs.ratingsExpiry).Unix()
+		if expiresAt <= now {
+			continue
+		}
+
+		ratings = append(ratings, &common.PeerRating{
+			Pid:       ratingInfo.Pid.Pretty(),
+			Rating:    ratingInfo.Rating,
+			ExpiresAt: expiresAt,
+		})
+	}
+
+	return ratings
+}
+
+// Import applies the provided snapshot. Expired entries are ignored, the blacklisted peers are merged with the
+// existing ones while the provided ratings overwrite the existing ones, keeping their expiry time. The snapshot is
+// validated before applying it, so nothing is imported if an entry is invalid
+func (prs *peerReputationStorer) Import(snapshot *common.PeerReputationSnapshot) error {
+	if snapshot == nil {
+		return process.ErrNilPeerReputationSnapshot
+	}
+
+	prs.mutOperation.Lock()
+	defer prs.mutOperation.Unlock()
+
+	now := prs.getTimeHandler()
+	blacklistedPeers, err := getBlacklistedPeersToImport(snapshot.BlacklistedPeers, now)
+	if err != nil {
+		return err
+	}
+	ratings, err := getRatingsToImport(snapshot.Ratings, now)
+	if err != nil {
+		return err
+	}
+
+	for _, blacklistedPeer := range blacklistedPeers {
+		err = prs.blackListHandler.Upsert(blacklistedPeer.pid, blacklistedPeer.duration)
+		if err != nil {
+			return err
+		}
+	}
+	for _, peerRating := range ratings {
+		lastUpdate := time.Unix(peerRating.expiresAt, 0).Add(-prs.ratingsExpiry).Unix()
+		prs.peersRatingTracker.SetRating(peerRating.pid, peerRating.rating, lastUpdate)
+	}
+
+	log.Debug("peerReputationStorer.Import", "num blacklisted peers", len(blacklistedPeers), "num ratings", len(ratings))
+
+	return nil
+}
+
+func getBlacklistedPeersToImport(blacklistedPeers []*common.BlacklistedPeer, now time.Time) ([]*importedBlacklistedPeer, error) {
+	result := make([]*importedBlacklistedPeer, 0, len(blacklistedPeers))
+	for _, blacklistedPeer := range blacklistedPeers {
+		if blacklistedPeer == nil {
+			continue
+		}
+
+		pid, err := decodePeerID(blacklistedPeer.Pid)
+		if err != nil {
+			return nil, err
+		}
+
+		duration := time.Unix(blacklistedPeer.ExpiresAt, 0).Sub(now)
+		if duration <= 0 {
+			continue
+		}
+
+		result = append(result, &importedBlacklistedPeer{
+			pid:      pid,
+			duration: duration,
+		})
+	}
+
+	return result, nil
+}
+
+func getRatingsToImport(ratings []*common.PeerRating, now time.Time) ([]*importedPeerRating, error) {
+	result := make([]*importedPeerRating, 0, len(ratings))
+	for _, peerRating := range ratings {
+		if peerRating == nil {
+			continue
+		}
+
+		pid, err := decodePeerID(peerRating.Pid)
+		if err != nil {
+			return nil, err
+		}
+
+		if peerRating.ExpiresAt <= now.Unix() {
+			continue
+		}
+
+		result = append(result, &importedPeerRating{
+			pid:       pid,
+			rating:    peerRating.Rating,
+			expiresAt: peerRating.ExpiresAt,
+		})
+	}
+
+	return result, nil
+}
+
+func decodePeerID(pretty string) (core.PeerID, error) {
+	if len(pretty) == 0 {
+		return "", process.ErrEmptyPeerID
+	}
+
+	pid, err := core.NewPeerID(pretty)
+	if err != nil {
+		return "", fmt.Errorf("%w for peer %s", err, pretty)
+	}
+
+	return pid, nil
+}
+
+// Close saves the current state and closes the underlying storer
+func (prs *peerReputationStorer) Close() error {
+	err := prs.Save()
+	if err != nil {
+		log.Warn("peerReputationStorer.Close: could not save the peer reputation", "error", err)
+	}
+
+	return prs.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prs *peerReputationStorer) IsInterfaceNil() bool {
+	return prs == nil
+}
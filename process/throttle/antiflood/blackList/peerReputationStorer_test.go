@@ -0,0 +1,388 @@
+package blackList_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/blackList"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/cache"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ratingsCaches struct {
+	topRated storage.Cacher
+	badRated storage.Cacher
+}
+
+func createMockArgsPeerReputationStorer() (blackList.ArgsPeerReputationStorer, *ratingsCaches) {
+	return createMockArgsPeerReputationStorerWithTime(time.Now)
+}
+
+func createMockArgsPeerReputationStorerWithTime(getTimeHandler func() time.Time) (blackList.ArgsPeerReputationStorer, *ratingsCaches) {
+	caches := &ratingsCaches{}
+	caches.topRated, _ = cache.NewLRUCache(100)
+	caches.badRated, _ = cache.NewLRUCache(100)
+	tracker, _ := blackList.NewPeersRatingTracker(blackList.ArgsPeersRatingTracker{
+		PeersRatingHandler: &p2pmocks.PeersRatingHandlerStub{},
+		TopRatedCache:      caches.topRated,
+		BadRatedCache:      caches.badRated,
+	})
+	tracker.SetGetTimeHandler(getTimeHandler)
+
+	return blackList.ArgsPeerReputationStorer{
+		Storer:             genericMocks.NewStorerMock(),
+		Marshaller:         &marshal.JsonMarshalizer{},
+		BlackListHandler:   blackList.NewPeerBlackListCache(),
+		PeersRatingTracker: tracker,
+		RatingsExpiry:      time.Hour,
+	}, caches
+}
+
+func TestNewPeerReputationStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsPeerReputationStorer()
+		args.Storer = nil
+		prs, err := blackList.NewPeerReputationStorer(args)
+		assert.Equal(t, process.ErrNilStorage, err)
+		assert.True(t, check.IfNil(prs))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsPeerReputationStorer()
+		args.Marshaller = nil
+		prs, err := blackList.NewPeerReputationStorer(args)
+		assert.Equal(t, process.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(prs))
+	})
+	t.Run("nil blacklist handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsPeerReputationStorer()
+		args.BlackListHandler = nil
+		prs, err := blackList.NewPeerReputationStorer(args)
+		assert.Equal(t, process.ErrNilBlackListCacher, err)
+		assert.True(t, check.IfNil(prs))
+	})
+	t.Run("nil peers rating tracker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsPeerReputationStorer()
+		args.PeersRatingTracker = nil
+		prs, err := blackList.NewPeerReputationStorer(args)
+		assert.Equal(t, process.ErrNilPeersRatingHandler, err)
+		assert.True(t, check.IfNil(prs))
+	})
+	t.Run("invalid ratings expiry should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsPeerReputationStorer()
+		args.RatingsExpiry = time.Millisecond
+		prs, err := blackList.NewPeerReputationStorer(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+		assert.True(t, check.IfNil(prs))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsPeerReputationStorer()
+		prs, err := blackList.NewPeerReputationStorer(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(prs))
+	})
+}
+
+func TestPeerReputationStorer_Export(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	args, caches := createMockArgsPeerReputationStorerWithTime(func() time.Time {
+		return now
+	})
+	blacklist := blackList.NewPeerBlackListCache()
+	blacklist.SetGetTimeHandler(func() time.Time {
+		return now
+	})
+	args.BlackListHandler = blacklist
+	_ = blacklist.Upsert("pid2", time.Minute)
+	_ = blacklist.Upsert("pid1", time.Hour)
+	args.PeersRatingTracker.SetRating("pid3", 10, 1000)
+	args.PeersRatingTracker.SetRating("pid4", -10, 900)
+	caches.badRated.Put([]byte("pid5"), "not a rating", 4)
+
+	prs, _ := blackList.NewPeerReputationStorer(args)
+	prs.SetGetTimeHandler(func() time.Time {
+		return now
+	})
+
+	snapshot := prs.Export()
+	expectedSnapshot := &common.PeerReputationSnapshot{
+		BlacklistedPeers: []*common.BlacklistedPeer{
+			{Pid: core.PeerID("pid1").Pretty(), ExpiresAt: 1000 + 3600},
+			{Pid: core.PeerID("pid2").Pretty(), ExpiresAt: 1000 + 60},
+		},
+		Ratings: []*common.PeerRating{
+			{Pid: core.PeerID("pid3").Pretty(), Rating: 10, ExpiresAt: 1000 + 3600},
+			{Pid: core.PeerID("pid4").Pretty(), Rating: -10, ExpiresAt: 900 + 3600},
+		},
+	}
+	assert.ElementsMatch(t, expectedSnapshot.BlacklistedPeers, snapshot.BlacklistedPeers)
+	assert.ElementsMatch(t, expectedSnapshot.Ratings, snapshot.Ratings)
+}
+
+func TestPeerReputationStorer_ExportShouldCountTheRatingsExpiryFromTheLastUpdate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	args, caches := createMockArgsPeerReputationStorerWithTime(func() time.Time {
+		return now
+	})
+	args.PeersRatingTracker.SetRating("pid1", 10, 1000)
+	prs, _ := blackList.NewPeerReputationStorer(args)
+	prs.SetGetTimeHandler(func() time.Time {
+		return now
+	})
+	err := prs.Import(&common.PeerReputationSnapshot{
+		Ratings: []*common.PeerRating{{Pid: core.PeerID("pid2").Pretty(), Rating: -10, ExpiresAt: 1500}},
+	})
+	require.Nil(t, err)
+
+	snapshot := prs.Export()
+	expectedRatings := []*common.PeerRating{
+		{Pid: core.PeerID("pid1").Pretty(), Rating: 10, ExpiresAt: 1000 + 3600},
+		{Pid: core.PeerID("pid2").Pretty(), Rating: -10, ExpiresAt: 1500},
+	}
+	assert.ElementsMatch(t, expectedRatings, snapshot.Ratings)
+
+	now = time.Unix(1000+1800, 0)
+	args.PeersRatingTracker.IncreaseRating("pid1")
+	snapshot = prs.Export()
+	expectedRatings = []*common.PeerRating{
+		{Pid: core.PeerID("pid1").Pretty(), Rating: 10, ExpiresAt: 1000 + 1800 + 3600},
+	}
+	assert.ElementsMatch(t, expectedRatings, snapshot.Ratings)
+
+	// the updated rating does not expire after the first exported expiry time
+	now = time.Unix(1000+3600, 0)
+	snapshot = prs.Export()
+	assert.ElementsMatch(t, expectedRatings, snapshot.Ratings)
+
+	now = time.Unix(1000+1800+3600, 0)
+	snapshot = prs.Export()
+	assert.Empty(t, snapshot.Ratings)
+
+	// exporting does not change the current ratings
+	assert.True(t, caches.topRated.Has([]byte("pid1")))
+	assert.True(t, caches.badRated.Has([]byte("pid2")))
+}
+
+func TestPeerReputationStorer_Import(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+
+	t.Run("nil snapshot should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsPeerReputationStorer()
+		prs, _ := blackList.NewPeerReputationStorer(args)
+		err := prs.Import(nil)
+		assert.Equal(t, process.ErrNilPeerReputationSnapshot, err)
+	})
+	t.Run("invalid pid should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsPeerReputationStorer()
+		prs, _ := blackList.NewPeerReputationStorer(args)
+		prs.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+		err := prs.Import(&common.PeerReputationSnapshot{
+			BlacklistedPeers: []*common.BlacklistedPeer{{Pid: "0OIl", ExpiresAt: 2000}},
+		})
+		assert.NotNil(t, err)
+
+		err = prs.Import(&common.PeerReputationSnapshot{
+			Ratings: []*common.PeerRating{{Pid: "", Rating: 1, ExpiresAt: 2000}},
+		})
+		assert.Equal(t, process.ErrEmptyPeerID, err)
+	})
+	t.Run("invalid pid should not import the valid entries", func(t *testing.T) {
+		t.Parallel()
+
+		args, caches := createMockArgsPeerReputationStorer()
+		blacklist := blackList.NewPeerBlackListCache()
+		blacklist.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+		args.BlackListHandler = blacklist
+		prs, _ := blackList.NewPeerReputationStorer(args)
+		prs.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+
+		err := prs.Import(&common.PeerReputationSnapshot{
+			BlacklistedPeers: []*common.BlacklistedPeer{{Pid: core.PeerID("pid1").Pretty(), ExpiresAt: 2000}},
+			Ratings: []*common.PeerRating{
+				{Pid: core.PeerID("pid2").Pretty(), Rating: 1, ExpiresAt: 2000},
+				{Pid: "0OIl", Rating: 1, ExpiresAt: 2000},
+			},
+		})
+		assert.NotNil(t, err)
+		assert.False(t, blacklist.Has("pid1"))
+		assert.False(t, caches.topRated.Has([]byte("pid2")))
+	})
+	t.Run("should import non-expired entries", func(t *testing.T) {
+		t.Parallel()
+
+		args, caches := createMockArgsPeerReputationStorer()
+		blacklist := blackList.NewPeerBlackListCache()
+		blacklist.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+		args.BlackListHandler = blacklist
+		caches.badRated.Put([]byte("pid3"), int32(-5), 4)
+		prs, _ := blackList.NewPeerReputationStorer(args)
+		prs.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+
+		err := prs.Import(&common.PeerReputationSnapshot{
+			BlacklistedPeers: []*common.BlacklistedPeer{
+				nil,
+				{Pid: core.PeerID("pid1").Pretty(), ExpiresAt: 1100},
+				{Pid: core.PeerID("pid2").Pretty(), ExpiresAt: 900},
+			},
+			Ratings: []*common.PeerRating{
+				nil,
+				{Pid: core.PeerID("pid3").Pretty(), Rating: 500, ExpiresAt: 1100},
+				{Pid: core.PeerID("pid4").Pretty(), Rating: -500, ExpiresAt: 1100},
+				{Pid: core.PeerID("pid5").Pretty(), Rating: 50, ExpiresAt: 1000},
+			},
+		})
+		require.Nil(t, err)
+
+		assert.True(t, blacklist.Has("pid1"))
+		assert.False(t, blacklist.Has("pid2"))
+
+		assert.False(t, caches.badRated.Has([]byte("pid3")))
+		rating, _ := caches.topRated.Get([]byte("pid3"))
+		assert.Equal(t, int32(100), rating)
+		rating, _ = caches.badRated.Get([]byte("pid4"))
+		assert.Equal(t, int32(-100), rating)
+		assert.False(t, caches.topRated.Has([]byte("pid5")))
+	})
+}
+
+func TestPeerReputationStorer_SaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	args, _ := createMockArgsPeerReputationStorer()
+	blacklist := blackList.NewPeerBlackListCache()
+	blacklist.SetGetTimeHandler(func() time.Time {
+		return now
+	})
+	args.BlackListHandler = blacklist
+	_ = blacklist.Upsert("pid1", time.Hour)
+	args.PeersRatingTracker.SetRating("pid2", 20, 1000)
+
+	prs, _ := blackList.NewPeerReputationStorer(args)
+	prs.SetGetTimeHandler(func() time.Time {
+		return now
+	})
+	err := prs.Save()
+	require.Nil(t, err)
+
+	// simulate a restart by reusing only the storer
+	newArgs, newCaches := createMockArgsPeerReputationStorer()
+	newArgs.Storer = args.Storer
+	newBlacklist := blackList.NewPeerBlackListCache()
+	newBlacklist.SetGetTimeHandler(func() time.Time {
+		return now.Add(time.Minute)
+	})
+	newArgs.BlackListHandler = newBlacklist
+	newPrs, _ := blackList.NewPeerReputationStorer(newArgs)
+	newPrs.SetGetTimeHandler(func() time.Time {
+		return now.Add(time.Minute)
+	})
+
+	err = newPrs.Load()
+	require.Nil(t, err)
+	assert.True(t, newBlacklist.Has("pid1"))
+	rating, _ := newCaches.topRated.Get([]byte("pid2"))
+	assert.Equal(t, int32(20), rating)
+
+	// the expiry time of the rating is kept after the restart
+	snapshot := newPrs.Export()
+	expectedRatings := []*common.PeerRating{
+		{Pid: core.PeerID("pid2").Pretty(), Rating: 20, ExpiresAt: 1000 + 3600},
+	}
+	assert.Equal(t, expectedRatings, snapshot.Ratings)
+}
+
+func TestPeerReputationStorer_Load(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing key should not error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsPeerReputationStorer()
+		prs, _ := blackList.NewPeerReputationStorer(args)
+		assert.Nil(t, prs.Load())
+	})
+	t.Run("corrupted data should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsPeerReputationStorer()
+		args.Storer = &storageStubs.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return []byte("not a json"), nil
+			},
+		}
+		prs, _ := blackList.NewPeerReputationStorer(args)
+		assert.NotNil(t, prs.Load())
+	})
+}
+
+func TestPeerReputationStorer_Close(t *testing.T) {
+	t.Parallel()
+
+	putCalled := false
+	closeCalled := false
+	args, _ := createMockArgsPeerReputationStorer()
+	args.Storer = &storageStubs.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			putCalled = true
+			snapshot := &common.PeerReputationSnapshot{}
+			assert.Nil(t, json.Unmarshal(data, snapshot))
+			return nil
+		},
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+	prs, _ := blackList.NewPeerReputationStorer(args)
+
+	err := prs.Close()
+	assert.Nil(t, err)
+	assert.True(t, putCalled)
+	assert.True(t, closeCalled)
+}
@@ -0,0 +1,188 @@
+package blackList
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+var _ process.PeersRatingTracker = (*peersRatingTracker)(nil)
+
+const (
+	minPeerRating  = -100
+	maxPeerRating  = 100
+	peerRatingSize = 4
+)
+
+// ArgsPeersRatingTracker is the DTO used to create a new instance of peersRatingTracker
+type ArgsPeersRatingTracker struct {
+	PeersRatingHandler p2p.PeersRatingHandler
+	TopRatedCache      storage.Cacher
+	BadRatedCache      storage.Cacher
+}
+
+// peersRatingTracker wraps the peers rating handler, serializing all the changes of the ratings caches, including the
+// imported ratings, and keeping the moment of the last update of each rating
+type peersRatingTracker struct {
+	mut                sync.RWMutex
+	peersRatingHandler p2p.PeersRatingHandler
+	topRatedCache      storage.Cacher
+	badRatedCache      storage.Cacher
+	lastUpdates        map[core.PeerID]int64
+	getTimeHandler     func() time.Time
+}
+
+// NewPeersRatingTracker creates a new instance of peersRatingTracker
+func NewPeersRatingTracker(args ArgsPeersRatingTracker) (*peersRatingTracker, error) {
+	if check.IfNil(args.PeersRatingHandler) {
+		return nil, process.ErrNilPeersRatingHandler
+	}
+	if check.IfNil(args.TopRatedCache) {
+		return nil, fmt.Errorf("%w for TopRatedCache", process.ErrNilCacher)
+	}
+	if check.IfNil(args.BadRatedCache) {
+		return nil, fmt.Errorf("%w for BadRatedCache", process.ErrNilCacher)
+	}
+
+	return &peersRatingTracker{
+		peersRatingHandler: args.PeersRatingHandler,
+		topRatedCache:      args.TopRatedCache,
+		badRatedCache:      args.BadRatedCache,
+		lastUpdates:        make(map[core.PeerID]int64),
+		getTimeHandler:     time.Now,
+	}, nil
+}
+
+// IncreaseRating increases the rating of a peer
+func (prt *peersRatingTracker) IncreaseRating(pid core.PeerID) {
+	prt.mut.Lock()
+	defer prt.mut.Unlock()
+
+	prt.peersRatingHandler.IncreaseRating(pid)
+	prt.setLastUpdate(pid, prt.getTimeHandler().Unix())
+}
+
+// DecreaseRating decreases the rating of a peer
+func (prt *peersRatingTracker) DecreaseRating(pid core.PeerID) {
+	prt.mut.Lock()
+	defer prt.mut.Unlock()
+
+	prt.peersRatingHandler.DecreaseRating(pid)
+	prt.setLastUpdate(pid, prt.getTimeHandler().Unix())
+}
+
+// GetTopRatedPeersFromList returns a list of peers, searching them in the order of rating tiers. The peers without
+// a rating are added with the default rating
+func (prt *peersRatingTracker) GetTopRatedPeersFromList(peers []core.PeerID, minNumOfPeersExpected int) []core.PeerID {
+	prt.mut.Lock()
+	defer prt.mut.Unlock()
+
+	topRatedPeers := prt.peersRatingHandler.GetTopRatedPeersFromList(peers, minNumOfPeersExpected)
+
+	now := prt.getTimeHandler().Unix()
+	for _, pid := range peers {
+		_, isTracked := prt.lastUpdates[pid]
+		if !isTracked {
+			prt.setLastUpdate(pid, now)
+		}
+	}
+
+	return topRatedPeers
+}
+
+// GetRatings returns the current ratings together with the moment of their last update
+func (prt *peersRatingTracker) GetRatings() []*common.PeerRatingInfo {
+	prt.mut.RLock()
+	defer prt.mut.RUnlock()
+
+	now := prt.getTimeHandler().Unix()
+	ratings := make([]*common.PeerRatingInfo, 0)
+	ratings = append(ratings, prt.getRatingsFromCache(prt.topRatedCache, now)...)
+	ratings = append(ratings, prt.getRatingsFromCache(prt.badRatedCache, now)...)
+
+	return ratings
+}
+
+func (prt *peersRatingTracker) getRatingsFromCache(cacher storage.Cacher, now int64) []*common.PeerRatingInfo {
+	keys := cacher.Keys()
+	ratings := make([]*common.PeerRatingInfo, 0, len(keys))
+	for _, key := range keys {
+		value, found := cacher.Peek(key)
+		if !found {
+			continue
+		}
+
+		rating, ok := value.(int32)
+		if !ok {
+			continue
+		}
+
+		pid := core.PeerID(key)
+		lastUpdate, ok := prt.lastUpdates[pid]
+		if !ok {
+			lastUpdate = now
+		}
+
+		ratings = append(ratings, &common.PeerRatingInfo{
+			Pid:        pid,
+			Rating:     rating,
+			LastUpdate: lastUpdate,
+		})
+	}
+
+	return ratings
+}
+
+// SetRating overwrites the rating of a peer, keeping the provided moment of its last update
+func (prt *peersRatingTracker) SetRating(pid core.PeerID, rating int32, lastUpdate int64) {
+	if rating > maxPeerRating {
+		rating = maxPeerRating
+	}
+	if rating < minPeerRating {
+		rating = minPeerRating
+	}
+
+	prt.mut.Lock()
+	defer prt.mut.Unlock()
+
+	// same tiers as the ones used by the peers rating handler
+	if rating >= 0 {
+		prt.badRatedCache.Remove(pid.Bytes())
+		prt.topRatedCache.Put(pid.Bytes(), rating, peerRatingSize)
+	} else {
+		prt.topRatedCache.Remove(pid.Bytes())
+		prt.badRatedCache.Put(pid.Bytes(), rating, peerRatingSize)
+	}
+
+	prt.setLastUpdate(pid, lastUpdate)
+}
+
+// setLastUpdate records the moment of the last update of the provided peer. The records of the peers evicted from
+// the ratings caches are removed once there are twice as many records as the caches can hold
+func (prt *peersRatingTracker) setLastUpdate(pid core.PeerID, lastUpdate int64) {
+	prt.lastUpdates[pid] = lastUpdate
+
+	maxRecords := 2 * (prt.topRatedCache.MaxSize() + prt.badRatedCache.MaxSize())
+	if len(prt.lastUpdates) <= maxRecords {
+		return
+	}
+
+	for trackedPid := range prt.lastUpdates {
+		isRated := prt.topRatedCache.Has(trackedPid.Bytes()) || prt.badRatedCache.Has(trackedPid.Bytes())
+		if !isRated {
+			delete(prt.lastUpdates, trackedPid)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prt *peersRatingTracker) IsInterfaceNil() bool {
+	return prt == nil
+}
@@ -0,0 +1,188 @@
+package blackList_test
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-communication-go/p2p/rating"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/blackList"
+	"github.com/multiversx/mx-chain-go/storage/cache"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsPeersRatingTracker() blackList.ArgsPeersRatingTracker {
+	topRatedCache, _ := cache.NewLRUCache(100)
+	badRatedCache, _ := cache.NewLRUCache(100)
+
+	return blackList.ArgsPeersRatingTracker{
+		PeersRatingHandler: &p2pmocks.PeersRatingHandlerStub{},
+		TopRatedCache:      topRatedCache,
+		BadRatedCache:      badRatedCache,
+	}
+}
+
+func TestNewPeersRatingTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil peers rating handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersRatingTracker()
+		args.PeersRatingHandler = nil
+		prt, err := blackList.NewPeersRatingTracker(args)
+		assert.Equal(t, process.ErrNilPeersRatingHandler, err)
+		assert.True(t, check.IfNil(prt))
+	})
+	t.Run("nil top rated cache should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersRatingTracker()
+		args.TopRatedCache = nil
+		prt, err := blackList.NewPeersRatingTracker(args)
+		assert.True(t, errors.Is(err, process.ErrNilCacher))
+		assert.True(t, strings.Contains(err.Error(), "TopRatedCache"))
+		assert.True(t, check.IfNil(prt))
+	})
+	t.Run("nil bad rated cache should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersRatingTracker()
+		args.BadRatedCache = nil
+		prt, err := blackList.NewPeersRatingTracker(args)
+		assert.True(t, errors.Is(err, process.ErrNilCacher))
+		assert.True(t, strings.Contains(err.Error(), "BadRatedCache"))
+		assert.True(t, check.IfNil(prt))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		prt, err := blackList.NewPeersRatingTracker(createMockArgsPeersRatingTracker())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(prt))
+	})
+}
+
+func TestPeersRatingTracker_RatingsUpdatesShouldRecordTheLastUpdate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	args := createMockArgsPeersRatingTracker()
+	increasedPids := make([]core.PeerID, 0)
+	decreasedPids := make([]core.PeerID, 0)
+	args.PeersRatingHandler = &p2pmocks.PeersRatingHandlerStub{
+		IncreaseRatingCalled: func(pid core.PeerID) {
+			increasedPids = append(increasedPids, pid)
+			args.TopRatedCache.Put(pid.Bytes(), int32(2), 4)
+		},
+		DecreaseRatingCalled: func(pid core.PeerID) {
+			decreasedPids = append(decreasedPids, pid)
+			args.BadRatedCache.Put(pid.Bytes(), int32(-1), 4)
+		},
+		GetTopRatedPeersFromListCalled: func(peers []core.PeerID, numOfPeers int) []core.PeerID {
+			for _, pid := range peers {
+				args.TopRatedCache.Put(pid.Bytes(), int32(0), 4)
+			}
+			return peers
+		},
+	}
+	prt, _ := blackList.NewPeersRatingTracker(args)
+	prt.SetGetTimeHandler(func() time.Time {
+		return now
+	})
+
+	prt.IncreaseRating("pid1")
+	now = time.Unix(1100, 0)
+	prt.DecreaseRating("pid2")
+	now = time.Unix(1200, 0)
+	topRatedPeers := prt.GetTopRatedPeersFromList([]core.PeerID{"pid3"}, 1)
+	now = time.Unix(1300, 0)
+
+	assert.Equal(t, []core.PeerID{"pid1"}, increasedPids)
+	assert.Equal(t, []core.PeerID{"pid2"}, decreasedPids)
+	assert.Equal(t, []core.PeerID{"pid3"}, topRatedPeers)
+
+	expectedRatings := []*common.PeerRatingInfo{
+		{Pid: "pid1", Rating: 2, LastUpdate: 1000},
+		{Pid: "pid2", Rating: -1, LastUpdate: 1100},
+		{Pid: "pid3", Rating: 0, LastUpdate: 1200},
+	}
+	assert.ElementsMatch(t, expectedRatings, prt.GetRatings())
+}
+
+func TestPeersRatingTracker_SetRating(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPeersRatingTracker()
+	prt, _ := blackList.NewPeersRatingTracker(args)
+
+	prt.SetRating("pid1", -500, 1000)
+	rating, _ := args.BadRatedCache.Get([]byte("pid1"))
+	assert.Equal(t, int32(-100), rating)
+
+	prt.SetRating("pid1", 500, 1100)
+	assert.False(t, args.BadRatedCache.Has([]byte("pid1")))
+	rating, _ = args.TopRatedCache.Get([]byte("pid1"))
+	assert.Equal(t, int32(100), rating)
+
+	prt.SetRating("pid1", -5, 1200)
+	assert.False(t, args.TopRatedCache.Has([]byte("pid1")))
+
+	expectedRatings := []*common.PeerRatingInfo{
+		{Pid: "pid1", Rating: -5, LastUpdate: 1200},
+	}
+	assert.Equal(t, expectedRatings, prt.GetRatings())
+}
+
+func TestPeersRatingTracker_ConcurrentOperationsShouldKeepEachPeerInASingleTier(t *testing.T) {
+	t.Parallel()
+
+	topRatedCache, _ := cache.NewLRUCache(100)
+	badRatedCache, _ := cache.NewLRUCache(100)
+	peersRatingHandler, err := rating.NewPeersRatingHandler(rating.ArgPeersRatingHandler{
+		TopRatedCache: topRatedCache,
+		BadRatedCache: badRatedCache,
+		Logger:        &testscommon.LoggerStub{},
+	})
+	require.Nil(t, err)
+	prt, _ := blackList.NewPeersRatingTracker(blackList.ArgsPeersRatingTracker{
+		PeersRatingHandler: peersRatingHandler,
+		TopRatedCache:      topRatedCache,
+		BadRatedCache:      badRatedCache,
+	})
+
+	pid := core.PeerID("pid")
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			switch idx % 4 {
+			case 0:
+				prt.IncreaseRating(pid)
+			case 1:
+				prt.DecreaseRating(pid)
+			case 2:
+				prt.SetRating(pid, int32(idx%200-100), int64(idx))
+			default:
+				_ = prt.GetRatings()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	isInBothTiers := topRatedCache.Has(pid.Bytes()) && badRatedCache.Has(pid.Bytes())
+	assert.False(t, isInBothTiers)
+	assert.Equal(t, 1, len(prt.GetRatings()))
+}
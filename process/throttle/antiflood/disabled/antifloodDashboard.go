@@ -0,0 +1,55 @@
+package disabled
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+var _ process.AntifloodDashboardHandler = (*AntifloodDashboard)(nil)
+
+// AntifloodDashboard is a disabled instance of the antiflood dashboard, used when the antiflood is not enabled
+type AntifloodDashboard struct {
+}
+
+// GetQuotasStatus returns an empty slice
+func (ad *AntifloodDashboard) GetQuotasStatus() []*common.AntifloodQuotaStatus {
+	return make([]*common.AntifloodQuotaStatus, 0)
+}
+
+// GetTopicsStatus returns an empty slice
+func (ad *AntifloodDashboard) GetTopicsStatus() []*common.AntifloodTopicStatus {
+	return make([]*common.AntifloodTopicStatus, 0)
+}
+
+// GetBlacklistedPeers returns an empty slice
+func (ad *AntifloodDashboard) GetBlacklistedPeers() []*common.BlacklistedPeer {
+	return make([]*common.BlacklistedPeer, 0)
+}
+
+// SetMaxQuota returns ErrAntifloodDisabled
+func (ad *AntifloodDashboard) SetMaxQuota(_ string, _ uint32, _ uint64) error {
+	return process.ErrAntifloodDisabled
+}
+
+// SetMaxMessagesForTopic returns ErrAntifloodDisabled
+func (ad *AntifloodDashboard) SetMaxMessagesForTopic(_ string, _ uint32) error {
+	return process.ErrAntifloodDisabled
+}
+
+// BlacklistPeer returns ErrAntifloodDisabled
+func (ad *AntifloodDashboard) BlacklistPeer(_ core.PeerID, _ time.Duration) error {
+	return process.ErrAntifloodDisabled
+}
+
+// RemoveBlacklistedPeer returns ErrAntifloodDisabled
+func (ad *AntifloodDashboard) RemoveBlacklistedPeer(_ core.PeerID) error {
+	return process.ErrAntifloodDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ad *AntifloodDashboard) IsInterfaceNil() bool {
+	return ad == nil
+}
@@ -0,0 +1,30 @@
+package disabled
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAntifloodDashboard_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r, "this shouldn't panic")
+	}()
+
+	ad := &AntifloodDashboard{}
+	assert.False(t, check.IfNil(ad))
+
+	assert.Empty(t, ad.GetQuotasStatus())
+	assert.Empty(t, ad.GetTopicsStatus())
+	assert.Empty(t, ad.GetBlacklistedPeers())
+	assert.Equal(t, process.ErrAntifloodDisabled, ad.SetMaxQuota("name", 1, 1))
+	assert.Equal(t, process.ErrAntifloodDisabled, ad.SetMaxMessagesForTopic("topic", 1))
+	assert.Equal(t, process.ErrAntifloodDisabled, ad.BlacklistPeer("pid", time.Second))
+	assert.Equal(t, process.ErrAntifloodDisabled, ad.RemoveBlacklistedPeer("pid"))
+}
@@ -2,6 +2,7 @@ package disabled
 
 import (
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 )
 
@@ -26,6 +27,10 @@ func (ntfp *nilTopicFloodPreventer) IncreaseLoad(_ core.PeerID, _ string, _ uint
 func (ntfp *nilTopicFloodPreventer) ResetForTopic(_ string) {
 }
 
+// ResetForRegisteredTopics does nothing
+func (ntfp *nilTopicFloodPreventer) ResetForRegisteredTopics() {
+}
+
 // ResetForNotRegisteredTopics does nothing
 func (ntfp *nilTopicFloodPreventer) ResetForNotRegisteredTopics() {
 }
@@ -34,6 +39,11 @@ func (ntfp *nilTopicFloodPreventer) ResetForNotRegisteredTopics() {
 func (ntfp *nilTopicFloodPreventer) SetMaxMessagesForTopic(_ string, _ uint32) {
 }
 
+// GetTopicsStatus returns an empty slice
+func (ntfp *nilTopicFloodPreventer) GetTopicsStatus() []*common.AntifloodTopicStatus {
+	return make([]*common.AntifloodTopicStatus, 0)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ntfp *nilTopicFloodPreventer) IsInterfaceNil() bool {
 	return ntfp == nil
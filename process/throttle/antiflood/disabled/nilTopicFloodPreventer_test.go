@@ -32,4 +32,5 @@ func TestNilTopicFloodPreventer_FunctionsShouldNotPanic(t *testing.T) {
 	ntfp.ResetForTopic("")
 	ntfp.SetMaxMessagesForTopic("", 0)
 	assert.Nil(t, ntfp.IncreaseLoad("", "", math.MaxUint32))
+	assert.Empty(t, ntfp.GetTopicsStatus())
 }
@@ -7,7 +7,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 )
 
-var _ process.PeerBlackListCacher = (*PeerBlacklistCacher)(nil)
+var _ process.PeerBlackListManager = (*PeerBlacklistCacher)(nil)
 
 // PeerBlacklistCacher is a mock implementation of PeerBlacklistHandler that does not manage black listed keys
 // (all keys [peers] are whitelisted)
@@ -28,6 +28,15 @@ func (pbc *PeerBlacklistCacher) Has(_ core.PeerID) bool {
 	return false
 }
 
+// Remove does nothing
+func (pbc *PeerBlacklistCacher) Remove(_ core.PeerID) {
+}
+
+// GetBlacklistedPeers returns an empty map
+func (pbc *PeerBlacklistCacher) GetBlacklistedPeers() map[core.PeerID]time.Time {
+	return make(map[core.PeerID]time.Time)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (pbc *PeerBlacklistCacher) IsInterfaceNil() bool {
 	return pbc == nil
@@ -26,4 +26,6 @@ func TestBlacklistHandler_ShouldNotPanic(t *testing.T) {
 	assert.Nil(t, err)
 
 	pbc.Sweep()
+	pbc.Remove("a")
+	assert.Empty(t, pbc.GetBlacklistedPeers())
 }
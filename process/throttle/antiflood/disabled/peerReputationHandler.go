@@ -0,0 +1,40 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+var _ process.PeerReputationHandler = (*PeerReputationHandler)(nil)
+
+// PeerReputationHandler is a disabled instance of the peer reputation handler, used when the persistence is not enabled
+type PeerReputationHandler struct {
+}
+
+// Export returns an empty snapshot
+func (prh *PeerReputationHandler) Export() *common.PeerReputationSnapshot {
+	return &common.PeerReputationSnapshot{
+		BlacklistedPeers: make([]*common.BlacklistedPeer, 0),
+		Ratings:          make([]*common.PeerRating, 0),
+	}
+}
+
+// Import returns ErrPeerReputationDisabled
+func (prh *PeerReputationHandler) Import(_ *common.PeerReputationSnapshot) error {
+	return process.ErrPeerReputationDisabled
+}
+
+// Save does nothing and returns nil
+func (prh *PeerReputationHandler) Save() error {
+	return nil
+}
+
+// Close does nothing and returns nil
+func (prh *PeerReputationHandler) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prh *PeerReputationHandler) IsInterfaceNil() bool {
+	return prh == nil
+}
@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerReputationHandler_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r, "this shouldn't panic")
+	}()
+
+	prh := &PeerReputationHandler{}
+	assert.False(t, check.IfNil(prh))
+
+	snapshot := prh.Export()
+	assert.Empty(t, snapshot.BlacklistedPeers)
+	assert.Empty(t, snapshot.Ratings)
+	assert.Equal(t, process.ErrPeerReputationDisabled, prh.Import(&common.PeerReputationSnapshot{}))
+	assert.Nil(t, prh.Save())
+	assert.Nil(t, prh.Close())
+}
@@ -35,10 +35,11 @@ var durationSweepP2PBlacklist = time.Second * 5
 // AntiFloodComponents holds the handlers for the anti-flood and blacklist mechanisms
 type AntiFloodComponents struct {
 	AntiFloodHandler process.P2PAntifloodHandler
-	BlacklistHandler process.PeerBlackListCacher
+	BlacklistHandler process.PeerBlackListManager
 	FloodPreventers  []process.FloodPreventer
 	TopicPreventer   process.TopicFloodPreventer
 	PubKeysCacher    process.TimeCacher
+	Dashboard        process.AntifloodDashboardHandler
 }
 
 // NewP2PAntiFloodComponents will return instances of antiflood and blacklist, based on the config
@@ -56,6 +57,7 @@ func NewP2PAntiFloodComponents(ctx context.Context, config config.Config, status
 		FloodPreventers:  make([]process.FloodPreventer, 0),
 		TopicPreventer:   disabled.NewNilTopicFloodPreventer(),
 		PubKeysCacher:    &disabled.TimeCache{},
+		Dashboard:        &disabled.AntifloodDashboard{},
 	}, nil
 }
 
@@ -65,12 +67,7 @@ func initP2PAntiFloodComponents(
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
 ) (*AntiFloodComponents, error) {
-	timeCache := cache.NewTimeCache(defaultSpan)
-	p2pPeerBlackList, err := cache.NewPeerTimeCache(timeCache)
-	if err != nil {
-		return nil, err
-	}
-
+	p2pPeerBlackList := blackList.NewPeerBlackListCache()
 	publicKeysCache := cache.NewTimeCache(defaultSpan)
 
 	fastReactingFloodPreventer, err := createFloodPreventer(
@@ -120,6 +117,20 @@ func initP2PAntiFloodComponents(
 	topicMaxMessages := mainConfig.Antiflood.Topic.MaxMessages
 	setMaxMessages(topicFloodPreventer, topicMaxMessages)
 
+	argsDashboard := antiflood.ArgsAntifloodDashboard{
+		FloodPreventers: []process.QuotaFloodPreventer{
+			fastReactingFloodPreventer,
+			slowReactingFloodPreventer,
+			outOfSpecsFloodPreventer,
+		},
+		TopicFloodPreventer: topicFloodPreventer,
+		BlackListHandler:    p2pPeerBlackList,
+	}
+	dashboard, err := antiflood.NewAntifloodDashboard(argsDashboard)
+	if err != nil {
+		return nil, err
+	}
+
 	p2pAntiflood, err := antiflood.NewP2PAntiflood(
 		p2pPeerBlackList,
 		topicFloodPreventer,
@@ -143,7 +154,7 @@ func initP2PAntiFloodComponents(
 		}
 	}
 
-	startResettingTopicFloodPreventer(ctx, topicFloodPreventer, topicMaxMessages)
+	startResettingTopicFloodPreventer(ctx, topicFloodPreventer)
 	startSweepingTimeCaches(ctx, p2pPeerBlackList, publicKeysCache)
 
 	return &AntiFloodComponents{
@@ -156,6 +167,7 @@ func initP2PAntiFloodComponents(
 			outOfSpecsFloodPreventer,
 		},
 		TopicPreventer: topicFloodPreventer,
+		Dashboard:      dashboard,
 	}, nil
 }
 
@@ -168,12 +180,8 @@ func setMaxMessages(topicFloodPreventer process.TopicFloodPreventer, topicMaxMes
 func startResettingTopicFloodPreventer(
 	ctx context.Context,
 	topicFloodPreventer process.TopicFloodPreventer,
-	topicMaxMessages []config.TopicMaxMessagesConfig,
 	floodPreventers ...process.FloodPreventer,
 ) {
-	localTopicMaxMessages := make([]config.TopicMaxMessagesConfig, len(topicMaxMessages))
-	copy(localTopicMaxMessages, topicMaxMessages)
-
 	go func() {
 		for {
 			select {
@@ -186,9 +194,7 @@ func startResettingTopicFloodPreventer(
 			for _, fp := range floodPreventers {
 				fp.Reset()
 			}
-			for _, topicMaxMsg := range localTopicMaxMessages {
-				topicFloodPreventer.ResetForTopic(topicMaxMsg.Topic)
-			}
+			topicFloodPreventer.ResetForRegisteredTopics()
 			topicFloodPreventer.ResetForNotRegisteredTopics()
 		}
 	}()
@@ -218,7 +224,7 @@ func createFloodPreventer(
 	quotaIdentifier string,
 	blackListHandler process.PeerBlackListCacher,
 	selfPid core.PeerID,
-) (process.FloodPreventer, error) {
+) (process.QuotaFloodPreventer, error) {
 	cacheConfig := storageFactory.GetCacherFromConfig(antifloodCacheConfig)
 	blackListCache, err := storageunit.NewCache(cacheConfig)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const currentPid = core.PeerID("current pid")
@@ -42,9 +43,11 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(
 	_, ok1 := components.AntiFloodHandler.(*disabled.AntiFlood)
 	_, ok2 := components.BlacklistHandler.(*disabled.PeerBlacklistCacher)
 	_, ok3 := components.PubKeysCacher.(*disabled.TimeCache)
+	_, ok4 := components.Dashboard.(*disabled.AntifloodDashboard)
 	assert.True(t, ok1)
 	assert.True(t, ok2)
 	assert.True(t, ok3)
+	assert.True(t, ok4)
 }
 
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *testing.T) {
@@ -74,12 +77,52 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *tes
 	assert.NotNil(t, components.AntiFloodHandler)
 	assert.NotNil(t, components.BlacklistHandler)
 	assert.NotNil(t, components.PubKeysCacher)
+	assert.NotNil(t, components.Dashboard)
+	assert.Equal(t, 3, len(components.Dashboard.GetQuotasStatus()))
 
 	// we need this time sleep as to allow the code coverage tool to deterministically compute the code coverage
 	//on the go routines that are automatically launched
 	time.Sleep(time.Second * 2)
 }
 
+func TestNewP2PAntiFloodAndBlackList_TopicLimitSetAtRuntimeShouldBeReset(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{
+		Antiflood: config.AntifloodConfig{
+			Enabled: true,
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 10,
+				Shards:   2,
+			},
+			FastReacting: createFloodPreventerConfig(),
+			SlowReacting: createFloodPreventerConfig(),
+			OutOfSpecs:   createFloodPreventerConfig(),
+			Topic: config.TopicAntifloodConfig{
+				DefaultMaxMessagesPerSec: 10,
+			},
+		},
+	}
+
+	ash := statusHandler.NewAppStatusHandlerMock()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid)
+	require.Nil(t, err)
+
+	topic := "topic set at runtime"
+	err = components.Dashboard.SetMaxMessagesForTopic(topic, 1)
+	require.Nil(t, err)
+
+	err = components.TopicPreventer.IncreaseLoad(currentPid, topic, 2)
+	require.NotNil(t, err)
+
+	assert.Eventually(t, func() bool {
+		return components.TopicPreventer.IncreaseLoad(currentPid, topic, 1) == nil
+	}, time.Second*3, time.Millisecond*100)
+}
+
 func createFloodPreventerConfig() config.FloodPreventerConfig {
 	return config.FloodPreventerConfig{
 		IntervalInSeconds: 1,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
 )
@@ -22,7 +23,7 @@ type ArgQuotaFloodPreventer struct {
 	BaseMaxNumMessagesPerPeer uint32
 }
 
-var _ process.FloodPreventer = (*quotaFloodPreventer)(nil)
+var _ process.QuotaFloodPreventer = (*quotaFloodPreventer)(nil)
 
 const minMessages = 1
 const minTotalSize = 1 //1Byte
@@ -68,19 +69,9 @@ func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, e
 			return nil, process.ErrNilQuotaStatusHandler
 		}
 	}
-	if arg.BaseMaxNumMessagesPerPeer < minMessages {
-		return nil, fmt.Errorf("%w, maxMessagesPerPeer: provided %d, minimum %d",
-			process.ErrInvalidValue,
-			arg.BaseMaxNumMessagesPerPeer,
-			minMessages,
-		)
-	}
-	if arg.MaxTotalSizePerPeer < minTotalSize {
-		return nil, fmt.Errorf("%w, maxTotalSizePerPeer: provided %d, minimum %d",
-			process.ErrInvalidValue,
-			arg.MaxTotalSizePerPeer,
-			minTotalSize,
-		)
+	err := checkQuotaValues(arg.BaseMaxNumMessagesPerPeer, arg.MaxTotalSizePerPeer)
+	if err != nil {
+		return nil, err
 	}
 	if arg.PercentReserved > maxPercentReserved {
 		return nil, fmt.Errorf("%w, percentReserved: provided %0.3f, maximum %0.3f",
@@ -116,6 +107,25 @@ func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, e
 	}, nil
 }
 
+func checkQuotaValues(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	if baseMaxNumMessagesPerPeer < minMessages {
+		return fmt.Errorf("%w, maxMessagesPerPeer: provided %d, minimum %d",
+			process.ErrInvalidValue,
+			baseMaxNumMessagesPerPeer,
+			minMessages,
+		)
+	}
+	if maxTotalSizePerPeer < minTotalSize {
+		return fmt.Errorf("%w, maxTotalSizePerPeer: provided %d, minimum %d",
+			process.ErrInvalidValue,
+			maxTotalSizePerPeer,
+			minTotalSize,
+		)
+	}
+
+	return nil
+}
+
 // IncreaseLoad tries to increment the counter values held at "pid" position
 // It returns true if it had succeeded incrementing (existing counter value is lower or equal with provided maxOperations)
 // We need the mutOperation here as the get and put should be done atomically.
@@ -266,6 +276,75 @@ func (qfp *quotaFloodPreventer) ApplyConsensusSize(size int) {
 	)
 }
 
+// Name returns the name of the flood preventer
+func (qfp *quotaFloodPreventer) Name() string {
+	return qfp.name
+}
+
+// GetQuotaStatus returns the current limits and the quotas of all peers measured in the current interval
+func (qfp *quotaFloodPreventer) GetQuotaStatus() *common.AntifloodQuotaStatus {
+	qfp.mutOperation.RLock()
+	defer qfp.mutOperation.RUnlock()
+
+	status := &common.AntifloodQuotaStatus{
+		Name:                          qfp.name,
+		BaseMaxNumMessagesPerPeer:     qfp.baseMaxNumMessagesPerPeer,
+		ComputedMaxNumMessagesPerPeer: qfp.computedMaxNumMessagesPerPeer,
+		MaxTotalSizePerPeer:           qfp.maxTotalSizePerPeer,
+		PercentReserved:               qfp.percentReserved,
+		Peers:                         make([]*common.AntifloodPeerQuota, 0),
+	}
+
+	keys := qfp.cacher.Keys()
+	for _, k := range keys {
+		val, ok := qfp.cacher.Peek(k)
+		if !ok {
+			continue
+		}
+
+		q, isQuota := val.(*quota)
+		if !isQuota {
+			continue
+		}
+
+		status.Peers = append(status.Peers, &common.AntifloodPeerQuota{
+			Pid:                   core.PeerID(k).Pretty(),
+			NumReceivedMessages:   q.numReceivedMessages,
+			SizeReceivedMessages:  q.sizeReceivedMessages,
+			NumProcessedMessages:  q.numProcessedMessages,
+			SizeProcessedMessages: q.sizeProcessedMessages,
+		})
+	}
+
+	return status
+}
+
+// SetMaxQuota changes the base maximum number of messages and the maximum total size that can be received from a peer.
+// The increase computed from the consensus size, if any, is preserved on top of the new base value
+func (qfp *quotaFloodPreventer) SetMaxQuota(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	err := checkQuotaValues(baseMaxNumMessagesPerPeer, maxTotalSizePerPeer)
+	if err != nil {
+		return err
+	}
+
+	qfp.mutOperation.Lock()
+	defer qfp.mutOperation.Unlock()
+
+	consensusIncrease := qfp.computedMaxNumMessagesPerPeer - qfp.baseMaxNumMessagesPerPeer
+	qfp.baseMaxNumMessagesPerPeer = baseMaxNumMessagesPerPeer
+	qfp.computedMaxNumMessagesPerPeer = baseMaxNumMessagesPerPeer + consensusIncrease
+	qfp.maxTotalSizePerPeer = maxTotalSizePerPeer
+
+	log.Debug("quotaFloodPreventer.SetMaxQuota",
+		"name", qfp.name,
+		"base", qfp.baseMaxNumMessagesPerPeer,
+		"computed", qfp.computedMaxNumMessagesPerPeer,
+		"max total size", qfp.maxTotalSizePerPeer,
+	)
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (qfp *quotaFloodPreventer) IsInterfaceNil() bool {
 	return qfp == nil
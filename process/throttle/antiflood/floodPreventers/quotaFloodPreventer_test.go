@@ -488,3 +488,63 @@ func TestQuotaFloodPreventer_ApplyConsensusShouldWork(t *testing.T) {
 	err := qfp.IncreaseLoad(identifier, 0)
 	assert.NotNil(t, err)
 }
+
+//------- SetMaxQuota & GetQuotaStatus
+
+func TestQuotaFloodPreventer_SetMaxQuotaInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	qfp, _ := NewQuotaFloodPreventer(createDefaultArgument())
+
+	err := qfp.SetMaxQuota(minMessages-1, minTotalSize)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	err = qfp.SetMaxQuota(minMessages, minTotalSize-1)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestQuotaFloodPreventer_SetMaxQuotaShouldKeepTheConsensusIncrease(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.BaseMaxNumMessagesPerPeer = 2000
+	arg.IncreaseThreshold = 1000
+	arg.IncreaseFactor = 0.25
+	qfp, _ := NewQuotaFloodPreventer(arg)
+	qfp.ApplyConsensusSize(2000)
+
+	err := qfp.SetMaxQuota(3000, 4000)
+	assert.Nil(t, err)
+
+	status := qfp.GetQuotaStatus()
+	assert.Equal(t, uint32(3000), status.BaseMaxNumMessagesPerPeer)
+	assert.Equal(t, uint32(3250), status.ComputedMaxNumMessagesPerPeer)
+	assert.Equal(t, uint64(4000), status.MaxTotalSizePerPeer)
+}
+
+func TestQuotaFloodPreventer_GetQuotaStatusShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.Cacher = testscommon.NewCacherMock()
+	arg.BaseMaxNumMessagesPerPeer = 100
+	arg.MaxTotalSizePerPeer = 1000
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	identifier := core.PeerID("identifier")
+	_ = qfp.IncreaseLoad(identifier, 10)
+	_ = qfp.IncreaseLoad(identifier, 20)
+
+	status := qfp.GetQuotaStatus()
+	assert.Equal(t, arg.Name, status.Name)
+	assert.Equal(t, arg.BaseMaxNumMessagesPerPeer, status.BaseMaxNumMessagesPerPeer)
+	assert.Equal(t, arg.BaseMaxNumMessagesPerPeer, status.ComputedMaxNumMessagesPerPeer)
+	assert.Equal(t, arg.MaxTotalSizePerPeer, status.MaxTotalSizePerPeer)
+	assert.Equal(t, arg.PercentReserved, status.PercentReserved)
+	assert.Equal(t, 1, len(status.Peers))
+	assert.Equal(t, identifier.Pretty(), status.Peers[0].Pid)
+	assert.Equal(t, uint32(2), status.Peers[0].NumReceivedMessages)
+	assert.Equal(t, uint64(30), status.Peers[0].SizeReceivedMessages)
+	assert.Equal(t, uint32(2), status.Peers[0].NumProcessedMessages)
+	assert.Equal(t, uint64(30), status.Peers[0].SizeProcessedMessages)
+}
@@ -2,10 +2,12 @@ package floodPreventers
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-logger-go"
 )
@@ -74,11 +76,30 @@ func (tfp *topicFloodPreventer) IncreaseLoad(pid core.PeerID, topic string, numM
 func (tfp *topicFloodPreventer) SetMaxMessagesForTopic(topic string, numMessages uint32) {
 	log.Debug("SetMaxMessagesForTopic", "topic", topic, "num messages", numMessages)
 	tfp.mutTopicMaxMessages.Lock()
+	if strings.Contains(topic, WildcardCharacter) {
+		tfp.removeCachedMaxMessagesForWildcard(topic)
+	}
 	tfp.topicMaxMessages[topic] = numMessages
 	tfp.registeredTopics[topic] = struct{}{}
 	tfp.mutTopicMaxMessages.Unlock()
 }
 
+// removeCachedMaxMessagesForWildcard removes the limits resolved from a wildcard topic and cached for the concrete
+// topics matching the provided wildcard topic, so the new limit will be applied on them as well
+func (tfp *topicFloodPreventer) removeCachedMaxMessagesForWildcard(topic string) {
+	topicWithoutWildcard := strings.Replace(topic, WildcardCharacter, "", 1)
+	for topicKey := range tfp.topicMaxMessages {
+		_, isRegistered := tfp.registeredTopics[topicKey]
+		if isRegistered {
+			continue
+		}
+
+		if strings.Contains(topicKey, topicWithoutWildcard) {
+			delete(tfp.topicMaxMessages, topicKey)
+		}
+	}
+}
+
 // ResetForTopic clears all map values for a given topic
 func (tfp *topicFloodPreventer) ResetForTopic(topic string) {
 	tfp.mutTopicMaxMessages.Lock()
@@ -90,6 +111,20 @@ func (tfp *topicFloodPreventer) ResetForTopic(topic string) {
 	tfp.counterMap[topic] = make(map[core.PeerID]uint32)
 }
 
+// ResetForRegisteredTopics resets the counters of all the topics that have a maximum number of messages set,
+// either from the config or at runtime
+func (tfp *topicFloodPreventer) ResetForRegisteredTopics() {
+	tfp.mutTopicMaxMessages.Lock()
+	defer tfp.mutTopicMaxMessages.Unlock()
+
+	for topic := range tfp.registeredTopics {
+		if strings.Contains(topic, WildcardCharacter) {
+			tfp.resetTopicWithWildCard(topic)
+		}
+		tfp.counterMap[topic] = make(map[core.PeerID]uint32)
+	}
+}
+
 // ResetForNotRegisteredTopics resets all topic counters that were not registered
 // This will prevent some unregistered topics counters to overflow and thus, causing the stopping of messages flow
 func (tfp *topicFloodPreventer) ResetForNotRegisteredTopics() {
@@ -158,6 +193,46 @@ func (tfp *topicFloodPreventer) maxMessagesForTopicWildcard(topic string) uint32
 	return tfp.defaultMaxMessagesPerPeer
 }
 
+// GetTopicsStatus returns, for each topic, the maximum number of messages accepted from a peer and the number of
+// messages each peer sent in the current interval. The result is sorted by topic
+func (tfp *topicFloodPreventer) GetTopicsStatus() []*common.AntifloodTopicStatus {
+	tfp.mutTopicMaxMessages.RLock()
+	defer tfp.mutTopicMaxMessages.RUnlock()
+
+	topics := make(map[string]struct{})
+	for topic := range tfp.registeredTopics {
+		topics[topic] = struct{}{}
+	}
+	for topic := range tfp.counterMap {
+		topics[topic] = struct{}{}
+	}
+
+	result := make([]*common.AntifloodTopicStatus, 0, len(topics))
+	for topic := range topics {
+		maxMessages, ok := tfp.topicMaxMessages[topic]
+		if !ok {
+			maxMessages = tfp.maxMessagesForTopicWildcard(topic)
+		}
+
+		topicStatus := &common.AntifloodTopicStatus{
+			Topic:              topic,
+			MaxMessagesPerPeer: maxMessages,
+			Peers:              make(map[string]uint32),
+		}
+		for pid, numMessages := range tfp.counterMap[topic] {
+			topicStatus.Peers[pid.Pretty()] = numMessages
+		}
+
+		result = append(result, topicStatus)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Topic < result[j].Topic
+	})
+
+	return result
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tfp *topicFloodPreventer) IsInterfaceNil() bool {
 	return tfp == nil
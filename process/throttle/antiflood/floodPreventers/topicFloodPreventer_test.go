@@ -245,6 +245,64 @@ func TestTopicFloodPreventer_MaxMessagesOnWildcardTopicCachesTheValue(t *testing
 	assert.True(t, ok)
 }
 
+func TestTopicFloodPreventer_SetMaxMessagesOnWildcardTopicAfterTrafficShouldApplyOnSeenTopics(t *testing.T) {
+	t.Parallel()
+
+	defaultMaxMessages := uint32(2)
+	tfp, _ := floodPreventers.NewTopicFloodPreventer(defaultMaxMessages)
+
+	headersTopic := "headers"
+	shardHeadersTopic := headersTopic + "_0_META"
+	explicitHeadersTopic := headersTopic + "_1_META"
+	explicitMaxMessages := uint32(5)
+	tfp.SetMaxMessagesForTopic(headersTopic+floodPreventers.WildcardCharacter, 100)
+	tfp.SetMaxMessagesForTopic(explicitHeadersTopic, explicitMaxMessages)
+
+	id := core.PeerID("identifier")
+	err := tfp.IncreaseLoad(id, shardHeadersTopic, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(100), tfp.MaxMessagesForTopic(shardHeadersTopic))
+
+	tfp.SetMaxMessagesForTopic(headersTopic+floodPreventers.WildcardCharacter, 1)
+
+	assert.Equal(t, uint32(1), tfp.MaxMessagesForTopic(shardHeadersTopic))
+	assert.Equal(t, explicitMaxMessages, tfp.MaxMessagesForTopic(explicitHeadersTopic))
+	err = tfp.IncreaseLoad(id, shardHeadersTopic, 1)
+	assert.Equal(t, process.ErrSystemBusy, err)
+}
+
+func TestTopicFloodPreventer_ResetForRegisteredTopics(t *testing.T) {
+	t.Parallel()
+
+	defaultMaxMessages := uint32(2)
+	tfp, _ := floodPreventers.NewTopicFloodPreventer(defaultMaxMessages)
+
+	identifier := core.PeerID("pid")
+	headersTopic := "headers"
+	tfp.SetMaxMessagesForTopic(headersTopic, 100)
+	tfp.SetMaxMessagesForTopic("transactions"+floodPreventers.WildcardCharacter, 100)
+	transactionsTopic := "transactions_0"
+
+	unregisteredTopic := "unregistered topic"
+
+	err := tfp.IncreaseLoad(identifier, headersTopic, defaultMaxMessages)
+	assert.Nil(t, err)
+
+	err = tfp.IncreaseLoad(identifier, transactionsTopic, defaultMaxMessages)
+	assert.Nil(t, err)
+
+	err = tfp.IncreaseLoad(identifier, unregisteredTopic, defaultMaxMessages)
+	assert.Nil(t, err)
+
+	tfp.ResetForRegisteredTopics()
+
+	//registered topics should have been reset
+	assert.Equal(t, uint32(0), tfp.CountForTopicAndIdentifier(headersTopic, identifier))
+	assert.Equal(t, uint32(0), tfp.CountForTopicAndIdentifier(transactionsTopic, identifier))
+	//unregistered topic should not have been reset
+	assert.Equal(t, defaultMaxMessages, tfp.CountForTopicAndIdentifier(unregisteredTopic, identifier))
+}
+
 func TestTopicFloodPreventer_ResetForNotRegisteredTopics(t *testing.T) {
 	t.Parallel()
 
@@ -304,3 +362,33 @@ func TestTopicFloodPreventer_ResetForNotRegisteredTopicsWithWildcardShuldWork(t
 	err = tfp.IncreaseLoad(identifier, unregisteredTopic, defaultMaxMessages)
 	assert.Nil(t, err)
 }
+
+func TestTopicFloodPreventer_GetTopicsStatus(t *testing.T) {
+	t.Parallel()
+
+	tfp, _ := floodPreventers.NewTopicFloodPreventer(10)
+	tfp.SetMaxMessagesForTopic("topic_*", 20)
+	tfp.SetMaxMessagesForTopic("registered", 30)
+
+	pid := core.PeerID("pid")
+	_ = tfp.IncreaseLoad(pid, "topic_0", 2)
+	_ = tfp.IncreaseLoad(pid, "other", 3)
+
+	status := tfp.GetTopicsStatus()
+	assert.Equal(t, 4, len(status))
+
+	assert.Equal(t, "other", status[0].Topic)
+	assert.Equal(t, uint32(10), status[0].MaxMessagesPerPeer)
+	assert.Equal(t, map[string]uint32{pid.Pretty(): 3}, status[0].Peers)
+
+	assert.Equal(t, "registered", status[1].Topic)
+	assert.Equal(t, uint32(30), status[1].MaxMessagesPerPeer)
+	assert.Empty(t, status[1].Peers)
+
+	assert.Equal(t, "topic_*", status[2].Topic)
+	assert.Equal(t, uint32(20), status[2].MaxMessagesPerPeer)
+
+	assert.Equal(t, "topic_0", status[3].Topic)
+	assert.Equal(t, uint32(20), status[3].MaxMessagesPerPeer)
+	assert.Equal(t, map[string]uint32{pid.Pretty(): 2}, status[3].Peers)
+}
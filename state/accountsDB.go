@@ -951,6 +951,30 @@ func (adb *AccountsDB) recreateTrie(options common.RootHashHolder) error {
 	return nil
 }
 
+// GetRecreatedTrie returns the main trie, as recreated by the last RecreateTrie call
+func (adb *AccountsDB) GetRecreatedTrie() common.Trie {
+	return adb.getMainTrie()
+}
+
+// SetRecreatedTrie sets the provided trie, already recreated on the provided root hash, as the main trie. The trie
+// can be shared only between accounts adapters that do not change the state
+func (adb *AccountsDB) SetRecreatedTrie(rootHash []byte, recreatedTrie common.Trie) error {
+	if check.IfNil(recreatedTrie) {
+		return ErrNilTrie
+	}
+
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	adb.obsoleteDataTrieHashes = make(map[string][][]byte)
+	adb.dataTries.Reset()
+	adb.entries = make([]JournalEntry, 0)
+	adb.mainTrie = recreatedTrie
+	adb.lastRootHash = rootHash
+
+	return nil
+}
+
 // RecreateAllTries recreates all the tries from the accounts DB
 func (adb *AccountsDB) RecreateAllTries(rootHash []byte) (map[string]common.Trie, error) {
 	leavesChannels := &common.TrieIteratorChannels{
@@ -195,6 +195,46 @@ func (accountsDB *accountsDBApi) RecreateTrieFromEpoch(options common.RootHashHo
 	return nil
 }
 
+// GetRecreatedTrie returns the main trie recreated on the current root hash, if any
+func (accountsDB *accountsDBApi) GetRecreatedTrie() common.Trie {
+	accountsDB.mutRecreatedTrieBlockInfo.RLock()
+	defer accountsDB.mutRecreatedTrieBlockInfo.RUnlock()
+
+	handler, ok := accountsDB.innerAccountsAdapter.(RecreatedTrieHandler)
+	if !ok || check.IfNil(accountsDB.blockInfo) {
+		return nil
+	}
+
+	return handler.GetRecreatedTrie()
+}
+
+// SetRecreatedTrie sets the provided trie, already recreated on the provided root hash, as the state to be read,
+// without recreating it
+func (accountsDB *accountsDBApi) SetRecreatedTrie(rootHash []byte, recreatedTrie common.Trie) error {
+	accountsDB.mutRecreatedTrieBlockInfo.Lock()
+	defer accountsDB.mutRecreatedTrieBlockInfo.Unlock()
+
+	handler, ok := accountsDB.innerAccountsAdapter.(RecreatedTrieHandler)
+	if !ok {
+		return ErrOperationNotPermitted
+	}
+
+	newBlockInfo := holders.NewBlockInfo([]byte{}, 0, rootHash)
+	if newBlockInfo.Equal(accountsDB.blockInfo) {
+		return nil
+	}
+
+	err := handler.SetRecreatedTrie(rootHash, recreatedTrie)
+	if err != nil {
+		accountsDB.blockInfo = nil
+		return err
+	}
+
+	accountsDB.blockInfo = newBlockInfo
+
+	return nil
+}
+
 // PruneTrie is a not permitted operation in this implementation and thus, does nothing
 func (accountsDB *accountsDBApi) PruneTrie(_ []byte, _ TriePruningIdentifier, _ PruningHandler) {
 }
@@ -261,6 +261,48 @@ func TestAccountsDBApi_RecreateTrieFromEpoch(t *testing.T) {
 	})
 }
 
+func TestAccountsDBApi_SetRecreatedTrie(t *testing.T) {
+	t.Parallel()
+
+	t.Run("inner accounts adapter not able to share its trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsApi, _ := state.NewAccountsDBApi(&mockState.AccountsStub{}, createBlockInfoProviderStub(dummyRootHash))
+
+		err := accountsApi.SetRecreatedTrie(dummyRootHash, &testTrie.TrieStub{})
+		assert.Equal(t, state.ErrOperationNotPermitted, err)
+		assert.Nil(t, accountsApi.GetRecreatedTrie())
+	})
+	t.Run("nil trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsApi, _ := state.NewAccountsDBApi(generateAccountDBFromTrie(&testTrie.TrieStub{}), createBlockInfoProviderStub(dummyRootHash))
+
+		err := accountsApi.SetRecreatedTrie(dummyRootHash, nil)
+		assert.Equal(t, state.ErrNilTrie, err)
+		assert.Nil(t, accountsApi.GetRecreatedTrie())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		recreateTrieCalled := false
+		providedTrie := &testTrie.TrieStub{
+			RecreateCalled: func(root []byte) (common.Trie, error) {
+				recreateTrieCalled = true
+				return nil, nil
+			},
+		}
+		accountsApi, _ := state.NewAccountsDBApi(generateAccountDBFromTrie(&testTrie.TrieStub{}), createBlockInfoProviderStub(dummyRootHash))
+
+		err := accountsApi.SetRecreatedTrie(dummyRootHash, providedTrie)
+		assert.Nil(t, err)
+		assert.True(t, accountsApi.GetRecreatedTrie() == providedTrie)
+
+		_ = accountsApi.GetCode([]byte("code hash"))
+		assert.False(t, recreateTrieCalled)
+	})
+}
+
 func TestAccountsDBApi_EmptyMethodsShouldNotPanic(t *testing.T) {
 	t.Parallel()
 
@@ -214,6 +214,13 @@ type AccountsAdapterAPI interface {
 	GetCodeWithBlockInfo(codeHash []byte, options common.RootHashHolder) ([]byte, common.BlockInfo, error)
 }
 
+// RecreatedTrieHandler defines the read only accounts adapter able to share its recreated main trie, so other read only
+// accounts adapters can reuse it, along with its already loaded nodes, instead of recreating it
+type RecreatedTrieHandler interface {
+	GetRecreatedTrie() common.Trie
+	SetRecreatedTrie(rootHash []byte, recreatedTrie common.Trie) error
+}
+
 // DataTrie defines the behavior of a data trie
 type DataTrie interface {
 	common.Trie
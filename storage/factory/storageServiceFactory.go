@@ -286,6 +286,24 @@ func (psf *StorageServiceFactory) createAndAddBaseStorageUnits(
 	}
 	store.AddStorer(dataRetriever.TrieEpochRootHashUnit, trieEpochRootHashStorageUnit)
 
+	validatorsHistoryStorageUnit, err := psf.createMetachainStaticStorer(psf.generalConfig.ValidatorsHistoryStorage, "ValidatorsHistoryStorage")
+	if err != nil {
+		return err
+	}
+	store.AddStorer(dataRetriever.ValidatorsHistoryUnit, validatorsHistoryStorageUnit)
+
+	rewardsBreakdownStorageUnit, err := psf.createMetachainStaticStorer(psf.generalConfig.RewardsBreakdownStorage, "RewardsBreakdownStorage")
+	if err != nil {
+		return err
+	}
+	store.AddStorer(dataRetriever.RewardsBreakdownUnit, rewardsBreakdownStorageUnit)
+
+	contractsVerificationStorageUnit, err := psf.createShardStaticStorer(psf.generalConfig.ContractsVerificationStorage, "ContractsVerificationStorage")
+	if err != nil {
+		return err
+	}
+	store.AddStorer(dataRetriever.ContractsVerificationUnit, contractsVerificationStorageUnit)
+
 	return nil
 }
 
@@ -710,6 +728,49 @@ func (psf *StorageServiceFactory) createTrieEpochRootHashStorerIfNeeded() (stora
 	return trieEpochRootHashStorageUnit, nil
 }
 
+// createMetachainStaticStorer creates a static storer only for the metachain nodes, as the data it keeps is computed
+// only by the metachain. The other nodes will receive a nil storer
+func (psf *StorageServiceFactory) createMetachainStaticStorer(storageConfig config.StorageConfig, configName string) (storage.Storer, error) {
+	if psf.shardCoordinator.SelfId() != core.MetachainShardId {
+		return storageunit.NewNilStorer(), nil
+	}
+
+	return psf.createStaticStorer(storageConfig, configName)
+}
+
+// createShardStaticStorer creates a static storer only for the shard nodes, as the data it keeps is related to the
+// user accounts. The metachain nodes will receive a nil storer
+func (psf *StorageServiceFactory) createShardStaticStorer(storageConfig config.StorageConfig, configName string) (storage.Storer, error) {
+	if psf.shardCoordinator.SelfId() == core.MetachainShardId {
+		return storageunit.NewNilStorer(), nil
+	}
+
+	return psf.createStaticStorer(storageConfig, configName)
+}
+
+func (psf *StorageServiceFactory) createStaticStorer(storageConfig config.StorageConfig, configName string) (storage.Storer, error) {
+	dbConfig := GetDBFromConfig(storageConfig.DB)
+	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
+	dbConfig.FilePath = psf.pathManager.PathForStatic(shardId, storageConfig.DB.FilePath)
+
+	dbConfigHandlerInstance := NewDBConfigHandler(storageConfig.DB)
+	persisterCreator, err := NewPersisterFactory(dbConfigHandlerInstance)
+	if err != nil {
+		return nil, err
+	}
+
+	storageUnit, err := storageunit.NewStorageUnitFromConf(
+		GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		persisterCreator,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s", err, configName)
+	}
+
+	return storageUnit, nil
+}
+
 func (psf *StorageServiceFactory) createTriePersister(
 	storageConfig config.StorageConfig,
 ) (storage.Storer, error) {
@@ -31,22 +31,25 @@ func createMockArgument(t *testing.T) StorageServiceFactoryArgs {
 				NumEpochsToKeep:            4,
 				ObserverCleanOldEpochsData: true,
 			},
-			ShardHdrNonceHashStorage:   createMockStorageConfig("ShardHdrNonceHashStorage"),
-			TxStorage:                  createMockStorageConfig("TxStorage"),
-			UnsignedTransactionStorage: createMockStorageConfig("UnsignedTransactionStorage"),
-			RewardTxStorage:            createMockStorageConfig("RewardTxStorage"),
-			ReceiptsStorage:            createMockStorageConfig("ReceiptsStorage"),
-			ScheduledSCRsStorage:       createMockStorageConfig("ScheduledSCRsStorage"),
-			BootstrapStorage:           createMockStorageConfig("BootstrapStorage"),
-			MiniBlocksStorage:          createMockStorageConfig("MiniBlocksStorage"),
-			MetaBlockStorage:           createMockStorageConfig("MetaBlockStorage"),
-			MetaHdrNonceHashStorage:    createMockStorageConfig("MetaHdrNonceHashStorage"),
-			BlockHeaderStorage:         createMockStorageConfig("BlockHeaderStorage"),
-			AccountsTrieStorage:        createMockStorageConfig("AccountsTrieStorage"),
-			PeerAccountsTrieStorage:    createMockStorageConfig("PeerAccountsTrieStorage"),
-			StatusMetricsStorage:       createMockStorageConfig("StatusMetricsStorage"),
-			PeerBlockBodyStorage:       createMockStorageConfig("PeerBlockBodyStorage"),
-			TrieEpochRootHashStorage:   createMockStorageConfig("TrieEpochRootHashStorage"),
+			ShardHdrNonceHashStorage:     createMockStorageConfig("ShardHdrNonceHashStorage"),
+			TxStorage:                    createMockStorageConfig("TxStorage"),
+			UnsignedTransactionStorage:   createMockStorageConfig("UnsignedTransactionStorage"),
+			RewardTxStorage:              createMockStorageConfig("RewardTxStorage"),
+			ReceiptsStorage:              createMockStorageConfig("ReceiptsStorage"),
+			ScheduledSCRsStorage:         createMockStorageConfig("ScheduledSCRsStorage"),
+			BootstrapStorage:             createMockStorageConfig("BootstrapStorage"),
+			MiniBlocksStorage:            createMockStorageConfig("MiniBlocksStorage"),
+			MetaBlockStorage:             createMockStorageConfig("MetaBlockStorage"),
+			MetaHdrNonceHashStorage:      createMockStorageConfig("MetaHdrNonceHashStorage"),
+			BlockHeaderStorage:           createMockStorageConfig("BlockHeaderStorage"),
+			AccountsTrieStorage:          createMockStorageConfig("AccountsTrieStorage"),
+			PeerAccountsTrieStorage:      createMockStorageConfig("PeerAccountsTrieStorage"),
+			StatusMetricsStorage:         createMockStorageConfig("StatusMetricsStorage"),
+			PeerBlockBodyStorage:         createMockStorageConfig("PeerBlockBodyStorage"),
+			TrieEpochRootHashStorage:     createMockStorageConfig("TrieEpochRootHashStorage"),
+			ValidatorsHistoryStorage:     createMockStorageConfig("ValidatorsHistoryStorage"),
+			RewardsBreakdownStorage:      createMockStorageConfig("RewardsBreakdownStorage"),
+			ContractsVerificationStorage: createMockStorageConfig("ContractsVerificationStorage"),
 			DbLookupExtensions: config.DbLookupExtensionsConfig{
 				Enabled:                            true,
 				DbLookupMaxActivePersisters:        10,
@@ -408,7 +411,7 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 		assert.Nil(t, err)
 		assert.False(t, check.IfNil(storageService))
 		allStorers := storageService.GetAllStorers()
-		expectedStorers := 23
+		expectedStorers := 26
 		assert.Equal(t, expectedStorers, len(allStorers))
 
 		storer, _ := storageService.GetStorer(dataRetriever.UserAccountsUnit)
@@ -430,7 +433,7 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 		assert.False(t, check.IfNil(storageService))
 		allStorers := storageService.GetAllStorers()
 		numDBLookupExtensionUnits := 6
-		expectedStorers := 23 - numDBLookupExtensionUnits
+		expectedStorers := 26 - numDBLookupExtensionUnits
 		assert.Equal(t, expectedStorers, len(allStorers))
 		_ = storageService.CloseAll()
 	})
@@ -444,7 +447,7 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 		assert.Nil(t, err)
 		assert.False(t, check.IfNil(storageService))
 		allStorers := storageService.GetAllStorers()
-		expectedStorers := 23 // we still have a storer for trie epoch root hash
+		expectedStorers := 26 // we still have a storer for trie epoch root hash
 		assert.Equal(t, expectedStorers, len(allStorers))
 		_ = storageService.CloseAll()
 	})
@@ -458,7 +461,7 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 		assert.Nil(t, err)
 		assert.False(t, check.IfNil(storageService))
 		allStorers := storageService.GetAllStorers()
-		expectedStorers := 23
+		expectedStorers := 26
 		assert.Equal(t, expectedStorers, len(allStorers))
 
 		storer, _ := storageService.GetStorer(dataRetriever.UserAccountsUnit)
@@ -527,7 +530,7 @@ func TestStorageServiceFactory_CreateForMeta(t *testing.T) {
 		allStorers := storageService.GetAllStorers()
 		missingStorers := 2 // PeerChangesUnit and ShardHdrNonceHashDataUnit
 		numShardHdrStorage := 3
-		expectedStorers := 23 - missingStorers + numShardHdrStorage
+		expectedStorers := 26 - missingStorers + numShardHdrStorage
 		assert.Equal(t, expectedStorers, len(allStorers))
 
 		storer, _ := storageService.GetStorer(dataRetriever.UserAccountsUnit)
@@ -550,7 +553,7 @@ func TestStorageServiceFactory_CreateForMeta(t *testing.T) {
 		allStorers := storageService.GetAllStorers()
 		missingStorers := 2 // PeerChangesUnit and ShardHdrNonceHashDataUnit
 		numShardHdrStorage := 3
-		expectedStorers := 23 - missingStorers + numShardHdrStorage
+		expectedStorers := 26 - missingStorers + numShardHdrStorage
 		assert.Equal(t, expectedStorers, len(allStorers))
 
 		storer, _ := storageService.GetStorer(dataRetriever.UserAccountsUnit)
@@ -0,0 +1,65 @@
+package testscommon
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// ABIRegistryStub -
+type ABIRegistryStub struct {
+	RegisterABICalled            func(address string, abiJSON []byte) error
+	GetRegisteredAddressesCalled func() []string
+	DecodeArgumentsCalled        func(address string, function string, args [][]byte) ([]*common.ABIDecodedValue, error)
+	DecodeReturnDataCalled       func(address string, function string, returnData [][]byte) ([]*common.ABIDecodedValue, error)
+	DecodeEventCalled            func(event *transaction.Events) (*common.ABIDecodedEvent, error)
+}
+
+// RegisterABI -
+func (stub *ABIRegistryStub) RegisterABI(address string, abiJSON []byte) error {
+	if stub.RegisterABICalled != nil {
+		return stub.RegisterABICalled(address, abiJSON)
+	}
+
+	return nil
+}
+
+// GetRegisteredAddresses -
+func (stub *ABIRegistryStub) GetRegisteredAddresses() []string {
+	if stub.GetRegisteredAddressesCalled != nil {
+		return stub.GetRegisteredAddressesCalled()
+	}
+
+	return make([]string, 0)
+}
+
+// DecodeArguments -
+func (stub *ABIRegistryStub) DecodeArguments(address string, function string, args [][]byte) ([]*common.ABIDecodedValue, error) {
+	if stub.DecodeArgumentsCalled != nil {
+		return stub.DecodeArgumentsCalled(address, function, args)
+	}
+
+	return nil, nil
+}
+
+// DecodeReturnData -
+func (stub *ABIRegistryStub) DecodeReturnData(address string, function string, returnData [][]byte) ([]*common.ABIDecodedValue, error) {
+	if stub.DecodeReturnDataCalled != nil {
+		return stub.DecodeReturnDataCalled(address, function, returnData)
+	}
+
+	return nil, nil
+}
+
+// DecodeEvent -
+func (stub *ABIRegistryStub) DecodeEvent(event *transaction.Events) (*common.ABIDecodedEvent, error) {
+	if stub.DecodeEventCalled != nil {
+		return stub.DecodeEventCalled(event)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *ABIRegistryStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,80 @@
+package testscommon
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// AntifloodDashboardStub -
+type AntifloodDashboardStub struct {
+	GetQuotasStatusCalled        func() []*common.AntifloodQuotaStatus
+	GetTopicsStatusCalled        func() []*common.AntifloodTopicStatus
+	GetBlacklistedPeersCalled    func() []*common.BlacklistedPeer
+	SetMaxQuotaCalled            func(name string, baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetMaxMessagesForTopicCalled func(topic string, maxNum uint32) error
+	BlacklistPeerCalled          func(pid core.PeerID, duration time.Duration) error
+	RemoveBlacklistedPeerCalled  func(pid core.PeerID) error
+}
+
+// GetQuotasStatus -
+func (stub *AntifloodDashboardStub) GetQuotasStatus() []*common.AntifloodQuotaStatus {
+	if stub.GetQuotasStatusCalled != nil {
+		return stub.GetQuotasStatusCalled()
+	}
+	return make([]*common.AntifloodQuotaStatus, 0)
+}
+
+// GetTopicsStatus -
+func (stub *AntifloodDashboardStub) GetTopicsStatus() []*common.AntifloodTopicStatus {
+	if stub.GetTopicsStatusCalled != nil {
+		return stub.GetTopicsStatusCalled()
+	}
+	return make([]*common.AntifloodTopicStatus, 0)
+}
+
+// GetBlacklistedPeers -
+func (stub *AntifloodDashboardStub) GetBlacklistedPeers() []*common.BlacklistedPeer {
+	if stub.GetBlacklistedPeersCalled != nil {
+		return stub.GetBlacklistedPeersCalled()
+	}
+	return make([]*common.BlacklistedPeer, 0)
+}
+
+// SetMaxQuota -
+func (stub *AntifloodDashboardStub) SetMaxQuota(name string, baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	if stub.SetMaxQuotaCalled != nil {
+		return stub.SetMaxQuotaCalled(name, baseMaxNumMessagesPerPeer, maxTotalSizePerPeer)
+	}
+	return nil
+}
+
+// SetMaxMessagesForTopic -
+func (stub *AntifloodDashboardStub) SetMaxMessagesForTopic(topic string, maxNum uint32) error {
+	if stub.SetMaxMessagesForTopicCalled != nil {
+		return stub.SetMaxMessagesForTopicCalled(topic, maxNum)
+	}
+	return nil
+}
+
+// BlacklistPeer -
+func (stub *AntifloodDashboardStub) BlacklistPeer(pid core.PeerID, duration time.Duration) error {
+	if stub.BlacklistPeerCalled != nil {
+		return stub.BlacklistPeerCalled(pid, duration)
+	}
+	return nil
+}
+
+// RemoveBlacklistedPeer -
+func (stub *AntifloodDashboardStub) RemoveBlacklistedPeer(pid core.PeerID) error {
+	if stub.RemoveBlacklistedPeerCalled != nil {
+		return stub.RemoveBlacklistedPeerCalled(pid)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *AntifloodDashboardStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -2,16 +2,17 @@ package consensus
 
 // SigningHandlerStub implements SigningHandler interface
 type SigningHandlerStub struct {
-	ResetCalled                            func(pubKeys []string) error
-	CreateSignatureShareForPublicKeyCalled func(message []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error)
-	CreateSignatureForPublicKeyCalled      func(message []byte, publicKeyBytes []byte) ([]byte, error)
-	VerifySingleSignatureCalled            func(publicKeyBytes []byte, message []byte, signature []byte) error
-	StoreSignatureShareCalled              func(index uint16, sig []byte) error
-	SignatureShareCalled                   func(index uint16) ([]byte, error)
-	VerifySignatureShareCalled             func(index uint16, sig []byte, msg []byte, epoch uint32) error
-	AggregateSigsCalled                    func(bitmap []byte, epoch uint32) ([]byte, error)
-	SetAggregatedSigCalled                 func(_ []byte) error
-	VerifyCalled                           func(msg []byte, bitmap []byte, epoch uint32) error
+	ResetCalled                                 func(pubKeys []string) error
+	CreateSignatureShareForPublicKeyCalled      func(message []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error)
+	CreateSignatureForPublicKeyCalled           func(message []byte, publicKeyBytes []byte) ([]byte, error)
+	CreateRandomSeedSignatureForPublicKeyCalled func(prevRandSeed []byte, publicKeyBytes []byte) ([]byte, error)
+	VerifySingleSignatureCalled                 func(publicKeyBytes []byte, message []byte, signature []byte) error
+	StoreSignatureShareCalled                   func(index uint16, sig []byte) error
+	SignatureShareCalled                        func(index uint16) ([]byte, error)
+	VerifySignatureShareCalled                  func(index uint16, sig []byte, msg []byte, epoch uint32) error
+	AggregateSigsCalled                         func(bitmap []byte, epoch uint32) ([]byte, error)
+	SetAggregatedSigCalled                      func(_ []byte) error
+	VerifyCalled                                func(msg []byte, bitmap []byte, epoch uint32) error
 }
 
 // Reset -
@@ -41,6 +42,15 @@ func (stub *SigningHandlerStub) CreateSignatureForPublicKey(message []byte, publ
 	return make([]byte, 0), nil
 }
 
+// CreateRandomSeedSignatureForPublicKey -
+func (stub *SigningHandlerStub) CreateRandomSeedSignatureForPublicKey(prevRandSeed []byte, publicKeyBytes []byte) ([]byte, error) {
+	if stub.CreateRandomSeedSignatureForPublicKeyCalled != nil {
+		return stub.CreateRandomSeedSignatureForPublicKeyCalled(prevRandSeed, publicKeyBytes)
+	}
+
+	return make([]byte, 0), nil
+}
+
 // VerifySingleSignature -
 func (stub *SigningHandlerStub) VerifySingleSignature(publicKeyBytes []byte, message []byte, signature []byte) error {
 	if stub.VerifySingleSignatureCalled != nil {
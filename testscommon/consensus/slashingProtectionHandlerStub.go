@@ -0,0 +1,52 @@
+package consensus
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// SlashingProtectionHandlerStub -
+type SlashingProtectionHandlerStub struct {
+	CheckAndRecordCalled func(publicKey []byte, epoch uint32, round int64, headerHash []byte) error
+	ExportCalled         func() *common.SlashingProtectionSnapshot
+	ImportCalled         func(snapshot *common.SlashingProtectionSnapshot) error
+	CloseCalled          func() error
+}
+
+// CheckAndRecord -
+func (stub *SlashingProtectionHandlerStub) CheckAndRecord(publicKey []byte, epoch uint32, round int64, headerHash []byte) error {
+	if stub.CheckAndRecordCalled != nil {
+		return stub.CheckAndRecordCalled(publicKey, epoch, round, headerHash)
+	}
+
+	return nil
+}
+
+// Export -
+func (stub *SlashingProtectionHandlerStub) Export() *common.SlashingProtectionSnapshot {
+	if stub.ExportCalled != nil {
+		return stub.ExportCalled()
+	}
+
+	return &common.SlashingProtectionSnapshot{}
+}
+
+// Import -
+func (stub *SlashingProtectionHandlerStub) Import(snapshot *common.SlashingProtectionSnapshot) error {
+	if stub.ImportCalled != nil {
+		return stub.ImportCalled(snapshot)
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *SlashingProtectionHandlerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SlashingProtectionHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,42 @@
+package cryptoMocks
+
+import "github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+
+// RemoteSignerClientStub -
+type RemoteSignerClientStub struct {
+	PublicKeysCalled func() ([][]byte, error)
+	SignCalled       func(request *remoteSigner.SignRequest) ([]byte, error)
+	CloseCalled      func() error
+}
+
+// PublicKeys -
+func (stub *RemoteSignerClientStub) PublicKeys() ([][]byte, error) {
+	if stub.PublicKeysCalled != nil {
+		return stub.PublicKeysCalled()
+	}
+
+	return make([][]byte, 0), nil
+}
+
+// Sign -
+func (stub *RemoteSignerClientStub) Sign(request *remoteSigner.SignRequest) ([]byte, error) {
+	if stub.SignCalled != nil {
+		return stub.SignCalled(request)
+	}
+
+	return nil, nil
+}
+
+// Close -
+func (stub *RemoteSignerClientStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *RemoteSignerClientStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -2,12 +2,15 @@ package testscommon
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 // LogsFacadeStub -
 type LogsFacadeStub struct {
 	GetLogCalled                    func(txHash []byte, epoch uint32) (*transaction.ApiLogs, error)
 	IncludeLogsInTransactionsCalled func(txs []*transaction.ApiTransactionResult, logsKeys [][]byte, epoch uint32) error
+	GetLogsCalled                   func(logsKeys [][]byte, epoch uint32) (map[string]*transaction.Log, error)
+	DecodeLogEventsCalled           func(logs *transaction.ApiLogs) []*common.ABIDecodedEvent
 }
 
 // GetLog -
@@ -28,6 +31,24 @@ func (stub *LogsFacadeStub) IncludeLogsInTransactions(txs []*transaction.ApiTran
 	return nil
 }
 
+// GetLogs -
+func (stub *LogsFacadeStub) GetLogs(logsKeys [][]byte, epoch uint32) (map[string]*transaction.Log, error) {
+	if stub.GetLogsCalled != nil {
+		return stub.GetLogsCalled(logsKeys, epoch)
+	}
+
+	return make(map[string]*transaction.Log), nil
+}
+
+// DecodeLogEvents -
+func (stub *LogsFacadeStub) DecodeLogEvents(logs *transaction.ApiLogs) []*common.ABIDecodedEvent {
+	if stub.DecodeLogEventsCalled != nil {
+		return stub.DecodeLogEventsCalled(logs)
+	}
+
+	return make([]*common.ABIDecodedEvent, 0)
+}
+
 // IsInterfaceNil -
 func (stub *LogsFacadeStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -10,6 +10,7 @@ import (
 // StatusComponentsStub -
 type StatusComponentsStub struct {
 	Outport                  outport.OutportHandler
+	ReplayDriverFactory      outport.ReplayDriverFactory
 	SoftwareVersionCheck     statistics.SoftwareVersionChecker
 	AppStatusHandler         core.AppStatusHandler
 	ManagedPeersMonitorField common.ManagedPeersMonitor
@@ -35,6 +36,11 @@ func (scs *StatusComponentsStub) OutportHandler() outport.OutportHandler {
 	return scs.Outport
 }
 
+// OutportReplayDriverFactory -
+func (scs *StatusComponentsStub) OutportReplayDriverFactory() outport.ReplayDriverFactory {
+	return scs.ReplayDriverFactory
+}
+
 // SoftwareVersionChecker -
 func (scs *StatusComponentsStub) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	return scs.SoftwareVersionCheck
@@ -10,6 +10,7 @@ import (
 // ManagedPeersHolderStub -
 type ManagedPeersHolderStub struct {
 	AddManagedPeerCalled                         func(privateKeyBytes []byte) error
+	RemoveManagedPeerCalled                      func(pkBytes []byte) error
 	GetPrivateKeyCalled                          func(pkBytes []byte) (crypto.PrivateKey, error)
 	GetP2PIdentityCalled                         func(pkBytes []byte) ([]byte, core.PeerID, error)
 	GetMachineIDCalled                           func(pkBytes []byte) (string, error)
@@ -37,6 +38,14 @@ func (stub *ManagedPeersHolderStub) AddManagedPeer(privateKeyBytes []byte) error
 	return nil
 }
 
+// RemoveManagedPeer -
+func (stub *ManagedPeersHolderStub) RemoveManagedPeer(pkBytes []byte) error {
+	if stub.RemoveManagedPeerCalled != nil {
+		return stub.RemoveManagedPeerCalled(pkBytes)
+	}
+	return nil
+}
+
 // GetPrivateKey -
 func (stub *ManagedPeersHolderStub) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
 	if stub.GetPrivateKeyCalled != nil {
@@ -0,0 +1,24 @@
+package outport
+
+import (
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+)
+
+// OutportBlockFilterStub -
+type OutportBlockFilterStub struct {
+	FilterOutportBlockCalled func(outportBlock *outportcore.OutportBlock) *outportcore.OutportBlock
+}
+
+// FilterOutportBlock -
+func (stub *OutportBlockFilterStub) FilterOutportBlock(outportBlock *outportcore.OutportBlock) *outportcore.OutportBlock {
+	if stub.FilterOutportBlockCalled != nil {
+		return stub.FilterOutportBlockCalled(outportBlock)
+	}
+
+	return outportBlock
+}
+
+// IsInterfaceNil -
+func (stub *OutportBlockFilterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,42 @@
+package outport
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// OutportReplayerStub -
+type OutportReplayerStub struct {
+	StartReplayCalled func(startNonce uint64, endNonce uint64, target string) error
+	GetStatusCalled   func() common.OutportReplayStatus
+	CloseCalled       func() error
+}
+
+// StartReplay -
+func (stub *OutportReplayerStub) StartReplay(startNonce uint64, endNonce uint64, target string) error {
+	if stub.StartReplayCalled != nil {
+		return stub.StartReplayCalled(startNonce, endNonce, target)
+	}
+
+	return nil
+}
+
+// GetStatus -
+func (stub *OutportReplayerStub) GetStatus() common.OutportReplayStatus {
+	if stub.GetStatusCalled != nil {
+		return stub.GetStatusCalled()
+	}
+
+	return common.OutportReplayStatus{}
+}
+
+// Close -
+func (stub *OutportReplayerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *OutportReplayerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
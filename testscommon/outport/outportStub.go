@@ -11,6 +11,7 @@ type OutportStub struct {
 	SaveValidatorsRatingCalled  func(validatorsRating *outportcore.ValidatorsRating)
 	SaveValidatorsPubKeysCalled func(validatorsPubKeys *outportcore.ValidatorsPubKeys)
 	HasDriversCalled            func() bool
+	FinalizedBlockCalled        func(finalizedBlock *outportcore.FinalizedBlock)
 }
 
 // SaveBlock -
@@ -75,5 +76,8 @@ func (as *OutportStub) SubscribeDriver(_ outport.Driver) error {
 }
 
 // FinalizedBlock -
-func (as *OutportStub) FinalizedBlock(_ *outportcore.FinalizedBlock) {
+func (as *OutportStub) FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock) {
+	if as.FinalizedBlockCalled != nil {
+		as.FinalizedBlockCalled(finalizedBlock)
+	}
 }
@@ -0,0 +1,25 @@
+package outport
+
+import (
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/mock"
+)
+
+// ReplayDriverFactoryStub -
+type ReplayDriverFactoryStub struct {
+	CreateReplayDriverCalled func(target string) (outport.Driver, error)
+}
+
+// CreateReplayDriver -
+func (stub *ReplayDriverFactoryStub) CreateReplayDriver(target string) (outport.Driver, error) {
+	if stub.CreateReplayDriverCalled != nil {
+		return stub.CreateReplayDriverCalled(target)
+	}
+
+	return &mock.DriverStub{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *ReplayDriverFactoryStub) IsInterfaceNil() bool {
+	return stub == nil
+}
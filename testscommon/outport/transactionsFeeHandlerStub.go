@@ -0,0 +1,24 @@
+package outport
+
+import (
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+)
+
+// TransactionsFeeHandlerStub -
+type TransactionsFeeHandlerStub struct {
+	PutFeeAndGasUsedCalled func(pool *outportcore.TransactionPool) error
+}
+
+// PutFeeAndGasUsed -
+func (stub *TransactionsFeeHandlerStub) PutFeeAndGasUsed(pool *outportcore.TransactionPool) error {
+	if stub.PutFeeAndGasUsedCalled != nil {
+		return stub.PutFeeAndGasUsedCalled(pool)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *TransactionsFeeHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
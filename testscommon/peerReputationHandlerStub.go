@@ -0,0 +1,50 @@
+package testscommon
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// PeerReputationHandlerStub -
+type PeerReputationHandlerStub struct {
+	ExportCalled func() *common.PeerReputationSnapshot
+	ImportCalled func(snapshot *common.PeerReputationSnapshot) error
+	SaveCalled   func() error
+	CloseCalled  func() error
+}
+
+// Export -
+func (stub *PeerReputationHandlerStub) Export() *common.PeerReputationSnapshot {
+	if stub.ExportCalled != nil {
+		return stub.ExportCalled()
+	}
+	return &common.PeerReputationSnapshot{}
+}
+
+// Import -
+func (stub *PeerReputationHandlerStub) Import(snapshot *common.PeerReputationSnapshot) error {
+	if stub.ImportCalled != nil {
+		return stub.ImportCalled(snapshot)
+	}
+	return nil
+}
+
+// Save -
+func (stub *PeerReputationHandlerStub) Save() error {
+	if stub.SaveCalled != nil {
+		return stub.SaveCalled()
+	}
+	return nil
+}
+
+// Close -
+func (stub *PeerReputationHandlerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *PeerReputationHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
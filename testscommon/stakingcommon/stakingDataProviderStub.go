@@ -18,6 +18,8 @@ type StakingDataProviderStub struct {
 	ComputeUnQualifiedNodesCalled         func(validatorInfos state.ShardValidatorsInfoMapHandler) ([][]byte, map[string][][]byte, error)
 	GetBlsKeyOwnerCalled                  func(blsKey []byte) (string, error)
 	GetOwnersDataCalled                   func() map[string]*epochStart.OwnerData
+	SetOwnersDataOverrideCalled           func(ownersData map[string]*epochStart.OwnerData)
+	ResetOwnersDataOverrideCalled         func()
 }
 
 // FillValidatorInfo -
@@ -105,6 +107,20 @@ func (sdps *StakingDataProviderStub) GetOwnersData() map[string]*epochStart.Owne
 	return nil
 }
 
+// SetOwnersDataOverride -
+func (sdps *StakingDataProviderStub) SetOwnersDataOverride(ownersData map[string]*epochStart.OwnerData) {
+	if sdps.SetOwnersDataOverrideCalled != nil {
+		sdps.SetOwnersDataOverrideCalled(ownersData)
+	}
+}
+
+// ResetOwnersDataOverride -
+func (sdps *StakingDataProviderStub) ResetOwnersDataOverride() {
+	if sdps.ResetOwnersDataOverrideCalled != nil {
+		sdps.ResetOwnersDataOverrideCalled()
+	}
+}
+
 // EpochConfirmed -
 func (sdps *StakingDataProviderStub) EpochConfirmed(uint32, uint64) {
 }
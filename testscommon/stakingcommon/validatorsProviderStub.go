@@ -1,6 +1,7 @@
 package stakingcommon
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-go/common"
 )
@@ -9,6 +10,8 @@ import (
 type ValidatorsProviderStub struct {
 	GetLatestValidatorsCalled func() map[string]*validator.ValidatorStatistics
 	GetAuctionListCalled      func() ([]*common.AuctionListValidatorAPIResponse, error)
+	SimulateAuctionCalled     func(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error)
+	GetValidatorHistoryCalled func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error)
 	ForceUpdateCalled         func() error
 }
 
@@ -30,6 +33,24 @@ func (vp *ValidatorsProviderStub) GetAuctionList() ([]*common.AuctionListValidat
 	return nil, nil
 }
 
+// SimulateAuction -
+func (vp *ValidatorsProviderStub) SimulateAuction(request *common.AuctionSimulationAPIRequest) (*common.AuctionSimulationAPIResponse, error) {
+	if vp.SimulateAuctionCalled != nil {
+		return vp.SimulateAuctionCalled(request)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorHistory -
+func (vp *ValidatorsProviderStub) GetValidatorHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorEpochStatisticsAPIResponse, error) {
+	if vp.GetValidatorHistoryCalled != nil {
+		return vp.GetValidatorHistoryCalled(blsKey, fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
 // ForceUpdate -
 func (vp *ValidatorsProviderStub) ForceUpdate() error {
 	if vp.ForceUpdateCalled != nil {